@@ -21,6 +21,9 @@ const (
 
 	// DefaultGRPCWebAddress defines the default address to bind the gRPC-web server to.
 	DefaultGRPCWebAddress = "0.0.0.0:9091"
+
+	// DefaultStreamAddress defines the default address to bind the stream server to.
+	DefaultStreamAddress = "0.0.0.0:9999"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -93,6 +96,21 @@ type GRPCWebConfig struct {
 	Address string `mapstructure:"address"`
 }
 
+// StreamConfig defines configuration for the exchange and oracle updates stream server.
+type StreamConfig struct {
+	// Enable defines if the stream server should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// Address defines the stream server to listen on
+	Address string `mapstructure:"address"`
+}
+
+// IndexerConfig defines configuration for the local indexer of the exchange, oracle, peggy, insurance and auction events.
+type IndexerConfig struct {
+	// Enable defines if the events should be indexed into the indexer database of the node.
+	Enable bool `mapstructure:"enable"`
+}
+
 // RosettaConfig defines the Rosetta API listener configuration.
 type RosettaConfig struct {
 	// Address defines the API server to listen on
@@ -136,6 +154,8 @@ type Config struct {
 	API       APIConfig        `mapstructure:"api"`
 	GRPC      GRPCConfig       `mapstructure:"grpc"`
 	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
+	Stream    StreamConfig     `mapstructure:"stream"`
+	Indexer   IndexerConfig    `mapstructure:"indexer"`
 	Rosetta   RosettaConfig    `mapstructure:"rosetta"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
 }
@@ -201,6 +221,13 @@ func DefaultConfig() *Config {
 			Enable:  true,
 			Address: DefaultGRPCWebAddress,
 		},
+		Stream: StreamConfig{
+			Enable:  false,
+			Address: DefaultStreamAddress,
+		},
+		Indexer: IndexerConfig{
+			Enable: false,
+		},
 		Rosetta: RosettaConfig{
 			Enable:     true,
 			Address:    ":8080",
@@ -261,6 +288,13 @@ func GetConfig(v *viper.Viper) Config {
 			Enable:  v.GetBool("grpc-web.enable"),
 			Address: v.GetString("grpc-web.address"),
 		},
+		Stream: StreamConfig{
+			Enable:  v.GetBool("stream.enable"),
+			Address: v.GetString("stream.address"),
+		},
+		Indexer: IndexerConfig{
+			Enable: v.GetBool("indexer.enable"),
+		},
 		Rosetta: RosettaConfig{
 			Enable:     v.GetBool("rosetta.enable"),
 			Address:    v.GetString("rosetta.address"),
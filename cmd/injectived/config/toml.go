@@ -155,6 +155,20 @@ enable = {{ .GRPCWeb.Enable }}
 # Address defines the gRPC Web server address to bind to.
 address = "{{ .GRPCWeb.Address }}"
 
+[stream]
+
+# Enable defines if the stream server of the exchange and oracle updates of every block should be enabled.
+enable = {{ .Stream.Enable }}
+
+# Address defines the stream server address to bind to.
+address = "{{ .Stream.Address }}"
+
+[indexer]
+
+# Enable defines if the exchange, oracle, peggy, insurance and auction events of every block should be indexed into
+# the indexer database of the node, queryable through the gRPC and REST API.
+enable = {{ .Indexer.Enable }}
+
 ###############################################################################
 ###                        State Sync Configuration                         ###
 ###############################################################################
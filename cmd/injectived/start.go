@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -27,6 +28,8 @@ import (
 
 	"github.com/CosmWasm/wasmd/x/wasm"
 	"github.com/InjectiveLabs/injective-core/cmd/injectived/config"
+	"github.com/InjectiveLabs/injective-core/injective-chain/indexer"
+	"github.com/InjectiveLabs/injective-core/injective-chain/stream"
 	"github.com/InjectiveLabs/metrics"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -71,6 +74,9 @@ const (
 	flagGRPCAddress    = "grpc.address"
 	flagGRPCWebEnable  = "grpc-web.enable"
 	flagGRPCWebAddress = "grpc-web.address"
+	flagStreamEnable   = "stream.enable"
+	flagStreamAddress  = "stream.address"
+	flagIndexerEnable  = "indexer.enable"
 )
 
 // State sync-related flags.
@@ -165,6 +171,9 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().String(flagGRPCAddress, config.DefaultGRPCAddress, "the gRPC server address to listen on")
 	cmd.Flags().Bool(flagGRPCWebEnable, true, "Define if the gRPC-Web server should be enabled. (Note: gRPC must also be enabled.)")
 	cmd.Flags().String(flagGRPCWebAddress, config.DefaultGRPCWebAddress, "The gRPC-Web server address to listen on")
+	cmd.Flags().Bool(flagStreamEnable, false, "Define if the stream server of the exchange and oracle updates should be enabled")
+	cmd.Flags().String(flagStreamAddress, config.DefaultStreamAddress, "The stream server address to listen on")
+	cmd.Flags().Bool(flagIndexerEnable, false, "Define if the exchange, oracle, peggy, insurance and auction events should be indexed")
 
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
@@ -292,6 +301,22 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, appCreator ty
 		}
 	}
 
+	var streamSrv *stream.Server
+	if parsedConfig.Stream.Enable {
+		streamingApp, ok := app.(interface{ GetStreamPublisher() *stream.Publisher })
+		if !ok || streamingApp.GetStreamPublisher() == nil {
+			err := errors.New("the app doesn't publish any stream")
+			log.WithError(err).Errorln("failed to boot stream server")
+			return err
+		}
+
+		streamSrv = stream.NewServer(streamingApp.GetStreamPublisher())
+		if err := streamSrv.Serve(parsedConfig.Stream.Address); err != nil {
+			log.WithError(err).Errorln("failed to boot stream server")
+			return err
+		}
+	}
+
 	sdkcfg, _ := sdkconfig.GetConfig(ctx.Viper)
 	sdkcfg.API = parsedConfig.API
 	if sdkcfg.API.Enable {
@@ -362,6 +387,16 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, appCreator ty
 			grpcSrv.Stop()
 		}
 
+		if streamSrv != nil {
+			streamSrv.Stop()
+		}
+
+		if indexingApp, ok := app.(interface{ GetIndexer() *indexer.Indexer }); ok && indexingApp.GetIndexer() != nil {
+			if err := indexingApp.GetIndexer().Close(); err != nil {
+				log.WithError(err).Errorln("failed to close the indexer database")
+			}
+		}
+
 		log.Infoln("Bye!")
 	})
 
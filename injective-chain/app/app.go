@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"os"
@@ -10,12 +11,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
 
+	"github.com/InjectiveLabs/injective-core/injective-chain/indexer"
+	indexertypes "github.com/InjectiveLabs/injective-core/injective-chain/indexer/types"
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/auction"
 	auctionkeeper "github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/keeper"
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/insurance"
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/ocr"
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle"
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/wasmx"
+	"github.com/InjectiveLabs/injective-core/injective-chain/stream"
 	"github.com/InjectiveLabs/injective-core/injective-chain/wasmbinding"
 
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
@@ -25,6 +29,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/gorilla/mux"
 	"github.com/rakyll/statik/fs"
+	"github.com/spf13/cast"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
@@ -302,6 +307,12 @@ type InjectiveApp struct {
 
 	WasmxKeeper wasmxkeeper.Keeper
 
+	// publishes the exchange and oracle updates of every block to the stream server, nil if disabled
+	StreamPublisher *stream.Publisher
+
+	// indexes the exchange, oracle, peggy, insurance and auction history of the node, nil if disabled
+	Indexer *indexer.Indexer
+
 	// the module manager
 	mm *module.Manager
 
@@ -456,12 +467,6 @@ func NewInjectiveApp(
 		),
 	)
 
-	oracleModule := oracle.NewAppModule(
-		app.OracleKeeper,
-		app.AccountKeeper,
-		app.BankKeeper,
-	)
-
 	app.InsuranceKeeper = insurancekeeper.NewKeeper(
 		appCodec,
 		keys[insurancetypes.StoreKey],
@@ -505,6 +510,15 @@ func NewInjectiveApp(
 		app.MsgServiceRouter(),
 	)
 
+	// the oracle module is created after the exchange keeper is set as it keeps a copy of the oracle keeper
+	app.OracleKeeper.SetExchangeKeeper(&app.ExchangeKeeper)
+
+	oracleModule := oracle.NewAppModule(
+		app.OracleKeeper,
+		app.AccountKeeper,
+		app.BankKeeper,
+	)
+
 	app.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(
 		app.keys[tokenfactorytypes.StoreKey],
 		app.GetSubspace(tokenfactorytypes.ModuleName),
@@ -858,6 +872,21 @@ func NewInjectiveApp(
 
 	app.SetEndBlocker(app.EndBlocker)
 
+	if cast.ToBool(appOpts.Get(FlagStreamEnable)) {
+		app.StreamPublisher = stream.NewPublisher(stream.DefaultSubscriberBufferCapacity)
+		app.SetStreamingService(app.StreamPublisher)
+	}
+
+	if cast.ToBool(appOpts.Get(FlagIndexerEnable)) {
+		app.Indexer, err = indexer.NewIndexer(filepath.Join(homePath, "data"), indexer.DefaultBlockBufferCapacity)
+		if err != nil {
+			panic("error while opening the indexer database: " + err.Error())
+		}
+
+		app.SetStreamingService(app.Indexer)
+		indexertypes.RegisterQueryServer(app.GRPCQueryRouter(), app.Indexer)
+	}
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
@@ -876,6 +905,10 @@ func NewInjectiveApp(
 
 func (app *InjectiveApp) GetBaseApp() *baseapp.BaseApp { return app.BaseApp }
 
+func (app *InjectiveApp) GetStreamPublisher() *stream.Publisher { return app.StreamPublisher }
+
+func (app *InjectiveApp) GetIndexer() *indexer.Indexer { return app.Indexer }
+
 func (app *InjectiveApp) GetStakingKeeper() stakingkeeper.Keeper { return app.StakingKeeper }
 
 func (app *InjectiveApp) GetIBCKeeper() *ibckeeper.Keeper { return app.IBCKeeper }
@@ -1043,6 +1076,7 @@ func (app *InjectiveApp) registerUpgradeHandlers() {
 				BinaryOptionsAtomicMarketOrderFeeMultiplier: sdk.MustNewDecFromStr("2"),
 				MinimalProtocolFeeRate:                      sdk.MustNewDecFromStr("0.00001"),
 				IsInstantDerivativeMarketLaunchEnabled:      false,
+				TerminalOrderRetentionBlocks:                exchangetypes.DefaultTerminalOrderRetentionBlocks,
 			})
 
 			app.OracleKeeper.SetParams(ctx, oracletypes.Params{
@@ -1055,6 +1089,9 @@ func (app *InjectiveApp) registerUpgradeHandlers() {
 				app.ExchangeKeeper.MigrateExchangeBalances(ctx, exchangeBalances[idx])
 			}
 
+			// migrate the historical trade records to the records stored per market and timestamp
+			app.ExchangeKeeper.MigrateLegacyHistoricalTradeRecords(ctx)
+
 			slashingParams := app.SlashingKeeper.GetParams(ctx)
 			slashingParams.SignedBlocksWindow = 100000
 			app.SlashingKeeper.SetParams(ctx, slashingParams)
@@ -1118,6 +1155,13 @@ func (app *InjectiveApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.
 	ModuleBasics.RegisterRESTRoutes(clientCtx, apiSvr.Router)
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register the grpc-gateway routes of the local indexer if enabled.
+	if app.Indexer != nil {
+		if err := indexertypes.RegisterQueryHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, indexertypes.NewQueryClient(clientCtx)); err != nil {
+			panic(err)
+		}
+	}
+
 	// register swagger API from root so that other applications can override easily
 	if apiConfig.Swagger {
 		RegisterSwaggerAPI(apiSvr.Router)
@@ -0,0 +1,5 @@
+package app
+
+// FlagIndexerEnable defines if the local indexer of the exchange, oracle, peggy, insurance and auction events should
+// be enabled
+const FlagIndexerEnable = "indexer.enable"
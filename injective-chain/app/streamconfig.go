@@ -0,0 +1,4 @@
+package app
+
+// FlagStreamEnable defines if the exchange and oracle updates should be published to the stream server
+const FlagStreamEnable = "stream.enable"
@@ -0,0 +1,258 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/indexer/types"
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var _ types.QueryServer = &Indexer{}
+
+var (
+	tradeKeyColumns = []string{"block_height", "event_index", "trade_index"}
+	eventKeyColumns = []string{"block_height", "event_index"}
+)
+
+func (i *Indexer) Trades(c context.Context, req *types.QueryTradesRequest) (*types.QueryTradesResponse, error) {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+
+	if req.SubaccountId != "" {
+		conditions = append(conditions, "subaccount_id = ?")
+		args = append(args, parseHash(req.SubaccountId).Hex())
+	}
+	if req.MarketId != "" {
+		conditions = append(conditions, "market_id = ?")
+		args = append(args, parseHash(req.MarketId).Hex())
+	}
+	if len(conditions) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "either the market ID or the subaccount ID must be set")
+	}
+
+	trades := make([]types.Trade, 0)
+
+	pageRes, err := i.paginate(c, "trades", tradeColumns, tradeKeyColumns, conditions, args, req.Pagination, func(rows *sql.Rows, accumulate bool) ([]int64, error) {
+		trade, eventIndex, tradeIndex, err := scanTrade(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		if accumulate {
+			trades = append(trades, trade)
+		}
+		return []int64{trade.BlockHeight, eventIndex, tradeIndex}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTradesResponse{
+		Trades:     trades,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (i *Indexer) Events(c context.Context, req *types.QueryEventsRequest) (*types.QueryEventsResponse, error) {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+
+	if req.BlockHeight > 0 {
+		conditions = append(conditions, "block_height = ?")
+		args = append(args, req.BlockHeight)
+	}
+	if req.Type != "" {
+		conditions = append(conditions, "type = ?")
+		args = append(args, req.Type)
+	}
+
+	events := make([]types.IndexedEvent, 0)
+
+	pageRes, err := i.paginate(c, "events", eventColumns, eventKeyColumns, conditions, args, req.Pagination, func(rows *sql.Rows, accumulate bool) ([]int64, error) {
+		event, eventIndex, err := scanEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		if accumulate {
+			events = append(events, event)
+		}
+		return []int64{event.BlockHeight, eventIndex}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEventsResponse{
+		Events:     events,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (i *Indexer) IndexerStatus(_ context.Context, _ *types.QueryIndexerStatusRequest) (*types.QueryIndexerStatusResponse, error) {
+	lastBlockHeight, err := i.GetLastBlockHeight()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIndexerStatusResponse{
+		LastBlockHeight: lastBlockHeight,
+	}, nil
+}
+
+// paginate selects a page of the records of the table matching the conditions, in the order of the key columns. The
+// page key is the big endian encoding of the key columns of the first record of the next page. The scan function
+// decodes the current row, accumulates it if asked, and returns the values of its key columns.
+func (i *Indexer) paginate(
+	c context.Context,
+	table, columns string,
+	keyColumns, conditions []string,
+	args []interface{},
+	pageReq *query.PageRequest,
+	scan func(rows *sql.Rows, accumulate bool) ([]int64, error),
+) (*query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+	}
+
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+
+	pageConditions := conditions
+	pageArgs := args
+
+	if pageReq.Key != nil {
+		if len(pageReq.Key) != 8*len(keyColumns) {
+			return nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+
+		comparison := ">="
+		if pageReq.Reverse {
+			comparison = "<="
+		}
+
+		placeholders := make([]string, 0, len(keyColumns))
+		for idx := range keyColumns {
+			placeholders = append(placeholders, "?")
+			pageArgs = append(pageArgs, int64(sdk.BigEndianToUint64(pageReq.Key[8*idx:8*(idx+1)])))
+		}
+
+		pageConditions = append(pageConditions, fmt.Sprintf("(%s) %s (%s)", strings.Join(keyColumns, ", "), comparison, strings.Join(placeholders, ", ")))
+	}
+
+	order := " ASC"
+	if pageReq.Reverse {
+		order = " DESC"
+	}
+
+	// one more record is selected to get the key of the next page
+	statement := fmt.Sprintf("SELECT %s FROM %s%s ORDER BY %s LIMIT ? OFFSET ?",
+		columns, table, getWhereClause(pageConditions), strings.Join(keyColumns, order+", ")+order)
+	pageArgs = append(pageArgs, limit+1, pageReq.Offset)
+
+	rows, err := i.db.QueryContext(c, statement, pageArgs...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer rows.Close()
+
+	pageRes := &query.PageResponse{}
+
+	for count := uint64(0); rows.Next(); count++ {
+		keys, err := scan(rows, count < limit)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if count == limit {
+			nextKey := make([]byte, 0, 8*len(keys))
+			for _, key := range keys {
+				nextKey = append(nextKey, sdk.Uint64ToBigEndian(uint64(key))...)
+			}
+			pageRes.NextKey = nextKey
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if countTotal && pageReq.Key == nil {
+		statement := fmt.Sprintf("SELECT COUNT(*) FROM %s%s", table, getWhereClause(conditions))
+		if err := i.db.QueryRowContext(c, statement, args...).Scan(&pageRes.Total); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return pageRes, nil
+}
+
+func getWhereClause(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(conditions, " AND ")
+}
+
+func scanTrade(rows *sql.Rows) (trade types.Trade, eventIndex, tradeIndex int64, err error) {
+	var executionType, price, quantity, fee, payout string
+
+	if err := rows.Scan(
+		&trade.BlockHeight, &eventIndex, &tradeIndex, &trade.BlockTime, &trade.TxHash, &trade.MarketId, &trade.IsSpot,
+		&trade.SubaccountId, &trade.OrderHash, &trade.IsBuy, &executionType, &trade.IsLiquidation, &price, &quantity,
+		&fee, &payout, &trade.FeeRecipientAddress,
+	); err != nil {
+		return trade, 0, 0, err
+	}
+
+	trade.ExecutionType = exchangetypes.ExecutionType(exchangetypes.ExecutionType_value[executionType])
+
+	for _, dec := range []struct {
+		field *sdk.Dec
+		value string
+	}{
+		{&trade.Price, price},
+		{&trade.Quantity, quantity},
+		{&trade.Fee, fee},
+		{&trade.Payout, payout},
+	} {
+		if *dec.field, err = sdk.NewDecFromStr(dec.value); err != nil {
+			return trade, 0, 0, err
+		}
+	}
+
+	return trade, eventIndex, tradeIndex, nil
+}
+
+func scanEvent(rows *sql.Rows) (event types.IndexedEvent, eventIndex int64, err error) {
+	var attributes string
+
+	if err := rows.Scan(&event.BlockHeight, &eventIndex, &event.BlockTime, &event.TxHash, &event.Type, &attributes); err != nil {
+		return event, 0, err
+	}
+
+	if err := json.Unmarshal([]byte(attributes), &event.Attributes); err != nil {
+		return event, 0, err
+	}
+
+	return event, eventIndex, nil
+}
@@ -0,0 +1,260 @@
+package indexer
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	log "github.com/xlab/suplog"
+	// registers the pure Go sqlite driver, which doesn't need cgo for the static builds
+	_ "modernc.org/sqlite"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/indexer/types"
+)
+
+const (
+	// DBName is the name of the indexer SQLite database file in the data directory of the node
+	DBName = "indexer.db"
+	// DefaultBlockBufferCapacity is the number of executed blocks which can wait to be written to the database before
+	// the block execution waits for the writes
+	DefaultBlockBufferCapacity = 100
+)
+
+// IndexedEventTypePrefixes are the prefixes of the typed events being indexed
+var IndexedEventTypePrefixes = []string{
+	"injective.exchange.",
+	"injective.oracle.",
+	"injective.peggy.",
+	"injective.insurance.",
+	"injective.auction.",
+}
+
+var _ baseapp.StreamingService = &Indexer{}
+
+type indexedTrade struct {
+	eventIndex uint32
+	tradeIndex uint32
+	trade      types.Trade
+}
+
+// indexedBlock holds the records of an executed block until they're written to the database
+type indexedBlock struct {
+	height int64
+	events []types.IndexedEvent
+	trades []indexedTrade
+}
+
+// Indexer collects the exchange, oracle, peggy, insurance and auction events of every block through the ABCI listener
+// hooks of the BaseApp and writes them along with the trades they contain into an embedded database once the
+// EndBlocker is executed. The blocks are written in the background in execution order, and a block which fails to be
+// written is logged rather than failing the block execution. Since the block execution is deterministic, replaying a
+// block after a restart overwrites its records with the same ones.
+type Indexer struct {
+	db *sql.DB
+
+	// the executed blocks waiting to be written by the writer
+	blocks        chan *indexedBlock
+	writerStopped chan struct{}
+
+	// the block being executed, only accessed by the ABCI hooks
+	block     *indexedBlock
+	blockTime int64
+}
+
+func NewIndexer(dir string, blockBufferCapacity int) (*Indexer, error) {
+	// the WAL journal lets the queries read the database while a block is being written
+	db, err := sql.Open("sqlite", "file:"+filepath.Join(dir, DBName)+"?_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := db.Exec(schema); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	i := &Indexer{
+		db:            db,
+		blocks:        make(chan *indexedBlock, blockBufferCapacity),
+		writerStopped: make(chan struct{}),
+		block:         &indexedBlock{},
+	}
+
+	go i.runWriter()
+	return i, nil
+}
+
+// runWriter writes the executed blocks to the database until the indexer is closed
+func (i *Indexer) runWriter() {
+	defer close(i.writerStopped)
+
+	for block := range i.blocks {
+		if err := i.write(block); err != nil {
+			log.WithError(err).WithField("height", block.height).Errorln("failed to write the indexed block")
+		}
+	}
+}
+
+func isIndexedEventType(eventType string) bool {
+	for _, prefix := range IndexedEventTypePrefixes {
+		if strings.HasPrefix(eventType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *Indexer) collect(events []abci.Event, txHash string) {
+	for _, event := range events {
+		if !isIndexedEventType(event.Type) {
+			continue
+		}
+
+		eventIndex := uint32(len(i.block.events))
+		for tradeIndex, trade := range newTrades(event, i.block.height, i.blockTime, txHash) {
+			i.block.trades = append(i.block.trades, indexedTrade{
+				eventIndex: eventIndex,
+				tradeIndex: uint32(tradeIndex),
+				trade:      trade,
+			})
+		}
+
+		attributes := make([]types.EventAttribute, 0, len(event.Attributes))
+		for _, attribute := range event.Attributes {
+			attributes = append(attributes, types.EventAttribute{
+				Key:   string(attribute.Key),
+				Value: string(attribute.Value),
+			})
+		}
+
+		i.block.events = append(i.block.events, types.IndexedEvent{
+			BlockHeight: i.block.height,
+			BlockTime:   i.blockTime,
+			TxHash:      txHash,
+			Type:        event.Type,
+			Attributes:  attributes,
+		})
+	}
+}
+
+func (i *Indexer) write(block *indexedBlock) (err error) {
+	tx, err := i.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if _, err := tx.Exec(deleteBlockEvents, block.height); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(deleteBlockTrades, block.height); err != nil {
+		return err
+	}
+
+	eventStmt, err := tx.Prepare(insertEvent)
+	if err != nil {
+		return err
+	}
+	defer eventStmt.Close()
+
+	for eventIndex, event := range block.events {
+		attributes, err := json.Marshal(event.Attributes)
+		if err != nil {
+			return err
+		}
+
+		if _, err := eventStmt.Exec(event.BlockHeight, eventIndex, event.BlockTime, event.TxHash, event.Type, string(attributes)); err != nil {
+			return err
+		}
+	}
+
+	tradeStmt, err := tx.Prepare(insertTrade)
+	if err != nil {
+		return err
+	}
+	defer tradeStmt.Close()
+
+	for _, indexedTrade := range block.trades {
+		trade := indexedTrade.trade
+		if _, err := tradeStmt.Exec(
+			trade.BlockHeight, indexedTrade.eventIndex, indexedTrade.tradeIndex, trade.BlockTime, trade.TxHash,
+			trade.MarketId, trade.IsSpot, trade.SubaccountId, trade.OrderHash, trade.IsBuy, trade.ExecutionType.String(),
+			trade.IsLiquidation, trade.Price.String(), trade.Quantity.String(), trade.Fee.String(), trade.Payout.String(),
+			trade.FeeRecipientAddress,
+		); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.Exec(upsertLastBlockHeight, block.height); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetLastBlockHeight returns the height of the last indexed block, or zero if no block has been indexed yet
+func (i *Indexer) GetLastBlockHeight() (int64, error) {
+	var lastBlockHeight int64
+	err := i.db.QueryRow(selectLastBlockHeight).Scan(&lastBlockHeight)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return lastBlockHeight, err
+}
+
+func (i *Indexer) ListenBeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	// the previous block is owned by the writer once sent, so every block gets its own records
+	i.block = &indexedBlock{
+		height: ctx.BlockHeight(),
+	}
+	i.blockTime = ctx.BlockTime().UnixMilli()
+
+	i.collect(res.Events, "")
+	return nil
+}
+
+func (i *Indexer) ListenDeliverTx(_ sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	if res.IsOK() {
+		i.collect(res.Events, fmt.Sprintf("%X", sha256.Sum256(req.Tx)))
+	}
+	return nil
+}
+
+func (i *Indexer) ListenEndBlock(_ sdk.Context, _ abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	i.collect(res.Events, "")
+
+	// the block execution only waits for the writer if it lags behind by more than the buffer capacity
+	i.blocks <- i.block
+	return nil
+}
+
+// Listeners returns no store listener since the records are built from the events
+func (i *Indexer) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// Stream is a no-op since the records are written from the ABCI hooks
+func (i *Indexer) Stream(_ *sync.WaitGroup) error {
+	return nil
+}
+
+// Close waits for the executed blocks to be written and closes the indexer database
+func (i *Indexer) Close() error {
+	close(i.blocks)
+	<-i.writerStopped
+
+	return i.db.Close()
+}
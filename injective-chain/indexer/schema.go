@@ -0,0 +1,67 @@
+package indexer
+
+// The schema of the indexer database. The records are ordered by block height, event index and trade index, so that
+// they are iterated in the order of execution. The event index is the index of the event among the indexed events of
+// the block, and the trade index is the index of the trade in its execution event.
+const schema = `
+CREATE TABLE IF NOT EXISTS status (
+	id                INTEGER PRIMARY KEY CHECK (id = 1),
+	last_block_height INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS events (
+	block_height INTEGER NOT NULL,
+	event_index  INTEGER NOT NULL,
+	block_time   INTEGER NOT NULL,
+	tx_hash      TEXT    NOT NULL,
+	type         TEXT    NOT NULL,
+	attributes   TEXT    NOT NULL,
+	PRIMARY KEY (block_height, event_index)
+);
+
+CREATE INDEX IF NOT EXISTS events_by_type ON events (type, block_height, event_index);
+
+CREATE TABLE IF NOT EXISTS trades (
+	block_height          INTEGER NOT NULL,
+	event_index           INTEGER NOT NULL,
+	trade_index           INTEGER NOT NULL,
+	block_time            INTEGER NOT NULL,
+	tx_hash               TEXT    NOT NULL,
+	market_id             TEXT    NOT NULL,
+	is_spot               INTEGER NOT NULL,
+	subaccount_id         TEXT    NOT NULL,
+	order_hash            TEXT    NOT NULL,
+	is_buy                INTEGER NOT NULL,
+	execution_type        TEXT    NOT NULL,
+	is_liquidation        INTEGER NOT NULL,
+	price                 TEXT    NOT NULL,
+	quantity              TEXT    NOT NULL,
+	fee                   TEXT    NOT NULL,
+	payout                TEXT    NOT NULL,
+	fee_recipient_address TEXT    NOT NULL,
+	PRIMARY KEY (block_height, event_index, trade_index)
+);
+
+CREATE INDEX IF NOT EXISTS trades_by_market ON trades (market_id, block_height, event_index, trade_index);
+CREATE INDEX IF NOT EXISTS trades_by_subaccount ON trades (subaccount_id, block_height, event_index, trade_index);
+CREATE INDEX IF NOT EXISTS trades_by_subaccount_market ON trades (subaccount_id, market_id, block_height, event_index, trade_index);
+`
+
+const (
+	selectLastBlockHeight = `SELECT last_block_height FROM status WHERE id = 1`
+	upsertLastBlockHeight = `INSERT INTO status (id, last_block_height) VALUES (1, ?)
+		ON CONFLICT (id) DO UPDATE SET last_block_height = excluded.last_block_height`
+
+	deleteBlockEvents = `DELETE FROM events WHERE block_height = ?`
+	deleteBlockTrades = `DELETE FROM trades WHERE block_height = ?`
+
+	insertEvent = `INSERT INTO events (block_height, event_index, block_time, tx_hash, type, attributes)
+		VALUES (?, ?, ?, ?, ?, ?)`
+	insertTrade = `INSERT INTO trades (block_height, event_index, trade_index, block_time, tx_hash, market_id, is_spot,
+		subaccount_id, order_hash, is_buy, execution_type, is_liquidation, price, quantity, fee, payout, fee_recipient_address)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	tradeColumns = `block_height, event_index, trade_index, block_time, tx_hash, market_id, is_spot, subaccount_id,
+		order_hash, is_buy, execution_type, is_liquidation, price, quantity, fee, payout, fee_recipient_address`
+	eventColumns = `block_height, event_index, block_time, tx_hash, type, attributes`
+)
@@ -0,0 +1,83 @@
+package indexer
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/indexer/types"
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+var (
+	spotExecutionEventType       = proto.MessageName(&exchangetypes.EventBatchSpotExecution{})
+	derivativeExecutionEventType = proto.MessageName(&exchangetypes.EventBatchDerivativeExecution{})
+)
+
+// parseHash returns the hash of a hex market or subaccount ID
+func parseHash(id string) common.Hash {
+	return common.HexToHash(id)
+}
+
+// newTrades returns the trades of a spot or derivative execution event, or nil for any other event
+func newTrades(event abci.Event, blockHeight, blockTime int64, txHash string) []types.Trade {
+	if event.Type != spotExecutionEventType && event.Type != derivativeExecutionEventType {
+		return nil
+	}
+
+	typedEvent, err := sdk.ParseTypedEvent(event)
+	if err != nil {
+		return nil
+	}
+
+	switch e := typedEvent.(type) {
+	case *exchangetypes.EventBatchSpotExecution:
+		trades := make([]types.Trade, 0, len(e.Trades))
+		for _, trade := range e.Trades {
+			trades = append(trades, types.Trade{
+				BlockHeight:         blockHeight,
+				BlockTime:           blockTime,
+				TxHash:              txHash,
+				MarketId:            parseHash(e.MarketId).Hex(),
+				IsSpot:              true,
+				SubaccountId:        common.BytesToHash(trade.SubaccountId).Hex(),
+				OrderHash:           common.BytesToHash(trade.OrderHash).Hex(),
+				IsBuy:               e.IsBuy,
+				ExecutionType:       e.ExecutionType,
+				Price:               trade.Price,
+				Quantity:            trade.Quantity,
+				Fee:                 trade.Fee,
+				Payout:              sdk.ZeroDec(),
+				FeeRecipientAddress: sdk.AccAddress(trade.FeeRecipientAddress).String(),
+			})
+		}
+		return trades
+	case *exchangetypes.EventBatchDerivativeExecution:
+		trades := make([]types.Trade, 0, len(e.Trades))
+		for _, trade := range e.Trades {
+			if trade.PositionDelta == nil {
+				continue
+			}
+
+			trades = append(trades, types.Trade{
+				BlockHeight:         blockHeight,
+				BlockTime:           blockTime,
+				TxHash:              txHash,
+				MarketId:            parseHash(e.MarketId).Hex(),
+				SubaccountId:        common.BytesToHash(trade.SubaccountId).Hex(),
+				OrderHash:           common.BytesToHash(trade.OrderHash).Hex(),
+				IsBuy:               trade.PositionDelta.IsLong,
+				ExecutionType:       e.ExecutionType,
+				IsLiquidation:       e.IsLiquidation,
+				Price:               trade.PositionDelta.ExecutionPrice,
+				Quantity:            trade.PositionDelta.ExecutionQuantity,
+				Fee:                 trade.Fee,
+				Payout:              trade.Payout,
+				FeeRecipientAddress: sdk.AccAddress(trade.FeeRecipientAddress).String(),
+			})
+		}
+		return trades
+	}
+	return nil
+}
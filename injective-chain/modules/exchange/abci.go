@@ -35,15 +35,23 @@ func (h *BlockHandler) BeginBlocker(ctx sdk.Context) {
 	defer doneFn()
 
 	// swap the gas meter with a threadsafe version
+	h.k.ProcessPreLaunchPerpetualMarkPrices(ctx) // ensure this runs before ProcessHourlyFundings
 	h.k.ProcessHourlyFundings(ctx)
 	h.k.ProcessForceClosedSpotMarkets(ctx)
-	h.k.ProcessMarketsScheduledToSettle(ctx) // ensure this runs before ProcessMatureExpiryFutureMarkets
+	h.k.ProcessMarketsScheduledToSettle(ctx)  // ensure this runs before ProcessMatureExpiryFutureMarkets
+	h.k.ProcessExpiryFuturesMarketSeries(ctx) // ensure this runs before ProcessMatureExpiryFutureMarkets
 	h.k.ProcessMatureExpiryFutureMarkets(ctx)
+	h.k.ProcessCategoricalMarketsToSettle(ctx) // ensure this runs before ProcessBinaryOptionsMarketsToExpireAndSettle
 	h.k.ProcessBinaryOptionsMarketsToExpireAndSettle(ctx)
+	h.k.ProcessVanillaOptionsMarketsToExpireAndSettle(ctx)
+	h.k.ProcessIcebergOrderRefills(ctx) // ensure this runs after the market settlements and closures
 	h.k.ProcessTradingRewards(ctx)
 	h.k.ProcessFeeDiscountBuckets(ctx)
+	h.k.PruneTerminalOrders(ctx)
+	h.k.ProcessExpiredRFQRequests(ctx)
+	h.k.ProcessExpiredSignedOrders(ctx)
 
-	if ctx.BlockHeight()%100000 == 0 {
+	if ctx.BlockHeight()%types.TradeRecordPruneIntervalBlocks == 0 {
 		h.k.CleanupHistoricalTradeRecords(ctx)
 	}
 }
@@ -106,6 +114,9 @@ func (h *BlockHandler) EndBlocker(ctx sdk.Context) {
 		triggerMarketOrders(ctx, true)
 	}
 
+	// Place the due TWAP order slices as market orders, so they are matched together with the other market orders
+	h.k.ProcessTWAPOrders(ctx)
+
 	stakingInfo := h.k.InitialFetchAndUpdateActiveAccountFeeDiscountStakingInfo(ctx)
 	spotVwapData := keeper.NewSpotVwapInfo()
 
@@ -177,6 +188,9 @@ func (h *BlockHandler) EndBlocker(ctx sdk.Context) {
 	// Persist Spot market order execution data
 	tradingRewards := h.k.PersistSpotMarketOrderExecution(ctx, batchSpotExecutionData, spotVwapData)
 
+	// Execute each spot quote market order on its own after the other market orders, so it spends or receives at most its own quote amount
+	h.k.ExecuteSpotQuoteMarketOrders(ctx)
+
 	// Trigger Conditional Limit Orders (after market orders matching is done, so we won't hit the limitation of one market order per block)
 	for _, triggeredMarket := range triggeredMarketsAndOrders {
 		if triggeredMarket == nil {
@@ -303,6 +317,14 @@ func (h *BlockHandler) EndBlocker(ctx sdk.Context) {
 		return false
 	})
 
+	h.k.IterateVanillaOptionsMarketParamUpdates(ctx, func(p *types.VanillaOptionsMarketParamUpdateProposal) (stop bool) {
+		err := h.k.ExecuteVanillaOptionsMarketParamUpdateProposal(ctx, p)
+		if err != nil {
+			ctx.Logger().Error(err.Error())
+		}
+		return false
+	})
+
 	/** =========== Stage 9: Invalidate conditional RO orders if no locked margin left =========== */
 	h.k.IterateInvalidConditionalOrderFlags(ctx, func(marketID, subaccountID common.Hash, isBuy bool) (stop bool) {
 		h.k.InvalidateConditionalOrdersIfNoMarginLocked(ctx, marketID, subaccountID, false, &isBuy, marketCache)
@@ -312,7 +334,7 @@ func (h *BlockHandler) EndBlocker(ctx sdk.Context) {
 	/** =========== Stage 10: Emit Deposit, Position and Orderbook Update Events =========== */
 	h.k.EmitAllTransientDepositUpdates(ctx)
 	h.k.EmitAllTransientPositionUpdates(ctx)
-	h.k.IncrementSequenceAndEmitAllTransientOrderbookUpdates(ctx)
+	h.k.EmitAllTransientOrderbookUpdates(ctx)
 }
 
 func triggerMarketOrdersForMarket(ctx sdk.Context, k keeper.Keeper, triggeredMarket *types.TriggeredOrdersInMarket, useIndividualCacheCtx bool) {
@@ -50,4 +50,6 @@ const (
 	FlagSubscriptionBaseAmount  = "base-amount"
 	FlagSubscriptionMaxSlippage = "max-slippage"
 	FlagSubscriptionDeadline    = "deadline"
+	FlagScalarLowerBound        = "scalar-lower-bound"
+	FlagScalarUpperBound        = "scalar-upper-bound"
 )
@@ -61,6 +61,7 @@ func NewTxCmd() *cobra.Command {
 		FeeDiscountProposalTxCmd(),
 		BatchCommunityPoolSpendProposalTxCmd(),
 		NewAtomicMarketOrderFeeMultiplierScheduleProposalTxCmd(),
+		NewTradeRecordRetentionScheduleProposalTxCmd(),
 		// account
 		NewDepositTxCmd(),
 		NewWithdrawTxCmd(),
@@ -1015,6 +1016,16 @@ func NewInstantBinaryOptionsMarketLaunchTxCmd() *cobra.Command {
 				return err
 			}
 
+			scalarLowerBound, err := optionalDecimalFromFlag(cmd, FlagScalarLowerBound)
+			if err != nil {
+				return err
+			}
+
+			scalarUpperBound, err := optionalDecimalFromFlag(cmd, FlagScalarUpperBound)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgInstantBinaryOptionsMarketLaunch{
 				Sender:              clientCtx.GetFromAddress().String(),
 				Ticker:              ticker,
@@ -1030,6 +1041,8 @@ func NewInstantBinaryOptionsMarketLaunchTxCmd() *cobra.Command {
 				QuoteDenom:          quoteDenom,
 				MinPriceTickSize:    minPriceTickSize,
 				MinQuantityTickSize: minQuantityTickSize,
+				ScalarLowerBound:    scalarLowerBound,
+				ScalarUpperBound:    scalarUpperBound,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -1055,6 +1068,8 @@ func NewInstantBinaryOptionsMarketLaunchTxCmd() *cobra.Command {
 	cmd.Flags().String(FlagMaintenanceMarginRatio, "", "maintenance margin ratio")
 	cmd.Flags().String(FlagMinPriceTickSize, "", "min price tick size")
 	cmd.Flags().String(FlagMinQuantityTickSize, "", "min quantity tick size")
+	cmd.Flags().String(FlagScalarLowerBound, "", "oracle value at which a scalar market settles at 0, empty for binary markets")
+	cmd.Flags().String(FlagScalarUpperBound, "", "oracle value at which a scalar market settles at 1, empty for binary markets")
 	cliflags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -2810,6 +2825,88 @@ func NewAtomicMarketOrderFeeMultiplierScheduleProposalTxCmd() *cobra.Command {
 	return cmd
 }
 
+func NewTradeRecordRetentionScheduleProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-trade-record-retention [marketId:seconds] [flags]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to set how long the trade records of given markets are kept",
+		Long: `Submit a proposal to set how long the trade records of given markets are kept.
+
+		Example:
+		$ %s tx exchange propose-trade-record-retention 0xfd30930cb70d176c37d0c405cde055e551c5b1116b7049a88bcf821766b62d61:86400 0xfd30930cb70d176c37d0c405cde055e551c5b1116b7049a88bcf821766b62d62:604800  \
+			--title="Set Trade Record Retention" \
+			--description="Set Trade Record Retention" \
+			--from=genesis \
+			--keyring-backend=file \
+			--yes
+		`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			retentions := make([]*types.MarketTradeRecordRetention, 0)
+			for _, arg := range args {
+				split := strings.Split(arg, ":")
+				if len(split) != 2 {
+					return types.ErrInvalidArgument.Wrapf("%v does not match a pattern marketId:seconds", arg)
+				}
+				retentionSeconds, err := strconv.ParseInt(split[1], 10, 64)
+				if err != nil {
+					return err
+				}
+				retention := types.MarketTradeRecordRetention{MarketId: split[0], RetentionSeconds: retentionSeconds}
+				retentions = append(retentions, &retention)
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := &types.TradeRecordRetentionScheduleProposal{
+				Title:                       title,
+				Description:                 description,
+				MarketTradeRecordRetentions: retentions,
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	cliflags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func getSpotMarketIdFromTicker(ticker string, ctx grpc.ClientConn) (any, error) {
 	queryClient := types.NewQueryClient(ctx)
 	req := &types.QuerySpotMarketsRequest{
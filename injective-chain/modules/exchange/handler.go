@@ -133,9 +133,77 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.ReclaimLockedFunds(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgCreateTWAPOrder:
+			res, err := msgServer.CreateTWAPOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCancelTWAPOrder:
+			res, err := msgServer.CancelTWAPOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCreateLadderOrders:
+			res, err := msgServer.CreateLadderOrders(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCreateSpotQuoteMarketOrder:
+			res, err := msgServer.CreateSpotQuoteMarketOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgAdminUpdateBinaryOptionsMarket:
 			res, err := msgServer.AdminUpdateBinaryOptionsMarket(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCreateVanillaOptionsLimitOrder:
+			res, err := msgServer.CreateVanillaOptionsLimitOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCreateVanillaOptionsMarketOrder:
+			res, err := msgServer.CreateVanillaOptionsMarketOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCancelVanillaOptionsOrder:
+			res, err := msgServer.CancelVanillaOptionsOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgMintCategoricalOutcomeSet:
+			res, err := msgServer.MintCategoricalOutcomeSet(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRedeemCategoricalOutcomeSet:
+			res, err := msgServer.RedeemCategoricalOutcomeSet(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgAdminUpdateCategoricalMarket:
+			res, err := msgServer.AdminUpdateCategoricalMarket(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgUpdateExpiryFuturesAutoRoll:
+			res, err := msgServer.UpdateExpiryFuturesAutoRoll(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCreateMultiLegOrder:
+			res, err := msgServer.CreateMultiLegOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCreateRFQRequest:
+			res, err := msgServer.CreateRFQRequest(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCancelRFQRequest:
+			res, err := msgServer.CancelRFQRequest(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgAcceptQuote:
+			res, err := msgServer.AcceptQuote(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSubmitSignedOrders:
+			res, err := msgServer.SubmitSignedOrders(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCancelSignedOrders:
+			res, err := msgServer.CancelSignedOrders(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
 				fmt.Sprintf("Unrecognized exchange Msg type: %T", msg))
@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// GetAccountSummary aggregates the deposits and the positions of a subaccount, valuing the positions at the mark price
+func (k *Keeper) GetAccountSummary(ctx sdk.Context, subaccountID common.Hash) *types.QueryAccountSummaryResponse {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	denomSummaries := make(map[string]*types.DenomSummary)
+	getDenomSummary := func(denom string) *types.DenomSummary {
+		if _, ok := denomSummaries[denom]; !ok {
+			denomSummaries[denom] = &types.DenomSummary{
+				Denom:             denom,
+				TotalBalance:      sdk.ZeroDec(),
+				AvailableBalance:  sdk.ZeroDec(),
+				BalanceHold:       sdk.ZeroDec(),
+				PositionMargin:    sdk.ZeroDec(),
+				UnrealizedPnl:     sdk.ZeroDec(),
+				TotalNotional:     sdk.ZeroDec(),
+				TotalEquity:       sdk.ZeroDec(),
+				EffectiveLeverage: sdk.ZeroDec(),
+			}
+		}
+		return denomSummaries[denom]
+	}
+
+	for denom, deposit := range k.GetDeposits(ctx, subaccountID) {
+		summary := getDenomSummary(denom)
+		summary.TotalBalance = deposit.TotalBalance
+		summary.AvailableBalance = deposit.AvailableBalance
+		summary.BalanceHold = deposit.TotalBalance.Sub(deposit.AvailableBalance)
+		summary.TotalEquity = deposit.TotalBalance
+	}
+
+	positionSummaries := make([]*types.PositionSummary, 0)
+	for _, market := range k.GetAllDerivativeAndBinaryOptionsMarkets(ctx) {
+		position := k.GetPosition(ctx, market.MarketID(), subaccountID)
+		if position == nil || !position.Quantity.IsPositive() {
+			continue
+		}
+
+		positionSummary := k.getPositionSummary(ctx, market, position)
+		positionSummaries = append(positionSummaries, positionSummary)
+
+		summary := getDenomSummary(market.GetQuoteDenom())
+		summary.PositionMargin = summary.PositionMargin.Add(position.Margin).Add(positionSummary.PendingFunding)
+		summary.UnrealizedPnl = summary.UnrealizedPnl.Add(positionSummary.UnrealizedPnl)
+		summary.TotalNotional = summary.TotalNotional.Add(positionSummary.Notional)
+		summary.TotalEquity = summary.TotalEquity.Add(positionSummary.EffectiveMargin)
+	}
+
+	denoms := make([]string, 0, len(denomSummaries))
+	for denom := range denomSummaries {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	summaries := make([]*types.DenomSummary, 0, len(denoms))
+	for _, denom := range denoms {
+		summary := denomSummaries[denom]
+		if summary.TotalEquity.IsPositive() {
+			summary.EffectiveLeverage = summary.TotalNotional.Quo(summary.TotalEquity)
+		}
+		summaries = append(summaries, summary)
+	}
+
+	return &types.QueryAccountSummaryResponse{
+		Denoms:    summaries,
+		Positions: positionSummaries,
+	}
+}
+
+func (k *Keeper) getPositionSummary(ctx sdk.Context, market MarketI, position *types.Position) *types.PositionSummary {
+	marketID := market.MarketID()
+
+	var funding *types.PerpetualMarketFunding
+	if market.GetIsPerpetual() {
+		funding = k.GetPerpetualMarketFunding(ctx, marketID)
+	}
+
+	// the effective margin without any closing price is the funding adjusted margin
+	pendingFunding := position.GetEffectiveMargin(funding, sdk.Dec{}).Sub(position.Margin)
+
+	summary := &types.PositionSummary{
+		MarketId:          marketID.Hex(),
+		Ticker:            market.GetTicker(),
+		QuoteDenom:        market.GetQuoteDenom(),
+		Position:          position,
+		PendingFunding:    pendingFunding,
+		UnrealizedPnl:     pendingFunding,
+		EffectiveMargin:   position.Margin.Add(pendingFunding),
+		Notional:          sdk.ZeroDec(),
+		EffectiveLeverage: sdk.ZeroDec(),
+	}
+
+	if derivativeMarket, ok := market.(*types.DerivativeMarket); ok {
+		liquidationPrice := position.GetLiquidationPrice(derivativeMarket.MaintenanceMarginRatio, funding)
+		summary.LiquidationPrice = &liquidationPrice
+	}
+
+	markPrice := k.getMarkPrice(ctx, market)
+	if markPrice == nil {
+		return summary
+	}
+
+	summary.MarkPrice = markPrice
+	summary.UnrealizedPnl = summary.UnrealizedPnl.Add(position.GetPayoutFromPnl(*markPrice, position.Quantity))
+	summary.EffectiveMargin = position.GetEffectiveMargin(funding, *markPrice)
+	summary.Notional = position.Quantity.Mul(*markPrice)
+
+	if summary.EffectiveMargin.IsPositive() {
+		summary.EffectiveLeverage = summary.Notional.Quo(summary.EffectiveMargin)
+	}
+	return summary
+}
+
+// getMarkPrice returns the oracle price of a derivative or options market, or nil if it isn't available
+func (k *Keeper) getMarkPrice(ctx sdk.Context, market MarketI) *sdk.Dec {
+	switch m := market.(type) {
+	case *types.DerivativeMarket:
+		markPrice, err := k.GetDerivativeMarketMarkPrice(ctx, m)
+		if err != nil {
+			return nil
+		}
+		return markPrice
+	case *types.BinaryOptionsMarket:
+		oraclePrice := k.OracleKeeper.GetProviderPrice(ctx, m.OracleProvider, m.OracleSymbol)
+		if oraclePrice == nil {
+			return nil
+		}
+		// binary options prices are the mark price of the oracle value, scaled by the oracle scale factor of the market
+		markPrice := types.GetScaledPrice(m.GetMarkPrice(*oraclePrice), m.OracleScaleFactor)
+		return &markPrice
+	case *types.VanillaOptionsMarket:
+		underlyingPrice := k.OracleKeeper.GetPrice(ctx, m.OracleType, m.OracleBase, m.OracleQuote)
+		if underlyingPrice == nil || underlyingPrice.IsNil() {
+			return nil
+		}
+		// vanilla options are marked at their intrinsic value, as there's no option pricing oracle
+		markPrice := m.GetPayoff(*underlyingPrice)
+		return &markPrice
+	default:
+		return nil
+	}
+}
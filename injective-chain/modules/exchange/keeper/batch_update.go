@@ -63,7 +63,7 @@ func (k *Keeper) ExecuteBatchUpdateOrders(
 				continue
 			}
 
-			k.CancelAllSpotLimitOrders(ctx, market, subaccountIDForCancelAll, marketID)
+			k.CancelAllSpotLimitOrders(ctx, market, subaccountIDForCancelAll, marketID, types.OrderTerminationReason_UserCancellation)
 		}
 
 		for _, derivativeMarketIdToCancelAll := range derivativeMarketIdsToCancelAll {
@@ -80,7 +80,7 @@ func (k *Keeper) ExecuteBatchUpdateOrders(
 				continue
 			}
 
-			if err := k.CancelAllRestingDerivativeLimitOrdersForSubaccount(ctx, market, subaccountIDForCancelAll, true, true); err != nil {
+			if err := k.CancelAllRestingDerivativeLimitOrdersForSubaccount(ctx, market, subaccountIDForCancelAll, true, true, types.OrderTerminationReason_UserCancellation); err != nil {
 				k.Logger(ctx).Debug("failed to cancel all derivative limit orders", "marketID", marketID.Hex())
 			}
 
@@ -102,7 +102,7 @@ func (k *Keeper) ExecuteBatchUpdateOrders(
 				continue
 			}
 
-			if err := k.CancelAllRestingDerivativeLimitOrdersForSubaccount(ctx, market, subaccountIDForCancelAll, true, true); err != nil {
+			if err := k.CancelAllRestingDerivativeLimitOrdersForSubaccount(ctx, market, subaccountIDForCancelAll, true, true, types.OrderTerminationReason_UserCancellation); err != nil {
 				k.Logger(ctx).Debug("failed to cancel all derivative limit orders", "marketID", marketID.Hex())
 			}
 
@@ -125,7 +125,7 @@ func (k *Keeper) ExecuteBatchUpdateOrders(
 		subaccountID := types.MustGetSubaccountIDOrDeriveFromNonce(sender, spotOrderToCancel.SubaccountId)
 		orderHash := common.HexToHash(spotOrderToCancel.OrderHash)
 
-		err := k.cancelSpotLimitOrder(ctx, subaccountID, orderHash, market, marketID)
+		err := k.cancelSpotLimitOrder(ctx, subaccountID, orderHash, market, marketID, types.OrderTerminationReason_UserCancellation)
 		if err == nil {
 			spotCancelSuccesses[idx] = true
 		}
@@ -222,7 +222,7 @@ func (k *Keeper) ExecuteBatchUpdateOrders(
 		}
 
 		if _, ok := markPrices[marketID]; !ok {
-			price, err := k.GetDerivativeMarketPrice(ctx, market.OracleBase, market.OracleQuote, market.OracleScaleFactor, market.OracleType)
+			price, err := k.GetDerivativeMarketMarkPrice(ctx, market)
 			if err != nil {
 				k.Logger(ctx).Debug("failed to create derivative limit order for market with no mark price", "marketID", marketID.Hex())
 				metrics.ReportFuncError(k.svcTags)
@@ -20,6 +20,7 @@ func (k *Keeper) BinaryOptionsMarketLaunch(
 	expirationTimestamp, settlementTimestamp int64,
 	admin, quoteDenom string,
 	minPriceTickSize, minQuantityTickSize sdk.Dec,
+	scalarLowerBound, scalarUpperBound *sdk.Dec,
 ) (*types.BinaryOptionsMarket, error) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
@@ -80,6 +81,8 @@ func (k *Keeper) BinaryOptionsMarketLaunch(
 		MinPriceTickSize:    minPriceTickSize,
 		MinQuantityTickSize: minQuantityTickSize,
 		SettlementPrice:     nil,
+		ScalarLowerBound:    scalarLowerBound,
+		ScalarUpperBound:    scalarUpperBound,
 	}
 
 	k.SetBinaryOptionsMarket(ctx, market)
@@ -167,16 +170,9 @@ func (k *Keeper) GetAllBinaryOptionsMarketsToNaturallySettle(ctx sdk.Context) []
 		if market.SettlementPrice == nil || market.SettlementPrice.IsNil() {
 			oraclePrice := k.OracleKeeper.GetProviderPrice(ctx, market.OracleProvider, market.OracleSymbol)
 			if oraclePrice != nil {
-				switch {
-				case oraclePrice.LT(sdk.ZeroDec()):
-					zero := sdk.ZeroDec()
-					market.SettlementPrice = &zero
-				case oraclePrice.GT(sdk.OneDec()):
-					one := sdk.OneDec()
-					market.SettlementPrice = &one
-				default:
-					market.SettlementPrice = oraclePrice
-				}
+				// the oracle value is clamped to the [0, 1] band, after being interpolated between the bounds of scalar markets
+				settlementPrice := market.GetOutcomePrice(*oraclePrice)
+				market.SettlementPrice = &settlementPrice
 			} else {
 				// market will be settled with nil price which gets overwritten just before the settlement with -1
 				log.Infof("the binary options market was going to be naturally settled but has no settlement price? marketID=%s", marketID.Hex())
@@ -196,7 +192,7 @@ func (k *Keeper) ProcessBinaryOptionsMarketsToExpireAndSettle(ctx sdk.Context) {
 	marketsToExpire := k.GetAllBinaryOptionsMarketsToExpire(ctx)
 	for _, market := range marketsToExpire {
 		// no need to cancel transient orders since SettleMarket only runs in the BeginBlocker
-		k.CancelAllRestingDerivativeLimitOrders(ctx, market)
+		k.CancelAllRestingDerivativeLimitOrders(ctx, market, types.OrderTerminationReason_MarketExpiry)
 		market.Status = types.MarketStatus_Expired
 		k.SetBinaryOptionsMarket(ctx, market)
 	}
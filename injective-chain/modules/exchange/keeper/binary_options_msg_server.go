@@ -64,6 +64,8 @@ func (k BinaryOptionsMsgServer) InstantBinaryOptionsMarketLaunch(goCtx context.C
 		msg.QuoteDenom,
 		msg.MinPriceTickSize,
 		msg.MinQuantityTickSize,
+		msg.ScalarLowerBound,
+		msg.ScalarUpperBound,
 	)
 	if err != nil {
 		metrics.ReportFuncError(k.svcTags)
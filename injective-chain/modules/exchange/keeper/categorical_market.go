@@ -0,0 +1,329 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// CategoricalMarketLaunch launches the categorical market of the proposal along with the binary options market of each
+// of its outcomes.
+func (k *Keeper) CategoricalMarketLaunch(ctx sdk.Context, p *types.CategoricalMarketLaunchProposal) (*types.CategoricalMarket, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	marketID := types.NewCategoricalMarketID(p.Ticker, p.QuoteDenom, p.OracleSymbol, p.OracleProvider, p.OracleType)
+	if k.GetCategoricalMarket(ctx, marketID) != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrCategoricalMarketExists, "ticker %s quoteDenom %s", p.Ticker, p.QuoteDenom)
+	}
+
+	// Enforce that admin account exists, if specified
+	if p.Admin != "" {
+		adminAccount, _ := sdk.AccAddressFromBech32(p.Admin)
+		if !k.AccountKeeper.HasAccount(ctx, adminAccount) {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, sdkerrors.Wrapf(types.ErrAccountDoesntExist, "admin %s", p.Admin)
+		}
+	}
+
+	outcomeMarketIDs := make([]string, 0, len(p.Outcomes))
+	for _, outcome := range p.Outcomes {
+		// the outcome markets have no admin of their own, they are only updated through the categorical market
+		outcomeMarket, err := k.BinaryOptionsMarketLaunch(
+			ctx,
+			types.GetCategoricalOutcomeTicker(p.Ticker, outcome),
+			types.GetCategoricalOutcomeOracleSymbol(p.OracleSymbol, outcome),
+			p.OracleProvider,
+			p.OracleType,
+			p.OracleScaleFactor,
+			p.MakerFeeRate,
+			p.TakerFeeRate,
+			p.ExpirationTimestamp,
+			p.SettlementTimestamp,
+			"",
+			p.QuoteDenom,
+			p.MinPriceTickSize,
+			p.MinQuantityTickSize,
+			nil,
+			nil,
+		)
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, err
+		}
+		outcomeMarketIDs = append(outcomeMarketIDs, outcomeMarket.MarketId)
+	}
+
+	market := &types.CategoricalMarket{
+		Ticker:              p.Ticker,
+		OracleSymbol:        p.OracleSymbol,
+		OracleProvider:      p.OracleProvider,
+		OracleType:          p.OracleType,
+		OracleScaleFactor:   p.OracleScaleFactor,
+		ExpirationTimestamp: p.ExpirationTimestamp,
+		SettlementTimestamp: p.SettlementTimestamp,
+		Admin:               p.Admin,
+		QuoteDenom:          p.QuoteDenom,
+		MarketId:            marketID.Hex(),
+		Outcomes:            p.Outcomes,
+		OutcomeMarketIds:    outcomeMarketIDs,
+		Status:              types.MarketStatus_Active,
+	}
+
+	k.SetCategoricalMarket(ctx, market)
+	return market, nil
+}
+
+// ProcessCategoricalMarketsToSettle sets the settlement price of the outcome markets of every categorical market whose
+// settlement timestamp has been reached, from the index of the winning outcome reported by the oracle. It must run
+// before the binary options markets are settled in the same BeginBlocker.
+func (k *Keeper) ProcessCategoricalMarketsToSettle(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	blockTime := ctx.BlockTime().Unix()
+	marketsToSettle := make([]*types.CategoricalMarket, 0)
+
+	k.IterateCategoricalMarkets(ctx, func(market *types.CategoricalMarket) (stop bool) {
+		if market.Status == types.MarketStatus_Active && market.SettlementTimestamp <= blockTime {
+			marketsToSettle = append(marketsToSettle, market)
+		}
+		return false
+	})
+
+	for _, market := range marketsToSettle {
+		// the market is voided if the oracle doesn't report the index of one of its outcomes
+		winningOutcomeIndex := -1
+
+		oraclePrice := k.OracleKeeper.GetProviderPrice(ctx, market.OracleProvider, market.OracleSymbol)
+		if oraclePrice != nil && oraclePrice.IsInteger() && !oraclePrice.IsNegative() && oraclePrice.LT(sdk.NewDec(int64(len(market.Outcomes)))) {
+			winningOutcomeIndex = int(oraclePrice.TruncateInt64())
+		} else {
+			log.Infof("the categorical market has no valid winning outcome at settlement and is voided, marketID=%s", market.MarketId)
+		}
+
+		settlementPrices := market.GetOutcomeSettlementPrices(winningOutcomeIndex)
+		for idx, outcomeMarketID := range market.OutcomeMarketIds {
+			outcomeMarket := k.GetBinaryOptionsMarketByID(ctx, common.HexToHash(outcomeMarketID))
+			if outcomeMarket == nil || outcomeMarket.Status == types.MarketStatus_Demolished {
+				continue
+			}
+
+			// the outcome market is settled at this price by the binary options settlement of the same block
+			settlementPrice := settlementPrices[idx]
+			outcomeMarket.SettlementPrice = &settlementPrice
+			k.SetBinaryOptionsMarket(ctx, outcomeMarket)
+		}
+
+		market.Status = types.MarketStatus_Demolished
+		if winningOutcomeIndex >= 0 {
+			market.WinningOutcome = market.Outcomes[winningOutcomeIndex]
+		}
+		k.SetCategoricalMarket(ctx, market)
+	}
+}
+
+// mintCategoricalOutcomeSets charges the subaccount par for quantity complete outcome sets of the market and opens a long
+// position of quantity contracts in every outcome market, with the par split evenly between the position margins.
+func (k *Keeper) mintCategoricalOutcomeSets(ctx sdk.Context, market *types.CategoricalMarket, subaccountID common.Hash, quantity sdk.Dec) error {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	if market.Status != types.MarketStatus_Active {
+		metrics.ReportFuncError(k.svcTags)
+		return sdkerrors.Wrapf(types.ErrInvalidMarketStatus, "categorical market %s is not active", market.MarketId)
+	}
+
+	outcomeMarkets := make([]*types.BinaryOptionsMarket, 0, len(market.OutcomeMarketIds))
+	for _, outcomeMarketID := range market.OutcomeMarketIds {
+		outcomeMarket := k.GetBinaryOptionsMarket(ctx, common.HexToHash(outcomeMarketID), true)
+		if outcomeMarket == nil {
+			metrics.ReportFuncError(k.svcTags)
+			return sdkerrors.Wrapf(types.ErrBinaryOptionsMarketNotFound, "active outcome market %s not found", outcomeMarketID)
+		}
+
+		if types.BreachesMinimumTickSize(quantity, outcomeMarket.MinQuantityTickSize) {
+			metrics.ReportFuncError(k.svcTags)
+			return sdkerrors.Wrapf(types.ErrInvalidQuantity, "quantity %s must be a multiple of the minimum quantity tick size %s", quantity.String(), outcomeMarket.MinQuantityTickSize.String())
+		}
+
+		// minting into a short position would close it at an arbitrary price, so it has to be closed on the orderbook first
+		if position := k.GetPosition(ctx, outcomeMarket.MarketID(), subaccountID); position != nil && position.Quantity.IsPositive() && !position.IsLong {
+			metrics.ReportFuncError(k.svcTags)
+			return sdkerrors.Wrapf(types.ErrInvalidReduceOnlyPositionDirection, "subaccount holds a short position in outcome market %s", outcomeMarketID)
+		}
+
+		outcomeMarkets = append(outcomeMarkets, outcomeMarket)
+	}
+
+	par := outcomeMarkets[0].GetMaxPrice().Mul(quantity)
+	chargeAmount, err := k.DecrementDepositOrChargeFromBank(ctx, subaccountID, market.QuoteDenom, par)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return err
+	}
+
+	outcomeMargin := chargeAmount.QuoInt64(int64(len(outcomeMarkets)))
+	remainingMargin := chargeAmount
+
+	for idx, outcomeMarket := range outcomeMarkets {
+		margin := outcomeMargin
+		if idx == len(outcomeMarkets)-1 {
+			// the last outcome takes the rounding remainder, so that the margins add up to the charged amount
+			margin = remainingMargin
+		}
+		remainingMargin = remainingMargin.Sub(margin)
+
+		marketID := outcomeMarket.MarketID()
+		position := k.GetPosition(ctx, marketID, subaccountID)
+		if position == nil {
+			position = types.NewPosition(true, sdk.Dec{})
+		}
+
+		position.ApplyPositionDelta(&types.PositionDelta{
+			IsLong:            true,
+			ExecutionQuantity: quantity,
+			ExecutionMargin:   margin,
+			ExecutionPrice:    margin.Quo(quantity),
+		}, sdk.ZeroDec())
+		k.SetPosition(ctx, marketID, subaccountID, position)
+	}
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventCategoricalOutcomeSets{
+		MarketId:     market.MarketId,
+		SubaccountId: subaccountID.Hex(),
+		Quantity:     quantity,
+		IsMint:       true,
+	})
+	return nil
+}
+
+// redeemCategoricalOutcomeSets reduces the long position of the subaccount in every outcome market of the market by
+// quantity contracts and pays out par for the redeemed complete outcome sets.
+func (k *Keeper) redeemCategoricalOutcomeSets(ctx sdk.Context, market *types.CategoricalMarket, subaccountID common.Hash, quantity sdk.Dec) error {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	if market.Status == types.MarketStatus_Demolished {
+		metrics.ReportFuncError(k.svcTags)
+		return sdkerrors.Wrapf(types.ErrInvalidMarketStatus, "categorical market %s is already settled", market.MarketId)
+	}
+
+	outcomeMarkets := make([]*types.BinaryOptionsMarket, 0, len(market.OutcomeMarketIds))
+	positions := make([]*types.Position, 0, len(market.OutcomeMarketIds))
+
+	for _, outcomeMarketID := range market.OutcomeMarketIds {
+		outcomeMarket := k.GetBinaryOptionsMarketByID(ctx, common.HexToHash(outcomeMarketID))
+		if outcomeMarket == nil || outcomeMarket.Status == types.MarketStatus_Demolished {
+			metrics.ReportFuncError(k.svcTags)
+			return sdkerrors.Wrapf(types.ErrBinaryOptionsMarketNotFound, "outcome market %s not found or demolished", outcomeMarketID)
+		}
+
+		position := k.GetPosition(ctx, outcomeMarket.MarketID(), subaccountID)
+		if position == nil || !position.IsLong {
+			metrics.ReportFuncError(k.svcTags)
+			return sdkerrors.Wrapf(types.ErrPositionNotFound, "no long position in outcome market %s", outcomeMarketID)
+		}
+
+		if position.Quantity.LT(quantity) {
+			metrics.ReportFuncError(k.svcTags)
+			return sdkerrors.Wrapf(types.ErrInsufficientPositionQuantity, "position quantity %s in outcome market %s is less than %s", position.Quantity.String(), outcomeMarketID, quantity.String())
+		}
+
+		outcomeMarkets = append(outcomeMarkets, outcomeMarket)
+		positions = append(positions, position)
+	}
+
+	for idx, outcomeMarket := range outcomeMarkets {
+		position := positions[idx]
+		marketID := outcomeMarket.MarketID()
+
+		newPositionQuantity := position.Quantity.Sub(quantity)
+		position.Margin = position.Margin.Mul(newPositionQuantity).Quo(position.Quantity)
+		position.Quantity = newPositionQuantity
+		k.SetPosition(ctx, marketID, subaccountID, position)
+
+		k.checkAndResolveReduceOnlyConflicts(ctx, marketID, subaccountID, position, false)
+	}
+
+	par := outcomeMarkets[0].GetMaxPrice().Mul(quantity)
+	k.IncrementDepositOrSendToBank(ctx, subaccountID, market.QuoteDenom, par)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventCategoricalOutcomeSets{
+		MarketId:     market.MarketId,
+		SubaccountId: subaccountID.Hex(),
+		Quantity:     quantity,
+		IsMint:       false,
+	})
+	return nil
+}
+
+// GetCategoricalMarket fetches the categorical market from the store by marketID.
+func (k *Keeper) GetCategoricalMarket(ctx sdk.Context, marketID common.Hash) *types.CategoricalMarket {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	marketStore := prefix.NewStore(store, types.CategoricalMarketPrefix)
+
+	bz := marketStore.Get(marketID.Bytes())
+	if bz == nil {
+		return nil
+	}
+
+	var market types.CategoricalMarket
+	k.cdc.MustUnmarshal(bz, &market)
+	return &market
+}
+
+// SetCategoricalMarket saves the categorical market in the store.
+func (k *Keeper) SetCategoricalMarket(ctx sdk.Context, market *types.CategoricalMarket) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	marketStore := prefix.NewStore(store, types.CategoricalMarketPrefix)
+
+	bz := k.cdc.MustMarshal(market)
+	marketStore.Set(market.MarketID().Bytes(), bz)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventCategoricalMarketUpdate{
+		Market: *market,
+	})
+}
+
+// GetAllCategoricalMarkets returns all categorical markets.
+func (k *Keeper) GetAllCategoricalMarkets(ctx sdk.Context) []*types.CategoricalMarket {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	markets := make([]*types.CategoricalMarket, 0)
+	k.IterateCategoricalMarkets(ctx, func(market *types.CategoricalMarket) (stop bool) {
+		markets = append(markets, market)
+		return false
+	})
+
+	return markets
+}
+
+// IterateCategoricalMarkets iterates over the categorical markets calling process on each market.
+func (k *Keeper) IterateCategoricalMarkets(ctx sdk.Context, process func(market *types.CategoricalMarket) (stop bool)) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	marketStore := prefix.NewStore(store, types.CategoricalMarketPrefix)
+
+	iterator := marketStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var market types.CategoricalMarket
+		k.cdc.MustUnmarshal(iterator.Value(), &market)
+
+		if process(&market) {
+			return
+		}
+	}
+}
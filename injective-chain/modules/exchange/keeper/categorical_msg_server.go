@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+type CategoricalMsgServer struct {
+	Keeper
+	svcTags metrics.Tags
+}
+
+// NewCategoricalMsgServerImpl returns an implementation of the exchange MsgServer interface for the provided Keeper for categorical market functions.
+func NewCategoricalMsgServerImpl(keeper Keeper) CategoricalMsgServer {
+	return CategoricalMsgServer{
+		Keeper: keeper,
+		svcTags: metrics.Tags{
+			"svc": "categorical_msg_h",
+		},
+	}
+}
+
+func (k CategoricalMsgServer) MintCategoricalOutcomeSet(goCtx context.Context, msg *types.MsgMintCategoricalOutcomeSet) (*types.MsgMintCategoricalOutcomeSetResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var (
+		sender       = sdk.MustAccAddressFromBech32(msg.Sender)
+		subaccountID = types.MustGetSubaccountIDOrDeriveFromNonce(sender, msg.SubaccountId)
+		marketID     = common.HexToHash(msg.MarketId)
+	)
+
+	market := k.GetCategoricalMarket(ctx, marketID)
+	if market == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrCategoricalMarketNotFound, "marketID %s", msg.MarketId)
+	}
+
+	if err := k.mintCategoricalOutcomeSets(ctx, market, subaccountID, msg.Quantity); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintCategoricalOutcomeSetResponse{}, nil
+}
+
+func (k CategoricalMsgServer) RedeemCategoricalOutcomeSet(goCtx context.Context, msg *types.MsgRedeemCategoricalOutcomeSet) (*types.MsgRedeemCategoricalOutcomeSetResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var (
+		sender       = sdk.MustAccAddressFromBech32(msg.Sender)
+		subaccountID = types.MustGetSubaccountIDOrDeriveFromNonce(sender, msg.SubaccountId)
+		marketID     = common.HexToHash(msg.MarketId)
+	)
+
+	market := k.GetCategoricalMarket(ctx, marketID)
+	if market == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrCategoricalMarketNotFound, "marketID %s", msg.MarketId)
+	}
+
+	if err := k.redeemCategoricalOutcomeSets(ctx, market, subaccountID, msg.Quantity); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRedeemCategoricalOutcomeSetResponse{}, nil
+}
+
+func (k CategoricalMsgServer) AdminUpdateCategoricalMarket(goCtx context.Context, msg *types.MsgAdminUpdateCategoricalMarket) (*types.MsgAdminUpdateCategoricalMarketResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	market := k.GetCategoricalMarket(ctx, common.HexToHash(msg.MarketId))
+	if market == nil {
+		k.Logger(ctx).Error("categorical market doesn't exist", "marketID", msg.MarketId)
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrCategoricalMarketNotFound, "marketID %s", msg.MarketId)
+	}
+
+	if market.Admin == "" || market.Admin != msg.Sender {
+		k.Logger(ctx).Error("message sender is not an admin of categorical market", "sender", msg.Sender, "admin", market.Admin)
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrSenderIsNotAnAdmin, "sender %s, admin %s", msg.Sender, market.Admin)
+	}
+
+	if market.Status == types.MarketStatus_Demolished {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMarketStatus, "can't update market that was demolished already")
+	}
+
+	expTimestamp, settlementTimestamp := market.ExpirationTimestamp, market.SettlementTimestamp
+
+	if msg.ExpirationTimestamp > 0 {
+		if msg.ExpirationTimestamp <= ctx.BlockTime().Unix() {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, sdkerrors.Wrapf(types.ErrInvalidExpiry, "expiration timestamp %d is in the past", msg.ExpirationTimestamp)
+		}
+		if market.ExpirationTimestamp <= ctx.BlockTime().Unix() {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, sdkerrors.Wrap(types.ErrInvalidExpiry, "cannot change expiration time of an expired market")
+		}
+		expTimestamp = msg.ExpirationTimestamp
+	}
+
+	if msg.SettlementTimestamp > 0 {
+		if msg.SettlementTimestamp <= ctx.BlockTime().Unix() {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, sdkerrors.Wrapf(types.ErrInvalidSettlement, "SettlementTimestamp %d should be in future", msg.SettlementTimestamp)
+		}
+		if msg.SettlementTimestamp <= expTimestamp {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, sdkerrors.Wrap(types.ErrInvalidSettlement, "settlement time must be after expiration time")
+		}
+		settlementTimestamp = msg.SettlementTimestamp
+	}
+
+	if expTimestamp >= settlementTimestamp {
+		return nil, sdkerrors.Wrap(types.ErrInvalidExpiry, "expiration timestamp should be prior to settlement timestamp")
+	}
+
+	var settlementPrices []sdk.Dec
+	if msg.Status == types.MarketStatus_Demolished {
+		// an empty winning outcome voids the market
+		winningOutcomeIndex := -1
+		if msg.WinningOutcome != "" {
+			winningOutcomeIndex = market.GetOutcomeIndex(msg.WinningOutcome)
+			if winningOutcomeIndex < 0 {
+				metrics.ReportFuncError(k.svcTags)
+				return nil, sdkerrors.Wrapf(types.ErrInvalidCategoricalOutcomes, "market has no outcome %s", msg.WinningOutcome)
+			}
+		}
+		settlementPrices = market.GetOutcomeSettlementPrices(winningOutcomeIndex)
+
+		market.Status = types.MarketStatus_Demolished
+		market.WinningOutcome = msg.WinningOutcome
+	}
+
+	// every outcome market goes through the same update as if its admin had sent a MsgAdminUpdateBinaryOptionsMarket
+	for idx, outcomeMarketID := range market.OutcomeMarketIds {
+		newParams := types.BinaryOptionsMarketParamUpdateProposal{
+			MarketId:            outcomeMarketID,
+			Status:              msg.Status,
+			ExpirationTimestamp: msg.ExpirationTimestamp,
+			SettlementTimestamp: msg.SettlementTimestamp,
+		}
+		if settlementPrices != nil {
+			newParams.SettlementPrice = &settlementPrices[idx]
+		}
+
+		// schedule market param change in transient store
+		if err := k.ScheduleBinaryOptionsMarketParamUpdate(ctx, &newParams); err != nil {
+			return nil, err
+		}
+	}
+
+	market.ExpirationTimestamp = expTimestamp
+	market.SettlementTimestamp = settlementTimestamp
+	k.SetCategoricalMarket(ctx, market)
+
+	return &types.MsgAdminUpdateCategoricalMarketResponse{}, nil
+}
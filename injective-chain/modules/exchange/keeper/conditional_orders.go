@@ -665,7 +665,7 @@ func (k *Keeper) GetAllTriggeredConditionalOrders(ctx sdk.Context) ([]*types.Tri
 			defer wg.Done()
 			marketID := market.MarketID()
 
-			markPrice, _ := k.GetDerivativeMarketPrice(ctx, market.OracleBase, market.OracleQuote, market.OracleScaleFactor, market.OracleType)
+			markPrice, _ := k.GetDerivativeMarketMarkPrice(ctx, market)
 			if markPrice == nil || markPrice.IsNil() {
 				return
 			}
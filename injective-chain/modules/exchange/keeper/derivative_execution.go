@@ -530,6 +530,7 @@ func ApplyDeltasAndGetDerivativeOrderBatchEvent(
 				Fee:                 realizedTradeFee,
 				OrderHash:           expansion.OrderHash.Bytes(),
 				FeeRecipientAddress: expansion.FeeRecipient.Bytes(),
+				Pnl:                 expansion.RealizedPnl,
 			}
 			trades = append(trades, tradeLog)
 		}
@@ -179,6 +179,16 @@ func (k *Keeper) PersistDerivativeMatchingExecution(
 			k.SetPosition(ctx, marketID, subaccountID, execution.Positions[idx])
 		}
 
+		k.RecordDerivativeLimitOrderFills(ctx, execution.RestingLimitBuyOrderExecutionEvent)
+		k.RecordDerivativeLimitOrderFills(ctx, execution.RestingLimitSellOrderExecutionEvent)
+		k.RecordDerivativeLimitOrderFills(ctx, execution.TransientLimitBuyOrderExecutionEvent)
+		k.RecordDerivativeLimitOrderFills(ctx, execution.TransientLimitSellOrderExecutionEvent)
+
+		k.RecordDerivativeTradesPnl(ctx, execution.RestingLimitBuyOrderExecutionEvent)
+		k.RecordDerivativeTradesPnl(ctx, execution.RestingLimitSellOrderExecutionEvent)
+		k.RecordDerivativeTradesPnl(ctx, execution.TransientLimitBuyOrderExecutionEvent)
+		k.RecordDerivativeTradesPnl(ctx, execution.TransientLimitSellOrderExecutionEvent)
+
 		k.UpdateDerivativeLimitOrdersFromFilledDeltas(ctx, marketID, true, execution.RestingLimitOrderFilledDeltas)
 		k.UpdateDerivativeLimitOrdersFromFilledDeltas(ctx, marketID, false, execution.TransientLimitOrderFilledDeltas)
 		k.UpdateDerivativeLimitOrdersFromFilledDeltas(ctx, marketID, true, execution.RestingLimitOrderCancelledDeltas)
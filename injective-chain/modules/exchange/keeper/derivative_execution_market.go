@@ -65,7 +65,7 @@ func (k *Keeper) PersistSingleDerivativeMarketOrderExecution(
 	}
 
 	marketID := execution.Market.MarketID()
-	hasValidMarkPrice := execution.Market.GetMarketType() == types.MarketType_BinaryOption || !execution.MarkPrice.IsNil() && execution.MarkPrice.IsPositive()
+	hasValidMarkPrice := execution.Market.GetMarketType().IsFullyCollateralized() || !execution.MarkPrice.IsNil() && execution.MarkPrice.IsPositive()
 
 	if execution.VwapData != nil && !execution.VwapData.Price.IsZero() && !execution.VwapData.Quantity.IsZero() && hasValidMarkPrice {
 		derivativeVwapData.ApplyVwap(marketID, &execution.MarkPrice, execution.VwapData, execution.Market.GetMarketType())
@@ -80,6 +80,14 @@ func (k *Keeper) PersistSingleDerivativeMarketOrderExecution(
 		}
 	}
 
+	k.RecordDerivativeLimitOrderFills(ctx, execution.RestingLimitBuyOrderExecutionEvent)
+	k.RecordDerivativeLimitOrderFills(ctx, execution.RestingLimitSellOrderExecutionEvent)
+
+	k.RecordDerivativeTradesPnl(ctx, execution.MarketBuyOrderExecutionEvent)
+	k.RecordDerivativeTradesPnl(ctx, execution.MarketSellOrderExecutionEvent)
+	k.RecordDerivativeTradesPnl(ctx, execution.RestingLimitBuyOrderExecutionEvent)
+	k.RecordDerivativeTradesPnl(ctx, execution.RestingLimitSellOrderExecutionEvent)
+
 	k.UpdateDerivativeLimitOrdersFromFilledDeltas(ctx, marketID, true, execution.RestingLimitOrderFilledDeltas)
 	k.UpdateDerivativeLimitOrdersFromFilledDeltas(ctx, marketID, true, execution.RestingLimitOrderCancelledDeltas)
 
@@ -92,12 +100,16 @@ func (k *Keeper) PersistSingleDerivativeMarketOrderExecution(
 	}
 
 	if execution.MarketBuyOrderExecutionEvent != nil {
+		k.RecordTWAPDerivativeFills(ctx, execution.MarketBuyOrderExecutionEvent.Trades)
+
 		// nolint:errcheck //ignored on purpose
 		ctx.EventManager().EmitTypedEvent(execution.MarketBuyOrderExecutionEvent)
 		// nolint:errcheck //ignored on purpose
 		ctx.EventManager().EmitTypedEvent(execution.RestingLimitSellOrderExecutionEvent)
 	}
 	if execution.MarketSellOrderExecutionEvent != nil {
+		k.RecordTWAPDerivativeFills(ctx, execution.MarketSellOrderExecutionEvent.Trades)
+
 		// nolint:errcheck //ignored on purpose
 		ctx.EventManager().EmitTypedEvent(execution.MarketSellOrderExecutionEvent)
 		// nolint:errcheck //ignored on purpose
@@ -11,6 +11,7 @@ type DerivativeOrderStateExpansion struct {
 	SubaccountID  common.Hash
 	PositionDelta *types.PositionDelta
 	Payout        sdk.Dec
+	RealizedPnl   sdk.Dec
 
 	TotalBalanceDelta     sdk.Dec
 	AvailableBalanceDelta sdk.Dec
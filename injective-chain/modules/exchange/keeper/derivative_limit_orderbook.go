@@ -270,7 +270,7 @@ func (b *DerivativeLimitOrderbook) advanceNewOrder(ctx sdk.Context) {
 		}
 	}
 
-	if currOrder.IsVanilla() && b.market.GetMarketType() != types.MarketType_BinaryOption {
+	if currOrder.IsVanilla() && !b.market.GetMarketType().IsFullyCollateralized() {
 		err := currOrder.CheckInitialMarginRequirementMarkPriceThreshold(b.market.GetInitialMarginRatio(), b.markPrice)
 
 		if err != nil {
@@ -114,7 +114,7 @@ func (k *Keeper) cancelAllOrdersFromTraderInCurrentMarket(
 ) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
-	if err := k.CancelAllRestingDerivativeLimitOrdersForSubaccount(ctx, market, subaccountID, false, true); err != nil {
+	if err := k.CancelAllRestingDerivativeLimitOrdersForSubaccount(ctx, market, subaccountID, false, true, types.OrderTerminationReason_PositionLiquidation); err != nil {
 		k.Logger(ctx).Error("CancelAllRestingDerivativeLimitOrdersForSubaccount fail:", err)
 	}
 	k.CancelAllTransientDerivativeLimitOrdersBySubaccountID(ctx, market, subaccountID)
@@ -224,7 +224,7 @@ func (k DerivativesMsgServer) LiquidatePosition(goCtx context.Context, msg *type
 
 	// Step 1a: Cancel all reduce-only limit orders created by the position holder in the given market
 	k.CancelAllTransientDerivativeLimitOrdersBySubaccountID(cacheCtx, market, positionSubaccountID)
-	if err := k.CancelAllRestingDerivativeLimitOrdersForSubaccount(cacheCtx, market, positionSubaccountID, true, true); err != nil {
+	if err := k.CancelAllRestingDerivativeLimitOrdersForSubaccount(cacheCtx, market, positionSubaccountID, true, true, types.OrderTerminationReason_PositionLiquidation); err != nil {
 		metrics.ReportFuncError(k.svcTags)
 		return nil, err
 	}
@@ -259,6 +259,11 @@ func (k DerivativesMsgServer) LiquidatePosition(goCtx context.Context, msg *type
 		msg.Order.OrderInfo.SubaccountId = liquidatorSubaccountID.Hex()
 		metadata := k.GetSubaccountOrderbookMetadata(cacheCtx, marketID, liquidationMarketOrder.SubaccountID(), liquidationMarketOrder.IsBuy())
 
+		if err := msg.Order.CheckMarginTickSize(market.GetMinQuantityTickSize()); err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, err
+		}
+
 		isMaker := true
 		liquidatorOrderHash, err = k.ensureValidDerivativeOrder(cacheCtx, msg.Order, market, metadata, markPrice, false, nil, isMaker)
 		if err != nil {
@@ -283,7 +288,7 @@ func (k DerivativesMsgServer) LiquidatePosition(goCtx context.Context, msg *type
 		isBuy := msg.Order.IsBuy()
 		orderAfterLiquidation := k.GetDerivativeLimitOrderBySubaccountIDAndHash(cacheCtx, marketID, &isBuy, msg.Order.SubaccountID(), liquidatorOrderHash)
 		if orderAfterLiquidation != nil && orderAfterLiquidation.Fillable.IsPositive() {
-			if err := k.CancelRestingDerivativeLimitOrder(cacheCtx, market, orderAfterLiquidation.SubaccountID(), &isBuy, liquidatorOrderHash, true, true); err != nil {
+			if err := k.CancelRestingDerivativeLimitOrder(cacheCtx, market, orderAfterLiquidation.SubaccountID(), &isBuy, liquidatorOrderHash, true, true, types.OrderTerminationReason_PositionLiquidation); err != nil {
 				k.Logger(ctx).Info("CancelRestingDerivativeLimitOrder failed during LiquidatePosition of subaccount", "subaccountID", msg.SubaccountId, "order", msg.Order.String(), "err", err)
 			}
 		}
@@ -369,7 +374,7 @@ func (k DerivativesMsgServer) pauseMarketAndScheduleForSettlement(
 ) error {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
-	settlementPrice, err := k.GetDerivativeMarketPrice(ctx, market.OracleBase, market.OracleQuote, market.OracleScaleFactor, market.OracleType)
+	settlementPrice, err := k.GetDerivativeMarketMarkPrice(ctx, market)
 	if err != nil || settlementPrice.IsZero() || settlementPrice.IsNegative() {
 		metrics.ReportFuncError(k.svcTags)
 		return err
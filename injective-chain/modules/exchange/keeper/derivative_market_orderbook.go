@@ -135,7 +135,7 @@ func (b *DerivativeMarketOrderbook) Peek(ctx sdk.Context) *types.PriceLevel {
 	}
 
 	// validate initial margin for perpetual and expiry futures markets
-	if order.IsVanilla() && b.market.GetMarketType() != types.MarketType_BinaryOption {
+	if order.IsVanilla() && !b.market.GetMarketType().IsFullyCollateralized() {
 		err := order.CheckInitialMarginRequirementMarkPriceThreshold(b.market.GetInitialMarginRatio(), b.markPrice)
 
 		if err != nil {
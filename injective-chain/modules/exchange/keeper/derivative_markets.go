@@ -45,6 +45,16 @@ func (k *Keeper) GetDerivativeMarketPrice(ctx sdk.Context, oracleBase, oracleQuo
 	return &scaledPrice, nil
 }
 
+// GetDerivativeMarketMarkPrice fetches the mark price of the Derivative Market, which is the oracle price or the trade
+// TWAP for pre-launch perpetual markets.
+func (k *Keeper) GetDerivativeMarketMarkPrice(ctx sdk.Context, market *types.DerivativeMarket) (*sdk.Dec, error) {
+	if market.IsPreLaunch() {
+		return k.GetPreLaunchPerpetualMarkPrice(ctx, market.MarketID())
+	}
+
+	return k.GetDerivativeMarketPrice(ctx, market.OracleBase, market.OracleQuote, market.OracleScaleFactor, market.OracleType)
+}
+
 // GetDerivativeMarketCumulativePrice fetches the Derivative Market's (unscaled) cumulative price
 func (k *Keeper) GetDerivativeMarketCumulativePrice(ctx sdk.Context, oracleBase, oracleQuote string, oracleType oracletypes.OracleType) (*sdk.Dec, error) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
@@ -88,7 +98,7 @@ func (k *Keeper) GetDerivativeMarketWithMarkPrice(ctx sdk.Context, marketID comm
 		return nil, sdk.Dec{}
 	}
 
-	price, err := k.GetDerivativeMarketPrice(ctx, market.OracleBase, market.OracleQuote, market.OracleScaleFactor, market.OracleType)
+	price, err := k.GetDerivativeMarketMarkPrice(ctx, market)
 	if err != nil {
 		metrics.ReportFuncError(k.svcTags)
 		return nil, sdk.Dec{}
@@ -266,18 +276,7 @@ func (k *Keeper) GetAllFullDerivativeMarkets(ctx sdk.Context) []*types.FullDeriv
 
 	fullMarkets := make([]*types.FullDerivativeMarket, 0)
 	appendMarket := func(m *types.DerivativeMarket) (stop bool) {
-		price, err := k.GetDerivativeMarketPrice(ctx, m.OracleBase, m.OracleQuote, m.OracleScaleFactor, m.OracleType)
-		if err != nil {
-			price = &sdk.Dec{}
-		}
-
-		fullMarket := &types.FullDerivativeMarket{
-			Market:    m,
-			MarkPrice: *price,
-		}
-		k.populateDerivativeMarketInfo(ctx, m.MarketID(), m.IsPerpetual, fullMarket)
-
-		fullMarkets = append(fullMarkets, fullMarket)
+		fullMarkets = append(fullMarkets, k.getFullDerivativeMarket(ctx, m))
 		return false
 	}
 
@@ -285,6 +284,20 @@ func (k *Keeper) GetAllFullDerivativeMarkets(ctx sdk.Context) []*types.FullDeriv
 	return fullMarkets
 }
 
+func (k *Keeper) getFullDerivativeMarket(ctx sdk.Context, m *types.DerivativeMarket) *types.FullDerivativeMarket {
+	price, err := k.GetDerivativeMarketMarkPrice(ctx, m)
+	if err != nil {
+		price = &sdk.Dec{}
+	}
+
+	fullMarket := &types.FullDerivativeMarket{
+		Market:    m,
+		MarkPrice: *price,
+	}
+	k.populateDerivativeMarketInfo(ctx, m.MarketID(), m.IsPerpetual, fullMarket)
+	return fullMarket
+}
+
 // GetAllDerivativeMarkets returns all derivative markets.
 func (k *Keeper) GetAllDerivativeMarkets(ctx sdk.Context) []*types.DerivativeMarket {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
@@ -451,6 +464,7 @@ func (k *Keeper) handleDerivativeFeeIncrease(ctx sdk.Context, orderbook []*types
 			common.BytesToHash(order.OrderHash),
 			true,
 			true,
+			types.OrderTerminationReason_MarketUpdateCancellation,
 		); err != nil {
 			k.Logger(ctx).Error("CancelRestingDerivativeLimitOrder failed during handleDerivativeFeeIncrease", "orderHash", common.BytesToHash(order.OrderHash).Hex(), "err", err.Error())
 		}
@@ -522,9 +536,11 @@ func (k *Keeper) ExecuteDerivativeMarketParamUpdateProposal(ctx sdk.Context, p *
 
 	// cancel resting orders in the market when it shuts down
 	switch p.Status {
-	case types.MarketStatus_Expired,
-		types.MarketStatus_Demolished:
-		k.CancelAllRestingDerivativeLimitOrders(ctx, prevMarket)
+	case types.MarketStatus_Expired:
+		k.CancelAllRestingDerivativeLimitOrders(ctx, prevMarket, types.OrderTerminationReason_MarketExpiry)
+		k.CancelAllConditionalDerivativeOrders(ctx, prevMarket)
+	case types.MarketStatus_Demolished:
+		k.CancelAllRestingDerivativeLimitOrders(ctx, prevMarket, types.OrderTerminationReason_MarketUpdateCancellation)
 		k.CancelAllConditionalDerivativeOrders(ctx, prevMarket)
 	}
 
@@ -597,10 +613,17 @@ func (k *Keeper) UpdateDerivativeMarketParam(
 	market.Status = status
 
 	if oracleParams != nil {
+		isPreLaunch := market.IsPreLaunch()
+
 		market.OracleBase = oracleParams.OracleBase
 		market.OracleQuote = oracleParams.OracleQuote
 		market.OracleType = oracleParams.OracleType
 		market.OracleScaleFactor = oracleParams.OracleScaleFactor
+
+		// the market is converted to an oracle-backed market and no longer marked to its trade TWAP
+		if isPreLaunch && !market.IsPreLaunch() {
+			k.DeletePreLaunchPerpetualMarketInfo(ctx, marketID)
+		}
 	}
 
 	var perpetualMarketInfo *types.PerpetualMarketInfo = nil
@@ -16,12 +16,14 @@ import (
 
 // CancelAllRestingDerivativeLimitOrdersForSubaccount cancels all of the derivative limit orders for a given subaccount and marketID.
 // If shouldCancelReduceOnly is true, reduce-only orders are cancelled. If shouldCancelVanilla is true, vanilla orders are cancelled.
+// The reason is recorded in the terminal state of the cancelled orders.
 func (k *Keeper) CancelAllRestingDerivativeLimitOrdersForSubaccount(
 	ctx sdk.Context,
 	market MarketI,
 	subaccountID common.Hash,
 	shouldCancelReduceOnly bool,
 	shouldCancelVanilla bool,
+	reason types.OrderTerminationReason,
 ) error {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
@@ -31,7 +33,7 @@ func (k *Keeper) CancelAllRestingDerivativeLimitOrdersForSubaccount(
 
 	for _, hash := range restingBuyOrderHashes {
 		isBuy := true
-		if err := k.CancelRestingDerivativeLimitOrder(ctx, market, subaccountID, &isBuy, hash, shouldCancelReduceOnly, shouldCancelVanilla); err != nil {
+		if err := k.CancelRestingDerivativeLimitOrder(ctx, market, subaccountID, &isBuy, hash, shouldCancelReduceOnly, shouldCancelVanilla, reason); err != nil {
 			metrics.ReportFuncError(k.svcTags)
 			continue
 		}
@@ -39,7 +41,7 @@ func (k *Keeper) CancelAllRestingDerivativeLimitOrdersForSubaccount(
 
 	for _, hash := range restingSellOrderHashes {
 		isBuy := false
-		if err := k.CancelRestingDerivativeLimitOrder(ctx, market, subaccountID, &isBuy, hash, shouldCancelReduceOnly, shouldCancelVanilla); err != nil {
+		if err := k.CancelRestingDerivativeLimitOrder(ctx, market, subaccountID, &isBuy, hash, shouldCancelReduceOnly, shouldCancelVanilla, reason); err != nil {
 			metrics.ReportFuncError(k.svcTags)
 			continue
 		}
@@ -72,7 +74,7 @@ func (k *Keeper) CancelRestingDerivativeLimitOrdersForSubaccountUpToBalance(
 
 		isBuy := true
 		order := k.GetDerivativeLimitOrderBySubaccountIDAndHash(ctx, marketID, &isBuy, subaccountID, hash)
-		if err := k.CancelRestingDerivativeLimitOrder(ctx, market, subaccountID, &isBuy, hash, false, true); err != nil {
+		if err := k.CancelRestingDerivativeLimitOrder(ctx, market, subaccountID, &isBuy, hash, false, true, types.OrderTerminationReason_InsufficientFundsCancellation); err != nil {
 			metrics.ReportFuncError(k.svcTags)
 			continue
 		} else {
@@ -90,7 +92,7 @@ func (k *Keeper) CancelRestingDerivativeLimitOrdersForSubaccountUpToBalance(
 
 		isBuy := false
 		order := k.GetDerivativeLimitOrderBySubaccountIDAndHash(ctx, marketID, &isBuy, subaccountID, hash)
-		if err := k.CancelRestingDerivativeLimitOrder(ctx, market, subaccountID, &isBuy, hash, false, true); err != nil {
+		if err := k.CancelRestingDerivativeLimitOrder(ctx, market, subaccountID, &isBuy, hash, false, true, types.OrderTerminationReason_InsufficientFundsCancellation); err != nil {
 			metrics.ReportFuncError(k.svcTags)
 			continue
 		} else {
@@ -107,6 +109,7 @@ func (k *Keeper) CancelRestingDerivativeLimitOrdersForSubaccountUpToBalance(
 func (k *Keeper) CancelAllRestingDerivativeLimitOrders(
 	ctx sdk.Context,
 	market MarketI,
+	reason types.OrderTerminationReason,
 ) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
@@ -117,14 +120,14 @@ func (k *Keeper) CancelAllRestingDerivativeLimitOrders(
 
 	for _, buyOrder := range buyOrders {
 		isBuy := true
-		if err := k.CancelRestingDerivativeLimitOrder(ctx, market, buyOrder.SubaccountID(), &isBuy, buyOrder.Hash(), true, true); err != nil {
+		if err := k.CancelRestingDerivativeLimitOrder(ctx, market, buyOrder.SubaccountID(), &isBuy, buyOrder.Hash(), true, true, reason); err != nil {
 			k.Logger(ctx).Error("CancelRestingDerivativeLimitOrder (buy) failed during CancelAllRestingDerivativeLimitOrders:", err)
 		}
 	}
 
 	for _, sellOrder := range sellOrders {
 		isBuy := false
-		if err := k.CancelRestingDerivativeLimitOrder(ctx, market, sellOrder.SubaccountID(), &isBuy, sellOrder.Hash(), true, true); err != nil {
+		if err := k.CancelRestingDerivativeLimitOrder(ctx, market, sellOrder.SubaccountID(), &isBuy, sellOrder.Hash(), true, true, reason); err != nil {
 			k.Logger(ctx).Error("CancelRestingDerivativeLimitOrder (sell) failed during CancelAllRestingDerivativeLimitOrders:", err)
 		}
 	}
@@ -184,7 +187,7 @@ func (k *Keeper) IterateRestingDerivativeLimitOrderHashesBySubaccount(
 	}
 }
 
-// CancelRestingDerivativeLimitOrder cancels the derivative limit order
+// CancelRestingDerivativeLimitOrder cancels the derivative limit order and records its terminal state with the given reason
 func (k *Keeper) CancelRestingDerivativeLimitOrder(
 	ctx sdk.Context,
 	market MarketI,
@@ -193,6 +196,7 @@ func (k *Keeper) CancelRestingDerivativeLimitOrder(
 	orderHash common.Hash,
 	shouldCancelReduceOnly bool,
 	shouldCancelVanilla bool,
+	reason types.OrderTerminationReason,
 ) error {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
@@ -217,6 +221,7 @@ func (k *Keeper) CancelRestingDerivativeLimitOrder(
 
 	// 2. Delete the order state from ordersStore, ordersIndexStore and subaccountOrderStore
 	k.DeleteDerivativeLimitOrder(ctx, marketID, order)
+	k.RecordDerivativeTerminalOrder(ctx, marketID, order, reason)
 
 	k.UpdateSubaccountOrderbookMetadataFromOrderCancel(ctx, marketID, subaccountID, order)
 
@@ -276,6 +281,7 @@ func (k *Keeper) SetNewDerivativeLimitOrderWithMetadata(
 
 	// update the orderbook metadata
 	k.IncrementOrderbookPriceLevelQuantity(ctx, marketID, isBuy, false, price, order.GetFillable())
+	k.SetOrderInsertionSequence(ctx, orderHash, k.GetOrderbookSequence(ctx, marketID))
 }
 
 // UpdateDerivativeLimitOrdersFromFilledDeltas applies the filledDeltas to the derivative limit orders and stores the updated order (and order index) in the keeper.
@@ -336,6 +342,7 @@ func (k *Keeper) UpdateDerivativeLimitOrdersFromFilledDeltas(
 			if isResting {
 				ordersStore.Delete(priceKey)
 				ordersIndexStore.Delete(subaccountIndexKey)
+				k.DeleteOrderInsertionSequence(ctx, orderHash)
 			}
 
 			store.Delete(subaccountOrderKey)
@@ -345,6 +352,18 @@ func (k *Keeper) UpdateDerivativeLimitOrdersFromFilledDeltas(
 			} else {
 				metadataDelta.VanillaLimitOrderCount -= 1
 			}
+
+			// cancelled iceberg orders have already released their hidden reserve
+			switch {
+			case filledDelta.CancelQuantity.IsZero() && filledDelta.Order.HasHiddenQuantity():
+				k.SetDerivativeIcebergRefill(ctx, marketID, filledDelta.Order)
+			case filledDelta.CancelQuantity.IsZero():
+				k.RecordDerivativeTerminalOrder(ctx, marketID, filledDelta.Order, types.OrderTerminationReason_FullyFilled)
+			case filledDelta.Order.IsReduceOnly():
+				k.RecordDerivativeTerminalOrder(ctx, marketID, filledDelta.Order, types.OrderTerminationReason_ReduceOnlyCancellation)
+			default:
+				k.RecordDerivativeTerminalOrder(ctx, marketID, filledDelta.Order, types.OrderTerminationReason_InsufficientFundsCancellation)
+			}
 		} else {
 			orderBz := k.cdc.MustMarshal(filledDelta.Order)
 			// add transient order to index store since it's our first time seeing this order
@@ -367,6 +386,10 @@ func (k *Keeper) UpdateDerivativeLimitOrdersFromFilledDeltas(
 		} else {
 			// update orderbook metadata
 			k.IncrementOrderbookPriceLevelQuantity(ctx, marketID, isBuy, false, price, filledDelta.FillableQuantity())
+
+			if !filledDelta.FillableQuantity().IsZero() {
+				k.SetOrderInsertionSequence(ctx, orderHash, k.GetOrderbookSequence(ctx, marketID))
+			}
 		}
 	}
 
@@ -455,6 +478,7 @@ func (k *Keeper) DeleteDerivativeLimitOrder(
 
 	// delete from subaccount order store as well
 	store.Delete(subaccountOrderKey)
+	k.DeleteOrderInsertionSequence(ctx, orderHash)
 
 	// update orderbook metadata
 	k.DecrementOrderbookPriceLevelQuantity(ctx, marketID, isBuy, false, order.GetPrice(), order.GetFillable())
@@ -451,6 +451,7 @@ func (k *Keeper) processDerivativeMarketOrderbookMatchingResults(
 				SubaccountID:          o.SubaccountID(),
 				PositionDelta:         nil,
 				Payout:                sdk.ZeroDec(),
+				RealizedPnl:           sdk.ZeroDec(),
 				TotalBalanceDelta:     sdk.ZeroDec(),
 				AvailableBalanceDelta: o.MarginHold,
 				AuctionFeeReward:      sdk.ZeroDec(),
@@ -622,11 +623,11 @@ func (k *Keeper) applyPositionDeltaAndGetDerivativeMarketOrderStateExpansion(
 	position := positionStates[order.SubaccountID()].Position
 
 	var executionMargin sdk.Dec
-	if market.GetMarketType() == types.MarketType_BinaryOption {
-		executionMargin = types.GetRequiredBinaryOptionsOrderMargin(
+	if market.GetMarketType().IsFullyCollateralized() {
+		executionMargin = types.GetRequiredFullyCollateralizedOrderMargin(
 			clearingPrice,
 			fillQuantity,
-			market.GetOracleScaleFactor(),
+			market.GetMaxPrice(),
 			order.GetOrderType(),
 			order.IsReduceOnly(),
 		)
@@ -646,6 +647,7 @@ func (k *Keeper) applyPositionDeltaAndGetDerivativeMarketOrderStateExpansion(
 		}
 	}
 
+	realizedPnl := position.GetRealizedPnl(positionDelta)
 	payout, closeExecutionMargin, collateralizationMargin := position.ApplyPositionDelta(positionDelta, feeData.traderFee)
 
 	unmatchedFeeRefundRate := takerFeeRate
@@ -676,6 +678,7 @@ func (k *Keeper) applyPositionDeltaAndGetDerivativeMarketOrderStateExpansion(
 		SubaccountID:          order.SubaccountID(),
 		PositionDelta:         positionDelta,
 		Payout:                payout,
+		RealizedPnl:           realizedPnl,
 		TotalBalanceDelta:     totalBalanceChange,
 		AvailableBalanceDelta: availableBalanceChange,
 		AuctionFeeReward:      feeData.auctionFeeReward,
@@ -790,13 +793,13 @@ func (k *Keeper) applyPositionDeltaAndGetDerivativeLimitOrderStateExpansion(
 		marginFillProportion := order.Margin.Mul(fillQuantity).Quo(order.OrderInfo.Quantity)
 
 		var executionMargin sdk.Dec
-		if market.GetMarketType() != types.MarketType_BinaryOption {
+		if !market.GetMarketType().IsFullyCollateralized() {
 			executionMargin = marginFillProportion
 		} else {
-			executionMargin = types.GetRequiredBinaryOptionsOrderMargin(
+			executionMargin = types.GetRequiredFullyCollateralizedOrderMargin(
 				executionPrice,
 				fillQuantity,
-				market.GetOracleScaleFactor(),
+				market.GetMaxPrice(),
 				order.GetOrderType(),
 				order.IsReduceOnly(),
 			)
@@ -814,6 +817,7 @@ func (k *Keeper) applyPositionDeltaAndGetDerivativeLimitOrderStateExpansion(
 		}
 	}
 
+	realizedPnl := position.GetRealizedPnl(positionDelta)
 	payout, closeExecutionMargin, collateralizationMargin := position.ApplyPositionDelta(positionDelta, feeData.traderFee)
 
 	unmatchedFeeRefundRate := sdk.ZeroDec()
@@ -858,6 +862,7 @@ func (k *Keeper) applyPositionDeltaAndGetDerivativeLimitOrderStateExpansion(
 		SubaccountID:          order.SubaccountID(),
 		PositionDelta:         positionDelta,
 		Payout:                payout,
+		RealizedPnl:           realizedPnl,
 		TotalBalanceDelta:     totalBalanceChange,
 		AvailableBalanceDelta: availableBalanceChange,
 		AuctionFeeReward:      feeData.auctionFeeReward,
@@ -891,11 +896,11 @@ func (k *Keeper) adjustPositionMarginIfNecessary(
 		return availableBalanceChange, totalBalanceChange
 	}
 
-	// for binary options:
+	// for binary and vanilla options:
 	// 	we can safely reduce from balances, because his margin was adjusted meaning he has enough balance to cover it
 	// 	and we shouldn't adjust the margin anyways
-	isBinaryOptions := market.GetMarketType().IsBinaryOptions()
-	if isBinaryOptions {
+	isFullyCollateralized := market.GetMarketType().IsFullyCollateralized()
+	if isFullyCollateralized {
 		return availableBalanceChange, totalBalanceChange
 	}
 
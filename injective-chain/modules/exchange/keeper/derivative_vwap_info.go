@@ -53,16 +53,18 @@ func NewVwapInfo(markPrice *sdk.Dec) *VwapInfo {
 }
 
 type DerivativeVwapInfo struct {
-	perpetualVwapInfo     map[common.Hash]*VwapInfo
-	expiryVwapInfo        map[common.Hash]*VwapInfo
-	binaryOptionsVwapInfo map[common.Hash]*VwapInfo
+	perpetualVwapInfo      map[common.Hash]*VwapInfo
+	expiryVwapInfo         map[common.Hash]*VwapInfo
+	binaryOptionsVwapInfo  map[common.Hash]*VwapInfo
+	vanillaOptionsVwapInfo map[common.Hash]*VwapInfo
 }
 
 func NewDerivativeVwapInfo() DerivativeVwapInfo {
 	return DerivativeVwapInfo{
-		perpetualVwapInfo:     make(map[common.Hash]*VwapInfo),
-		expiryVwapInfo:        make(map[common.Hash]*VwapInfo),
-		binaryOptionsVwapInfo: make(map[common.Hash]*VwapInfo),
+		perpetualVwapInfo:      make(map[common.Hash]*VwapInfo),
+		expiryVwapInfo:         make(map[common.Hash]*VwapInfo),
+		binaryOptionsVwapInfo:  make(map[common.Hash]*VwapInfo),
+		vanillaOptionsVwapInfo: make(map[common.Hash]*VwapInfo),
 	}
 }
 
@@ -88,6 +90,12 @@ func (p *DerivativeVwapInfo) ApplyVwap(marketID common.Hash, markPrice *sdk.Dec,
 			vwapInfo = NewVwapInfo(markPrice)
 			p.binaryOptionsVwapInfo[marketID] = vwapInfo
 		}
+	case types.MarketType_VanillaOption:
+		vwapInfo = p.vanillaOptionsVwapInfo[marketID]
+		if vwapInfo == nil {
+			vwapInfo = NewVwapInfo(markPrice)
+			p.vanillaOptionsVwapInfo[marketID] = vwapInfo
+		}
 	}
 
 	if !vwapData.Quantity.IsZero() {
@@ -131,6 +139,18 @@ func (p *DerivativeVwapInfo) GetSortedBinaryOptionsMarketIDs() []common.Hash {
 	return binaryOptionsMarketIDs
 }
 
+func (p *DerivativeVwapInfo) GetSortedVanillaOptionsMarketIDs() []common.Hash {
+	vanillaOptionsMarketIDs := make([]common.Hash, 0)
+	for k := range p.vanillaOptionsVwapInfo {
+		vanillaOptionsMarketIDs = append(vanillaOptionsMarketIDs, k)
+	}
+
+	sort.SliceStable(vanillaOptionsMarketIDs, func(i, j int) bool {
+		return bytes.Compare(vanillaOptionsMarketIDs[i].Bytes(), vanillaOptionsMarketIDs[j].Bytes()) < 0
+	})
+	return vanillaOptionsMarketIDs
+}
+
 // ComputeSyntheticVwapUnitDelta returns (price - markPrice) / markPrice
 func (p *DerivativeVwapInfo) ComputeSyntheticVwapUnitDelta(marketID common.Hash) sdk.Dec {
 	vwapInfo := p.perpetualVwapInfo[marketID]
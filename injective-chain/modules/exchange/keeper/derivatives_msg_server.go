@@ -140,6 +140,23 @@ func (k *Keeper) createDerivativeLimitOrder(
 	order *types.DerivativeOrder,
 	market MarketI,
 	markPrice sdk.Dec,
+) (hash common.Hash, err error) {
+	if err := order.CheckMarginTickSize(market.GetMinQuantityTickSize()); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return common.Hash{}, err
+	}
+
+	return k.placeDerivativeLimitOrder(ctx, sender, order, market, markPrice)
+}
+
+// placeDerivativeLimitOrder places a limit order without checking the tick size of its margin, which is only required
+// for the margins chosen by traders.
+func (k *Keeper) placeDerivativeLimitOrder(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	order *types.DerivativeOrder,
+	market MarketI,
+	markPrice sdk.Dec,
 ) (hash common.Hash, err error) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
@@ -154,13 +171,29 @@ func (k *Keeper) createDerivativeLimitOrder(
 
 	isMaker := order.OrderType.IsPostOnly()
 
-	orderHash, err := k.ensureValidDerivativeOrder(ctx, order, market, metadata, markPrice, false, nil, isMaker)
+	var (
+		marginHold    sdk.Dec
+		visibleMargin sdk.Dec
+	)
+
+	if order.IsIceberg() {
+		if visibleMargin, err = order.GetIcebergVisibleMargin(); err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return common.Hash{}, err
+		}
+	}
+
+	orderHash, err := k.ensureValidDerivativeOrder(ctx, order, market, metadata, markPrice, false, &marginHold, isMaker)
 	if err != nil {
 		metrics.ReportFuncError(k.svcTags)
 		return orderHash, err
 	}
 
 	derivativeLimitOrder := types.NewDerivativeLimitOrder(order, sender, orderHash)
+	if order.IsIceberg() {
+		// only the visible slice is displayed on the orderbook, the rest stays in the hidden reserve
+		derivativeLimitOrder.ApplyIcebergSlice(*order.VisibleQuantity, visibleMargin, marginHold)
+	}
 
 	// Store the order in the conditionals store -or- transient limit order store and transient market indicator store
 	if order.IsConditional() {
@@ -235,6 +268,17 @@ func (k DerivativesMsgServer) BatchCreateDerivativeLimitOrders(goCtx context.Con
 }
 
 func (k *Keeper) createDerivativeMarketOrder(ctx sdk.Context, sender sdk.AccAddress, derivativeOrder *types.DerivativeOrder, market MarketI, markPrice sdk.Dec) (orderHash common.Hash, results *types.DerivativeMarketOrderResults, err error) {
+	if err := derivativeOrder.CheckMarginTickSize(market.GetMinQuantityTickSize()); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return common.Hash{}, nil, err
+	}
+
+	return k.placeDerivativeMarketOrder(ctx, sender, derivativeOrder, market, markPrice)
+}
+
+// placeDerivativeMarketOrder places a market order without checking the tick size of its margin, which is only
+// required for the margins chosen by traders.
+func (k *Keeper) placeDerivativeMarketOrder(ctx sdk.Context, sender sdk.AccAddress, derivativeOrder *types.DerivativeOrder, market MarketI, markPrice sdk.Dec) (orderHash common.Hash, results *types.DerivativeMarketOrderResults, err error) {
 	var (
 		subaccountID = types.MustGetSubaccountIDOrDeriveFromNonce(sender, derivativeOrder.OrderInfo.SubaccountId)
 		marketID     = derivativeOrder.MarketID()
@@ -413,7 +457,7 @@ func (k *Keeper) cancelDerivativeOrder(
 				err = k.CancelTransientDerivativeLimitOrder(ctx, market, order)
 			} else {
 				direction := order.OrderType.IsBuy()
-				err = k.CancelRestingDerivativeLimitOrder(ctx, market, subaccountID, &direction, orderHash, true, true)
+				err = k.CancelRestingDerivativeLimitOrder(ctx, market, subaccountID, &direction, orderHash, true, true, types.OrderTerminationReason_UserCancellation)
 			}
 			return err
 		}
@@ -501,3 +545,43 @@ func (k DerivativesMsgServer) IncreasePositionMargin(goCtx context.Context, msg
 
 	return &types.MsgIncreasePositionMarginResponse{}, nil
 }
+
+func (k DerivativesMsgServer) UpdateExpiryFuturesAutoRoll(goCtx context.Context, msg *types.MsgUpdateExpiryFuturesAutoRoll) (*types.MsgUpdateExpiryFuturesAutoRollResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var (
+		sender       = sdk.MustAccAddressFromBech32(msg.Sender)
+		subaccountID = types.MustGetSubaccountIDOrDeriveFromNonce(sender, msg.SubaccountId)
+		seriesID     = common.HexToHash(msg.SeriesId)
+	)
+
+	if k.GetExpiryFuturesMarketSeries(ctx, seriesID) == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrExpiryFuturesMarketSeriesNotFound, "seriesID %s", msg.SeriesId)
+	}
+
+	if msg.Enabled {
+		k.SetExpiryFuturesAutoRoll(ctx, seriesID, subaccountID)
+	} else {
+		k.DeleteExpiryFuturesAutoRoll(ctx, seriesID, subaccountID)
+	}
+
+	return &types.MsgUpdateExpiryFuturesAutoRollResponse{}, nil
+}
+
+func (k DerivativesMsgServer) CreateMultiLegOrder(goCtx context.Context, msg *types.MsgCreateMultiLegOrder) (*types.MsgCreateMultiLegOrderResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	resp, err := k.createMultiLegOrder(ctx, sender, msg)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+	return resp, nil
+}
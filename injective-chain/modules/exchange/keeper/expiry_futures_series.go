@@ -0,0 +1,442 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// expiryFuturesRollingPosition is the position of a subaccount in a settling contract of a series which opted into
+// rolling its positions.
+type expiryFuturesRollingPosition struct {
+	subaccountID common.Hash
+	isLong       bool
+	quantity     sdk.Dec
+}
+
+// expiryFuturesRolls holds the positions of a settling contract of a series to roll into the next contract. The next
+// market is nil if the next contract isn't active.
+type expiryFuturesRolls struct {
+	series       *types.ExpiryFuturesMarketSeries
+	nextMarketID common.Hash
+	nextMarket   *types.DerivativeMarket
+	positions    []expiryFuturesRollingPosition
+}
+
+// ExpiryFuturesMarketSeriesLaunch stores the rolling series of expiry futures markets of the proposal. Its contracts are
+// launched by the BeginBlocker.
+func (k *Keeper) ExpiryFuturesMarketSeriesLaunch(ctx sdk.Context, p *types.ExpiryFuturesMarketSeriesLaunchProposal) (*types.ExpiryFuturesMarketSeries, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	seriesID := types.NewExpiryFuturesMarketSeriesID(p.TickerPrefix, p.QuoteDenom, p.OracleBase, p.OracleQuote, p.OracleType)
+	if k.GetExpiryFuturesMarketSeries(ctx, seriesID) != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrExpiryFuturesMarketSeriesExists, "ticker prefix %s quoteDenom %s", p.TickerPrefix, p.QuoteDenom)
+	}
+
+	if !k.IsDenomValid(ctx, p.QuoteDenom) {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrInvalidQuoteDenom, "denom %s does not exist in supply", p.QuoteDenom)
+	}
+
+	if p.FirstExpirationTimestamp <= ctx.BlockTime().Unix() {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrInvalidExpiry, "first expiration timestamp %d is in the past", p.FirstExpirationTimestamp)
+	}
+
+	if _, err := k.GetDerivativeMarketPrice(ctx, p.OracleBase, p.OracleQuote, p.OracleScaleFactor, p.OracleType); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	series := &types.ExpiryFuturesMarketSeries{
+		SeriesId:                seriesID.Hex(),
+		TickerPrefix:            p.TickerPrefix,
+		QuoteDenom:              p.QuoteDenom,
+		OracleBase:              p.OracleBase,
+		OracleQuote:             p.OracleQuote,
+		OracleType:              p.OracleType,
+		OracleScaleFactor:       p.OracleScaleFactor,
+		Interval:                p.Interval,
+		LeadTime:                p.LeadTime,
+		NextExpirationTimestamp: p.FirstExpirationTimestamp,
+		InitialMarginRatio:      p.InitialMarginRatio,
+		MaintenanceMarginRatio:  p.MaintenanceMarginRatio,
+		MakerFeeRate:            p.MakerFeeRate,
+		TakerFeeRate:            p.TakerFeeRate,
+		MinPriceTickSize:        p.MinPriceTickSize,
+		MinQuantityTickSize:     p.MinQuantityTickSize,
+		MarketIds:               []string{},
+		Status:                  types.MarketStatus_Active,
+	}
+
+	k.SetExpiryFuturesMarketSeries(ctx, series)
+	return series, nil
+}
+
+// ProcessExpiryFuturesMarketSeries launches the next contract of every active series once the lead time ahead of its
+// expiration has been reached. A contract which can't be launched yet, e.g. because its insurance fund hasn't been
+// created, is retried in the next blocks until it expires. It must run before the matured expiry futures markets are
+// settled in the same BeginBlocker.
+func (k *Keeper) ProcessExpiryFuturesMarketSeries(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	blockTime := ctx.BlockTime().Unix()
+
+	for _, series := range k.GetAllExpiryFuturesMarketSeries(ctx) {
+		isUpdated := k.pruneSettledExpiryFuturesSeriesMarkets(ctx, series)
+
+		for series.Status == types.MarketStatus_Active && series.NextExpirationTimestamp-series.LeadTime <= blockTime {
+			expiry := series.NextExpirationTimestamp
+
+			// skip the contracts whose launch window was missed entirely
+			if expiry <= blockTime {
+				missedContracts := (blockTime-expiry)/series.Interval + 1
+				series.NextExpirationTimestamp += missedContracts * series.Interval
+				isUpdated = true
+				continue
+			}
+
+			market, _, err := k.ExpiryFuturesMarketLaunch(
+				ctx,
+				series.GetContractTicker(expiry),
+				series.QuoteDenom,
+				series.OracleBase,
+				series.OracleQuote,
+				series.OracleScaleFactor,
+				series.OracleType,
+				expiry,
+				series.InitialMarginRatio,
+				series.MaintenanceMarginRatio,
+				series.MakerFeeRate,
+				series.TakerFeeRate,
+				series.MinPriceTickSize,
+				series.MinQuantityTickSize,
+			)
+			if err != nil {
+				k.Logger(ctx).Debug("failed to launch the next contract of the expiry futures market series", "seriesID", series.SeriesId, "expiry", expiry, "err", err.Error())
+				break
+			}
+
+			series.MarketIds = append(series.MarketIds, market.MarketId)
+			series.NextExpirationTimestamp += series.Interval
+			isUpdated = true
+		}
+
+		if isUpdated {
+			k.SetExpiryFuturesMarketSeries(ctx, series)
+		}
+	}
+}
+
+// pruneSettledExpiryFuturesSeriesMarkets removes the contracts which were settled, naturally or by force, from the
+// series and returns true if any was removed.
+func (k *Keeper) pruneSettledExpiryFuturesSeriesMarkets(ctx sdk.Context, series *types.ExpiryFuturesMarketSeries) bool {
+	marketIDs := make([]string, 0, len(series.MarketIds))
+	for _, marketID := range series.MarketIds {
+		if k.GetExpiryFuturesMarketInfo(ctx, common.HexToHash(marketID)) != nil {
+			marketIDs = append(marketIDs, marketID)
+		}
+	}
+
+	if len(marketIDs) == len(series.MarketIds) {
+		return false
+	}
+
+	series.MarketIds = marketIDs
+	return true
+}
+
+// getExpiryFuturesRolls returns the positions of the subaccounts which opted into rolling them from a contract of a
+// series expiring at expiry into the next contract, or nil if the market isn't part of a series.
+func (k *Keeper) getExpiryFuturesRolls(ctx sdk.Context, marketID common.Hash, expiry int64) *expiryFuturesRolls {
+	var series *types.ExpiryFuturesMarketSeries
+	k.IterateExpiryFuturesMarketSeries(ctx, func(s *types.ExpiryFuturesMarketSeries) (stop bool) {
+		if s.HasMarket(marketID) {
+			series = s
+			return true
+		}
+		return false
+	})
+
+	if series == nil {
+		return nil
+	}
+
+	nextMarketID := series.GetContractMarketID(expiry + series.Interval)
+
+	positions := make([]expiryFuturesRollingPosition, 0)
+	k.IterateExpiryFuturesAutoRolls(ctx, series.SeriesID(), func(subaccountID common.Hash) (stop bool) {
+		position := k.GetPosition(ctx, marketID, subaccountID)
+		if position != nil && position.Quantity.IsPositive() {
+			positions = append(positions, expiryFuturesRollingPosition{
+				subaccountID: subaccountID,
+				isLong:       position.IsLong,
+				quantity:     position.Quantity,
+			})
+		}
+		return false
+	})
+
+	return &expiryFuturesRolls{
+		series:       series,
+		nextMarketID: nextMarketID,
+		nextMarket:   k.GetDerivativeMarket(ctx, nextMarketID, true),
+		positions:    positions,
+	}
+}
+
+// executeExpiryFuturesRolls opens the rolled positions in the next contract at the settlement price of the settled
+// contract, after its positions were closed. The initial margin of each rolled position is charged from the deposits of
+// its subaccount, which have been credited with the settlement payout. Since the next contract has no open interest to
+// take the other side, the rolled long and short quantities are matched against each other: if one side is larger,
+// its positions are rolled in order of subaccount ID until the quantity of the other side is exhausted. An
+// EventExpiryFuturesPositionNotRolled is emitted for every position, or remainder of a position, which isn't rolled.
+func (k *Keeper) executeExpiryFuturesRolls(ctx sdk.Context, rolls *expiryFuturesRolls, marketID common.Hash, settlementPrice sdk.Dec) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	nextMarketID := rolls.nextMarketID
+
+	if rolls.nextMarket == nil {
+		if len(rolls.positions) > 0 {
+			k.Logger(ctx).Info("the next contract of the expiry futures market series isn't active, positions aren't rolled", "seriesID", rolls.series.SeriesId, "marketID", marketID.Hex(), "nextMarketID", nextMarketID.Hex())
+		}
+
+		for _, rollingPosition := range rolls.positions {
+			k.emitExpiryFuturesPositionNotRolled(ctx, rolls, marketID, rollingPosition, rollingPosition.quantity, types.ExpiryFuturesRollFailureReason_NextContractNotActive)
+		}
+		return
+	}
+
+	totalLongQuantity, totalShortQuantity := sdk.ZeroDec(), sdk.ZeroDec()
+	eligiblePositions := make([]expiryFuturesRollingPosition, 0, len(rolls.positions))
+
+	for _, rollingPosition := range rolls.positions {
+		// a position in the opposite direction in the next contract would be partially closed by the roll
+		if nextPosition := k.GetPosition(ctx, nextMarketID, rollingPosition.subaccountID); nextPosition != nil && nextPosition.Quantity.IsPositive() && nextPosition.IsLong != rollingPosition.isLong {
+			k.emitExpiryFuturesPositionNotRolled(ctx, rolls, marketID, rollingPosition, rollingPosition.quantity, types.ExpiryFuturesRollFailureReason_OppositeNextContractPosition)
+			continue
+		}
+
+		requiredMargin := rollingPosition.quantity.Mul(settlementPrice).Mul(rolls.nextMarket.InitialMarginRatio)
+		if !k.HasSufficientFunds(ctx, rollingPosition.subaccountID, rolls.nextMarket.QuoteDenom, requiredMargin) {
+			k.emitExpiryFuturesPositionNotRolled(ctx, rolls, marketID, rollingPosition, rollingPosition.quantity, types.ExpiryFuturesRollFailureReason_InsufficientRollMargin)
+			continue
+		}
+
+		if rollingPosition.isLong {
+			totalLongQuantity = totalLongQuantity.Add(rollingPosition.quantity)
+		} else {
+			totalShortQuantity = totalShortQuantity.Add(rollingPosition.quantity)
+		}
+		eligiblePositions = append(eligiblePositions, rollingPosition)
+	}
+
+	remainingLongQuantity := sdk.MinDec(totalLongQuantity, totalShortQuantity)
+	remainingShortQuantity := remainingLongQuantity
+
+	for _, rollingPosition := range eligiblePositions {
+		remainingQuantity := &remainingShortQuantity
+		if rollingPosition.isLong {
+			remainingQuantity = &remainingLongQuantity
+		}
+
+		quantity := sdk.MinDec(rollingPosition.quantity, *remainingQuantity)
+		if unmatchedQuantity := rollingPosition.quantity.Sub(quantity); unmatchedQuantity.IsPositive() {
+			k.emitExpiryFuturesPositionNotRolled(ctx, rolls, marketID, rollingPosition, unmatchedQuantity, types.ExpiryFuturesRollFailureReason_NoRollCounterparty)
+		}
+		if !quantity.IsPositive() {
+			continue
+		}
+		*remainingQuantity = remainingQuantity.Sub(quantity)
+
+		margin, err := k.DecrementDepositOrChargeFromBank(ctx, rollingPosition.subaccountID, rolls.nextMarket.QuoteDenom, quantity.Mul(settlementPrice).Mul(rolls.nextMarket.InitialMarginRatio))
+		if err != nil {
+			// should never happen since the funds were checked above
+			metrics.ReportFuncError(k.svcTags)
+			k.Logger(ctx).Error("failed to charge the margin of a rolled position", "subaccountID", rollingPosition.subaccountID.Hex(), "err", err.Error())
+			continue
+		}
+
+		position := k.GetPosition(ctx, nextMarketID, rollingPosition.subaccountID)
+		if position == nil || position.Quantity.IsZero() {
+			position = types.NewPosition(rollingPosition.isLong, sdk.Dec{})
+		}
+
+		position.ApplyPositionDelta(&types.PositionDelta{
+			IsLong:            rollingPosition.isLong,
+			ExecutionQuantity: quantity,
+			ExecutionMargin:   margin,
+			ExecutionPrice:    settlementPrice,
+		}, sdk.ZeroDec())
+		k.SetPosition(ctx, nextMarketID, rollingPosition.subaccountID, position)
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventExpiryFuturesPositionRolled{
+			SeriesId:     rolls.series.SeriesId,
+			SubaccountId: rollingPosition.subaccountID.Hex(),
+			MarketId:     marketID.Hex(),
+			NextMarketId: nextMarketID.Hex(),
+			IsLong:       rollingPosition.isLong,
+			Quantity:     quantity,
+			Price:        settlementPrice,
+			Margin:       margin,
+		})
+	}
+}
+
+func (k *Keeper) emitExpiryFuturesPositionNotRolled(
+	ctx sdk.Context,
+	rolls *expiryFuturesRolls,
+	marketID common.Hash,
+	rollingPosition expiryFuturesRollingPosition,
+	quantity sdk.Dec,
+	reason types.ExpiryFuturesRollFailureReason,
+) {
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventExpiryFuturesPositionNotRolled{
+		SeriesId:     rolls.series.SeriesId,
+		SubaccountId: rollingPosition.subaccountID.Hex(),
+		MarketId:     marketID.Hex(),
+		NextMarketId: rolls.nextMarketID.Hex(),
+		IsLong:       rollingPosition.isLong,
+		Quantity:     quantity,
+		Reason:       reason,
+	})
+}
+
+// GetExpiryFuturesMarketSeries fetches the expiry futures market series from the store by seriesID.
+func (k *Keeper) GetExpiryFuturesMarketSeries(ctx sdk.Context, seriesID common.Hash) *types.ExpiryFuturesMarketSeries {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	seriesStore := prefix.NewStore(store, types.ExpiryFuturesMarketSeriesPrefix)
+
+	bz := seriesStore.Get(seriesID.Bytes())
+	if bz == nil {
+		return nil
+	}
+
+	var series types.ExpiryFuturesMarketSeries
+	k.cdc.MustUnmarshal(bz, &series)
+	return &series
+}
+
+// SetExpiryFuturesMarketSeries saves the expiry futures market series in the store.
+func (k *Keeper) SetExpiryFuturesMarketSeries(ctx sdk.Context, series *types.ExpiryFuturesMarketSeries) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	seriesStore := prefix.NewStore(store, types.ExpiryFuturesMarketSeriesPrefix)
+
+	bz := k.cdc.MustMarshal(series)
+	seriesStore.Set(series.SeriesID().Bytes(), bz)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventExpiryFuturesMarketSeriesUpdate{
+		Series: *series,
+	})
+}
+
+// GetAllExpiryFuturesMarketSeries returns all expiry futures market series.
+func (k *Keeper) GetAllExpiryFuturesMarketSeries(ctx sdk.Context) []*types.ExpiryFuturesMarketSeries {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	series := make([]*types.ExpiryFuturesMarketSeries, 0)
+	k.IterateExpiryFuturesMarketSeries(ctx, func(s *types.ExpiryFuturesMarketSeries) (stop bool) {
+		series = append(series, s)
+		return false
+	})
+
+	return series
+}
+
+// IterateExpiryFuturesMarketSeries iterates over the expiry futures market series calling process on each series.
+func (k *Keeper) IterateExpiryFuturesMarketSeries(ctx sdk.Context, process func(*types.ExpiryFuturesMarketSeries) (stop bool)) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	seriesStore := prefix.NewStore(store, types.ExpiryFuturesMarketSeriesPrefix)
+
+	iterator := seriesStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var series types.ExpiryFuturesMarketSeries
+		k.cdc.MustUnmarshal(iterator.Value(), &series)
+		if process(&series) {
+			return
+		}
+	}
+}
+
+// SetExpiryFuturesAutoRoll opts the subaccount into rolling its positions in the contracts of the series.
+func (k *Keeper) SetExpiryFuturesAutoRoll(ctx sdk.Context, seriesID, subaccountID common.Hash) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	autoRollStore := prefix.NewStore(store, types.ExpiryFuturesAutoRollPrefix)
+	autoRollStore.Set(types.GetExpiryFuturesAutoRollKey(seriesID, subaccountID), []byte{})
+}
+
+// DeleteExpiryFuturesAutoRoll opts the subaccount out of rolling its positions in the contracts of the series.
+func (k *Keeper) DeleteExpiryFuturesAutoRoll(ctx sdk.Context, seriesID, subaccountID common.Hash) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	autoRollStore := prefix.NewStore(store, types.ExpiryFuturesAutoRollPrefix)
+	autoRollStore.Delete(types.GetExpiryFuturesAutoRollKey(seriesID, subaccountID))
+}
+
+// HasExpiryFuturesAutoRoll returns true if the subaccount opted into rolling its positions in the contracts of the series.
+func (k *Keeper) HasExpiryFuturesAutoRoll(ctx sdk.Context, seriesID, subaccountID common.Hash) bool {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	autoRollStore := prefix.NewStore(store, types.ExpiryFuturesAutoRollPrefix)
+	return autoRollStore.Has(types.GetExpiryFuturesAutoRollKey(seriesID, subaccountID))
+}
+
+// IterateExpiryFuturesAutoRolls iterates over the subaccounts which opted into rolling their positions in the contracts
+// of the series, in order of subaccount ID.
+func (k *Keeper) IterateExpiryFuturesAutoRolls(ctx sdk.Context, seriesID common.Hash, process func(subaccountID common.Hash) (stop bool)) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	autoRollStore := prefix.NewStore(store, append(types.ExpiryFuturesAutoRollPrefix, seriesID.Bytes()...))
+
+	iterator := autoRollStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if process(common.BytesToHash(iterator.Key())) {
+			return
+		}
+	}
+}
+
+// GetAllExpiryFuturesAutoRolls returns the subaccounts which opted into rolling their positions in every series.
+func (k *Keeper) GetAllExpiryFuturesAutoRolls(ctx sdk.Context) []*types.ExpiryFuturesAutoRoll {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	autoRollStore := prefix.NewStore(store, types.ExpiryFuturesAutoRollPrefix)
+
+	iterator := autoRollStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	autoRolls := make([]*types.ExpiryFuturesAutoRoll, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		autoRolls = append(autoRolls, &types.ExpiryFuturesAutoRoll{
+			SeriesId:     common.BytesToHash(key[:common.HashLength]).Hex(),
+			SubaccountId: common.BytesToHash(key[common.HashLength:]).Hex(),
+		})
+	}
+
+	return autoRolls
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// GetFullSpotOrderbook returns a page of the resting limit orders of one side of a spot market orderbook, best price first.
+func (k *Keeper) GetFullSpotOrderbook(
+	ctx sdk.Context,
+	marketID common.Hash,
+	isBuy bool,
+	pageRequest *query.PageRequest,
+) (*types.QueryFullOrderbookResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	toFullOrderbookOrder := func(value []byte) *types.FullOrderbookOrder {
+		var order types.SpotLimitOrder
+		k.cdc.MustUnmarshal(value, &order)
+
+		orderHash := common.BytesToHash(order.OrderHash)
+		return &types.FullOrderbookOrder{
+			OrderHash:         orderHash.Hex(),
+			SubaccountId:      order.OrderInfo.SubaccountId,
+			Price:             order.OrderInfo.Price,
+			Quantity:          order.OrderInfo.Quantity,
+			Fillable:          order.Fillable,
+			Margin:            sdk.ZeroDec(),
+			OrderType:         order.OrderType,
+			InsertionSequence: k.GetOrderInsertionSequence(ctx, orderHash),
+		}
+	}
+
+	return k.getFullOrderbook(ctx, types.SpotLimitOrdersPrefix, marketID, isBuy, pageRequest, toFullOrderbookOrder)
+}
+
+// GetFullDerivativeOrderbook returns a page of the resting limit orders of one side of a derivative or binary options
+// market orderbook, best price first.
+func (k *Keeper) GetFullDerivativeOrderbook(
+	ctx sdk.Context,
+	marketID common.Hash,
+	isBuy bool,
+	pageRequest *query.PageRequest,
+) (*types.QueryFullOrderbookResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	toFullOrderbookOrder := func(value []byte) *types.FullOrderbookOrder {
+		var order types.DerivativeLimitOrder
+		k.cdc.MustUnmarshal(value, &order)
+
+		orderHash := order.Hash()
+		return &types.FullOrderbookOrder{
+			OrderHash:         orderHash.Hex(),
+			SubaccountId:      order.OrderInfo.SubaccountId,
+			Price:             order.OrderInfo.Price,
+			Quantity:          order.OrderInfo.Quantity,
+			Fillable:          order.Fillable,
+			Margin:            order.Margin,
+			OrderType:         order.OrderType,
+			InsertionSequence: k.GetOrderInsertionSequence(ctx, orderHash),
+		}
+	}
+
+	return k.getFullOrderbook(ctx, types.DerivativeLimitOrdersPrefix, marketID, isBuy, pageRequest, toFullOrderbookOrder)
+}
+
+func (k *Keeper) getFullOrderbook(
+	ctx sdk.Context,
+	ordersPrefix []byte,
+	marketID common.Hash,
+	isBuy bool,
+	pageRequest *query.PageRequest,
+	toFullOrderbookOrder func(value []byte) *types.FullOrderbookOrder,
+) (*types.QueryFullOrderbookResponse, error) {
+	ordersStore := prefix.NewStore(k.getStore(ctx), append(ordersPrefix, types.MarketDirectionPrefix(marketID, isBuy)...))
+
+	// orders are keyed by price in ascending order, so buys are iterated in reverse to get the best price first
+	request := query.PageRequest{}
+	if pageRequest != nil {
+		request = *pageRequest
+	}
+	request.Reverse = isBuy
+
+	orders := make([]*types.FullOrderbookOrder, 0)
+	pageResponse, err := query.Paginate(ordersStore, &request, func(_, value []byte) error {
+		orders = append(orders, toFullOrderbookOrder(value))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFullOrderbookResponse{
+		Orders:     orders,
+		Sequence:   k.GetOrderbookSequence(ctx, marketID),
+		Pagination: pageResponse,
+	}, nil
+}
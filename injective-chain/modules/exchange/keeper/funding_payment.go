@@ -124,5 +124,9 @@ func (k *Keeper) PersistPerpetualFundingInfo(ctx sdk.Context, perpetualVwapInfo
 			FundingRate:     nil,
 			MarkPrice:       nil,
 		})
+
+		if vwapData := perpetualVwapInfo.perpetualVwapInfo[marketID].VwapData; vwapData.Quantity.IsPositive() {
+			k.recordPreLaunchPerpetualMarketTrade(ctx, marketID, vwapData.Price, vwapData.Quantity)
+		}
 	}
 }
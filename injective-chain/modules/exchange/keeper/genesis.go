@@ -68,6 +68,10 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		k.SetPerpetualMarketFunding(ctx, common.HexToHash(m.MarketId), m.Funding)
 	}
 
+	for idx := range data.PreLaunchPerpetualMarketInfos {
+		k.SetPreLaunchPerpetualMarketInfo(ctx, &data.PreLaunchPerpetualMarketInfos[idx])
+	}
+
 	for _, scheduledSettlementInfo := range data.DerivativeMarketSettlementScheduled {
 		k.SetDerivativesMarketScheduledSettlementInfo(ctx, &scheduledSettlementInfo)
 	}
@@ -189,6 +193,11 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		k.SetIsOptedOutOfRewards(ctx, dmmAccount, true)
 	}
 
+	// the retentions bound the trade records kept on import
+	if len(data.MarketTradeRecordRetentions) > 0 {
+		k.SetTradeRecordRetentions(ctx, data.MarketTradeRecordRetentions)
+	}
+
 	for _, tradeRecords := range data.HistoricalTradeRecords {
 		marketID := common.HexToHash(tradeRecords.MarketId)
 		for _, record := range tradeRecords.LatestTradeRecords {
@@ -204,6 +213,22 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		k.scheduleBinaryOptionsMarketForSettlement(ctx, common.HexToHash(marketId))
 	}
 
+	for _, market := range data.VanillaOptionsMarkets {
+		k.SetVanillaOptionsMarket(ctx, market)
+	}
+
+	for _, market := range data.CategoricalMarkets {
+		k.SetCategoricalMarket(ctx, market)
+	}
+
+	for _, series := range data.ExpiryFuturesMarketSeries {
+		k.SetExpiryFuturesMarketSeries(ctx, series)
+	}
+
+	for _, autoRoll := range data.ExpiryFuturesAutoRolls {
+		k.SetExpiryFuturesAutoRoll(ctx, common.HexToHash(autoRoll.SeriesId), common.HexToHash(autoRoll.SubaccountId))
+	}
+
 	for _, denomDecimal := range data.DenomDecimals {
 		k.SetDenomDecimals(ctx, denomDecimal.Denom, denomDecimal.Decimals)
 	}
@@ -214,7 +239,7 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		marketID := common.HexToHash(orderbook.MarketId)
 		market, _ := k.GetDerivativeMarketAndStatus(ctx, marketID)
-		markPrice, err := k.GetDerivativeMarketPrice(ctx, market.OracleBase, market.OracleQuote, market.OracleScaleFactor, market.OracleType)
+		markPrice, err := k.GetDerivativeMarketMarkPrice(ctx, market)
 		if err != nil {
 			panic("error in ConditionalDerivativeOrderbooks mark price, err: " + err.Error() + " marketID: " + marketID.String())
 		}
@@ -237,6 +262,10 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		k.SetOrderbookSequence(ctx, marketID, orderbookSequence.Sequence)
 	}
 
+	for _, orderInsertionSequence := range data.OrderInsertionSequences {
+		k.SetOrderInsertionSequence(ctx, common.HexToHash(orderInsertionSequence.OrderHash), orderInsertionSequence.Sequence)
+	}
+
 	for _, record := range data.SubaccountVolumes {
 		subaccountID := common.HexToHash(record.SubaccountId)
 
@@ -248,6 +277,50 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	for _, record := range data.MarketVolumes {
 		k.SetMarketAggregateVolume(ctx, common.HexToHash(record.MarketId), record.Volume)
 	}
+
+	for _, orderbook := range data.SpotIcebergRefills {
+		for _, order := range orderbook.Orders {
+			k.SetSpotIcebergRefill(ctx, common.HexToHash(orderbook.MarketId), order)
+		}
+	}
+
+	for _, orderbook := range data.DerivativeIcebergRefills {
+		for _, order := range orderbook.Orders {
+			k.SetDerivativeIcebergRefill(ctx, common.HexToHash(orderbook.MarketId), order)
+		}
+	}
+
+	for _, order := range data.TwapOrders {
+		k.SetTWAPOrder(ctx, order)
+	}
+
+	for _, order := range data.TerminalOrders {
+		k.SetTerminalOrder(ctx, order)
+	}
+
+	for _, fill := range data.LimitOrderFills {
+		k.SetLimitOrderFill(ctx, fill)
+	}
+
+	for _, pnl := range data.SubaccountPnls {
+		k.SetSubaccountPnl(ctx, pnl)
+	}
+
+	for _, request := range data.RfqRequests {
+		k.SetRFQRequest(ctx, request)
+	}
+
+	if data.NextRfqRequestId > 0 {
+		k.SetNextRFQRequestID(ctx, data.NextRfqRequestId)
+	}
+
+	for idx := range data.SignedOrderNonces {
+		k.SetSignedOrderNonce(ctx, &data.SignedOrderNonces[idx])
+	}
+
+	for _, minNonce := range data.SignedOrderMinNonces {
+		k.SetSignedOrderMinNonce(ctx, common.HexToHash(minNonce.SubaccountId), minNonce.MinNonce)
+	}
 }
 
 func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
@@ -278,6 +351,11 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		RewardsOptOutAddresses:                       k.GetAllOptedOutRewardAccounts(ctx),
 		HistoricalTradeRecords:                       k.GetAllHistoricalTradeRecords(ctx),
 		BinaryOptionsMarkets:                         k.GetAllBinaryOptionsMarkets(ctx),
+		VanillaOptionsMarkets:                        k.GetAllVanillaOptionsMarkets(ctx),
+		CategoricalMarkets:                           k.GetAllCategoricalMarkets(ctx),
+		ExpiryFuturesMarketSeries:                    k.GetAllExpiryFuturesMarketSeries(ctx),
+		ExpiryFuturesAutoRolls:                       k.GetAllExpiryFuturesAutoRolls(ctx),
+		PreLaunchPerpetualMarketInfos:                k.GetAllPreLaunchPerpetualMarketInfos(ctx),
 		BinaryOptionsMarketIdsScheduledForSettlement: k.GetAllBinaryOptionsMarketIDsScheduledForSettlement(ctx),
 		SpotMarketIdsScheduledToForceClose:           k.GetAllForceClosedSpotMarketIDStrings(ctx),
 		DenomDecimals:                                k.GetAllDenomDecimals(ctx),
@@ -286,5 +364,17 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		OrderbookSequences:                           k.GetAllOrderbookSequences(ctx),
 		SubaccountVolumes:                            k.GetAllSubaccountMarketAggregateVolumes(ctx),
 		MarketVolumes:                                k.GetAllMarketAggregateVolumes(ctx),
+		SpotIcebergRefills:                           k.GetAllSpotIcebergRefills(ctx),
+		DerivativeIcebergRefills:                     k.GetAllDerivativeIcebergRefills(ctx),
+		TwapOrders:                                   k.GetAllTWAPOrders(ctx),
+		MarketTradeRecordRetentions:                  k.GetAllTradeRecordRetentions(ctx),
+		OrderInsertionSequences:                      k.GetAllOrderInsertionSequences(ctx),
+		TerminalOrders:                               k.GetAllTerminalOrders(ctx),
+		LimitOrderFills:                              k.GetAllLimitOrderFills(ctx),
+		SubaccountPnls:                               k.GetAllSubaccountPnls(ctx),
+		RfqRequests:                                  k.GetAllRFQRequests(ctx),
+		NextRfqRequestId:                             k.GetNextRFQRequestID(ctx),
+		SignedOrderNonces:                            k.GetAllSignedOrderNonces(ctx),
+		SignedOrderMinNonces:                         k.GetAllSignedOrderMinNonces(ctx),
 	}
 }
@@ -5,6 +5,8 @@ import (
 	"errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/InjectiveLabs/metrics"
@@ -83,15 +85,26 @@ func (k *Keeper) SubaccountDeposits(c context.Context, req *types.QuerySubaccoun
 	return res, nil
 }
 
-func (k *Keeper) ExchangeBalances(c context.Context, _ *types.QueryExchangeBalancesRequest) (*types.QueryExchangeBalancesResponse, error) {
+func (k *Keeper) ExchangeBalances(c context.Context, req *types.QueryExchangeBalancesRequest) (*types.QueryExchangeBalancesResponse, error) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	balances := k.GetAllExchangeBalances(ctx)
+	if req.Pagination == nil {
+		return &types.QueryExchangeBalancesResponse{
+			Balances: k.GetAllExchangeBalances(ctx),
+		}, nil
+	}
+
+	balances, pageResponse, err := k.GetExchangeBalancesPage(ctx, req.Pagination)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
 
 	res := &types.QueryExchangeBalancesResponse{
-		Balances: balances,
+		Balances:   balances,
+		Pagination: pageResponse,
 	}
 
 	return res, nil
@@ -122,26 +135,46 @@ func (k *Keeper) AggregateVolume(c context.Context, req *types.QueryAggregateVol
 func (k *Keeper) AggregateVolumes(c context.Context, req *types.QueryAggregateVolumesRequest) (*types.QueryAggregateVolumesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	marketVolumes := make([]*types.MarketVolume, 0, len(req.MarketIds))
-	marketIDs := make([]common.Hash, 0, len(req.MarketIds))
-	marketIDMap := make(map[common.Hash]struct{})
+	var (
+		marketVolumes []*types.MarketVolume
+		pageResponse  *query.PageResponse
+	)
 
-	for _, marketId := range req.MarketIds {
-		marketID := common.HexToHash(marketId)
+	if req.Pagination == nil {
+		marketVolumes = make([]*types.MarketVolume, 0, len(req.MarketIds))
+		requestedMarketIDs := make(map[common.Hash]struct{})
 
-		// skip duplicate marketIDs
-		if _, found := marketIDMap[marketID]; found {
-			continue
+		for _, marketId := range req.MarketIds {
+			marketID := common.HexToHash(marketId)
+
+			// skip duplicate marketIDs
+			if _, found := requestedMarketIDs[marketID]; found {
+				continue
+			}
+
+			marketVolumes = append(marketVolumes, &types.MarketVolume{
+				MarketId: marketID.Hex(),
+				Volume:   k.GetMarketAggregateVolume(ctx, marketID),
+			})
+			requestedMarketIDs[marketID] = struct{}{}
+		}
+	} else {
+		var err error
+		marketVolumes, pageResponse, err = k.GetMarketAggregateVolumesPage(ctx, hexToHashes(req.MarketIds), req.Pagination)
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, err
 		}
+	}
 
-		volume := k.GetMarketAggregateVolume(ctx, marketID)
-		marketVolumes = append(marketVolumes, &types.MarketVolume{
-			MarketId: marketID.Hex(),
-			Volume:   volume,
-		})
+	marketIDs := make([]common.Hash, 0, len(marketVolumes))
+	marketIDMap := make(map[common.Hash]struct{})
+
+	for _, marketVolume := range marketVolumes {
+		marketID := common.HexToHash(marketVolume.MarketId)
 
 		// minor optimization so we don't check account volumes for markets that have 0 volume
-		if !volume.IsZero() {
+		if !marketVolume.Volume.IsZero() {
 			marketIDs = append(marketIDs, marketID)
 		}
 		marketIDMap[marketID] = struct{}{}
@@ -193,6 +226,7 @@ func (k *Keeper) AggregateVolumes(c context.Context, req *types.QueryAggregateVo
 	res := &types.QueryAggregateVolumesResponse{
 		AggregateAccountVolumes: accountVolumes,
 		AggregateMarketVolumes:  marketVolumes,
+		Pagination:              pageResponse,
 	}
 	return res, nil
 }
@@ -212,6 +246,19 @@ func (k *Keeper) AggregateMarketVolume(c context.Context, req *types.QueryAggreg
 func (k *Keeper) AggregateMarketVolumes(c context.Context, req *types.QueryAggregateMarketVolumesRequest) (*types.QueryAggregateMarketVolumesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
+	if req.Pagination != nil {
+		volumes, pageResponse, err := k.GetMarketAggregateVolumesPage(ctx, hexToHashes(req.MarketIds), req.Pagination)
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, err
+		}
+
+		return &types.QueryAggregateMarketVolumesResponse{
+			Volumes:    volumes,
+			Pagination: pageResponse,
+		}, nil
+	}
+
 	volumes := make([]*types.MarketVolume, 0, len(req.MarketIds))
 
 	// get all the market aggregate volumes if unspecified
@@ -248,8 +295,23 @@ func (k *Keeper) DenomDecimal(c context.Context, req *types.QueryDenomDecimalReq
 }
 
 func (k *Keeper) DenomDecimals(c context.Context, req *types.QueryDenomDecimalsRequest) (*types.QueryDenomDecimalsResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
 	ctx := sdk.UnwrapSDKContext(c)
 
+	if len(req.Denoms) == 0 && req.Pagination != nil {
+		denomDecimals, pageResponse, err := k.GetDenomDecimalsPage(ctx, req.Pagination)
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, err
+		}
+
+		return &types.QueryDenomDecimalsResponse{
+			DenomDecimals: denomDecimals,
+			Pagination:    pageResponse,
+		}, nil
+	}
+
 	denomDecimals := make([]types.DenomDecimals, 0, len(req.Denoms))
 	if len(req.Denoms) == 0 {
 		denomDecimals = k.GetAllDenomDecimals(ctx)
@@ -272,15 +334,28 @@ func (k *Keeper) SpotMarkets(c context.Context, req *types.QuerySpotMarketsReque
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
 	ctx := sdk.UnwrapSDKContext(c)
+	status := parseMarketStatusFilter(req.Status)
+
+	if req.Pagination != nil {
+		if status == types.MarketStatus_Unspecified {
+			return &types.QuerySpotMarketsResponse{Markets: []*types.SpotMarket{}}, nil
+		}
+
+		markets, pageResponse, err := k.GetSpotMarketsPage(ctx, status, req.Pagination)
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, err
+		}
+
+		return &types.QuerySpotMarketsResponse{
+			Markets:    markets,
+			Pagination: pageResponse,
+		}, nil
+	}
+
 	m := k.GetAllSpotMarkets(ctx)
 
 	markets := make([]*types.SpotMarket, 0, len(m))
-	var status types.MarketStatus
-	if req.Status == "" {
-		status = types.MarketStatus_Active
-	} else {
-		status = types.MarketStatus(types.MarketStatus_value[req.Status])
-	}
 	if status != types.MarketStatus_Unspecified {
 		for _, market := range m {
 			if market.Status == status {
@@ -466,6 +541,41 @@ func (k *Keeper) TraderDerivativeOrdersToCancelUpToAmountRequest(c context.Conte
 	return res, nil
 }
 
+func (k *Keeper) TraderTWAPOrders(c context.Context, req *types.QueryTraderTWAPOrdersRequest) (*types.QueryTraderTWAPOrdersResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marketID := common.HexToHash(req.MarketId)
+	subaccountID := common.HexToHash(req.SubaccountId)
+
+	orders := k.GetAllTraderTWAPOrders(ctx, marketID, subaccountID)
+	trimmedOrders := make([]*types.TrimmedTWAPOrder, 0, len(orders))
+	for _, order := range orders {
+		trimmedOrders = append(trimmedOrders, order.ToTrimmed())
+	}
+
+	res := &types.QueryTraderTWAPOrdersResponse{
+		Orders: trimmedOrders,
+	}
+
+	return res, nil
+}
+
+func (k *Keeper) SimulateOrder(c context.Context, req *types.QuerySimulateOrderRequest) (*types.QuerySimulateOrderResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res, err := k.simulateOrder(ctx, req)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	return res, nil
+}
+
 func (k *Keeper) TraderSpotTransientOrders(c context.Context, req *types.QueryTraderSpotOrdersRequest) (*types.QueryTraderSpotOrdersResponse, error) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
@@ -592,17 +702,29 @@ func (k *Keeper) DerivativeMarkets(c context.Context, req *types.QueryDerivative
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
 	ctx := sdk.UnwrapSDKContext(c)
-	m := k.GetAllFullDerivativeMarkets(ctx)
+	status := parseMarketStatusFilter(req.Status)
 
-	markets := make([]*types.FullDerivativeMarket, 0, len(m))
+	if req.Pagination != nil {
+		if status == types.MarketStatus_Unspecified {
+			return &types.QueryDerivativeMarketsResponse{Markets: []*types.FullDerivativeMarket{}}, nil
+		}
 
-	var status types.MarketStatus
-	if req.Status == "" {
-		status = types.MarketStatus_Active
-	} else {
-		status = types.MarketStatus(types.MarketStatus_value[req.Status])
+		markets, pageResponse, err := k.GetFullDerivativeMarketsPage(ctx, status, req.Pagination)
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, err
+		}
+
+		return &types.QueryDerivativeMarketsResponse{
+			Markets:    markets,
+			Pagination: pageResponse,
+		}, nil
 	}
 
+	m := k.GetAllFullDerivativeMarkets(ctx)
+
+	markets := make([]*types.FullDerivativeMarket, 0, len(m))
+
 	if status != types.MarketStatus_Unspecified {
 		for _, market := range m {
 			if market.Market.Status == status {
@@ -702,8 +824,21 @@ func (k *Keeper) Positions(c context.Context, req *types.QueryPositionsRequest)
 
 	ctx := sdk.UnwrapSDKContext(c)
 
+	if req.Pagination == nil {
+		return &types.QueryPositionsResponse{
+			State: k.GetAllPositions(ctx),
+		}, nil
+	}
+
+	positions, pageResponse, err := k.GetPositionsPage(ctx, req.Pagination)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
 	res := &types.QueryPositionsResponse{
-		State: k.GetAllPositions(ctx),
+		State:      positions,
+		Pagination: pageResponse,
 	}
 
 	return res, nil
@@ -764,6 +899,19 @@ func (k *Keeper) SubaccountEffectivePositionInMarket(c context.Context, req *typ
 	return res, nil
 }
 
+func (k *Keeper) AccountSummary(c context.Context, req *types.QueryAccountSummaryRequest) (*types.QueryAccountSummaryResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !types.IsHexHash(req.SubaccountId) {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, types.ErrBadSubaccountID
+	}
+
+	return k.GetAccountSummary(ctx, common.HexToHash(req.SubaccountId)), nil
+}
+
 func (k *Keeper) SubaccountOrderMetadata(c context.Context, req *types.QuerySubaccountOrderMetadataRequest) (*types.QuerySubaccountOrderMetadataResponse, error) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
@@ -796,6 +944,20 @@ func (k *Keeper) TradeRewardPoints(c context.Context, req *types.QueryTradeRewar
 
 	ctx := sdk.UnwrapSDKContext(c)
 
+	if len(req.Accounts) == 0 && req.Pagination != nil {
+		accounts, accountPoints, pageResponse, err := k.GetTradeRewardPointsPage(ctx, req.Pagination)
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, err
+		}
+
+		return &types.QueryTradeRewardPointsResponse{
+			AccountTradeRewardPoints: accountPoints,
+			Accounts:                 accounts,
+			Pagination:               pageResponse,
+		}, nil
+	}
+
 	accounts := make([]sdk.AccAddress, 0, len(req.Accounts))
 	for _, accountStr := range req.Accounts {
 		account, err := sdk.AccAddressFromBech32(accountStr)
@@ -824,6 +986,20 @@ func (k *Keeper) PendingTradeRewardPoints(c context.Context, req *types.QueryTra
 
 	ctx := sdk.UnwrapSDKContext(c)
 
+	if len(req.Accounts) == 0 && req.Pagination != nil {
+		accounts, accountPoints, pageResponse, err := k.GetPendingTradeRewardPointsPage(ctx, req.PendingPoolTimestamp, req.Pagination)
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, err
+		}
+
+		return &types.QueryTradeRewardPointsResponse{
+			AccountTradeRewardPoints: accountPoints,
+			Accounts:                 accounts,
+			Pagination:               pageResponse,
+		}, nil
+	}
+
 	accounts := make([]sdk.AccAddress, 0, len(req.Accounts))
 	for _, accountStr := range req.Accounts {
 		account, err := sdk.AccAddressFromBech32(accountStr)
@@ -889,8 +1065,21 @@ func (k *Keeper) OptedOutOfRewardsAccounts(c context.Context, req *types.QueryOp
 
 	ctx := sdk.UnwrapSDKContext(c)
 
+	if req.Pagination == nil {
+		return &types.QueryOptedOutOfRewardsAccountsResponse{
+			Accounts: k.GetAllOptedOutRewardAccounts(ctx),
+		}, nil
+	}
+
+	accounts, pageResponse, err := k.GetOptedOutRewardAccountsPage(ctx, req.Pagination)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
 	res := &types.QueryOptedOutOfRewardsAccountsResponse{
-		Accounts: k.GetAllOptedOutRewardAccounts(ctx),
+		Accounts:   accounts,
+		Pagination: pageResponse,
 	}
 
 	return res, nil
@@ -958,11 +1147,24 @@ func (k *Keeper) FeeDiscountSchedule(c context.Context, req *types.QueryFeeDisco
 func (k *Keeper) GetAllBalancesWithBalanceHolds(ctx sdk.Context) []*types.BalanceWithMarginHold {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
+	balances := k.GetAllExchangeBalances(ctx)
+	balanceHolds := k.getAllBalanceHolds(ctx)
+
+	balanceWithBalanceHolds := make([]*types.BalanceWithMarginHold, 0, len(balances))
+	for _, balance := range balances {
+		balanceWithBalanceHolds = append(balanceWithBalanceHolds, newBalanceWithBalanceHold(balance, balanceHolds))
+	}
+
+	return balanceWithBalanceHolds
+}
+
+// getAllBalanceHolds returns the balances held by the resting orders and the TWAP orders, by subaccount ID and denom.
+func (k *Keeper) getAllBalanceHolds(ctx sdk.Context) map[string]map[string]sdk.Dec {
 	balanceHolds := make(map[string]map[string]sdk.Dec)
 
-	balances := k.GetAllExchangeBalances(ctx)
-	restingSpotOrders := k.GetAllSpotLimitOrderbook(ctx)
-	restingDerivativeOrders := k.GetAllDerivativeAndBinaryOptionsLimitOrderbook(ctx)
+	// exhausted iceberg orders awaiting refill still hold the balance of their hidden reserve
+	restingSpotOrders := append(k.GetAllSpotLimitOrderbook(ctx), k.GetAllSpotIcebergRefills(ctx)...)
+	restingDerivativeOrders := append(k.GetAllDerivativeAndBinaryOptionsLimitOrderbook(ctx), k.GetAllDerivativeIcebergRefills(ctx)...)
 
 	var safeUpdateBalanceHolds = func(subaccountId, denom string, amount sdk.Dec) {
 		if _, ok := balanceHolds[subaccountId]; !ok {
@@ -994,24 +1196,27 @@ func (k *Keeper) GetAllBalancesWithBalanceHolds(ctx sdk.Context) []*types.Balanc
 		}
 	}
 
-	balanceWithBalanceHolds := make([]*types.BalanceWithMarginHold, 0, len(balances))
-	for _, balance := range balances {
-		balanceHold := balanceHolds[balance.SubaccountId][balance.Denom]
+	for _, order := range k.GetAllTWAPOrders(ctx) {
+		safeUpdateBalanceHolds(order.SubaccountID().Hex(), k.getTWAPOrderMarginDenom(ctx, order), order.BalanceHold)
+	}
 
-		if balanceHold.IsNil() {
-			balanceHold = sdk.ZeroDec()
-		}
+	return balanceHolds
+}
 
-		balanceWithBalanceHolds = append(balanceWithBalanceHolds, &types.BalanceWithMarginHold{
-			SubaccountId: balance.SubaccountId,
-			Denom:        balance.Denom,
-			Available:    balance.Deposits.AvailableBalance,
-			Total:        balance.Deposits.TotalBalance,
-			BalanceHold:  balanceHold,
-		})
+func newBalanceWithBalanceHold(balance types.Balance, balanceHolds map[string]map[string]sdk.Dec) *types.BalanceWithMarginHold {
+	balanceHold := balanceHolds[balance.SubaccountId][balance.Denom]
+
+	if balanceHold.IsNil() {
+		balanceHold = sdk.ZeroDec()
 	}
 
-	return balanceWithBalanceHolds
+	return &types.BalanceWithMarginHold{
+		SubaccountId: balance.SubaccountId,
+		Denom:        balance.Denom,
+		Available:    balance.Deposits.AvailableBalance,
+		Total:        balance.Deposits.TotalBalance,
+		BalanceHold:  balanceHold,
+	}
 }
 
 func (k *Keeper) BalanceWithBalanceHolds(c context.Context, req *types.QueryBalanceWithBalanceHoldsRequest) (*types.QueryBalanceWithBalanceHoldsResponse, error) {
@@ -1019,8 +1224,21 @@ func (k *Keeper) BalanceWithBalanceHolds(c context.Context, req *types.QueryBala
 
 	ctx := sdk.UnwrapSDKContext(c)
 
+	if req.Pagination == nil {
+		return &types.QueryBalanceWithBalanceHoldsResponse{
+			BalanceWithBalanceHolds: k.GetAllBalancesWithBalanceHolds(ctx),
+		}, nil
+	}
+
+	balanceWithBalanceHolds, pageResponse, err := k.GetBalancesWithBalanceHoldsPage(ctx, nil, req.Pagination)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
 	res := &types.QueryBalanceWithBalanceHoldsResponse{
-		BalanceWithBalanceHolds: k.GetAllBalancesWithBalanceHolds(ctx),
+		BalanceWithBalanceHolds: balanceWithBalanceHolds,
+		Pagination:              pageResponse,
 	}
 
 	return res, nil
@@ -1031,26 +1249,35 @@ func (k *Keeper) BalanceMismatches(c context.Context, req *types.QueryBalanceMis
 
 	ctx := sdk.UnwrapSDKContext(c)
 
+	if req.Pagination != nil {
+		isMismatching := func(balanceWithBalanceHold *types.BalanceWithMarginHold) bool {
+			return newBalanceMismatch(balanceWithBalanceHold, req.DustFactor) != nil
+		}
+
+		balancesWithBalanceHolds, pageResponse, err := k.GetBalancesWithBalanceHoldsPage(ctx, isMismatching, req.Pagination)
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, err
+		}
+
+		balanceMismatches := make([]*types.BalanceMismatch, 0, len(balancesWithBalanceHolds))
+		for _, balanceWithBalanceHold := range balancesWithBalanceHolds {
+			balanceMismatches = append(balanceMismatches, newBalanceMismatch(balanceWithBalanceHold, req.DustFactor))
+		}
+
+		return &types.QueryBalanceMismatchesResponse{
+			BalanceMismatches: balanceMismatches,
+			Pagination:        pageResponse,
+		}, nil
+	}
+
 	balancesWithBalanceHolds := k.GetAllBalancesWithBalanceHolds(ctx)
 
 	balanceMismatches := make([]*types.BalanceMismatch, 0)
 
 	for _, balanceWithBalanceHold := range balancesWithBalanceHolds {
-		balanceHold := balanceWithBalanceHold.BalanceHold
-		expectedTotalBalance := balanceWithBalanceHold.Available.Add(balanceHold)
-
-		isMatching := expectedTotalBalance.Sub(balanceWithBalanceHold.Total).Abs().LT(sdk.SmallestDec().MulInt64(req.DustFactor))
-
-		if !isMatching {
-			balanceMismatches = append(balanceMismatches, &types.BalanceMismatch{
-				SubaccountId:  balanceWithBalanceHold.SubaccountId,
-				Denom:         balanceWithBalanceHold.Denom,
-				Available:     balanceWithBalanceHold.Available,
-				Total:         balanceWithBalanceHold.Total,
-				BalanceHold:   balanceHold,
-				ExpectedTotal: expectedTotalBalance,
-				Difference:    expectedTotalBalance.Sub(balanceWithBalanceHold.Total),
-			})
+		if balanceMismatch := newBalanceMismatch(balanceWithBalanceHold, req.DustFactor); balanceMismatch != nil {
+			balanceMismatches = append(balanceMismatches, balanceMismatch)
 		}
 	}
 
@@ -1061,6 +1288,36 @@ func (k *Keeper) BalanceMismatches(c context.Context, req *types.QueryBalanceMis
 	return res, nil
 }
 
+// newBalanceMismatch returns the mismatch between the total balance and the available balance plus the balance hold
+// if it exceeds the dust factor, or nil otherwise.
+func newBalanceMismatch(balanceWithBalanceHold *types.BalanceWithMarginHold, dustFactor int64) *types.BalanceMismatch {
+	balanceHold := balanceWithBalanceHold.BalanceHold
+	expectedTotalBalance := balanceWithBalanceHold.Available.Add(balanceHold)
+
+	isMatching := expectedTotalBalance.Sub(balanceWithBalanceHold.Total).Abs().LT(sdk.SmallestDec().MulInt64(dustFactor))
+	if isMatching {
+		return nil
+	}
+
+	return &types.BalanceMismatch{
+		SubaccountId:  balanceWithBalanceHold.SubaccountId,
+		Denom:         balanceWithBalanceHold.Denom,
+		Available:     balanceWithBalanceHold.Available,
+		Total:         balanceWithBalanceHold.Total,
+		BalanceHold:   balanceHold,
+		ExpectedTotal: expectedTotalBalance,
+		Difference:    expectedTotalBalance.Sub(balanceWithBalanceHold.Total),
+	}
+}
+
+// parseMarketStatusFilter returns the market status to filter the markets by, which is Active if unset.
+func parseMarketStatusFilter(status string) types.MarketStatus {
+	if status == "" {
+		return types.MarketStatus_Active
+	}
+	return types.MarketStatus(types.MarketStatus_value[status])
+}
+
 func (k *Keeper) FeeDiscountTierStatistics(c context.Context, req *types.QueryFeeDiscountTierStatisticsRequest) (*types.QueryFeeDiscountTierStatisticsResponse, error) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
@@ -1195,10 +1452,29 @@ func (k *Keeper) HistoricalTradeRecords(c context.Context, req *types.QueryHisto
 
 	ctx := sdk.UnwrapSDKContext(c)
 
+	if req.Pagination != nil {
+		var marketID *common.Hash
+		if len(req.MarketId) > 0 {
+			hash := common.HexToHash(req.MarketId)
+			marketID = &hash
+		}
+
+		tradeRecords, pageResponse, err := k.GetHistoricalTradeRecordsPage(ctx, marketID, req.Pagination)
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, err
+		}
+
+		return &types.QueryHistoricalTradeRecordsResponse{
+			TradeRecords: tradeRecords,
+			Pagination:   pageResponse,
+		}, nil
+	}
+
 	res := &types.QueryHistoricalTradeRecordsResponse{}
 
 	if len(req.MarketId) > 0 {
-		records, _ := k.GetHistoricalTradeRecords(ctx, common.HexToHash(req.MarketId), 0)
+		records := k.GetHistoricalTradeRecords(ctx, common.HexToHash(req.MarketId), 0)
 		res.TradeRecords = []*types.TradeRecords{records}
 	} else {
 		res.TradeRecords = k.GetAllHistoricalTradeRecords(ctx)
@@ -1219,6 +1495,138 @@ func (k *Keeper) MarketVolatility(c context.Context, req *types.QueryMarketVolat
 	return res, nil
 }
 
+func (k *Keeper) MarketCandles(c context.Context, req *types.QueryMarketCandlesRequest) (*types.QueryMarketCandlesResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	if req.Resolution <= 0 {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrInvalidArgument, "resolution must be positive: %d", req.Resolution)
+	}
+
+	candles := k.GetMarketCandles(sdk.UnwrapSDKContext(c), common.HexToHash(req.MarketId), req.Resolution, req.From, req.To)
+	res := &types.QueryMarketCandlesResponse{
+		Candles: candles,
+	}
+	return res, nil
+}
+
+func (k *Keeper) MarketStats24H(c context.Context, req *types.QueryMarketStats24HRequest) (*types.QueryMarketStats24HResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	return k.GetMarketStats24h(sdk.UnwrapSDKContext(c), common.HexToHash(req.MarketId)), nil
+}
+
+func (k *Keeper) OrderbookDepth(c context.Context, req *types.QueryOrderbookDepthRequest) (*types.QueryOrderbookDepthResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	priceGranularity, depthPercent := req.PriceGranularity, req.DepthPercent
+	if priceGranularity.IsNil() {
+		priceGranularity = sdk.ZeroDec()
+	}
+	if depthPercent.IsNil() {
+		depthPercent = sdk.ZeroDec()
+	}
+
+	if priceGranularity.IsNegative() || depthPercent.IsNegative() {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrInvalidArgument, "price granularity %s and depth percent %s must not be negative", priceGranularity.String(), depthPercent.String())
+	}
+
+	marketID := common.HexToHash(req.MarketId)
+	limit := req.Limit
+	if limit == 0 {
+		limit = types.DefaultQueryOrderbookLimit
+	}
+
+	// binary options markets share the orderbook store of derivative markets
+	isSpot := k.GetSpotMarketByID(ctx, marketID) != nil
+	if !isSpot && k.GetDerivativeOrBinaryOptionsMarket(ctx, marketID, nil) == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrMarketInvalid, "market for marketID %s not found", req.MarketId)
+	}
+
+	return k.GetOrderbookDepth(ctx, isSpot, marketID, priceGranularity, depthPercent, limit), nil
+}
+
+func (k *Keeper) FullSpotOrderbook(c context.Context, req *types.QueryFullSpotOrderbookRequest) (*types.QueryFullOrderbookResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	res, err := k.GetFullSpotOrderbook(sdk.UnwrapSDKContext(c), common.HexToHash(req.MarketId), req.IsBuy, req.Pagination)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (k *Keeper) FullDerivativeOrderbook(c context.Context, req *types.QueryFullDerivativeOrderbookRequest) (*types.QueryFullOrderbookResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	res, err := k.GetFullDerivativeOrderbook(sdk.UnwrapSDKContext(c), common.HexToHash(req.MarketId), req.IsBuy, req.Pagination)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (k *Keeper) TerminalOrdersByHashes(c context.Context, req *types.QueryTerminalOrdersByHashesRequest) (*types.QueryTerminalOrdersByHashesResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	orderHashes := make([]common.Hash, 0, len(req.OrderHashes))
+	for _, orderHash := range req.OrderHashes {
+		orderHashes = append(orderHashes, common.HexToHash(orderHash))
+	}
+
+	res := &types.QueryTerminalOrdersByHashesResponse{
+		Orders: k.GetTerminalOrdersByHashes(sdk.UnwrapSDKContext(c), orderHashes),
+	}
+	return res, nil
+}
+
+func (k *Keeper) SubaccountTerminalOrders(c context.Context, req *types.QuerySubaccountTerminalOrdersRequest) (*types.QuerySubaccountTerminalOrdersResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	var marketID *common.Hash
+	if req.MarketId != "" {
+		id := common.HexToHash(req.MarketId)
+		marketID = &id
+	}
+
+	orders, pageResponse, err := k.GetSubaccountTerminalOrders(sdk.UnwrapSDKContext(c), common.HexToHash(req.SubaccountId), marketID, req.Pagination)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	res := &types.QuerySubaccountTerminalOrdersResponse{
+		Orders:     orders,
+		Pagination: pageResponse,
+	}
+	return res, nil
+}
+
+func (k *Keeper) SubaccountPnl(c context.Context, req *types.QuerySubaccountPnlRequest) (*types.QuerySubaccountPnlResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	subaccountID := common.HexToHash(req.SubaccountId)
+
+	pnls := make([]*types.SubaccountPnl, 0)
+	if req.MarketId == "" {
+		pnls = k.GetSubaccountPnls(ctx, subaccountID)
+	} else if pnl := k.GetSubaccountPnl(ctx, subaccountID, common.HexToHash(req.MarketId)); pnl != nil {
+		pnls = append(pnls, pnl)
+	}
+
+	res := &types.QuerySubaccountPnlResponse{
+		Pnls: pnls,
+	}
+	return res, nil
+}
+
 func (k *Keeper) QueryMarketIDFromVault(c context.Context, req *types.QueryMarketIDFromVaultRequest) (*types.QueryMarketIDFromVaultResponse, error) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
@@ -1239,17 +1647,29 @@ func (k *Keeper) BinaryOptionsMarkets(c context.Context, req *types.QueryBinaryM
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
 	ctx := sdk.UnwrapSDKContext(c)
-	m := k.GetAllBinaryOptionsMarkets(ctx)
+	status := parseMarketStatusFilter(req.Status)
 
-	markets := make([]*types.BinaryOptionsMarket, 0, len(m))
+	if req.Pagination != nil {
+		if status == types.MarketStatus_Unspecified {
+			return &types.QueryBinaryMarketsResponse{Markets: []*types.BinaryOptionsMarket{}}, nil
+		}
 
-	var status types.MarketStatus
-	if req.Status == "" {
-		status = types.MarketStatus_Active
-	} else {
-		status = types.MarketStatus(types.MarketStatus_value[req.Status])
+		markets, pageResponse, err := k.GetBinaryOptionsMarketsPage(ctx, status, req.Pagination)
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, err
+		}
+
+		return &types.QueryBinaryMarketsResponse{
+			Markets:    markets,
+			Pagination: pageResponse,
+		}, nil
 	}
 
+	m := k.GetAllBinaryOptionsMarkets(ctx)
+
+	markets := make([]*types.BinaryOptionsMarket, 0, len(m))
+
 	if status != types.MarketStatus_Unspecified {
 		for _, market := range m {
 			if market.Status == status {
@@ -1265,6 +1685,129 @@ func (k *Keeper) BinaryOptionsMarkets(c context.Context, req *types.QueryBinaryM
 	return res, nil
 }
 
+func (k *Keeper) VanillaOptionsMarkets(c context.Context, req *types.QueryVanillaOptionsMarketsRequest) (*types.QueryVanillaOptionsMarketsResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	status := parseMarketStatusFilter(req.Status)
+
+	if req.Pagination != nil {
+		marketIDHashes := make([]common.Hash, 0, len(req.MarketIds))
+		for _, marketID := range req.MarketIds {
+			marketIDHashes = append(marketIDHashes, common.HexToHash(marketID))
+		}
+
+		markets, pageResponse, err := k.GetVanillaOptionsMarketsPage(ctx, status, marketIDHashes, req.Pagination)
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, err
+		}
+
+		return &types.QueryVanillaOptionsMarketsResponse{
+			Markets:    markets,
+			Pagination: pageResponse,
+		}, nil
+	}
+
+	marketIDs := make(map[string]struct{}, len(req.MarketIds))
+	for _, marketID := range req.MarketIds {
+		marketIDs[common.HexToHash(marketID).Hex()] = struct{}{}
+	}
+
+	m := k.GetAllVanillaOptionsMarkets(ctx)
+	markets := make([]*types.VanillaOptionsMarket, 0, len(m))
+
+	for _, market := range m {
+		if status != types.MarketStatus_Unspecified && market.Status != status {
+			continue
+		}
+
+		if len(marketIDs) > 0 {
+			if _, ok := marketIDs[market.MarketId]; !ok {
+				continue
+			}
+		}
+
+		markets = append(markets, market)
+	}
+
+	res := &types.QueryVanillaOptionsMarketsResponse{
+		Markets: markets,
+	}
+
+	return res, nil
+}
+
+func (k *Keeper) CategoricalMarkets(c context.Context, req *types.QueryCategoricalMarketsRequest) (*types.QueryCategoricalMarketsResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	status := parseMarketStatusFilter(req.Status)
+
+	if req.Pagination != nil {
+		markets, pageResponse, err := k.GetCategoricalMarketsPage(ctx, status, req.Pagination)
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, err
+		}
+
+		return &types.QueryCategoricalMarketsResponse{
+			Markets:    markets,
+			Pagination: pageResponse,
+		}, nil
+	}
+
+	m := k.GetAllCategoricalMarkets(ctx)
+	markets := make([]*types.CategoricalMarket, 0, len(m))
+
+	for _, market := range m {
+		if status != types.MarketStatus_Unspecified && market.Status != status {
+			continue
+		}
+
+		markets = append(markets, market)
+	}
+
+	res := &types.QueryCategoricalMarketsResponse{
+		Markets: markets,
+	}
+
+	return res, nil
+}
+
+func (k *Keeper) ExpiryFuturesMarketSeries(c context.Context, req *types.QueryExpiryFuturesMarketSeriesRequest) (*types.QueryExpiryFuturesMarketSeriesResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QueryExpiryFuturesMarketSeriesResponse{}
+
+	if req.Pagination != nil {
+		series, pageResponse, err := k.GetExpiryFuturesMarketSeriesPage(ctx, req.Pagination)
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, err
+		}
+
+		res.Series = series
+		res.Pagination = pageResponse
+	} else {
+		res.Series = k.GetAllExpiryFuturesMarketSeries(ctx)
+	}
+
+	if req.SubaccountId != "" {
+		subaccountID := common.HexToHash(req.SubaccountId)
+		res.AutoRollSeriesIds = make([]string, 0)
+
+		for _, s := range res.Series {
+			if k.HasExpiryFuturesAutoRoll(ctx, s.SeriesID(), subaccountID) {
+				res.AutoRollSeriesIds = append(res.AutoRollSeriesIds, s.SeriesId)
+			}
+		}
+	}
+
+	return res, nil
+}
+
 func (k *Keeper) TraderDerivativeConditionalOrders(c context.Context, req *types.QueryTraderDerivativeConditionalOrdersRequest) (*types.QueryTraderDerivativeConditionalOrdersResponse, error) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
@@ -1296,3 +1839,11 @@ func (k *Keeper) MarketAtomicExecutionFeeMultiplier(c context.Context, req *type
 	}
 	return &response, nil
 }
+
+func hexToHashes(ids []string) []common.Hash {
+	hashes := make([]common.Hash, 0, len(ids))
+	for _, id := range ids {
+		hashes = append(hashes, common.HexToHash(id))
+	}
+	return hashes
+}
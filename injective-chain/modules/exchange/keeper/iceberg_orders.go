@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// SetSpotIcebergRefill stores an exhausted spot iceberg order until its visible slice is refilled in the next BeginBlocker.
+func (k *Keeper) SetSpotIcebergRefill(ctx sdk.Context, marketID common.Hash, order *types.SpotLimitOrder) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	refillsStore := prefix.NewStore(store, types.SpotIcebergRefillsPrefix)
+	key := types.GetLimitOrderIndexKey(marketID, order.IsBuy(), order.SubaccountID(), order.Hash())
+	refillsStore.Set(key, k.cdc.MustMarshal(order))
+}
+
+// DeleteSpotIcebergRefill deletes an exhausted spot iceberg order from the refill store.
+func (k *Keeper) DeleteSpotIcebergRefill(ctx sdk.Context, marketID common.Hash, order *types.SpotLimitOrder) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	refillsStore := prefix.NewStore(store, types.SpotIcebergRefillsPrefix)
+	refillsStore.Delete(types.GetLimitOrderIndexKey(marketID, order.IsBuy(), order.SubaccountID(), order.Hash()))
+}
+
+// GetAllSpotIcebergRefills returns all exhausted spot iceberg orders awaiting refill, grouped by market and direction.
+func (k *Keeper) GetAllSpotIcebergRefills(ctx sdk.Context) []types.SpotOrderBook {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	refillsStore := prefix.NewStore(store, types.SpotIcebergRefillsPrefix)
+
+	iterator := refillsStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	orderbooks := make([]types.SpotOrderBook, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.SpotLimitOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &order)
+		marketID := common.BytesToHash(iterator.Key()[:common.HashLength]).Hex()
+
+		if last := len(orderbooks) - 1; last >= 0 && orderbooks[last].MarketId == marketID && orderbooks[last].IsBuySide == order.IsBuy() {
+			orderbooks[last].Orders = append(orderbooks[last].Orders, &order)
+			continue
+		}
+
+		orderbooks = append(orderbooks, types.SpotOrderBook{
+			MarketId:  marketID,
+			IsBuySide: order.IsBuy(),
+			Orders:    []*types.SpotLimitOrder{&order},
+		})
+	}
+	return orderbooks
+}
+
+// SetDerivativeIcebergRefill stores an exhausted derivative iceberg order until its visible slice is refilled in the next BeginBlocker.
+func (k *Keeper) SetDerivativeIcebergRefill(ctx sdk.Context, marketID common.Hash, order *types.DerivativeLimitOrder) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	refillsStore := prefix.NewStore(store, types.DerivativeIcebergRefillsPrefix)
+	key := types.GetLimitOrderIndexKey(marketID, order.IsBuy(), order.SubaccountID(), order.Hash())
+	refillsStore.Set(key, k.cdc.MustMarshal(order))
+}
+
+// DeleteDerivativeIcebergRefill deletes an exhausted derivative iceberg order from the refill store.
+func (k *Keeper) DeleteDerivativeIcebergRefill(ctx sdk.Context, marketID common.Hash, order *types.DerivativeLimitOrder) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	refillsStore := prefix.NewStore(store, types.DerivativeIcebergRefillsPrefix)
+	refillsStore.Delete(types.GetLimitOrderIndexKey(marketID, order.IsBuy(), order.SubaccountID(), order.Hash()))
+}
+
+// GetAllDerivativeIcebergRefills returns all exhausted derivative iceberg orders awaiting refill, grouped by market and direction.
+func (k *Keeper) GetAllDerivativeIcebergRefills(ctx sdk.Context) []types.DerivativeOrderBook {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	refillsStore := prefix.NewStore(store, types.DerivativeIcebergRefillsPrefix)
+
+	iterator := refillsStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	orderbooks := make([]types.DerivativeOrderBook, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.DerivativeLimitOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &order)
+		marketID := common.BytesToHash(iterator.Key()[:common.HashLength]).Hex()
+
+		if last := len(orderbooks) - 1; last >= 0 && orderbooks[last].MarketId == marketID && orderbooks[last].IsBuySide == order.IsBuy() {
+			orderbooks[last].Orders = append(orderbooks[last].Orders, &order)
+			continue
+		}
+
+		orderbooks = append(orderbooks, types.DerivativeOrderBook{
+			MarketId:  marketID,
+			IsBuySide: order.IsBuy(),
+			Orders:    []*types.DerivativeLimitOrder{&order},
+		})
+	}
+	return orderbooks
+}
+
+// ProcessIcebergOrderRefills refills the visible slice of the iceberg orders exhausted in the previous block from their
+// hidden reserve. Each refill is placed as a new order with a new order hash, so it loses its time priority and is
+// matched as a new order in this block's EndBlocker.
+func (k *Keeper) ProcessIcebergOrderRefills(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	for _, orderbook := range k.GetAllSpotIcebergRefills(ctx) {
+		marketID := common.HexToHash(orderbook.MarketId)
+		for _, order := range orderbook.Orders {
+			k.refillSpotIcebergOrder(ctx, marketID, order)
+		}
+	}
+
+	for _, orderbook := range k.GetAllDerivativeIcebergRefills(ctx) {
+		marketID := common.HexToHash(orderbook.MarketId)
+		for _, order := range orderbook.Orders {
+			k.refillDerivativeIcebergOrder(ctx, marketID, order)
+		}
+	}
+}
+
+func (k *Keeper) refillSpotIcebergOrder(ctx sdk.Context, marketID common.Hash, order *types.SpotLimitOrder) {
+	k.DeleteSpotIcebergRefill(ctx, marketID, order)
+
+	// release the hidden reserve hold, the refill is charged again like any new order
+	market := k.GetSpotMarketByID(ctx, marketID)
+	hiddenBalanceHold, marginDenom := order.GetUnfilledMarginHoldAndMarginDenom(market, false)
+	k.incrementAvailableBalanceOrBank(ctx, order.SubaccountID(), marginDenom, hiddenBalanceHold)
+
+	var (
+		orderHash common.Hash
+		err       error
+	)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if market.IsActive() {
+		orderHash, err = k.createSpotLimitOrder(cacheCtx, order.SdkAccAddress(), order.GetIcebergRefillOrder(marketID), market)
+	} else {
+		err = types.ErrSpotMarketNotFound
+	}
+
+	if err != nil {
+		k.Logger(ctx).Debug("failed to refill spot iceberg order", "marketID", marketID.Hex(), "orderHash", order.Hash().Hex(), "err", err.Error())
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventCancelSpotOrder{
+			MarketId: marketID.Hex(),
+			Order:    *order,
+		})
+		return
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventIcebergOrderRefill{
+		MarketId:           marketID.Bytes(),
+		SubaccountId:       order.OrderInfo.SubaccountId,
+		ExhaustedOrderHash: order.OrderHash,
+		PlacedOrderHash:    orderHash.Bytes(),
+	})
+}
+
+func (k *Keeper) refillDerivativeIcebergOrder(ctx sdk.Context, marketID common.Hash, order *types.DerivativeLimitOrder) {
+	k.DeleteDerivativeIcebergRefill(ctx, marketID, order)
+
+	market := k.GetDerivativeOrBinaryOptionsMarket(ctx, marketID, nil)
+
+	// release the hidden reserve hold, the refill is charged again like any new order
+	k.incrementAvailableBalanceOrBank(ctx, order.SubaccountID(), market.GetQuoteDenom(), order.GetHiddenBalanceHold())
+
+	refillOrder := order.GetIcebergRefillOrder(marketID)
+
+	var (
+		orderHash common.Hash
+		err       error
+		markPrice sdk.Dec
+	)
+
+	if market.GetMarketType().IsFullyCollateralized() {
+		refillOrder.Margin = refillOrder.GetRequiredFullyCollateralizedMargin(market.GetMaxPrice())
+	} else {
+		_, markPrice = k.GetDerivativeMarketWithMarkPrice(ctx, marketID, true)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if market.GetMarketStatus() == types.MarketStatus_Active && (market.GetMarketType().IsFullyCollateralized() || !markPrice.IsNil()) {
+		orderHash, err = k.placeDerivativeLimitOrder(cacheCtx, order.SdkAccAddress(), refillOrder, market, markPrice)
+	} else {
+		err = types.ErrDerivativeMarketNotFound
+	}
+
+	if err != nil {
+		k.Logger(ctx).Debug("failed to refill derivative iceberg order", "marketID", marketID.Hex(), "orderHash", order.Hash().Hex(), "err", err.Error())
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventCancelDerivativeOrder{
+			MarketId:      marketID.Hex(),
+			IsLimitCancel: true,
+			LimitOrder:    order,
+		})
+		return
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventIcebergOrderRefill{
+		MarketId:           marketID.Bytes(),
+		SubaccountId:       order.OrderInfo.SubaccountId,
+		ExhaustedOrderHash: order.OrderHash,
+		PlacedOrderHash:    orderHash.Bytes(),
+	})
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+type LadderMsgServer struct {
+	Keeper
+	svcTags metrics.Tags
+}
+
+// NewLadderMsgServerImpl returns an implementation of the exchange MsgServer interface for the provided Keeper for ladder order functions.
+func NewLadderMsgServerImpl(keeper Keeper) LadderMsgServer {
+	return LadderMsgServer{
+		Keeper: keeper,
+		svcTags: metrics.Tags{
+			"svc": "ladder_msg_h",
+		},
+	}
+}
+
+func (k LadderMsgServer) CreateLadderOrders(goCtx context.Context, msg *types.MsgCreateLadderOrders) (*types.MsgCreateLadderOrdersResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	hashes, err := k.createLadderOrders(ctx, sender, msg)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	orderHashes := make([]string, len(hashes))
+	for idx, hash := range hashes {
+		orderHashes[idx] = hash.Hex()
+	}
+
+	return &types.MsgCreateLadderOrdersResponse{
+		OrderHashes: orderHashes,
+	}, nil
+}
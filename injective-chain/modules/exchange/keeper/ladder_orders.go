@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// createLadderOrders places every level of the ladder as a limit order. The ladder is placed atomically: if any of its
+// orders can't be placed or funded, the whole message fails and none of the orders is created.
+func (k *Keeper) createLadderOrders(ctx sdk.Context, sender sdk.AccAddress, msg *types.MsgCreateLadderOrders) ([]common.Hash, error) {
+	marketID := msg.MarketID()
+
+	if spotMarket := k.GetSpotMarket(ctx, marketID, true); spotMarket != nil {
+		if !msg.Margin.IsZero() {
+			return nil, sdkerrors.Wrap(types.ErrInvalidLadderOrder, "spot ladder orders can't have a margin")
+		}
+
+		if err := msg.CheckPriceStep(spotMarket.MinPriceTickSize); err != nil {
+			return nil, err
+		}
+
+		spotOrders, err := msg.ToSpotOrders(spotMarket.MinQuantityTickSize)
+		if err != nil {
+			return nil, err
+		}
+
+		orderHashes := make([]common.Hash, len(spotOrders))
+		for idx := range spotOrders {
+			if orderHashes[idx], err = k.createSpotLimitOrder(ctx, sender, &spotOrders[idx], spotMarket); err != nil {
+				return nil, err
+			}
+		}
+		return orderHashes, nil
+	}
+
+	market, markPrice := k.GetDerivativeMarketWithMarkPrice(ctx, marketID, true)
+	if market == nil || markPrice.IsNil() {
+		return nil, sdkerrors.Wrapf(types.ErrMarketInvalid, "active spot or derivative market for marketID %s not found", msg.MarketId)
+	}
+
+	if err := msg.CheckPriceStep(market.MinPriceTickSize); err != nil {
+		return nil, err
+	}
+
+	derivativeOrders, err := msg.ToDerivativeOrders(market.MinQuantityTickSize)
+	if err != nil {
+		return nil, err
+	}
+
+	orderHashes := make([]common.Hash, len(derivativeOrders))
+	for idx := range derivativeOrders {
+		if orderHashes[idx], err = k.createDerivativeLimitOrder(ctx, sender, &derivativeOrders[idx], market, markPrice); err != nil {
+			return nil, err
+		}
+	}
+	return orderHashes, nil
+}
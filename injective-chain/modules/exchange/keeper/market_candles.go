@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+const secondsPerDay = 60 * 60 * 24
+
+// GetMarketCandles aggregates the trade records of a market from `from` until `to` (exclusive, unbounded if zero) into
+// OHLCV candles of `resolution` seconds. Candles without any trade are omitted.
+func (k *Keeper) GetMarketCandles(ctx sdk.Context, marketID common.Hash, resolution, from, to int64) []*types.Candle {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	candles := make([]*types.Candle, 0)
+
+	k.IterateMarketTradeRecords(ctx, marketID, from, to, func(tradeRecord *types.TradeRecord) (stop bool) {
+		candleTimestamp := tradeRecord.Timestamp - tradeRecord.Timestamp%resolution
+		if len(candles) > 0 && candles[len(candles)-1].Timestamp == candleTimestamp {
+			applyTradeRecordToCandle(candles[len(candles)-1], tradeRecord)
+			return false
+		}
+		candles = append(candles, newCandle(candleTimestamp, tradeRecord))
+		return false
+	})
+	return candles
+}
+
+// GetMarketStats24h returns the statistics of a market over the trade records of the last 24 hours, or over the retention
+// of the market if it's shorter.
+func (k *Keeper) GetMarketStats24h(ctx sdk.Context, marketID common.Hash) *types.QueryMarketStats24HResponse {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	stats := &types.QueryMarketStats24HResponse{
+		LastPrice:          sdk.ZeroDec(),
+		High:               sdk.ZeroDec(),
+		Low:                sdk.ZeroDec(),
+		BaseVolume:         sdk.ZeroDec(),
+		QuoteVolume:        sdk.ZeroDec(),
+		PriceChange:        sdk.ZeroDec(),
+		PriceChangePercent: sdk.ZeroDec(),
+	}
+
+	tradeRecords := k.GetHistoricalTradeRecords(ctx, marketID, ctx.BlockTime().Unix()-secondsPerDay)
+	if len(tradeRecords.LatestTradeRecords) == 0 {
+		return stats
+	}
+
+	candle := newCandle(tradeRecords.LatestTradeRecords[0].Timestamp, tradeRecords.LatestTradeRecords[0])
+	for _, tradeRecord := range tradeRecords.LatestTradeRecords[1:] {
+		applyTradeRecordToCandle(candle, tradeRecord)
+	}
+
+	stats.LastPrice = candle.Close
+	stats.High = candle.High
+	stats.Low = candle.Low
+	stats.BaseVolume = candle.BaseVolume
+	stats.QuoteVolume = candle.QuoteVolume
+	stats.PriceChange = candle.Close.Sub(candle.Open)
+
+	if candle.Open.IsPositive() {
+		stats.PriceChangePercent = stats.PriceChange.Quo(candle.Open).MulInt64(100)
+	}
+	return stats
+}
+
+func newCandle(timestamp int64, tradeRecord *types.TradeRecord) *types.Candle {
+	return &types.Candle{
+		Timestamp:   timestamp,
+		Open:        tradeRecord.Price,
+		High:        tradeRecord.Price,
+		Low:         tradeRecord.Price,
+		Close:       tradeRecord.Price,
+		BaseVolume:  tradeRecord.Quantity,
+		QuoteVolume: tradeRecord.Price.Mul(tradeRecord.Quantity),
+	}
+}
+
+func applyTradeRecordToCandle(candle *types.Candle, tradeRecord *types.TradeRecord) {
+	candle.High = sdk.MaxDec(candle.High, tradeRecord.Price)
+	candle.Low = sdk.MinDec(candle.Low, tradeRecord.Price)
+	candle.Close = tradeRecord.Price
+	candle.BaseVolume = candle.BaseVolume.Add(tradeRecord.Quantity)
+	candle.QuoteVolume = candle.QuoteVolume.Add(tradeRecord.Price.Mul(tradeRecord.Quantity))
+}
@@ -24,6 +24,7 @@ type MarketI interface {
 	GetOracleScaleFactor() uint32
 	StatusSupportsOrderCancellations() bool
 	GetMarketStatus() types.MarketStatus
+	GetMaxPrice() sdk.Dec
 }
 
 type MarketIDQuoteDenomMakerFee struct {
@@ -35,14 +36,18 @@ type MarketIDQuoteDenomMakerFee struct {
 func (k *Keeper) GetAllDerivativeAndBinaryOptionsMarkets(ctx sdk.Context) []MarketI {
 	derivativeMarkets := k.GetAllDerivativeMarkets(ctx)
 	binaryOptionsMarkets := k.GetAllBinaryOptionsMarkets(ctx)
+	vanillaOptionsMarkets := k.GetAllVanillaOptionsMarkets(ctx)
 
-	markets := make([]MarketI, 0, len(derivativeMarkets)+len(binaryOptionsMarkets))
+	markets := make([]MarketI, 0, len(derivativeMarkets)+len(binaryOptionsMarkets)+len(vanillaOptionsMarkets))
 	for _, m := range derivativeMarkets {
 		markets = append(markets, m)
 	}
 	for _, m := range binaryOptionsMarkets {
 		markets = append(markets, m)
 	}
+	for _, m := range vanillaOptionsMarkets {
+		markets = append(markets, m)
+	}
 
 	return markets
 }
@@ -64,6 +69,10 @@ func (k *Keeper) GetDerivativeOrBinaryOptionsMarket(ctx sdk.Context, marketID co
 		return market
 	}
 
+	if market := k.GetVanillaOptionsMarket(ctx, marketID, isEnabledToCheck); market != nil {
+		return market
+	}
+
 	// stop early
 	if shouldOnlyCheckOneStatus {
 		return nil
@@ -80,13 +89,17 @@ func (k *Keeper) GetDerivativeOrBinaryOptionsMarket(ctx sdk.Context, marketID co
 		return market
 	}
 
+	if market := k.GetVanillaOptionsMarket(ctx, marketID, isEnabledToCheck); market != nil {
+		return market
+	}
+
 	return nil
 }
 
 func (k *Keeper) GetDerivativeOrBinaryOptionsMarketWithMarkPrice(ctx sdk.Context, marketID common.Hash, isEnabled bool) (MarketI, sdk.Dec) {
 	derivativeMarket := k.GetDerivativeMarket(ctx, marketID, isEnabled)
 	if derivativeMarket != nil {
-		price, err := k.GetDerivativeMarketPrice(ctx, derivativeMarket.OracleBase, derivativeMarket.OracleQuote, derivativeMarket.OracleScaleFactor, derivativeMarket.OracleType)
+		price, err := k.GetDerivativeMarketMarkPrice(ctx, derivativeMarket)
 		if err != nil {
 			return nil, sdk.Dec{}
 		}
@@ -99,12 +112,19 @@ func (k *Keeper) GetDerivativeOrBinaryOptionsMarketWithMarkPrice(ctx sdk.Context
 		oraclePrice := k.OracleKeeper.GetProviderPrice(ctx, binaryOptionsMarket.OracleProvider, binaryOptionsMarket.OracleSymbol)
 
 		if oraclePrice != nil {
-			return binaryOptionsMarket, *oraclePrice
+			return binaryOptionsMarket, binaryOptionsMarket.GetMarkPrice(*oraclePrice)
 		}
 
 		return binaryOptionsMarket, sdk.Dec{}
 	}
 
+	// the oracle price of a vanilla options market is the price of its underlying rather than of its contracts, so
+	// there's no mark price to return
+	vanillaOptionsMarket := k.GetVanillaOptionsMarket(ctx, marketID, isEnabled)
+	if vanillaOptionsMarket != nil {
+		return vanillaOptionsMarket, sdk.Dec{}
+	}
+
 	return nil, sdk.Dec{}
 }
 
@@ -112,8 +132,9 @@ func (k *Keeper) GetAllMarketIDsWithQuoteDenoms(ctx sdk.Context) []*MarketIDQuot
 	derivativeMarkets := k.GetAllDerivativeMarkets(ctx)
 	spotMarkets := k.GetAllSpotMarkets(ctx)
 	binaryOptionsMarkets := k.GetAllBinaryOptionsMarkets(ctx)
+	vanillaOptionsMarkets := k.GetAllVanillaOptionsMarkets(ctx)
 
-	marketIDQuoteDenoms := make([]*MarketIDQuoteDenomMakerFee, 0, len(derivativeMarkets)+len(spotMarkets)+len(binaryOptionsMarkets))
+	marketIDQuoteDenoms := make([]*MarketIDQuoteDenomMakerFee, 0, len(derivativeMarkets)+len(spotMarkets)+len(binaryOptionsMarkets)+len(vanillaOptionsMarkets))
 
 	for _, m := range derivativeMarkets {
 		marketIDQuoteDenoms = append(marketIDQuoteDenoms, &MarketIDQuoteDenomMakerFee{
@@ -139,6 +160,14 @@ func (k *Keeper) GetAllMarketIDsWithQuoteDenoms(ctx sdk.Context) []*MarketIDQuot
 		})
 	}
 
+	for _, m := range vanillaOptionsMarkets {
+		marketIDQuoteDenoms = append(marketIDQuoteDenoms, &MarketIDQuoteDenomMakerFee{
+			MarketID:   m.MarketID(),
+			QuoteDenom: m.QuoteDenom,
+			MakerFee:   m.MakerFeeRate,
+		})
+	}
+
 	return marketIDQuoteDenoms
 }
 
@@ -209,5 +238,10 @@ func (k *Keeper) GetMarketType(ctx sdk.Context, marketID common.Hash) (*types.Ma
 		tp := types.MarketType_BinaryOption
 		return &tp, nil
 	}
+	isVanillaMarket := k.HasVanillaOptionsMarket(ctx, marketID, true)
+	if isVanillaMarket {
+		tp := types.MarketType_VanillaOption
+		return &tp, nil
+	}
 	return nil, types.ErrMarketInvalid.Wrapf("Market with id: %v doesn't exist or is not active", marketID)
 }
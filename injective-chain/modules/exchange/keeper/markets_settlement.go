@@ -25,7 +25,12 @@ func (k *Keeper) ProcessMarketsScheduledToSettle(ctx sdk.Context) {
 		market := k.GetDerivativeMarketByID(ctx, marketID)
 
 		if marketSettlementInfo.SettlementPrice.IsZero() {
-			latestPrice := k.OracleKeeper.GetPrice(ctx, market.OracleType, market.OracleBase, market.OracleQuote)
+			var latestPrice *sdk.Dec
+			if market.IsPreLaunch() {
+				latestPrice, _ = k.GetPreLaunchPerpetualMarkPrice(ctx, marketID)
+			} else {
+				latestPrice = k.OracleKeeper.GetPrice(ctx, market.OracleType, market.OracleBase, market.OracleQuote)
+			}
 			marketSettlementInfo.SettlementPrice = *latestPrice
 		}
 
@@ -132,6 +137,9 @@ func (k *Keeper) ProcessMatureExpiryFutureMarkets(ctx sdk.Context) {
 		marketID := common.HexToHash(marketInfo.MarketId)
 		market := markets[marketID]
 
+		// the positions to roll into the next contract of the series have to be read before they are closed
+		rolls := k.getExpiryFuturesRolls(ctx, marketID, marketInfo.ExpirationTimestamp)
+
 		closingFeeWhenSettlingTimeExpiryMarket := market.TakerFeeRate
 		k.SettleMarket(ctx, market, closingFeeWhenSettlingTimeExpiryMarket, &marketInfo.SettlementPrice)
 
@@ -140,6 +148,10 @@ func (k *Keeper) ProcessMatureExpiryFutureMarkets(ctx sdk.Context) {
 
 		k.DeleteExpiryFuturesMarketInfoByTimestamp(ctx, marketID, marketInfo.ExpirationTimestamp)
 		k.DeleteExpiryFuturesMarketInfo(ctx, marketID)
+
+		if rolls != nil {
+			k.executeExpiryFuturesRolls(ctx, rolls, marketID, marketInfo.SettlementPrice)
+		}
 	}
 }
 
@@ -227,9 +239,9 @@ func getTotalMarginAndQuantity(positions []*types.DerivativePosition) (sdk.Dec,
 
 func getBinaryOptionsSocializedLossData(positions []*types.DerivativePosition, market MarketI) SocializedLossData {
 	// liabilities =  ∑ (margin)
-	// assets = 10^oracleScaleFactor * ∑ (quantity) / 2
+	// assets = maxPrice * ∑ (quantity) / 2, i.e. 10^oracleScaleFactor * ∑ (quantity) / 2 for binary options
 	totalMarginLiabilities, totalQuantity := getTotalMarginAndQuantity(positions)
-	assets := types.GetScaledPrice(totalQuantity, market.GetOracleScaleFactor()).Quo(sdk.NewDec(2))
+	assets := market.GetMaxPrice().Mul(totalQuantity).Quo(sdk.NewDec(2))
 
 	// all positions receive haircut in BO refunds
 	positionsReceivingHaircut := make([]*types.Position, len(positions))
@@ -268,7 +280,7 @@ func (k *Keeper) executeSocializedLoss(
 	marketType := market.GetMarketType()
 
 	hasPotentialDeficit := len(positions) > 0 &&
-		(!marketType.IsBinaryOptions() || settlementPrice == types.BinaryOptionsMarketRefundFlagPrice)
+		(!marketType.IsFullyCollateralized() || settlementPrice == types.BinaryOptionsMarketRefundFlagPrice)
 
 	if !hasPotentialDeficit {
 		return make([]DeficitPositions, 0)
@@ -276,7 +288,7 @@ func (k *Keeper) executeSocializedLoss(
 
 	var socializedLossData SocializedLossData
 
-	if marketType.IsBinaryOptions() {
+	if marketType.IsFullyCollateralized() {
 		socializedLossData = getBinaryOptionsSocializedLossData(positions, market)
 	} else {
 		socializedLossData = getDerivativeSocializedLossData(
@@ -309,7 +321,7 @@ func (k *Keeper) executeSocializedLoss(
 	}
 
 	canTakeHaircutFromProfits := socializedLossData.TotalProfits.IsPositive()
-	canProfitsCoverDeficit := socializedLossData.TotalProfits.GTE(deficitAmountAfterInsuranceFunds) || marketType.IsBinaryOptions()
+	canProfitsCoverDeficit := socializedLossData.TotalProfits.GTE(deficitAmountAfterInsuranceFunds) || marketType.IsFullyCollateralized()
 
 	if canTakeHaircutFromProfits {
 		var deficitTakenFromProfits sdk.Dec
@@ -321,8 +333,8 @@ func (k *Keeper) executeSocializedLoss(
 		}
 
 		for _, positionsReceivingHaircut := range socializedLossData.PositionsReceivingHaircut {
-			if marketType.IsBinaryOptions() {
-				positionsReceivingHaircut.ApplyProfitHaircutForBinaryOptions(deficitTakenFromProfits, socializedLossData.TotalProfits, market.GetOracleScaleFactor())
+			if marketType.IsFullyCollateralized() {
+				positionsReceivingHaircut.ApplyProfitHaircutForFullyCollateralizedMarkets(deficitTakenFromProfits, socializedLossData.TotalProfits, market.GetMaxPrice())
 			} else {
 				positionsReceivingHaircut.ApplyProfitHaircutForDerivatives(deficitTakenFromProfits, socializedLossData.TotalProfits, settlementPrice)
 			}
@@ -406,6 +418,7 @@ func (k *Keeper) closeAllPositionsWithSettlePrice(
 		}
 
 		subaccountID := common.HexToHash(position.SubaccountId)
+		positionBeforeClose := position.Position.Copy()
 		var (
 			payout          sdk.Dec
 			closeTradingFee sdk.Dec
@@ -427,6 +440,7 @@ func (k *Keeper) closeAllPositionsWithSettlePrice(
 			OrderHash:           common.Hash{}.Bytes(),
 			PositionDelta:       positionDelta,
 			FeeRecipientAddress: common.Address{}.Bytes(),
+			Pnl:                 positionBeforeClose.GetRealizedPnl(positionDelta),
 		}
 
 		if position.Position.IsLong {
@@ -443,7 +457,7 @@ func (k *Keeper) closeAllPositionsWithSettlePrice(
 		cumulativeFunding = marketFunding.CumulativeFunding
 	}
 
-	wasMarketLiquidation := closingFeeRate.IsZero() && market.GetMarketType() != types.MarketType_BinaryOption
+	wasMarketLiquidation := closingFeeRate.IsZero() && !market.GetMarketType().IsFullyCollateralized()
 	var executionType types.ExecutionType
 
 	if wasMarketLiquidation {
@@ -469,6 +483,9 @@ func (k *Keeper) closeAllPositionsWithSettlePrice(
 		CumulativeFunding: &cumulativeFunding,
 	}
 
+	k.RecordDerivativeTradesPnl(ctx, closingBuyTradeEvents)
+	k.RecordDerivativeTradesPnl(ctx, closingSellTradeEvents)
+
 	// nolint:errcheck //ignored on purpose
 	ctx.EventManager().EmitTypedEvent(closingBuyTradeEvents)
 	// nolint:errcheck //ignored on purpose
@@ -497,7 +514,7 @@ func (k *Keeper) SettleMarket(
 	marketFunding := k.GetPerpetualMarketFunding(ctx, marketID)
 
 	// no need to cancel transient orders since SettleMarket only runs in the BeginBlocker
-	k.CancelAllRestingDerivativeLimitOrders(ctx, market)
+	k.CancelAllRestingDerivativeLimitOrders(ctx, market, types.OrderTerminationReason_MarketExpiry)
 	k.CancelAllConditionalDerivativeOrders(ctx, market)
 
 	deficitPositions := k.executeSocializedLoss(ctx, market, marketFunding, derivativePositions, *settlementPrice, closingFeeRate)
@@ -10,8 +10,14 @@ type MsgServer struct {
 	SpotMsgServer
 	DerivativesMsgServer
 	BinaryOptionsMsgServer
+	VanillaOptionsMsgServer
+	CategoricalMsgServer
 	AccountsMsgServer
 	WasmMsgServer
+	TWAPMsgServer
+	LadderMsgServer
+	RFQMsgServer
+	SignedOrdersMsgServer
 	Keeper
 	svcTags metrics.Tags
 }
@@ -20,12 +26,18 @@ type MsgServer struct {
 // for the provided Keeper.
 func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 	return &MsgServer{
-		SpotMsgServer:          NewSpotMsgServerImpl(keeper),
-		DerivativesMsgServer:   NewDerivativesMsgServerImpl(keeper),
-		BinaryOptionsMsgServer: NewBinaryOptionsMsgServerImpl(keeper),
-		AccountsMsgServer:      AccountsMsgServerImpl(keeper),
-		WasmMsgServer:          NewWasmMsgServerImpl(keeper),
-		Keeper:                 keeper,
+		SpotMsgServer:           NewSpotMsgServerImpl(keeper),
+		DerivativesMsgServer:    NewDerivativesMsgServerImpl(keeper),
+		BinaryOptionsMsgServer:  NewBinaryOptionsMsgServerImpl(keeper),
+		VanillaOptionsMsgServer: NewVanillaOptionsMsgServerImpl(keeper),
+		CategoricalMsgServer:    NewCategoricalMsgServerImpl(keeper),
+		AccountsMsgServer:       AccountsMsgServerImpl(keeper),
+		WasmMsgServer:           NewWasmMsgServerImpl(keeper),
+		TWAPMsgServer:           NewTWAPMsgServerImpl(keeper),
+		LadderMsgServer:         NewLadderMsgServerImpl(keeper),
+		RFQMsgServer:            NewRFQMsgServerImpl(keeper),
+		SignedOrdersMsgServer:   NewSignedOrdersMsgServerImpl(keeper),
+		Keeper:                  keeper,
 		svcTags: metrics.Tags{
 			"svc": "exchange_h",
 		},
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// createMultiLegOrder executes every leg of a multi-leg order as an atomic market order through the regular spot and
+// derivative execution. The legs are charged one after the other from the same subaccount, so the subaccount must be
+// able to fund the margin and fees of all of them combined. An error in any leg, a leg not filled for its full quantity
+// or a net price above the limit fails the message, which reverts the legs executed before.
+func (k *Keeper) createMultiLegOrder(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	msg *types.MsgCreateMultiLegOrder,
+) (*types.MsgCreateMultiLegOrderResponse, error) {
+	var (
+		subaccountID = types.MustGetSubaccountIDOrDeriveFromNonce(sender, msg.SubaccountId)
+		legResults   = make([]types.MultiLegOrderLegResult, 0, len(msg.Legs))
+		netPrice     = sdk.ZeroDec()
+	)
+
+	// the legs are executed immediately rather than in the batch auction, so they're restricted like atomic orders
+	if err := k.ensureValidAccessLevelForAtomicExecution(ctx, sender); err != nil {
+		return nil, err
+	}
+
+	for idx := range msg.Legs {
+		leg := &msg.Legs[idx]
+		legQuantity := leg.GetQuantity(msg.Quantity)
+
+		legResult, err := k.executeMultiLegOrderLeg(ctx, sender, subaccountID.Hex(), msg.FeeRecipient, leg, msg.Quantity)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "leg %d in market %s", idx, leg.MarketId)
+		}
+
+		if !legResult.Quantity.Equal(legQuantity) {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, sdkerrors.Wrapf(types.ErrNoLiquidity, "leg %d in market %s filled %s of %s", idx, leg.MarketId, legResult.Quantity, legQuantity)
+		}
+
+		netPrice = netPrice.Add(leg.GetNetPriceContribution(legResult.Price))
+		legResults = append(legResults, *legResult)
+	}
+
+	if netPrice.GT(msg.NetPrice) {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrSlippageExceedsWorstPrice, "net price %s exceeds the limit %s", netPrice, msg.NetPrice)
+	}
+
+	marketIDs := make([]string, 0, len(legResults))
+	orderHashes := make([]string, 0, len(legResults))
+	for _, legResult := range legResults {
+		marketIDs = append(marketIDs, legResult.MarketId)
+		orderHashes = append(orderHashes, legResult.OrderHash)
+	}
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventMultiLegOrder{
+		SubaccountId: subaccountID.Hex(),
+		MarketIds:    marketIDs,
+		OrderHashes:  orderHashes,
+		Quantity:     msg.Quantity,
+		NetPrice:     netPrice,
+	})
+
+	return &types.MsgCreateMultiLegOrderResponse{
+		Legs:     legResults,
+		NetPrice: netPrice,
+	}, nil
+}
+
+func (k *Keeper) executeMultiLegOrderLeg(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	subaccountID string,
+	feeRecipient string,
+	leg *types.MultiLegOrderLeg,
+	quantity sdk.Dec,
+) (*types.MultiLegOrderLegResult, error) {
+	marketID := leg.MarketID()
+
+	if spotMarket := k.GetSpotMarket(ctx, marketID, true); spotMarket != nil {
+		if !leg.Margin.IsZero() {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, sdkerrors.Wrap(types.ErrInvalidMultiLegOrder, "spot legs cannot have margin")
+		}
+
+		spotOrder := leg.ToSpotOrder(subaccountID, feeRecipient, quantity)
+		orderHash, results, err := k.createSpotMarketOrder(ctx, sender, spotOrder, spotMarket)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.MultiLegOrderLegResult{
+			MarketId:  leg.MarketId,
+			OrderHash: orderHash.Hex(),
+			Quantity:  results.Quantity,
+			Price:     results.Price,
+			Fee:       results.Fee,
+		}, nil
+	}
+
+	derivativeMarket, markPrice := k.GetDerivativeMarketWithMarkPrice(ctx, marketID, true)
+	if derivativeMarket == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrMarketInvalid, "active spot or derivative market for marketID %s not found", leg.MarketId)
+	}
+
+	derivativeOrder := leg.ToDerivativeOrder(subaccountID, feeRecipient, quantity)
+	orderHash, results, err := k.createDerivativeMarketOrder(ctx, sender, derivativeOrder, derivativeMarket, markPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MultiLegOrderLegResult{
+		MarketId:  leg.MarketId,
+		OrderHash: orderHash.Hex(),
+		Quantity:  results.Quantity,
+		Price:     results.Price,
+		Fee:       results.Fee,
+	}, nil
+}
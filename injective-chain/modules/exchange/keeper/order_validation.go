@@ -53,7 +53,7 @@ func (k *Keeper) ensureValidDerivativeOrder(
 	}
 
 	// check that market exists and has mark price (except for non-conditional binary options)
-	isMissingRequiredMarkPrice := (!marketType.IsBinaryOptions() || derivativeOrder.IsConditional()) && markPrice.IsNil()
+	isMissingRequiredMarkPrice := (!marketType.IsFullyCollateralized() || derivativeOrder.IsConditional()) && markPrice.IsNil()
 	if market == nil || isMissingRequiredMarkPrice {
 		k.Logger(ctx).Debug("active market with valid mark price doesn't exist", "marketId", derivativeOrder.MarketId, "mark price", markPrice)
 		return orderHash, sdkerrors.Wrapf(types.ErrDerivativeMarketNotFound, "active derivative market for marketID %s not found", derivativeOrder.MarketId)
@@ -68,8 +68,8 @@ func (k *Keeper) ensureValidDerivativeOrder(
 	}
 
 	// check binary options max order prices
-	if marketType.IsBinaryOptions() {
-		if err := derivativeOrder.CheckBinaryOptionsPricesWithinBounds(market.GetOracleScaleFactor()); err != nil {
+	if marketType.IsFullyCollateralized() {
+		if err := derivativeOrder.CheckFullyCollateralizedPricesWithinBounds(market.GetMaxPrice()); err != nil {
 			return orderHash, err
 		}
 	}
@@ -154,7 +154,7 @@ func (k *Keeper) ensureValidDerivativeOrder(
 		if derivativeOrder.IsConditional() {
 			markPriceToCheck = *derivativeOrder.TriggerPrice // for conditionals triggerprice == mark price at the point in the future when the order will materialise
 		}
-		marginHold, err := derivativeOrder.CheckMarginAndGetMarginHold(market.GetInitialMarginRatio(), markPriceToCheck, tradeFeeRate, marketType, market.GetOracleScaleFactor())
+		marginHold, err := derivativeOrder.CheckMarginAndGetMarginHold(market.GetInitialMarginRatio(), markPriceToCheck, tradeFeeRate, marketType, market.GetMaxPrice())
 		if err != nil {
 			return orderHash, err
 		}
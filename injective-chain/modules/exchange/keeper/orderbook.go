@@ -81,6 +81,9 @@ func (k *Keeper) SetOrderbookPriceLevelQuantity(
 	//// set transient store value to 0 in order to emit this info in the event
 	tStore := k.getTransientStore(ctx)
 	tStore.Set(key, bz)
+
+	// every change of the orderbook increments its sequence
+	k.IncrementOrderbookSequence(ctx, marketID)
 }
 
 // IncrementOrderbookPriceLevelQuantity increments the orderbook price level.
@@ -304,9 +307,9 @@ func (k *Keeper) IncrementOrderbookSequence(
 	return sequence
 }
 
-// IncrementSequenceAndEmitAllTransientOrderbookUpdates increments each orderbook sequence and emits an
-// EventOrderbookUpdate event for all the modified orderbooks in all markets.
-func (k *Keeper) IncrementSequenceAndEmitAllTransientOrderbookUpdates(
+// EmitAllTransientOrderbookUpdates emits an EventOrderbookUpdate event for all the modified orderbooks in all markets,
+// along with their sequence after the last change of the block.
+func (k *Keeper) EmitAllTransientOrderbookUpdates(
 	ctx sdk.Context,
 ) {
 	metrics.ReportFuncCall(k.svcTags)
@@ -324,7 +327,7 @@ func (k *Keeper) IncrementSequenceAndEmitAllTransientOrderbookUpdates(
 	derivativeUpdates := make([]*types.OrderbookUpdate, 0, len(derivativeOrderbooks))
 
 	for _, orderbook := range spotOrderbooks {
-		sequence := k.IncrementOrderbookSequence(ctx, common.BytesToHash(orderbook.MarketId))
+		sequence := k.GetOrderbookSequence(ctx, common.BytesToHash(orderbook.MarketId))
 		spotUpdates = append(spotUpdates, &types.OrderbookUpdate{
 			Seq:       sequence,
 			Orderbook: orderbook,
@@ -332,7 +335,7 @@ func (k *Keeper) IncrementSequenceAndEmitAllTransientOrderbookUpdates(
 	}
 
 	for _, orderbook := range derivativeOrderbooks {
-		sequence := k.IncrementOrderbookSequence(ctx, common.BytesToHash(orderbook.MarketId))
+		sequence := k.GetOrderbookSequence(ctx, common.BytesToHash(orderbook.MarketId))
 		derivativeUpdates = append(derivativeUpdates, &types.OrderbookUpdate{
 			Seq:       sequence,
 			Orderbook: orderbook,
@@ -345,3 +348,56 @@ func (k *Keeper) IncrementSequenceAndEmitAllTransientOrderbookUpdates(
 		DerivativeUpdates: derivativeUpdates,
 	})
 }
+
+// GetOrderInsertionSequence gets the orderbook sequence at which a resting limit order entered the orderbook.
+func (k *Keeper) GetOrderInsertionSequence(ctx sdk.Context, orderHash common.Hash) uint64 {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	sequenceStore := prefix.NewStore(store, types.OrderInsertionSequencePrefix)
+	bz := sequenceStore.Get(orderHash.Bytes())
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetAllOrderInsertionSequences gets the insertion sequences of all the resting limit orders.
+func (k *Keeper) GetAllOrderInsertionSequences(ctx sdk.Context) []*types.OrderInsertionSequence {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	sequenceStore := prefix.NewStore(store, types.OrderInsertionSequencePrefix)
+
+	orderInsertionSequences := make([]*types.OrderInsertionSequence, 0)
+
+	iterator := sequenceStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		orderInsertionSequences = append(orderInsertionSequences, &types.OrderInsertionSequence{
+			OrderHash: common.BytesToHash(iterator.Key()).Hex(),
+			Sequence:  sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+	return orderInsertionSequences
+}
+
+// SetOrderInsertionSequence sets the orderbook sequence at which a resting limit order entered the orderbook.
+func (k *Keeper) SetOrderInsertionSequence(ctx sdk.Context, orderHash common.Hash, sequence uint64) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	sequenceStore := prefix.NewStore(store, types.OrderInsertionSequencePrefix)
+	sequenceStore.Set(orderHash.Bytes(), sdk.Uint64ToBigEndian(sequence))
+}
+
+// DeleteOrderInsertionSequence deletes the insertion sequence of a limit order leaving the orderbook.
+func (k *Keeper) DeleteOrderInsertionSequence(ctx sdk.Context, orderHash common.Hash) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	sequenceStore := prefix.NewStore(store, types.OrderInsertionSequencePrefix)
+	sequenceStore.Delete(orderHash.Bytes())
+}
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// GetOrderbookDepth returns both sides of the orderbook aggregated into buckets of priceGranularity (not aggregated if
+// zero), restricted to the levels within depthPercent of the mid price (unbounded if zero). When a depth is requested
+// but the orderbook has no mid price, no level is within the depth.
+func (k *Keeper) GetOrderbookDepth(
+	ctx sdk.Context,
+	isSpot bool,
+	marketID common.Hash,
+	priceGranularity, depthPercent sdk.Dec,
+	limit uint64,
+) *types.QueryOrderbookDepthResponse {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	var midPrice *sdk.Dec
+	if isSpot {
+		midPrice, _, _ = k.GetSpotMidPriceAndTOB(ctx, marketID)
+	} else {
+		midPrice, _, _ = k.GetDerivativeMidPriceAndTOB(ctx, marketID)
+	}
+
+	res := &types.QueryOrderbookDepthResponse{
+		MidPrice:      midPrice,
+		BuysDepth:     make([]*types.DepthLevel, 0),
+		SellsDepth:    make([]*types.DepthLevel, 0),
+		BuysQuantity:  sdk.ZeroDec(),
+		BuysNotional:  sdk.ZeroDec(),
+		SellsQuantity: sdk.ZeroDec(),
+		SellsNotional: sdk.ZeroDec(),
+	}
+
+	var buysBound, sellsBound *sdk.Dec
+	if depthPercent.IsPositive() {
+		if midPrice == nil {
+			return res
+		}
+
+		depth := midPrice.Mul(depthPercent).QuoInt64(100)
+		buysBoundValue, sellsBoundValue := midPrice.Sub(depth), midPrice.Add(depth)
+		buysBound, sellsBound = &buysBoundValue, &sellsBoundValue
+	}
+
+	res.BuysDepth, res.BuysQuantity, res.BuysNotional = k.getOrderbookSideDepth(ctx, isSpot, marketID, true, priceGranularity, buysBound, limit)
+	res.SellsDepth, res.SellsQuantity, res.SellsNotional = k.getOrderbookSideDepth(ctx, isSpot, marketID, false, priceGranularity, sellsBound, limit)
+	return res
+}
+
+// getOrderbookSideDepth aggregates one side of the orderbook, from the best price until the bound (if any). Only the
+// first limit aggregated levels are returned, but the total quantity and notional cover every level until the bound.
+func (k *Keeper) getOrderbookSideDepth(
+	ctx sdk.Context,
+	isSpot bool,
+	marketID common.Hash,
+	isBuy bool,
+	priceGranularity sdk.Dec,
+	bound *sdk.Dec,
+	limit uint64,
+) (levels []*types.DepthLevel, totalQuantity, totalNotional sdk.Dec) {
+	var storeKey []byte
+	if isSpot {
+		storeKey = types.GetSpotOrderbookLevelsKey(marketID, isBuy)
+	} else {
+		storeKey = types.GetDerivativeOrderbookLevelsKey(marketID, isBuy)
+	}
+
+	priceLevelStore := prefix.NewStore(k.getStore(ctx), storeKey)
+	var iterator storetypes.Iterator
+
+	if isBuy {
+		iterator = priceLevelStore.ReverseIterator(nil, nil)
+	} else {
+		iterator = priceLevelStore.Iterator(nil, nil)
+	}
+
+	defer iterator.Close()
+
+	levels = make([]*types.DepthLevel, 0)
+	totalQuantity, totalNotional = sdk.ZeroDec(), sdk.ZeroDec()
+
+	for ; iterator.Valid(); iterator.Next() {
+		price := types.GetPriceFromPaddedPrice(string(iterator.Key()))
+		if bound != nil && (isBuy && price.LT(*bound) || !isBuy && price.GT(*bound)) {
+			break
+		}
+
+		quantity := types.DecBytesToDec(iterator.Value())
+		notional := price.Mul(quantity)
+		totalQuantity = totalQuantity.Add(quantity)
+		totalNotional = totalNotional.Add(notional)
+
+		bucketPrice := getDepthBucketPrice(price, priceGranularity, isBuy)
+		if len(levels) > 0 && levels[len(levels)-1].Price.Equal(bucketPrice) {
+			lastLevel := levels[len(levels)-1]
+			lastLevel.Quantity = lastLevel.Quantity.Add(quantity)
+			lastLevel.Notional = lastLevel.Notional.Add(notional)
+			lastLevel.CumulativeQuantity = totalQuantity
+			lastLevel.CumulativeNotional = totalNotional
+			continue
+		}
+
+		if uint64(len(levels)) == limit {
+			// keep iterating until the bound for the totals only
+			continue
+		}
+
+		levels = append(levels, &types.DepthLevel{
+			Price:              bucketPrice,
+			Quantity:           quantity,
+			Notional:           notional,
+			CumulativeQuantity: totalQuantity,
+			CumulativeNotional: totalNotional,
+		})
+	}
+	return levels, totalQuantity, totalNotional
+}
+
+// getDepthBucketPrice rounds the price to the granularity, down for buys and up for sells so that a bucket never
+// looks better than the orders it's made of
+func getDepthBucketPrice(price, priceGranularity sdk.Dec, isBuy bool) sdk.Dec {
+	if !priceGranularity.IsPositive() {
+		return price
+	}
+
+	buckets := price.Quo(priceGranularity)
+	if isBuy {
+		return buckets.TruncateDec().Mul(priceGranularity)
+	}
+	return buckets.Ceil().Mul(priceGranularity)
+}
@@ -13,18 +13,29 @@ type SpotMarketOrderbook struct {
 	orders         []*types.SpotMarketOrder
 	fillQuantities []sdk.Dec
 	orderIdx       int
+
+	// remaining quote amounts of the quote market orders, nil for the other orders
+	quoteAmounts        []sdk.Dec
+	takerFeeRate        sdk.Dec
+	minQuantityTickSize sdk.Dec
 }
 
 func NewSpotMarketOrderbook(
 	spotMarketOrders []*types.SpotMarketOrder,
+	takerFeeRate sdk.Dec,
+	minQuantityTickSize sdk.Dec,
 ) *SpotMarketOrderbook {
 	if len(spotMarketOrders) == 0 {
 		return nil
 	}
 
 	fillQuantities := make([]sdk.Dec, len(spotMarketOrders))
-	for idx := range spotMarketOrders {
+	quoteAmounts := make([]sdk.Dec, len(spotMarketOrders))
+	for idx, order := range spotMarketOrders {
 		fillQuantities[idx] = sdk.ZeroDec()
+		if order.IsQuoteMarketOrder() {
+			quoteAmounts[idx] = *order.QuoteAmount
+		}
 	}
 
 	orderGroup := SpotMarketOrderbook{
@@ -34,6 +45,10 @@ func NewSpotMarketOrderbook(
 		orders:         spotMarketOrders,
 		fillQuantities: fillQuantities,
 		orderIdx:       0,
+
+		quoteAmounts:        quoteAmounts,
+		takerFeeRate:        takerFeeRate,
+		minQuantityTickSize: minQuantityTickSize,
 	}
 
 	return &orderGroup
@@ -48,7 +63,7 @@ func (b *SpotMarketOrderbook) Peek() *types.PriceLevel {
 		return nil
 	}
 
-	if b.fillQuantities[b.orderIdx].Equal(b.orders[b.orderIdx].OrderInfo.Quantity) {
+	if b.fillQuantities[b.orderIdx].Equal(b.orders[b.orderIdx].OrderInfo.Quantity) || b.isQuoteAmountExhausted() {
 		b.orderIdx++
 		return b.Peek()
 	}
@@ -59,7 +74,33 @@ func (b *SpotMarketOrderbook) Peek() *types.PriceLevel {
 	}
 }
 
-func (b *SpotMarketOrderbook) Fill(fillQuantity sdk.Dec) error {
+func (b *SpotMarketOrderbook) isQuoteAmountExhausted() bool {
+	quoteAmount := b.quoteAmounts[b.orderIdx]
+	return !quoteAmount.IsNil() && !quoteAmount.IsPositive()
+}
+
+// GetFillableQuantity returns the part of the match quantity the current order can be filled with at the given limit
+// order price. Quote market orders are capped by the quantity their remaining quote amount buys including the fee or
+// sells net of the fee. A quote market order whose remaining quote amount cannot be filled with a single quantity tick
+// is exhausted, in which case zero is returned.
+func (b *SpotMarketOrderbook) GetFillableQuantity(price, matchQuantity sdk.Dec) sdk.Dec {
+	quoteAmount := b.quoteAmounts[b.orderIdx]
+	if quoteAmount.IsNil() {
+		return matchQuantity
+	}
+
+	isBuy := b.orders[b.orderIdx].IsBuy()
+	fillableQuantity := types.GetQuoteAmountQuantity(isBuy, quoteAmount, price, b.takerFeeRate, b.minQuantityTickSize)
+
+	if !fillableQuantity.IsPositive() {
+		b.quoteAmounts[b.orderIdx] = sdk.ZeroDec()
+		return sdk.ZeroDec()
+	}
+
+	return sdk.MinDec(matchQuantity, fillableQuantity)
+}
+
+func (b *SpotMarketOrderbook) Fill(fillQuantity, price sdk.Dec) error {
 	newFillAmount := b.fillQuantities[b.orderIdx].Add(fillQuantity)
 
 	if newFillAmount.GT(b.orders[b.orderIdx].OrderInfo.Quantity) {
@@ -70,5 +111,13 @@ func (b *SpotMarketOrderbook) Fill(fillQuantity sdk.Dec) error {
 	b.notional = b.notional.Add(fillQuantity.Mul(b.orders[b.orderIdx].OrderInfo.Price))
 	b.totalQuantity = b.totalQuantity.Add(fillQuantity)
 
+	if quoteAmount := b.quoteAmounts[b.orderIdx]; !quoteAmount.IsNil() {
+		feeFactor := sdk.OneDec().Sub(b.takerFeeRate)
+		if b.orders[b.orderIdx].IsBuy() {
+			feeFactor = sdk.OneDec().Add(b.takerFeeRate)
+		}
+		b.quoteAmounts[b.orderIdx] = quoteAmount.Sub(fillQuantity.Mul(price).Mul(feeFactor))
+	}
+
 	return nil
 }
@@ -0,0 +1,458 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// GetSpotMarketsPage returns a page of the spot markets with the given status.
+func (k *Keeper) GetSpotMarketsPage(
+	ctx sdk.Context,
+	status types.MarketStatus,
+	pageRequest *query.PageRequest,
+) ([]*types.SpotMarket, *query.PageResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	marketStore := prefix.NewStore(k.getStore(ctx), types.SpotMarketsPrefix)
+
+	markets := make([]*types.SpotMarket, 0)
+	pageResponse, err := query.FilteredPaginate(marketStore, pageRequest, func(_, value []byte, accumulate bool) (bool, error) {
+		var market types.SpotMarket
+		k.cdc.MustUnmarshal(value, &market)
+		if market.Status != status {
+			return false, nil
+		}
+
+		if accumulate {
+			markets = append(markets, &market)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return markets, pageResponse, nil
+}
+
+// GetFullDerivativeMarketsPage returns a page of the derivative markets with the given status, along with their mark
+// price and perpetual or expiry futures info.
+func (k *Keeper) GetFullDerivativeMarketsPage(
+	ctx sdk.Context,
+	status types.MarketStatus,
+	pageRequest *query.PageRequest,
+) ([]*types.FullDerivativeMarket, *query.PageResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	marketStore := prefix.NewStore(k.getStore(ctx), types.DerivativeMarketPrefix)
+
+	fullMarkets := make([]*types.FullDerivativeMarket, 0)
+	pageResponse, err := query.FilteredPaginate(marketStore, pageRequest, func(_, value []byte, accumulate bool) (bool, error) {
+		var market types.DerivativeMarket
+		k.cdc.MustUnmarshal(value, &market)
+		if market.Status != status {
+			return false, nil
+		}
+
+		if accumulate {
+			fullMarkets = append(fullMarkets, k.getFullDerivativeMarket(ctx, &market))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return fullMarkets, pageResponse, nil
+}
+
+// GetBinaryOptionsMarketsPage returns a page of the binary options markets with the given status.
+func (k *Keeper) GetBinaryOptionsMarketsPage(
+	ctx sdk.Context,
+	status types.MarketStatus,
+	pageRequest *query.PageRequest,
+) ([]*types.BinaryOptionsMarket, *query.PageResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	marketStore := prefix.NewStore(k.getStore(ctx), types.BinaryOptionsMarketPrefix)
+
+	markets := make([]*types.BinaryOptionsMarket, 0)
+	pageResponse, err := query.FilteredPaginate(marketStore, pageRequest, func(_, value []byte, accumulate bool) (bool, error) {
+		var market types.BinaryOptionsMarket
+		k.cdc.MustUnmarshal(value, &market)
+		if market.Status != status {
+			return false, nil
+		}
+
+		if accumulate {
+			markets = append(markets, &market)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return markets, pageResponse, nil
+}
+
+// GetPositionsPage returns a page of the positions of all markets, ordered by market ID and subaccount ID.
+func (k *Keeper) GetPositionsPage(
+	ctx sdk.Context,
+	pageRequest *query.PageRequest,
+) ([]types.DerivativePosition, *query.PageResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	positionStore := prefix.NewStore(k.getStore(ctx), types.DerivativePositionsPrefix)
+
+	positions := make([]types.DerivativePosition, 0)
+	pageResponse, err := query.Paginate(positionStore, pageRequest, func(key, value []byte) error {
+		var position types.Position
+		k.cdc.MustUnmarshal(value, &position)
+
+		subaccountID, marketID := types.GetSubaccountAndMarketIDFromPositionKey(key)
+		positions = append(positions, types.DerivativePosition{
+			SubaccountId: subaccountID.Hex(),
+			MarketId:     marketID.Hex(),
+			Position:     &position,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return positions, pageResponse, nil
+}
+
+// GetExchangeBalancesPage returns a page of the deposits of all subaccounts, ordered by subaccount ID and denom.
+func (k *Keeper) GetExchangeBalancesPage(
+	ctx sdk.Context,
+	pageRequest *query.PageRequest,
+) ([]types.Balance, *query.PageResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	depositStore := prefix.NewStore(k.getStore(ctx), types.DepositsPrefix)
+
+	balances := make([]types.Balance, 0)
+	pageResponse, err := query.Paginate(depositStore, pageRequest, func(key, value []byte) error {
+		var deposit types.Deposit
+		k.cdc.MustUnmarshal(value, &deposit)
+
+		subaccountID, denom := types.ParseDepositStoreKey(key)
+		balances = append(balances, types.Balance{
+			SubaccountId: subaccountID.Hex(),
+			Denom:        denom,
+			Deposits:     &deposit,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return balances, pageResponse, nil
+}
+
+// GetBalancesWithBalanceHoldsPage returns a page of the deposits of all subaccounts along with the balance held by
+// their resting orders, for which the balance passes the filter if set.
+func (k *Keeper) GetBalancesWithBalanceHoldsPage(
+	ctx sdk.Context,
+	filter func(*types.BalanceWithMarginHold) bool,
+	pageRequest *query.PageRequest,
+) ([]*types.BalanceWithMarginHold, *query.PageResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	depositStore := prefix.NewStore(k.getStore(ctx), types.DepositsPrefix)
+	balanceHolds := k.getAllBalanceHolds(ctx)
+
+	balanceWithBalanceHolds := make([]*types.BalanceWithMarginHold, 0)
+	pageResponse, err := query.FilteredPaginate(depositStore, pageRequest, func(key, value []byte, accumulate bool) (bool, error) {
+		var deposit types.Deposit
+		k.cdc.MustUnmarshal(value, &deposit)
+
+		subaccountID, denom := types.ParseDepositStoreKey(key)
+		balanceWithBalanceHold := newBalanceWithBalanceHold(types.Balance{
+			SubaccountId: subaccountID.Hex(),
+			Denom:        denom,
+			Deposits:     &deposit,
+		}, balanceHolds)
+
+		if filter != nil && !filter(balanceWithBalanceHold) {
+			return false, nil
+		}
+
+		if accumulate {
+			balanceWithBalanceHolds = append(balanceWithBalanceHolds, balanceWithBalanceHold)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return balanceWithBalanceHolds, pageResponse, nil
+}
+
+// GetOptedOutRewardAccountsPage returns a page of the accounts opted out of the trading rewards.
+func (k *Keeper) GetOptedOutRewardAccountsPage(
+	ctx sdk.Context,
+	pageRequest *query.PageRequest,
+) ([]string, *query.PageResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	rewardsOptOutStore := prefix.NewStore(k.getStore(ctx), types.IsOptedOutOfRewardsPrefix)
+
+	accounts := make([]string, 0)
+	pageResponse, err := query.FilteredPaginate(rewardsOptOutStore, pageRequest, func(key, value []byte, accumulate bool) (bool, error) {
+		if value == nil || !types.IsTrueByte(value) {
+			return false, nil
+		}
+
+		if accumulate {
+			accounts = append(accounts, sdk.AccAddress(key).String())
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return accounts, pageResponse, nil
+}
+
+// GetMarketAggregateVolumesPage returns a page of the aggregate volumes of the given markets, or of all markets if none
+// is given. Markets without any recorded volume are skipped.
+func (k *Keeper) GetMarketAggregateVolumesPage(
+	ctx sdk.Context,
+	marketIDs []common.Hash,
+	pageRequest *query.PageRequest,
+) ([]*types.MarketVolume, *query.PageResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	marketIDMap := make(map[common.Hash]struct{}, len(marketIDs))
+	for _, marketID := range marketIDs {
+		marketIDMap[marketID] = struct{}{}
+	}
+
+	volumeStore := prefix.NewStore(k.getStore(ctx), types.MarketVolumePrefix)
+
+	volumes := make([]*types.MarketVolume, 0)
+	pageResponse, err := query.FilteredPaginate(volumeStore, pageRequest, func(key, value []byte, accumulate bool) (bool, error) {
+		marketID := common.BytesToHash(key)
+		if _, found := marketIDMap[marketID]; len(marketIDMap) > 0 && !found {
+			return false, nil
+		}
+
+		if accumulate {
+			var volume types.VolumeRecord
+			k.cdc.MustUnmarshal(value, &volume)
+			volumes = append(volumes, &types.MarketVolume{
+				MarketId: marketID.Hex(),
+				Volume:   volume,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return volumes, pageResponse, nil
+}
+
+// GetDenomDecimalsPage returns a page of the decimals of all denoms, ordered by denom.
+func (k *Keeper) GetDenomDecimalsPage(
+	ctx sdk.Context,
+	pageRequest *query.PageRequest,
+) ([]types.DenomDecimals, *query.PageResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	denomDecimalStore := prefix.NewStore(k.getStore(ctx), types.DenomDecimalsPrefix)
+
+	denomDecimals := make([]types.DenomDecimals, 0)
+	pageResponse, err := query.Paginate(denomDecimalStore, pageRequest, func(key, value []byte) error {
+		denomDecimals = append(denomDecimals, types.DenomDecimals{
+			Denom:    string(key),
+			Decimals: sdk.BigEndianToUint64(value),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return denomDecimals, pageResponse, nil
+}
+
+// GetTradeRewardPointsPage returns a page of the trading reward points of all accounts in the current campaign, ordered
+// by account.
+func (k *Keeper) GetTradeRewardPointsPage(
+	ctx sdk.Context,
+	pageRequest *query.PageRequest,
+) ([]string, []sdk.Dec, *query.PageResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	return k.getAccountPointsPage(ctx, types.TradingRewardAccountPointsPrefix, pageRequest)
+}
+
+// GetPendingTradeRewardPointsPage returns a page of the trading reward points of all accounts in the pending pool
+// starting at the given timestamp, ordered by account.
+func (k *Keeper) GetPendingTradeRewardPointsPage(
+	ctx sdk.Context,
+	pendingPoolStartTimestamp int64,
+	pageRequest *query.PageRequest,
+) ([]string, []sdk.Dec, *query.PageResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	return k.getAccountPointsPage(ctx, types.GetTradingRewardAccountPendingPointsPrefix(pendingPoolStartTimestamp), pageRequest)
+}
+
+func (k *Keeper) getAccountPointsPage(
+	ctx sdk.Context,
+	pointsPrefix []byte,
+	pageRequest *query.PageRequest,
+) ([]string, []sdk.Dec, *query.PageResponse, error) {
+	pointsStore := prefix.NewStore(k.getStore(ctx), pointsPrefix)
+
+	accounts, points := make([]string, 0), make([]sdk.Dec, 0)
+	pageResponse, err := query.Paginate(pointsStore, pageRequest, func(key, value []byte) error {
+		accounts = append(accounts, sdk.AccAddress(key).String())
+		points = append(points, types.DecBytesToDec(value))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return accounts, points, pageResponse, nil
+}
+
+// GetHistoricalTradeRecordsPage returns a page of the historical trade records of the given market, or of all markets
+// if none is given, grouped by market and ordered by timestamp.
+func (k *Keeper) GetHistoricalTradeRecordsPage(
+	ctx sdk.Context,
+	marketID *common.Hash,
+	pageRequest *query.PageRequest,
+) ([]*types.TradeRecords, *query.PageResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	tradeRecordPrefix := types.MarketTradeRecordPrefix
+	if marketID != nil {
+		tradeRecordPrefix = types.GetMarketTradeRecordPrefix(*marketID)
+	}
+
+	tradeRecordStore := prefix.NewStore(k.getStore(ctx), tradeRecordPrefix)
+
+	allTradeRecords := make([]*types.TradeRecords, 0)
+	pageResponse, err := query.Paginate(tradeRecordStore, pageRequest, func(key, value []byte) error {
+		recordMarketID := common.BytesToHash(key[:common.HashLength])
+		if marketID != nil {
+			recordMarketID = *marketID
+		}
+
+		var tradeRecord types.TradeRecord
+		k.cdc.MustUnmarshal(value, &tradeRecord)
+
+		if len(allTradeRecords) == 0 || allTradeRecords[len(allTradeRecords)-1].MarketId != recordMarketID.Hex() {
+			allTradeRecords = append(allTradeRecords, &types.TradeRecords{
+				MarketId: recordMarketID.Hex(),
+			})
+		}
+
+		marketTradeRecords := allTradeRecords[len(allTradeRecords)-1]
+		marketTradeRecords.LatestTradeRecords = append(marketTradeRecords.LatestTradeRecords, &tradeRecord)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return allTradeRecords, pageResponse, nil
+}
+
+// GetVanillaOptionsMarketsPage returns a page of the vanilla options markets with the given status, or with any status if
+// unspecified, among the given markets or all markets if none is given.
+func (k *Keeper) GetVanillaOptionsMarketsPage(
+	ctx sdk.Context,
+	status types.MarketStatus,
+	marketIDs []common.Hash,
+	pageRequest *query.PageRequest,
+) ([]*types.VanillaOptionsMarket, *query.PageResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	marketIDMap := make(map[common.Hash]struct{}, len(marketIDs))
+	for _, marketID := range marketIDs {
+		marketIDMap[marketID] = struct{}{}
+	}
+
+	marketStore := prefix.NewStore(k.getStore(ctx), types.VanillaOptionsMarketPrefix)
+
+	markets := make([]*types.VanillaOptionsMarket, 0)
+	pageResponse, err := query.FilteredPaginate(marketStore, pageRequest, func(_, value []byte, accumulate bool) (bool, error) {
+		var market types.VanillaOptionsMarket
+		k.cdc.MustUnmarshal(value, &market)
+		if status != types.MarketStatus_Unspecified && market.Status != status {
+			return false, nil
+		}
+
+		if _, found := marketIDMap[market.MarketID()]; len(marketIDMap) > 0 && !found {
+			return false, nil
+		}
+
+		if accumulate {
+			markets = append(markets, &market)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return markets, pageResponse, nil
+}
+
+// GetCategoricalMarketsPage returns a page of the categorical markets with the given status, or with any status if
+// unspecified.
+func (k *Keeper) GetCategoricalMarketsPage(
+	ctx sdk.Context,
+	status types.MarketStatus,
+	pageRequest *query.PageRequest,
+) ([]*types.CategoricalMarket, *query.PageResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	marketStore := prefix.NewStore(k.getStore(ctx), types.CategoricalMarketPrefix)
+
+	markets := make([]*types.CategoricalMarket, 0)
+	pageResponse, err := query.FilteredPaginate(marketStore, pageRequest, func(_, value []byte, accumulate bool) (bool, error) {
+		var market types.CategoricalMarket
+		k.cdc.MustUnmarshal(value, &market)
+		if status != types.MarketStatus_Unspecified && market.Status != status {
+			return false, nil
+		}
+
+		if accumulate {
+			markets = append(markets, &market)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return markets, pageResponse, nil
+}
+
+// GetExpiryFuturesMarketSeriesPage returns a page of the expiry futures market series.
+func (k *Keeper) GetExpiryFuturesMarketSeriesPage(
+	ctx sdk.Context,
+	pageRequest *query.PageRequest,
+) ([]*types.ExpiryFuturesMarketSeries, *query.PageResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	seriesStore := prefix.NewStore(k.getStore(ctx), types.ExpiryFuturesMarketSeriesPrefix)
+
+	series := make([]*types.ExpiryFuturesMarketSeries, 0)
+	pageResponse, err := query.Paginate(seriesStore, pageRequest, func(_, value []byte) error {
+		var s types.ExpiryFuturesMarketSeries
+		k.cdc.MustUnmarshal(value, &s)
+		series = append(series, &s)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return series, pageResponse, nil
+}
@@ -151,7 +151,7 @@ func (k *Keeper) GetDefaultAtomicMarketOrderFeeMultiplier(ctx sdk.Context, marke
 	switch marketType {
 	case types.MarketType_Spot:
 		key = types.KeySpotAtomicMarketOrderFeeMultiplier
-	case types.MarketType_Expiry, types.MarketType_Perpetual:
+	case types.MarketType_Expiry, types.MarketType_Perpetual, types.MarketType_VanillaOption:
 		key = types.KeyDerivativeAtomicMarketOrderFeeMultiplier
 	case types.MarketType_BinaryOption:
 		key = types.KeyBinaryOptionsAtomicMarketOrderFeeMultiplier
@@ -190,3 +190,11 @@ func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) {
 
 	k.paramSpace.SetParamSet(ctx, &params)
 }
+
+// GetTerminalOrderRetentionBlocks returns the number of blocks for which the terminal states of the limit orders are kept
+func (k *Keeper) GetTerminalOrderRetentionBlocks(ctx sdk.Context) (res int64) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	k.paramSpace.Get(ctx, types.KeyTerminalOrderRetentionBlocks, &res)
+	return
+}
@@ -47,10 +47,12 @@ func (k *Keeper) PerpetualMarketLaunch(
 		}
 	}
 
-	_, err := k.GetDerivativeMarketPrice(ctx, oracleBase, oracleQuote, oracleScaleFactor, oracleType)
-	if err != nil {
-		metrics.ReportFuncError(k.svcTags)
-		return nil, nil, err
+	// pre-launch markets have no oracle, they're marked to their own trade TWAP
+	if oracleType != oracletypes.OracleType_Unspecified {
+		if _, err := k.GetDerivativeMarketPrice(ctx, oracleBase, oracleQuote, oracleScaleFactor, oracleType); err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, nil, err
+		}
 	}
 
 	if !k.insuranceKeeper.HasInsuranceFund(ctx, marketID) {
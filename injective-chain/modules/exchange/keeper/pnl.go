@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// GetSubaccountPnl returns the realized PnL, fees and funding ledger of a subaccount in a market, or nil if it
+// doesn't exist.
+func (k *Keeper) GetSubaccountPnl(ctx sdk.Context, subaccountID, marketID common.Hash) *types.SubaccountPnl {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	pnlStore := prefix.NewStore(k.getStore(ctx), types.SubaccountPnlPrefix)
+	bz := pnlStore.Get(types.GetSubaccountPnlKey(subaccountID, marketID))
+	if bz == nil {
+		return nil
+	}
+
+	var pnl types.SubaccountPnl
+	k.cdc.MustUnmarshal(bz, &pnl)
+	return &pnl
+}
+
+// SetSubaccountPnl stores the realized PnL, fees and funding ledger of a subaccount in a market.
+func (k *Keeper) SetSubaccountPnl(ctx sdk.Context, pnl *types.SubaccountPnl) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	pnlStore := prefix.NewStore(k.getStore(ctx), types.SubaccountPnlPrefix)
+	pnlStore.Set(types.GetSubaccountPnlKey(pnl.SubaccountID(), pnl.MarketID()), k.cdc.MustMarshal(pnl))
+}
+
+// GetSubaccountPnls returns the realized PnL, fees and funding ledgers of a subaccount in all markets.
+func (k *Keeper) GetSubaccountPnls(ctx sdk.Context, subaccountID common.Hash) []*types.SubaccountPnl {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	pnlStore := prefix.NewStore(k.getStore(ctx), append(types.SubaccountPnlPrefix, subaccountID.Bytes()...))
+	return k.iterateSubaccountPnls(pnlStore)
+}
+
+// GetAllSubaccountPnls returns the realized PnL, fees and funding ledgers of all subaccounts.
+func (k *Keeper) GetAllSubaccountPnls(ctx sdk.Context) []*types.SubaccountPnl {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	pnlStore := prefix.NewStore(k.getStore(ctx), types.SubaccountPnlPrefix)
+	return k.iterateSubaccountPnls(pnlStore)
+}
+
+func (k *Keeper) iterateSubaccountPnls(pnlStore prefix.Store) []*types.SubaccountPnl {
+	iterator := pnlStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	pnls := make([]*types.SubaccountPnl, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var pnl types.SubaccountPnl
+		k.cdc.MustUnmarshal(iterator.Value(), &pnl)
+		pnls = append(pnls, &pnl)
+	}
+	return pnls
+}
+
+func (k *Keeper) updateSubaccountPnl(ctx sdk.Context, subaccountID, marketID common.Hash, realizedPnl, feePaid, fundingPaid sdk.Dec) {
+	pnl := k.GetSubaccountPnl(ctx, subaccountID, marketID)
+	if pnl == nil {
+		pnl = types.NewSubaccountPnl(subaccountID, marketID)
+	}
+
+	pnl.RealizedPnl = pnl.RealizedPnl.Add(realizedPnl)
+	pnl.FeesPaid = pnl.FeesPaid.Add(feePaid)
+	pnl.FundingPaid = pnl.FundingPaid.Add(fundingPaid)
+	pnl.LastUpdatedHeight = ctx.BlockHeight()
+	k.SetSubaccountPnl(ctx, pnl)
+}
+
+// RecordDerivativeTradesPnl adds the realized PnL and the fees of the derivative trades to the ledgers of the traders.
+func (k *Keeper) RecordDerivativeTradesPnl(ctx sdk.Context, event *types.EventBatchDerivativeExecution) {
+	if event == nil {
+		return
+	}
+
+	marketID := common.HexToHash(event.MarketId)
+	for _, trade := range event.Trades {
+		realizedPnl := trade.Pnl
+		if realizedPnl.IsNil() {
+			realizedPnl = sdk.ZeroDec()
+		}
+
+		k.updateSubaccountPnl(ctx, common.BytesToHash(trade.SubaccountId), marketID, realizedPnl, trade.Fee, sdk.ZeroDec())
+	}
+}
+
+// recordPositionFundingPayment adds the funding applied to the stored position of a subaccount to its ledger, before
+// the position is replaced with the given one.
+func (k *Keeper) recordPositionFundingPayment(ctx sdk.Context, marketID, subaccountID common.Hash, position *types.Position) {
+	previousPosition := k.GetPosition(ctx, marketID, subaccountID)
+	if previousPosition == nil {
+		return
+	}
+
+	fundingPaid := previousPosition.GetFundingPayment(position.CumulativeFundingEntry)
+	if fundingPaid.IsZero() {
+		return
+	}
+
+	k.updateSubaccountPnl(ctx, subaccountID, marketID, sdk.ZeroDec(), sdk.ZeroDec(), fundingPaid)
+}
@@ -17,6 +17,7 @@ func (k *Keeper) SetPosition(
 ) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
+	k.recordPositionFundingPayment(ctx, marketID, subaccountID, position)
 	k.SetTransientPosition(ctx, marketID, subaccountID, position)
 
 	store := k.getStore(ctx)
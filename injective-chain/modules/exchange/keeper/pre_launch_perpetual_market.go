@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+// PreLaunchPerpetualMarketLaunch launches a perpetual market without an oracle. The market is marked to the TWAP of its
+// own trades until a DerivativeMarketParamUpdateProposal sets its oracle params.
+func (k *Keeper) PreLaunchPerpetualMarketLaunch(ctx sdk.Context, p *types.PreLaunchPerpetualMarketLaunchProposal) (*types.DerivativeMarket, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	market, _, err := k.PerpetualMarketLaunch(ctx, p.Ticker, p.QuoteDenom, "", "", 0, oracletypes.OracleType_Unspecified, p.InitialMarginRatio, p.MaintenanceMarginRatio, p.MakerFeeRate, p.TakerFeeRate, p.MinPriceTickSize, p.MinQuantityTickSize)
+	if err != nil {
+		return nil, err
+	}
+
+	info := types.NewPreLaunchPerpetualMarketInfo(market.MarketID(), p.Window, p.InitialMarkPrice, p.MinWindowQuantity, p.MaxMarkPriceChangeRate, ctx.BlockTime().Unix())
+	k.SetPreLaunchPerpetualMarketInfo(ctx, info)
+
+	return market, nil
+}
+
+// GetPreLaunchPerpetualMarkPrice returns the trade TWAP mark price of a pre-launch perpetual market.
+func (k *Keeper) GetPreLaunchPerpetualMarkPrice(ctx sdk.Context, marketID common.Hash) (*sdk.Dec, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	info := k.GetPreLaunchPerpetualMarketInfo(ctx, marketID)
+	if info == nil || info.MarkPrice.IsNil() || !info.MarkPrice.IsPositive() {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPreLaunchPerpetualMarket, "no mark price for marketID %s", marketID.Hex())
+	}
+
+	markPrice := info.MarkPrice
+	return &markPrice, nil
+}
+
+// recordPreLaunchPerpetualMarketTrade records the VWAP and quantity of the block's trades of a pre-launch perpetual
+// market into its current window, it does nothing for oracle-backed markets.
+func (k *Keeper) recordPreLaunchPerpetualMarketTrade(ctx sdk.Context, marketID common.Hash, vwap, quantity sdk.Dec) {
+	info := k.GetPreLaunchPerpetualMarketInfo(ctx, marketID)
+	if info == nil {
+		return
+	}
+
+	info.ApplyTrade(vwap, quantity, ctx.BlockTime().Unix())
+	k.SetPreLaunchPerpetualMarketInfo(ctx, info)
+}
+
+// ProcessPreLaunchPerpetualMarkPrices sets the mark price of every pre-launch perpetual market whose window has elapsed
+// to the bounded TWAP of that window, if enough quantity was traded in it, and starts a new window.
+func (k *Keeper) ProcessPreLaunchPerpetualMarkPrices(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	blockTime := ctx.BlockTime().Unix()
+
+	infos := k.GetAllPreLaunchPerpetualMarketInfos(ctx)
+	for idx := range infos {
+		info := &infos[idx]
+		if !info.IsWindowCompleted(blockTime) {
+			continue
+		}
+
+		info.RollWindow(blockTime)
+		k.SetPreLaunchPerpetualMarketInfo(ctx, info)
+	}
+}
+
+// GetPreLaunchPerpetualMarketInfo fetches the mark price state of a pre-launch perpetual market from the store by marketID.
+func (k *Keeper) GetPreLaunchPerpetualMarketInfo(ctx sdk.Context, marketID common.Hash) *types.PreLaunchPerpetualMarketInfo {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	infoStore := prefix.NewStore(store, types.PreLaunchPerpetualMarketInfoPrefix)
+
+	bz := infoStore.Get(marketID.Bytes())
+	if bz == nil {
+		return nil
+	}
+
+	var info types.PreLaunchPerpetualMarketInfo
+	k.cdc.MustUnmarshal(bz, &info)
+	return &info
+}
+
+// SetPreLaunchPerpetualMarketInfo saves the mark price state of a pre-launch perpetual market in the store.
+func (k *Keeper) SetPreLaunchPerpetualMarketInfo(ctx sdk.Context, info *types.PreLaunchPerpetualMarketInfo) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	infoStore := prefix.NewStore(store, types.PreLaunchPerpetualMarketInfoPrefix)
+
+	bz := k.cdc.MustMarshal(info)
+	infoStore.Set(info.MarketID().Bytes(), bz)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventPreLaunchPerpetualMarketInfoUpdate{
+		Info: *info,
+	})
+}
+
+// DeletePreLaunchPerpetualMarketInfo deletes the mark price state of a pre-launch perpetual market from the store.
+func (k *Keeper) DeletePreLaunchPerpetualMarketInfo(ctx sdk.Context, marketID common.Hash) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	infoStore := prefix.NewStore(store, types.PreLaunchPerpetualMarketInfoPrefix)
+	infoStore.Delete(marketID.Bytes())
+}
+
+// GetAllPreLaunchPerpetualMarketInfos returns the mark price states of all pre-launch perpetual markets.
+func (k *Keeper) GetAllPreLaunchPerpetualMarketInfos(ctx sdk.Context) []types.PreLaunchPerpetualMarketInfo {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	infos := make([]types.PreLaunchPerpetualMarketInfo, 0)
+	k.IteratePreLaunchPerpetualMarketInfos(ctx, func(info *types.PreLaunchPerpetualMarketInfo) (stop bool) {
+		infos = append(infos, *info)
+		return false
+	})
+
+	return infos
+}
+
+// IteratePreLaunchPerpetualMarketInfos iterates over the mark price states of the pre-launch perpetual markets calling process on each state.
+func (k *Keeper) IteratePreLaunchPerpetualMarketInfos(ctx sdk.Context, process func(*types.PreLaunchPerpetualMarketInfo) (stop bool)) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	infoStore := prefix.NewStore(store, types.PreLaunchPerpetualMarketInfoPrefix)
+
+	iterator := infoStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.PreLaunchPerpetualMarketInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		if process(&info) {
+			return
+		}
+	}
+}
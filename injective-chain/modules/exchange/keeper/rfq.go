@@ -0,0 +1,524 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// createRFQRequest stores a new RFQ request of the sender and returns its id.
+func (k *Keeper) createRFQRequest(ctx sdk.Context, sender sdk.AccAddress, msg *types.MsgCreateRFQRequest) (uint64, error) {
+	subaccountID, err := types.GetSubaccountIDOrDeriveFromNonce(sender, msg.SubaccountId)
+	if err != nil {
+		return 0, err
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+	if msg.Expiry <= blockTime || msg.Expiry > blockTime+types.MaxRFQRequestDuration {
+		metrics.ReportFuncError(k.svcTags)
+		return 0, sdkerrors.Wrapf(types.ErrInvalidRFQRequest, "expiry must be within %d seconds after the block time %d, got %d", types.MaxRFQRequestDuration, blockTime, msg.Expiry)
+	}
+
+	marketID := common.HexToHash(msg.MarketId)
+
+	var minQuantityTickSize sdk.Dec
+	if spotMarket := k.GetSpotMarket(ctx, marketID, true); spotMarket != nil {
+		minQuantityTickSize = spotMarket.MinQuantityTickSize
+	} else if derivativeMarket := k.GetDerivativeMarket(ctx, marketID, true); derivativeMarket != nil {
+		minQuantityTickSize = derivativeMarket.MinQuantityTickSize
+	} else {
+		metrics.ReportFuncError(k.svcTags)
+		return 0, sdkerrors.Wrapf(types.ErrMarketInvalid, "no active spot or derivative market for marketID %s", msg.MarketId)
+	}
+
+	if types.BreachesMinimumTickSize(msg.Quantity, minQuantityTickSize) {
+		metrics.ReportFuncError(k.svcTags)
+		return 0, sdkerrors.Wrapf(types.ErrInvalidQuantity, "quantity %s must be a multiple of the minimum quantity tick size %s", msg.Quantity.String(), minQuantityTickSize.String())
+	}
+
+	requestID := k.GetNextRFQRequestID(ctx)
+	k.SetNextRFQRequestID(ctx, requestID+1)
+
+	request := types.NewRFQRequest(requestID, sender, subaccountID, msg)
+	k.SetRFQRequest(ctx, request)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventNewRFQRequest{
+		Request: *request,
+	})
+
+	return requestID, nil
+}
+
+// cancelRFQRequest deletes an RFQ request of the sender.
+func (k *Keeper) cancelRFQRequest(ctx sdk.Context, sender sdk.AccAddress, requestID uint64) error {
+	request := k.GetRFQRequest(ctx, requestID)
+	if request == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return sdkerrors.Wrapf(types.ErrRFQRequestNotFound, "request id %d", requestID)
+	}
+
+	if request.Taker != sender.String() {
+		metrics.ReportFuncError(k.svcTags)
+		return sdkerrors.Wrapf(types.ErrInvalidRFQRequest, "request %d was not created by %s", requestID, sender.String())
+	}
+
+	k.DeleteRFQRequest(ctx, request)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventCancelRFQRequest{
+		RequestId: request.RequestId,
+		MarketId:  request.MarketId,
+	})
+	return nil
+}
+
+// acceptQuote settles the block trade of an RFQ request of the sender at the price of a maker signed quote. Both sides
+// are settled atomically against their subaccount deposits or positions, without touching the orderbook. The request
+// is deleted so the quote can't be replayed.
+func (k *Keeper) acceptQuote(ctx sdk.Context, sender sdk.AccAddress, msg *types.MsgAcceptQuote) error {
+	request := k.GetRFQRequest(ctx, msg.RequestId)
+	if request == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return sdkerrors.Wrapf(types.ErrRFQRequestNotFound, "request id %d", msg.RequestId)
+	}
+
+	if request.Taker != sender.String() {
+		metrics.ReportFuncError(k.svcTags)
+		return sdkerrors.Wrapf(types.ErrInvalidRFQRequest, "request %d was not created by %s", msg.RequestId, sender.String())
+	}
+
+	quote := &msg.Quote
+	if err := k.validateRFQQuote(ctx, request, quote, msg.MakerSignature); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return err
+	}
+
+	k.DeleteRFQRequest(ctx, request)
+
+	marketID := request.MarketID()
+
+	var err error
+	if spotMarket := k.GetSpotMarket(ctx, marketID, true); spotMarket != nil {
+		err = k.settleSpotBlockTrade(ctx, spotMarket, request, quote)
+	} else if marketInfo := k.GetDerivativeMarketInfo(ctx, marketID, true); marketInfo != nil {
+		err = k.settleDerivativeBlockTrade(ctx, marketInfo, request, quote, msg.Margin)
+	} else {
+		err = sdkerrors.Wrapf(types.ErrMarketInvalid, "no active spot or derivative market for marketID %s", request.MarketId)
+	}
+
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return err
+	}
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventRFQQuoteAccepted{
+		Request: *request,
+		Quote:   *quote,
+	})
+	return nil
+}
+
+// validateRFQQuote checks that the quote matches the request, hasn't expired and was signed by the account of the maker
+// subaccount for this chain.
+func (k *Keeper) validateRFQQuote(ctx sdk.Context, request *types.RFQRequest, quote *types.RFQQuote, signature []byte) error {
+	blockTime := ctx.BlockTime().Unix()
+
+	if request.IsExpired(blockTime) {
+		return sdkerrors.Wrapf(types.ErrInvalidRFQRequest, "request %d expired at %d", request.RequestId, request.Expiry)
+	}
+
+	if quote.IsExpired(blockTime) {
+		return sdkerrors.Wrapf(types.ErrInvalidRFQQuote, "quote expired at %d", quote.Expiry)
+	}
+
+	if quote.RequestId != request.RequestId || quote.MarketID() != request.MarketID() {
+		return sdkerrors.Wrapf(types.ErrInvalidRFQQuote, "quote is for request %d in market %s", quote.RequestId, quote.MarketId)
+	}
+
+	if quote.MakerSubaccountID() == request.SubaccountID() {
+		return sdkerrors.Wrap(types.ErrInvalidRFQQuote, "maker and taker subaccounts must be different")
+	}
+
+	makerAccount := k.AccountKeeper.GetAccount(ctx, quote.MakerAddress())
+	if makerAccount == nil || makerAccount.GetPubKey() == nil {
+		return sdkerrors.Wrapf(types.ErrInvalidRFQQuote, "maker account %s has no public key", quote.MakerAddress().String())
+	}
+
+	if !makerAccount.GetPubKey().VerifySignature(types.GetRFQQuoteSignBytes(ctx.ChainID(), quote), signature) {
+		return sdkerrors.Wrapf(types.ErrInvalidRFQQuote, "invalid signature of maker %s", quote.MakerAddress().String())
+	}
+	return nil
+}
+
+// settleSpotBlockTrade exchanges the base and quote assets of the block trade between the taker and the maker, both
+// paying their discounted fees to the auction subaccount.
+func (k *Keeper) settleSpotBlockTrade(ctx sdk.Context, market *types.SpotMarket, request *types.RFQRequest, quote *types.RFQQuote) error {
+	marketID := market.MarketID()
+
+	if types.BreachesMinimumTickSize(quote.Price, market.MinPriceTickSize) {
+		return sdkerrors.Wrapf(types.ErrInvalidPrice, "price %s must be a multiple of the minimum price tick size %s", quote.Price.String(), market.MinPriceTickSize.String())
+	}
+
+	stakingInfo, feeDiscountConfig := k.getFeeDiscountConfigAndStakingInfoForMarket(ctx, marketID)
+	tradingRewards := types.NewTradingRewardPoints()
+	pointsMultiplier := k.GetEffectiveTradingRewardsMarketPointsMultiplierConfig(ctx, marketID)
+
+	takerSubaccountID, makerSubaccountID := request.SubaccountID(), quote.MakerSubaccountID()
+	quantity, price := request.Quantity, quote.Price
+
+	takerFeeData := k.getTradeDataAndIncrementVolumeContribution(ctx, takerSubaccountID, marketID, quantity, price, market.TakerFeeRate, sdk.ZeroDec(), pointsMultiplier.TakerPointsMultiplier, feeDiscountConfig, false)
+	makerFeeData := k.getTradeDataAndIncrementVolumeContribution(ctx, makerSubaccountID, marketID, quantity, price, market.MakerFeeRate, sdk.ZeroDec(), pointsMultiplier.MakerPointsMultiplier, feeDiscountConfig, true)
+
+	buyerSubaccountID, buyerFeeData := takerSubaccountID, takerFeeData
+	sellerSubaccountID, sellerFeeData := makerSubaccountID, makerFeeData
+	if !request.IsBuy {
+		buyerSubaccountID, buyerFeeData = makerSubaccountID, makerFeeData
+		sellerSubaccountID, sellerFeeData = takerSubaccountID, takerFeeData
+	}
+
+	notional := quantity.Mul(price)
+
+	if err := k.spendFromAccount(ctx, buyerSubaccountID, market.QuoteDenom, notional.Add(buyerFeeData.traderFee)); err != nil {
+		return err
+	}
+	if err := k.spendFromAccount(ctx, sellerSubaccountID, market.BaseDenom, quantity); err != nil {
+		return err
+	}
+
+	k.UpdateDepositWithDelta(ctx, buyerSubaccountID, market.BaseDenom, types.NewUniformDepositDelta(quantity))
+	k.UpdateDepositWithDelta(ctx, sellerSubaccountID, market.QuoteDenom, types.NewUniformDepositDelta(notional.Sub(sellerFeeData.traderFee)))
+
+	auctionFeeReward := takerFeeData.auctionFeeReward.Add(makerFeeData.auctionFeeReward)
+	k.UpdateDepositWithDelta(ctx, types.AuctionSubaccountID, market.QuoteDenom, types.NewUniformDepositDelta(auctionFeeReward))
+
+	tradingRewards.AddPointsForAddress(types.SubaccountIDToSdkAddress(takerSubaccountID).String(), takerFeeData.tradingRewardPoints)
+	tradingRewards.AddPointsForAddress(types.SubaccountIDToSdkAddress(makerSubaccountID).String(), makerFeeData.tradingRewardPoints)
+
+	k.PersistTradingRewardPoints(ctx, tradingRewards)
+	k.PersistFeeDiscountStakingInfoUpdates(ctx, stakingInfo)
+
+	for _, side := range []struct {
+		subaccountID common.Hash
+		isBuy        bool
+		feeData      *tradeFeeData
+	}{
+		{subaccountID: buyerSubaccountID, isBuy: true, feeData: buyerFeeData},
+		{subaccountID: sellerSubaccountID, isBuy: false, feeData: sellerFeeData},
+	} {
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventBatchSpotExecution{
+			MarketId:      market.MarketId,
+			IsBuy:         side.isBuy,
+			ExecutionType: types.ExecutionType_BlockTrade,
+			Trades: []*types.TradeLog{{
+				Quantity:     quantity,
+				Price:        price,
+				SubaccountId: side.subaccountID.Bytes(),
+				Fee:          side.feeData.traderFee,
+			}},
+		})
+	}
+	return nil
+}
+
+// settleDerivativeBlockTrade applies the block trade to the positions of the taker and the maker, like a synthetic
+// trade. A side committing no margin must be a pure reduction of its position, its fee being deducted from the PnL.
+func (k *Keeper) settleDerivativeBlockTrade(
+	ctx sdk.Context,
+	marketInfo *types.DerivativeMarketInfo,
+	request *types.RFQRequest,
+	quote *types.RFQQuote,
+	takerMargin sdk.Dec,
+) error {
+	market := marketInfo.Market
+	marketID := market.MarketID()
+
+	if marketInfo.MarkPrice.IsNil() {
+		return sdkerrors.Wrapf(types.ErrInvalidMarketStatus, "no mark price for marketID %s", marketID.Hex())
+	}
+
+	if types.BreachesMinimumTickSize(quote.Price, market.MinPriceTickSize) {
+		return sdkerrors.Wrapf(types.ErrInvalidPrice, "price %s must be a multiple of the minimum price tick size %s", quote.Price.String(), market.MinPriceTickSize.String())
+	}
+
+	stakingInfo, feeDiscountConfig := k.getFeeDiscountConfigAndStakingInfoForMarket(ctx, marketID)
+	tradingRewards := types.NewTradingRewardPoints()
+	pointsMultiplier := k.GetEffectiveTradingRewardsMarketPointsMultiplierConfig(ctx, marketID)
+
+	initialPositions := NewModifiedPositionCache()
+	finalPositions := NewModifiedPositionCache()
+
+	var cumulativeFunding *sdk.Dec
+	if market.IsPerpetual && marketInfo.Funding != nil {
+		cumulativeFunding = &marketInfo.Funding.CumulativeFunding
+	}
+
+	sides := []struct {
+		subaccountID     common.Hash
+		isBuy            bool
+		margin           sdk.Dec
+		feeRate          sdk.Dec
+		pointsMultiplier sdk.Dec
+		isMaker          bool
+	}{
+		{request.SubaccountID(), request.IsBuy, takerMargin, market.TakerFeeRate, pointsMultiplier.TakerPointsMultiplier, false},
+		{quote.MakerSubaccountID(), !request.IsBuy, quote.Margin, market.MakerFeeRate, pointsMultiplier.MakerPointsMultiplier, true},
+	}
+
+	auctionFeeReward := sdk.ZeroDec()
+
+	for _, side := range sides {
+		feeData := k.getTradeDataAndIncrementVolumeContribution(ctx, side.subaccountID, marketID, request.Quantity, quote.Price, side.feeRate, sdk.ZeroDec(), side.pointsMultiplier, feeDiscountConfig, side.isMaker)
+
+		positionDelta := &types.PositionDelta{
+			IsLong:            side.isBuy,
+			ExecutionQuantity: request.Quantity,
+			ExecutionMargin:   side.margin,
+			ExecutionPrice:    quote.Price,
+		}
+
+		payout, realizedPnl, err := k.applyBlockTradePositionDelta(ctx, marketInfo, side.subaccountID, positionDelta, feeData.traderFee, initialPositions, finalPositions)
+		if err != nil {
+			return err
+		}
+
+		auctionFeeReward = auctionFeeReward.Add(feeData.auctionFeeReward)
+		tradingRewards.AddPointsForAddress(types.SubaccountIDToSdkAddress(side.subaccountID).String(), feeData.tradingRewardPoints)
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventBatchDerivativeExecution{
+			MarketId:          market.MarketId,
+			IsBuy:             side.isBuy,
+			CumulativeFunding: cumulativeFunding,
+			ExecutionType:     types.ExecutionType_BlockTrade,
+			Trades: []*types.DerivativeTradeLog{{
+				SubaccountId:  side.subaccountID.Bytes(),
+				PositionDelta: positionDelta,
+				Payout:        payout,
+				Fee:           feeData.traderFee,
+				Pnl:           realizedPnl,
+			}},
+		})
+	}
+
+	k.UpdateDepositWithDelta(ctx, types.AuctionSubaccountID, market.QuoteDenom, types.NewUniformDepositDelta(auctionFeeReward))
+
+	k.resolveSyntheticTradeROConflictsForMarket(ctx, marketID, initialPositions, finalPositions)
+	k.AppendModifiedSubaccountsByMarket(ctx, marketID, finalPositions.GetSortedSubaccountIDsByMarket(marketID))
+
+	k.PersistTradingRewardPoints(ctx, tradingRewards)
+	k.PersistFeeDiscountStakingInfoUpdates(ctx, stakingInfo)
+	return nil
+}
+
+// applyBlockTradePositionDelta applies one side of a derivative block trade to the position of the subaccount, charging
+// the margin and fee and crediting the payout of the closed quantity. It returns the payout and the realized PnL.
+func (k *Keeper) applyBlockTradePositionDelta(
+	ctx sdk.Context,
+	marketInfo *types.DerivativeMarketInfo,
+	subaccountID common.Hash,
+	positionDelta *types.PositionDelta,
+	tradingFee sdk.Dec,
+	initialPositions, finalPositions ModifiedPositionCache,
+) (payout, realizedPnl sdk.Dec, err error) {
+	market, marketID := marketInfo.Market, marketInfo.Market.MarketID()
+
+	position := k.GetPosition(ctx, marketID, subaccountID)
+	if position == nil {
+		var cumulativeFundingEntry sdk.Dec
+		if marketInfo.Funding != nil {
+			cumulativeFundingEntry = marketInfo.Funding.CumulativeFunding
+		}
+		position = types.NewPosition(positionDelta.IsLong, cumulativeFundingEntry)
+	} else if market.IsPerpetual {
+		position.ApplyFunding(marketInfo.Funding)
+	}
+
+	initialPositions.SetPosition(marketID, subaccountID, &types.Position{
+		IsLong:                 position.IsLong,
+		Quantity:               position.Quantity,
+		EntryPrice:             position.EntryPrice,
+		Margin:                 position.Margin,
+		CumulativeFundingEntry: position.CumulativeFundingEntry,
+	})
+
+	isClosingPosition := positionDelta.IsLong != position.IsLong && position.Quantity.IsPositive()
+	isReduceOnly := positionDelta.ExecutionMargin.IsZero()
+
+	if isReduceOnly && (!isClosingPosition || position.Quantity.LT(positionDelta.ExecutionQuantity)) {
+		return sdk.Dec{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrInsufficientOrderMargin, "subaccount %s commits no margin to a trade which doesn't purely reduce its position", subaccountID.Hex())
+	}
+
+	if isClosingPosition {
+		if err := k.ensurePositionAboveBankruptcyForClosing(position, market, positionDelta.ExecutionPrice, tradingFee); err != nil {
+			return sdk.Dec{}, sdk.Dec{}, err
+		}
+	}
+
+	// reduce-only sides pay their fee from the PnL, the others along with their margin
+	tradingFeeForReduceOnly, marginAndFee := sdk.ZeroDec(), positionDelta.ExecutionMargin.Add(tradingFee)
+	if isReduceOnly {
+		tradingFeeForReduceOnly, marginAndFee = tradingFee, sdk.ZeroDec()
+	}
+
+	if marginAndFee.IsPositive() {
+		if err := k.spendFromAccount(ctx, subaccountID, market.QuoteDenom, marginAndFee); err != nil {
+			return sdk.Dec{}, sdk.Dec{}, err
+		}
+	}
+
+	realizedPnl = position.GetRealizedPnl(positionDelta)
+	payout, closeExecutionMargin, _ := position.ApplyPositionDelta(positionDelta, tradingFeeForReduceOnly)
+
+	if !isReduceOnly {
+		if err := k.ensurePositionAboveInitialMarginRatio(position, market, marketInfo.MarkPrice); err != nil {
+			return sdk.Dec{}, sdk.Dec{}, err
+		}
+	}
+
+	finalPositions.SetPosition(marketID, subaccountID, position)
+	k.SetPosition(ctx, marketID, subaccountID, position)
+	k.updateSubaccountPnl(ctx, subaccountID, marketID, realizedPnl, tradingFee, sdk.ZeroDec())
+
+	// a maker rebate exceeding the margin is credited along with the payout
+	credit := payout.Add(closeExecutionMargin)
+	if marginAndFee.IsNegative() {
+		credit = credit.Sub(marginAndFee)
+	}
+	k.UpdateDepositWithDelta(ctx, subaccountID, market.QuoteDenom, types.NewUniformDepositDelta(credit))
+
+	return payout, realizedPnl, nil
+}
+
+// spendFromAccount charges the amount from the subaccount deposits, or the bank balance for a default subaccount, and
+// removes it from the total deposits.
+func (k *Keeper) spendFromAccount(ctx sdk.Context, subaccountID common.Hash, denom string, amount sdk.Dec) error {
+	if err := k.chargeAccount(ctx, subaccountID, denom, amount); err != nil {
+		return err
+	}
+
+	k.UpdateDepositWithDelta(ctx, subaccountID, denom, &types.DepositDelta{
+		AvailableBalanceDelta: sdk.ZeroDec(),
+		TotalBalanceDelta:     amount.Neg(),
+	})
+	return nil
+}
+
+// ProcessExpiredRFQRequests deletes the RFQ requests which expired before the current block.
+func (k *Keeper) ProcessExpiredRFQRequests(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	blockTime := ctx.BlockTime().Unix()
+
+	store := k.getStore(ctx)
+	expiryStore := prefix.NewStore(store, types.RFQRequestByExpiryPrefix)
+
+	// requests are expired once the block time is past their expiry
+	iterator := expiryStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(blockTime)))
+	requestIDs := make([]uint64, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		requestIDs = append(requestIDs, sdk.BigEndianToUint64(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, requestID := range requestIDs {
+		request := k.GetRFQRequest(ctx, requestID)
+		if request == nil {
+			continue
+		}
+
+		k.DeleteRFQRequest(ctx, request)
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventCancelRFQRequest{
+			RequestId: request.RequestId,
+			MarketId:  request.MarketId,
+			IsExpired: true,
+		})
+	}
+}
+
+// GetNextRFQRequestID returns the id of the next RFQ request, starting at 1.
+func (k *Keeper) GetNextRFQRequestID(ctx sdk.Context) uint64 {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz := k.getStore(ctx).Get(types.NextRFQRequestIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextRFQRequestID sets the id of the next RFQ request.
+func (k *Keeper) SetNextRFQRequestID(ctx sdk.Context, requestID uint64) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	k.getStore(ctx).Set(types.NextRFQRequestIDKey, sdk.Uint64ToBigEndian(requestID))
+}
+
+// SetRFQRequest stores an RFQ request and indexes it by expiry.
+func (k *Keeper) SetRFQRequest(ctx sdk.Context, request *types.RFQRequest) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	requestStore := prefix.NewStore(store, types.RFQRequestPrefix)
+	expiryStore := prefix.NewStore(store, types.RFQRequestByExpiryPrefix)
+
+	requestIDKey := sdk.Uint64ToBigEndian(request.RequestId)
+	requestStore.Set(requestIDKey, k.cdc.MustMarshal(request))
+	expiryStore.Set(types.GetRFQRequestByExpiryKey(request.Expiry, request.RequestId), requestIDKey)
+}
+
+// DeleteRFQRequest deletes an RFQ request along with its expiry index.
+func (k *Keeper) DeleteRFQRequest(ctx sdk.Context, request *types.RFQRequest) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	requestStore := prefix.NewStore(store, types.RFQRequestPrefix)
+	expiryStore := prefix.NewStore(store, types.RFQRequestByExpiryPrefix)
+
+	requestStore.Delete(sdk.Uint64ToBigEndian(request.RequestId))
+	expiryStore.Delete(types.GetRFQRequestByExpiryKey(request.Expiry, request.RequestId))
+}
+
+// GetRFQRequest fetches an RFQ request by id, returns nil if it doesn't exist.
+func (k *Keeper) GetRFQRequest(ctx sdk.Context, requestID uint64) *types.RFQRequest {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	requestStore := prefix.NewStore(store, types.RFQRequestPrefix)
+
+	bz := requestStore.Get(sdk.Uint64ToBigEndian(requestID))
+	if bz == nil {
+		return nil
+	}
+
+	var request types.RFQRequest
+	k.cdc.MustUnmarshal(bz, &request)
+	return &request
+}
+
+// GetAllRFQRequests returns all RFQ requests.
+func (k *Keeper) GetAllRFQRequests(ctx sdk.Context) []*types.RFQRequest {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	requestStore := prefix.NewStore(store, types.RFQRequestPrefix)
+
+	iterator := requestStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	requests := make([]*types.RFQRequest, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var request types.RFQRequest
+		k.cdc.MustUnmarshal(iterator.Value(), &request)
+		requests = append(requests, &request)
+	}
+	return requests
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+type RFQMsgServer struct {
+	Keeper
+	svcTags metrics.Tags
+}
+
+// NewRFQMsgServerImpl returns an implementation of the exchange MsgServer interface for the provided Keeper for RFQ functions.
+func NewRFQMsgServerImpl(keeper Keeper) RFQMsgServer {
+	return RFQMsgServer{
+		Keeper: keeper,
+		svcTags: metrics.Tags{
+			"svc": "rfq_msg_h",
+		},
+	}
+}
+
+func (k RFQMsgServer) CreateRFQRequest(goCtx context.Context, msg *types.MsgCreateRFQRequest) (*types.MsgCreateRFQRequestResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	requestID, err := k.createRFQRequest(ctx, sender, msg)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	return &types.MsgCreateRFQRequestResponse{
+		RequestId: requestID,
+	}, nil
+}
+
+func (k RFQMsgServer) CancelRFQRequest(goCtx context.Context, msg *types.MsgCancelRFQRequest) (*types.MsgCancelRFQRequestResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	if err := k.cancelRFQRequest(ctx, sender, msg.RequestId); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	return &types.MsgCancelRFQRequestResponse{}, nil
+}
+
+func (k RFQMsgServer) AcceptQuote(goCtx context.Context, msg *types.MsgAcceptQuote) (*types.MsgAcceptQuoteResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	if err := k.acceptQuote(ctx, sender, msg); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	return &types.MsgAcceptQuoteResponse{}, nil
+}
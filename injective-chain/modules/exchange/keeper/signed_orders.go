@@ -0,0 +1,360 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// submitSignedOrders places the signed orders on behalf of their traders. Each order is placed independently: the order
+// hash is returned for the orders which were placed and the ABCI error code for the ones which failed.
+func (k *Keeper) submitSignedOrders(ctx sdk.Context, relayer sdk.AccAddress, msg *types.MsgSubmitSignedOrders) []string {
+	orderFailEvent := types.EventOrderFail{
+		Account: relayer.Bytes(),
+		Hashes:  make([][]byte, 0),
+		Flags:   make([]uint32, 0),
+	}
+
+	orderHashes := make([]string, len(msg.Orders))
+	for idx := range msg.Orders {
+		order := &msg.Orders[idx]
+
+		orderHash, err := k.submitSignedOrder(ctx, relayer, order)
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			k.Logger(ctx).Debug("failed to place signed order", "subaccountID", order.SubaccountId, "nonce", order.Nonce, "err", err.Error())
+
+			sdkerror := &sdkerrors.Error{}
+			if errors.As(err, &sdkerror) {
+				orderHashes[idx] = fmt.Sprintf("%d", sdkerror.ABCICode())
+				orderFailEvent.AddOrderFail(orderHash, sdkerror.ABCICode())
+			}
+			continue
+		}
+
+		orderHashes[idx] = orderHash.Hex()
+	}
+
+	if !orderFailEvent.IsEmpty() {
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&orderFailEvent)
+	}
+
+	return orderHashes
+}
+
+// submitSignedOrder verifies the signature, nonce and expiry of a signed order and places it as a limit order of the
+// trader. The nonce is only used once the order is placed, so an order which can't be placed can be submitted again
+// until it expires.
+func (k *Keeper) submitSignedOrder(ctx sdk.Context, relayer sdk.AccAddress, order *types.SignedOrder) (common.Hash, error) {
+	blockTime := ctx.BlockTime().Unix()
+	subaccountID := order.SubaccountID()
+
+	if order.Expiry < blockTime {
+		return common.Hash{}, sdkerrors.Wrapf(types.ErrInvalidSignedOrder, "order expired at %d", order.Expiry)
+	}
+
+	if order.Expiry > blockTime+types.MaxSignedOrderDuration {
+		return common.Hash{}, sdkerrors.Wrapf(types.ErrInvalidSignedOrder, "order expiry can't be more than %d seconds away", types.MaxSignedOrderDuration)
+	}
+
+	if order.Nonce < k.GetSignedOrderMinNonce(ctx, subaccountID) {
+		return common.Hash{}, sdkerrors.Wrapf(types.ErrInvalidSignedOrder, "nonce %d was cancelled", order.Nonce)
+	}
+
+	if k.HasSignedOrderNonce(ctx, subaccountID, order.Nonce) {
+		return common.Hash{}, sdkerrors.Wrapf(types.ErrInvalidSignedOrder, "nonce %d was already used or cancelled", order.Nonce)
+	}
+
+	signer, err := order.RecoverSigner(ctx.ChainID())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	trader := order.Trader()
+	if !signer.Equals(trader) {
+		return common.Hash{}, sdkerrors.Wrapf(types.ErrInvalidSignedOrder, "order signed by %s instead of %s", signer.String(), trader.String())
+	}
+
+	// the relayer collects the fee share of the orders which don't specify a fee recipient
+	feeRecipient := order.FeeRecipient
+	if feeRecipient == "" {
+		feeRecipient = relayer.String()
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	orderHash, err := k.placeSignedOrder(cacheCtx, trader, order, feeRecipient)
+	if err != nil {
+		return orderHash, err
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	k.SetSignedOrderNonce(ctx, &types.SignedOrderNonce{
+		SubaccountId: order.SubaccountId,
+		Nonce:        order.Nonce,
+		Expiry:       order.Expiry,
+		MarketId:     order.MarketId,
+		OrderHash:    orderHash.Hex(),
+	})
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventSignedOrderPlaced{
+		Relayer:      relayer.String(),
+		SubaccountId: order.SubaccountId,
+		Nonce:        order.Nonce,
+		OrderHash:    orderHash.Bytes(),
+	})
+
+	return orderHash, nil
+}
+
+// placeSignedOrder places the signed order in its spot or derivative market.
+func (k *Keeper) placeSignedOrder(ctx sdk.Context, trader sdk.AccAddress, order *types.SignedOrder, feeRecipient string) (common.Hash, error) {
+	marketID := order.MarketID()
+
+	if spotMarket := k.GetSpotMarket(ctx, marketID, true); spotMarket != nil {
+		if !order.Margin.IsZero() {
+			return common.Hash{}, sdkerrors.Wrap(types.ErrInvalidSignedOrder, "spot signed orders can't have a margin")
+		}
+
+		spotOrder := order.ToSpotOrder(feeRecipient)
+		if err := spotOrder.ValidateBasic(trader); err != nil {
+			return common.Hash{}, err
+		}
+		return k.createSpotLimitOrder(ctx, trader, spotOrder, spotMarket)
+	}
+
+	market, markPrice := k.GetDerivativeMarketWithMarkPrice(ctx, marketID, true)
+	if market == nil || markPrice.IsNil() {
+		return common.Hash{}, sdkerrors.Wrapf(types.ErrMarketInvalid, "active spot or derivative market for marketID %s not found", order.MarketId)
+	}
+
+	derivativeOrder := order.ToDerivativeOrder(feeRecipient)
+	if err := derivativeOrder.ValidateBasic(trader, false); err != nil {
+		return common.Hash{}, err
+	}
+	return k.createDerivativeLimitOrder(ctx, trader, derivativeOrder, market, markPrice)
+}
+
+// cancelSignedOrders invalidates the nonces of the given signed orders of the subaccount and raises its min nonce, so
+// that the signed orders using them can't be submitted anymore. The orders must be signed by the trader so that their
+// nonces are kept until the expiry the orders were actually signed with. Orders which were already placed rest on the
+// orderbook and are cancelled like any other limit order.
+func (k *Keeper) cancelSignedOrders(ctx sdk.Context, sender sdk.AccAddress, msg *types.MsgCancelSignedOrders) error {
+	subaccountID, err := types.GetSubaccountIDOrDeriveFromNonce(sender, msg.SubaccountId)
+	if err != nil {
+		return err
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+	nonces := make([]uint64, 0, len(msg.Orders))
+
+	for idx := range msg.Orders {
+		order := &msg.Orders[idx]
+
+		if order.SubaccountID() != subaccountID {
+			return sdkerrors.Wrapf(types.ErrInvalidSignedOrder, "order with nonce %d belongs to subaccount %s", order.Nonce, order.SubaccountId)
+		}
+
+		signer, err := order.RecoverSigner(ctx.ChainID())
+		if err != nil {
+			return err
+		}
+
+		if trader := order.Trader(); !signer.Equals(trader) {
+			return sdkerrors.Wrapf(types.ErrInvalidSignedOrder, "order with nonce %d signed by %s instead of %s", order.Nonce, signer.String(), trader.String())
+		}
+
+		nonces = append(nonces, order.Nonce)
+
+		// an expired order can't be submitted anymore, so its nonce doesn't need to be kept
+		if order.Expiry < blockTime || k.HasSignedOrderNonce(ctx, subaccountID, order.Nonce) {
+			continue
+		}
+
+		// a cancelled nonce is kept until the expiry of its order, like a used one
+		k.SetSignedOrderNonce(ctx, &types.SignedOrderNonce{
+			SubaccountId: subaccountID.Hex(),
+			Nonce:        order.Nonce,
+			Expiry:       order.Expiry,
+		})
+	}
+
+	minNonce := k.GetSignedOrderMinNonce(ctx, subaccountID)
+	if msg.MinNonce > minNonce {
+		minNonce = msg.MinNonce
+		k.SetSignedOrderMinNonce(ctx, subaccountID, minNonce)
+	}
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventCancelSignedOrders{
+		SubaccountId: subaccountID.Hex(),
+		Nonces:       nonces,
+		MinNonce:     minNonce,
+	})
+	return nil
+}
+
+// ProcessExpiredSignedOrders cancels the placed signed orders which expired before the current block and still rest on
+// the orderbook, and deletes the nonces of the placed or cancelled signed orders which expired, since these orders
+// can't be submitted anymore.
+func (k *Keeper) ProcessExpiredSignedOrders(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	blockTime := ctx.BlockTime().Unix()
+
+	store := k.getStore(ctx)
+	nonceStore := prefix.NewStore(store, types.SignedOrderNoncePrefix)
+	expiryStore := prefix.NewStore(store, types.SignedOrderNonceByExpiryPrefix)
+
+	// orders are expired once the block time is past their expiry
+	iterator := expiryStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(blockTime)))
+	expiredKeys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		expiredKeys = append(expiredKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, expiredKey := range expiredKeys {
+		// the expiry index key is the expiry followed by the nonce key
+		nonceKey := expiredKey[8:]
+
+		if bz := nonceStore.Get(nonceKey); bz != nil {
+			var signedOrderNonce types.SignedOrderNonce
+			k.cdc.MustUnmarshal(bz, &signedOrderNonce)
+
+			if signedOrderNonce.OrderHash != "" {
+				k.cancelExpiredSignedOrder(ctx, &signedOrderNonce)
+			}
+		}
+
+		nonceStore.Delete(nonceKey)
+		expiryStore.Delete(expiredKey)
+	}
+}
+
+// cancelExpiredSignedOrder cancels the placed signed order of the nonce if it still rests on the orderbook, it does
+// nothing if the order was filled or cancelled already.
+func (k *Keeper) cancelExpiredSignedOrder(ctx sdk.Context, signedOrderNonce *types.SignedOrderNonce) {
+	var (
+		marketID     = common.HexToHash(signedOrderNonce.MarketId)
+		subaccountID = common.HexToHash(signedOrderNonce.SubaccountId)
+		orderHash    = common.HexToHash(signedOrderNonce.OrderHash)
+	)
+
+	if spotMarket := k.GetSpotMarketByID(ctx, marketID); spotMarket != nil {
+		order := k.GetSpotLimitOrderBySubaccountID(ctx, marketID, nil, subaccountID, orderHash)
+		if order != nil && spotMarket.StatusSupportsOrderCancellations() {
+			k.CancelSpotLimitOrder(ctx, spotMarket, marketID, subaccountID, order.IsBuy(), order, types.OrderTerminationReason_SignedOrderExpiry)
+		}
+		return
+	}
+
+	derivativeMarket := k.GetDerivativeMarketByID(ctx, marketID)
+	if derivativeMarket == nil || !derivativeMarket.StatusSupportsOrderCancellations() {
+		return
+	}
+
+	if k.GetDerivativeLimitOrderBySubaccountIDAndHash(ctx, marketID, nil, subaccountID, orderHash) == nil {
+		return
+	}
+
+	if err := k.CancelRestingDerivativeLimitOrder(ctx, derivativeMarket, subaccountID, nil, orderHash, true, true, types.OrderTerminationReason_SignedOrderExpiry); err != nil {
+		k.Logger(ctx).Error("failed to cancel the expired signed order", "orderHash", orderHash.Hex(), "err", err.Error())
+	}
+}
+
+// HasSignedOrderNonce returns true if the nonce of the subaccount was used by a placed signed order or cancelled.
+func (k *Keeper) HasSignedOrderNonce(ctx sdk.Context, subaccountID common.Hash, nonce uint64) bool {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	nonceStore := prefix.NewStore(store, types.SignedOrderNoncePrefix)
+	return nonceStore.Has(types.GetSignedOrderNonceKey(subaccountID, nonce))
+}
+
+// SetSignedOrderNonce marks a nonce as used or cancelled and indexes it by expiry, so that it's pruned once its order
+// expired.
+func (k *Keeper) SetSignedOrderNonce(ctx sdk.Context, signedOrderNonce *types.SignedOrderNonce) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	nonceStore := prefix.NewStore(store, types.SignedOrderNoncePrefix)
+	expiryStore := prefix.NewStore(store, types.SignedOrderNonceByExpiryPrefix)
+
+	subaccountID := common.HexToHash(signedOrderNonce.SubaccountId)
+	bz := k.cdc.MustMarshal(signedOrderNonce)
+	nonceStore.Set(types.GetSignedOrderNonceKey(subaccountID, signedOrderNonce.Nonce), bz)
+	expiryStore.Set(types.GetSignedOrderNonceByExpiryKey(signedOrderNonce.Expiry, subaccountID, signedOrderNonce.Nonce), []byte{})
+}
+
+// GetAllSignedOrderNonces returns all used or cancelled signed order nonces.
+func (k *Keeper) GetAllSignedOrderNonces(ctx sdk.Context) []types.SignedOrderNonce {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	nonceStore := prefix.NewStore(store, types.SignedOrderNoncePrefix)
+
+	iterator := nonceStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	signedOrderNonces := make([]types.SignedOrderNonce, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var signedOrderNonce types.SignedOrderNonce
+		k.cdc.MustUnmarshal(iterator.Value(), &signedOrderNonce)
+		signedOrderNonces = append(signedOrderNonces, signedOrderNonce)
+	}
+	return signedOrderNonces
+}
+
+// GetSignedOrderMinNonce returns the lowest nonce the signed orders of the subaccount can use.
+func (k *Keeper) GetSignedOrderMinNonce(ctx sdk.Context, subaccountID common.Hash) uint64 {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	minNonceStore := prefix.NewStore(store, types.SignedOrderMinNoncePrefix)
+
+	bz := minNonceStore.Get(subaccountID.Bytes())
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetSignedOrderMinNonce sets the lowest nonce the signed orders of the subaccount can use.
+func (k *Keeper) SetSignedOrderMinNonce(ctx sdk.Context, subaccountID common.Hash, minNonce uint64) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	minNonceStore := prefix.NewStore(store, types.SignedOrderMinNoncePrefix)
+	minNonceStore.Set(subaccountID.Bytes(), sdk.Uint64ToBigEndian(minNonce))
+}
+
+// GetAllSignedOrderMinNonces returns the min nonces of all subaccounts which cancelled signed orders by min nonce.
+func (k *Keeper) GetAllSignedOrderMinNonces(ctx sdk.Context) []types.SignedOrderMinNonce {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	minNonceStore := prefix.NewStore(store, types.SignedOrderMinNoncePrefix)
+
+	iterator := minNonceStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	minNonces := make([]types.SignedOrderMinNonce, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		minNonces = append(minNonces, types.SignedOrderMinNonce{
+			SubaccountId: common.BytesToHash(iterator.Key()).Hex(),
+			MinNonce:     sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+	return minNonces
+}
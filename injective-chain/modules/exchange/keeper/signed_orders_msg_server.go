@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+type SignedOrdersMsgServer struct {
+	Keeper
+	svcTags metrics.Tags
+}
+
+// NewSignedOrdersMsgServerImpl returns an implementation of the exchange MsgServer interface for the provided Keeper for signed order functions.
+func NewSignedOrdersMsgServerImpl(keeper Keeper) SignedOrdersMsgServer {
+	return SignedOrdersMsgServer{
+		Keeper: keeper,
+		svcTags: metrics.Tags{
+			"svc": "signed_orders_msg_h",
+		},
+	}
+}
+
+func (k SignedOrdersMsgServer) SubmitSignedOrders(goCtx context.Context, msg *types.MsgSubmitSignedOrders) (*types.MsgSubmitSignedOrdersResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	relayer := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	return &types.MsgSubmitSignedOrdersResponse{
+		OrderHashes: k.submitSignedOrders(ctx, relayer, msg),
+	}, nil
+}
+
+func (k SignedOrdersMsgServer) CancelSignedOrders(goCtx context.Context, msg *types.MsgCancelSignedOrders) (*types.MsgCancelSignedOrdersResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	if err := k.cancelSignedOrders(ctx, sender, msg); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	return &types.MsgCancelSignedOrdersResponse{}, nil
+}
@@ -0,0 +1,243 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// simulateOrder executes the order against the current orderbook as an immediate market order, going through the same
+// validation, matching and settlement logic as a real order. Everything happens on a cached context which is never
+// written back, so nothing is persisted.
+func (k *Keeper) simulateOrder(ctx sdk.Context, req *types.QuerySimulateOrderRequest) (*types.QuerySimulateOrderResponse, error) {
+	switch req.OrderType {
+	case types.OrderType_BUY, types.OrderType_SELL, types.OrderType_BUY_ATOMIC, types.OrderType_SELL_ATOMIC:
+		// do nothing
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrUnrecognizedOrderType, "order type %s can't be simulated", req.OrderType.String())
+	}
+
+	if !types.IsHexHash(req.SubaccountId) {
+		return nil, sdkerrors.Wrap(types.ErrBadSubaccountID, req.SubaccountId)
+	}
+
+	var (
+		cacheCtx, _ = ctx.CacheContext()
+		marketID    = common.HexToHash(req.MarketId)
+		sender      = types.SubaccountIDToSdkAddress(common.HexToHash(req.SubaccountId))
+	)
+
+	if spotMarket := k.GetSpotMarket(cacheCtx, marketID, true); spotMarket != nil {
+		return k.simulateSpotOrder(cacheCtx, sender, spotMarket, req)
+	}
+
+	market, markPrice := k.GetDerivativeOrBinaryOptionsMarketWithMarkPrice(cacheCtx, marketID, true)
+	if market == nil {
+		return nil, sdkerrors.Wrapf(types.ErrMarketInvalid, "active market for marketID %s not found", req.MarketId)
+	}
+	return k.simulateDerivativeOrder(cacheCtx, sender, market, markPrice, req)
+}
+
+func (k *Keeper) simulateSpotOrder(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	market *types.SpotMarket,
+	req *types.QuerySimulateOrderRequest,
+) (*types.QuerySimulateOrderResponse, error) {
+	spotOrder := &types.SpotOrder{
+		MarketId:  req.MarketId,
+		OrderInfo: getSimulatedOrderInfo(sender, req),
+		OrderType: req.OrderType,
+	}
+
+	if err := spotOrder.ValidateBasic(sender); err != nil {
+		return nil, err
+	}
+
+	if err := spotOrder.CheckTickSize(market.MinPriceTickSize, market.MinQuantityTickSize); err != nil {
+		return nil, err
+	}
+
+	var (
+		marketID     = market.MarketID()
+		subaccountID = spotOrder.SubaccountID()
+		isBuy        = spotOrder.IsBuy()
+	)
+
+	bestPrice := k.GetBestSpotLimitOrderPrice(ctx, marketID, !isBuy)
+	if bestPrice == nil {
+		return nil, types.ErrNoLiquidity
+	} else if isBuy && spotOrder.OrderInfo.Price.LT(*bestPrice) || !isBuy && spotOrder.OrderInfo.Price.GT(*bestPrice) {
+		return nil, types.ErrSlippageExceedsWorstPrice
+	}
+
+	feeRate := market.TakerFeeRate
+	if spotOrder.OrderType.IsAtomic() {
+		feeRate = feeRate.Mul(k.GetMarketAtomicExecutionFeeMultiplier(ctx, marketID, types.MarketType_Spot))
+	}
+
+	balanceHold := spotOrder.GetMarketOrderBalanceHold(feeRate, *bestPrice)
+	if err := k.chargeAccount(ctx, subaccountID, spotOrder.GetMarginDenom(market), balanceHold); err != nil {
+		return nil, err
+	}
+
+	marketOrder := spotOrder.ToSpotMarketOrder(sender, balanceHold, common.Hash{})
+
+	_, feeDiscountConfig := k.getFeeDiscountConfigAndStakingInfoForMarket(ctx, marketID)
+	pointsMultiplier := k.GetEffectiveTradingRewardsMarketPointsMultiplierConfig(ctx, marketID)
+
+	limitOrderStateExpansions, _, clearingPrice, clearingQuantity := k.getMarketOrderStateExpansionsAndClearingPrice(ctx, market, isBuy, SingleElementSlice(marketOrder), pointsMultiplier, feeDiscountConfig, feeRate)
+
+	fills := make([]*types.PriceLevel, 0)
+	for _, expansion := range limitOrderStateExpansions {
+		fills = appendFillToPriceLevels(fills, expansion.OrderPrice, expansion.LimitOrderFillQuantity)
+	}
+
+	discountedFeeRate := k.FetchAndUpdateDiscountedTradingFeeRate(ctx, feeRate, false, sender, feeDiscountConfig)
+	return newSimulateOrderResponse(fills, clearingQuantity, clearingPrice, discountedFeeRate, balanceHold), nil
+}
+
+func (k *Keeper) simulateDerivativeOrder(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	market MarketI,
+	markPrice sdk.Dec,
+	req *types.QuerySimulateOrderRequest,
+) (*types.QuerySimulateOrderResponse, error) {
+	derivativeOrder := &types.DerivativeOrder{
+		MarketId:  req.MarketId,
+		OrderInfo: getSimulatedOrderInfo(sender, req),
+		OrderType: req.OrderType,
+		Margin:    req.Margin,
+	}
+
+	isFullyCollateralized := market.GetMarketType().IsFullyCollateralized()
+	if err := derivativeOrder.ValidateBasic(sender, isFullyCollateralized); err != nil {
+		return nil, err
+	}
+
+	var (
+		marketID        = market.MarketID()
+		subaccountID    = derivativeOrder.SubaccountID()
+		isBuy           = derivativeOrder.IsBuy()
+		metadata        = k.GetSubaccountOrderbookMetadata(ctx, marketID, subaccountID, isBuy)
+		orderMarginHold sdk.Dec
+	)
+
+	if err := derivativeOrder.CheckMarginTickSize(market.GetMinQuantityTickSize()); err != nil {
+		return nil, err
+	}
+
+	orderHash, err := k.ensureValidDerivativeOrder(ctx, derivativeOrder, market, metadata, markPrice, true, &orderMarginHold, false)
+	if err != nil {
+		return nil, err
+	}
+
+	marketOrder := types.NewDerivativeMarketOrder(derivativeOrder, sender, orderHash)
+	marginRequired := sdk.ZeroDec()
+	if marketOrder.IsVanilla() {
+		marketOrder.MarginHold = orderMarginHold
+		marginRequired = orderMarginHold
+	}
+
+	var funding *types.PerpetualMarketFunding
+	if market.GetIsPerpetual() {
+		funding = k.GetPerpetualMarketFunding(ctx, marketID)
+	}
+
+	takerFeeRate := market.GetTakerFeeRate()
+	if derivativeOrder.OrderType.IsAtomic() {
+		takerFeeRate = takerFeeRate.Mul(k.getDerivativeMarketAtomicExecutionFeeMultiplier(ctx, marketID, market.GetMarketType()))
+	}
+
+	var (
+		marketBuyOrders  = make([]*types.DerivativeMarketOrder, 0)
+		marketSellOrders = make([]*types.DerivativeMarketOrder, 0)
+		positionStates   = NewPositionStates()
+	)
+
+	if isBuy {
+		marketBuyOrders = append(marketBuyOrders, marketOrder)
+	} else {
+		marketSellOrders = append(marketSellOrders, marketOrder)
+	}
+
+	_, feeDiscountConfig := k.getFeeDiscountConfigAndStakingInfoForMarket(ctx, marketID)
+	execution := k.GetDerivativeMarketOrderExecutionData(ctx, market, takerFeeRate, markPrice, funding, marketBuyOrders, marketSellOrders, positionStates, feeDiscountConfig, false)
+
+	limitOrderStateExpansions, clearingPrice, clearingQuantity := execution.LimitSellExpansions, execution.MarketBuyClearingPrice, execution.MarketBuyClearingQuantity
+	if !isBuy {
+		limitOrderStateExpansions, clearingPrice, clearingQuantity = execution.LimitBuyExpansions, execution.MarketSellClearingPrice, execution.MarketSellClearingQuantity
+	}
+
+	fills := make([]*types.PriceLevel, 0)
+	for _, expansion := range limitOrderStateExpansions {
+		fills = appendFillToPriceLevels(fills, expansion.LimitOrderFilledDelta.Order.OrderInfo.Price, expansion.LimitOrderFilledDelta.FillQuantity)
+	}
+
+	// settle the execution on the cached context to obtain the resulting position
+	batchExecutionData := execution.getMarketDerivativeBatchExecutionData(market, markPrice, funding, positionStates, false)
+	k.PersistSingleDerivativeMarketOrderExecution(ctx, batchExecutionData, NewDerivativeVwapInfo(), types.NewTradingRewardPoints(), NewModifiedPositionCache(), false)
+
+	discountedFeeRate := k.FetchAndUpdateDiscountedTradingFeeRate(ctx, takerFeeRate, false, sender, feeDiscountConfig)
+	response := newSimulateOrderResponse(fills, clearingQuantity, clearingPrice, discountedFeeRate, marginRequired)
+
+	position := k.GetPosition(ctx, marketID, subaccountID)
+	if position == nil || !position.Quantity.IsPositive() {
+		return response, nil
+	}
+
+	response.Position = position
+	if derivativeMarket, ok := market.(*types.DerivativeMarket); ok {
+		liquidationPrice := position.GetLiquidationPrice(derivativeMarket.MaintenanceMarginRatio, funding)
+		response.LiquidationPrice = &liquidationPrice
+	}
+	return response, nil
+}
+
+func getSimulatedOrderInfo(sender sdk.AccAddress, req *types.QuerySimulateOrderRequest) types.OrderInfo {
+	return types.OrderInfo{
+		SubaccountId: req.SubaccountId,
+		FeeRecipient: sender.String(),
+		Price:        req.Price,
+		Quantity:     req.Quantity,
+	}
+}
+
+// appendFillToPriceLevels adds the fill to the last price level if it has the same price, since fills are ordered by price
+func appendFillToPriceLevels(levels []*types.PriceLevel, price, quantity sdk.Dec) []*types.PriceLevel {
+	if quantity.IsNil() || !quantity.IsPositive() {
+		return levels
+	}
+
+	if len(levels) > 0 && levels[len(levels)-1].Price.Equal(price) {
+		lastLevel := levels[len(levels)-1]
+		lastLevel.Quantity = lastLevel.Quantity.Add(quantity)
+		return levels
+	}
+	return append(levels, &types.PriceLevel{
+		Price:    price,
+		Quantity: quantity,
+	})
+}
+
+func newSimulateOrderResponse(
+	fills []*types.PriceLevel,
+	filledQuantity, averagePrice sdk.Dec,
+	feeRate, marginRequired sdk.Dec,
+) *types.QuerySimulateOrderResponse {
+	if filledQuantity.IsNil() || !filledQuantity.IsPositive() {
+		filledQuantity, averagePrice = sdk.ZeroDec(), sdk.ZeroDec()
+	}
+
+	return &types.QuerySimulateOrderResponse{
+		Fills:          fills,
+		FilledQuantity: filledQuantity,
+		AveragePrice:   averagePrice,
+		FeeRate:        feeRate,
+		Fee:            filledQuantity.Mul(averagePrice).Mul(feeRate),
+		MarginRequired: marginRequired,
+	}
+}
@@ -121,9 +121,48 @@ func (k *Keeper) GetSpotLimitMatchingBatchExecutionData(
 			SellOrders: newRestingSellSpotLimitOrders,
 		}
 	}
+
+	// fully filled new iceberg orders never rest on the book, so their refill is scheduled here
+	batch.ExhaustedIcebergOrders = getExhaustedTransientIcebergOrders(orderbookResults)
+	batch.FilledTransientOrders = getFilledTransientOrders(orderbookResults)
 	return batch
 }
 
+func getExhaustedTransientIcebergOrders(orderbookResults *ordermatching.SpotOrderbookMatchingResults) []*types.SpotLimitOrder {
+	exhaustedOrders := make([]*types.SpotLimitOrder, 0)
+
+	for _, fills := range []*ordermatching.OrderbookFills{orderbookResults.TransientBuyOrderbookFills, orderbookResults.TransientSellOrderbookFills} {
+		if fills == nil {
+			continue
+		}
+
+		for _, order := range fills.Orders {
+			if order.Fillable.IsZero() && order.HasHiddenQuantity() {
+				exhaustedOrders = append(exhaustedOrders, order)
+			}
+		}
+	}
+	return exhaustedOrders
+}
+
+// getFilledTransientOrders returns the new non-iceberg orders which were fully filled, and so never rest on the book
+func getFilledTransientOrders(orderbookResults *ordermatching.SpotOrderbookMatchingResults) []*types.SpotLimitOrder {
+	filledOrders := make([]*types.SpotLimitOrder, 0)
+
+	for _, fills := range []*ordermatching.OrderbookFills{orderbookResults.TransientBuyOrderbookFills, orderbookResults.TransientSellOrderbookFills} {
+		if fills == nil {
+			continue
+		}
+
+		for _, order := range fills.Orders {
+			if order.Fillable.IsZero() && !order.HasHiddenQuantity() {
+				filledOrders = append(filledOrders, order)
+			}
+		}
+	}
+	return filledOrders
+}
+
 func (k *Keeper) PersistSpotMatchingExecution(ctx sdk.Context, batchSpotMatchingExecutionData []*SpotBatchExecutionData, spotVwapData SpotVwapInfo, tradingRewardPoints types.TradingRewardPoints) types.TradingRewardPoints {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
@@ -149,6 +188,10 @@ func (k *Keeper) PersistSpotMatchingExecution(ctx sdk.Context, batchSpotMatching
 			k.UpdateDepositWithDelta(ctx, subaccountID, quoteDenom, execution.QuoteDenomDepositDeltas[subaccountID])
 		}
 
+		for _, executionEvent := range execution.LimitOrderExecutionEvent {
+			k.RecordSpotLimitOrderFills(ctx, executionEvent)
+		}
+
 		if execution.NewOrdersEvent != nil {
 			for idx := range execution.NewOrdersEvent.BuyOrders {
 				k.SetNewSpotLimitOrder(ctx,
@@ -174,6 +217,14 @@ func (k *Keeper) PersistSpotMatchingExecution(ctx sdk.Context, batchSpotMatching
 			k.UpdateSpotLimitOrder(ctx, marketID, limitOrderDelta)
 		}
 
+		for _, order := range execution.ExhaustedIcebergOrders {
+			k.SetSpotIcebergRefill(ctx, marketID, order)
+		}
+
+		for _, order := range execution.FilledTransientOrders {
+			k.RecordSpotTerminalOrder(ctx, marketID, order, types.OrderTerminationReason_FullyFilled)
+		}
+
 		for idx := range execution.LimitOrderExecutionEvent {
 			if execution.LimitOrderExecutionEvent[idx] != nil {
 				// nolint:errcheck //ignored on purpose
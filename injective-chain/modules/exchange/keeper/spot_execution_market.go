@@ -141,12 +141,18 @@ func (k *Keeper) PersistSingleSpotMarketOrderExecution(
 		k.UpdateDepositWithDelta(ctx, subaccountID, quoteDenom, execution.QuoteDenomDepositDeltas[subaccountID])
 	}
 
+	for _, executionEvent := range execution.LimitOrderExecutionEvent {
+		k.RecordSpotLimitOrderFills(ctx, executionEvent)
+	}
+
 	for _, limitOrderDelta := range execution.LimitOrderFilledDeltas {
 		k.UpdateSpotLimitOrder(ctx, marketID, limitOrderDelta)
 	}
 
 	// only get first index since only one limit order side that gets filled
 	if execution.MarketOrderExecutionEvent != nil {
+		k.RecordTWAPSpotFills(ctx, execution.MarketOrderExecutionEvent.Trades)
+
 		// nolint:errcheck //ignored on purpose
 		ctx.EventManager().EmitTypedEvent(execution.MarketOrderExecutionEvent)
 	}
@@ -20,6 +20,8 @@ type SpotBatchExecutionData struct {
 	MarketOrderExecutionEvent      *types.EventBatchSpotExecution
 	LimitOrderExecutionEvent       []*types.EventBatchSpotExecution
 	NewOrdersEvent                 *types.EventNewSpotOrders
+	ExhaustedIcebergOrders         []*types.SpotLimitOrder
+	FilledTransientOrders          []*types.SpotLimitOrder
 	TradingRewardPoints            types.TradingRewardPoints
 	VwapData                       *SpotVwapData
 }
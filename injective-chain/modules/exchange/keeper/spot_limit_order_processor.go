@@ -141,18 +141,20 @@ func (k *Keeper) getMarketOrderStateExpansionsAndClearingPrice(
 		return
 	}
 
-	marketOrderbook := ordermatching.NewSpotMarketOrderbook(marketOrders)
+	marketOrderbook := ordermatching.NewSpotMarketOrderbook(marketOrders, takerFeeRate, market.MinQuantityTickSize)
 
 	// Determine matchable market orders and limit orders
 	for {
-		var buyOrder, sellOrder *types.PriceLevel
+		var buyOrder, sellOrder, limitOrder *types.PriceLevel
 
 		if isMarketBuy {
 			buyOrder = marketOrderbook.Peek()
 			sellOrder = limitOrderbook.Peek()
+			limitOrder = sellOrder
 		} else {
 			sellOrder = marketOrderbook.Peek()
 			buyOrder = limitOrderbook.Peek()
+			limitOrder = buyOrder
 		}
 
 		// Base Case: Iterated over all the orders!
@@ -168,7 +170,14 @@ func (k *Keeper) getMarketOrderStateExpansionsAndClearingPrice(
 			break
 		}
 
-		if err := marketOrderbook.Fill(matchQuantityIncrement); err != nil {
+		// quote market orders are only filled up to their remaining quote amount at the limit order price
+		matchQuantityIncrement = marketOrderbook.GetFillableQuantity(limitOrder.Price, matchQuantityIncrement)
+		if matchQuantityIncrement.IsZero() {
+			// the quote amount of the market order is exhausted, move on to the next one
+			continue
+		}
+
+		if err := marketOrderbook.Fill(matchQuantityIncrement, limitOrder.Price); err != nil {
 			k.Logger(ctx).Error("Fill marketOrderbook failed during getMarketOrderStateExpansionsAndClearingPrice:", err)
 		}
 		if err := limitOrderbook.Fill(matchQuantityIncrement); err != nil {
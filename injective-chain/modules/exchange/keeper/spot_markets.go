@@ -148,7 +148,7 @@ func (k *Keeper) handleSpotMakerFeeIncrease(ctx sdk.Context, buyOrderbook []*typ
 			}
 		}
 
-		k.CancelSpotLimitOrder(ctx, prevMarket, marketID, subaccountID, isBuy, order)
+		k.CancelSpotLimitOrder(ctx, prevMarket, marketID, subaccountID, isBuy, order, types.OrderTerminationReason_MarketUpdateCancellation)
 	}
 }
 
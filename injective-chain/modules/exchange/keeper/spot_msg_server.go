@@ -131,6 +131,10 @@ func (k *Keeper) createSpotLimitOrder(
 	// 5. If Post Only, add the order to the resting orderbook
 	//    Otherwise store the order in the transient limit order store and transient market indicator store
 	spotLimitOrder := order.GetNewSpotLimitOrder(sender, orderHash)
+	if order.IsIceberg() {
+		// only the visible slice is displayed on the orderbook, the rest stays in the hidden reserve
+		spotLimitOrder.ApplyIcebergSlice(*order.VisibleQuantity, balanceHoldIncrement)
+	}
 
 	// 4. store the order in the conditional spot limit order store
 	if order.IsConditional() {
@@ -175,14 +179,10 @@ func (k SpotMsgServer) CreateSpotMarketOrder(goCtx context.Context, msg *types.M
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	var (
-		marketID     = common.HexToHash(msg.Order.MarketId)
-		sender       = sdk.MustAccAddressFromBech32(msg.Sender)
-		subaccountID = types.MustGetSubaccountIDOrDeriveFromNonce(sender, msg.Order.OrderInfo.SubaccountId)
+		marketID = common.HexToHash(msg.Order.MarketId)
+		sender   = sdk.MustAccAddressFromBech32(msg.Sender)
 	)
 
-	// populate the order with the actual subaccountID value, since it might be a nonce value
-	msg.Order.OrderInfo.SubaccountId = subaccountID.Hex()
-
 	// 1a. Reject if spot market id does not reference an active spot market
 	market := k.GetSpotMarket(ctx, marketID, true)
 	if market == nil {
@@ -191,77 +191,126 @@ func (k SpotMsgServer) CreateSpotMarketOrder(goCtx context.Context, msg *types.M
 		return nil, sdkerrors.Wrapf(types.ErrSpotMarketNotFound, "active spot market doesn't exist %s", msg.Order.MarketId)
 	}
 
-	if err := msg.Order.CheckTickSize(market.MinPriceTickSize, market.MinQuantityTickSize); err != nil {
+	orderHash, marketOrderResults, err := k.createSpotMarketOrder(ctx, sender, &msg.Order, market)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &types.MsgCreateSpotMarketOrderResponse{
+		OrderHash: orderHash.Hex(),
+	}
+
+	if marketOrderResults != nil {
+		response.Results = marketOrderResults
+	}
+	return response, nil
+}
+
+func (k SpotMsgServer) CreateSpotQuoteMarketOrder(goCtx context.Context, msg *types.MsgCreateSpotQuoteMarketOrder) (*types.MsgCreateSpotQuoteMarketOrderResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var (
+		marketID = msg.MarketID()
+		sender   = sdk.MustAccAddressFromBech32(msg.Sender)
+	)
+
+	market := k.GetSpotMarket(ctx, marketID, true)
+	if market == nil {
+		k.Logger(ctx).Error("active spot market doesn't exist", "marketId", msg.MarketId)
 		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrSpotMarketNotFound, "active spot market doesn't exist %s", msg.MarketId)
+	}
+
+	orderHash, results, err := k.createSpotQuoteMarketOrder(ctx, sender, msg, market)
+	if err != nil {
 		return nil, err
 	}
 
+	return &types.MsgCreateSpotQuoteMarketOrderResponse{
+		OrderHash: orderHash.Hex(),
+		Results:   results,
+	}, nil
+}
+
+func (k *Keeper) createSpotMarketOrder(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	spotOrder *types.SpotOrder,
+	market *types.SpotMarket,
+) (orderHash common.Hash, results *types.SpotMarketOrderResults, err error) {
+	var (
+		marketID     = market.MarketID()
+		subaccountID = types.MustGetSubaccountIDOrDeriveFromNonce(sender, spotOrder.OrderInfo.SubaccountId)
+	)
+
+	// populate the order with the actual subaccountID value, since it might be a nonce value
+	spotOrder.OrderInfo.SubaccountId = subaccountID.Hex()
+
+	if err := spotOrder.CheckTickSize(market.MinPriceTickSize, market.MinQuantityTickSize); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return orderHash, nil, err
+	}
+
 	// 1b. Check access level if order type is atomic
-	isAtomic := msg.Order.OrderType.IsAtomic()
+	isAtomic := spotOrder.OrderType.IsAtomic()
 	if isAtomic {
 		err := k.ensureValidAccessLevelForAtomicExecution(ctx, sender)
 		if err != nil {
-			return nil, err
+			return orderHash, nil, err
 		}
 	}
 
 	// 2. Check and increment Subaccount Nonce, Compute Order Hash
 	subaccountNonce := k.IncrementSubaccountTradeNonce(ctx, subaccountID)
-	orderHash, err := msg.Order.ComputeOrderHash(subaccountNonce.Nonce)
+	orderHash, err = spotOrder.ComputeOrderHash(subaccountNonce.Nonce)
 	if err != nil {
 		metrics.ReportFuncError(k.svcTags)
-		return nil, err
+		return orderHash, nil, err
 	}
 
-	marginDenom := msg.Order.GetMarginDenom(market)
+	marginDenom := spotOrder.GetMarginDenom(market)
 
 	// 3. Check the order crosses TOB
-	bestPrice := k.GetBestSpotLimitOrderPrice(ctx, marketID, !msg.Order.IsBuy())
+	bestPrice := k.GetBestSpotLimitOrderPrice(ctx, marketID, !spotOrder.IsBuy())
 
 	if bestPrice == nil {
 		metrics.ReportFuncError(k.svcTags)
-		return nil, types.ErrNoLiquidity
-	} else if msg.Order.IsBuy() && msg.Order.OrderInfo.Price.LT(*bestPrice) ||
-		!msg.Order.IsBuy() && msg.Order.OrderInfo.Price.GT(*bestPrice) {
+		return orderHash, nil, types.ErrNoLiquidity
+	} else if spotOrder.IsBuy() && spotOrder.OrderInfo.Price.LT(*bestPrice) ||
+		!spotOrder.IsBuy() && spotOrder.OrderInfo.Price.GT(*bestPrice) {
 		// If market buy order worst price less than best sell order price
 		// or market sell order worst price greater than best buy order price
 		metrics.ReportFuncError(k.svcTags)
-		return nil, types.ErrSlippageExceedsWorstPrice
+		return orderHash, nil, types.ErrSlippageExceedsWorstPrice
 	}
 
 	// 4. Check available balance to fund the market order factoring in fee discounts, based on the worst acceptable price for the market order
 	feeRate := market.TakerFeeRate
-	if msg.Order.OrderType.IsAtomic() {
-		feeRate = feeRate.Mul(k.Keeper.GetMarketAtomicExecutionFeeMultiplier(ctx, marketID, types.MarketType_Spot))
+	if spotOrder.OrderType.IsAtomic() {
+		feeRate = feeRate.Mul(k.GetMarketAtomicExecutionFeeMultiplier(ctx, marketID, types.MarketType_Spot))
 	}
 
-	balanceHold := msg.Order.GetMarketOrderBalanceHold(feeRate, *bestPrice)
+	balanceHold := spotOrder.GetMarketOrderBalanceHold(feeRate, *bestPrice)
 
 	// 5. Decrement deposit's AvailableBalance by the balance hold
 	if err := k.chargeAccount(ctx, subaccountID, marginDenom, balanceHold); err != nil {
-		return nil, err
+		return orderHash, nil, err
 	}
 
-	marketOrder := msg.Order.ToSpotMarketOrder(sender, balanceHold, orderHash)
+	marketOrder := spotOrder.ToSpotMarketOrder(sender, balanceHold, orderHash)
 
-	var marketOrderResults *types.SpotMarketOrderResults
 	if isAtomic {
-		marketOrderResults = k.ExecuteAtomicSpotMarketOrder(ctx, market, marketOrder, feeRate)
+		results = k.ExecuteAtomicSpotMarketOrder(ctx, market, marketOrder, feeRate)
 	} else {
 		// 6. Store the order in the transient spot market order store and transient market indicator store
-		k.SetTransientSpotMarketOrder(ctx, marketOrder, &msg.Order, orderHash)
+		k.SetTransientSpotMarketOrder(ctx, marketOrder, spotOrder, orderHash)
 	}
 
 	k.CheckAndSetFeeDiscountAccountActivityIndicator(ctx, marketID, sender)
 
-	response := &types.MsgCreateSpotMarketOrderResponse{
-		OrderHash: orderHash.Hex(),
-	}
-
-	if marketOrderResults != nil {
-		response.Results = marketOrderResults
-	}
-	return response, nil
+	return orderHash, results, nil
 }
 
 func (k SpotMsgServer) BatchCreateSpotLimitOrders(goCtx context.Context, msg *types.MsgBatchCreateSpotLimitOrders) (*types.MsgBatchCreateSpotLimitOrdersResponse, error) {
@@ -315,7 +364,7 @@ func (k SpotMsgServer) CancelSpotOrder(goCtx context.Context, msg *types.MsgCanc
 
 	// Reject if spot market id does not reference an active, suspended or demolished spot market
 	market := k.GetSpotMarketByID(ctx, marketID)
-	err := k.cancelSpotLimitOrder(ctx, subaccountID, orderHash, market, marketID)
+	err := k.cancelSpotLimitOrder(ctx, subaccountID, orderHash, market, marketID, types.OrderTerminationReason_UserCancellation)
 	return &types.MsgCancelSpotOrderResponse{}, err
 }
 
@@ -325,6 +374,7 @@ func (k *Keeper) cancelSpotLimitOrder(
 	orderHash common.Hash,
 	market *types.SpotMarket,
 	marketID common.Hash,
+	reason types.OrderTerminationReason,
 ) (err error) {
 
 	if market == nil || !market.StatusSupportsOrderCancellations() {
@@ -346,7 +396,7 @@ func (k *Keeper) cancelSpotLimitOrder(
 	if isTransient {
 		k.CancelTransientSpotLimitOrder(ctx, market, marketID, subaccountID, order)
 	} else {
-		k.CancelSpotLimitOrder(ctx, market, marketID, subaccountID, order.IsBuy(), order)
+		k.CancelSpotLimitOrder(ctx, market, marketID, subaccountID, order.IsBuy(), order, reason)
 	}
 	return nil
 }
@@ -39,6 +39,7 @@ func (k *Keeper) SetNewSpotLimitOrder(
 
 	// update the orderbook metadata
 	k.IncrementOrderbookPriceLevelQuantity(ctx, marketID, isBuy, true, order.GetPrice(), order.GetFillable())
+	k.SetOrderInsertionSequence(ctx, orderHash, k.GetOrderbookSequence(ctx, marketID))
 }
 
 // SetConditionalSpotMarketOrder stores conditional order in a store
@@ -102,6 +103,7 @@ func (k *Keeper) CancelAllSpotLimitOrders(
 	market *types.SpotMarket,
 	subaccountID common.Hash,
 	marketID common.Hash,
+	reason types.OrderTerminationReason,
 ) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
@@ -111,11 +113,11 @@ func (k *Keeper) CancelAllSpotLimitOrders(
 	transientSellOrders := k.GetAllTransientSpotLimitOrdersBySubaccountAndMarket(ctx, marketID, false, subaccountID)
 
 	for idx := range restingBuyOrders {
-		k.CancelSpotLimitOrder(ctx, market, marketID, subaccountID, true, restingBuyOrders[idx])
+		k.CancelSpotLimitOrder(ctx, market, marketID, subaccountID, true, restingBuyOrders[idx], reason)
 	}
 
 	for idx := range restingSellOrders {
-		k.CancelSpotLimitOrder(ctx, market, marketID, subaccountID, false, restingSellOrders[idx])
+		k.CancelSpotLimitOrder(ctx, market, marketID, subaccountID, false, restingSellOrders[idx], reason)
 	}
 
 	for idx := range transientBuyOrders {
@@ -136,7 +138,7 @@ func (k *Keeper) CancelAllRestingLimitOrdersFromSpotMarket(
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
 	cancelFunc := func(order *types.SpotLimitOrder) bool {
-		err := k.cancelSpotLimitOrder(ctx, order.SubaccountID(), order.Hash(), market, marketID)
+		err := k.cancelSpotLimitOrder(ctx, order.SubaccountID(), order.Hash(), market, marketID, types.OrderTerminationReason_MarketUpdateCancellation)
 		return err != nil
 	}
 
@@ -284,7 +286,7 @@ func (k *Keeper) IterateSpotLimitOrdersBySubaccount(
 	}
 }
 
-// CancelSpotLimitOrder cancels the SpotLimitOrder
+// CancelSpotLimitOrder cancels the SpotLimitOrder and records its terminal state with the given reason
 func (k *Keeper) CancelSpotLimitOrder(
 	ctx sdk.Context,
 	market *types.SpotMarket,
@@ -292,6 +294,7 @@ func (k *Keeper) CancelSpotLimitOrder(
 	subaccountID common.Hash,
 	isBuy bool,
 	order *types.SpotLimitOrder,
+	reason types.OrderTerminationReason,
 ) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
@@ -303,6 +306,7 @@ func (k *Keeper) CancelSpotLimitOrder(
 
 	// 3. Delete the order state from ordersStore and ordersIndexStore
 	k.DeleteSpotLimitOrder(ctx, marketID, isBuy, order)
+	k.RecordSpotTerminalOrder(ctx, marketID, order, reason)
 
 	// nolint:errcheck //ignored on purpose
 	ctx.EventManager().EmitTypedEvent(&types.EventCancelSpotOrder{
@@ -332,6 +336,13 @@ func (k *Keeper) UpdateSpotLimitOrder(
 	if orderDelta.Order.Fillable.IsZero() {
 		ordersStore.Delete(priceKey)
 		ordersIndexStore.Delete(subaccountIndexKey)
+		k.DeleteOrderInsertionSequence(ctx, orderDelta.Order.Hash())
+
+		if orderDelta.Order.HasHiddenQuantity() {
+			k.SetSpotIcebergRefill(ctx, marketID, orderDelta.Order)
+		} else {
+			k.RecordSpotTerminalOrder(ctx, marketID, orderDelta.Order, types.OrderTerminationReason_FullyFilled)
+		}
 	} else {
 		orderBz := k.cdc.MustMarshal(orderDelta.Order)
 		ordersStore.Set(priceKey, orderBz)
@@ -429,6 +440,7 @@ func (k *Keeper) DeleteSpotLimitOrder(
 
 	// delete from subaccount index key store
 	ordersIndexStore.Delete(subaccountKey)
+	k.DeleteOrderInsertionSequence(ctx, common.BytesToHash(order.OrderHash))
 
 	// update orderbook metadata
 	k.DecrementOrderbookPriceLevelQuantity(ctx, marketID, isBuy, true, order.GetPrice(), order.GetFillable())
@@ -708,6 +720,19 @@ func (k *Keeper) GetConditionalSpotLimitOrderBySubaccountIDAndHash(
 func (k *Keeper) ExecuteAtomicSpotMarketOrder(ctx sdk.Context, market *types.SpotMarket, marketOrder *types.SpotMarketOrder, feeRate sdk.Dec) *types.SpotMarketOrderResults {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
+	// a trade will always occur since there must exist at least one spot limit order that will cross
+	marketOrderTrade := k.executeSingleSpotMarketOrder(ctx, market, marketOrder, feeRate)
+
+	return &types.SpotMarketOrderResults{
+		Quantity: marketOrderTrade.Quantity,
+		Price:    marketOrderTrade.Price,
+		Fee:      marketOrderTrade.Fee,
+	}
+}
+
+// executeSingleSpotMarketOrder matches a single spot market order against the resting orderbook on its own, persists
+// the execution and returns the trade of the market order.
+func (k *Keeper) executeSingleSpotMarketOrder(ctx sdk.Context, market *types.SpotMarket, marketOrder *types.SpotMarketOrder, feeRate sdk.Dec) *types.TradeLog {
 	marketID := market.MarketID()
 
 	stakingInfo, feeDiscountConfig := k.getFeeDiscountConfigAndStakingInfoForMarket(ctx, marketID)
@@ -731,12 +756,5 @@ func (k *Keeper) ExecuteAtomicSpotMarketOrder(ctx sdk.Context, market *types.Spo
 	k.PersistFeeDiscountStakingInfoUpdates(ctx, stakingInfo)
 	k.PersistVwapInfo(ctx, spotVwapInfo, nil)
 
-	// a trade will always occur since there must exist at least one spot limit order that will cross
-	marketOrderTrade := batchExecutionData.MarketOrderExecutionEvent.Trades[0]
-
-	return &types.SpotMarketOrderResults{
-		Quantity: marketOrderTrade.Quantity,
-		Price:    marketOrderTrade.Price,
-		Fee:      marketOrderTrade.Fee,
-	}
+	return batchExecutionData.MarketOrderExecutionEvent.Trades[0]
 }
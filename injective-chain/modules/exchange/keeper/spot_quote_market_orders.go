@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// SetTransientSpotQuoteMarketOrder stores a spot quote market order in the transient store until it is executed in
+// the EndBlocker.
+func (k *Keeper) SetTransientSpotQuoteMarketOrder(ctx sdk.Context, marketID common.Hash, marketOrder *types.SpotMarketOrder) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getTransientStore(ctx)
+	ordersStore := prefix.NewStore(store, types.SpotQuoteMarketOrdersPrefix)
+	key := append(marketID.Bytes(), marketOrder.OrderHash...)
+	ordersStore.Set(key, k.cdc.MustMarshal(marketOrder))
+}
+
+// GetAllTransientSpotQuoteMarketOrders returns the spot quote market orders created in the current block along with
+// their market IDs, ordered by market ID and order hash.
+func (k *Keeper) GetAllTransientSpotQuoteMarketOrders(ctx sdk.Context) (marketIDs []common.Hash, marketOrders []*types.SpotMarketOrder) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getTransientStore(ctx)
+	ordersStore := prefix.NewStore(store, types.SpotQuoteMarketOrdersPrefix)
+
+	iterator := ordersStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	marketIDs = make([]common.Hash, 0)
+	marketOrders = make([]*types.SpotMarketOrder, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.SpotMarketOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &order)
+		marketIDs = append(marketIDs, common.BytesToHash(iterator.Key()[:common.HashLength]))
+		marketOrders = append(marketOrders, &order)
+	}
+	return marketIDs, marketOrders
+}
+
+func (k *Keeper) createSpotQuoteMarketOrder(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	msg *types.MsgCreateSpotQuoteMarketOrder,
+	market *types.SpotMarket,
+) (orderHash common.Hash, results *types.SpotQuoteMarketOrderResults, err error) {
+	var (
+		marketID     = market.MarketID()
+		subaccountID = types.MustGetSubaccountIDOrDeriveFromNonce(sender, msg.SubaccountId)
+		isBuy        = msg.OrderType.IsBuy()
+		isAtomic     = msg.OrderType.IsAtomic()
+	)
+
+	if isAtomic {
+		if err := k.ensureValidAccessLevelForAtomicExecution(ctx, sender); err != nil {
+			return orderHash, nil, err
+		}
+	}
+
+	// the slippage is guarded against the best price of the opposite side of the orderbook
+	bestPrice := k.GetBestSpotLimitOrderPrice(ctx, marketID, !isBuy)
+	if bestPrice == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return orderHash, nil, types.ErrNoLiquidity
+	}
+
+	feeRate := market.TakerFeeRate
+	if isAtomic {
+		feeRate = feeRate.Mul(k.GetMarketAtomicExecutionFeeMultiplier(ctx, marketID, types.MarketType_Spot))
+	}
+
+	worstPrice := msg.GetWorstPrice(*bestPrice, market.MinPriceTickSize)
+	balanceHold := msg.GetBalanceHold(worstPrice, feeRate, market.MinQuantityTickSize)
+
+	// buys can be filled at most with the quantity the quote amount buys at the best price, sells with the quantity held
+	quantity := balanceHold
+	if isBuy {
+		quantity = types.GetQuoteAmountQuantity(true, msg.QuoteAmount, *bestPrice, feeRate, market.MinQuantityTickSize)
+	}
+
+	if !quantity.IsPositive() {
+		metrics.ReportFuncError(k.svcTags)
+		return orderHash, nil, sdkerrors.Wrapf(types.ErrInvalidQuoteMarketOrder, "quote amount %s does not fill the min quantity tick size %s at the best price %s", msg.QuoteAmount.String(), market.MinQuantityTickSize.String(), bestPrice.String())
+	}
+
+	spotOrder := msg.ToSpotOrder(subaccountID, worstPrice, quantity)
+
+	subaccountNonce := k.IncrementSubaccountTradeNonce(ctx, subaccountID)
+	orderHash, err = spotOrder.ComputeOrderHash(subaccountNonce.Nonce)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return orderHash, nil, err
+	}
+
+	if err := k.chargeAccount(ctx, subaccountID, spotOrder.GetMarginDenom(market), balanceHold); err != nil {
+		return orderHash, nil, err
+	}
+
+	quoteAmount := msg.QuoteAmount
+	marketOrder := spotOrder.ToSpotMarketOrder(sender, balanceHold, orderHash)
+	marketOrder.QuoteAmount = &quoteAmount
+
+	if isAtomic {
+		results = k.executeSpotQuoteMarketOrder(ctx, market, marketOrder, feeRate)
+	} else {
+		k.SetTransientSpotQuoteMarketOrder(ctx, marketID, marketOrder)
+	}
+
+	k.CheckAndSetFeeDiscountAccountActivityIndicator(ctx, marketID, sender)
+
+	return orderHash, results, nil
+}
+
+// executeSpotQuoteMarketOrder matches a spot quote market order against the resting orderbook on its own, so that it
+// spends or receives at most its own quote amount, and returns the filled amounts. Any unfilled balance hold is
+// refunded.
+func (k *Keeper) executeSpotQuoteMarketOrder(
+	ctx sdk.Context,
+	market *types.SpotMarket,
+	marketOrder *types.SpotMarketOrder,
+	feeRate sdk.Dec,
+) *types.SpotQuoteMarketOrderResults {
+	isBuy := marketOrder.IsBuy()
+
+	// the quantity a buy can be filled with depends on the best price at execution
+	if bestPrice := k.GetBestSpotLimitOrderPrice(ctx, market.MarketID(), !isBuy); bestPrice != nil && isBuy {
+		marketOrder.OrderInfo.Quantity = types.GetQuoteAmountQuantity(true, *marketOrder.QuoteAmount, *bestPrice, feeRate, market.MinQuantityTickSize)
+	}
+
+	marketOrderTrade := k.executeSingleSpotMarketOrder(ctx, market, marketOrder, feeRate)
+
+	results := &types.SpotQuoteMarketOrderResults{
+		Quantity:    sdk.ZeroDec(),
+		QuoteAmount: sdk.ZeroDec(),
+		Price:       sdk.ZeroDec(),
+		Fee:         sdk.ZeroDec(),
+	}
+
+	if !marketOrderTrade.Quantity.IsPositive() {
+		return results
+	}
+
+	results.Quantity = marketOrderTrade.Quantity
+	results.Price = marketOrderTrade.Price
+	results.Fee = marketOrderTrade.Fee
+
+	notional := marketOrderTrade.Quantity.Mul(marketOrderTrade.Price)
+	if isBuy {
+		results.QuoteAmount = notional.Add(marketOrderTrade.Fee)
+	} else {
+		results.QuoteAmount = notional.Sub(marketOrderTrade.Fee)
+	}
+	return results
+}
+
+// ExecuteSpotQuoteMarketOrders executes the spot quote market orders created in the current block, refunding the
+// balance hold of the orders whose market is no longer active.
+func (k *Keeper) ExecuteSpotQuoteMarketOrders(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	marketIDs, marketOrders := k.GetAllTransientSpotQuoteMarketOrders(ctx)
+	for idx, marketOrder := range marketOrders {
+		market := k.GetSpotMarket(ctx, marketIDs[idx], true)
+
+		if market == nil {
+			market = k.GetSpotMarketByID(ctx, marketIDs[idx])
+			marginDenom := market.BaseDenom
+			if marketOrder.IsBuy() {
+				marginDenom = market.QuoteDenom
+			}
+			k.incrementAvailableBalanceOrBank(ctx, marketOrder.SubaccountID(), marginDenom, marketOrder.BalanceHold)
+			continue
+		}
+
+		k.executeSpotQuoteMarketOrder(ctx, market, marketOrder, market.TakerFeeRate)
+	}
+}
@@ -508,6 +508,8 @@ func (k *Keeper) CancelReduceOnlySubaccountOrders(
 			panic(message)
 		}
 
+		k.RecordDerivativeTerminalOrder(ctx, marketID, order, types.OrderTerminationReason_ReduceOnlyCancellation)
+
 		cumulativeReduceOnlyQuantityToCancel = cumulativeReduceOnlyQuantityToCancel.Add(order.Fillable)
 		orders = append(orders, order)
 		// nolint:errcheck //ignored on purpose
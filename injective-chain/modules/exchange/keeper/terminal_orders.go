@@ -0,0 +1,286 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// GetLimitOrderFill returns the aggregated fills of a resting limit order, or nil if it wasn't filled.
+func (k *Keeper) GetLimitOrderFill(ctx sdk.Context, orderHash common.Hash) *types.LimitOrderFill {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	fillStore := prefix.NewStore(k.getStore(ctx), types.LimitOrderFillPrefix)
+	bz := fillStore.Get(orderHash.Bytes())
+	if bz == nil {
+		return nil
+	}
+
+	var fill types.LimitOrderFill
+	k.cdc.MustUnmarshal(bz, &fill)
+	return &fill
+}
+
+// SetLimitOrderFill stores the aggregated fills of a resting limit order.
+func (k *Keeper) SetLimitOrderFill(ctx sdk.Context, fill *types.LimitOrderFill) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	fillStore := prefix.NewStore(k.getStore(ctx), types.LimitOrderFillPrefix)
+	fillStore.Set(fill.Hash().Bytes(), k.cdc.MustMarshal(fill))
+}
+
+// DeleteLimitOrderFill deletes the aggregated fills of a limit order.
+func (k *Keeper) DeleteLimitOrderFill(ctx sdk.Context, orderHash common.Hash) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	fillStore := prefix.NewStore(k.getStore(ctx), types.LimitOrderFillPrefix)
+	fillStore.Delete(orderHash.Bytes())
+}
+
+// GetAllLimitOrderFills returns the aggregated fills of all resting limit orders.
+func (k *Keeper) GetAllLimitOrderFills(ctx sdk.Context) []*types.LimitOrderFill {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	fillStore := prefix.NewStore(k.getStore(ctx), types.LimitOrderFillPrefix)
+	iterator := fillStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	fills := make([]*types.LimitOrderFill, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var fill types.LimitOrderFill
+		k.cdc.MustUnmarshal(iterator.Value(), &fill)
+		fills = append(fills, &fill)
+	}
+	return fills
+}
+
+func (k *Keeper) addLimitOrderFill(ctx sdk.Context, orderHash common.Hash, quantity, price sdk.Dec) {
+	fill := k.GetLimitOrderFill(ctx, orderHash)
+	if fill == nil {
+		fill = types.NewLimitOrderFill(orderHash)
+	}
+
+	fill.AddFill(quantity, price)
+	k.SetLimitOrderFill(ctx, fill)
+}
+
+// RecordSpotLimitOrderFills aggregates the fills of the spot limit orders, so that their average price can be
+// recorded once they leave the orderbook. Nothing is recorded if the terminal orders aren't retained.
+func (k *Keeper) RecordSpotLimitOrderFills(ctx sdk.Context, event *types.EventBatchSpotExecution) {
+	if event == nil || k.GetTerminalOrderRetentionBlocks(ctx) == 0 {
+		return
+	}
+
+	for _, trade := range event.Trades {
+		k.addLimitOrderFill(ctx, common.BytesToHash(trade.OrderHash), trade.Quantity, trade.Price)
+	}
+}
+
+// RecordDerivativeLimitOrderFills aggregates the fills of the derivative limit orders, so that their average price
+// can be recorded once they leave the orderbook. Nothing is recorded if the terminal orders aren't retained.
+func (k *Keeper) RecordDerivativeLimitOrderFills(ctx sdk.Context, event *types.EventBatchDerivativeExecution) {
+	if event == nil || k.GetTerminalOrderRetentionBlocks(ctx) == 0 {
+		return
+	}
+
+	for _, trade := range event.Trades {
+		if trade.PositionDelta == nil {
+			continue
+		}
+		k.addLimitOrderFill(ctx, common.BytesToHash(trade.OrderHash), trade.PositionDelta.ExecutionQuantity, trade.PositionDelta.ExecutionPrice)
+	}
+}
+
+// RecordSpotTerminalOrder records the terminal state of a spot limit order which left the orderbook.
+func (k *Keeper) RecordSpotTerminalOrder(ctx sdk.Context, marketID common.Hash, order *types.SpotLimitOrder, reason types.OrderTerminationReason) {
+	k.recordTerminalOrder(ctx, &types.TerminalOrder{
+		OrderHash:    order.Hash().Hex(),
+		MarketId:     marketID.Hex(),
+		SubaccountId: order.SubaccountID().Hex(),
+		IsSpot:       true,
+		OrderType:    order.OrderType,
+		Price:        order.OrderInfo.Price,
+		Quantity:     order.OrderInfo.Quantity,
+		Reason:       reason,
+	})
+}
+
+// RecordDerivativeTerminalOrder records the terminal state of a derivative or binary options limit order which left
+// the orderbook.
+func (k *Keeper) RecordDerivativeTerminalOrder(ctx sdk.Context, marketID common.Hash, order *types.DerivativeLimitOrder, reason types.OrderTerminationReason) {
+	k.recordTerminalOrder(ctx, &types.TerminalOrder{
+		OrderHash:    order.Hash().Hex(),
+		MarketId:     marketID.Hex(),
+		SubaccountId: order.SubaccountID().Hex(),
+		OrderType:    order.OrderType,
+		Price:        order.OrderInfo.Price,
+		Quantity:     order.OrderInfo.Quantity,
+		Reason:       reason,
+	})
+}
+
+func (k *Keeper) recordTerminalOrder(ctx sdk.Context, order *types.TerminalOrder) {
+	orderHash := order.Hash()
+
+	// the aggregated fills are always cleared, even when the retention has been set to zero in the meantime
+	fill := k.GetLimitOrderFill(ctx, orderHash)
+	if fill != nil {
+		k.DeleteLimitOrderFill(ctx, orderHash)
+	}
+
+	if k.GetTerminalOrderRetentionBlocks(ctx) == 0 {
+		return
+	}
+
+	if fill == nil {
+		fill = types.NewLimitOrderFill(orderHash)
+	}
+
+	order.Status = order.Reason.TerminalStatus()
+	order.FilledQuantity = fill.Quantity
+	order.AveragePrice = fill.AveragePrice()
+	order.BlockHeight = ctx.BlockHeight()
+	order.Timestamp = ctx.BlockTime().Unix()
+
+	k.SetTerminalOrder(ctx, order)
+}
+
+// GetTerminalOrder returns the terminal state of a limit order, or nil if it isn't retained.
+func (k *Keeper) GetTerminalOrder(ctx sdk.Context, orderHash common.Hash) *types.TerminalOrder {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ordersStore := prefix.NewStore(k.getStore(ctx), types.TerminalOrdersPrefix)
+	bz := ordersStore.Get(orderHash.Bytes())
+	if bz == nil {
+		return nil
+	}
+
+	var order types.TerminalOrder
+	k.cdc.MustUnmarshal(bz, &order)
+	return &order
+}
+
+// SetTerminalOrder stores the terminal state of a limit order along with its subaccount and height indexes.
+func (k *Keeper) SetTerminalOrder(ctx sdk.Context, order *types.TerminalOrder) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	// an order hash can't be reused, but the indexes of a previous record must not be left behind regardless
+	k.DeleteTerminalOrder(ctx, order.Hash())
+
+	store := k.getStore(ctx)
+	ordersStore := prefix.NewStore(store, types.TerminalOrdersPrefix)
+	subaccountIndexStore := prefix.NewStore(store, types.TerminalOrdersBySubaccountPrefix)
+	heightIndexStore := prefix.NewStore(store, types.TerminalOrdersByHeightPrefix)
+
+	ordersStore.Set(order.Hash().Bytes(), k.cdc.MustMarshal(order))
+	subaccountIndexStore.Set(types.GetTerminalOrderBySubaccountKey(order.SubaccountID(), order.BlockHeight, order.Hash()), []byte{})
+	heightIndexStore.Set(types.GetTerminalOrderByHeightKey(order.BlockHeight, order.Hash()), []byte{})
+}
+
+// DeleteTerminalOrder deletes the terminal state of a limit order along with its indexes.
+func (k *Keeper) DeleteTerminalOrder(ctx sdk.Context, orderHash common.Hash) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	order := k.GetTerminalOrder(ctx, orderHash)
+	if order == nil {
+		return
+	}
+
+	store := k.getStore(ctx)
+	ordersStore := prefix.NewStore(store, types.TerminalOrdersPrefix)
+	subaccountIndexStore := prefix.NewStore(store, types.TerminalOrdersBySubaccountPrefix)
+	heightIndexStore := prefix.NewStore(store, types.TerminalOrdersByHeightPrefix)
+
+	ordersStore.Delete(orderHash.Bytes())
+	subaccountIndexStore.Delete(types.GetTerminalOrderBySubaccountKey(order.SubaccountID(), order.BlockHeight, orderHash))
+	heightIndexStore.Delete(types.GetTerminalOrderByHeightKey(order.BlockHeight, orderHash))
+}
+
+// GetAllTerminalOrders returns all retained terminal orders.
+func (k *Keeper) GetAllTerminalOrders(ctx sdk.Context) []*types.TerminalOrder {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ordersStore := prefix.NewStore(k.getStore(ctx), types.TerminalOrdersPrefix)
+	iterator := ordersStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	orders := make([]*types.TerminalOrder, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.TerminalOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &order)
+		orders = append(orders, &order)
+	}
+	return orders
+}
+
+// GetTerminalOrdersByHashes returns the retained terminal orders with the given hashes, skipping the unknown ones.
+func (k *Keeper) GetTerminalOrdersByHashes(ctx sdk.Context, orderHashes []common.Hash) []*types.TerminalOrder {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	orders := make([]*types.TerminalOrder, 0, len(orderHashes))
+	for _, orderHash := range orderHashes {
+		if order := k.GetTerminalOrder(ctx, orderHash); order != nil {
+			orders = append(orders, order)
+		}
+	}
+	return orders
+}
+
+// GetSubaccountTerminalOrders returns a page of the retained terminal orders of a subaccount, oldest first, optionally
+// restricted to the given market.
+func (k *Keeper) GetSubaccountTerminalOrders(
+	ctx sdk.Context,
+	subaccountID common.Hash,
+	marketID *common.Hash,
+	pageRequest *query.PageRequest,
+) ([]*types.TerminalOrder, *query.PageResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	indexStore := prefix.NewStore(k.getStore(ctx), append(types.TerminalOrdersBySubaccountPrefix, subaccountID.Bytes()...))
+
+	orders := make([]*types.TerminalOrder, 0)
+	pageResponse, err := query.FilteredPaginate(indexStore, pageRequest, func(key, _ []byte, accumulate bool) (bool, error) {
+		// the key is made of the block height followed by the order hash
+		order := k.GetTerminalOrder(ctx, common.BytesToHash(key[8:]))
+		if order == nil || marketID != nil && order.MarketID() != *marketID {
+			return false, nil
+		}
+
+		if accumulate {
+			orders = append(orders, order)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return orders, pageResponse, nil
+}
+
+// PruneTerminalOrders deletes the terminal orders which have been retained for the number of blocks set by the
+// TerminalOrderRetentionBlocks param, or all of them if the retention is set to zero.
+func (k *Keeper) PruneTerminalOrders(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	lastPrunedHeight := ctx.BlockHeight() - k.GetTerminalOrderRetentionBlocks(ctx)
+	if lastPrunedHeight < 0 {
+		return
+	}
+
+	heightIndexStore := prefix.NewStore(k.getStore(ctx), types.TerminalOrdersByHeightPrefix)
+	iterator := heightIndexStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(lastPrunedHeight+1)))
+
+	orderHashes := make([]common.Hash, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		orderHashes = append(orderHashes, common.BytesToHash(iterator.Key()[8:]))
+	}
+	iterator.Close()
+
+	for _, orderHash := range orderHashes {
+		k.DeleteTerminalOrder(ctx, orderHash)
+	}
+}
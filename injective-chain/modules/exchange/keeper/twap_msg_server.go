@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+type TWAPMsgServer struct {
+	Keeper
+	svcTags metrics.Tags
+}
+
+// NewTWAPMsgServerImpl returns an implementation of the exchange MsgServer interface for the provided Keeper for TWAP order functions.
+func NewTWAPMsgServerImpl(keeper Keeper) TWAPMsgServer {
+	return TWAPMsgServer{
+		Keeper: keeper,
+		svcTags: metrics.Tags{
+			"svc": "twap_msg_h",
+		},
+	}
+}
+
+func (k TWAPMsgServer) CreateTWAPOrder(goCtx context.Context, msg *types.MsgCreateTWAPOrder) (*types.MsgCreateTWAPOrderResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+
+	orderHash, err := k.createTWAPOrder(ctx, sender, msg)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	return &types.MsgCreateTWAPOrderResponse{
+		OrderHash: orderHash.Hex(),
+	}, nil
+}
+
+func (k TWAPMsgServer) CancelTWAPOrder(goCtx context.Context, msg *types.MsgCancelTWAPOrder) (*types.MsgCancelTWAPOrderResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var (
+		sender       = sdk.MustAccAddressFromBech32(msg.Sender)
+		marketID     = common.HexToHash(msg.MarketId)
+		subaccountID = types.MustGetSubaccountIDOrDeriveFromNonce(sender, msg.SubaccountId)
+		orderHash    = common.HexToHash(msg.OrderHash)
+	)
+
+	order := k.GetTWAPOrder(ctx, marketID, subaccountID, orderHash)
+	if order == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrOrderDoesntExist, "TWAP order %s doesn't exist", msg.OrderHash)
+	}
+
+	k.cancelTWAPOrder(ctx, order)
+	return &types.MsgCancelTWAPOrderResponse{}, nil
+}
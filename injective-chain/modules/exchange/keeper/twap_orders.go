@@ -0,0 +1,488 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// SetTWAPOrder stores a TWAP order.
+func (k *Keeper) SetTWAPOrder(ctx sdk.Context, order *types.TWAPOrder) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	ordersStore := prefix.NewStore(store, types.TWAPOrdersPrefix)
+	key := types.GetTWAPOrderKey(order.MarketID(), order.SubaccountID(), order.Hash())
+	ordersStore.Set(key, k.cdc.MustMarshal(order))
+}
+
+// DeleteTWAPOrder deletes a TWAP order.
+func (k *Keeper) DeleteTWAPOrder(ctx sdk.Context, order *types.TWAPOrder) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	ordersStore := prefix.NewStore(store, types.TWAPOrdersPrefix)
+	ordersStore.Delete(types.GetTWAPOrderKey(order.MarketID(), order.SubaccountID(), order.Hash()))
+}
+
+// GetTWAPOrder fetches a TWAP order, returns nil if it doesn't exist.
+func (k *Keeper) GetTWAPOrder(ctx sdk.Context, marketID, subaccountID, orderHash common.Hash) *types.TWAPOrder {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	ordersStore := prefix.NewStore(store, types.TWAPOrdersPrefix)
+
+	bz := ordersStore.Get(types.GetTWAPOrderKey(marketID, subaccountID, orderHash))
+	if bz == nil {
+		return nil
+	}
+
+	var order types.TWAPOrder
+	k.cdc.MustUnmarshal(bz, &order)
+	return &order
+}
+
+// GetAllTraderTWAPOrders returns all TWAP orders of the trader in the given market.
+func (k *Keeper) GetAllTraderTWAPOrders(ctx sdk.Context, marketID, subaccountID common.Hash) []*types.TWAPOrder {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	ordersStore := prefix.NewStore(store, append(types.TWAPOrdersPrefix, types.MarketSubaccountInfix(marketID, subaccountID)...))
+	return k.iterateTWAPOrders(ordersStore)
+}
+
+// GetAllTWAPOrders returns all TWAP orders.
+func (k *Keeper) GetAllTWAPOrders(ctx sdk.Context) []*types.TWAPOrder {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	ordersStore := prefix.NewStore(store, types.TWAPOrdersPrefix)
+	return k.iterateTWAPOrders(ordersStore)
+}
+
+func (k *Keeper) iterateTWAPOrders(ordersStore prefix.Store) []*types.TWAPOrder {
+	iterator := ordersStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	orders := make([]*types.TWAPOrder, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.TWAPOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &order)
+		orders = append(orders, &order)
+	}
+	return orders
+}
+
+// setTransientTWAPChildOrder stores in the transient store the TWAP order a child market order was placed for,
+// so that the fills of the child order can be attributed to it.
+func (k *Keeper) setTransientTWAPChildOrder(ctx sdk.Context, childOrderHash common.Hash, order *types.TWAPOrder) {
+	store := k.getTransientStore(ctx)
+	childOrdersStore := prefix.NewStore(store, types.TWAPChildOrdersPrefix)
+	childOrdersStore.Set(childOrderHash.Bytes(), types.GetTWAPOrderKey(order.MarketID(), order.SubaccountID(), order.Hash()))
+}
+
+// recordTWAPChildOrderFill updates the progress of the TWAP order which placed the filled order, if any.
+func (k *Keeper) recordTWAPChildOrderFill(ctx sdk.Context, childOrderHash common.Hash, quantity, price sdk.Dec) {
+	childOrdersStore := prefix.NewStore(k.getTransientStore(ctx), types.TWAPChildOrdersPrefix)
+	key := childOrdersStore.Get(childOrderHash.Bytes())
+	if key == nil {
+		return
+	}
+
+	ordersStore := prefix.NewStore(k.getStore(ctx), types.TWAPOrdersPrefix)
+	bz := ordersStore.Get(key)
+	if bz == nil {
+		return
+	}
+
+	var order types.TWAPOrder
+	k.cdc.MustUnmarshal(bz, &order)
+	order.AddFill(quantity, price)
+	ordersStore.Set(key, k.cdc.MustMarshal(&order))
+}
+
+// RecordTWAPSpotFills attributes the spot market order fills to the TWAP orders which placed them.
+func (k *Keeper) RecordTWAPSpotFills(ctx sdk.Context, trades []*types.TradeLog) {
+	for _, trade := range trades {
+		k.recordTWAPChildOrderFill(ctx, common.BytesToHash(trade.OrderHash), trade.Quantity, trade.Price)
+	}
+}
+
+// RecordTWAPDerivativeFills attributes the derivative market order fills to the TWAP orders which placed them.
+func (k *Keeper) RecordTWAPDerivativeFills(ctx sdk.Context, trades []*types.DerivativeTradeLog) {
+	for _, trade := range trades {
+		if trade.PositionDelta == nil {
+			continue
+		}
+		k.recordTWAPChildOrderFill(ctx, common.BytesToHash(trade.OrderHash), trade.PositionDelta.ExecutionQuantity, trade.PositionDelta.ExecutionPrice)
+	}
+}
+
+func (k *Keeper) getTWAPOrderMarginDenom(ctx sdk.Context, order *types.TWAPOrder) string {
+	if order.IsDerivative {
+		return k.GetDerivativeOrBinaryOptionsMarket(ctx, order.MarketID(), nil).GetQuoteDenom()
+	}
+
+	market := k.GetSpotMarketByID(ctx, order.MarketID())
+	if order.IsBuy() {
+		return market.QuoteDenom
+	}
+	return market.BaseDenom
+}
+
+func (k *Keeper) createTWAPOrder(ctx sdk.Context, sender sdk.AccAddress, msg *types.MsgCreateTWAPOrder) (orderHash common.Hash, err error) {
+	var (
+		marketID     = common.HexToHash(msg.MarketId)
+		subaccountID = types.MustGetSubaccountIDOrDeriveFromNonce(sender, msg.OrderInfo.SubaccountId)
+		isDerivative bool
+		marginDenom  string
+		balanceHold  sdk.Dec
+	)
+
+	// populate the order with the actual subaccountID value, since it might be a nonce value
+	msg.OrderInfo.SubaccountId = subaccountID.Hex()
+
+	if !msg.HasLimitPrice() {
+		msg.OrderInfo.Price = sdk.ZeroDec()
+	} else {
+		msg.MaxSlippage = sdk.ZeroDec()
+	}
+
+	if spotMarket := k.GetSpotMarket(ctx, marketID, true); spotMarket != nil {
+		if !msg.Margin.IsZero() {
+			return orderHash, sdkerrors.Wrap(types.ErrInvalidTWAPOrder, "spot TWAP orders can't have a margin")
+		}
+
+		spotOrder := msg.ToSpotOrder()
+		if err := spotOrder.CheckTickSize(spotMarket.MinPriceTickSize, spotMarket.MinQuantityTickSize); err != nil {
+			return orderHash, err
+		}
+
+		if err := msg.CheckSliceQuantity(spotMarket.MinQuantityTickSize); err != nil {
+			return orderHash, err
+		}
+
+		subaccountNonce := k.IncrementSubaccountTradeNonce(ctx, subaccountID)
+		if orderHash, err = spotOrder.ComputeOrderHash(subaccountNonce.Nonce); err != nil {
+			return orderHash, err
+		}
+
+		// every slice is funded at its worst price, the one allowed by the max slippage from the current best price for
+		// orders without a limit price
+		worstPrice, err := k.getTWAPSliceWorstPrice(ctx, marketID, false, msg.OrderType.IsBuy(), msg.OrderInfo.Price, msg.MaxSlippage, spotMarket.MinPriceTickSize)
+		if err != nil {
+			return orderHash, err
+		}
+
+		balanceHold = spotOrder.GetMarketOrderBalanceHold(spotMarket.TakerFeeRate, worstPrice)
+		marginDenom = spotOrder.GetMarginDenom(spotMarket)
+	} else {
+		isEnabled := true
+		market := k.GetDerivativeOrBinaryOptionsMarket(ctx, marketID, &isEnabled)
+		if market == nil {
+			return orderHash, sdkerrors.Wrapf(types.ErrMarketInvalid, "active market for marketID %s not found", msg.MarketId)
+		}
+
+		derivativeOrder := msg.ToDerivativeOrder()
+		if err := derivativeOrder.CheckTickSize(market.GetMinPriceTickSize(), market.GetMinQuantityTickSize()); err != nil {
+			return orderHash, err
+		}
+
+		if err := derivativeOrder.CheckMarginTickSize(market.GetMinQuantityTickSize()); err != nil {
+			return orderHash, err
+		}
+
+		if err := msg.CheckSliceQuantity(market.GetMinQuantityTickSize()); err != nil {
+			return orderHash, err
+		}
+
+		subaccountNonce := k.IncrementSubaccountTradeNonce(ctx, subaccountID)
+		if orderHash, err = derivativeOrder.ComputeOrderHash(subaccountNonce.Nonce); err != nil {
+			return orderHash, err
+		}
+
+		// every slice is funded at its worst price, the one allowed by the max slippage from the current best price for
+		// orders without a limit price
+		worstPrice, err := k.getTWAPSliceWorstPrice(ctx, marketID, true, msg.OrderType.IsBuy(), msg.OrderInfo.Price, msg.MaxSlippage, market.GetMinPriceTickSize())
+		if err != nil {
+			return orderHash, err
+		}
+		if !msg.HasLimitPrice() && market.GetMarketType().IsFullyCollateralized() {
+			worstPrice = sdk.MinDec(worstPrice, market.GetMaxPrice())
+		}
+		derivativeOrder.OrderInfo.Price = worstPrice
+
+		var markPrice sdk.Dec
+		if market.GetMarketType().IsFullyCollateralized() {
+			if err := derivativeOrder.CheckFullyCollateralizedPricesWithinBounds(market.GetMaxPrice()); err != nil {
+				return orderHash, err
+			}
+		} else if _, markPrice = k.GetDerivativeMarketWithMarkPrice(ctx, marketID, true); markPrice.IsNil() {
+			return orderHash, sdkerrors.Wrapf(types.ErrDerivativeMarketNotFound, "active derivative market with valid mark price for marketID %s not found", msg.MarketId)
+		}
+
+		// reduce-only slices don't hold any balance
+		balanceHold = sdk.ZeroDec()
+		if derivativeOrder.IsVanilla() {
+			balanceHold, err = derivativeOrder.CheckMarginAndGetMarginHold(market.GetInitialMarginRatio(), markPrice, market.GetTakerFeeRate(), market.GetMarketType(), market.GetMaxPrice())
+			if err != nil {
+				return orderHash, err
+			}
+		}
+
+		isDerivative = true
+		marginDenom = market.GetQuoteDenom()
+	}
+
+	if err := k.chargeAccount(ctx, subaccountID, marginDenom, balanceHold); err != nil {
+		return orderHash, err
+	}
+
+	order := &types.TWAPOrder{
+		MarketId:           msg.MarketId,
+		OrderInfo:          msg.OrderInfo,
+		OrderType:          msg.OrderType,
+		Margin:             msg.Margin,
+		Slices:             msg.Slices,
+		IntervalBlocks:     msg.IntervalBlocks,
+		IntervalSeconds:    msg.IntervalSeconds,
+		OrderHash:          orderHash.Bytes(),
+		IsDerivative:       isDerivative,
+		NextSliceHeight:    ctx.BlockHeight(),
+		NextSliceTimestamp: ctx.BlockTime().Unix(),
+		BalanceHold:        balanceHold,
+		FilledQuantity:     sdk.ZeroDec(),
+		FilledNotional:     sdk.ZeroDec(),
+		RemainingQuantity:  msg.OrderInfo.Quantity,
+		RemainingMargin:    msg.Margin,
+		MaxSlippage:        msg.MaxSlippage,
+		PendingQuantity:    sdk.ZeroDec(),
+		PendingMargin:      sdk.ZeroDec(),
+		PendingBalanceHold: sdk.ZeroDec(),
+	}
+	k.SetTWAPOrder(ctx, order)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventNewTWAPOrder{
+		Order: *order,
+	})
+	return orderHash, nil
+}
+
+// getTWAPSliceWorstPrice returns the worst price of a slice: the limit price of the order, or the price allowed by its
+// max slippage from the best price of the opposite side of the orderbook for orders without a limit price.
+func (k *Keeper) getTWAPSliceWorstPrice(
+	ctx sdk.Context,
+	marketID common.Hash,
+	isDerivative, isBuy bool,
+	limitPrice, maxSlippage, minPriceTickSize sdk.Dec,
+) (sdk.Dec, error) {
+	if limitPrice.IsPositive() {
+		return limitPrice, nil
+	}
+
+	var bestPrice *sdk.Dec
+	if isDerivative {
+		bestPrice = k.GetBestDerivativeLimitOrderPrice(ctx, marketID, !isBuy)
+	} else {
+		bestPrice = k.GetBestSpotLimitOrderPrice(ctx, marketID, !isBuy)
+	}
+
+	if bestPrice == nil {
+		return sdk.Dec{}, types.ErrNoLiquidity
+	}
+	return types.GetMaxSlippageWorstPrice(isBuy, *bestPrice, maxSlippage, minPriceTickSize), nil
+}
+
+// cancelTWAPOrder releases the balance held for the slices not placed yet and deletes the TWAP order.
+func (k *Keeper) cancelTWAPOrder(ctx sdk.Context, order *types.TWAPOrder) {
+	k.incrementAvailableBalanceOrBank(ctx, order.SubaccountID(), k.getTWAPOrderMarginDenom(ctx, order), order.BalanceHold)
+	k.DeleteTWAPOrder(ctx, order)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventCancelTWAPOrder{
+		Order: *order,
+	})
+}
+
+// ProcessTWAPOrders places the due slices of the TWAP orders as market orders, so that they are matched with the
+// other market orders of the block. The unfilled quantity of the slices placed in the previous blocks is carried into
+// the remaining slices, and TWAP orders whose last slice was placed in a previous block are deleted.
+func (k *Keeper) ProcessTWAPOrders(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	blockHeight, blockTime := ctx.BlockHeight(), ctx.BlockTime().Unix()
+
+	for _, order := range k.GetAllTWAPOrders(ctx) {
+		if order.HasPendingSlice() {
+			k.settleTWAPOrderSlice(ctx, order)
+		}
+
+		if order.IsCompleted() {
+			// the balance still held is the one of the quantity which couldn't be placed by the last slice
+			k.incrementAvailableBalanceOrBank(ctx, order.SubaccountID(), k.getTWAPOrderMarginDenom(ctx, order), order.BalanceHold)
+			k.DeleteTWAPOrder(ctx, order)
+
+			// nolint:errcheck //ignored on purpose
+			ctx.EventManager().EmitTypedEvent(&types.EventTWAPOrderCompleted{
+				Order: *order,
+			})
+			continue
+		}
+
+		if !order.IsSliceDue(blockHeight, blockTime) {
+			continue
+		}
+
+		if order.IsDerivative {
+			k.placeDerivativeTWAPOrderSlice(ctx, order)
+		} else {
+			k.placeSpotTWAPOrderSlice(ctx, order)
+		}
+	}
+}
+
+// settleTWAPOrderSlice carries the unfilled quantity of the last placed slice into the remaining slices. The balance
+// of the unfilled quantity was refunded when the slice's market order was settled, so its share of the hold is charged
+// again, the quantity being dropped if the account can't fund it anymore or if there is no slice left.
+func (k *Keeper) settleTWAPOrderSlice(ctx sdk.Context, order *types.TWAPOrder) {
+	quantity, margin, balanceHold := order.TakePendingSlice()
+
+	if !order.IsCompleted() {
+		if err := k.chargeAccount(ctx, order.SubaccountID(), k.getTWAPOrderMarginDenom(ctx, order), balanceHold); err != nil {
+			k.Logger(ctx).Debug("failed to carry the unfilled TWAP order slice", "marketID", order.MarketId, "orderHash", order.Hash().Hex(), "quantity", quantity.String(), "err", err.Error())
+		} else {
+			order.CarrySlice(quantity, margin, balanceHold)
+		}
+	}
+
+	k.SetTWAPOrder(ctx, order)
+}
+
+func (k *Keeper) placeSpotTWAPOrderSlice(ctx sdk.Context, order *types.TWAPOrder) {
+	market := k.GetSpotMarket(ctx, order.MarketID(), true)
+	if market == nil {
+		k.cancelTWAPOrder(ctx, order)
+		return
+	}
+
+	quantity, margin, balanceHold := order.GetNextSlice(market.MinQuantityTickSize)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	childOrderHash, err := k.placeSpotTWAPOrderSliceOrder(cacheCtx, order, market, quantity, balanceHold)
+
+	k.finalizeTWAPOrderSlice(ctx, cacheCtx, writeCache, order, childOrderHash, err, quantity, margin, balanceHold)
+}
+
+func (k *Keeper) placeSpotTWAPOrderSliceOrder(ctx sdk.Context, order *types.TWAPOrder, market *types.SpotMarket, quantity, balanceHold sdk.Dec) (common.Hash, error) {
+	if !quantity.IsPositive() {
+		return common.Hash{}, sdkerrors.Wrap(types.ErrInvalidTWAPOrder, "no quantity left to place")
+	}
+
+	worstPrice, err := k.getTWAPSliceWorstPrice(ctx, market.MarketID(), false, order.IsBuy(), order.OrderInfo.Price, order.MaxSlippage, market.MinPriceTickSize)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	sliceOrder := order.GetSpotSliceOrder(quantity, worstPrice)
+
+	// release the slice's share of the hold, the slice is charged again like any new market order
+	k.incrementAvailableBalanceOrBank(ctx, order.SubaccountID(), sliceOrder.GetMarginDenom(market), balanceHold)
+
+	childOrderHash, _, err := k.createSpotMarketOrder(ctx, order.SdkAccAddress(), sliceOrder, market)
+	return childOrderHash, err
+}
+
+func (k *Keeper) placeDerivativeTWAPOrderSlice(ctx sdk.Context, order *types.TWAPOrder) {
+	isEnabled := true
+	marketID := order.MarketID()
+
+	market := k.GetDerivativeOrBinaryOptionsMarket(ctx, marketID, &isEnabled)
+	if market == nil {
+		k.cancelTWAPOrder(ctx, order)
+		return
+	}
+
+	quantity, margin, balanceHold := order.GetNextSlice(market.GetMinQuantityTickSize())
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	childOrderHash, err := k.placeDerivativeTWAPOrderSliceOrder(cacheCtx, order, market, quantity, margin, balanceHold)
+
+	k.finalizeTWAPOrderSlice(ctx, cacheCtx, writeCache, order, childOrderHash, err, quantity, margin, balanceHold)
+}
+
+func (k *Keeper) placeDerivativeTWAPOrderSliceOrder(ctx sdk.Context, order *types.TWAPOrder, market MarketI, quantity, margin, balanceHold sdk.Dec) (common.Hash, error) {
+	if !quantity.IsPositive() {
+		return common.Hash{}, sdkerrors.Wrap(types.ErrInvalidTWAPOrder, "no quantity left to place")
+	}
+
+	marketID := market.MarketID()
+
+	worstPrice, err := k.getTWAPSliceWorstPrice(ctx, marketID, true, order.IsBuy(), order.OrderInfo.Price, order.MaxSlippage, market.GetMinPriceTickSize())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	var markPrice sdk.Dec
+	if market.GetMarketType().IsFullyCollateralized() {
+		if !order.HasLimitPrice() {
+			worstPrice = sdk.MinDec(worstPrice, market.GetMaxPrice())
+		}
+	} else if _, markPrice = k.GetDerivativeMarketWithMarkPrice(ctx, marketID, true); markPrice.IsNil() {
+		return common.Hash{}, types.ErrDerivativeMarketNotFound
+	}
+
+	sliceOrder := order.GetDerivativeSliceOrder(quantity, margin, worstPrice)
+	if market.GetMarketType().IsFullyCollateralized() && sliceOrder.IsVanilla() {
+		sliceOrder.Margin = sliceOrder.GetRequiredFullyCollateralizedMargin(market.GetMaxPrice())
+	}
+
+	// release the slice's share of the hold, the slice is charged again like any new market order
+	k.incrementAvailableBalanceOrBank(ctx, order.SubaccountID(), market.GetQuoteDenom(), balanceHold)
+
+	// the slice's margin is a pro rata share of the order's margin, only the order's margin is checked against the tick size
+	childOrderHash, _, err := k.placeDerivativeMarketOrder(ctx, order.SdkAccAddress(), sliceOrder, market, markPrice)
+	return childOrderHash, err
+}
+
+// finalizeTWAPOrderSlice persists the slice's market order if it could be placed and moves the TWAP order to its next
+// slice either way. A slice which can't be placed (e.g. no liquidity within the limit price or a market order already
+// placed by the subaccount in the block) is skipped, its quantity being kept for the remaining slices.
+func (k *Keeper) finalizeTWAPOrderSlice(
+	ctx, cacheCtx sdk.Context,
+	writeCache func(),
+	order *types.TWAPOrder,
+	childOrderHash common.Hash,
+	err error,
+	quantity, margin, balanceHold sdk.Dec,
+) {
+	slice := order.ExecutedSlices + 1
+
+	var placedOrderHash []byte
+	if err != nil {
+		k.Logger(ctx).Debug("failed to place TWAP order slice", "marketID", order.MarketId, "orderHash", order.Hash().Hex(), "slice", slice, "err", err.Error())
+		order.SkipSlice(ctx.BlockHeight(), ctx.BlockTime().Unix())
+	} else {
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		k.setTransientTWAPChildOrder(ctx, childOrderHash, order)
+		placedOrderHash = childOrderHash.Bytes()
+		order.ApplySlice(quantity, margin, balanceHold, ctx.BlockHeight(), ctx.BlockTime().Unix())
+	}
+
+	k.SetTWAPOrder(ctx, order)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventTWAPOrderSlice{
+		MarketId:        order.MarketID().Bytes(),
+		SubaccountId:    order.OrderInfo.SubaccountId,
+		TwapOrderHash:   order.OrderHash,
+		PlacedOrderHash: placedOrderHash,
+		Slice:           slice,
+	})
+}
@@ -0,0 +1,378 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// VanillaOptionsMarketLaunch launches the vanilla options market of the proposal.
+func (k *Keeper) VanillaOptionsMarketLaunch(ctx sdk.Context, p *types.VanillaOptionsMarketLaunchProposal) (*types.VanillaOptionsMarket, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	relayerFeeShareRate := k.GetRelayerFeeShare(ctx)
+	minimalProtocolFeeRate := k.GetMinimalProtocolFeeRate(ctx)
+	discountSchedule := k.GetFeeDiscountSchedule(ctx)
+	if err := types.ValidateMakerWithTakerFeeAndDiscounts(p.MakerFeeRate, p.TakerFeeRate, relayerFeeShareRate, minimalProtocolFeeRate, discountSchedule); err != nil {
+		return nil, err
+	}
+
+	marketID := types.NewVanillaOptionsMarketID(p.Ticker, p.QuoteDenom, p.OracleBase, p.OracleQuote, p.OracleType, p.OptionType, p.StrikePrice, p.ExpirationTimestamp)
+
+	if !k.IsDenomValid(ctx, p.QuoteDenom) {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrInvalidQuoteDenom, "denom %s does not exist in supply", p.QuoteDenom)
+	}
+
+	if market := k.GetVanillaOptionsMarketByID(ctx, marketID); market != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrVanillaOptionsMarketExists, "ticker %s quoteDenom %s", p.Ticker, p.QuoteDenom)
+	}
+
+	// Enforce that the oracle price of the underlying exists, since the market is settled with it
+	if _, err := k.GetDerivativeMarketPrice(ctx, p.OracleBase, p.OracleQuote, p.OracleScaleFactor, p.OracleType); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	// Enforce that expiration is in the future
+	if p.ExpirationTimestamp <= ctx.BlockTime().Unix() {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrInvalidExpiry, "expiration timestamp %d is in the past", p.ExpirationTimestamp)
+	}
+
+	market := &types.VanillaOptionsMarket{
+		Ticker:              p.Ticker,
+		OracleBase:          p.OracleBase,
+		OracleQuote:         p.OracleQuote,
+		OracleType:          p.OracleType,
+		OracleScaleFactor:   p.OracleScaleFactor,
+		OptionType:          p.OptionType,
+		StrikePrice:         p.StrikePrice,
+		ContractMultiplier:  p.ContractMultiplier,
+		MaxUnderlyingPrice:  p.MaxUnderlyingPrice,
+		ExpirationTimestamp: p.ExpirationTimestamp,
+		SettlementTimestamp: p.SettlementTimestamp,
+		QuoteDenom:          p.QuoteDenom,
+		MarketId:            marketID.Hex(),
+		MakerFeeRate:        p.MakerFeeRate,
+		TakerFeeRate:        p.TakerFeeRate,
+		RelayerFeeShareRate: relayerFeeShareRate,
+		Status:              types.MarketStatus_Active,
+		MinPriceTickSize:    p.MinPriceTickSize,
+		MinQuantityTickSize: p.MinQuantityTickSize,
+		SettlementPrice:     nil,
+	}
+
+	// the max underlying price only bounds the payoff of calls
+	if !market.IsCall() {
+		market.MaxUnderlyingPrice = sdk.ZeroDec()
+	}
+
+	k.SetVanillaOptionsMarket(ctx, market)
+	k.CheckQuoteAndSetTradingRewardQualification(ctx, marketID, p.QuoteDenom)
+	k.CheckQuoteAndSetFeeDiscountQualification(ctx, marketID, p.QuoteDenom)
+
+	return market, nil
+}
+
+// getVanillaOptionsMarketsByTimestampIndex returns the markets of the given timestamp index whose timestamp has passed.
+func (k *Keeper) getVanillaOptionsMarketsByTimestampIndex(ctx sdk.Context, timestampPrefix []byte) []*types.VanillaOptionsMarket {
+	blockTime := ctx.BlockTime().Unix()
+	timestampStore := prefix.NewStore(k.getStore(ctx), timestampPrefix)
+
+	markets := make([]*types.VanillaOptionsMarket, 0)
+	endTimestampLimit := sdk.Uint64ToBigEndian(uint64(blockTime))
+	// prefix range until the end timestamp limit all
+	iter := timestampStore.Iterator(nil, endTimestampLimit)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		marketID := common.BytesToHash(iter.Value())
+		market := k.GetVanillaOptionsMarketByID(ctx, marketID)
+
+		if market == nil {
+			log.Infof("vanilla options market does not exist, marketID=%s", marketID.Hex())
+			continue
+		}
+
+		markets = append(markets, market)
+	}
+	return markets
+}
+
+// ProcessVanillaOptionsMarketsToExpireAndSettle expires the vanilla options markets whose expiration time has passed
+// and settles the ones whose settlement time has passed with the payoff of their contracts.
+func (k *Keeper) ProcessVanillaOptionsMarketsToExpireAndSettle(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	// 1. Find all markets whose expiration time has just passed and cancel all orders
+	for _, market := range k.getVanillaOptionsMarketsByTimestampIndex(ctx, types.VanillaOptionsMarketExpiryTimestampPrefix) {
+		if market.Status != types.MarketStatus_Active {
+			continue
+		}
+
+		// no need to cancel transient orders since this only runs in the BeginBlocker
+		k.CancelAllRestingDerivativeLimitOrders(ctx, market, types.OrderTerminationReason_MarketExpiry)
+		market.Status = types.MarketStatus_Expired
+		k.SetVanillaOptionsMarket(ctx, market)
+	}
+
+	// 2. Settle all markets whose settlement time has passed with the oracle price of the underlying
+	for _, market := range k.getVanillaOptionsMarketsByTimestampIndex(ctx, types.VanillaOptionsMarketSettlementTimestampPrefix) {
+		if market.Status == types.MarketStatus_Demolished {
+			continue
+		}
+
+		var settlementPrice sdk.Dec
+		if underlyingPrice := k.OracleKeeper.GetPrice(ctx, market.OracleType, market.OracleBase, market.OracleQuote); underlyingPrice != nil && !underlyingPrice.IsNil() {
+			market.SettlementPrice = underlyingPrice
+			settlementPrice = market.GetPayoff(*underlyingPrice)
+		} else {
+			// trigger refund if the underlying has no price to settle with
+			log.Infof("the vanilla options market was going to be settled but has no underlying price? marketID=%s", market.MarketId)
+			settlementPrice = types.BinaryOptionsMarketRefundFlagPrice
+		}
+
+		// closingFeeRate is zero as the writers' margin only covers the payoff
+		k.SettleMarket(ctx, market, sdk.ZeroDec(), &settlementPrice)
+
+		market.Status = types.MarketStatus_Demolished
+		k.SetVanillaOptionsMarket(ctx, market)
+	}
+}
+
+// HasVanillaOptionsMarket returns true the if the vanilla options market exists in the store.
+func (k *Keeper) HasVanillaOptionsMarket(ctx sdk.Context, marketID common.Hash, isEnabled bool) bool {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	key := types.GetVanillaOptionsMarketKey(isEnabled, marketID)
+	return store.Has(key)
+}
+
+// GetVanillaOptionsMarket fetches the vanilla options market from the store by marketID.
+func (k *Keeper) GetVanillaOptionsMarket(ctx sdk.Context, marketID common.Hash, isEnabled bool) *types.VanillaOptionsMarket {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+
+	marketStore := prefix.NewStore(store, types.GetVanillaOptionsMarketPrefix(isEnabled))
+
+	bz := marketStore.Get(marketID.Bytes())
+	if bz == nil {
+		return nil
+	}
+
+	var market types.VanillaOptionsMarket
+	k.cdc.MustUnmarshal(bz, &market)
+	return &market
+}
+
+// GetVanillaOptionsMarketByID fetches the vanilla options market from the store by marketID, regardless of its status.
+func (k *Keeper) GetVanillaOptionsMarketByID(ctx sdk.Context, marketID common.Hash) *types.VanillaOptionsMarket {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	market := k.GetVanillaOptionsMarket(ctx, marketID, true)
+	if market != nil {
+		return market
+	}
+
+	return k.GetVanillaOptionsMarket(ctx, marketID, false)
+}
+
+// SetVanillaOptionsMarket saves the vanilla options market in keeper.
+func (k *Keeper) SetVanillaOptionsMarket(ctx sdk.Context, market *types.VanillaOptionsMarket) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+
+	isEnabled := market.IsActive()
+	marketID := market.MarketID()
+
+	if k.HasVanillaOptionsMarket(ctx, marketID, !isEnabled) {
+		k.DeleteVanillaOptionsMarket(ctx, market, !isEnabled)
+	}
+
+	marketStore := prefix.NewStore(store, types.GetVanillaOptionsMarketPrefix(isEnabled))
+	bz := k.cdc.MustMarshal(market)
+	marketStore.Set(marketID.Bytes(), bz)
+
+	switch market.Status {
+	case types.MarketStatus_Active:
+		k.setVanillaOptionsMarketTimestampIndex(ctx, types.GetVanillaOptionsMarketExpiryTimestampKey(market.ExpirationTimestamp, marketID), marketID)
+		k.setVanillaOptionsMarketTimestampIndex(ctx, types.GetVanillaOptionsMarketSettlementTimestampKey(market.SettlementTimestamp, marketID), marketID)
+	case types.MarketStatus_Expired:
+		// delete the expiry timestamp index (if any), since the market is expired
+		k.deleteVanillaOptionsMarketTimestampIndex(ctx, types.GetVanillaOptionsMarketExpiryTimestampKey(market.ExpirationTimestamp, marketID))
+		k.setVanillaOptionsMarketTimestampIndex(ctx, types.GetVanillaOptionsMarketSettlementTimestampKey(market.SettlementTimestamp, marketID), marketID)
+	case types.MarketStatus_Demolished:
+		// delete the expiry and settlement timestamp index (if any), since the market is demolished
+		k.deleteVanillaOptionsMarketTimestampIndex(ctx, types.GetVanillaOptionsMarketExpiryTimestampKey(market.ExpirationTimestamp, marketID))
+		k.deleteVanillaOptionsMarketTimestampIndex(ctx, types.GetVanillaOptionsMarketSettlementTimestampKey(market.SettlementTimestamp, marketID))
+	default:
+	}
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventVanillaOptionsMarketUpdate{
+		Market: *market,
+	})
+}
+
+// DeleteVanillaOptionsMarket deletes the vanilla options market from the markets store (needed for moving to another hash).
+func (k *Keeper) DeleteVanillaOptionsMarket(ctx sdk.Context, market *types.VanillaOptionsMarket, isEnabled bool) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	marketID := market.MarketID()
+
+	k.deleteVanillaOptionsMarketTimestampIndex(ctx, types.GetVanillaOptionsMarketExpiryTimestampKey(market.ExpirationTimestamp, marketID))
+	k.deleteVanillaOptionsMarketTimestampIndex(ctx, types.GetVanillaOptionsMarketSettlementTimestampKey(market.SettlementTimestamp, marketID))
+
+	marketStore := prefix.NewStore(store, types.GetVanillaOptionsMarketPrefix(isEnabled))
+	marketStore.Delete(marketID.Bytes())
+}
+
+// setVanillaOptionsMarketTimestampIndex saves the vanilla options market id keyed by an expiration or settlement timestamp key
+func (k *Keeper) setVanillaOptionsMarketTimestampIndex(ctx sdk.Context, key []byte, marketID common.Hash) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	k.getStore(ctx).Set(key, marketID.Bytes())
+}
+
+// deleteVanillaOptionsMarketTimestampIndex deletes the vanilla options market id keyed by an expiration or settlement timestamp key
+func (k *Keeper) deleteVanillaOptionsMarketTimestampIndex(ctx sdk.Context, key []byte) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	k.getStore(ctx).Delete(key)
+}
+
+// GetAllVanillaOptionsMarkets returns all vanilla options markets.
+func (k *Keeper) GetAllVanillaOptionsMarkets(ctx sdk.Context) []*types.VanillaOptionsMarket {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	markets := make([]*types.VanillaOptionsMarket, 0)
+	appendMarket := func(p *types.VanillaOptionsMarket) (stop bool) {
+		markets = append(markets, p)
+		return false
+	}
+
+	k.IterateVanillaOptionsMarkets(ctx, nil, appendMarket)
+	return markets
+}
+
+// IterateVanillaOptionsMarkets iterates over vanilla options markets calling process on each market.
+func (k *Keeper) IterateVanillaOptionsMarkets(ctx sdk.Context, isEnabled *bool, process func(market *types.VanillaOptionsMarket) (stop bool)) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+
+	var marketStore prefix.Store
+	if isEnabled != nil {
+		marketStore = prefix.NewStore(store, types.GetVanillaOptionsMarketPrefix(*isEnabled))
+	} else {
+		marketStore = prefix.NewStore(store, types.VanillaOptionsMarketPrefix)
+	}
+
+	iterator := marketStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var market types.VanillaOptionsMarket
+		bz := iterator.Value()
+		k.cdc.MustUnmarshal(bz, &market)
+		if process(&market) {
+			return
+		}
+	}
+}
+
+func (k *Keeper) ScheduleVanillaOptionsMarketParamUpdate(ctx sdk.Context, p *types.VanillaOptionsMarketParamUpdateProposal) error {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getTransientStore(ctx)
+	marketID := common.HexToHash(p.MarketId)
+	paramUpdateStore := prefix.NewStore(store, types.VanillaOptionsMarketParamUpdateSchedulePrefix)
+	bz := k.cdc.MustMarshal(p)
+	paramUpdateStore.Set(marketID.Bytes(), bz)
+	return nil
+}
+
+// IterateVanillaOptionsMarketParamUpdates iterates over VanillaOptionsMarketParamUpdates calling process on each pair.
+func (k *Keeper) IterateVanillaOptionsMarketParamUpdates(ctx sdk.Context, process func(*types.VanillaOptionsMarketParamUpdateProposal) (stop bool)) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getTransientStore(ctx)
+	paramUpdateStore := prefix.NewStore(store, types.VanillaOptionsMarketParamUpdateSchedulePrefix)
+
+	iterator := paramUpdateStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.VanillaOptionsMarketParamUpdateProposal
+		bz := iterator.Value()
+		k.cdc.MustUnmarshal(bz, &proposal)
+		if process(&proposal) {
+			return
+		}
+	}
+}
+
+func (k *Keeper) ExecuteVanillaOptionsMarketParamUpdateProposal(ctx sdk.Context, p *types.VanillaOptionsMarketParamUpdateProposal) error {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	marketID := common.HexToHash(p.MarketId)
+	market := k.GetVanillaOptionsMarketByID(ctx, marketID)
+
+	if market == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return errors.Errorf("market is not available, market_id %s", p.MarketId)
+	}
+	if market.Status == types.MarketStatus_Demolished {
+		metrics.ReportFuncError(k.svcTags)
+		return sdkerrors.Wrapf(types.ErrInvalidMarketStatus, "can't update market that was demolished already")
+	}
+
+	if p.MakerFeeRate != nil {
+		if p.MakerFeeRate.LT(market.MakerFeeRate) {
+			orders := k.GetAllDerivativeLimitOrdersByMarketID(ctx, marketID)
+			k.handleDerivativeFeeDecrease(ctx, orders, market.MakerFeeRate, *p.MakerFeeRate, market.QuoteDenom)
+		} else if p.MakerFeeRate.GT(market.MakerFeeRate) {
+			orders := k.GetAllDerivativeLimitOrdersByMarketID(ctx, marketID)
+			k.handleDerivativeFeeIncrease(ctx, orders, *p.MakerFeeRate, market)
+		}
+		market.MakerFeeRate = *p.MakerFeeRate
+	}
+	if p.TakerFeeRate != nil {
+		market.TakerFeeRate = *p.TakerFeeRate
+	}
+	if p.RelayerFeeShareRate != nil {
+		market.RelayerFeeShareRate = *p.RelayerFeeShareRate
+	}
+	if p.MinPriceTickSize != nil {
+		market.MinPriceTickSize = *p.MinPriceTickSize
+	}
+	if p.MinQuantityTickSize != nil {
+		market.MinQuantityTickSize = *p.MinQuantityTickSize
+	}
+
+	if p.ExpirationTimestamp > 0 {
+		// delete the old expiry timestamp index
+		k.deleteVanillaOptionsMarketTimestampIndex(ctx, types.GetVanillaOptionsMarketExpiryTimestampKey(market.ExpirationTimestamp, marketID))
+		market.ExpirationTimestamp = p.ExpirationTimestamp
+	}
+	if p.SettlementTimestamp > 0 {
+		// delete the old settlement timestamp index
+		k.deleteVanillaOptionsMarketTimestampIndex(ctx, types.GetVanillaOptionsMarketSettlementTimestampKey(market.SettlementTimestamp, marketID))
+		market.SettlementTimestamp = p.SettlementTimestamp
+	}
+
+	k.SetVanillaOptionsMarket(ctx, market)
+	return nil
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+type VanillaOptionsMsgServer struct {
+	Keeper
+	svcTags metrics.Tags
+}
+
+// NewVanillaOptionsMsgServerImpl returns an implementation of the exchange MsgServer interface for the provided Keeper for vanilla options market functions.
+func NewVanillaOptionsMsgServerImpl(keeper Keeper) VanillaOptionsMsgServer {
+	return VanillaOptionsMsgServer{
+		Keeper: keeper,
+		svcTags: metrics.Tags{
+			"svc": "vanilla_msg_h",
+		},
+	}
+}
+
+func (k VanillaOptionsMsgServer) CreateVanillaOptionsLimitOrder(goCtx context.Context, msg *types.MsgCreateVanillaOptionsLimitOrder) (*types.MsgCreateVanillaOptionsLimitOrderResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	account, _ := sdk.AccAddressFromBech32(msg.Sender)
+
+	market := k.GetVanillaOptionsMarket(ctx, msg.Order.MarketID(), true)
+	if market == nil {
+		k.Logger(ctx).Error("active vanilla options market doesn't exist", "marketId", msg.Order.MarketId)
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrVanillaOptionsMarketNotFound, "marketID %s", msg.Order.MarketId)
+	}
+
+	requiredMargin := msg.Order.GetRequiredFullyCollateralizedMargin(market.GetMaxPrice())
+	if msg.Order.Margin.GT(requiredMargin) {
+		// decrease order margin to the required amount if greater, since there's no need to overpay
+		msg.Order.Margin = requiredMargin
+	}
+
+	orderHash, err := k.createDerivativeLimitOrder(ctx, account, &msg.Order, market, sdk.Dec{})
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	return &types.MsgCreateVanillaOptionsLimitOrderResponse{
+		OrderHash: orderHash.Hex(),
+	}, nil
+}
+
+func (k VanillaOptionsMsgServer) CreateVanillaOptionsMarketOrder(goCtx context.Context, msg *types.MsgCreateVanillaOptionsMarketOrder) (*types.MsgCreateVanillaOptionsMarketOrderResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	account, _ := sdk.AccAddressFromBech32(msg.Sender)
+
+	market := k.GetVanillaOptionsMarket(ctx, msg.Order.MarketID(), true)
+	if market == nil {
+		k.Logger(ctx).Error("active vanilla options market doesn't exist", "marketId", msg.Order.MarketId)
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrVanillaOptionsMarketNotFound, "marketID %s", msg.Order.MarketId)
+	}
+
+	requiredMargin := msg.Order.GetRequiredFullyCollateralizedMargin(market.GetMaxPrice())
+	if msg.Order.Margin.GT(requiredMargin) {
+		// decrease order margin to the required amount if greater, since there's no need to overpay
+		msg.Order.Margin = requiredMargin
+	}
+
+	orderHash, results, err := k.createDerivativeMarketOrder(ctx, account, &msg.Order, market, sdk.Dec{})
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	resp := &types.MsgCreateVanillaOptionsMarketOrderResponse{
+		OrderHash: orderHash.Hex(),
+	}
+
+	if results != nil {
+		resp.Results = results
+	}
+	return resp, nil
+}
+
+func (k VanillaOptionsMsgServer) CancelVanillaOptionsOrder(goCtx context.Context, msg *types.MsgCancelVanillaOptionsOrder) (*types.MsgCancelVanillaOptionsOrderResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var (
+		sender       = sdk.MustAccAddressFromBech32(msg.Sender)
+		subaccountID = types.MustGetSubaccountIDOrDeriveFromNonce(sender, msg.SubaccountId)
+		marketID     = common.HexToHash(msg.MarketId)
+		orderHash    = common.HexToHash(msg.OrderHash)
+	)
+
+	market := k.GetVanillaOptionsMarketByID(ctx, marketID)
+	if market == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrVanillaOptionsMarketNotFound, "marketID %s", msg.MarketId)
+	}
+
+	if err := k.cancelDerivativeOrder(ctx, subaccountID, orderHash, market, marketID, msg.OrderMask); err != nil {
+		return nil, err
+	}
+	return &types.MsgCancelVanillaOptionsOrderResponse{}, nil
+}
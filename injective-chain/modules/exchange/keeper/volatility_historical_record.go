@@ -77,127 +77,289 @@ func (k *Keeper) PersistVwapInfo(ctx sdk.Context, spotVwapInfo *SpotVwapInfo, de
 				Quantity:  derivativeVwapInfo.binaryOptionsVwapInfo[binaryOptionMarketID].VwapData.Quantity,
 			})
 		}
+
+		vanillaOptionsMarketIDs := derivativeVwapInfo.GetSortedVanillaOptionsMarketIDs()
+		for _, vanillaOptionsMarketID := range vanillaOptionsMarketIDs {
+			vanillaOptionsMarket := k.GetVanillaOptionsMarketByID(ctx, vanillaOptionsMarketID)
+			scaleDivisor := k.GetDenomDecimals(ctx, vanillaOptionsMarket.QuoteDenom)
+			k.AppendTradeRecord(ctx, vanillaOptionsMarketID, &types.TradeRecord{
+				Timestamp: blockTime.Unix(),
+				Price:     derivativeVwapInfo.vanillaOptionsVwapInfo[vanillaOptionsMarketID].VwapData.Price.Quo(sdk.NewDec(10).Power(scaleDivisor)),
+				Quantity:  derivativeVwapInfo.vanillaOptionsVwapInfo[vanillaOptionsMarketID].VwapData.Quantity,
+			})
+		}
 	}
 }
 
+// AppendTradeRecord stores the trade record of a market under its timestamp, merged with the record of a previous block
+// with the same timestamp. The records older than the retention of the market are pruned periodically in the
+// BeginBlocker.
 func (k *Keeper) AppendTradeRecord(ctx sdk.Context, marketID common.Hash, tradeRecord *types.TradeRecord) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
-	existingOrEmptyRecord, _ := k.GetHistoricalTradeRecords(ctx, marketID, tradeRecord.Timestamp-types.MaxHistoricalTradeRecordAge)
-	existingOrEmptyRecord.LatestTradeRecords = append(existingOrEmptyRecord.LatestTradeRecords, tradeRecord)
+	store := k.getStore(ctx)
+	key := types.GetMarketTradeRecordKey(marketID, tradeRecord.Timestamp)
+
+	if bz := store.Get(key); bz != nil {
+		var existingRecord types.TradeRecord
+		k.cdc.MustUnmarshal(bz, &existingRecord)
+		tradeRecord = mergeTradeRecords(&existingRecord, tradeRecord)
+	}
+
+	store.Set(key, k.cdc.MustMarshal(tradeRecord))
+}
+
+// MigrateLegacyHistoricalTradeRecords moves the trade records stored together per market under the legacy prefix to
+// the records stored per market and timestamp.
+func (k *Keeper) MigrateLegacyHistoricalTradeRecords(ctx sdk.Context) {
+	store := k.getStore(ctx)
+	legacyStore := prefix.NewStore(store, types.LegacyMarketHistoricalTradeRecordsPrefix)
+
+	iterator := legacyStore.Iterator(nil, nil)
+	keys := make([][]byte, 0)
+	allTradeRecords := make([]types.TradeRecords, 0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tradeRecords types.TradeRecords
+		k.cdc.MustUnmarshal(iterator.Value(), &tradeRecords)
+		keys = append(keys, iterator.Key())
+		allTradeRecords = append(allTradeRecords, tradeRecords)
+	}
+	iterator.Close()
+
+	for idx := range allTradeRecords {
+		marketID := common.HexToHash(allTradeRecords[idx].MarketId)
+		for _, tradeRecord := range allTradeRecords[idx].LatestTradeRecords {
+			k.AppendTradeRecord(ctx, marketID, tradeRecord)
+		}
+	}
+
+	for _, key := range keys {
+		legacyStore.Delete(key)
+	}
+}
+
+// mergeTradeRecords returns the record of both records at the same timestamp, with their volume-weighted price
+func mergeTradeRecords(existingRecord, tradeRecord *types.TradeRecord) *types.TradeRecord {
+	quantity := existingRecord.Quantity.Add(tradeRecord.Quantity)
+	if !quantity.IsPositive() {
+		return tradeRecord
+	}
+
+	return &types.TradeRecord{
+		Timestamp: tradeRecord.Timestamp,
+		// price = (p0 * q0 + p1 * q1) / (q0 + q1)
+		Price:    existingRecord.Price.Mul(existingRecord.Quantity).Add(tradeRecord.Price.Mul(tradeRecord.Quantity)).Quo(quantity),
+		Quantity: quantity,
+	}
+}
+
+// GetTradeRecordRetention returns how long the trade records of a market are kept, in seconds. Markets keep their
+// records for MaxHistoricalTradeRecordAge unless a longer retention was set for them by governance.
+func (k *Keeper) GetTradeRecordRetention(ctx sdk.Context, marketID common.Hash) int64 {
+	store := k.getStore(ctx)
+	retentionStore := prefix.NewStore(store, types.TradeRecordRetentionPrefix)
+
+	bz := retentionStore.Get(marketID.Bytes())
+	if bz == nil {
+		return types.MaxHistoricalTradeRecordAge
+	}
+
+	var retention types.MarketTradeRecordRetention
+	k.cdc.MustUnmarshal(bz, &retention)
+	return retention.RetentionSeconds
+}
+
+func (k *Keeper) GetAllTradeRecordRetentions(ctx sdk.Context) []*types.MarketTradeRecordRetention {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	retentionStore := prefix.NewStore(store, types.TradeRecordRetentionPrefix)
+
+	iterator := retentionStore.Iterator(nil, nil)
+	defer iterator.Close()
+	retentions := make([]*types.MarketTradeRecordRetention, 0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var retention types.MarketTradeRecordRetention
+		k.cdc.MustUnmarshal(iterator.Value(), &retention)
+		retentions = append(retentions, &retention)
+	}
+
+	return retentions
+}
+
+func (k *Keeper) SetTradeRecordRetentions(ctx sdk.Context, retentions []*types.MarketTradeRecordRetention) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	retentionStore := prefix.NewStore(store, types.TradeRecordRetentionPrefix)
 
-	k.setHistoricalTradeRecords(ctx, marketID, existingOrEmptyRecord)
+	for _, retention := range retentions {
+		marketID := common.HexToHash(retention.MarketId)
+		retentionStore.Set(marketID.Bytes(), k.cdc.MustMarshal(retention))
+	}
 }
 
 func (k *Keeper) GetAllHistoricalTradeRecords(ctx sdk.Context) []*types.TradeRecords {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
 	allTradeRecords := make([]*types.TradeRecords, 0)
-	store := ctx.KVStore(k.storeKey)
+	store := k.getStore(ctx)
 
-	historicalTradeRecordsStore := prefix.NewStore(store, types.MarketHistoricalTradeRecordsPrefix)
+	tradeRecordStore := prefix.NewStore(store, types.MarketTradeRecordPrefix)
 
-	iterator := historicalTradeRecordsStore.Iterator(nil, nil)
+	iterator := tradeRecordStore.Iterator(nil, nil)
 	defer iterator.Close()
 
+	// the records are ordered by market, then by timestamp
 	for ; iterator.Valid(); iterator.Next() {
-		var tradeRecords types.TradeRecords
-		bz := iterator.Value()
-		k.cdc.MustUnmarshal(bz, &tradeRecords)
-		allTradeRecords = append(allTradeRecords, &tradeRecords)
+		marketID := common.BytesToHash(iterator.Key()[:common.HashLength])
+
+		var tradeRecord types.TradeRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &tradeRecord)
+
+		if len(allTradeRecords) == 0 || allTradeRecords[len(allTradeRecords)-1].MarketId != marketID.Hex() {
+			allTradeRecords = append(allTradeRecords, &types.TradeRecords{
+				MarketId: marketID.Hex(),
+			})
+		}
+
+		marketTradeRecords := allTradeRecords[len(allTradeRecords)-1]
+		marketTradeRecords.LatestTradeRecords = append(marketTradeRecords.LatestTradeRecords, &tradeRecord)
 	}
 
 	return allTradeRecords
 }
 
+// CleanupHistoricalTradeRecords prunes the trade records of every market which are older than its retention,
+// including the records of the markets which aren't active anymore.
 func (k *Keeper) CleanupHistoricalTradeRecords(ctx sdk.Context) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
-	before := ctx.BlockTime().Unix() - types.MaxHistoricalTradeRecordAge
-	onlyEnabled := true
+	blockTime := ctx.BlockTime().Unix()
+	pruneMarket := func(marketID common.Hash) {
+		k.pruneMarketTradeRecords(ctx, marketID, blockTime-k.GetTradeRecordRetention(ctx, marketID))
+	}
+
+	k.IterateSpotMarkets(ctx, nil, func(m *types.SpotMarket) (stop bool) {
+		pruneMarket(m.MarketID())
+		return false
+	})
 
-	k.IterateSpotMarkets(ctx, &onlyEnabled, func(m *types.SpotMarket) (stop bool) {
-		k.cleanupMarketHistoricalTradeRecords(ctx, m.MarketID(), before)
+	k.IterateDerivativeMarkets(ctx, nil, func(m *types.DerivativeMarket) (stop bool) {
+		pruneMarket(m.MarketID())
 		return false
 	})
 
-	k.IterateDerivativeMarkets(ctx, &onlyEnabled, func(m *types.DerivativeMarket) (stop bool) {
-		k.cleanupMarketHistoricalTradeRecords(ctx, m.MarketID(), before)
+	k.IterateBinaryOptionsMarkets(ctx, nil, func(m *types.BinaryOptionsMarket) (stop bool) {
+		pruneMarket(m.MarketID())
+		return false
+	})
+
+	k.IterateVanillaOptionsMarkets(ctx, nil, func(m *types.VanillaOptionsMarket) (stop bool) {
+		pruneMarket(m.MarketID())
 		return false
 	})
 }
 
-func (k *Keeper) cleanupMarketHistoricalTradeRecords(ctx sdk.Context, marketID common.Hash, before int64) {
-	needsSave := false
-	existingOrEmptyRecord, omitted := k.GetHistoricalTradeRecords(ctx, marketID, before)
+// pruneMarketTradeRecords deletes the trade records of a market older than the `before` time.
+func (k *Keeper) pruneMarketTradeRecords(ctx sdk.Context, marketID common.Hash, before int64) {
+	if before <= 0 {
+		return
+	}
 
-	if len(existingOrEmptyRecord.LatestTradeRecords) == 0 {
-		if omitted {
-			// some records older than 'before' have been omitted, need to overwrite with empty entry
-			needsSave = true
-		} else {
-			// empty records - no need to cleanup the entry
-			return
-		}
-	} else if omitted {
-		// non-empty records and something has been omitted, need to save new entry
-		needsSave = true
+	store := k.getStore(ctx)
+	tradeRecordStore := prefix.NewStore(store, types.GetMarketTradeRecordPrefix(marketID))
+
+	iterator := tradeRecordStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(before)))
+	keys := make([][]byte, 0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
 	}
+	iterator.Close()
 
-	if needsSave {
-		k.setHistoricalTradeRecords(ctx, marketID, existingOrEmptyRecord)
+	for _, key := range keys {
+		tradeRecordStore.Delete(key)
 	}
 }
 
-func (k *Keeper) setHistoricalTradeRecords(ctx sdk.Context, marketID common.Hash, entry *types.TradeRecords) {
-	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
-
+// IterateMarketTradeRecords iterates over the trade records of a market from the `from` time until the `to` time
+// (exclusive, unbounded if zero), oldest first.
+func (k *Keeper) IterateMarketTradeRecords(ctx sdk.Context, marketID common.Hash, from, to int64, process func(tradeRecord *types.TradeRecord) (stop bool)) {
 	store := k.getStore(ctx)
+	tradeRecordStore := prefix.NewStore(store, types.GetMarketTradeRecordPrefix(marketID))
+
+	var start, end []byte
+	if from > 0 {
+		start = sdk.Uint64ToBigEndian(uint64(from))
+	}
+	if to > 0 {
+		end = sdk.Uint64ToBigEndian(uint64(to))
+	}
+
+	iterator := tradeRecordStore.Iterator(start, end)
+	defer iterator.Close()
 
-	bz := k.cdc.MustMarshal(entry)
-	store.Set(types.GetMarketHistoricalTradeRecordsKey(marketID), bz)
+	for ; iterator.Valid(); iterator.Next() {
+		var tradeRecord types.TradeRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &tradeRecord)
+		if process(&tradeRecord) {
+			return
+		}
+	}
 }
 
 // GetHistoricalTradeRecords returns the historical trade records for a market starting from the `from` time.
-func (k *Keeper) GetHistoricalTradeRecords(ctx sdk.Context, marketID common.Hash, from int64) (entry *types.TradeRecords, omitted bool) {
+func (k *Keeper) GetHistoricalTradeRecords(ctx sdk.Context, marketID common.Hash, from int64) *types.TradeRecords {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
-	entry = &types.TradeRecords{
+	entry := &types.TradeRecords{
 		MarketId: marketID.Hex(),
 	}
 
-	store := k.getStore(ctx)
-	bz := store.Get(types.GetMarketHistoricalTradeRecordsKey(marketID))
-	if bz == nil {
-		return entry, false
-	}
-
-	var tradeEntry types.TradeRecords
-	k.cdc.MustUnmarshal(bz, &tradeEntry)
-
-	entry.LatestTradeRecords, omitted = filterHistoricalTradeRecords(tradeEntry.LatestTradeRecords, from)
+	k.IterateMarketTradeRecords(ctx, marketID, from, 0, func(tradeRecord *types.TradeRecord) (stop bool) {
+		entry.LatestTradeRecords = append(entry.LatestTradeRecords, tradeRecord)
+		return false
+	})
 
-	return entry, omitted
+	return entry
 }
 
-func filterHistoricalTradeRecords(records []*types.TradeRecord, from int64) (filteredRecords []*types.TradeRecord, omitted bool) {
-	offsetIdx := -1
+// GetSpotMarketTradeRecords returns the trade records since from of the active spot market with the given base and
+// quote denoms for the ExchangeTwap oracle, or false if there is no such market.
+func (k *Keeper) GetSpotMarketTradeRecords(ctx sdk.Context, baseDenom, quoteDenom string, from int64) ([]oracletypes.SpotTradeRecord, bool) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	marketID := types.NewSpotMarketID(baseDenom, quoteDenom)
+	if k.GetSpotMarket(ctx, marketID, true) == nil {
+		return nil, false
+	}
 
-	for idx, tradeRecord := range records {
-		if tradeRecord.Timestamp < from {
-			omitted = true
-			continue
-		}
+	tradeRecords := k.GetHistoricalTradeRecords(ctx, marketID, from)
 
-		offsetIdx = idx
-		break
+	records := make([]oracletypes.SpotTradeRecord, 0, len(tradeRecords.LatestTradeRecords))
+	for _, tradeRecord := range tradeRecords.LatestTradeRecords {
+		records = append(records, oracletypes.SpotTradeRecord{
+			Timestamp: tradeRecord.Timestamp,
+			Price:     tradeRecord.Price,
+			Quantity:  tradeRecord.Quantity,
+		})
 	}
 
-	if offsetIdx < 0 {
-		return nil, omitted
+	return records, true
+}
+
+// GetSpotMarketTradeRecordRetention returns the trade record retention of the active spot market with the given base
+// and quote denoms, which bounds the TWAP window of the ExchangeTwap oracle, or false if there is no such market.
+func (k *Keeper) GetSpotMarketTradeRecordRetention(ctx sdk.Context, baseDenom, quoteDenom string) (int64, bool) {
+	marketID := types.NewSpotMarketID(baseDenom, quoteDenom)
+	if k.GetSpotMarket(ctx, marketID, true) == nil {
+		return 0, false
 	}
 
-	return records[offsetIdx:], omitted
+	return k.GetTradeRecordRetention(ctx, marketID), true
 }
 
 func GetRecordsGroupedBy(tradeRecords []*types.TradeRecord, seconds int64) (groupedTradeRecords []*types.TradeRecord) {
@@ -342,7 +504,8 @@ func (k *Keeper) GetMarketVolatility(ctx sdk.Context, marketID common.Hash, opti
 	meta *oracletypes.MetadataStatistics) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
-	maxAge := int64(0)
+	// markets can retain their trade records for longer, so only the default window is used unless asked otherwise
+	maxAge := ctx.BlockTime().Unix() - types.MaxHistoricalTradeRecordAge
 	groupingSec := int64(GROUPING_SECONDS_DEFAULT)
 	includeRawHistory := false
 	includeMetadata := false
@@ -358,7 +521,7 @@ func (k *Keeper) GetMarketVolatility(ctx sdk.Context, marketID common.Hash, opti
 		}
 	}
 
-	tradeRecords, _ := k.GetHistoricalTradeRecords(ctx, marketID, maxAge)
+	tradeRecords := k.GetHistoricalTradeRecords(ctx, marketID, maxAge)
 	trades := tradeRecords.LatestTradeRecords
 
 	if len(trades) == 0 {
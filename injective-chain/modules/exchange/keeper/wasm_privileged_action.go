@@ -155,6 +155,7 @@ func (k *Keeper) handleSyntheticTradeAction(
 			}
 		}
 
+		realizedPnl := position.GetRealizedPnl(trade.ToPositionDelta())
 		payout, closeExecutionMargin, _ := position.ApplyPositionDelta(trade.ToPositionDelta(), sdk.ZeroDec())
 
 		// Enforce that a position cannot have a negative quantity
@@ -168,6 +169,7 @@ func (k *Keeper) handleSyntheticTradeAction(
 
 		finalPositions.SetPosition(trade.MarketID, trade.SubaccountID, position)
 		k.SetPosition(ctx, trade.MarketID, trade.SubaccountID, position)
+		k.updateSubaccountPnl(ctx, trade.SubaccountID, trade.MarketID, realizedPnl, sdk.ZeroDec(), sdk.ZeroDec())
 
 		depositDelta := types.NewUniformDepositDelta(payout.Add(closeExecutionMargin))
 		k.UpdateDepositWithDelta(ctx, trade.SubaccountID, market.QuoteDenom, depositDelta)
@@ -29,8 +29,20 @@ func NewExchangeProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return handleBinaryOptionsMarketLaunchProposal(ctx, k, c)
 		case *types.BinaryOptionsMarketParamUpdateProposal:
 			return handleBinaryOptionsMarketParamUpdateProposal(ctx, k, c)
+		case *types.VanillaOptionsMarketLaunchProposal:
+			return handleVanillaOptionsMarketLaunchProposal(ctx, k, c)
+		case *types.VanillaOptionsMarketParamUpdateProposal:
+			return handleVanillaOptionsMarketParamUpdateProposal(ctx, k, c)
+		case *types.CategoricalMarketLaunchProposal:
+			return handleCategoricalMarketLaunchProposal(ctx, k, c)
+		case *types.ExpiryFuturesMarketSeriesLaunchProposal:
+			return handleExpiryFuturesMarketSeriesLaunchProposal(ctx, k, c)
+		case *types.ExpiryFuturesMarketSeriesParamUpdateProposal:
+			return handleExpiryFuturesMarketSeriesParamUpdateProposal(ctx, k, c)
 		case *types.ExpiryFuturesMarketLaunchProposal:
 			return handleExpiryFuturesMarketLaunchProposal(ctx, k, c)
+		case *types.PreLaunchPerpetualMarketLaunchProposal:
+			return handlePreLaunchPerpetualMarketLaunchProposal(ctx, k, c)
 		case *types.DerivativeMarketParamUpdateProposal:
 			return handleDerivativeMarketParamUpdateProposal(ctx, k, c)
 		case *types.MarketForcedSettlementProposal:
@@ -49,6 +61,8 @@ func NewExchangeProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return handleBatchCommunityPoolSpendProposal(ctx, k, c)
 		case *types.AtomicMarketOrderFeeMultiplierScheduleProposal:
 			return handleAtomicMarketOrderFeeMultiplierScheduleProposal(ctx, k, c)
+		case *types.TradeRecordRetentionScheduleProposal:
+			return handleTradeRecordRetentionScheduleProposal(ctx, k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized exchange proposal content type: %T", c)
 		}
@@ -229,6 +243,15 @@ func handleExpiryFuturesMarketLaunchProposal(ctx sdk.Context, k keeper.Keeper, p
 	return err
 }
 
+func handlePreLaunchPerpetualMarketLaunchProposal(ctx sdk.Context, k keeper.Keeper, p *types.PreLaunchPerpetualMarketLaunchProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	_, err := k.PreLaunchPerpetualMarketLaunch(ctx, p)
+	return err
+}
+
 func scheduleSpotMarketForceClosure(ctx sdk.Context, k keeper.Keeper, spotMarket *types.SpotMarket) error {
 	settlementInfo := k.GetSpotMarketForceCloseInfo(ctx, common.HexToHash(spotMarket.MarketId))
 	if settlementInfo != nil {
@@ -336,12 +359,20 @@ func handleDerivativeMarketParamUpdateProposal(ctx sdk.Context, k keeper.Keeper,
 		return types.ErrMarginsRelation
 	}
 
+	// the leverage caps hold until a pre-launch market is converted to an oracle-backed market by setting its oracle params
+	if market.IsPreLaunch() && p.OracleParams == nil {
+		if err := types.ValidatePreLaunchPerpetualMarginRatios(*p.InitialMarginRatio, *p.MaintenanceMarginRatio); err != nil {
+			return err
+		}
+	}
+
 	if p.OracleParams == nil {
 		p.OracleParams = types.NewOracleParams(market.OracleBase, market.OracleQuote, market.OracleScaleFactor, market.OracleType)
 	} else {
 		oracleParams := p.OracleParams
 
-		oldPrice, err := k.GetDerivativeMarketPrice(ctx, market.OracleBase, market.OracleQuote, market.OracleScaleFactor, market.OracleType)
+		// the old price of a pre-launch market is its trade TWAP
+		oldPrice, err := k.GetDerivativeMarketMarkPrice(ctx, market)
 		if err != nil {
 			return err
 		}
@@ -404,6 +435,8 @@ func handleBinaryOptionsMarketLaunchProposal(ctx sdk.Context, k keeper.Keeper, p
 		p.QuoteDenom,
 		p.MinPriceTickSize,
 		p.MinQuantityTickSize,
+		p.ScalarLowerBound,
+		p.ScalarUpperBound,
 	)
 	if err != nil {
 		return err
@@ -494,6 +527,154 @@ func handleBinaryOptionsMarketParamUpdateProposal(ctx sdk.Context, k keeper.Keep
 	return nil
 }
 
+func handleVanillaOptionsMarketLaunchProposal(ctx sdk.Context, k keeper.Keeper, p *types.VanillaOptionsMarketLaunchProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if _, err := k.VanillaOptionsMarketLaunch(ctx, p); err != nil {
+		return err
+	}
+	return nil
+}
+
+func handleVanillaOptionsMarketParamUpdateProposal(ctx sdk.Context, k keeper.Keeper, p *types.VanillaOptionsMarketParamUpdateProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	market := k.GetVanillaOptionsMarketByID(ctx, common.HexToHash(p.MarketId))
+	if market == nil {
+		return types.ErrVanillaOptionsMarketNotFound
+	}
+
+	if market.Status == types.MarketStatus_Demolished {
+		return types.ErrInvalidMarketStatus
+	}
+
+	expTimestamp, settlementTimestamp := market.ExpirationTimestamp, market.SettlementTimestamp
+
+	if p.ExpirationTimestamp != 0 {
+		if market.ExpirationTimestamp <= ctx.BlockTime().Unix() {
+			return sdkerrors.Wrap(types.ErrInvalidExpiry, "cannot change expiration time of an expired market")
+		}
+		// Enforce that expiration is in the future, if being modified
+		if p.ExpirationTimestamp <= ctx.BlockTime().Unix() {
+			return sdkerrors.Wrapf(types.ErrInvalidExpiry, "expiration timestamp %d is in the past", p.ExpirationTimestamp)
+		}
+		expTimestamp = p.ExpirationTimestamp
+	}
+
+	if p.SettlementTimestamp != 0 {
+		if p.SettlementTimestamp <= ctx.BlockTime().Unix() {
+			return sdkerrors.Wrapf(types.ErrInvalidSettlement, "settlement timestamp %d is in the past", p.SettlementTimestamp)
+		}
+		settlementTimestamp = p.SettlementTimestamp
+	}
+
+	if expTimestamp >= settlementTimestamp {
+		return sdkerrors.Wrap(types.ErrInvalidExpiry, "expiration timestamp should be prior to settlement timestamp")
+	}
+
+	if p.MakerFeeRate != nil || p.TakerFeeRate != nil || p.RelayerFeeShareRate != nil {
+		// if any fee is changed we need to validate those fees still make sense
+		if p.MakerFeeRate == nil {
+			p.MakerFeeRate = &market.MakerFeeRate
+		}
+		if p.TakerFeeRate == nil {
+			p.TakerFeeRate = &market.TakerFeeRate
+		}
+		if p.RelayerFeeShareRate == nil {
+			p.RelayerFeeShareRate = &market.RelayerFeeShareRate
+		}
+
+		minimalProtocolFeeRate := k.GetMinimalProtocolFeeRate(ctx)
+		discountSchedule := k.GetFeeDiscountSchedule(ctx)
+
+		if err := types.ValidateMakerWithTakerFeeAndDiscounts(*p.MakerFeeRate, *p.TakerFeeRate, *p.RelayerFeeShareRate, minimalProtocolFeeRate, discountSchedule); err != nil {
+			return err
+		}
+	}
+
+	// schedule market param change in transient store
+	if err := k.ScheduleVanillaOptionsMarketParamUpdate(ctx, p); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func handleCategoricalMarketLaunchProposal(ctx sdk.Context, k keeper.Keeper, p *types.CategoricalMarketLaunchProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if _, err := k.CategoricalMarketLaunch(ctx, p); err != nil {
+		return err
+	}
+	return nil
+}
+
+func handleExpiryFuturesMarketSeriesLaunchProposal(ctx sdk.Context, k keeper.Keeper, p *types.ExpiryFuturesMarketSeriesLaunchProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if _, err := k.ExpiryFuturesMarketSeriesLaunch(ctx, p); err != nil {
+		return err
+	}
+	return nil
+}
+
+func handleExpiryFuturesMarketSeriesParamUpdateProposal(ctx sdk.Context, k keeper.Keeper, p *types.ExpiryFuturesMarketSeriesParamUpdateProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	series := k.GetExpiryFuturesMarketSeries(ctx, common.HexToHash(p.SeriesId))
+	if series == nil {
+		return sdkerrors.Wrapf(types.ErrExpiryFuturesMarketSeriesNotFound, "seriesID %s", p.SeriesId)
+	}
+
+	if p.InitialMarginRatio != nil {
+		series.InitialMarginRatio = *p.InitialMarginRatio
+	}
+	if p.MaintenanceMarginRatio != nil {
+		series.MaintenanceMarginRatio = *p.MaintenanceMarginRatio
+	}
+	if p.MakerFeeRate != nil {
+		series.MakerFeeRate = *p.MakerFeeRate
+	}
+	if p.TakerFeeRate != nil {
+		series.TakerFeeRate = *p.TakerFeeRate
+	}
+	if p.MinPriceTickSize != nil {
+		series.MinPriceTickSize = *p.MinPriceTickSize
+	}
+	if p.MinQuantityTickSize != nil {
+		series.MinQuantityTickSize = *p.MinQuantityTickSize
+	}
+	if p.LeadTime > 0 {
+		series.LeadTime = p.LeadTime
+	}
+	if p.Status != types.MarketStatus_Unspecified {
+		series.Status = p.Status
+	}
+
+	if series.MakerFeeRate.GT(series.TakerFeeRate) {
+		return types.ErrFeeRatesRelation
+	}
+	if series.InitialMarginRatio.LT(series.MaintenanceMarginRatio) {
+		return types.ErrMarginsRelation
+	}
+	if err := types.ValidateExpiryFuturesSeriesSchedule(series.Interval, series.LeadTime); err != nil {
+		return err
+	}
+
+	k.SetExpiryFuturesMarketSeries(ctx, series)
+	return nil
+}
+
 func handleTradingRewardCampaignLaunchProposal(ctx sdk.Context, k keeper.Keeper, p *types.TradingRewardCampaignLaunchProposal) error {
 	if err := p.ValidateBasic(); err != nil {
 		return err
@@ -688,6 +869,7 @@ func handleFeeDiscountProposal(ctx sdk.Context, k keeper.Keeper, p *types.FeeDis
 	spotMarkets := k.GetAllSpotMarkets(ctx)
 	derivativeMarkets := k.GetAllDerivativeMarkets(ctx)
 	binaryOptionsMarkets := k.GetAllBinaryOptionsMarkets(ctx)
+	vanillaOptionsMarkets := k.GetAllVanillaOptionsMarkets(ctx)
 	minimalProtocolFeeRate := k.GetMinimalProtocolFeeRate(ctx)
 
 	for _, market := range spotMarkets {
@@ -720,6 +902,16 @@ func handleFeeDiscountProposal(ctx sdk.Context, k keeper.Keeper, p *types.FeeDis
 		}
 	}
 
+	for _, market := range vanillaOptionsMarkets {
+		if !market.MakerFeeRate.IsNegative() {
+			continue
+		}
+		smallestTakerFeeRate := sdk.OneDec().Sub(maxTakerDiscount).Mul(market.TakerFeeRate)
+		if err := types.ValidateMakerWithTakerFee(market.MakerFeeRate, smallestTakerFeeRate, market.RelayerFeeShareRate, minimalProtocolFeeRate); err != nil {
+			return err
+		}
+	}
+
 	isBucketCountSame := k.GetFeeDiscountBucketCount(ctx) == p.Schedule.BucketCount
 	isBucketDurationSame := k.GetFeeDiscountBucketDuration(ctx) == p.Schedule.BucketDuration
 
@@ -778,3 +970,15 @@ func handleAtomicMarketOrderFeeMultiplierScheduleProposal(ctx sdk.Context, k kee
 	})
 	return nil
 }
+
+func handleTradeRecordRetentionScheduleProposal(ctx sdk.Context, k keeper.Keeper, p *types.TradeRecordRetentionScheduleProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	k.SetTradeRecordRetentions(ctx, p.MarketTradeRecordRetentions)
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventTradeRecordRetentionsUpdated{
+		MarketTradeRecordRetentions: p.MarketTradeRecordRetentions,
+	})
+	return nil
+}
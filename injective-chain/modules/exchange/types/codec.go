@@ -42,6 +42,23 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCancelBinaryOptionsOrder{}, "exchange/MsgCancelBinaryOptionsOrder", nil)
 	cdc.RegisterConcrete(&MsgAdminUpdateBinaryOptionsMarket{}, "exchange/MsgAdminUpdateBinaryOptionsMarket", nil)
 	cdc.RegisterConcrete(&MsgReclaimLockedFunds{}, "exchange/MsgReclaimLockedFunds", nil)
+	cdc.RegisterConcrete(&MsgCreateTWAPOrder{}, "exchange/MsgCreateTWAPOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelTWAPOrder{}, "exchange/MsgCancelTWAPOrder", nil)
+	cdc.RegisterConcrete(&MsgCreateLadderOrders{}, "exchange/MsgCreateLadderOrders", nil)
+	cdc.RegisterConcrete(&MsgCreateSpotQuoteMarketOrder{}, "exchange/MsgCreateSpotQuoteMarketOrder", nil)
+	cdc.RegisterConcrete(&MsgCreateVanillaOptionsLimitOrder{}, "exchange/MsgCreateVanillaOptionsLimitOrder", nil)
+	cdc.RegisterConcrete(&MsgCreateVanillaOptionsMarketOrder{}, "exchange/MsgCreateVanillaOptionsMarketOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelVanillaOptionsOrder{}, "exchange/MsgCancelVanillaOptionsOrder", nil)
+	cdc.RegisterConcrete(&MsgMintCategoricalOutcomeSet{}, "exchange/MsgMintCategoricalOutcomeSet", nil)
+	cdc.RegisterConcrete(&MsgRedeemCategoricalOutcomeSet{}, "exchange/MsgRedeemCategoricalOutcomeSet", nil)
+	cdc.RegisterConcrete(&MsgAdminUpdateCategoricalMarket{}, "exchange/MsgAdminUpdateCategoricalMarket", nil)
+	cdc.RegisterConcrete(&MsgUpdateExpiryFuturesAutoRoll{}, "exchange/MsgUpdateExpiryFuturesAutoRoll", nil)
+	cdc.RegisterConcrete(&MsgCreateMultiLegOrder{}, "exchange/MsgCreateMultiLegOrder", nil)
+	cdc.RegisterConcrete(&MsgCreateRFQRequest{}, "exchange/MsgCreateRFQRequest", nil)
+	cdc.RegisterConcrete(&MsgCancelRFQRequest{}, "exchange/MsgCancelRFQRequest", nil)
+	cdc.RegisterConcrete(&MsgAcceptQuote{}, "exchange/MsgAcceptQuote", nil)
+	cdc.RegisterConcrete(&MsgSubmitSignedOrders{}, "exchange/MsgSubmitSignedOrders", nil)
+	cdc.RegisterConcrete(&MsgCancelSignedOrders{}, "exchange/MsgCancelSignedOrders", nil)
 
 	cdc.RegisterConcrete(&ExchangeEnableProposal{}, "exchange/ExchangeEnableProposal", nil)
 	cdc.RegisterConcrete(&BatchExchangeModificationProposal{}, "exchange/BatchExchangeModificationProposal", nil)
@@ -60,6 +77,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&BinaryOptionsMarketParamUpdateProposal{}, "exchange/BinaryOptionsMarketParamUpdateProposal", nil)
 	cdc.RegisterConcrete(&BinaryOptionsMarketLaunchProposal{}, "exchange/BinaryOptionsMarketLaunchProposal", nil)
 	cdc.RegisterConcrete(&AtomicMarketOrderFeeMultiplierScheduleProposal{}, "exchange/AtomicMarketOrderFeeMultiplierScheduleProposal", nil)
+	cdc.RegisterConcrete(&TradeRecordRetentionScheduleProposal{}, "exchange/TradeRecordRetentionScheduleProposal", nil)
+	cdc.RegisterConcrete(&VanillaOptionsMarketLaunchProposal{}, "exchange/VanillaOptionsMarketLaunchProposal", nil)
+	cdc.RegisterConcrete(&VanillaOptionsMarketParamUpdateProposal{}, "exchange/VanillaOptionsMarketParamUpdateProposal", nil)
+	cdc.RegisterConcrete(&CategoricalMarketLaunchProposal{}, "exchange/CategoricalMarketLaunchProposal", nil)
+	cdc.RegisterConcrete(&ExpiryFuturesMarketSeriesLaunchProposal{}, "exchange/ExpiryFuturesMarketSeriesLaunchProposal", nil)
+	cdc.RegisterConcrete(&ExpiryFuturesMarketSeriesParamUpdateProposal{}, "exchange/ExpiryFuturesMarketSeriesParamUpdateProposal", nil)
+	cdc.RegisterConcrete(&PreLaunchPerpetualMarketLaunchProposal{}, "exchange/PreLaunchPerpetualMarketLaunchProposal", nil)
 
 	cdc.RegisterConcrete(&CreateSpotLimitOrderAuthz{}, "exchange/CreateSpotLimitOrderAuthz", nil)
 	cdc.RegisterConcrete(&CreateSpotMarketOrderAuthz{}, "exchange/CreateSpotMarketOrderAuthz", nil)
@@ -105,6 +129,23 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgCancelBinaryOptionsOrder{},
 		&MsgAdminUpdateBinaryOptionsMarket{},
 		&MsgReclaimLockedFunds{},
+		&MsgCreateTWAPOrder{},
+		&MsgCancelTWAPOrder{},
+		&MsgCreateLadderOrders{},
+		&MsgCreateSpotQuoteMarketOrder{},
+		&MsgCreateVanillaOptionsLimitOrder{},
+		&MsgCreateVanillaOptionsMarketOrder{},
+		&MsgCancelVanillaOptionsOrder{},
+		&MsgMintCategoricalOutcomeSet{},
+		&MsgRedeemCategoricalOutcomeSet{},
+		&MsgAdminUpdateCategoricalMarket{},
+		&MsgUpdateExpiryFuturesAutoRoll{},
+		&MsgCreateMultiLegOrder{},
+		&MsgCreateRFQRequest{},
+		&MsgCancelRFQRequest{},
+		&MsgAcceptQuote{},
+		&MsgSubmitSignedOrders{},
+		&MsgCancelSignedOrders{},
 	)
 
 	registry.RegisterImplementations(
@@ -126,6 +167,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&BinaryOptionsMarketParamUpdateProposal{},
 		&BinaryOptionsMarketLaunchProposal{},
 		&AtomicMarketOrderFeeMultiplierScheduleProposal{},
+		&TradeRecordRetentionScheduleProposal{},
+		&VanillaOptionsMarketLaunchProposal{},
+		&VanillaOptionsMarketParamUpdateProposal{},
+		&CategoricalMarketLaunchProposal{},
+		&ExpiryFuturesMarketSeriesLaunchProposal{},
+		&ExpiryFuturesMarketSeriesParamUpdateProposal{},
+		&PreLaunchPerpetualMarketLaunchProposal{},
 	)
 
 	registry.RegisterImplementations(
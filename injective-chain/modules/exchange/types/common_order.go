@@ -18,6 +18,18 @@ func GetRequiredBinaryOptionsOrderMargin(
 	oracleScaleFactor uint32,
 	orderType OrderType,
 	isReduceOnly bool,
+) sdk.Dec {
+	return GetRequiredFullyCollateralizedOrderMargin(price, quantity, GetScaledPrice(sdk.OneDec(), oracleScaleFactor), orderType, isReduceOnly)
+}
+
+// GetRequiredFullyCollateralizedOrderMargin returns the required margin for a trade (or order) at a given price in a
+// fully collateralized market whose contracts are worth at most maxPrice, i.e. the maximum loss of either side
+func GetRequiredFullyCollateralizedOrderMargin(
+	price sdk.Dec,
+	quantity sdk.Dec,
+	maxPrice sdk.Dec,
+	orderType OrderType,
+	isReduceOnly bool,
 ) sdk.Dec {
 	if isReduceOnly {
 		return sdk.ZeroDec()
@@ -26,7 +38,7 @@ func GetRequiredBinaryOptionsOrderMargin(
 	if orderType.IsBuy() {
 		return price.Mul(quantity)
 	}
-	return GetScaledPrice(sdk.OneDec(), oracleScaleFactor).Sub(price).Mul(quantity)
+	return maxPrice.Sub(price).Mul(quantity)
 }
 
 func (t OrderType) IsBuy() bool {
@@ -172,6 +172,31 @@ func BreachesMinimumTickSize(value, minTickSize sdk.Dec) bool {
 	return !bytes.Equal(residue.Bytes(), big.NewInt(0).Bytes())
 }
 
+// RoundDownToTickSize rounds the value down to the closest multiple of minTickSize
+func RoundDownToTickSize(value, minTickSize sdk.Dec) sdk.Dec {
+	if minTickSize.IsZero() || !BreachesMinimumTickSize(value, minTickSize) {
+		return value
+	}
+	return value.Quo(minTickSize).TruncateDec().Mul(minTickSize)
+}
+
+// RoundUpToTickSize rounds the value up to the closest multiple of minTickSize
+func RoundUpToTickSize(value, minTickSize sdk.Dec) sdk.Dec {
+	if minTickSize.IsZero() || !BreachesMinimumTickSize(value, minTickSize) {
+		return value
+	}
+	return value.Quo(minTickSize).Ceil().Mul(minTickSize)
+}
+
+// GetMaxSlippageWorstPrice returns the worst execution price allowed by the max slippage from the best price of the
+// opposite side of the orderbook, rounded to the minimum price tick size towards the best price.
+func GetMaxSlippageWorstPrice(isBuy bool, bestPrice, maxSlippage, minPriceTickSize sdk.Dec) sdk.Dec {
+	if isBuy {
+		return RoundDownToTickSize(bestPrice.Mul(sdk.OneDec().Add(maxSlippage)), minPriceTickSize)
+	}
+	return RoundUpToTickSize(bestPrice.Mul(sdk.OneDec().Sub(maxSlippage)), minPriceTickSize)
+}
+
 func (s *Subaccount) GetSubaccountID() (*common.Hash, error) {
 	trader, err := sdk.AccAddressFromBech32(s.Trader)
 	if err != nil {
@@ -184,13 +184,19 @@ func (o *DerivativeMarketOrder) ResizeReduceOnlyOrder(
 }
 
 func (o *DerivativeLimitOrder) GetRequiredBinaryOptionsMargin(oracleScaleFactor uint32) sdk.Dec {
+	return o.GetRequiredFullyCollateralizedMargin(GetScaledPrice(sdk.OneDec(), oracleScaleFactor))
+}
+
+// GetRequiredFullyCollateralizedMargin returns the required margin of the order in a fully collateralized market whose
+// contracts are worth at most maxPrice.
+func (o *DerivativeLimitOrder) GetRequiredFullyCollateralizedMargin(maxPrice sdk.Dec) sdk.Dec {
 	// Margin = Price * Quantity for buys
 	if o.IsBuy() {
 		notional := o.Price().Mul(o.OrderInfo.Quantity)
 		return notional
 	}
-	// Margin = (scaled(1) - Price) * Quantity for sells
-	return o.OrderInfo.Quantity.Mul(GetScaledPrice(sdk.OneDec(), oracleScaleFactor).Sub(o.Price()))
+	// Margin = (maxPrice - Price) * Quantity for sells
+	return o.OrderInfo.Quantity.Mul(maxPrice.Sub(o.Price()))
 }
 
 func (o *DerivativeMarketOrder) GetRequiredBinaryOptionsMargin(oracleScaleFactor uint32) sdk.Dec {
@@ -219,6 +225,8 @@ func (o *DerivativeLimitOrder) GetCancelRefundAmount(feeRate sdk.Dec) sdk.Dec {
 		// Refund = (FillableQuantity / Quantity) * (Margin + Price * Quantity * feeRate)
 		notional := o.OrderInfo.Price.Mul(o.OrderInfo.Quantity)
 		marginHoldRefund = o.Fillable.Mul(o.Margin.Add(notional.Mul(positiveFeePart))).Quo(o.OrderInfo.Quantity)
+		// the hidden reserve of iceberg orders is held separately from the visible slice
+		marginHoldRefund = marginHoldRefund.Add(o.GetHiddenBalanceHold())
 	}
 	return marginHoldRefund
 }
@@ -230,10 +238,14 @@ func (o *DerivativeOrder) CheckTickSize(minPriceTickSize, minQuantityTickSize sd
 	if BreachesMinimumTickSize(o.OrderInfo.Quantity, minQuantityTickSize) {
 		return sdkerrors.Wrapf(ErrInvalidQuantity, "quantity %s must be a multiple of the minimum quantity tick size %s", o.OrderInfo.Quantity.String(), minQuantityTickSize.String())
 	}
-	if !o.Margin.IsZero() {
-		if BreachesMinimumTickSize(o.Margin, minQuantityTickSize) {
-			return sdkerrors.Wrapf(ErrInvalidMargin, "margin %s must be a multiple of the minimum quantity tick size %s", o.Margin.String(), minQuantityTickSize.String())
-		}
+	return checkIcebergVisibleQuantityTickSize(o.VisibleQuantity, minQuantityTickSize)
+}
+
+// CheckMarginTickSize checks the margin of an order submitted by a trader. It isn't checked for the orders placed from
+// the reserve of an iceberg or TWAP order, whose margin is a pro rata share of the margin of the parent order.
+func (o *DerivativeOrder) CheckMarginTickSize(minQuantityTickSize sdk.Dec) error {
+	if !o.Margin.IsZero() && BreachesMinimumTickSize(o.Margin, minQuantityTickSize) {
+		return sdkerrors.Wrapf(ErrInvalidMargin, "margin %s must be a multiple of the minimum quantity tick size %s", o.Margin.String(), minQuantityTickSize.String())
 	}
 	return nil
 }
@@ -243,23 +255,29 @@ func GetScaledPrice(price sdk.Dec, scaleFactor uint32) sdk.Dec {
 }
 
 func (o *DerivativeOrder) GetRequiredBinaryOptionsMargin(oracleScaleFactor uint32) sdk.Dec {
+	return o.GetRequiredFullyCollateralizedMargin(GetScaledPrice(sdk.OneDec(), oracleScaleFactor))
+}
+
+// GetRequiredFullyCollateralizedMargin returns the required margin of the order in a fully collateralized market whose
+// contracts are worth at most maxPrice.
+func (o *DerivativeOrder) GetRequiredFullyCollateralizedMargin(maxPrice sdk.Dec) sdk.Dec {
 	// Margin = Price * Quantity for buys
 	if o.IsBuy() {
 		notional := o.Price().Mul(o.OrderInfo.Quantity)
 		return notional
 	}
-	// Margin = (scaled(1) - Price) * Quantity for sells
-	return o.OrderInfo.Quantity.Mul(GetScaledPrice(sdk.OneDec(), oracleScaleFactor).Sub(o.Price()))
+	// Margin = (maxPrice - Price) * Quantity for sells
+	return o.OrderInfo.Quantity.Mul(maxPrice.Sub(o.Price()))
 }
 
-func (o *DerivativeOrder) CheckMarginAndGetMarginHold(initialMarginRatio, executionMarkPrice, feeRate sdk.Dec, marketType MarketType, oracleScaleFactor uint32) (marginHold sdk.Dec, err error) {
+func (o *DerivativeOrder) CheckMarginAndGetMarginHold(initialMarginRatio, executionMarkPrice, feeRate sdk.Dec, marketType MarketType, maxPrice sdk.Dec) (marginHold sdk.Dec, err error) {
 	notional := o.OrderInfo.Price.Mul(o.OrderInfo.Quantity)
 	positiveFeeRatePart := sdk.MaxDec(feeRate, sdk.ZeroDec())
 	feeAmount := notional.Mul(positiveFeeRatePart)
 
 	marginHold = o.Margin.Add(feeAmount)
-	if marketType == MarketType_BinaryOption {
-		requiredMargin := o.GetRequiredBinaryOptionsMargin(oracleScaleFactor)
+	if marketType.IsFullyCollateralized() {
+		requiredMargin := o.GetRequiredFullyCollateralizedMargin(maxPrice)
 		if !o.Margin.Equal(requiredMargin) {
 			return sdk.Dec{}, sdkerrors.Wrapf(ErrInsufficientOrderMargin, "margin check: need %s but got %s", requiredMargin.String(), o.Margin.String())
 		}
@@ -311,15 +329,15 @@ func (o *DerivativeOrder) CheckValidConditionalPrice(markPrice sdk.Dec) (err err
 	return nil
 }
 
-// CheckBinaryOptionsPricesWithinBounds checks that binary options order prices don't exceed 1 (scaled)
-func (o *DerivativeOrder) CheckBinaryOptionsPricesWithinBounds(oracleScaleFactor uint32) (err error) {
-	maxScaledPrice := GetScaledPrice(sdk.OneDec(), oracleScaleFactor)
-	if o.Price().GTE(maxScaledPrice) {
-		return sdkerrors.Wrapf(ErrInvalidPrice, "price must be less than %s", maxScaledPrice.String())
+// CheckFullyCollateralizedPricesWithinBounds checks that the order prices of a fully collateralized market don't exceed
+// the max price of its contracts, e.g. 1 (scaled) for binary options
+func (o *DerivativeOrder) CheckFullyCollateralizedPricesWithinBounds(maxPrice sdk.Dec) (err error) {
+	if o.Price().GTE(maxPrice) {
+		return sdkerrors.Wrapf(ErrInvalidPrice, "price must be less than %s", maxPrice.String())
 	}
 
-	if o.IsConditional() && o.TriggerPrice.GTE(maxScaledPrice) {
-		return sdkerrors.Wrapf(ErrInvalidTriggerPrice, "trigger price must be less than %s", maxScaledPrice.String())
+	if o.IsConditional() && o.TriggerPrice.GTE(maxPrice) {
+		return sdkerrors.Wrapf(ErrInvalidTriggerPrice, "trigger price must be less than %s", maxPrice.String())
 	}
 	return nil
 }
@@ -99,4 +99,23 @@ var (
 	ErrFeatureDisabled                          = sdkerrors.Register(ModuleName, 92, "The current feature has been disabled")
 	ErrTooMuchOrderMargin                       = sdkerrors.Register(ModuleName, 93, "Order has too much margin")
 	ErrBadSubaccountNonce                       = sdkerrors.Register(ModuleName, 94, "Subaccount nonce is invalid")
+	ErrInvalidIcebergOrder                      = sdkerrors.Register(ModuleName, 95, "Invalid iceberg order")
+	ErrInvalidTWAPOrder                         = sdkerrors.Register(ModuleName, 96, "Invalid TWAP order")
+	ErrInvalidLadderOrder                       = sdkerrors.Register(ModuleName, 97, "Invalid ladder order")
+	ErrInvalidQuoteMarketOrder                  = sdkerrors.Register(ModuleName, 98, "Invalid quote market order")
+	ErrVanillaOptionsMarketExists               = sdkerrors.Register(ModuleName, 99, "vanilla options market exists")
+	ErrVanillaOptionsMarketNotFound             = sdkerrors.Register(ModuleName, 100, "vanilla options market not found")
+	ErrInvalidScalarBounds                      = sdkerrors.Register(ModuleName, 101, "invalid scalar bounds")
+	ErrCategoricalMarketExists                  = sdkerrors.Register(ModuleName, 102, "categorical market exists")
+	ErrCategoricalMarketNotFound                = sdkerrors.Register(ModuleName, 103, "categorical market not found")
+	ErrInvalidCategoricalOutcomes               = sdkerrors.Register(ModuleName, 104, "invalid categorical market outcomes")
+	ErrExpiryFuturesMarketSeriesExists          = sdkerrors.Register(ModuleName, 105, "expiry futures market series exists")
+	ErrExpiryFuturesMarketSeriesNotFound        = sdkerrors.Register(ModuleName, 106, "expiry futures market series not found")
+	ErrInvalidExpiryFuturesMarketSeries         = sdkerrors.Register(ModuleName, 107, "invalid expiry futures market series")
+	ErrInvalidMultiLegOrder                     = sdkerrors.Register(ModuleName, 108, "invalid multi-leg order")
+	ErrInvalidPreLaunchPerpetualMarket          = sdkerrors.Register(ModuleName, 109, "invalid pre-launch perpetual market")
+	ErrInvalidRFQRequest                        = sdkerrors.Register(ModuleName, 110, "invalid RFQ request")
+	ErrRFQRequestNotFound                       = sdkerrors.Register(ModuleName, 111, "RFQ request not found")
+	ErrInvalidRFQQuote                          = sdkerrors.Register(ModuleName, 112, "invalid RFQ quote")
+	ErrInvalidSignedOrder                       = sdkerrors.Register(ModuleName, 113, "invalid signed order")
 )
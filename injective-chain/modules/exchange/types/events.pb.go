@@ -510,24 +510,22 @@ func (m *EventBinaryOptionsMarketUpdate) GetMarket() BinaryOptionsMarket {
 	return BinaryOptionsMarket{}
 }
 
-type EventNewSpotOrders struct {
-	MarketId   string            `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	BuyOrders  []*SpotLimitOrder `protobuf:"bytes,2,rep,name=buy_orders,json=buyOrders,proto3" json:"buy_orders,omitempty"`
-	SellOrders []*SpotLimitOrder `protobuf:"bytes,3,rep,name=sell_orders,json=sellOrders,proto3" json:"sell_orders,omitempty"`
+type EventVanillaOptionsMarketUpdate struct {
+	Market VanillaOptionsMarket `protobuf:"bytes,1,opt,name=market,proto3" json:"market"`
 }
 
-func (m *EventNewSpotOrders) Reset()         { *m = EventNewSpotOrders{} }
-func (m *EventNewSpotOrders) String() string { return proto.CompactTextString(m) }
-func (*EventNewSpotOrders) ProtoMessage()    {}
-func (*EventNewSpotOrders) Descriptor() ([]byte, []int) {
+func (m *EventVanillaOptionsMarketUpdate) Reset()         { *m = EventVanillaOptionsMarketUpdate{} }
+func (m *EventVanillaOptionsMarketUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventVanillaOptionsMarketUpdate) ProtoMessage()    {}
+func (*EventVanillaOptionsMarketUpdate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{8}
 }
-func (m *EventNewSpotOrders) XXX_Unmarshal(b []byte) error {
+func (m *EventVanillaOptionsMarketUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventNewSpotOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventVanillaOptionsMarketUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventNewSpotOrders.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventVanillaOptionsMarketUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -537,57 +535,41 @@ func (m *EventNewSpotOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventNewSpotOrders) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventNewSpotOrders.Merge(m, src)
+func (m *EventVanillaOptionsMarketUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVanillaOptionsMarketUpdate.Merge(m, src)
 }
-func (m *EventNewSpotOrders) XXX_Size() int {
+func (m *EventVanillaOptionsMarketUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventNewSpotOrders) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventNewSpotOrders.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EventNewSpotOrders proto.InternalMessageInfo
-
-func (m *EventNewSpotOrders) GetMarketId() string {
-	if m != nil {
-		return m.MarketId
-	}
-	return ""
+func (m *EventVanillaOptionsMarketUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVanillaOptionsMarketUpdate.DiscardUnknown(m)
 }
 
-func (m *EventNewSpotOrders) GetBuyOrders() []*SpotLimitOrder {
-	if m != nil {
-		return m.BuyOrders
-	}
-	return nil
-}
+var xxx_messageInfo_EventVanillaOptionsMarketUpdate proto.InternalMessageInfo
 
-func (m *EventNewSpotOrders) GetSellOrders() []*SpotLimitOrder {
+func (m *EventVanillaOptionsMarketUpdate) GetMarket() VanillaOptionsMarket {
 	if m != nil {
-		return m.SellOrders
+		return m.Market
 	}
-	return nil
+	return VanillaOptionsMarket{}
 }
 
-type EventNewDerivativeOrders struct {
-	MarketId   string                  `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	BuyOrders  []*DerivativeLimitOrder `protobuf:"bytes,2,rep,name=buy_orders,json=buyOrders,proto3" json:"buy_orders,omitempty"`
-	SellOrders []*DerivativeLimitOrder `protobuf:"bytes,3,rep,name=sell_orders,json=sellOrders,proto3" json:"sell_orders,omitempty"`
+type EventCategoricalMarketUpdate struct {
+	Market CategoricalMarket `protobuf:"bytes,1,opt,name=market,proto3" json:"market"`
 }
 
-func (m *EventNewDerivativeOrders) Reset()         { *m = EventNewDerivativeOrders{} }
-func (m *EventNewDerivativeOrders) String() string { return proto.CompactTextString(m) }
-func (*EventNewDerivativeOrders) ProtoMessage()    {}
-func (*EventNewDerivativeOrders) Descriptor() ([]byte, []int) {
+func (m *EventCategoricalMarketUpdate) Reset()         { *m = EventCategoricalMarketUpdate{} }
+func (m *EventCategoricalMarketUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventCategoricalMarketUpdate) ProtoMessage()    {}
+func (*EventCategoricalMarketUpdate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{9}
 }
-func (m *EventNewDerivativeOrders) XXX_Unmarshal(b []byte) error {
+func (m *EventCategoricalMarketUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventNewDerivativeOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventCategoricalMarketUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventNewDerivativeOrders.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventCategoricalMarketUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -597,56 +579,41 @@ func (m *EventNewDerivativeOrders) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *EventNewDerivativeOrders) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventNewDerivativeOrders.Merge(m, src)
+func (m *EventCategoricalMarketUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCategoricalMarketUpdate.Merge(m, src)
 }
-func (m *EventNewDerivativeOrders) XXX_Size() int {
+func (m *EventCategoricalMarketUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventNewDerivativeOrders) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventNewDerivativeOrders.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EventNewDerivativeOrders proto.InternalMessageInfo
-
-func (m *EventNewDerivativeOrders) GetMarketId() string {
-	if m != nil {
-		return m.MarketId
-	}
-	return ""
+func (m *EventCategoricalMarketUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCategoricalMarketUpdate.DiscardUnknown(m)
 }
 
-func (m *EventNewDerivativeOrders) GetBuyOrders() []*DerivativeLimitOrder {
-	if m != nil {
-		return m.BuyOrders
-	}
-	return nil
-}
+var xxx_messageInfo_EventCategoricalMarketUpdate proto.InternalMessageInfo
 
-func (m *EventNewDerivativeOrders) GetSellOrders() []*DerivativeLimitOrder {
+func (m *EventCategoricalMarketUpdate) GetMarket() CategoricalMarket {
 	if m != nil {
-		return m.SellOrders
+		return m.Market
 	}
-	return nil
+	return CategoricalMarket{}
 }
 
-type EventCancelSpotOrder struct {
-	MarketId string         `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	Order    SpotLimitOrder `protobuf:"bytes,2,opt,name=order,proto3" json:"order"`
+type EventExpiryFuturesMarketSeriesUpdate struct {
+	Series ExpiryFuturesMarketSeries `protobuf:"bytes,1,opt,name=series,proto3" json:"series"`
 }
 
-func (m *EventCancelSpotOrder) Reset()         { *m = EventCancelSpotOrder{} }
-func (m *EventCancelSpotOrder) String() string { return proto.CompactTextString(m) }
-func (*EventCancelSpotOrder) ProtoMessage()    {}
-func (*EventCancelSpotOrder) Descriptor() ([]byte, []int) {
+func (m *EventExpiryFuturesMarketSeriesUpdate) Reset()         { *m = EventExpiryFuturesMarketSeriesUpdate{} }
+func (m *EventExpiryFuturesMarketSeriesUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventExpiryFuturesMarketSeriesUpdate) ProtoMessage()    {}
+func (*EventExpiryFuturesMarketSeriesUpdate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{10}
 }
-func (m *EventCancelSpotOrder) XXX_Unmarshal(b []byte) error {
+func (m *EventExpiryFuturesMarketSeriesUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventCancelSpotOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventExpiryFuturesMarketSeriesUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventCancelSpotOrder.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventExpiryFuturesMarketSeriesUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -656,48 +623,51 @@ func (m *EventCancelSpotOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *EventCancelSpotOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventCancelSpotOrder.Merge(m, src)
+func (m *EventExpiryFuturesMarketSeriesUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExpiryFuturesMarketSeriesUpdate.Merge(m, src)
 }
-func (m *EventCancelSpotOrder) XXX_Size() int {
+func (m *EventExpiryFuturesMarketSeriesUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventCancelSpotOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventCancelSpotOrder.DiscardUnknown(m)
+func (m *EventExpiryFuturesMarketSeriesUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExpiryFuturesMarketSeriesUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventCancelSpotOrder proto.InternalMessageInfo
-
-func (m *EventCancelSpotOrder) GetMarketId() string {
-	if m != nil {
-		return m.MarketId
-	}
-	return ""
-}
+var xxx_messageInfo_EventExpiryFuturesMarketSeriesUpdate proto.InternalMessageInfo
 
-func (m *EventCancelSpotOrder) GetOrder() SpotLimitOrder {
+func (m *EventExpiryFuturesMarketSeriesUpdate) GetSeries() ExpiryFuturesMarketSeries {
 	if m != nil {
-		return m.Order
+		return m.Series
 	}
-	return SpotLimitOrder{}
+	return ExpiryFuturesMarketSeries{}
 }
 
-type EventSpotMarketUpdate struct {
-	Market SpotMarket `protobuf:"bytes,1,opt,name=market,proto3" json:"market"`
+type EventExpiryFuturesPositionRolled struct {
+	SeriesId     string `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	SubaccountId string `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	// market_id defines the settled contract
+	MarketId string `protobuf:"bytes,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// next_market_id defines the contract the position was rolled into
+	NextMarketId string                                 `protobuf:"bytes,4,opt,name=next_market_id,json=nextMarketId,proto3" json:"next_market_id,omitempty"`
+	IsLong       bool                                   `protobuf:"varint,5,opt,name=is_long,json=isLong,proto3" json:"is_long,omitempty"`
+	Quantity     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	// price defines the settlement price of the settled contract, at which the position is opened in the next contract
+	Price  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Margin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=margin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"margin"`
 }
 
-func (m *EventSpotMarketUpdate) Reset()         { *m = EventSpotMarketUpdate{} }
-func (m *EventSpotMarketUpdate) String() string { return proto.CompactTextString(m) }
-func (*EventSpotMarketUpdate) ProtoMessage()    {}
-func (*EventSpotMarketUpdate) Descriptor() ([]byte, []int) {
+func (m *EventExpiryFuturesPositionRolled) Reset()         { *m = EventExpiryFuturesPositionRolled{} }
+func (m *EventExpiryFuturesPositionRolled) String() string { return proto.CompactTextString(m) }
+func (*EventExpiryFuturesPositionRolled) ProtoMessage()    {}
+func (*EventExpiryFuturesPositionRolled) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{11}
 }
-func (m *EventSpotMarketUpdate) XXX_Unmarshal(b []byte) error {
+func (m *EventExpiryFuturesPositionRolled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventSpotMarketUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventExpiryFuturesPositionRolled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventSpotMarketUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventExpiryFuturesPositionRolled.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -707,43 +677,78 @@ func (m *EventSpotMarketUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *EventSpotMarketUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventSpotMarketUpdate.Merge(m, src)
+func (m *EventExpiryFuturesPositionRolled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExpiryFuturesPositionRolled.Merge(m, src)
 }
-func (m *EventSpotMarketUpdate) XXX_Size() int {
+func (m *EventExpiryFuturesPositionRolled) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventSpotMarketUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventSpotMarketUpdate.DiscardUnknown(m)
+func (m *EventExpiryFuturesPositionRolled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExpiryFuturesPositionRolled.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventSpotMarketUpdate proto.InternalMessageInfo
+var xxx_messageInfo_EventExpiryFuturesPositionRolled proto.InternalMessageInfo
 
-func (m *EventSpotMarketUpdate) GetMarket() SpotMarket {
+func (m *EventExpiryFuturesPositionRolled) GetSeriesId() string {
 	if m != nil {
-		return m.Market
+		return m.SeriesId
 	}
-	return SpotMarket{}
+	return ""
 }
 
-type EventPerpetualMarketUpdate struct {
-	Market              DerivativeMarket        `protobuf:"bytes,1,opt,name=market,proto3" json:"market"`
-	PerpetualMarketInfo *PerpetualMarketInfo    `protobuf:"bytes,2,opt,name=perpetual_market_info,json=perpetualMarketInfo,proto3" json:"perpetual_market_info,omitempty"`
-	Funding             *PerpetualMarketFunding `protobuf:"bytes,3,opt,name=funding,proto3" json:"funding,omitempty"`
+func (m *EventExpiryFuturesPositionRolled) GetSubaccountId() string {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return ""
 }
 
-func (m *EventPerpetualMarketUpdate) Reset()         { *m = EventPerpetualMarketUpdate{} }
-func (m *EventPerpetualMarketUpdate) String() string { return proto.CompactTextString(m) }
-func (*EventPerpetualMarketUpdate) ProtoMessage()    {}
-func (*EventPerpetualMarketUpdate) Descriptor() ([]byte, []int) {
+func (m *EventExpiryFuturesPositionRolled) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+func (m *EventExpiryFuturesPositionRolled) GetNextMarketId() string {
+	if m != nil {
+		return m.NextMarketId
+	}
+	return ""
+}
+
+func (m *EventExpiryFuturesPositionRolled) GetIsLong() bool {
+	if m != nil {
+		return m.IsLong
+	}
+	return false
+}
+
+type EventExpiryFuturesPositionNotRolled struct {
+	SeriesId     string `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	SubaccountId string `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	// market_id defines the settled contract
+	MarketId string `protobuf:"bytes,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// next_market_id defines the contract the position was to be rolled into
+	NextMarketId string `protobuf:"bytes,4,opt,name=next_market_id,json=nextMarketId,proto3" json:"next_market_id,omitempty"`
+	IsLong       bool   `protobuf:"varint,5,opt,name=is_long,json=isLong,proto3" json:"is_long,omitempty"`
+	// quantity defines the quantity of the position which wasn't rolled
+	Quantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	Reason   ExpiryFuturesRollFailureReason         `protobuf:"varint,7,opt,name=reason,proto3,enum=injective.exchange.v1beta1.ExpiryFuturesRollFailureReason" json:"reason,omitempty"`
+}
+
+func (m *EventExpiryFuturesPositionNotRolled) Reset()         { *m = EventExpiryFuturesPositionNotRolled{} }
+func (m *EventExpiryFuturesPositionNotRolled) String() string { return proto.CompactTextString(m) }
+func (*EventExpiryFuturesPositionNotRolled) ProtoMessage()    {}
+func (*EventExpiryFuturesPositionNotRolled) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{12}
 }
-func (m *EventPerpetualMarketUpdate) XXX_Unmarshal(b []byte) error {
+func (m *EventExpiryFuturesPositionNotRolled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventPerpetualMarketUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventExpiryFuturesPositionNotRolled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventPerpetualMarketUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventExpiryFuturesPositionNotRolled.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -753,56 +758,80 @@ func (m *EventPerpetualMarketUpdate) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *EventPerpetualMarketUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventPerpetualMarketUpdate.Merge(m, src)
+func (m *EventExpiryFuturesPositionNotRolled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExpiryFuturesPositionNotRolled.Merge(m, src)
 }
-func (m *EventPerpetualMarketUpdate) XXX_Size() int {
+func (m *EventExpiryFuturesPositionNotRolled) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventPerpetualMarketUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventPerpetualMarketUpdate.DiscardUnknown(m)
+func (m *EventExpiryFuturesPositionNotRolled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExpiryFuturesPositionNotRolled.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventPerpetualMarketUpdate proto.InternalMessageInfo
+var xxx_messageInfo_EventExpiryFuturesPositionNotRolled proto.InternalMessageInfo
 
-func (m *EventPerpetualMarketUpdate) GetMarket() DerivativeMarket {
+func (m *EventExpiryFuturesPositionNotRolled) GetSeriesId() string {
 	if m != nil {
-		return m.Market
+		return m.SeriesId
 	}
-	return DerivativeMarket{}
+	return ""
 }
 
-func (m *EventPerpetualMarketUpdate) GetPerpetualMarketInfo() *PerpetualMarketInfo {
+func (m *EventExpiryFuturesPositionNotRolled) GetSubaccountId() string {
 	if m != nil {
-		return m.PerpetualMarketInfo
+		return m.SubaccountId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventPerpetualMarketUpdate) GetFunding() *PerpetualMarketFunding {
+func (m *EventExpiryFuturesPositionNotRolled) GetMarketId() string {
 	if m != nil {
-		return m.Funding
+		return m.MarketId
 	}
-	return nil
+	return ""
 }
 
-type EventExpiryFuturesMarketUpdate struct {
-	Market                  DerivativeMarket         `protobuf:"bytes,1,opt,name=market,proto3" json:"market"`
-	ExpiryFuturesMarketInfo *ExpiryFuturesMarketInfo `protobuf:"bytes,3,opt,name=expiry_futures_market_info,json=expiryFuturesMarketInfo,proto3" json:"expiry_futures_market_info,omitempty"`
+func (m *EventExpiryFuturesPositionNotRolled) GetNextMarketId() string {
+	if m != nil {
+		return m.NextMarketId
+	}
+	return ""
 }
 
-func (m *EventExpiryFuturesMarketUpdate) Reset()         { *m = EventExpiryFuturesMarketUpdate{} }
-func (m *EventExpiryFuturesMarketUpdate) String() string { return proto.CompactTextString(m) }
-func (*EventExpiryFuturesMarketUpdate) ProtoMessage()    {}
-func (*EventExpiryFuturesMarketUpdate) Descriptor() ([]byte, []int) {
+func (m *EventExpiryFuturesPositionNotRolled) GetIsLong() bool {
+	if m != nil {
+		return m.IsLong
+	}
+	return false
+}
+
+func (m *EventExpiryFuturesPositionNotRolled) GetReason() ExpiryFuturesRollFailureReason {
+	if m != nil {
+		return m.Reason
+	}
+	return ExpiryFuturesRollFailureReason_UnspecifiedRollFailureReason
+}
+
+type EventCategoricalOutcomeSets struct {
+	MarketId     string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	SubaccountId string                                 `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	Quantity     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	// is_mint is true for minted sets and false for redeemed sets
+	IsMint bool `protobuf:"varint,4,opt,name=is_mint,json=isMint,proto3" json:"is_mint,omitempty"`
+}
+
+func (m *EventCategoricalOutcomeSets) Reset()         { *m = EventCategoricalOutcomeSets{} }
+func (m *EventCategoricalOutcomeSets) String() string { return proto.CompactTextString(m) }
+func (*EventCategoricalOutcomeSets) ProtoMessage()    {}
+func (*EventCategoricalOutcomeSets) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{13}
 }
-func (m *EventExpiryFuturesMarketUpdate) XXX_Unmarshal(b []byte) error {
+func (m *EventCategoricalOutcomeSets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventExpiryFuturesMarketUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventCategoricalOutcomeSets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventExpiryFuturesMarketUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventCategoricalOutcomeSets.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -812,52 +841,60 @@ func (m *EventExpiryFuturesMarketUpdate) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *EventExpiryFuturesMarketUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventExpiryFuturesMarketUpdate.Merge(m, src)
+func (m *EventCategoricalOutcomeSets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCategoricalOutcomeSets.Merge(m, src)
 }
-func (m *EventExpiryFuturesMarketUpdate) XXX_Size() int {
+func (m *EventCategoricalOutcomeSets) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventExpiryFuturesMarketUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventExpiryFuturesMarketUpdate.DiscardUnknown(m)
+func (m *EventCategoricalOutcomeSets) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCategoricalOutcomeSets.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventExpiryFuturesMarketUpdate proto.InternalMessageInfo
+var xxx_messageInfo_EventCategoricalOutcomeSets proto.InternalMessageInfo
 
-func (m *EventExpiryFuturesMarketUpdate) GetMarket() DerivativeMarket {
+func (m *EventCategoricalOutcomeSets) GetMarketId() string {
 	if m != nil {
-		return m.Market
+		return m.MarketId
 	}
-	return DerivativeMarket{}
+	return ""
 }
 
-func (m *EventExpiryFuturesMarketUpdate) GetExpiryFuturesMarketInfo() *ExpiryFuturesMarketInfo {
+func (m *EventCategoricalOutcomeSets) GetSubaccountId() string {
 	if m != nil {
-		return m.ExpiryFuturesMarketInfo
+		return m.SubaccountId
 	}
-	return nil
+	return ""
 }
 
-type EventPerpetualMarketFundingUpdate struct {
-	MarketId        string                                  `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	Funding         PerpetualMarketFunding                  `protobuf:"bytes,2,opt,name=funding,proto3" json:"funding"`
-	IsHourlyFunding bool                                    `protobuf:"varint,3,opt,name=is_hourly_funding,json=isHourlyFunding,proto3" json:"is_hourly_funding,omitempty"`
-	FundingRate     *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=funding_rate,json=fundingRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_rate,omitempty"`
-	MarkPrice       *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=mark_price,json=markPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_price,omitempty"`
+func (m *EventCategoricalOutcomeSets) GetIsMint() bool {
+	if m != nil {
+		return m.IsMint
+	}
+	return false
 }
 
-func (m *EventPerpetualMarketFundingUpdate) Reset()         { *m = EventPerpetualMarketFundingUpdate{} }
-func (m *EventPerpetualMarketFundingUpdate) String() string { return proto.CompactTextString(m) }
-func (*EventPerpetualMarketFundingUpdate) ProtoMessage()    {}
-func (*EventPerpetualMarketFundingUpdate) Descriptor() ([]byte, []int) {
+// EventMultiLegOrder links the atomic market orders executed for the legs of a multi-leg order
+type EventMultiLegOrder struct {
+	SubaccountId string                                 `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	MarketIds    []string                               `protobuf:"bytes,2,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+	OrderHashes  []string                               `protobuf:"bytes,3,rep,name=order_hashes,json=orderHashes,proto3" json:"order_hashes,omitempty"`
+	Quantity     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	NetPrice     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=net_price,json=netPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"net_price"`
+}
+
+func (m *EventMultiLegOrder) Reset()         { *m = EventMultiLegOrder{} }
+func (m *EventMultiLegOrder) String() string { return proto.CompactTextString(m) }
+func (*EventMultiLegOrder) ProtoMessage()    {}
+func (*EventMultiLegOrder) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{14}
 }
-func (m *EventPerpetualMarketFundingUpdate) XXX_Unmarshal(b []byte) error {
+func (m *EventMultiLegOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventPerpetualMarketFundingUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventMultiLegOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventPerpetualMarketFundingUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventMultiLegOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -867,57 +904,57 @@ func (m *EventPerpetualMarketFundingUpdate) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *EventPerpetualMarketFundingUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventPerpetualMarketFundingUpdate.Merge(m, src)
+func (m *EventMultiLegOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMultiLegOrder.Merge(m, src)
 }
-func (m *EventPerpetualMarketFundingUpdate) XXX_Size() int {
+func (m *EventMultiLegOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventPerpetualMarketFundingUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventPerpetualMarketFundingUpdate.DiscardUnknown(m)
+func (m *EventMultiLegOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMultiLegOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventPerpetualMarketFundingUpdate proto.InternalMessageInfo
+var xxx_messageInfo_EventMultiLegOrder proto.InternalMessageInfo
 
-func (m *EventPerpetualMarketFundingUpdate) GetMarketId() string {
+func (m *EventMultiLegOrder) GetSubaccountId() string {
 	if m != nil {
-		return m.MarketId
+		return m.SubaccountId
 	}
 	return ""
 }
 
-func (m *EventPerpetualMarketFundingUpdate) GetFunding() PerpetualMarketFunding {
+func (m *EventMultiLegOrder) GetMarketIds() []string {
 	if m != nil {
-		return m.Funding
+		return m.MarketIds
 	}
-	return PerpetualMarketFunding{}
+	return nil
 }
 
-func (m *EventPerpetualMarketFundingUpdate) GetIsHourlyFunding() bool {
+func (m *EventMultiLegOrder) GetOrderHashes() []string {
 	if m != nil {
-		return m.IsHourlyFunding
+		return m.OrderHashes
 	}
-	return false
+	return nil
 }
 
-type EventSubaccountDeposit struct {
-	SrcAddress   string     `protobuf:"bytes,1,opt,name=src_address,json=srcAddress,proto3" json:"src_address,omitempty"`
-	SubaccountId []byte     `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
-	Amount       types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+type EventNewSpotOrders struct {
+	MarketId   string            `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	BuyOrders  []*SpotLimitOrder `protobuf:"bytes,2,rep,name=buy_orders,json=buyOrders,proto3" json:"buy_orders,omitempty"`
+	SellOrders []*SpotLimitOrder `protobuf:"bytes,3,rep,name=sell_orders,json=sellOrders,proto3" json:"sell_orders,omitempty"`
 }
 
-func (m *EventSubaccountDeposit) Reset()         { *m = EventSubaccountDeposit{} }
-func (m *EventSubaccountDeposit) String() string { return proto.CompactTextString(m) }
-func (*EventSubaccountDeposit) ProtoMessage()    {}
-func (*EventSubaccountDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20dda602b6b13fd3, []int{15}
+func (m *EventNewSpotOrders) Reset()         { *m = EventNewSpotOrders{} }
+func (m *EventNewSpotOrders) String() string { return proto.CompactTextString(m) }
+func (*EventNewSpotOrders) ProtoMessage()    {}
+func (*EventNewSpotOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{15}
 }
-func (m *EventSubaccountDeposit) XXX_Unmarshal(b []byte) error {
+func (m *EventNewSpotOrders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventSubaccountDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventNewSpotOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventSubaccountDeposit.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventNewSpotOrders.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -927,57 +964,57 @@ func (m *EventSubaccountDeposit) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *EventSubaccountDeposit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventSubaccountDeposit.Merge(m, src)
+func (m *EventNewSpotOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNewSpotOrders.Merge(m, src)
 }
-func (m *EventSubaccountDeposit) XXX_Size() int {
+func (m *EventNewSpotOrders) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventSubaccountDeposit) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventSubaccountDeposit.DiscardUnknown(m)
+func (m *EventNewSpotOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNewSpotOrders.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventSubaccountDeposit proto.InternalMessageInfo
+var xxx_messageInfo_EventNewSpotOrders proto.InternalMessageInfo
 
-func (m *EventSubaccountDeposit) GetSrcAddress() string {
+func (m *EventNewSpotOrders) GetMarketId() string {
 	if m != nil {
-		return m.SrcAddress
+		return m.MarketId
 	}
 	return ""
 }
 
-func (m *EventSubaccountDeposit) GetSubaccountId() []byte {
+func (m *EventNewSpotOrders) GetBuyOrders() []*SpotLimitOrder {
 	if m != nil {
-		return m.SubaccountId
+		return m.BuyOrders
 	}
 	return nil
 }
 
-func (m *EventSubaccountDeposit) GetAmount() types.Coin {
+func (m *EventNewSpotOrders) GetSellOrders() []*SpotLimitOrder {
 	if m != nil {
-		return m.Amount
+		return m.SellOrders
 	}
-	return types.Coin{}
+	return nil
 }
 
-type EventSubaccountWithdraw struct {
-	SubaccountId []byte     `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
-	DstAddress   string     `protobuf:"bytes,2,opt,name=dst_address,json=dstAddress,proto3" json:"dst_address,omitempty"`
-	Amount       types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+type EventNewDerivativeOrders struct {
+	MarketId   string                  `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	BuyOrders  []*DerivativeLimitOrder `protobuf:"bytes,2,rep,name=buy_orders,json=buyOrders,proto3" json:"buy_orders,omitempty"`
+	SellOrders []*DerivativeLimitOrder `protobuf:"bytes,3,rep,name=sell_orders,json=sellOrders,proto3" json:"sell_orders,omitempty"`
 }
 
-func (m *EventSubaccountWithdraw) Reset()         { *m = EventSubaccountWithdraw{} }
-func (m *EventSubaccountWithdraw) String() string { return proto.CompactTextString(m) }
-func (*EventSubaccountWithdraw) ProtoMessage()    {}
-func (*EventSubaccountWithdraw) Descriptor() ([]byte, []int) {
+func (m *EventNewDerivativeOrders) Reset()         { *m = EventNewDerivativeOrders{} }
+func (m *EventNewDerivativeOrders) String() string { return proto.CompactTextString(m) }
+func (*EventNewDerivativeOrders) ProtoMessage()    {}
+func (*EventNewDerivativeOrders) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{16}
 }
-func (m *EventSubaccountWithdraw) XXX_Unmarshal(b []byte) error {
+func (m *EventNewDerivativeOrders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventSubaccountWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventNewDerivativeOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventSubaccountWithdraw.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventNewDerivativeOrders.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -987,57 +1024,56 @@ func (m *EventSubaccountWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *EventSubaccountWithdraw) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventSubaccountWithdraw.Merge(m, src)
+func (m *EventNewDerivativeOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNewDerivativeOrders.Merge(m, src)
 }
-func (m *EventSubaccountWithdraw) XXX_Size() int {
+func (m *EventNewDerivativeOrders) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventSubaccountWithdraw) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventSubaccountWithdraw.DiscardUnknown(m)
+func (m *EventNewDerivativeOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNewDerivativeOrders.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventSubaccountWithdraw proto.InternalMessageInfo
+var xxx_messageInfo_EventNewDerivativeOrders proto.InternalMessageInfo
 
-func (m *EventSubaccountWithdraw) GetSubaccountId() []byte {
+func (m *EventNewDerivativeOrders) GetMarketId() string {
 	if m != nil {
-		return m.SubaccountId
+		return m.MarketId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventSubaccountWithdraw) GetDstAddress() string {
+func (m *EventNewDerivativeOrders) GetBuyOrders() []*DerivativeLimitOrder {
 	if m != nil {
-		return m.DstAddress
+		return m.BuyOrders
 	}
-	return ""
+	return nil
 }
 
-func (m *EventSubaccountWithdraw) GetAmount() types.Coin {
+func (m *EventNewDerivativeOrders) GetSellOrders() []*DerivativeLimitOrder {
 	if m != nil {
-		return m.Amount
+		return m.SellOrders
 	}
-	return types.Coin{}
+	return nil
 }
 
-type EventSubaccountBalanceTransfer struct {
-	SrcSubaccountId string     `protobuf:"bytes,1,opt,name=src_subaccount_id,json=srcSubaccountId,proto3" json:"src_subaccount_id,omitempty"`
-	DstSubaccountId string     `protobuf:"bytes,2,opt,name=dst_subaccount_id,json=dstSubaccountId,proto3" json:"dst_subaccount_id,omitempty"`
-	Amount          types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+type EventCancelSpotOrder struct {
+	MarketId string         `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Order    SpotLimitOrder `protobuf:"bytes,2,opt,name=order,proto3" json:"order"`
 }
 
-func (m *EventSubaccountBalanceTransfer) Reset()         { *m = EventSubaccountBalanceTransfer{} }
-func (m *EventSubaccountBalanceTransfer) String() string { return proto.CompactTextString(m) }
-func (*EventSubaccountBalanceTransfer) ProtoMessage()    {}
-func (*EventSubaccountBalanceTransfer) Descriptor() ([]byte, []int) {
+func (m *EventCancelSpotOrder) Reset()         { *m = EventCancelSpotOrder{} }
+func (m *EventCancelSpotOrder) String() string { return proto.CompactTextString(m) }
+func (*EventCancelSpotOrder) ProtoMessage()    {}
+func (*EventCancelSpotOrder) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{17}
 }
-func (m *EventSubaccountBalanceTransfer) XXX_Unmarshal(b []byte) error {
+func (m *EventCancelSpotOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventSubaccountBalanceTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventCancelSpotOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventSubaccountBalanceTransfer.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventCancelSpotOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1047,55 +1083,48 @@ func (m *EventSubaccountBalanceTransfer) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *EventSubaccountBalanceTransfer) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventSubaccountBalanceTransfer.Merge(m, src)
+func (m *EventCancelSpotOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelSpotOrder.Merge(m, src)
 }
-func (m *EventSubaccountBalanceTransfer) XXX_Size() int {
+func (m *EventCancelSpotOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventSubaccountBalanceTransfer) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventSubaccountBalanceTransfer.DiscardUnknown(m)
+func (m *EventCancelSpotOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelSpotOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventSubaccountBalanceTransfer proto.InternalMessageInfo
-
-func (m *EventSubaccountBalanceTransfer) GetSrcSubaccountId() string {
-	if m != nil {
-		return m.SrcSubaccountId
-	}
-	return ""
-}
+var xxx_messageInfo_EventCancelSpotOrder proto.InternalMessageInfo
 
-func (m *EventSubaccountBalanceTransfer) GetDstSubaccountId() string {
+func (m *EventCancelSpotOrder) GetMarketId() string {
 	if m != nil {
-		return m.DstSubaccountId
+		return m.MarketId
 	}
 	return ""
 }
 
-func (m *EventSubaccountBalanceTransfer) GetAmount() types.Coin {
+func (m *EventCancelSpotOrder) GetOrder() SpotLimitOrder {
 	if m != nil {
-		return m.Amount
+		return m.Order
 	}
-	return types.Coin{}
+	return SpotLimitOrder{}
 }
 
-type EventBatchDepositUpdate struct {
-	DepositUpdates []*DepositUpdate `protobuf:"bytes,1,rep,name=deposit_updates,json=depositUpdates,proto3" json:"deposit_updates,omitempty"`
+type EventSpotMarketUpdate struct {
+	Market SpotMarket `protobuf:"bytes,1,opt,name=market,proto3" json:"market"`
 }
 
-func (m *EventBatchDepositUpdate) Reset()         { *m = EventBatchDepositUpdate{} }
-func (m *EventBatchDepositUpdate) String() string { return proto.CompactTextString(m) }
-func (*EventBatchDepositUpdate) ProtoMessage()    {}
-func (*EventBatchDepositUpdate) Descriptor() ([]byte, []int) {
+func (m *EventSpotMarketUpdate) Reset()         { *m = EventSpotMarketUpdate{} }
+func (m *EventSpotMarketUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventSpotMarketUpdate) ProtoMessage()    {}
+func (*EventSpotMarketUpdate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{18}
 }
-func (m *EventBatchDepositUpdate) XXX_Unmarshal(b []byte) error {
+func (m *EventSpotMarketUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventBatchDepositUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSpotMarketUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventBatchDepositUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSpotMarketUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1105,42 +1134,43 @@ func (m *EventBatchDepositUpdate) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *EventBatchDepositUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventBatchDepositUpdate.Merge(m, src)
+func (m *EventSpotMarketUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSpotMarketUpdate.Merge(m, src)
 }
-func (m *EventBatchDepositUpdate) XXX_Size() int {
+func (m *EventSpotMarketUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventBatchDepositUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventBatchDepositUpdate.DiscardUnknown(m)
+func (m *EventSpotMarketUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSpotMarketUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventBatchDepositUpdate proto.InternalMessageInfo
+var xxx_messageInfo_EventSpotMarketUpdate proto.InternalMessageInfo
 
-func (m *EventBatchDepositUpdate) GetDepositUpdates() []*DepositUpdate {
+func (m *EventSpotMarketUpdate) GetMarket() SpotMarket {
 	if m != nil {
-		return m.DepositUpdates
+		return m.Market
 	}
-	return nil
+	return SpotMarket{}
 }
 
-type DerivativeMarketOrderCancel struct {
-	MarketOrder    *DerivativeMarketOrder                 `protobuf:"bytes,1,opt,name=market_order,json=marketOrder,proto3" json:"market_order,omitempty"`
-	CancelQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=cancel_quantity,json=cancelQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cancel_quantity"`
+type EventPerpetualMarketUpdate struct {
+	Market              DerivativeMarket        `protobuf:"bytes,1,opt,name=market,proto3" json:"market"`
+	PerpetualMarketInfo *PerpetualMarketInfo    `protobuf:"bytes,2,opt,name=perpetual_market_info,json=perpetualMarketInfo,proto3" json:"perpetual_market_info,omitempty"`
+	Funding             *PerpetualMarketFunding `protobuf:"bytes,3,opt,name=funding,proto3" json:"funding,omitempty"`
 }
 
-func (m *DerivativeMarketOrderCancel) Reset()         { *m = DerivativeMarketOrderCancel{} }
-func (m *DerivativeMarketOrderCancel) String() string { return proto.CompactTextString(m) }
-func (*DerivativeMarketOrderCancel) ProtoMessage()    {}
-func (*DerivativeMarketOrderCancel) Descriptor() ([]byte, []int) {
+func (m *EventPerpetualMarketUpdate) Reset()         { *m = EventPerpetualMarketUpdate{} }
+func (m *EventPerpetualMarketUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventPerpetualMarketUpdate) ProtoMessage()    {}
+func (*EventPerpetualMarketUpdate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{19}
 }
-func (m *DerivativeMarketOrderCancel) XXX_Unmarshal(b []byte) error {
+func (m *EventPerpetualMarketUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DerivativeMarketOrderCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventPerpetualMarketUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DerivativeMarketOrderCancel.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventPerpetualMarketUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1150,44 +1180,56 @@ func (m *DerivativeMarketOrderCancel) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *DerivativeMarketOrderCancel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DerivativeMarketOrderCancel.Merge(m, src)
+func (m *EventPerpetualMarketUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPerpetualMarketUpdate.Merge(m, src)
 }
-func (m *DerivativeMarketOrderCancel) XXX_Size() int {
+func (m *EventPerpetualMarketUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *DerivativeMarketOrderCancel) XXX_DiscardUnknown() {
-	xxx_messageInfo_DerivativeMarketOrderCancel.DiscardUnknown(m)
+func (m *EventPerpetualMarketUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPerpetualMarketUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DerivativeMarketOrderCancel proto.InternalMessageInfo
+var xxx_messageInfo_EventPerpetualMarketUpdate proto.InternalMessageInfo
 
-func (m *DerivativeMarketOrderCancel) GetMarketOrder() *DerivativeMarketOrder {
+func (m *EventPerpetualMarketUpdate) GetMarket() DerivativeMarket {
 	if m != nil {
-		return m.MarketOrder
+		return m.Market
+	}
+	return DerivativeMarket{}
+}
+
+func (m *EventPerpetualMarketUpdate) GetPerpetualMarketInfo() *PerpetualMarketInfo {
+	if m != nil {
+		return m.PerpetualMarketInfo
 	}
 	return nil
 }
 
-type EventCancelDerivativeOrder struct {
-	MarketId          string                       `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	IsLimitCancel     bool                         `protobuf:"varint,2,opt,name=isLimitCancel,proto3" json:"isLimitCancel,omitempty"`
-	LimitOrder        *DerivativeLimitOrder        `protobuf:"bytes,3,opt,name=limit_order,json=limitOrder,proto3" json:"limit_order,omitempty"`
-	MarketOrderCancel *DerivativeMarketOrderCancel `protobuf:"bytes,4,opt,name=market_order_cancel,json=marketOrderCancel,proto3" json:"market_order_cancel,omitempty"`
+func (m *EventPerpetualMarketUpdate) GetFunding() *PerpetualMarketFunding {
+	if m != nil {
+		return m.Funding
+	}
+	return nil
 }
 
-func (m *EventCancelDerivativeOrder) Reset()         { *m = EventCancelDerivativeOrder{} }
-func (m *EventCancelDerivativeOrder) String() string { return proto.CompactTextString(m) }
-func (*EventCancelDerivativeOrder) ProtoMessage()    {}
-func (*EventCancelDerivativeOrder) Descriptor() ([]byte, []int) {
+type EventExpiryFuturesMarketUpdate struct {
+	Market                  DerivativeMarket         `protobuf:"bytes,1,opt,name=market,proto3" json:"market"`
+	ExpiryFuturesMarketInfo *ExpiryFuturesMarketInfo `protobuf:"bytes,3,opt,name=expiry_futures_market_info,json=expiryFuturesMarketInfo,proto3" json:"expiry_futures_market_info,omitempty"`
+}
+
+func (m *EventExpiryFuturesMarketUpdate) Reset()         { *m = EventExpiryFuturesMarketUpdate{} }
+func (m *EventExpiryFuturesMarketUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventExpiryFuturesMarketUpdate) ProtoMessage()    {}
+func (*EventExpiryFuturesMarketUpdate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{20}
 }
-func (m *EventCancelDerivativeOrder) XXX_Unmarshal(b []byte) error {
+func (m *EventExpiryFuturesMarketUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventCancelDerivativeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventExpiryFuturesMarketUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventCancelDerivativeOrder.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventExpiryFuturesMarketUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1197,62 +1239,52 @@ func (m *EventCancelDerivativeOrder) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *EventCancelDerivativeOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventCancelDerivativeOrder.Merge(m, src)
+func (m *EventExpiryFuturesMarketUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExpiryFuturesMarketUpdate.Merge(m, src)
 }
-func (m *EventCancelDerivativeOrder) XXX_Size() int {
+func (m *EventExpiryFuturesMarketUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventCancelDerivativeOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventCancelDerivativeOrder.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EventCancelDerivativeOrder proto.InternalMessageInfo
-
-func (m *EventCancelDerivativeOrder) GetMarketId() string {
-	if m != nil {
-		return m.MarketId
-	}
-	return ""
+func (m *EventExpiryFuturesMarketUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExpiryFuturesMarketUpdate.DiscardUnknown(m)
 }
 
-func (m *EventCancelDerivativeOrder) GetIsLimitCancel() bool {
-	if m != nil {
-		return m.IsLimitCancel
-	}
-	return false
-}
+var xxx_messageInfo_EventExpiryFuturesMarketUpdate proto.InternalMessageInfo
 
-func (m *EventCancelDerivativeOrder) GetLimitOrder() *DerivativeLimitOrder {
+func (m *EventExpiryFuturesMarketUpdate) GetMarket() DerivativeMarket {
 	if m != nil {
-		return m.LimitOrder
+		return m.Market
 	}
-	return nil
+	return DerivativeMarket{}
 }
 
-func (m *EventCancelDerivativeOrder) GetMarketOrderCancel() *DerivativeMarketOrderCancel {
+func (m *EventExpiryFuturesMarketUpdate) GetExpiryFuturesMarketInfo() *ExpiryFuturesMarketInfo {
 	if m != nil {
-		return m.MarketOrderCancel
+		return m.ExpiryFuturesMarketInfo
 	}
 	return nil
 }
 
-type EventFeeDiscountSchedule struct {
-	Schedule *FeeDiscountSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+type EventPerpetualMarketFundingUpdate struct {
+	MarketId        string                                  `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Funding         PerpetualMarketFunding                  `protobuf:"bytes,2,opt,name=funding,proto3" json:"funding"`
+	IsHourlyFunding bool                                    `protobuf:"varint,3,opt,name=is_hourly_funding,json=isHourlyFunding,proto3" json:"is_hourly_funding,omitempty"`
+	FundingRate     *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=funding_rate,json=fundingRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_rate,omitempty"`
+	MarkPrice       *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=mark_price,json=markPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_price,omitempty"`
 }
 
-func (m *EventFeeDiscountSchedule) Reset()         { *m = EventFeeDiscountSchedule{} }
-func (m *EventFeeDiscountSchedule) String() string { return proto.CompactTextString(m) }
-func (*EventFeeDiscountSchedule) ProtoMessage()    {}
-func (*EventFeeDiscountSchedule) Descriptor() ([]byte, []int) {
+func (m *EventPerpetualMarketFundingUpdate) Reset()         { *m = EventPerpetualMarketFundingUpdate{} }
+func (m *EventPerpetualMarketFundingUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventPerpetualMarketFundingUpdate) ProtoMessage()    {}
+func (*EventPerpetualMarketFundingUpdate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{21}
 }
-func (m *EventFeeDiscountSchedule) XXX_Unmarshal(b []byte) error {
+func (m *EventPerpetualMarketFundingUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventFeeDiscountSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventPerpetualMarketFundingUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventFeeDiscountSchedule.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventPerpetualMarketFundingUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1262,42 +1294,59 @@ func (m *EventFeeDiscountSchedule) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *EventFeeDiscountSchedule) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventFeeDiscountSchedule.Merge(m, src)
+func (m *EventPerpetualMarketFundingUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPerpetualMarketFundingUpdate.Merge(m, src)
 }
-func (m *EventFeeDiscountSchedule) XXX_Size() int {
+func (m *EventPerpetualMarketFundingUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventFeeDiscountSchedule) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventFeeDiscountSchedule.DiscardUnknown(m)
+func (m *EventPerpetualMarketFundingUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPerpetualMarketFundingUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventFeeDiscountSchedule proto.InternalMessageInfo
+var xxx_messageInfo_EventPerpetualMarketFundingUpdate proto.InternalMessageInfo
 
-func (m *EventFeeDiscountSchedule) GetSchedule() *FeeDiscountSchedule {
+func (m *EventPerpetualMarketFundingUpdate) GetMarketId() string {
 	if m != nil {
-		return m.Schedule
+		return m.MarketId
 	}
-	return nil
+	return ""
 }
 
-type EventTradingRewardCampaignUpdate struct {
-	CampaignInfo        *TradingRewardCampaignInfo `protobuf:"bytes,1,opt,name=campaign_info,json=campaignInfo,proto3" json:"campaign_info,omitempty"`
-	CampaignRewardPools []*CampaignRewardPool      `protobuf:"bytes,2,rep,name=campaign_reward_pools,json=campaignRewardPools,proto3" json:"campaign_reward_pools,omitempty"`
+func (m *EventPerpetualMarketFundingUpdate) GetFunding() PerpetualMarketFunding {
+	if m != nil {
+		return m.Funding
+	}
+	return PerpetualMarketFunding{}
 }
 
-func (m *EventTradingRewardCampaignUpdate) Reset()         { *m = EventTradingRewardCampaignUpdate{} }
-func (m *EventTradingRewardCampaignUpdate) String() string { return proto.CompactTextString(m) }
-func (*EventTradingRewardCampaignUpdate) ProtoMessage()    {}
-func (*EventTradingRewardCampaignUpdate) Descriptor() ([]byte, []int) {
+func (m *EventPerpetualMarketFundingUpdate) GetIsHourlyFunding() bool {
+	if m != nil {
+		return m.IsHourlyFunding
+	}
+	return false
+}
+
+// EventPreLaunchPerpetualMarketInfoUpdate is emitted whenever the mark price state of a pre-launch perpetual market
+// changes, i.e. when it trades and when its TWAP window is rolled
+type EventPreLaunchPerpetualMarketInfoUpdate struct {
+	Info PreLaunchPerpetualMarketInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info"`
+}
+
+func (m *EventPreLaunchPerpetualMarketInfoUpdate) Reset() {
+	*m = EventPreLaunchPerpetualMarketInfoUpdate{}
+}
+func (m *EventPreLaunchPerpetualMarketInfoUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventPreLaunchPerpetualMarketInfoUpdate) ProtoMessage()    {}
+func (*EventPreLaunchPerpetualMarketInfoUpdate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{22}
 }
-func (m *EventTradingRewardCampaignUpdate) XXX_Unmarshal(b []byte) error {
+func (m *EventPreLaunchPerpetualMarketInfoUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventTradingRewardCampaignUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventPreLaunchPerpetualMarketInfoUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventTradingRewardCampaignUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventPreLaunchPerpetualMarketInfoUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1307,48 +1356,43 @@ func (m *EventTradingRewardCampaignUpdate) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *EventTradingRewardCampaignUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventTradingRewardCampaignUpdate.Merge(m, src)
+func (m *EventPreLaunchPerpetualMarketInfoUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPreLaunchPerpetualMarketInfoUpdate.Merge(m, src)
 }
-func (m *EventTradingRewardCampaignUpdate) XXX_Size() int {
+func (m *EventPreLaunchPerpetualMarketInfoUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventTradingRewardCampaignUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventTradingRewardCampaignUpdate.DiscardUnknown(m)
+func (m *EventPreLaunchPerpetualMarketInfoUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPreLaunchPerpetualMarketInfoUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventTradingRewardCampaignUpdate proto.InternalMessageInfo
-
-func (m *EventTradingRewardCampaignUpdate) GetCampaignInfo() *TradingRewardCampaignInfo {
-	if m != nil {
-		return m.CampaignInfo
-	}
-	return nil
-}
+var xxx_messageInfo_EventPreLaunchPerpetualMarketInfoUpdate proto.InternalMessageInfo
 
-func (m *EventTradingRewardCampaignUpdate) GetCampaignRewardPools() []*CampaignRewardPool {
+func (m *EventPreLaunchPerpetualMarketInfoUpdate) GetInfo() PreLaunchPerpetualMarketInfo {
 	if m != nil {
-		return m.CampaignRewardPools
+		return m.Info
 	}
-	return nil
+	return PreLaunchPerpetualMarketInfo{}
 }
 
-type EventTradingRewardDistribution struct {
-	AccountRewards []*AccountRewards `protobuf:"bytes,1,rep,name=account_rewards,json=accountRewards,proto3" json:"account_rewards,omitempty"`
+type EventSubaccountDeposit struct {
+	SrcAddress   string     `protobuf:"bytes,1,opt,name=src_address,json=srcAddress,proto3" json:"src_address,omitempty"`
+	SubaccountId []byte     `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	Amount       types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *EventTradingRewardDistribution) Reset()         { *m = EventTradingRewardDistribution{} }
-func (m *EventTradingRewardDistribution) String() string { return proto.CompactTextString(m) }
-func (*EventTradingRewardDistribution) ProtoMessage()    {}
-func (*EventTradingRewardDistribution) Descriptor() ([]byte, []int) {
+func (m *EventSubaccountDeposit) Reset()         { *m = EventSubaccountDeposit{} }
+func (m *EventSubaccountDeposit) String() string { return proto.CompactTextString(m) }
+func (*EventSubaccountDeposit) ProtoMessage()    {}
+func (*EventSubaccountDeposit) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{23}
 }
-func (m *EventTradingRewardDistribution) XXX_Unmarshal(b []byte) error {
+func (m *EventSubaccountDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventTradingRewardDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSubaccountDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventTradingRewardDistribution.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSubaccountDeposit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1358,44 +1402,57 @@ func (m *EventTradingRewardDistribution) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *EventTradingRewardDistribution) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventTradingRewardDistribution.Merge(m, src)
+func (m *EventSubaccountDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSubaccountDeposit.Merge(m, src)
 }
-func (m *EventTradingRewardDistribution) XXX_Size() int {
+func (m *EventSubaccountDeposit) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventTradingRewardDistribution) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventTradingRewardDistribution.DiscardUnknown(m)
+func (m *EventSubaccountDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSubaccountDeposit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventTradingRewardDistribution proto.InternalMessageInfo
+var xxx_messageInfo_EventSubaccountDeposit proto.InternalMessageInfo
 
-func (m *EventTradingRewardDistribution) GetAccountRewards() []*AccountRewards {
+func (m *EventSubaccountDeposit) GetSrcAddress() string {
 	if m != nil {
-		return m.AccountRewards
+		return m.SrcAddress
+	}
+	return ""
+}
+
+func (m *EventSubaccountDeposit) GetSubaccountId() []byte {
+	if m != nil {
+		return m.SubaccountId
 	}
 	return nil
 }
 
-type EventNewConditionalDerivativeOrder struct {
-	MarketId string           `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	Order    *DerivativeOrder `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
-	Hash     []byte           `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
-	IsMarket bool             `protobuf:"varint,4,opt,name=is_market,json=isMarket,proto3" json:"is_market,omitempty"`
+func (m *EventSubaccountDeposit) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
 }
 
-func (m *EventNewConditionalDerivativeOrder) Reset()         { *m = EventNewConditionalDerivativeOrder{} }
-func (m *EventNewConditionalDerivativeOrder) String() string { return proto.CompactTextString(m) }
-func (*EventNewConditionalDerivativeOrder) ProtoMessage()    {}
-func (*EventNewConditionalDerivativeOrder) Descriptor() ([]byte, []int) {
+type EventSubaccountWithdraw struct {
+	SubaccountId []byte     `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	DstAddress   string     `protobuf:"bytes,2,opt,name=dst_address,json=dstAddress,proto3" json:"dst_address,omitempty"`
+	Amount       types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *EventSubaccountWithdraw) Reset()         { *m = EventSubaccountWithdraw{} }
+func (m *EventSubaccountWithdraw) String() string { return proto.CompactTextString(m) }
+func (*EventSubaccountWithdraw) ProtoMessage()    {}
+func (*EventSubaccountWithdraw) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{24}
 }
-func (m *EventNewConditionalDerivativeOrder) XXX_Unmarshal(b []byte) error {
+func (m *EventSubaccountWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventNewConditionalDerivativeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSubaccountWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventNewConditionalDerivativeOrder.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSubaccountWithdraw.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1405,65 +1462,57 @@ func (m *EventNewConditionalDerivativeOrder) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *EventNewConditionalDerivativeOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventNewConditionalDerivativeOrder.Merge(m, src)
+func (m *EventSubaccountWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSubaccountWithdraw.Merge(m, src)
 }
-func (m *EventNewConditionalDerivativeOrder) XXX_Size() int {
+func (m *EventSubaccountWithdraw) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventNewConditionalDerivativeOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventNewConditionalDerivativeOrder.DiscardUnknown(m)
+func (m *EventSubaccountWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSubaccountWithdraw.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventNewConditionalDerivativeOrder proto.InternalMessageInfo
-
-func (m *EventNewConditionalDerivativeOrder) GetMarketId() string {
-	if m != nil {
-		return m.MarketId
-	}
-	return ""
-}
+var xxx_messageInfo_EventSubaccountWithdraw proto.InternalMessageInfo
 
-func (m *EventNewConditionalDerivativeOrder) GetOrder() *DerivativeOrder {
+func (m *EventSubaccountWithdraw) GetSubaccountId() []byte {
 	if m != nil {
-		return m.Order
+		return m.SubaccountId
 	}
 	return nil
 }
 
-func (m *EventNewConditionalDerivativeOrder) GetHash() []byte {
+func (m *EventSubaccountWithdraw) GetDstAddress() string {
 	if m != nil {
-		return m.Hash
+		return m.DstAddress
 	}
-	return nil
+	return ""
 }
 
-func (m *EventNewConditionalDerivativeOrder) GetIsMarket() bool {
+func (m *EventSubaccountWithdraw) GetAmount() types.Coin {
 	if m != nil {
-		return m.IsMarket
+		return m.Amount
 	}
-	return false
+	return types.Coin{}
 }
 
-type EventCancelConditionalDerivativeOrder struct {
-	MarketId      string                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	IsLimitCancel bool                   `protobuf:"varint,2,opt,name=isLimitCancel,proto3" json:"isLimitCancel,omitempty"`
-	LimitOrder    *DerivativeLimitOrder  `protobuf:"bytes,3,opt,name=limit_order,json=limitOrder,proto3" json:"limit_order,omitempty"`
-	MarketOrder   *DerivativeMarketOrder `protobuf:"bytes,4,opt,name=market_order,json=marketOrder,proto3" json:"market_order,omitempty"`
+type EventSubaccountBalanceTransfer struct {
+	SrcSubaccountId string     `protobuf:"bytes,1,opt,name=src_subaccount_id,json=srcSubaccountId,proto3" json:"src_subaccount_id,omitempty"`
+	DstSubaccountId string     `protobuf:"bytes,2,opt,name=dst_subaccount_id,json=dstSubaccountId,proto3" json:"dst_subaccount_id,omitempty"`
+	Amount          types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *EventCancelConditionalDerivativeOrder) Reset()         { *m = EventCancelConditionalDerivativeOrder{} }
-func (m *EventCancelConditionalDerivativeOrder) String() string { return proto.CompactTextString(m) }
-func (*EventCancelConditionalDerivativeOrder) ProtoMessage()    {}
-func (*EventCancelConditionalDerivativeOrder) Descriptor() ([]byte, []int) {
+func (m *EventSubaccountBalanceTransfer) Reset()         { *m = EventSubaccountBalanceTransfer{} }
+func (m *EventSubaccountBalanceTransfer) String() string { return proto.CompactTextString(m) }
+func (*EventSubaccountBalanceTransfer) ProtoMessage()    {}
+func (*EventSubaccountBalanceTransfer) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{25}
 }
-func (m *EventCancelConditionalDerivativeOrder) XXX_Unmarshal(b []byte) error {
+func (m *EventSubaccountBalanceTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventCancelConditionalDerivativeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSubaccountBalanceTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventCancelConditionalDerivativeOrder.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSubaccountBalanceTransfer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1473,67 +1522,55 @@ func (m *EventCancelConditionalDerivativeOrder) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *EventCancelConditionalDerivativeOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventCancelConditionalDerivativeOrder.Merge(m, src)
+func (m *EventSubaccountBalanceTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSubaccountBalanceTransfer.Merge(m, src)
 }
-func (m *EventCancelConditionalDerivativeOrder) XXX_Size() int {
+func (m *EventSubaccountBalanceTransfer) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventCancelConditionalDerivativeOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventCancelConditionalDerivativeOrder.DiscardUnknown(m)
+func (m *EventSubaccountBalanceTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSubaccountBalanceTransfer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventCancelConditionalDerivativeOrder proto.InternalMessageInfo
+var xxx_messageInfo_EventSubaccountBalanceTransfer proto.InternalMessageInfo
 
-func (m *EventCancelConditionalDerivativeOrder) GetMarketId() string {
+func (m *EventSubaccountBalanceTransfer) GetSrcSubaccountId() string {
 	if m != nil {
-		return m.MarketId
+		return m.SrcSubaccountId
 	}
 	return ""
 }
 
-func (m *EventCancelConditionalDerivativeOrder) GetIsLimitCancel() bool {
-	if m != nil {
-		return m.IsLimitCancel
-	}
-	return false
-}
-
-func (m *EventCancelConditionalDerivativeOrder) GetLimitOrder() *DerivativeLimitOrder {
+func (m *EventSubaccountBalanceTransfer) GetDstSubaccountId() string {
 	if m != nil {
-		return m.LimitOrder
+		return m.DstSubaccountId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventCancelConditionalDerivativeOrder) GetMarketOrder() *DerivativeMarketOrder {
+func (m *EventSubaccountBalanceTransfer) GetAmount() types.Coin {
 	if m != nil {
-		return m.MarketOrder
+		return m.Amount
 	}
-	return nil
+	return types.Coin{}
 }
 
-type EventConditionalDerivativeOrderTrigger struct {
-	MarketId           []byte `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	IsLimitTrigger     bool   `protobuf:"varint,2,opt,name=isLimitTrigger,proto3" json:"isLimitTrigger,omitempty"`
-	TriggeredOrderHash []byte `protobuf:"bytes,3,opt,name=triggered_order_hash,json=triggeredOrderHash,proto3" json:"triggered_order_hash,omitempty"`
-	PlacedOrderHash    []byte `protobuf:"bytes,4,opt,name=placed_order_hash,json=placedOrderHash,proto3" json:"placed_order_hash,omitempty"`
+type EventBatchDepositUpdate struct {
+	DepositUpdates []*DepositUpdate `protobuf:"bytes,1,rep,name=deposit_updates,json=depositUpdates,proto3" json:"deposit_updates,omitempty"`
 }
 
-func (m *EventConditionalDerivativeOrderTrigger) Reset() {
-	*m = EventConditionalDerivativeOrderTrigger{}
-}
-func (m *EventConditionalDerivativeOrderTrigger) String() string { return proto.CompactTextString(m) }
-func (*EventConditionalDerivativeOrderTrigger) ProtoMessage()    {}
-func (*EventConditionalDerivativeOrderTrigger) Descriptor() ([]byte, []int) {
+func (m *EventBatchDepositUpdate) Reset()         { *m = EventBatchDepositUpdate{} }
+func (m *EventBatchDepositUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventBatchDepositUpdate) ProtoMessage()    {}
+func (*EventBatchDepositUpdate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_20dda602b6b13fd3, []int{26}
 }
-func (m *EventConditionalDerivativeOrderTrigger) XXX_Unmarshal(b []byte) error {
+func (m *EventBatchDepositUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventConditionalDerivativeOrderTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventBatchDepositUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventConditionalDerivativeOrderTrigger.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventBatchDepositUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1543,64 +1580,89 @@ func (m *EventConditionalDerivativeOrderTrigger) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *EventConditionalDerivativeOrderTrigger) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventConditionalDerivativeOrderTrigger.Merge(m, src)
+func (m *EventBatchDepositUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBatchDepositUpdate.Merge(m, src)
 }
-func (m *EventConditionalDerivativeOrderTrigger) XXX_Size() int {
+func (m *EventBatchDepositUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventConditionalDerivativeOrderTrigger) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventConditionalDerivativeOrderTrigger.DiscardUnknown(m)
+func (m *EventBatchDepositUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBatchDepositUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventConditionalDerivativeOrderTrigger proto.InternalMessageInfo
+var xxx_messageInfo_EventBatchDepositUpdate proto.InternalMessageInfo
 
-func (m *EventConditionalDerivativeOrderTrigger) GetMarketId() []byte {
+func (m *EventBatchDepositUpdate) GetDepositUpdates() []*DepositUpdate {
 	if m != nil {
-		return m.MarketId
+		return m.DepositUpdates
 	}
 	return nil
 }
 
-func (m *EventConditionalDerivativeOrderTrigger) GetIsLimitTrigger() bool {
-	if m != nil {
-		return m.IsLimitTrigger
-	}
-	return false
+type DerivativeMarketOrderCancel struct {
+	MarketOrder    *DerivativeMarketOrder                 `protobuf:"bytes,1,opt,name=market_order,json=marketOrder,proto3" json:"market_order,omitempty"`
+	CancelQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=cancel_quantity,json=cancelQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cancel_quantity"`
 }
 
-func (m *EventConditionalDerivativeOrderTrigger) GetTriggeredOrderHash() []byte {
-	if m != nil {
-		return m.TriggeredOrderHash
+func (m *DerivativeMarketOrderCancel) Reset()         { *m = DerivativeMarketOrderCancel{} }
+func (m *DerivativeMarketOrderCancel) String() string { return proto.CompactTextString(m) }
+func (*DerivativeMarketOrderCancel) ProtoMessage()    {}
+func (*DerivativeMarketOrderCancel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{27}
+}
+func (m *DerivativeMarketOrderCancel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DerivativeMarketOrderCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DerivativeMarketOrderCancel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *DerivativeMarketOrderCancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivativeMarketOrderCancel.Merge(m, src)
+}
+func (m *DerivativeMarketOrderCancel) XXX_Size() int {
+	return m.Size()
+}
+func (m *DerivativeMarketOrderCancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivativeMarketOrderCancel.DiscardUnknown(m)
 }
 
-func (m *EventConditionalDerivativeOrderTrigger) GetPlacedOrderHash() []byte {
+var xxx_messageInfo_DerivativeMarketOrderCancel proto.InternalMessageInfo
+
+func (m *DerivativeMarketOrderCancel) GetMarketOrder() *DerivativeMarketOrder {
 	if m != nil {
-		return m.PlacedOrderHash
+		return m.MarketOrder
 	}
 	return nil
 }
 
-type EventOrderFail struct {
-	Account []byte   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Hashes  [][]byte `protobuf:"bytes,2,rep,name=hashes,proto3" json:"hashes,omitempty"`
-	Flags   []uint32 `protobuf:"varint,3,rep,packed,name=flags,proto3" json:"flags,omitempty"`
+type EventCancelDerivativeOrder struct {
+	MarketId          string                       `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	IsLimitCancel     bool                         `protobuf:"varint,2,opt,name=isLimitCancel,proto3" json:"isLimitCancel,omitempty"`
+	LimitOrder        *DerivativeLimitOrder        `protobuf:"bytes,3,opt,name=limit_order,json=limitOrder,proto3" json:"limit_order,omitempty"`
+	MarketOrderCancel *DerivativeMarketOrderCancel `protobuf:"bytes,4,opt,name=market_order_cancel,json=marketOrderCancel,proto3" json:"market_order_cancel,omitempty"`
 }
 
-func (m *EventOrderFail) Reset()         { *m = EventOrderFail{} }
-func (m *EventOrderFail) String() string { return proto.CompactTextString(m) }
-func (*EventOrderFail) ProtoMessage()    {}
-func (*EventOrderFail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20dda602b6b13fd3, []int{27}
+func (m *EventCancelDerivativeOrder) Reset()         { *m = EventCancelDerivativeOrder{} }
+func (m *EventCancelDerivativeOrder) String() string { return proto.CompactTextString(m) }
+func (*EventCancelDerivativeOrder) ProtoMessage()    {}
+func (*EventCancelDerivativeOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{28}
 }
-func (m *EventOrderFail) XXX_Unmarshal(b []byte) error {
+func (m *EventCancelDerivativeOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventOrderFail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventCancelDerivativeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventOrderFail.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventCancelDerivativeOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1610,59 +1672,62 @@ func (m *EventOrderFail) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *EventOrderFail) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventOrderFail.Merge(m, src)
+func (m *EventCancelDerivativeOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelDerivativeOrder.Merge(m, src)
 }
-func (m *EventOrderFail) XXX_Size() int {
+func (m *EventCancelDerivativeOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventOrderFail) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventOrderFail.DiscardUnknown(m)
+func (m *EventCancelDerivativeOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelDerivativeOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventOrderFail proto.InternalMessageInfo
+var xxx_messageInfo_EventCancelDerivativeOrder proto.InternalMessageInfo
 
-func (m *EventOrderFail) GetAccount() []byte {
+func (m *EventCancelDerivativeOrder) GetMarketId() string {
 	if m != nil {
-		return m.Account
+		return m.MarketId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventOrderFail) GetHashes() [][]byte {
+func (m *EventCancelDerivativeOrder) GetIsLimitCancel() bool {
 	if m != nil {
-		return m.Hashes
+		return m.IsLimitCancel
 	}
-	return nil
+	return false
 }
 
-func (m *EventOrderFail) GetFlags() []uint32 {
+func (m *EventCancelDerivativeOrder) GetLimitOrder() *DerivativeLimitOrder {
 	if m != nil {
-		return m.Flags
+		return m.LimitOrder
 	}
 	return nil
 }
 
-type EventAtomicMarketOrderFeeMultipliersUpdated struct {
-	MarketFeeMultipliers []*MarketFeeMultiplier `protobuf:"bytes,1,rep,name=market_fee_multipliers,json=marketFeeMultipliers,proto3" json:"market_fee_multipliers,omitempty"`
+func (m *EventCancelDerivativeOrder) GetMarketOrderCancel() *DerivativeMarketOrderCancel {
+	if m != nil {
+		return m.MarketOrderCancel
+	}
+	return nil
 }
 
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) Reset() {
-	*m = EventAtomicMarketOrderFeeMultipliersUpdated{}
-}
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) String() string {
-	return proto.CompactTextString(m)
+type EventFeeDiscountSchedule struct {
+	Schedule *FeeDiscountSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 }
-func (*EventAtomicMarketOrderFeeMultipliersUpdated) ProtoMessage() {}
-func (*EventAtomicMarketOrderFeeMultipliersUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20dda602b6b13fd3, []int{28}
+
+func (m *EventFeeDiscountSchedule) Reset()         { *m = EventFeeDiscountSchedule{} }
+func (m *EventFeeDiscountSchedule) String() string { return proto.CompactTextString(m) }
+func (*EventFeeDiscountSchedule) ProtoMessage()    {}
+func (*EventFeeDiscountSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{29}
 }
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventFeeDiscountSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventFeeDiscountSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventAtomicMarketOrderFeeMultipliersUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventFeeDiscountSchedule.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1672,42 +1737,42 @@ func (m *EventAtomicMarketOrderFeeMultipliersUpdated) XXX_Marshal(b []byte, dete
 		return b[:n], nil
 	}
 }
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventAtomicMarketOrderFeeMultipliersUpdated.Merge(m, src)
+func (m *EventFeeDiscountSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeDiscountSchedule.Merge(m, src)
 }
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) XXX_Size() int {
+func (m *EventFeeDiscountSchedule) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventAtomicMarketOrderFeeMultipliersUpdated.DiscardUnknown(m)
+func (m *EventFeeDiscountSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeDiscountSchedule.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventAtomicMarketOrderFeeMultipliersUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventFeeDiscountSchedule proto.InternalMessageInfo
 
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) GetMarketFeeMultipliers() []*MarketFeeMultiplier {
+func (m *EventFeeDiscountSchedule) GetSchedule() *FeeDiscountSchedule {
 	if m != nil {
-		return m.MarketFeeMultipliers
+		return m.Schedule
 	}
 	return nil
 }
 
-type EventOrderbookUpdate struct {
-	SpotUpdates       []*OrderbookUpdate `protobuf:"bytes,1,rep,name=spot_updates,json=spotUpdates,proto3" json:"spot_updates,omitempty"`
-	DerivativeUpdates []*OrderbookUpdate `protobuf:"bytes,2,rep,name=derivative_updates,json=derivativeUpdates,proto3" json:"derivative_updates,omitempty"`
+type EventTradingRewardCampaignUpdate struct {
+	CampaignInfo        *TradingRewardCampaignInfo `protobuf:"bytes,1,opt,name=campaign_info,json=campaignInfo,proto3" json:"campaign_info,omitempty"`
+	CampaignRewardPools []*CampaignRewardPool      `protobuf:"bytes,2,rep,name=campaign_reward_pools,json=campaignRewardPools,proto3" json:"campaign_reward_pools,omitempty"`
 }
 
-func (m *EventOrderbookUpdate) Reset()         { *m = EventOrderbookUpdate{} }
-func (m *EventOrderbookUpdate) String() string { return proto.CompactTextString(m) }
-func (*EventOrderbookUpdate) ProtoMessage()    {}
-func (*EventOrderbookUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20dda602b6b13fd3, []int{29}
+func (m *EventTradingRewardCampaignUpdate) Reset()         { *m = EventTradingRewardCampaignUpdate{} }
+func (m *EventTradingRewardCampaignUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventTradingRewardCampaignUpdate) ProtoMessage()    {}
+func (*EventTradingRewardCampaignUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{30}
 }
-func (m *EventOrderbookUpdate) XXX_Unmarshal(b []byte) error {
+func (m *EventTradingRewardCampaignUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventOrderbookUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventTradingRewardCampaignUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventOrderbookUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventTradingRewardCampaignUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1717,49 +1782,48 @@ func (m *EventOrderbookUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *EventOrderbookUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventOrderbookUpdate.Merge(m, src)
+func (m *EventTradingRewardCampaignUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTradingRewardCampaignUpdate.Merge(m, src)
 }
-func (m *EventOrderbookUpdate) XXX_Size() int {
+func (m *EventTradingRewardCampaignUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventOrderbookUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventOrderbookUpdate.DiscardUnknown(m)
+func (m *EventTradingRewardCampaignUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTradingRewardCampaignUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventOrderbookUpdate proto.InternalMessageInfo
+var xxx_messageInfo_EventTradingRewardCampaignUpdate proto.InternalMessageInfo
 
-func (m *EventOrderbookUpdate) GetSpotUpdates() []*OrderbookUpdate {
+func (m *EventTradingRewardCampaignUpdate) GetCampaignInfo() *TradingRewardCampaignInfo {
 	if m != nil {
-		return m.SpotUpdates
+		return m.CampaignInfo
 	}
 	return nil
 }
 
-func (m *EventOrderbookUpdate) GetDerivativeUpdates() []*OrderbookUpdate {
+func (m *EventTradingRewardCampaignUpdate) GetCampaignRewardPools() []*CampaignRewardPool {
 	if m != nil {
-		return m.DerivativeUpdates
+		return m.CampaignRewardPools
 	}
 	return nil
 }
 
-type OrderbookUpdate struct {
-	Seq       uint64     `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
-	Orderbook *Orderbook `protobuf:"bytes,2,opt,name=orderbook,proto3" json:"orderbook,omitempty"`
+type EventTradingRewardDistribution struct {
+	AccountRewards []*AccountRewards `protobuf:"bytes,1,rep,name=account_rewards,json=accountRewards,proto3" json:"account_rewards,omitempty"`
 }
 
-func (m *OrderbookUpdate) Reset()         { *m = OrderbookUpdate{} }
-func (m *OrderbookUpdate) String() string { return proto.CompactTextString(m) }
-func (*OrderbookUpdate) ProtoMessage()    {}
-func (*OrderbookUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20dda602b6b13fd3, []int{30}
-}
-func (m *OrderbookUpdate) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *EventTradingRewardDistribution) Reset()         { *m = EventTradingRewardDistribution{} }
+func (m *EventTradingRewardDistribution) String() string { return proto.CompactTextString(m) }
+func (*EventTradingRewardDistribution) ProtoMessage()    {}
+func (*EventTradingRewardDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{31}
 }
-func (m *OrderbookUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventTradingRewardDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTradingRewardDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OrderbookUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventTradingRewardDistribution.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1769,50 +1833,44 @@ func (m *OrderbookUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *OrderbookUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OrderbookUpdate.Merge(m, src)
+func (m *EventTradingRewardDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTradingRewardDistribution.Merge(m, src)
 }
-func (m *OrderbookUpdate) XXX_Size() int {
+func (m *EventTradingRewardDistribution) XXX_Size() int {
 	return m.Size()
 }
-func (m *OrderbookUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_OrderbookUpdate.DiscardUnknown(m)
+func (m *EventTradingRewardDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTradingRewardDistribution.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OrderbookUpdate proto.InternalMessageInfo
-
-func (m *OrderbookUpdate) GetSeq() uint64 {
-	if m != nil {
-		return m.Seq
-	}
-	return 0
-}
+var xxx_messageInfo_EventTradingRewardDistribution proto.InternalMessageInfo
 
-func (m *OrderbookUpdate) GetOrderbook() *Orderbook {
+func (m *EventTradingRewardDistribution) GetAccountRewards() []*AccountRewards {
 	if m != nil {
-		return m.Orderbook
+		return m.AccountRewards
 	}
 	return nil
 }
 
-type Orderbook struct {
-	MarketId   []byte   `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	BuyLevels  []*Level `protobuf:"bytes,2,rep,name=buy_levels,json=buyLevels,proto3" json:"buy_levels,omitempty"`
-	SellLevels []*Level `protobuf:"bytes,3,rep,name=sell_levels,json=sellLevels,proto3" json:"sell_levels,omitempty"`
+type EventNewConditionalDerivativeOrder struct {
+	MarketId string           `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Order    *DerivativeOrder `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
+	Hash     []byte           `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	IsMarket bool             `protobuf:"varint,4,opt,name=is_market,json=isMarket,proto3" json:"is_market,omitempty"`
 }
 
-func (m *Orderbook) Reset()         { *m = Orderbook{} }
-func (m *Orderbook) String() string { return proto.CompactTextString(m) }
-func (*Orderbook) ProtoMessage()    {}
-func (*Orderbook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20dda602b6b13fd3, []int{31}
+func (m *EventNewConditionalDerivativeOrder) Reset()         { *m = EventNewConditionalDerivativeOrder{} }
+func (m *EventNewConditionalDerivativeOrder) String() string { return proto.CompactTextString(m) }
+func (*EventNewConditionalDerivativeOrder) ProtoMessage()    {}
+func (*EventNewConditionalDerivativeOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{32}
 }
-func (m *Orderbook) XXX_Unmarshal(b []byte) error {
+func (m *EventNewConditionalDerivativeOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Orderbook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventNewConditionalDerivativeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Orderbook.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventNewConditionalDerivativeOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1822,1075 +1880,1295 @@ func (m *Orderbook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Orderbook) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Orderbook.Merge(m, src)
+func (m *EventNewConditionalDerivativeOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNewConditionalDerivativeOrder.Merge(m, src)
 }
-func (m *Orderbook) XXX_Size() int {
+func (m *EventNewConditionalDerivativeOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *Orderbook) XXX_DiscardUnknown() {
-	xxx_messageInfo_Orderbook.DiscardUnknown(m)
+func (m *EventNewConditionalDerivativeOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNewConditionalDerivativeOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Orderbook proto.InternalMessageInfo
+var xxx_messageInfo_EventNewConditionalDerivativeOrder proto.InternalMessageInfo
 
-func (m *Orderbook) GetMarketId() []byte {
+func (m *EventNewConditionalDerivativeOrder) GetMarketId() string {
 	if m != nil {
 		return m.MarketId
 	}
-	return nil
+	return ""
 }
 
-func (m *Orderbook) GetBuyLevels() []*Level {
+func (m *EventNewConditionalDerivativeOrder) GetOrder() *DerivativeOrder {
 	if m != nil {
-		return m.BuyLevels
+		return m.Order
 	}
 	return nil
 }
 
-func (m *Orderbook) GetSellLevels() []*Level {
+func (m *EventNewConditionalDerivativeOrder) GetHash() []byte {
 	if m != nil {
-		return m.SellLevels
+		return m.Hash
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*EventBatchSpotExecution)(nil), "injective.exchange.v1beta1.EventBatchSpotExecution")
-	proto.RegisterType((*EventBatchDerivativeExecution)(nil), "injective.exchange.v1beta1.EventBatchDerivativeExecution")
-	proto.RegisterType((*EventLostFundsFromLiquidation)(nil), "injective.exchange.v1beta1.EventLostFundsFromLiquidation")
-	proto.RegisterType((*EventBatchDerivativePosition)(nil), "injective.exchange.v1beta1.EventBatchDerivativePosition")
-	proto.RegisterType((*EventDerivativeMarketPaused)(nil), "injective.exchange.v1beta1.EventDerivativeMarketPaused")
-	proto.RegisterType((*EventMarketBeyondBankruptcy)(nil), "injective.exchange.v1beta1.EventMarketBeyondBankruptcy")
-	proto.RegisterType((*EventAllPositionsHaircut)(nil), "injective.exchange.v1beta1.EventAllPositionsHaircut")
-	proto.RegisterType((*EventBinaryOptionsMarketUpdate)(nil), "injective.exchange.v1beta1.EventBinaryOptionsMarketUpdate")
-	proto.RegisterType((*EventNewSpotOrders)(nil), "injective.exchange.v1beta1.EventNewSpotOrders")
-	proto.RegisterType((*EventNewDerivativeOrders)(nil), "injective.exchange.v1beta1.EventNewDerivativeOrders")
-	proto.RegisterType((*EventCancelSpotOrder)(nil), "injective.exchange.v1beta1.EventCancelSpotOrder")
-	proto.RegisterType((*EventSpotMarketUpdate)(nil), "injective.exchange.v1beta1.EventSpotMarketUpdate")
-	proto.RegisterType((*EventPerpetualMarketUpdate)(nil), "injective.exchange.v1beta1.EventPerpetualMarketUpdate")
-	proto.RegisterType((*EventExpiryFuturesMarketUpdate)(nil), "injective.exchange.v1beta1.EventExpiryFuturesMarketUpdate")
-	proto.RegisterType((*EventPerpetualMarketFundingUpdate)(nil), "injective.exchange.v1beta1.EventPerpetualMarketFundingUpdate")
-	proto.RegisterType((*EventSubaccountDeposit)(nil), "injective.exchange.v1beta1.EventSubaccountDeposit")
-	proto.RegisterType((*EventSubaccountWithdraw)(nil), "injective.exchange.v1beta1.EventSubaccountWithdraw")
-	proto.RegisterType((*EventSubaccountBalanceTransfer)(nil), "injective.exchange.v1beta1.EventSubaccountBalanceTransfer")
-	proto.RegisterType((*EventBatchDepositUpdate)(nil), "injective.exchange.v1beta1.EventBatchDepositUpdate")
-	proto.RegisterType((*DerivativeMarketOrderCancel)(nil), "injective.exchange.v1beta1.DerivativeMarketOrderCancel")
-	proto.RegisterType((*EventCancelDerivativeOrder)(nil), "injective.exchange.v1beta1.EventCancelDerivativeOrder")
-	proto.RegisterType((*EventFeeDiscountSchedule)(nil), "injective.exchange.v1beta1.EventFeeDiscountSchedule")
-	proto.RegisterType((*EventTradingRewardCampaignUpdate)(nil), "injective.exchange.v1beta1.EventTradingRewardCampaignUpdate")
-	proto.RegisterType((*EventTradingRewardDistribution)(nil), "injective.exchange.v1beta1.EventTradingRewardDistribution")
-	proto.RegisterType((*EventNewConditionalDerivativeOrder)(nil), "injective.exchange.v1beta1.EventNewConditionalDerivativeOrder")
-	proto.RegisterType((*EventCancelConditionalDerivativeOrder)(nil), "injective.exchange.v1beta1.EventCancelConditionalDerivativeOrder")
-	proto.RegisterType((*EventConditionalDerivativeOrderTrigger)(nil), "injective.exchange.v1beta1.EventConditionalDerivativeOrderTrigger")
-	proto.RegisterType((*EventOrderFail)(nil), "injective.exchange.v1beta1.EventOrderFail")
-	proto.RegisterType((*EventAtomicMarketOrderFeeMultipliersUpdated)(nil), "injective.exchange.v1beta1.EventAtomicMarketOrderFeeMultipliersUpdated")
-	proto.RegisterType((*EventOrderbookUpdate)(nil), "injective.exchange.v1beta1.EventOrderbookUpdate")
-	proto.RegisterType((*OrderbookUpdate)(nil), "injective.exchange.v1beta1.OrderbookUpdate")
-	proto.RegisterType((*Orderbook)(nil), "injective.exchange.v1beta1.Orderbook")
+func (m *EventNewConditionalDerivativeOrder) GetIsMarket() bool {
+	if m != nil {
+		return m.IsMarket
+	}
+	return false
 }
 
-func init() {
-	proto.RegisterFile("injective/exchange/v1beta1/events.proto", fileDescriptor_20dda602b6b13fd3)
+type EventCancelConditionalDerivativeOrder struct {
+	MarketId      string                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	IsLimitCancel bool                   `protobuf:"varint,2,opt,name=isLimitCancel,proto3" json:"isLimitCancel,omitempty"`
+	LimitOrder    *DerivativeLimitOrder  `protobuf:"bytes,3,opt,name=limit_order,json=limitOrder,proto3" json:"limit_order,omitempty"`
+	MarketOrder   *DerivativeMarketOrder `protobuf:"bytes,4,opt,name=market_order,json=marketOrder,proto3" json:"market_order,omitempty"`
 }
 
-var fileDescriptor_20dda602b6b13fd3 = []byte{
-	// 1909 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcb, 0x6f, 0x1c, 0xc7,
-	0xf1, 0xe6, 0xf0, 0x65, 0xb2, 0x76, 0x49, 0x9a, 0x23, 0x4a, 0xa6, 0xa9, 0x9f, 0x29, 0x6a, 0x7e,
-	0x96, 0x2c, 0xc9, 0xf6, 0xae, 0x45, 0x23, 0xf0, 0x25, 0x87, 0x70, 0x49, 0x11, 0x62, 0x4c, 0x49,
-	0x54, 0x53, 0x81, 0x02, 0x01, 0xc6, 0xa0, 0x77, 0xa6, 0xb9, 0xdb, 0xd1, 0xcc, 0xf4, 0x68, 0xba,
-	0x87, 0xd2, 0x22, 0xc7, 0x5c, 0x92, 0x53, 0x72, 0x08, 0x90, 0xdc, 0x72, 0xcc, 0x2d, 0x40, 0x0e,
-	0x39, 0xe5, 0x96, 0x93, 0x83, 0x5c, 0x8c, 0x9c, 0xf2, 0x82, 0x11, 0x50, 0xf9, 0x0b, 0xf2, 0x17,
-	0x04, 0xfd, 0x98, 0xc7, 0x3e, 0x34, 0xda, 0x15, 0x1d, 0xe4, 0xc4, 0x99, 0x9e, 0xea, 0xaf, 0xbe,
-	0xfe, 0xba, 0xba, 0xba, 0x6a, 0x09, 0x1f, 0xd0, 0xe8, 0x07, 0xc4, 0x13, 0xf4, 0x94, 0x34, 0xc9,
-	0x0b, 0xaf, 0x8b, 0xa3, 0x0e, 0x69, 0x9e, 0xde, 0x6e, 0x13, 0x81, 0x6f, 0x37, 0xc9, 0x29, 0x89,
-	0x04, 0x6f, 0xc4, 0x09, 0x13, 0xcc, 0xde, 0xc8, 0x0d, 0x1b, 0x99, 0x61, 0xc3, 0x18, 0x6e, 0xac,
-	0x75, 0x58, 0x87, 0x29, 0xb3, 0xa6, 0x7c, 0xd2, 0x33, 0x36, 0x36, 0x3d, 0xc6, 0x43, 0xc6, 0x9b,
-	0x6d, 0xcc, 0x0b, 0x4c, 0x8f, 0xd1, 0xc8, 0x7c, 0xbf, 0x56, 0xb8, 0x66, 0x09, 0xf6, 0x82, 0xc2,
-	0x48, 0xbf, 0x1a, 0xb3, 0x9b, 0x55, 0x0c, 0x33, 0x26, 0xca, 0xd4, 0xf9, 0x87, 0x05, 0xef, 0xdc,
-	0x91, 0xa4, 0x5b, 0x58, 0x78, 0xdd, 0xe3, 0x98, 0x89, 0x3b, 0x2f, 0x88, 0x97, 0x0a, 0xca, 0x22,
-	0xfb, 0x32, 0x2c, 0x86, 0x38, 0x79, 0x4a, 0x84, 0x4b, 0xfd, 0x75, 0x6b, 0xcb, 0xba, 0xb1, 0x88,
-	0x16, 0xf4, 0xc0, 0x81, 0x6f, 0x5f, 0x84, 0x79, 0xca, 0xdd, 0x76, 0xda, 0x5b, 0x9f, 0xde, 0xb2,
-	0x6e, 0x2c, 0xa0, 0x39, 0xca, 0x5b, 0x69, 0xcf, 0x7e, 0x00, 0x4b, 0x24, 0x03, 0x78, 0xd4, 0x8b,
-	0xc9, 0xfa, 0xcc, 0x96, 0x75, 0x63, 0x79, 0xfb, 0x66, 0xe3, 0xd5, 0x5a, 0x34, 0xee, 0x94, 0x27,
-	0xa0, 0xfe, 0xf9, 0xf6, 0xb7, 0x61, 0x5e, 0x24, 0xd8, 0x27, 0x7c, 0x7d, 0x76, 0x6b, 0xe6, 0x46,
-	0x6d, 0xfb, 0xfd, 0x2a, 0xa4, 0x47, 0xd2, 0xf2, 0x90, 0x75, 0x90, 0x99, 0xe3, 0xfc, 0x7b, 0x1a,
-	0xde, 0x2b, 0x96, 0xb7, 0x47, 0x12, 0x7a, 0x8a, 0xe5, 0xd4, 0xf3, 0x2d, 0xf2, 0x1a, 0x2c, 0x53,
-	0xee, 0x06, 0xf4, 0x59, 0x4a, 0x7d, 0x2c, 0x51, 0xd4, 0x2a, 0x17, 0xd0, 0x12, 0xe5, 0x87, 0xc5,
-	0xa0, 0xfd, 0x05, 0xd8, 0x5e, 0x1a, 0xa6, 0x81, 0xf2, 0xe8, 0x9e, 0xa4, 0x91, 0x4f, 0xa3, 0xce,
-	0xfa, 0xac, 0xf4, 0xd1, 0x6a, 0x7c, 0xf9, 0xf5, 0x15, 0xeb, 0x6f, 0x5f, 0x5f, 0xb9, 0xde, 0xa1,
-	0xa2, 0x9b, 0xb6, 0x1b, 0x1e, 0x0b, 0x9b, 0x66, 0xf3, 0xf5, 0x9f, 0x8f, 0xb9, 0xff, 0xb4, 0x29,
-	0x7a, 0x31, 0xe1, 0x8d, 0x3d, 0xe2, 0xa1, 0xd5, 0x02, 0x69, 0x5f, 0x03, 0x0d, 0x4b, 0x3d, 0x77,
-	0x4e, 0xa9, 0xf7, 0x73, 0xa9, 0xe7, 0x95, 0xd4, 0x8d, 0x2a, 0xa4, 0x42, 0xcb, 0x21, 0xd1, 0xff,
-	0x9a, 0x89, 0x7e, 0xc8, 0xb8, 0x90, 0x6c, 0xf9, 0x7e, 0xc2, 0xc2, 0xb2, 0x32, 0x95, 0xa2, 0xff,
-	0x3f, 0x2c, 0xf1, 0xb4, 0x8d, 0x3d, 0x8f, 0xa5, 0x91, 0x32, 0x90, 0xda, 0xd7, 0x51, 0xbd, 0x18,
-	0x3c, 0xf0, 0xed, 0x1f, 0x59, 0xf0, 0x41, 0xc0, 0xb8, 0x50, 0xb2, 0x72, 0xf7, 0x24, 0x61, 0xa1,
-	0x8b, 0x4f, 0x31, 0x0d, 0x70, 0x3b, 0x20, 0xae, 0x9f, 0x26, 0x34, 0xea, 0xb8, 0x31, 0xee, 0xb1,
-	0x54, 0xa8, 0xcd, 0xd1, 0x8a, 0x4f, 0x4d, 0xa0, 0xb8, 0x13, 0x94, 0xd9, 0xef, 0x64, 0xd8, 0x7b,
-	0x0a, 0xfa, 0x48, 0x21, 0xdb, 0x31, 0xbc, 0x37, 0x48, 0x82, 0x25, 0x3e, 0x49, 0x5c, 0x0f, 0x47,
-	0x1e, 0x09, 0x78, 0x69, 0xb3, 0x27, 0x71, 0xfd, 0x6e, 0x9f, 0xeb, 0x07, 0x12, 0x71, 0x57, 0x03,
-	0x3a, 0x3f, 0xb1, 0xe0, 0xff, 0x46, 0x05, 0xf4, 0x11, 0xe3, 0xf4, 0xf5, 0xd2, 0x1e, 0xc2, 0x62,
-	0x6c, 0x0c, 0xf9, 0xfa, 0xf4, 0xeb, 0x37, 0xf9, 0x38, 0x97, 0x3c, 0xc3, 0x47, 0x05, 0x80, 0xf3,
-	0x7b, 0x0b, 0x2e, 0x2b, 0x2e, 0x05, 0x8d, 0x7b, 0xca, 0xd3, 0x11, 0x4e, 0x39, 0xf1, 0xab, 0xa9,
-	0x5c, 0x85, 0x3a, 0x27, 0x42, 0x04, 0xc4, 0x8d, 0x13, 0xea, 0x11, 0xb5, 0xc9, 0x8b, 0xa8, 0xa6,
-	0xc7, 0x8e, 0xe4, 0x90, 0xdd, 0x80, 0x0b, 0x82, 0x09, 0x1c, 0xb8, 0x21, 0xe5, 0x5c, 0xee, 0xa7,
-	0x92, 0x59, 0x6f, 0x27, 0x5a, 0x55, 0x9f, 0xee, 0xe9, 0x2f, 0x4a, 0x2b, 0xfb, 0x23, 0xb0, 0xfb,
-	0x2c, 0xdd, 0x04, 0x0b, 0xa2, 0xb7, 0x00, 0xbd, 0x1d, 0x96, 0x2c, 0x11, 0x16, 0xc4, 0xf9, 0x69,
-	0xc6, 0x5e, 0x73, 0x6e, 0x91, 0x1e, 0x8b, 0xfc, 0x16, 0x8e, 0x9e, 0x26, 0x69, 0x2c, 0xbc, 0xde,
-	0xb9, 0xd9, 0x7f, 0x02, 0x6b, 0x19, 0x1b, 0x83, 0x53, 0xa6, 0x9f, 0x31, 0xd5, 0xce, 0x15, 0x2b,
-	0xe7, 0xc7, 0x16, 0xac, 0x2b, 0x46, 0x3b, 0x41, 0x90, 0xe9, 0xcd, 0xef, 0x62, 0x9a, 0x78, 0xa9,
-	0x38, 0x37, 0x9d, 0xd1, 0xe2, 0xcc, 0xbc, 0x42, 0x1c, 0x06, 0x9b, 0x3a, 0xca, 0x68, 0x84, 0x93,
-	0xde, 0x83, 0x58, 0x51, 0xd1, 0x5c, 0xbf, 0x17, 0xfb, 0x58, 0x10, 0xfb, 0x1e, 0xcc, 0x6b, 0xf7,
-	0x8a, 0x4c, 0x6d, 0xbb, 0x59, 0x15, 0x47, 0x23, 0x60, 0x5a, 0xb3, 0xf2, 0x50, 0x20, 0x03, 0xe2,
-	0xfc, 0xd1, 0x02, 0x5b, 0x79, 0xbc, 0x4f, 0x9e, 0xcb, 0x5b, 0x48, 0x05, 0x3d, 0xaf, 0x5e, 0xf5,
-	0x01, 0x40, 0x3b, 0xed, 0xe9, 0x13, 0x97, 0x85, 0xf3, 0xad, 0xca, 0x70, 0x8e, 0x99, 0x38, 0xa4,
-	0x21, 0xd5, 0xe8, 0x68, 0xb1, 0x9d, 0xf6, 0x8c, 0x9f, 0xcf, 0xa1, 0xc6, 0x49, 0x10, 0x64, 0x58,
-	0x33, 0x13, 0x63, 0x81, 0x9c, 0xae, 0xc1, 0x9c, 0xbf, 0x67, 0xfb, 0x78, 0x9f, 0x3c, 0x2f, 0x8e,
-	0xc6, 0x38, 0x2b, 0x7a, 0x30, 0x62, 0x45, 0x9f, 0x8c, 0x97, 0x85, 0x47, 0xaf, 0xeb, 0xe1, 0xa8,
-	0x75, 0x4d, 0x8e, 0x58, 0x5e, 0xdd, 0x0f, 0x61, 0x4d, 0x2d, 0x4e, 0x67, 0xa4, 0x7c, 0xaf, 0xaa,
-	0x17, 0xb6, 0x0f, 0x73, 0x8a, 0x82, 0x8a, 0xcc, 0x89, 0x94, 0x35, 0x71, 0xa2, 0xa7, 0x3b, 0x5f,
-	0xc0, 0x45, 0xe5, 0x5c, 0xda, 0xf4, 0x85, 0xe3, 0xde, 0x40, 0x38, 0x5e, 0x7f, 0x9d, 0x87, 0x91,
-	0x51, 0xf8, 0xeb, 0x69, 0xd8, 0x50, 0xf8, 0x47, 0x24, 0x89, 0x89, 0x48, 0x71, 0xd0, 0xe7, 0xe4,
-	0xbb, 0x03, 0x4e, 0x3e, 0x1a, 0x4f, 0xc8, 0x51, 0xae, 0x6c, 0x0a, 0x17, 0xe3, 0xcc, 0x49, 0x96,
-	0x20, 0x68, 0x74, 0xc2, 0x8c, 0x42, 0x95, 0xc7, 0x69, 0x80, 0xdd, 0x41, 0x74, 0xc2, 0x14, 0xba,
-	0x85, 0x2e, 0xc4, 0xc3, 0x9f, 0x6c, 0x04, 0x6f, 0x65, 0xc5, 0xc7, 0x8c, 0x02, 0xdf, 0x9e, 0x00,
-	0xdc, 0x54, 0x1b, 0x06, 0x3f, 0x03, 0x72, 0xfe, 0x65, 0x99, 0x0c, 0x71, 0xe7, 0x45, 0x4c, 0x93,
-	0xde, 0x7e, 0x2a, 0xd2, 0x84, 0xf0, 0xff, 0x9a, 0x5a, 0xa7, 0xb0, 0x41, 0x94, 0x23, 0xf7, 0x44,
-	0x7b, 0xea, 0x93, 0x4c, 0xaf, 0xea, 0xd3, 0xea, 0xc2, 0x67, 0x88, 0x66, 0x49, 0xb6, 0x77, 0xc8,
-	0xe8, 0xcf, 0xce, 0xd9, 0x34, 0x5c, 0x1d, 0x15, 0x10, 0x46, 0x15, 0xb3, 0xd2, 0xca, 0xd0, 0x2f,
-	0xa9, 0x3f, 0x7d, 0x2e, 0xf5, 0xa7, 0x72, 0xf5, 0xed, 0x5b, 0xb0, 0x4a, 0xb9, 0xdb, 0x65, 0x69,
-	0x12, 0xf4, 0xdc, 0xf2, 0xde, 0x2e, 0xa0, 0x15, 0xca, 0xef, 0xaa, 0xf1, 0xac, 0x4c, 0x7c, 0x08,
-	0x75, 0x63, 0x51, 0xba, 0x0f, 0x27, 0xae, 0x3f, 0x6b, 0x06, 0x03, 0xe9, 0xdc, 0x0f, 0x72, 0x79,
-	0xe6, 0xb2, 0x99, 0x7b, 0x23, 0x40, 0xa5, 0x98, 0xba, 0x9a, 0x9c, 0x5f, 0x58, 0x70, 0x49, 0x9f,
-	0xea, 0xbc, 0xdc, 0xd8, 0x23, 0xaa, 0xcc, 0xb0, 0xaf, 0x40, 0x8d, 0x27, 0x9e, 0x8b, 0x7d, 0x3f,
-	0x21, 0x9c, 0x1b, 0x6d, 0x81, 0x27, 0xde, 0x8e, 0x1e, 0x19, 0xaf, 0x58, 0xfc, 0x0c, 0xe6, 0x71,
-	0x28, 0x9f, 0x4d, 0xa4, 0xbc, 0xdb, 0xd0, 0x94, 0x1a, 0xb2, 0xcf, 0xca, 0xa5, 0xdf, 0x65, 0x34,
-	0xca, 0xc2, 0x4e, 0x9b, 0x3b, 0xbf, 0xcc, 0xba, 0xa3, 0x82, 0xd9, 0x63, 0x2a, 0xba, 0x7e, 0x82,
-	0x9f, 0x0f, 0x7b, 0xb6, 0x46, 0x78, 0xbe, 0x02, 0x35, 0x9f, 0x8b, 0x9c, 0xbf, 0xbe, 0x97, 0xc1,
-	0xe7, 0x22, 0xe3, 0xff, 0xc6, 0xd4, 0x7e, 0x9b, 0x1d, 0xc0, 0x82, 0x5a, 0x0b, 0x07, 0x32, 0x27,
-	0x3f, 0x4a, 0x70, 0xc4, 0x4f, 0x48, 0x22, 0xa3, 0x44, 0x8a, 0x37, 0xcc, 0x72, 0x11, 0xad, 0xf0,
-	0xc4, 0x3b, 0x2e, 0x13, 0xbd, 0x05, 0xab, 0x92, 0xe8, 0xb0, 0x96, 0x8b, 0x68, 0xc5, 0xe7, 0xe2,
-	0xf8, 0x1b, 0x91, 0x33, 0x2c, 0xf7, 0x9a, 0x66, 0x8b, 0xcd, 0x11, 0x42, 0xb0, 0xe2, 0xeb, 0x01,
-	0x37, 0x55, 0x23, 0x72, 0xb3, 0xe5, 0x65, 0x75, 0xb3, 0x3a, 0x6b, 0x94, 0x30, 0xd0, 0xb2, 0x5f,
-	0x7e, 0xe5, 0xce, 0x9f, 0x2d, 0xb8, 0x3c, 0x98, 0x57, 0x4a, 0xc5, 0xb4, 0xfd, 0x04, 0xea, 0xe6,
-	0xd8, 0xea, 0xbb, 0x49, 0xa7, 0xa9, 0xdb, 0x93, 0xa4, 0xa9, 0xe2, 0x8a, 0xb2, 0x50, 0x2d, 0x2c,
-	0x86, 0xec, 0xc7, 0xb0, 0xa2, 0x7b, 0x00, 0xf7, 0x59, 0x8a, 0x23, 0x41, 0x85, 0x6e, 0x21, 0x27,
-	0xef, 0x05, 0x96, 0x35, 0xcc, 0x43, 0x83, 0x52, 0x5c, 0x51, 0x7a, 0x11, 0x03, 0xf5, 0x45, 0x75,
-	0x2a, 0x7a, 0x1f, 0x54, 0x87, 0x1a, 0x52, 0x33, 0xd9, 0x74, 0xb5, 0xfd, 0x83, 0xf6, 0x63, 0xa8,
-	0x05, 0xf2, 0xd5, 0xa8, 0xa2, 0xf7, 0x78, 0xe2, 0x9a, 0xc1, 0x88, 0x02, 0x41, 0x3e, 0x62, 0x87,
-	0x70, 0xa1, 0xac, 0xb7, 0x69, 0x92, 0x54, 0x42, 0xaa, 0x6d, 0x7f, 0x36, 0xb1, 0xec, 0x9a, 0xae,
-	0xf1, 0xb3, 0x1a, 0x0e, 0x7e, 0x70, 0x3a, 0xa6, 0x0a, 0xdb, 0x27, 0x64, 0x8f, 0x72, 0x15, 0xbc,
-	0xc7, 0x5e, 0x97, 0xf8, 0x69, 0x40, 0xec, 0xcf, 0x61, 0x81, 0x9b, 0xe7, 0x71, 0xea, 0xd7, 0x11,
-	0x10, 0x28, 0x07, 0x70, 0xce, 0x2c, 0xd8, 0x52, 0x9e, 0x64, 0x27, 0x2c, 0x73, 0x24, 0x79, 0x8e,
-	0x13, 0x7f, 0x17, 0x87, 0x31, 0xa6, 0x9d, 0xc8, 0x04, 0xf8, 0x13, 0x58, 0xf2, 0xcc, 0x88, 0xbe,
-	0xb4, 0xb4, 0xdb, 0x6f, 0xbd, 0xee, 0xe7, 0x8c, 0x21, 0x3c, 0x79, 0x2f, 0xa1, 0xba, 0x57, 0x7a,
-	0xb3, 0xdb, 0x70, 0x31, 0xc7, 0x4e, 0x94, 0xb1, 0x1b, 0x33, 0x16, 0x8c, 0xd5, 0xe2, 0x65, 0xb0,
-	0xda, 0xc9, 0x11, 0x63, 0x01, 0xba, 0xe0, 0x0d, 0x8d, 0x71, 0x27, 0x35, 0xe9, 0xa6, 0x8f, 0xd3,
-	0x1e, 0xe5, 0x22, 0xa1, 0x6d, 0xfd, 0x4b, 0xca, 0x31, 0xac, 0x64, 0xb9, 0x43, 0x93, 0xc8, 0x8e,
-	0x70, 0x65, 0xb5, 0xb7, 0xa3, 0xa7, 0x68, 0x3c, 0x8e, 0x96, 0x71, 0xdf, 0xbb, 0xf3, 0x3b, 0x0b,
-	0x9c, 0xac, 0x96, 0xde, 0x65, 0x91, 0xaf, 0x9a, 0x22, 0x3c, 0x59, 0xd8, 0xef, 0xf4, 0x17, 0x9f,
-	0x1f, 0x8e, 0x17, 0x69, 0xba, 0xf2, 0xd5, 0x33, 0x6d, 0x1b, 0x66, 0xbb, 0x98, 0x77, 0xd5, 0x61,
-	0xa8, 0x23, 0xf5, 0x2c, 0x7d, 0xd2, 0xac, 0x0e, 0x51, 0x41, 0xbc, 0x80, 0x16, 0xa8, 0x29, 0x1e,
-	0x9c, 0x5f, 0x4d, 0xc3, 0xb5, 0xd2, 0x31, 0x7d, 0x53, 0xea, 0xff, 0xe3, 0x13, 0x3b, 0x98, 0x21,
-	0x67, 0xbf, 0xb9, 0x0c, 0xe9, 0xfc, 0xc9, 0x82, 0xeb, 0x5a, 0xa1, 0x57, 0x6a, 0xf3, 0x28, 0xa1,
-	0x9d, 0xce, 0x28, 0x89, 0xea, 0x25, 0x89, 0xae, 0xc3, 0xb2, 0x51, 0xc3, 0x98, 0x1b, 0x8d, 0x06,
-	0x46, 0x65, 0x3f, 0x2e, 0xf4, 0x23, 0xf1, 0x4d, 0x02, 0x2a, 0x6d, 0xa9, 0x9d, 0x7f, 0x53, 0x9e,
-	0xef, 0xca, 0x0d, 0xbe, 0x05, 0xab, 0x71, 0x80, 0xbd, 0x7e, 0xf3, 0x59, 0x65, 0xbe, 0xa2, 0x3f,
-	0xe4, 0xb6, 0xce, 0xf7, 0x61, 0x59, 0x2d, 0x46, 0x8d, 0xec, 0x63, 0x1a, 0xd8, 0xeb, 0xf0, 0x96,
-	0x89, 0x65, 0x43, 0x39, 0x7b, 0xb5, 0x2f, 0xc1, 0xbc, 0x84, 0x22, 0xfa, 0x7c, 0xd6, 0x91, 0x79,
-	0xb3, 0xd7, 0x60, 0xee, 0x24, 0xc0, 0x1d, 0xdd, 0xa6, 0x2d, 0x21, 0xfd, 0xe2, 0xfc, 0xdc, 0x82,
-	0x0f, 0xf5, 0xaf, 0x02, 0x82, 0x85, 0xd4, 0x2b, 0xa9, 0xba, 0x4f, 0xc8, 0xbd, 0x34, 0x10, 0x34,
-	0x0e, 0x28, 0x49, 0xb8, 0xce, 0x33, 0xbe, 0x4d, 0xe0, 0x52, 0xf6, 0x7b, 0x03, 0x21, 0x6e, 0x58,
-	0x18, 0x98, 0xd3, 0x58, 0x99, 0xe8, 0x4c, 0xd5, 0x59, 0x06, 0x46, 0x6b, 0xe1, 0xf0, 0x20, 0x77,
-	0xfe, 0x60, 0x99, 0x3e, 0x50, 0x51, 0x69, 0x33, 0xf6, 0xd4, 0x24, 0xba, 0xfb, 0x50, 0xe7, 0x31,
-	0x1b, 0xbc, 0xc6, 0x2b, 0x0f, 0xdd, 0x00, 0x04, 0xaa, 0x49, 0x00, 0x73, 0x8b, 0xdb, 0x4f, 0xc0,
-	0xf6, 0xf3, 0xb0, 0xc8, 0x51, 0xa7, 0x27, 0x47, 0x5d, 0x2d, 0x60, 0xb2, 0x0a, 0xa1, 0x0b, 0x2b,
-	0x83, 0xf4, 0xdf, 0x86, 0x19, 0x4e, 0x9e, 0xa9, 0x2d, 0x9b, 0x45, 0xf2, 0xd1, 0xde, 0x85, 0x45,
-	0x96, 0x19, 0x99, 0x14, 0x72, 0x6d, 0x2c, 0xbf, 0xa8, 0x98, 0xe7, 0xfc, 0xc6, 0x82, 0xc5, 0xfc,
-	0x43, 0x75, 0x40, 0x7f, 0x47, 0xff, 0x08, 0x10, 0x90, 0x53, 0x92, 0xa7, 0xf0, 0xab, 0x55, 0x0e,
-	0x0f, 0xa5, 0xa5, 0xea, 0xfa, 0xd5, 0x13, 0xb7, 0x5b, 0xa6, 0xeb, 0x37, 0x10, 0x33, 0xe3, 0x42,
-	0xa8, 0x36, 0x5f, 0x63, 0xb4, 0xba, 0x5f, 0x9e, 0x6d, 0x5a, 0x5f, 0x9d, 0x6d, 0x5a, 0xff, 0x3c,
-	0xdb, 0xb4, 0x7e, 0xf6, 0x72, 0x73, 0xea, 0xab, 0x97, 0x9b, 0x53, 0x7f, 0x79, 0xb9, 0x39, 0xf5,
-	0xe4, 0x7e, 0xa9, 0x72, 0x39, 0xc8, 0x20, 0x0f, 0x71, 0x9b, 0x37, 0x73, 0x07, 0x1f, 0x7b, 0x2c,
-	0x21, 0xe5, 0xd7, 0x2e, 0xa6, 0x51, 0x33, 0x64, 0xf2, 0xba, 0xe4, 0xc5, 0xff, 0x24, 0x54, 0x95,
-	0xd3, 0x9e, 0x57, 0xff, 0x89, 0xf8, 0xf4, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xc0, 0xc6,
-	0x87, 0x58, 0x19, 0x00, 0x00,
+func (m *EventCancelConditionalDerivativeOrder) Reset()         { *m = EventCancelConditionalDerivativeOrder{} }
+func (m *EventCancelConditionalDerivativeOrder) String() string { return proto.CompactTextString(m) }
+func (*EventCancelConditionalDerivativeOrder) ProtoMessage()    {}
+func (*EventCancelConditionalDerivativeOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{33}
 }
-
-func (m *EventBatchSpotExecution) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventCancelConditionalDerivativeOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelConditionalDerivativeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelConditionalDerivativeOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventBatchSpotExecution) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventCancelConditionalDerivativeOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelConditionalDerivativeOrder.Merge(m, src)
+}
+func (m *EventCancelConditionalDerivativeOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelConditionalDerivativeOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelConditionalDerivativeOrder.DiscardUnknown(m)
 }
 
-func (m *EventBatchSpotExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Trades) > 0 {
-		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.ExecutionType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ExecutionType))
-		i--
-		dAtA[i] = 0x18
+var xxx_messageInfo_EventCancelConditionalDerivativeOrder proto.InternalMessageInfo
+
+func (m *EventCancelConditionalDerivativeOrder) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
 	}
-	if m.IsBuy {
-		i--
-		if m.IsBuy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *EventCancelConditionalDerivativeOrder) GetIsLimitCancel() bool {
+	if m != nil {
+		return m.IsLimitCancel
 	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *EventCancelConditionalDerivativeOrder) GetLimitOrder() *DerivativeLimitOrder {
+	if m != nil {
+		return m.LimitOrder
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventBatchDerivativeExecution) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventCancelConditionalDerivativeOrder) GetMarketOrder() *DerivativeMarketOrder {
+	if m != nil {
+		return m.MarketOrder
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventBatchDerivativeExecution) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventConditionalDerivativeOrderTrigger struct {
+	MarketId           []byte `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	IsLimitTrigger     bool   `protobuf:"varint,2,opt,name=isLimitTrigger,proto3" json:"isLimitTrigger,omitempty"`
+	TriggeredOrderHash []byte `protobuf:"bytes,3,opt,name=triggered_order_hash,json=triggeredOrderHash,proto3" json:"triggered_order_hash,omitempty"`
+	PlacedOrderHash    []byte `protobuf:"bytes,4,opt,name=placed_order_hash,json=placedOrderHash,proto3" json:"placed_order_hash,omitempty"`
 }
 
-func (m *EventBatchDerivativeExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Trades) > 0 {
-		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if m.ExecutionType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ExecutionType))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.CumulativeFunding != nil {
-		{
-			size := m.CumulativeFunding.Size()
-			i -= size
-			if _, err := m.CumulativeFunding.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *EventConditionalDerivativeOrderTrigger) Reset() {
+	*m = EventConditionalDerivativeOrderTrigger{}
+}
+func (m *EventConditionalDerivativeOrderTrigger) String() string { return proto.CompactTextString(m) }
+func (*EventConditionalDerivativeOrderTrigger) ProtoMessage()    {}
+func (*EventConditionalDerivativeOrderTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{34}
+}
+func (m *EventConditionalDerivativeOrderTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConditionalDerivativeOrderTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConditionalDerivativeOrderTrigger.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if m.IsLiquidation {
-		i--
-		if m.IsLiquidation {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+}
+func (m *EventConditionalDerivativeOrderTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConditionalDerivativeOrderTrigger.Merge(m, src)
+}
+func (m *EventConditionalDerivativeOrderTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConditionalDerivativeOrderTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConditionalDerivativeOrderTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConditionalDerivativeOrderTrigger proto.InternalMessageInfo
+
+func (m *EventConditionalDerivativeOrderTrigger) GetMarketId() []byte {
+	if m != nil {
+		return m.MarketId
 	}
-	if m.IsBuy {
-		i--
-		if m.IsBuy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	return nil
+}
+
+func (m *EventConditionalDerivativeOrderTrigger) GetIsLimitTrigger() bool {
+	if m != nil {
+		return m.IsLimitTrigger
 	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *EventConditionalDerivativeOrderTrigger) GetTriggeredOrderHash() []byte {
+	if m != nil {
+		return m.TriggeredOrderHash
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventLostFundsFromLiquidation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventConditionalDerivativeOrderTrigger) GetPlacedOrderHash() []byte {
+	if m != nil {
+		return m.PlacedOrderHash
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventLostFundsFromLiquidation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventIcebergOrderRefill struct {
+	MarketId           []byte `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	SubaccountId       string `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	ExhaustedOrderHash []byte `protobuf:"bytes,3,opt,name=exhausted_order_hash,json=exhaustedOrderHash,proto3" json:"exhausted_order_hash,omitempty"`
+	PlacedOrderHash    []byte `protobuf:"bytes,4,opt,name=placed_order_hash,json=placedOrderHash,proto3" json:"placed_order_hash,omitempty"`
 }
 
-func (m *EventLostFundsFromLiquidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.LostFundsFromOrderCancels.Size()
-		i -= size
-		if _, err := m.LostFundsFromOrderCancels.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventIcebergOrderRefill) Reset()         { *m = EventIcebergOrderRefill{} }
+func (m *EventIcebergOrderRefill) String() string { return proto.CompactTextString(m) }
+func (*EventIcebergOrderRefill) ProtoMessage()    {}
+func (*EventIcebergOrderRefill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{35}
+}
+func (m *EventIcebergOrderRefill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventIcebergOrderRefill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventIcebergOrderRefill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.LostFundsFromAvailableDuringPayout.Size()
-		i -= size
-		if _, err := m.LostFundsFromAvailableDuringPayout.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+}
+func (m *EventIcebergOrderRefill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventIcebergOrderRefill.Merge(m, src)
+}
+func (m *EventIcebergOrderRefill) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventIcebergOrderRefill) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventIcebergOrderRefill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventIcebergOrderRefill proto.InternalMessageInfo
+
+func (m *EventIcebergOrderRefill) GetMarketId() []byte {
+	if m != nil {
+		return m.MarketId
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.SubaccountId) > 0 {
-		i -= len(m.SubaccountId)
-		copy(dAtA[i:], m.SubaccountId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubaccountId)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *EventIcebergOrderRefill) GetSubaccountId() string {
+	if m != nil {
+		return m.SubaccountId
 	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventIcebergOrderRefill) GetExhaustedOrderHash() []byte {
+	if m != nil {
+		return m.ExhaustedOrderHash
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventBatchDerivativePosition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventIcebergOrderRefill) GetPlacedOrderHash() []byte {
+	if m != nil {
+		return m.PlacedOrderHash
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventBatchDerivativePosition) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventNewTWAPOrder struct {
+	Order TWAPOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
 }
 
-func (m *EventBatchDerivativePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Positions) > 0 {
-		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *EventNewTWAPOrder) Reset()         { *m = EventNewTWAPOrder{} }
+func (m *EventNewTWAPOrder) String() string { return proto.CompactTextString(m) }
+func (*EventNewTWAPOrder) ProtoMessage()    {}
+func (*EventNewTWAPOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{36}
+}
+func (m *EventNewTWAPOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNewTWAPOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNewTWAPOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *EventNewTWAPOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNewTWAPOrder.Merge(m, src)
+}
+func (m *EventNewTWAPOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNewTWAPOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNewTWAPOrder.DiscardUnknown(m)
 }
 
-func (m *EventDerivativeMarketPaused) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventNewTWAPOrder proto.InternalMessageInfo
+
+func (m *EventNewTWAPOrder) GetOrder() TWAPOrder {
+	if m != nil {
+		return m.Order
 	}
-	return dAtA[:n], nil
+	return TWAPOrder{}
 }
 
-func (m *EventDerivativeMarketPaused) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventTWAPOrderSlice struct {
+	MarketId      []byte `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	SubaccountId  string `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	TwapOrderHash []byte `protobuf:"bytes,3,opt,name=twap_order_hash,json=twapOrderHash,proto3" json:"twap_order_hash,omitempty"`
+	// placed_order_hash is empty if the slice could not be placed
+	PlacedOrderHash []byte `protobuf:"bytes,4,opt,name=placed_order_hash,json=placedOrderHash,proto3" json:"placed_order_hash,omitempty"`
+	Slice           uint64 `protobuf:"varint,5,opt,name=slice,proto3" json:"slice,omitempty"`
 }
 
-func (m *EventDerivativeMarketPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MissingFundsRate) > 0 {
-		i -= len(m.MissingFundsRate)
-		copy(dAtA[i:], m.MissingFundsRate)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MissingFundsRate)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TotalMissingFunds) > 0 {
-		i -= len(m.TotalMissingFunds)
-		copy(dAtA[i:], m.TotalMissingFunds)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.TotalMissingFunds)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SettlePrice) > 0 {
-		i -= len(m.SettlePrice)
-		copy(dAtA[i:], m.SettlePrice)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SettlePrice)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventTWAPOrderSlice) Reset()         { *m = EventTWAPOrderSlice{} }
+func (m *EventTWAPOrderSlice) String() string { return proto.CompactTextString(m) }
+func (*EventTWAPOrderSlice) ProtoMessage()    {}
+func (*EventTWAPOrderSlice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{37}
 }
-
-func (m *EventMarketBeyondBankruptcy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventTWAPOrderSlice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTWAPOrderSlice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTWAPOrderSlice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventMarketBeyondBankruptcy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventTWAPOrderSlice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTWAPOrderSlice.Merge(m, src)
+}
+func (m *EventTWAPOrderSlice) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTWAPOrderSlice) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTWAPOrderSlice.DiscardUnknown(m)
 }
 
-func (m *EventMarketBeyondBankruptcy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MissingMarketFunds) > 0 {
-		i -= len(m.MissingMarketFunds)
-		copy(dAtA[i:], m.MissingMarketFunds)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MissingMarketFunds)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SettlePrice) > 0 {
-		i -= len(m.SettlePrice)
-		copy(dAtA[i:], m.SettlePrice)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SettlePrice)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EventTWAPOrderSlice proto.InternalMessageInfo
+
+func (m *EventTWAPOrderSlice) GetMarketId() []byte {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventAllPositionsHaircut) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventTWAPOrderSlice) GetSubaccountId() string {
+	if m != nil {
+		return m.SubaccountId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventAllPositionsHaircut) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventTWAPOrderSlice) GetTwapOrderHash() []byte {
+	if m != nil {
+		return m.TwapOrderHash
+	}
+	return nil
 }
 
-func (m *EventAllPositionsHaircut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MissingFundsRate) > 0 {
-		i -= len(m.MissingFundsRate)
-		copy(dAtA[i:], m.MissingFundsRate)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MissingFundsRate)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SettlePrice) > 0 {
-		i -= len(m.SettlePrice)
-		copy(dAtA[i:], m.SettlePrice)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SettlePrice)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventTWAPOrderSlice) GetPlacedOrderHash() []byte {
+	if m != nil {
+		return m.PlacedOrderHash
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventBinaryOptionsMarketUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventTWAPOrderSlice) GetSlice() uint64 {
+	if m != nil {
+		return m.Slice
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventBinaryOptionsMarketUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventCancelTWAPOrder struct {
+	Order TWAPOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
 }
 
-func (m *EventBinaryOptionsMarketUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventCancelTWAPOrder) Reset()         { *m = EventCancelTWAPOrder{} }
+func (m *EventCancelTWAPOrder) String() string { return proto.CompactTextString(m) }
+func (*EventCancelTWAPOrder) ProtoMessage()    {}
+func (*EventCancelTWAPOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{38}
+}
+func (m *EventCancelTWAPOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelTWAPOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelTWAPOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *EventCancelTWAPOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelTWAPOrder.Merge(m, src)
+}
+func (m *EventCancelTWAPOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelTWAPOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelTWAPOrder.DiscardUnknown(m)
 }
 
-func (m *EventNewSpotOrders) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventCancelTWAPOrder proto.InternalMessageInfo
+
+func (m *EventCancelTWAPOrder) GetOrder() TWAPOrder {
+	if m != nil {
+		return m.Order
 	}
-	return dAtA[:n], nil
+	return TWAPOrder{}
 }
 
-func (m *EventNewSpotOrders) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventTWAPOrderCompleted struct {
+	Order TWAPOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
 }
 
-func (m *EventNewSpotOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SellOrders) > 0 {
-		for iNdEx := len(m.SellOrders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SellOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.BuyOrders) > 0 {
-		for iNdEx := len(m.BuyOrders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BuyOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *EventTWAPOrderCompleted) Reset()         { *m = EventTWAPOrderCompleted{} }
+func (m *EventTWAPOrderCompleted) String() string { return proto.CompactTextString(m) }
+func (*EventTWAPOrderCompleted) ProtoMessage()    {}
+func (*EventTWAPOrderCompleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{39}
+}
+func (m *EventTWAPOrderCompleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTWAPOrderCompleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTWAPOrderCompleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *EventTWAPOrderCompleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTWAPOrderCompleted.Merge(m, src)
+}
+func (m *EventTWAPOrderCompleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTWAPOrderCompleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTWAPOrderCompleted.DiscardUnknown(m)
 }
 
-func (m *EventNewDerivativeOrders) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventTWAPOrderCompleted proto.InternalMessageInfo
+
+func (m *EventTWAPOrderCompleted) GetOrder() TWAPOrder {
+	if m != nil {
+		return m.Order
 	}
-	return dAtA[:n], nil
+	return TWAPOrder{}
 }
 
-func (m *EventNewDerivativeOrders) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventOrderFail struct {
+	Account []byte   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Hashes  [][]byte `protobuf:"bytes,2,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	Flags   []uint32 `protobuf:"varint,3,rep,packed,name=flags,proto3" json:"flags,omitempty"`
 }
 
-func (m *EventNewDerivativeOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SellOrders) > 0 {
-		for iNdEx := len(m.SellOrders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SellOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.BuyOrders) > 0 {
-		for iNdEx := len(m.BuyOrders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BuyOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *EventOrderFail) Reset()         { *m = EventOrderFail{} }
+func (m *EventOrderFail) String() string { return proto.CompactTextString(m) }
+func (*EventOrderFail) ProtoMessage()    {}
+func (*EventOrderFail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{40}
+}
+func (m *EventOrderFail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderFail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderFail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventOrderFail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderFail.Merge(m, src)
+}
+func (m *EventOrderFail) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderFail) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderFail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderFail proto.InternalMessageInfo
+
+func (m *EventOrderFail) GetAccount() []byte {
+	if m != nil {
+		return m.Account
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventCancelSpotOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventOrderFail) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventCancelSpotOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventOrderFail) GetFlags() []uint32 {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
 }
 
-func (m *EventCancelSpotOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+type EventAtomicMarketOrderFeeMultipliersUpdated struct {
+	MarketFeeMultipliers []*MarketFeeMultiplier `protobuf:"bytes,1,rep,name=market_fee_multipliers,json=marketFeeMultipliers,proto3" json:"market_fee_multipliers,omitempty"`
+}
+
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) Reset() {
+	*m = EventAtomicMarketOrderFeeMultipliersUpdated{}
+}
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EventAtomicMarketOrderFeeMultipliersUpdated) ProtoMessage() {}
+func (*EventAtomicMarketOrderFeeMultipliersUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{41}
+}
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAtomicMarketOrderFeeMultipliersUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAtomicMarketOrderFeeMultipliersUpdated.Merge(m, src)
+}
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAtomicMarketOrderFeeMultipliersUpdated.DiscardUnknown(m)
 }
 
-func (m *EventSpotMarketUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventAtomicMarketOrderFeeMultipliersUpdated proto.InternalMessageInfo
+
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) GetMarketFeeMultipliers() []*MarketFeeMultiplier {
+	if m != nil {
+		return m.MarketFeeMultipliers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventSpotMarketUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventTradeRecordRetentionsUpdated struct {
+	MarketTradeRecordRetentions []*MarketTradeRecordRetention `protobuf:"bytes,1,rep,name=market_trade_record_retentions,json=marketTradeRecordRetentions,proto3" json:"market_trade_record_retentions,omitempty"`
 }
 
-func (m *EventSpotMarketUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventTradeRecordRetentionsUpdated) Reset()         { *m = EventTradeRecordRetentionsUpdated{} }
+func (m *EventTradeRecordRetentionsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventTradeRecordRetentionsUpdated) ProtoMessage()    {}
+func (*EventTradeRecordRetentionsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{42}
+}
+func (m *EventTradeRecordRetentionsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTradeRecordRetentionsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTradeRecordRetentionsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *EventTradeRecordRetentionsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTradeRecordRetentionsUpdated.Merge(m, src)
+}
+func (m *EventTradeRecordRetentionsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTradeRecordRetentionsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTradeRecordRetentionsUpdated.DiscardUnknown(m)
 }
 
-func (m *EventPerpetualMarketUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventTradeRecordRetentionsUpdated proto.InternalMessageInfo
+
+func (m *EventTradeRecordRetentionsUpdated) GetMarketTradeRecordRetentions() []*MarketTradeRecordRetention {
+	if m != nil {
+		return m.MarketTradeRecordRetentions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventPerpetualMarketUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventOrderbookUpdate is emitted at the end of the EndBlocker with the price levels changed during the block, one
+// OrderbookUpdate per touched market
+type EventOrderbookUpdate struct {
+	SpotUpdates []*OrderbookUpdate `protobuf:"bytes,1,rep,name=spot_updates,json=spotUpdates,proto3" json:"spot_updates,omitempty"`
+	// the updates of derivative and binary options markets
+	DerivativeUpdates []*OrderbookUpdate `protobuf:"bytes,2,rep,name=derivative_updates,json=derivativeUpdates,proto3" json:"derivative_updates,omitempty"`
 }
 
-func (m *EventPerpetualMarketUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Funding != nil {
-		{
-			size, err := m.Funding.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.PerpetualMarketInfo != nil {
-		{
-			size, err := m.PerpetualMarketInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventOrderbookUpdate) Reset()         { *m = EventOrderbookUpdate{} }
+func (m *EventOrderbookUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventOrderbookUpdate) ProtoMessage()    {}
+func (*EventOrderbookUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{43}
+}
+func (m *EventOrderbookUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderbookUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderbookUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *EventOrderbookUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderbookUpdate.Merge(m, src)
+}
+func (m *EventOrderbookUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderbookUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderbookUpdate.DiscardUnknown(m)
 }
 
-func (m *EventExpiryFuturesMarketUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventOrderbookUpdate proto.InternalMessageInfo
+
+func (m *EventOrderbookUpdate) GetSpotUpdates() []*OrderbookUpdate {
+	if m != nil {
+		return m.SpotUpdates
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventExpiryFuturesMarketUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventOrderbookUpdate) GetDerivativeUpdates() []*OrderbookUpdate {
+	if m != nil {
+		return m.DerivativeUpdates
+	}
+	return nil
 }
 
-func (m *EventExpiryFuturesMarketUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExpiryFuturesMarketInfo != nil {
-		{
-			size, err := m.ExpiryFuturesMarketInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+type OrderbookUpdate struct {
+	// the orderbook sequence of the market after the last change of the block
+	Seq       uint64     `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Orderbook *Orderbook `protobuf:"bytes,2,opt,name=orderbook,proto3" json:"orderbook,omitempty"`
+}
+
+func (m *OrderbookUpdate) Reset()         { *m = OrderbookUpdate{} }
+func (m *OrderbookUpdate) String() string { return proto.CompactTextString(m) }
+func (*OrderbookUpdate) ProtoMessage()    {}
+func (*OrderbookUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{44}
+}
+func (m *OrderbookUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderbookUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderbookUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *OrderbookUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderbookUpdate.Merge(m, src)
+}
+func (m *OrderbookUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderbookUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderbookUpdate.DiscardUnknown(m)
 }
 
-func (m *EventPerpetualMarketFundingUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OrderbookUpdate proto.InternalMessageInfo
+
+func (m *OrderbookUpdate) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventPerpetualMarketFundingUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OrderbookUpdate) GetOrderbook() *Orderbook {
+	if m != nil {
+		return m.Orderbook
+	}
+	return nil
 }
 
-func (m *EventPerpetualMarketFundingUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MarkPrice != nil {
-		{
-			size := m.MarkPrice.Size()
-			i -= size
-			if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.FundingRate != nil {
-		{
-			size := m.FundingRate.Size()
-			i -= size
-			if _, err := m.FundingRate.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.IsHourlyFunding {
-		i--
-		if m.IsHourlyFunding {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size, err := m.Funding.MarshalToSizedBuffer(dAtA[:i])
+type Orderbook struct {
+	MarketId []byte `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// the new aggregate quantity of every changed price level, zero for removed levels
+	BuyLevels  []*Level `protobuf:"bytes,2,rep,name=buy_levels,json=buyLevels,proto3" json:"buy_levels,omitempty"`
+	SellLevels []*Level `protobuf:"bytes,3,rep,name=sell_levels,json=sellLevels,proto3" json:"sell_levels,omitempty"`
+}
+
+func (m *Orderbook) Reset()         { *m = Orderbook{} }
+func (m *Orderbook) String() string { return proto.CompactTextString(m) }
+func (*Orderbook) ProtoMessage()    {}
+func (*Orderbook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{45}
+}
+func (m *Orderbook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Orderbook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Orderbook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *Orderbook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Orderbook.Merge(m, src)
+}
+func (m *Orderbook) XXX_Size() int {
+	return m.Size()
+}
+func (m *Orderbook) XXX_DiscardUnknown() {
+	xxx_messageInfo_Orderbook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Orderbook proto.InternalMessageInfo
+
+func (m *Orderbook) GetMarketId() []byte {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventSubaccountDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Orderbook) GetBuyLevels() []*Level {
+	if m != nil {
+		return m.BuyLevels
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventSubaccountDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Orderbook) GetSellLevels() []*Level {
+	if m != nil {
+		return m.SellLevels
+	}
+	return nil
 }
 
-func (m *EventSubaccountDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+type EventNewRFQRequest struct {
+	Request RFQRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request"`
+}
+
+func (m *EventNewRFQRequest) Reset()         { *m = EventNewRFQRequest{} }
+func (m *EventNewRFQRequest) String() string { return proto.CompactTextString(m) }
+func (*EventNewRFQRequest) ProtoMessage()    {}
+func (*EventNewRFQRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{46}
+}
+func (m *EventNewRFQRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNewRFQRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNewRFQRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.SubaccountId) > 0 {
-		i -= len(m.SubaccountId)
-		copy(dAtA[i:], m.SubaccountId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubaccountId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SrcAddress) > 0 {
-		i -= len(m.SrcAddress)
-		copy(dAtA[i:], m.SrcAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SrcAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventNewRFQRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNewRFQRequest.Merge(m, src)
+}
+func (m *EventNewRFQRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNewRFQRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNewRFQRequest.DiscardUnknown(m)
 }
 
-func (m *EventSubaccountWithdraw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventNewRFQRequest proto.InternalMessageInfo
+
+func (m *EventNewRFQRequest) GetRequest() RFQRequest {
+	if m != nil {
+		return m.Request
 	}
-	return dAtA[:n], nil
+	return RFQRequest{}
 }
 
-func (m *EventSubaccountWithdraw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventCancelRFQRequest struct {
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	MarketId  string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// is_expired is true if the request was removed because it expired
+	IsExpired bool `protobuf:"varint,3,opt,name=is_expired,json=isExpired,proto3" json:"is_expired,omitempty"`
 }
 
-func (m *EventSubaccountWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+func (m *EventCancelRFQRequest) Reset()         { *m = EventCancelRFQRequest{} }
+func (m *EventCancelRFQRequest) String() string { return proto.CompactTextString(m) }
+func (*EventCancelRFQRequest) ProtoMessage()    {}
+func (*EventCancelRFQRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{47}
+}
+func (m *EventCancelRFQRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelRFQRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelRFQRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.DstAddress) > 0 {
-		i -= len(m.DstAddress)
-		copy(dAtA[i:], m.DstAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.DstAddress)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventCancelRFQRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelRFQRequest.Merge(m, src)
+}
+func (m *EventCancelRFQRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelRFQRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelRFQRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelRFQRequest proto.InternalMessageInfo
+
+func (m *EventCancelRFQRequest) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
 	}
-	if len(m.SubaccountId) > 0 {
-		i -= len(m.SubaccountId)
-		copy(dAtA[i:], m.SubaccountId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubaccountId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *EventCancelRFQRequest) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventSubaccountBalanceTransfer) Marshal() (dAtA []byte, err error) {
+func (m *EventCancelRFQRequest) GetIsExpired() bool {
+	if m != nil {
+		return m.IsExpired
+	}
+	return false
+}
+
+type EventRFQQuoteAccepted struct {
+	Request RFQRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request"`
+	Quote   RFQQuote   `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote"`
+}
+
+func (m *EventRFQQuoteAccepted) Reset()         { *m = EventRFQQuoteAccepted{} }
+func (m *EventRFQQuoteAccepted) String() string { return proto.CompactTextString(m) }
+func (*EventRFQQuoteAccepted) ProtoMessage()    {}
+func (*EventRFQQuoteAccepted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{48}
+}
+func (m *EventRFQQuoteAccepted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRFQQuoteAccepted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRFQQuoteAccepted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRFQQuoteAccepted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRFQQuoteAccepted.Merge(m, src)
+}
+func (m *EventRFQQuoteAccepted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRFQQuoteAccepted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRFQQuoteAccepted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRFQQuoteAccepted proto.InternalMessageInfo
+
+func (m *EventRFQQuoteAccepted) GetRequest() RFQRequest {
+	if m != nil {
+		return m.Request
+	}
+	return RFQRequest{}
+}
+
+func (m *EventRFQQuoteAccepted) GetQuote() RFQQuote {
+	if m != nil {
+		return m.Quote
+	}
+	return RFQQuote{}
+}
+
+type EventSignedOrderPlaced struct {
+	// relayer is the address which submitted the order
+	Relayer      string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	SubaccountId string `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	Nonce        uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	OrderHash    []byte `protobuf:"bytes,4,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
+}
+
+func (m *EventSignedOrderPlaced) Reset()         { *m = EventSignedOrderPlaced{} }
+func (m *EventSignedOrderPlaced) String() string { return proto.CompactTextString(m) }
+func (*EventSignedOrderPlaced) ProtoMessage()    {}
+func (*EventSignedOrderPlaced) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{49}
+}
+func (m *EventSignedOrderPlaced) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSignedOrderPlaced) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSignedOrderPlaced.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSignedOrderPlaced) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSignedOrderPlaced.Merge(m, src)
+}
+func (m *EventSignedOrderPlaced) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSignedOrderPlaced) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSignedOrderPlaced.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSignedOrderPlaced proto.InternalMessageInfo
+
+func (m *EventSignedOrderPlaced) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *EventSignedOrderPlaced) GetSubaccountId() string {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return ""
+}
+
+func (m *EventSignedOrderPlaced) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *EventSignedOrderPlaced) GetOrderHash() []byte {
+	if m != nil {
+		return m.OrderHash
+	}
+	return nil
+}
+
+type EventCancelSignedOrders struct {
+	SubaccountId string   `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	Nonces       []uint64 `protobuf:"varint,2,rep,packed,name=nonces,proto3" json:"nonces,omitempty"`
+	MinNonce     uint64   `protobuf:"varint,3,opt,name=min_nonce,json=minNonce,proto3" json:"min_nonce,omitempty"`
+}
+
+func (m *EventCancelSignedOrders) Reset()         { *m = EventCancelSignedOrders{} }
+func (m *EventCancelSignedOrders) String() string { return proto.CompactTextString(m) }
+func (*EventCancelSignedOrders) ProtoMessage()    {}
+func (*EventCancelSignedOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20dda602b6b13fd3, []int{50}
+}
+func (m *EventCancelSignedOrders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelSignedOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelSignedOrders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCancelSignedOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelSignedOrders.Merge(m, src)
+}
+func (m *EventCancelSignedOrders) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelSignedOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelSignedOrders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelSignedOrders proto.InternalMessageInfo
+
+func (m *EventCancelSignedOrders) GetSubaccountId() string {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return ""
+}
+
+func (m *EventCancelSignedOrders) GetNonces() []uint64 {
+	if m != nil {
+		return m.Nonces
+	}
+	return nil
+}
+
+func (m *EventCancelSignedOrders) GetMinNonce() uint64 {
+	if m != nil {
+		return m.MinNonce
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventBatchSpotExecution)(nil), "injective.exchange.v1beta1.EventBatchSpotExecution")
+	proto.RegisterType((*EventBatchDerivativeExecution)(nil), "injective.exchange.v1beta1.EventBatchDerivativeExecution")
+	proto.RegisterType((*EventLostFundsFromLiquidation)(nil), "injective.exchange.v1beta1.EventLostFundsFromLiquidation")
+	proto.RegisterType((*EventBatchDerivativePosition)(nil), "injective.exchange.v1beta1.EventBatchDerivativePosition")
+	proto.RegisterType((*EventDerivativeMarketPaused)(nil), "injective.exchange.v1beta1.EventDerivativeMarketPaused")
+	proto.RegisterType((*EventMarketBeyondBankruptcy)(nil), "injective.exchange.v1beta1.EventMarketBeyondBankruptcy")
+	proto.RegisterType((*EventAllPositionsHaircut)(nil), "injective.exchange.v1beta1.EventAllPositionsHaircut")
+	proto.RegisterType((*EventBinaryOptionsMarketUpdate)(nil), "injective.exchange.v1beta1.EventBinaryOptionsMarketUpdate")
+	proto.RegisterType((*EventVanillaOptionsMarketUpdate)(nil), "injective.exchange.v1beta1.EventVanillaOptionsMarketUpdate")
+	proto.RegisterType((*EventCategoricalMarketUpdate)(nil), "injective.exchange.v1beta1.EventCategoricalMarketUpdate")
+	proto.RegisterType((*EventExpiryFuturesMarketSeriesUpdate)(nil), "injective.exchange.v1beta1.EventExpiryFuturesMarketSeriesUpdate")
+	proto.RegisterType((*EventExpiryFuturesPositionRolled)(nil), "injective.exchange.v1beta1.EventExpiryFuturesPositionRolled")
+	proto.RegisterType((*EventExpiryFuturesPositionNotRolled)(nil), "injective.exchange.v1beta1.EventExpiryFuturesPositionNotRolled")
+	proto.RegisterType((*EventCategoricalOutcomeSets)(nil), "injective.exchange.v1beta1.EventCategoricalOutcomeSets")
+	proto.RegisterType((*EventMultiLegOrder)(nil), "injective.exchange.v1beta1.EventMultiLegOrder")
+	proto.RegisterType((*EventNewSpotOrders)(nil), "injective.exchange.v1beta1.EventNewSpotOrders")
+	proto.RegisterType((*EventNewDerivativeOrders)(nil), "injective.exchange.v1beta1.EventNewDerivativeOrders")
+	proto.RegisterType((*EventCancelSpotOrder)(nil), "injective.exchange.v1beta1.EventCancelSpotOrder")
+	proto.RegisterType((*EventSpotMarketUpdate)(nil), "injective.exchange.v1beta1.EventSpotMarketUpdate")
+	proto.RegisterType((*EventPerpetualMarketUpdate)(nil), "injective.exchange.v1beta1.EventPerpetualMarketUpdate")
+	proto.RegisterType((*EventExpiryFuturesMarketUpdate)(nil), "injective.exchange.v1beta1.EventExpiryFuturesMarketUpdate")
+	proto.RegisterType((*EventPerpetualMarketFundingUpdate)(nil), "injective.exchange.v1beta1.EventPerpetualMarketFundingUpdate")
+	proto.RegisterType((*EventPreLaunchPerpetualMarketInfoUpdate)(nil), "injective.exchange.v1beta1.EventPreLaunchPerpetualMarketInfoUpdate")
+	proto.RegisterType((*EventSubaccountDeposit)(nil), "injective.exchange.v1beta1.EventSubaccountDeposit")
+	proto.RegisterType((*EventSubaccountWithdraw)(nil), "injective.exchange.v1beta1.EventSubaccountWithdraw")
+	proto.RegisterType((*EventSubaccountBalanceTransfer)(nil), "injective.exchange.v1beta1.EventSubaccountBalanceTransfer")
+	proto.RegisterType((*EventBatchDepositUpdate)(nil), "injective.exchange.v1beta1.EventBatchDepositUpdate")
+	proto.RegisterType((*DerivativeMarketOrderCancel)(nil), "injective.exchange.v1beta1.DerivativeMarketOrderCancel")
+	proto.RegisterType((*EventCancelDerivativeOrder)(nil), "injective.exchange.v1beta1.EventCancelDerivativeOrder")
+	proto.RegisterType((*EventFeeDiscountSchedule)(nil), "injective.exchange.v1beta1.EventFeeDiscountSchedule")
+	proto.RegisterType((*EventTradingRewardCampaignUpdate)(nil), "injective.exchange.v1beta1.EventTradingRewardCampaignUpdate")
+	proto.RegisterType((*EventTradingRewardDistribution)(nil), "injective.exchange.v1beta1.EventTradingRewardDistribution")
+	proto.RegisterType((*EventNewConditionalDerivativeOrder)(nil), "injective.exchange.v1beta1.EventNewConditionalDerivativeOrder")
+	proto.RegisterType((*EventCancelConditionalDerivativeOrder)(nil), "injective.exchange.v1beta1.EventCancelConditionalDerivativeOrder")
+	proto.RegisterType((*EventConditionalDerivativeOrderTrigger)(nil), "injective.exchange.v1beta1.EventConditionalDerivativeOrderTrigger")
+	proto.RegisterType((*EventIcebergOrderRefill)(nil), "injective.exchange.v1beta1.EventIcebergOrderRefill")
+	proto.RegisterType((*EventNewTWAPOrder)(nil), "injective.exchange.v1beta1.EventNewTWAPOrder")
+	proto.RegisterType((*EventTWAPOrderSlice)(nil), "injective.exchange.v1beta1.EventTWAPOrderSlice")
+	proto.RegisterType((*EventCancelTWAPOrder)(nil), "injective.exchange.v1beta1.EventCancelTWAPOrder")
+	proto.RegisterType((*EventTWAPOrderCompleted)(nil), "injective.exchange.v1beta1.EventTWAPOrderCompleted")
+	proto.RegisterType((*EventOrderFail)(nil), "injective.exchange.v1beta1.EventOrderFail")
+	proto.RegisterType((*EventAtomicMarketOrderFeeMultipliersUpdated)(nil), "injective.exchange.v1beta1.EventAtomicMarketOrderFeeMultipliersUpdated")
+	proto.RegisterType((*EventTradeRecordRetentionsUpdated)(nil), "injective.exchange.v1beta1.EventTradeRecordRetentionsUpdated")
+	proto.RegisterType((*EventOrderbookUpdate)(nil), "injective.exchange.v1beta1.EventOrderbookUpdate")
+	proto.RegisterType((*OrderbookUpdate)(nil), "injective.exchange.v1beta1.OrderbookUpdate")
+	proto.RegisterType((*Orderbook)(nil), "injective.exchange.v1beta1.Orderbook")
+	proto.RegisterType((*EventNewRFQRequest)(nil), "injective.exchange.v1beta1.EventNewRFQRequest")
+	proto.RegisterType((*EventCancelRFQRequest)(nil), "injective.exchange.v1beta1.EventCancelRFQRequest")
+	proto.RegisterType((*EventRFQQuoteAccepted)(nil), "injective.exchange.v1beta1.EventRFQQuoteAccepted")
+	proto.RegisterType((*EventSignedOrderPlaced)(nil), "injective.exchange.v1beta1.EventSignedOrderPlaced")
+	proto.RegisterType((*EventCancelSignedOrders)(nil), "injective.exchange.v1beta1.EventCancelSignedOrders")
+}
+
+func init() {
+	proto.RegisterFile("injective/exchange/v1beta1/events.proto", fileDescriptor_20dda602b6b13fd3)
+}
+
+var fileDescriptor_20dda602b6b13fd3 = []byte{
+	// 2619 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0x8f, 0xc7, 0x8e, 0xe7, 0x8d, 0x63, 0xaf, 0x3b, 0x4e, 0x32, 0x9b, 0x10, 0x27, 0xe9,
+	0x4d, 0xb2, 0xd9, 0xaf, 0x71, 0x36, 0xab, 0x65, 0x11, 0xe2, 0xb0, 0x76, 0x1c, 0x6b, 0xb3, 0xeb,
+	0x24, 0x4e, 0x39, 0x6c, 0x20, 0xda, 0x55, 0xab, 0xa6, 0xbb, 0x3c, 0x53, 0xa4, 0xbb, 0xab, 0xd3,
+	0x55, 0xed, 0x64, 0xb4, 0xe2, 0xc4, 0x05, 0x24, 0x24, 0x38, 0xac, 0x04, 0xe2, 0x02, 0x9c, 0xb8,
+	0x21, 0x71, 0xe0, 0x80, 0x38, 0x81, 0x38, 0x2c, 0xe2, 0xb2, 0xe2, 0xc4, 0x97, 0x56, 0x28, 0xe1,
+	0x2f, 0xe0, 0x2f, 0x40, 0xf5, 0xd1, 0x1f, 0xf3, 0xe1, 0xf1, 0x8c, 0x1d, 0xc4, 0x81, 0x93, 0xa7,
+	0xaa, 0x5e, 0xfd, 0xde, 0xab, 0x5f, 0x55, 0xbd, 0xf7, 0xea, 0xb5, 0xe1, 0x65, 0x1a, 0x7d, 0x8b,
+	0x78, 0x82, 0xee, 0x92, 0x15, 0xf2, 0xc4, 0xeb, 0xe0, 0xa8, 0x4d, 0x56, 0x76, 0xdf, 0x6c, 0x11,
+	0x81, 0xdf, 0x5c, 0x21, 0xbb, 0x24, 0x12, 0xbc, 0x19, 0x27, 0x4c, 0x30, 0xfb, 0x74, 0x2e, 0xd8,
+	0xcc, 0x04, 0x9b, 0x46, 0xf0, 0xf4, 0x52, 0x9b, 0xb5, 0x99, 0x12, 0x5b, 0x91, 0xbf, 0xf4, 0x8c,
+	0xd3, 0xcb, 0x1e, 0xe3, 0x21, 0xe3, 0x2b, 0x2d, 0xcc, 0x0b, 0x4c, 0x8f, 0xd1, 0xc8, 0x8c, 0x5f,
+	0x2a, 0x54, 0xb3, 0x04, 0x7b, 0x41, 0x21, 0xa4, 0x9b, 0x46, 0xec, 0x95, 0x51, 0x16, 0x66, 0x96,
+	0x28, 0x51, 0xe7, 0x1f, 0x16, 0x9c, 0xba, 0x21, 0x8d, 0x5e, 0xc3, 0xc2, 0xeb, 0x6c, 0xc7, 0x4c,
+	0xdc, 0x78, 0x42, 0xbc, 0x54, 0x50, 0x16, 0xd9, 0x67, 0xa0, 0x16, 0xe2, 0xe4, 0x21, 0x11, 0x2e,
+	0xf5, 0x1b, 0xd6, 0x79, 0xeb, 0x4a, 0x0d, 0xcd, 0xea, 0x8e, 0x9b, 0xbe, 0x7d, 0x02, 0x66, 0x28,
+	0x77, 0x5b, 0x69, 0xb7, 0x51, 0x39, 0x6f, 0x5d, 0x99, 0x45, 0xd3, 0x94, 0xaf, 0xa5, 0x5d, 0xfb,
+	0x0e, 0x1c, 0x23, 0x19, 0xc0, 0xbd, 0x6e, 0x4c, 0x1a, 0x53, 0xe7, 0xad, 0x2b, 0xf3, 0xd7, 0x5e,
+	0x69, 0xee, 0xcd, 0x45, 0xf3, 0x46, 0x79, 0x02, 0xea, 0x9d, 0x6f, 0x7f, 0x0d, 0x66, 0x44, 0x82,
+	0x7d, 0xc2, 0x1b, 0xd5, 0xf3, 0x53, 0x57, 0xea, 0xd7, 0x2e, 0x8e, 0x42, 0xba, 0x27, 0x25, 0x37,
+	0x59, 0x1b, 0x99, 0x39, 0xce, 0xbf, 0x2b, 0x70, 0xb6, 0x58, 0xde, 0x3a, 0x49, 0xe8, 0x2e, 0x96,
+	0x53, 0x0f, 0xb7, 0xc8, 0x4b, 0x30, 0x4f, 0xb9, 0x1b, 0xd0, 0x47, 0x29, 0xf5, 0xb1, 0x44, 0x51,
+	0xab, 0x9c, 0x45, 0xc7, 0x28, 0xdf, 0x2c, 0x3a, 0xed, 0x8f, 0xc1, 0xf6, 0xd2, 0x30, 0x0d, 0x94,
+	0x46, 0x77, 0x27, 0x8d, 0x7c, 0x1a, 0xb5, 0x1b, 0x55, 0xa9, 0x63, 0xad, 0xf9, 0xd9, 0x17, 0xe7,
+	0xac, 0xbf, 0x7d, 0x71, 0xee, 0x72, 0x9b, 0x8a, 0x4e, 0xda, 0x6a, 0x7a, 0x2c, 0x5c, 0x31, 0x9b,
+	0xaf, 0xff, 0xbc, 0xc1, 0xfd, 0x87, 0x2b, 0xa2, 0x1b, 0x13, 0xde, 0x5c, 0x27, 0x1e, 0x5a, 0x2c,
+	0x90, 0x36, 0x34, 0xd0, 0x20, 0xd5, 0xd3, 0x87, 0xa4, 0x7a, 0x23, 0xa7, 0x7a, 0x46, 0x51, 0xdd,
+	0x1c, 0x85, 0x54, 0x70, 0x39, 0x40, 0xfa, 0x5f, 0x33, 0xd2, 0x37, 0x19, 0x17, 0xd2, 0x5a, 0xbe,
+	0x91, 0xb0, 0xb0, 0xcc, 0xcc, 0x48, 0xd2, 0x5f, 0x82, 0x63, 0x3c, 0x6d, 0x61, 0xcf, 0x63, 0x69,
+	0xa4, 0x04, 0x24, 0xf7, 0x73, 0x68, 0xae, 0xe8, 0xbc, 0xe9, 0xdb, 0xdf, 0xb1, 0xe0, 0xe5, 0x80,
+	0x71, 0xa1, 0x68, 0xe5, 0xee, 0x4e, 0xc2, 0x42, 0x17, 0xef, 0x62, 0x1a, 0xe0, 0x56, 0x40, 0x5c,
+	0x3f, 0x4d, 0x68, 0xd4, 0x76, 0x63, 0xdc, 0x65, 0xa9, 0x68, 0x4c, 0xe5, 0x8c, 0x1f, 0x99, 0x80,
+	0x71, 0x27, 0x28, 0x5b, 0xbf, 0x9a, 0x61, 0xaf, 0x2b, 0xe8, 0x2d, 0x85, 0x6c, 0xc7, 0x70, 0xb6,
+	0xdf, 0x08, 0x96, 0xf8, 0x24, 0x71, 0x3d, 0x1c, 0x79, 0x24, 0xe0, 0x8d, 0xea, 0x81, 0x54, 0xbf,
+	0xd8, 0xa3, 0xfa, 0x8e, 0x44, 0xbc, 0xae, 0x01, 0x9d, 0xef, 0x59, 0xf0, 0xa5, 0x61, 0x07, 0x7a,
+	0x8b, 0x71, 0xba, 0x3f, 0xb5, 0x9b, 0x50, 0x8b, 0x8d, 0x20, 0x6f, 0x54, 0xf6, 0xdf, 0xe4, 0xed,
+	0x9c, 0xf2, 0x0c, 0x1f, 0x15, 0x00, 0xce, 0x6f, 0x2d, 0x38, 0xa3, 0x6c, 0x29, 0xcc, 0xb8, 0xa5,
+	0x34, 0x6d, 0xe1, 0x94, 0x13, 0x7f, 0xb4, 0x29, 0x17, 0x60, 0x8e, 0x13, 0x21, 0x02, 0xe2, 0xc6,
+	0x09, 0xf5, 0x88, 0xda, 0xe4, 0x1a, 0xaa, 0xeb, 0xbe, 0x2d, 0xd9, 0x65, 0x37, 0xe1, 0xb8, 0x60,
+	0x02, 0x07, 0x6e, 0x48, 0x39, 0x97, 0xfb, 0xa9, 0x68, 0xd6, 0xdb, 0x89, 0x16, 0xd5, 0xd0, 0x2d,
+	0x3d, 0xa2, 0xb8, 0xb2, 0x5f, 0x07, 0xbb, 0x47, 0xd2, 0x4d, 0xb0, 0x20, 0x7a, 0x0b, 0xd0, 0x0b,
+	0x61, 0x49, 0x12, 0x61, 0x41, 0x9c, 0x1f, 0x64, 0xd6, 0x6b, 0x9b, 0xd7, 0x48, 0x97, 0x45, 0xfe,
+	0x1a, 0x8e, 0x1e, 0x26, 0x69, 0x2c, 0xbc, 0xee, 0xa1, 0xad, 0xbf, 0x0a, 0x4b, 0x99, 0x35, 0x06,
+	0xa7, 0x6c, 0x7e, 0x66, 0xa9, 0x56, 0xae, 0xac, 0x72, 0xbe, 0x6b, 0x41, 0x43, 0x59, 0xb4, 0x1a,
+	0x04, 0x19, 0xdf, 0xfc, 0x3d, 0x4c, 0x13, 0x2f, 0x15, 0x87, 0x36, 0x67, 0x38, 0x39, 0x53, 0x7b,
+	0x90, 0xc3, 0x60, 0x59, 0x9f, 0x32, 0x1a, 0xe1, 0xa4, 0x7b, 0x27, 0x56, 0xa6, 0x68, 0x5b, 0xbf,
+	0x1e, 0xfb, 0x58, 0x10, 0xfb, 0x16, 0xcc, 0x68, 0xf5, 0xca, 0x98, 0xfa, 0xb5, 0x95, 0x51, 0xe7,
+	0x68, 0x08, 0xcc, 0x5a, 0x55, 0x5e, 0x0a, 0x64, 0x40, 0x9c, 0x47, 0x70, 0x4e, 0x29, 0xfc, 0x10,
+	0x47, 0x34, 0x08, 0xf0, 0x30, 0x8d, 0xb7, 0xfb, 0x34, 0x5e, 0x1d, 0xa5, 0x71, 0x18, 0x4e, 0x9f,
+	0xca, 0x87, 0xe6, 0x26, 0x5d, 0xc7, 0x82, 0xb4, 0x59, 0x42, 0x3d, 0x1c, 0xf4, 0xe8, 0xfb, 0xa0,
+	0x4f, 0xdf, 0x1b, 0xa3, 0xf4, 0x0d, 0x80, 0xf4, 0x29, 0xfb, 0x04, 0x2e, 0x2a, 0x65, 0x37, 0x9e,
+	0xc4, 0x34, 0xe9, 0x6e, 0xa4, 0x22, 0x4d, 0x88, 0x31, 0x6b, 0x9b, 0x24, 0x94, 0x70, 0xa3, 0x74,
+	0x1b, 0x66, 0xb8, 0x6a, 0x1b, 0xa5, 0x6f, 0x8f, 0xf6, 0xe6, 0x7b, 0x80, 0x65, 0xca, 0x35, 0x94,
+	0xf3, 0x93, 0x29, 0x38, 0x3f, 0xa8, 0x3d, 0xbf, 0xd2, 0x2c, 0x08, 0xf4, 0x6d, 0xd5, 0xe2, 0xa5,
+	0x03, 0xa6, 0x3b, 0xf6, 0xf2, 0xc9, 0xb5, 0x3e, 0x9f, 0xdc, 0x73, 0x44, 0xa7, 0xfa, 0x8e, 0xe8,
+	0x45, 0x98, 0x8f, 0xc8, 0x13, 0xe1, 0x16, 0x12, 0xfa, 0x62, 0xce, 0xc9, 0xde, 0x5b, 0x99, 0xd4,
+	0x29, 0x38, 0x2a, 0x23, 0x2b, 0x8b, 0xda, 0x2a, 0x9a, 0xcd, 0xa2, 0x19, 0xca, 0x37, 0x59, 0xd4,
+	0xb6, 0xdf, 0x87, 0xd9, 0x47, 0x29, 0x8e, 0x04, 0x15, 0xdd, 0xc6, 0xcc, 0x81, 0x9c, 0x6a, 0x3e,
+	0xdf, 0x5e, 0x87, 0x69, 0x7d, 0x4d, 0x8e, 0x1e, 0x08, 0x48, 0x4f, 0x96, 0xd1, 0x32, 0xc4, 0x49,
+	0x9b, 0x46, 0x8d, 0xd9, 0x03, 0xc1, 0x98, 0xd9, 0xce, 0xb3, 0x0a, 0xbc, 0xb4, 0xf7, 0xe6, 0xdc,
+	0x66, 0xe2, 0xff, 0x6e, 0x7f, 0x10, 0xcc, 0x24, 0x04, 0x73, 0x16, 0xa9, 0x0d, 0x9a, 0xbf, 0xf6,
+	0xd5, 0xb1, 0xef, 0x80, 0xa4, 0x6a, 0x03, 0xd3, 0x20, 0x4d, 0x08, 0x52, 0x08, 0xc8, 0x20, 0x39,
+	0x7f, 0xc8, 0xbc, 0x7d, 0xe9, 0xa2, 0xde, 0x49, 0x85, 0xc7, 0x42, 0xb2, 0x4d, 0x04, 0x3f, 0x40,
+	0x46, 0xd2, 0xcf, 0x6e, 0x99, 0x81, 0xa9, 0x43, 0x32, 0xa0, 0x69, 0x0e, 0x69, 0x24, 0x1a, 0xd5,
+	0x8c, 0xe6, 0x5b, 0x34, 0x12, 0xce, 0xa7, 0x15, 0xb0, 0x75, 0xd0, 0x4a, 0x03, 0x41, 0x37, 0x49,
+	0x5b, 0x25, 0x07, 0x83, 0x06, 0x5a, 0x43, 0x0c, 0x3c, 0x0b, 0x90, 0x2f, 0x51, 0x47, 0xff, 0x1a,
+	0xaa, 0x65, 0x6b, 0xe4, 0x32, 0x86, 0xe8, 0xdc, 0xa5, 0x83, 0x79, 0x87, 0xc8, 0x38, 0x25, 0x05,
+	0xea, 0xaa, 0xef, 0x3d, 0xd5, 0xd5, 0xb3, 0xc4, 0xea, 0x21, 0x97, 0xf8, 0x01, 0xd4, 0x22, 0x22,
+	0x4c, 0xbc, 0x9a, 0x3e, 0x18, 0x58, 0x44, 0x84, 0x0a, 0x6e, 0xce, 0x1f, 0x2d, 0x43, 0xcb, 0x6d,
+	0xf2, 0x58, 0xbe, 0x61, 0x14, 0x2b, 0xfb, 0x6c, 0xea, 0x4d, 0x80, 0x56, 0xda, 0xd5, 0xf9, 0x5a,
+	0x96, 0x0c, 0xbd, 0x3a, 0x32, 0x19, 0x8a, 0x99, 0xd8, 0xa4, 0x21, 0xd5, 0xe8, 0xa8, 0xd6, 0x4a,
+	0xbb, 0x46, 0xcf, 0x07, 0x50, 0xe7, 0x24, 0x08, 0x32, 0xac, 0xa9, 0x89, 0xb1, 0x40, 0x4e, 0xd7,
+	0x60, 0xce, 0xdf, 0xb3, 0x2c, 0xe0, 0x36, 0x79, 0x5c, 0x24, 0x56, 0xe3, 0xac, 0xe8, 0xce, 0x90,
+	0x15, 0x5d, 0x1d, 0x2f, 0x87, 0x1f, 0xbe, 0xae, 0xbb, 0xc3, 0xd6, 0x35, 0x39, 0x62, 0x79, 0x75,
+	0x9f, 0xc0, 0x92, 0xb9, 0x86, 0x32, 0x9f, 0xcd, 0xf7, 0x6a, 0xf4, 0xc2, 0x36, 0x60, 0x5a, 0x99,
+	0xa0, 0xee, 0xdd, 0x44, 0xcc, 0x9a, 0x40, 0xa8, 0xa7, 0x3b, 0x1f, 0xc3, 0x09, 0xa5, 0x5c, 0xca,
+	0xf4, 0x84, 0xfa, 0xf5, 0xbe, 0x50, 0x7f, 0x79, 0x3f, 0x0d, 0x43, 0x63, 0xfc, 0x2f, 0x2a, 0x70,
+	0x5a, 0xe1, 0x6f, 0x91, 0x24, 0x26, 0x22, 0xed, 0xcb, 0x27, 0xde, 0xef, 0x53, 0xf2, 0xfa, 0x78,
+	0x44, 0x0e, 0x53, 0x65, 0x53, 0x38, 0x11, 0x67, 0x4a, 0x72, 0x97, 0x1d, 0xed, 0xb0, 0x46, 0x65,
+	0xff, 0x64, 0xac, 0xcf, 0xba, 0x9b, 0xd1, 0x0e, 0x53, 0xe8, 0x16, 0x3a, 0x1e, 0x0f, 0x0e, 0xd9,
+	0x08, 0x8e, 0x66, 0x4f, 0xd7, 0x29, 0x05, 0x7e, 0x6d, 0x02, 0x70, 0xf3, 0x56, 0x35, 0xf8, 0x19,
+	0x90, 0xf3, 0x2f, 0xcb, 0xe4, 0x97, 0x43, 0x32, 0x98, 0xff, 0x02, 0x5b, 0xbb, 0x70, 0x9a, 0x28,
+	0x45, 0xee, 0x8e, 0xd6, 0xd4, 0x43, 0x99, 0x5e, 0xd5, 0x5b, 0x13, 0x26, 0x5a, 0x25, 0xda, 0x4e,
+	0x91, 0xe1, 0xc3, 0xce, 0xd3, 0x0a, 0x5c, 0x18, 0x76, 0x20, 0x0c, 0x2b, 0x66, 0xa5, 0x23, 0x8f,
+	0x7e, 0x89, 0xfd, 0xca, 0xa1, 0xd8, 0x3f, 0x92, 0xb3, 0x6f, 0xbf, 0x0a, 0x8b, 0x94, 0xbb, 0x1d,
+	0x96, 0x26, 0x41, 0xd7, 0x2d, 0xef, 0xed, 0x2c, 0x5a, 0xa0, 0xfc, 0x3d, 0xd5, 0x6f, 0xa6, 0xda,
+	0x77, 0x61, 0xce, 0x48, 0x94, 0x5e, 0x53, 0x13, 0x57, 0x2f, 0xea, 0x06, 0x03, 0xe9, 0x97, 0x83,
+	0x8a, 0x43, 0x03, 0xae, 0x7f, 0x12, 0x40, 0xc5, 0x98, 0xf6, 0xfd, 0xdf, 0x86, 0x97, 0x35, 0xc7,
+	0x09, 0xd9, 0xc4, 0x69, 0xe4, 0x75, 0x86, 0x9c, 0x6f, 0xc3, 0x34, 0x82, 0xaa, 0xda, 0x71, 0x7d,
+	0xa2, 0xbe, 0x32, 0x92, 0xc9, 0x11, 0x68, 0x86, 0x4f, 0x85, 0xe5, 0xfc, 0xc8, 0x82, 0x93, 0xda,
+	0xa9, 0xe4, 0xb1, 0x76, 0x9d, 0xa8, 0x37, 0xb2, 0x7d, 0x0e, 0xea, 0x3c, 0xf1, 0x5c, 0xec, 0xfb,
+	0x09, 0xe1, 0xdc, 0x6c, 0x2d, 0xf0, 0xc4, 0x5b, 0xd5, 0x3d, 0xe3, 0x55, 0x3a, 0xde, 0x81, 0x19,
+	0x1c, 0xca, 0xdf, 0xe6, 0xa0, 0xbe, 0xd8, 0xd4, 0x8c, 0x34, 0x5b, 0x98, 0x97, 0xde, 0x1f, 0x8c,
+	0x46, 0xd9, 0xa9, 0xd7, 0xe2, 0xce, 0x8f, 0xb3, 0xd2, 0x5e, 0x61, 0xd9, 0x7d, 0x2a, 0x3a, 0x7e,
+	0x82, 0x1f, 0x0f, 0x4f, 0x18, 0xfa, 0x35, 0x9f, 0x83, 0xba, 0xcf, 0x45, 0x6e, 0xbf, 0x4e, 0x7a,
+	0xc0, 0xe7, 0x22, 0xb3, 0xff, 0xc0, 0xa6, 0xfd, 0x2a, 0xbb, 0xff, 0x85, 0x69, 0x6b, 0x38, 0x90,
+	0x21, 0xe1, 0x5e, 0x82, 0x23, 0xbe, 0x43, 0x12, 0x79, 0x48, 0x25, 0x79, 0xc3, 0xd2, 0x9a, 0x05,
+	0x9e, 0x78, 0xdb, 0x65, 0x43, 0x5f, 0x85, 0x45, 0x69, 0xe8, 0xb0, 0x1c, 0x6d, 0xc1, 0xe7, 0x62,
+	0xfb, 0xb9, 0xd0, 0x19, 0x96, 0x0b, 0xa5, 0x66, 0x8b, 0xf3, 0x73, 0xb5, 0xe0, 0xeb, 0x0e, 0x37,
+	0x55, 0x3d, 0x72, 0xb3, 0x65, 0xac, 0x7c, 0x65, 0xb4, 0xd3, 0x2a, 0x61, 0xa0, 0x79, 0xbf, 0xdc,
+	0xe4, 0xce, 0x9f, 0x2d, 0x38, 0xd3, 0xef, 0xd6, 0x4a, 0x95, 0x20, 0xfb, 0x01, 0xcc, 0x19, 0xaf,
+	0xa1, 0x43, 0xa3, 0x3e, 0xd3, 0x6f, 0x4e, 0xe2, 0x25, 0x8b, 0x08, 0x69, 0xa1, 0x7a, 0x58, 0x74,
+	0xd9, 0xf7, 0x61, 0x41, 0x17, 0xb0, 0xdc, 0x3c, 0xdd, 0xab, 0x1c, 0x28, 0x43, 0x9b, 0xd7, 0x30,
+	0x77, 0x0d, 0x4a, 0x11, 0x21, 0xf5, 0x22, 0xfa, 0xd2, 0x9b, 0xd1, 0x9e, 0xf0, 0x22, 0xa8, 0xf2,
+	0x6a, 0x48, 0xcd, 0x64, 0x53, 0x92, 0xed, 0xed, 0xb4, 0xef, 0x43, 0x3d, 0x90, 0x4d, 0xc3, 0xca,
+	0xd4, 0xfe, 0x95, 0x82, 0x61, 0x29, 0x8b, 0x21, 0x05, 0x82, 0xbc, 0xc7, 0x0e, 0xe1, 0x78, 0x99,
+	0x6f, 0x53, 0xe1, 0x53, 0xfe, 0xb0, 0x7e, 0xed, 0x9d, 0x89, 0x69, 0xd7, 0xe6, 0x1a, 0x3d, 0x8b,
+	0x61, 0xff, 0x80, 0xd3, 0x36, 0x49, 0xe0, 0x06, 0x21, 0xeb, 0x94, 0xab, 0xc3, 0xbb, 0xed, 0x75,
+	0x88, 0x9f, 0x06, 0xb2, 0x30, 0x31, 0xcb, 0xcd, 0xef, 0x71, 0x8a, 0x2f, 0x43, 0x20, 0x50, 0x0e,
+	0xe0, 0x3c, 0xb5, 0x4c, 0x6d, 0x40, 0x96, 0x71, 0xa5, 0x8b, 0x26, 0x8f, 0x71, 0xe2, 0x5f, 0xc7,
+	0x61, 0x8c, 0x69, 0x3b, 0x32, 0x07, 0xfc, 0x01, 0x1c, 0xf3, 0x4c, 0x8f, 0x5b, 0xf2, 0xa0, 0x6f,
+	0xef, 0x57, 0x8b, 0x1f, 0xc0, 0x93, 0xee, 0x13, 0xcd, 0x79, 0xa5, 0x96, 0xdd, 0x82, 0x13, 0x39,
+	0x76, 0xa2, 0x84, 0xdd, 0x98, 0xb1, 0x60, 0xac, 0xfa, 0x64, 0x06, 0xab, 0x95, 0x6c, 0x31, 0x16,
+	0xa0, 0xe3, 0xde, 0x40, 0x1f, 0x77, 0x52, 0xe3, 0x6e, 0x7a, 0x6c, 0x5a, 0xa7, 0x5c, 0x24, 0xb4,
+	0xa5, 0x3f, 0x03, 0x6c, 0xc3, 0x42, 0xe6, 0x3b, 0xb4, 0x11, 0xd9, 0x15, 0x1e, 0x99, 0x6c, 0xae,
+	0xea, 0x29, 0x1a, 0x8f, 0xa3, 0x79, 0xdc, 0xd3, 0x76, 0x7e, 0x6d, 0x81, 0x93, 0xa5, 0xf2, 0xd7,
+	0x59, 0xe4, 0xab, 0x17, 0x3d, 0x9e, 0xec, 0xd8, 0xaf, 0xf6, 0xe6, 0xbe, 0xaf, 0x8d, 0x77, 0xd2,
+	0x74, 0xe2, 0xad, 0x67, 0xda, 0x36, 0x54, 0xe5, 0x9b, 0x4e, 0x5d, 0x86, 0x39, 0xa4, 0x7e, 0x4b,
+	0x9d, 0x34, 0x4b, 0x83, 0xcc, 0x1b, 0x73, 0x96, 0x9a, 0xdc, 0xc5, 0xf9, 0x69, 0x05, 0x2e, 0x95,
+	0xae, 0xe9, 0x41, 0x4d, 0xff, 0x1f, 0xdf, 0xd8, 0x7e, 0x0f, 0x59, 0x7d, 0x7e, 0x1e, 0xd2, 0xf9,
+	0x93, 0x05, 0x97, 0x35, 0x43, 0x7b, 0x72, 0x73, 0x2f, 0xa1, 0xed, 0xf6, 0x30, 0x8a, 0xe6, 0x4a,
+	0x14, 0x5d, 0x96, 0x5f, 0x92, 0xd4, 0x2a, 0x8c, 0xb8, 0xe1, 0xa8, 0xaf, 0x57, 0x16, 0x93, 0x85,
+	0xfe, 0x49, 0x7c, 0xb7, 0x78, 0xa6, 0x9b, 0x2d, 0xb5, 0xf3, 0xb1, 0x3b, 0xd9, 0x6b, 0x5d, 0xc6,
+	0xc4, 0x38, 0xc0, 0x5e, 0xaf, 0x78, 0x55, 0x89, 0x2f, 0xe8, 0x81, 0x5c, 0xd6, 0xf9, 0x4d, 0x96,
+	0x29, 0xdc, 0xf4, 0x48, 0x8b, 0x24, 0xba, 0xa8, 0x80, 0xc8, 0x0e, 0x0d, 0x82, 0xd1, 0xe6, 0x8f,
+	0x55, 0x18, 0xb9, 0x0a, 0x4b, 0xe4, 0x49, 0x07, 0xa7, 0x5c, 0x0c, 0xb5, 0x3d, 0x1f, 0x3b, 0x98,
+	0xed, 0x1f, 0xc2, 0x62, 0x76, 0xc5, 0xee, 0xdd, 0x5f, 0xdd, 0xd2, 0x5b, 0x9f, 0x5f, 0x1a, 0xed,
+	0xa7, 0x2e, 0x8d, 0xf4, 0x53, 0xd9, 0xac, 0xde, 0xb7, 0xe2, 0xef, 0x2c, 0x38, 0xae, 0x7d, 0x46,
+	0x36, 0xbe, 0x1d, 0xc8, 0xb2, 0xdf, 0xe1, 0xf9, 0xb8, 0x0c, 0x0b, 0xe2, 0x31, 0x8e, 0x07, 0xa9,
+	0x38, 0x26, 0xbb, 0x0f, 0xc4, 0x82, 0xbd, 0x04, 0xd3, 0x3c, 0xc8, 0xd2, 0xe9, 0x2a, 0xd2, 0x0d,
+	0xe7, 0x9b, 0x3d, 0x8f, 0xed, 0xe7, 0x4a, 0xcf, 0x47, 0xe6, 0xc4, 0xe4, 0xc3, 0xd7, 0x59, 0x18,
+	0x07, 0x44, 0x10, 0xff, 0x79, 0xa0, 0x7f, 0x03, 0xe6, 0x15, 0xba, 0x1a, 0x92, 0x05, 0x3d, 0xbb,
+	0x01, 0x47, 0x0d, 0x83, 0x86, 0xf4, 0xac, 0x69, 0x9f, 0x84, 0x19, 0x53, 0xb1, 0x92, 0x01, 0x63,
+	0x0e, 0x99, 0x96, 0xa4, 0x64, 0x27, 0xc0, 0x6d, 0x5d, 0xb6, 0x38, 0x86, 0x74, 0xc3, 0xf9, 0xd4,
+	0x82, 0xd7, 0xf4, 0x37, 0x16, 0xc1, 0x42, 0xea, 0x95, 0xae, 0xf9, 0x06, 0x21, 0xaa, 0xa8, 0x16,
+	0x07, 0x94, 0x24, 0xa6, 0x1c, 0xef, 0xdb, 0x04, 0x4e, 0x66, 0x5f, 0x6f, 0x08, 0x71, 0xc3, 0x42,
+	0xc0, 0x84, 0x87, 0x91, 0x91, 0xd7, 0xbc, 0xc2, 0xca, 0xc0, 0x68, 0x29, 0x1c, 0xec, 0xe4, 0xce,
+	0xcf, 0x2c, 0xb8, 0x90, 0x47, 0x28, 0x82, 0x88, 0xc7, 0x12, 0x1f, 0x11, 0x41, 0x22, 0xf5, 0xf9,
+	0x22, 0x33, 0xe6, 0x13, 0x58, 0x36, 0xc6, 0xa8, 0x2f, 0xad, 0x6e, 0xa2, 0xe4, 0xdc, 0x24, 0x17,
+	0x34, 0x46, 0x7d, 0x79, 0x7f, 0xa3, 0x86, 0xe9, 0x41, 0x67, 0xc2, 0x3d, 0xc7, 0xb8, 0xf3, 0x7b,
+	0xcb, 0x9c, 0x26, 0xc5, 0x56, 0x8b, 0xb1, 0x87, 0xf9, 0x77, 0x99, 0x39, 0x1e, 0xb3, 0xfe, 0xd4,
+	0x77, 0x64, 0xa0, 0xea, 0x83, 0x40, 0x75, 0x09, 0xa0, 0x7f, 0x73, 0xfb, 0x01, 0xd8, 0x7e, 0xee,
+	0x4a, 0x73, 0xd4, 0xca, 0xe4, 0xa8, 0x8b, 0x05, 0x4c, 0x96, 0x55, 0x77, 0x60, 0xa1, 0xdf, 0xfc,
+	0x17, 0x60, 0x8a, 0x93, 0x47, 0xea, 0x54, 0x55, 0x91, 0xfc, 0x69, 0x5f, 0x87, 0x1a, 0xcb, 0x84,
+	0x1a, 0x95, 0xfd, 0x0f, 0x71, 0x8e, 0x88, 0x8a, 0x79, 0xce, 0x2f, 0x2d, 0xa8, 0xe5, 0x03, 0xa3,
+	0xbd, 0xc6, 0xbb, 0xba, 0x6e, 0x17, 0x90, 0x5d, 0x92, 0xa7, 0x3d, 0x17, 0x46, 0x29, 0xdc, 0x94,
+	0x92, 0xaa, 0x50, 0xa7, 0x7e, 0x71, 0x7b, 0xcd, 0x14, 0xea, 0x0c, 0xc4, 0xd4, 0xb8, 0x10, 0xaa,
+	0x32, 0xa7, 0x31, 0x9c, 0x8f, 0x8a, 0x12, 0x2a, 0xda, 0xb8, 0x8b, 0xc8, 0xa3, 0x94, 0x70, 0x61,
+	0x6f, 0xc0, 0xd1, 0x44, 0xff, 0x1c, 0xa7, 0x34, 0x56, 0x4c, 0xcc, 0x6a, 0x0e, 0x66, 0xb2, 0x93,
+	0xc0, 0x89, 0x92, 0x2b, 0x2a, 0x29, 0x38, 0x0b, 0x60, 0x64, 0x32, 0x6a, 0xaa, 0xa8, 0x66, 0x7a,
+	0xfa, 0xbf, 0x59, 0x54, 0xfa, 0x12, 0x8c, 0xb3, 0x00, 0x94, 0xbb, 0xaa, 0xfa, 0x42, 0x7c, 0x53,
+	0xc1, 0xa8, 0x51, 0x7e, 0x43, 0x77, 0x38, 0x3f, 0xb7, 0x8c, 0x52, 0xb4, 0x71, 0xf7, 0x6e, 0xca,
+	0x04, 0x59, 0xf5, 0x3c, 0x12, 0xcb, 0x8b, 0xf4, 0x9c, 0x56, 0x65, 0xbf, 0x0b, 0xd3, 0x8f, 0x24,
+	0xb0, 0x39, 0x25, 0x17, 0xf7, 0x41, 0x51, 0x46, 0x64, 0x9e, 0x4e, 0x4d, 0x74, 0xbe, 0x9f, 0x97,
+	0x0f, 0x68, 0x3b, 0x32, 0x1e, 0x7d, 0x4b, 0x39, 0x77, 0xe9, 0xf2, 0x12, 0x12, 0xe0, 0xae, 0xf1,
+	0xa4, 0x35, 0x94, 0x35, 0xc7, 0x0b, 0x33, 0x4b, 0x30, 0x1d, 0xb1, 0xc8, 0xd3, 0xdf, 0x78, 0xab,
+	0x48, 0x37, 0x24, 0x65, 0x03, 0xd1, 0xa4, 0x96, 0xd7, 0xf8, 0x1d, 0x0e, 0xa7, 0x4a, 0xdb, 0x54,
+	0xb2, 0x89, 0x8f, 0xf7, 0x8d, 0xe1, 0x24, 0xcc, 0x28, 0x3d, 0xfa, 0x18, 0x57, 0x91, 0x69, 0xa9,
+	0x6d, 0xa4, 0x91, 0x5b, 0x36, 0x68, 0x36, 0xa4, 0xd1, 0x6d, 0xd9, 0x5e, 0xeb, 0x7c, 0xf6, 0x74,
+	0xd9, 0xfa, 0xfc, 0xe9, 0xb2, 0xf5, 0xcf, 0xa7, 0xcb, 0xd6, 0x0f, 0x9f, 0x2d, 0x1f, 0xf9, 0xfc,
+	0xd9, 0xf2, 0x91, 0xbf, 0x3c, 0x5b, 0x3e, 0xf2, 0xe0, 0x76, 0xe9, 0x9d, 0x79, 0x33, 0xa3, 0x76,
+	0x13, 0xb7, 0xf8, 0x4a, 0x4e, 0xf4, 0x1b, 0x1e, 0x4b, 0x48, 0xb9, 0xd9, 0xc1, 0x34, 0x5a, 0x09,
+	0x99, 0x7c, 0xdc, 0xf0, 0xe2, 0xdf, 0x9f, 0xd4, 0x9b, 0xb4, 0x35, 0xa3, 0xfe, 0xe9, 0xe9, 0xad,
+	0xff, 0x0c, 0x00, 0xed, 0xa1, 0x02, 0x21, 0xc3, 0x25, 0x00, 0x00,
+}
+
+func (m *EventBatchSpotExecution) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2900,44 +3178,56 @@ func (m *EventSubaccountBalanceTransfer) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSubaccountBalanceTransfer) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventBatchSpotExecution) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSubaccountBalanceTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventBatchSpotExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.DstSubaccountId) > 0 {
-		i -= len(m.DstSubaccountId)
-		copy(dAtA[i:], m.DstSubaccountId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.DstSubaccountId)))
+	if m.ExecutionType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExecutionType))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if len(m.SrcSubaccountId) > 0 {
-		i -= len(m.SrcSubaccountId)
-		copy(dAtA[i:], m.SrcSubaccountId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SrcSubaccountId)))
+	if m.IsBuy {
+		i--
+		if m.IsBuy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventBatchDepositUpdate) Marshal() (dAtA []byte, err error) {
+func (m *EventBatchDerivativeExecution) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2947,20 +3237,20 @@ func (m *EventBatchDepositUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventBatchDepositUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventBatchDerivativeExecution) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventBatchDepositUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventBatchDerivativeExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DepositUpdates) > 0 {
-		for iNdEx := len(m.DepositUpdates) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DepositUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2968,104 +3258,39 @@ func (m *EventBatchDepositUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error)
 				i = encodeVarintEvents(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DerivativeMarketOrderCancel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *DerivativeMarketOrderCancel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DerivativeMarketOrderCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.CancelQuantity.Size()
-		i -= size
-		if _, err := m.CancelQuantity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			dAtA[i] = 0x32
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.MarketOrder != nil {
-		{
-			size, err := m.MarketOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
+	if m.ExecutionType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExecutionType))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *EventCancelDerivativeOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x28
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EventCancelDerivativeOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventCancelDerivativeOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MarketOrderCancel != nil {
+	if m.CumulativeFunding != nil {
 		{
-			size, err := m.MarketOrderCancel.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+			size := m.CumulativeFunding.Size()
+			i -= size
+			if _, err := m.CumulativeFunding.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i -= size
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.LimitOrder != nil {
-		{
-			size, err := m.LimitOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+	if m.IsLiquidation {
+		i--
+		if m.IsLiquidation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if m.IsLimitCancel {
+	if m.IsBuy {
 		i--
-		if m.IsLimitCancel {
+		if m.IsBuy {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -3083,7 +3308,7 @@ func (m *EventCancelDerivativeOrder) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *EventFeeDiscountSchedule) Marshal() (dAtA []byte, err error) {
+func (m *EventLostFundsFromLiquidation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3093,32 +3318,54 @@ func (m *EventFeeDiscountSchedule) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventFeeDiscountSchedule) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventLostFundsFromLiquidation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventFeeDiscountSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventLostFundsFromLiquidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Schedule != nil {
-		{
-			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+	{
+		size := m.LostFundsFromOrderCancels.Size()
+		i -= size
+		if _, err := m.LostFundsFromOrderCancels.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LostFundsFromAvailableDuringPayout.Size()
+		i -= size
+		if _, err := m.LostFundsFromAvailableDuringPayout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventTradingRewardCampaignUpdate) Marshal() (dAtA []byte, err error) {
+func (m *EventBatchDerivativePosition) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3128,20 +3375,20 @@ func (m *EventTradingRewardCampaignUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventTradingRewardCampaignUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventBatchDerivativePosition) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventTradingRewardCampaignUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventBatchDerivativePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CampaignRewardPools) > 0 {
-		for iNdEx := len(m.CampaignRewardPools) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.CampaignRewardPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3152,22 +3399,17 @@ func (m *EventTradingRewardCampaignUpdate) MarshalToSizedBuffer(dAtA []byte) (in
 			dAtA[i] = 0x12
 		}
 	}
-	if m.CampaignInfo != nil {
-		{
-			size, err := m.CampaignInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventTradingRewardDistribution) Marshal() (dAtA []byte, err error) {
+func (m *EventDerivativeMarketPaused) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3177,34 +3419,48 @@ func (m *EventTradingRewardDistribution) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventTradingRewardDistribution) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDerivativeMarketPaused) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventTradingRewardDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDerivativeMarketPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AccountRewards) > 0 {
-		for iNdEx := len(m.AccountRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AccountRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.MissingFundsRate) > 0 {
+		i -= len(m.MissingFundsRate)
+		copy(dAtA[i:], m.MissingFundsRate)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MissingFundsRate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TotalMissingFunds) > 0 {
+		i -= len(m.TotalMissingFunds)
+		copy(dAtA[i:], m.TotalMissingFunds)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TotalMissingFunds)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SettlePrice) > 0 {
+		i -= len(m.SettlePrice)
+		copy(dAtA[i:], m.SettlePrice)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SettlePrice)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventNewConditionalDerivativeOrder) Marshal() (dAtA []byte, err error) {
+func (m *EventMarketBeyondBankruptcy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3214,42 +3470,27 @@ func (m *EventNewConditionalDerivativeOrder) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *EventNewConditionalDerivativeOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMarketBeyondBankruptcy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventNewConditionalDerivativeOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMarketBeyondBankruptcy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IsMarket {
-		i--
-		if m.IsMarket {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Hash)))
+	if len(m.MissingMarketFunds) > 0 {
+		i -= len(m.MissingMarketFunds)
+		copy(dAtA[i:], m.MissingMarketFunds)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MissingMarketFunds)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Order != nil {
-		{
-			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
+	if len(m.SettlePrice) > 0 {
+		i -= len(m.SettlePrice)
+		copy(dAtA[i:], m.SettlePrice)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SettlePrice)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3263,7 +3504,7 @@ func (m *EventNewConditionalDerivativeOrder) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCancelConditionalDerivativeOrder) Marshal() (dAtA []byte, err error) {
+func (m *EventAllPositionsHaircut) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3273,49 +3514,29 @@ func (m *EventCancelConditionalDerivativeOrder) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *EventCancelConditionalDerivativeOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventAllPositionsHaircut) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCancelConditionalDerivativeOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventAllPositionsHaircut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MarketOrder != nil {
-		{
-			size, err := m.MarketOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.LimitOrder != nil {
-		{
-			size, err := m.LimitOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
+	if len(m.MissingFundsRate) > 0 {
+		i -= len(m.MissingFundsRate)
+		copy(dAtA[i:], m.MissingFundsRate)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MissingFundsRate)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.IsLimitCancel {
-		i--
-		if m.IsLimitCancel {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.SettlePrice) > 0 {
+		i -= len(m.SettlePrice)
+		copy(dAtA[i:], m.SettlePrice)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SettlePrice)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if len(m.MarketId) > 0 {
 		i -= len(m.MarketId)
@@ -3327,7 +3548,7 @@ func (m *EventCancelConditionalDerivativeOrder) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *EventConditionalDerivativeOrderTrigger) Marshal() (dAtA []byte, err error) {
+func (m *EventBinaryOptionsMarketUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3337,51 +3558,30 @@ func (m *EventConditionalDerivativeOrderTrigger) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *EventConditionalDerivativeOrderTrigger) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventBinaryOptionsMarketUpdate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventConditionalDerivativeOrderTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventBinaryOptionsMarketUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PlacedOrderHash) > 0 {
-		i -= len(m.PlacedOrderHash)
-		copy(dAtA[i:], m.PlacedOrderHash)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PlacedOrderHash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TriggeredOrderHash) > 0 {
-		i -= len(m.TriggeredOrderHash)
-		copy(dAtA[i:], m.TriggeredOrderHash)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.TriggeredOrderHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.IsLimitTrigger {
-		i--
-		if m.IsLimitTrigger {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventOrderFail) Marshal() (dAtA []byte, err error) {
+func (m *EventVanillaOptionsMarketUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3391,54 +3591,30 @@ func (m *EventOrderFail) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventOrderFail) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventVanillaOptionsMarketUpdate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventOrderFail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventVanillaOptionsMarketUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Flags) > 0 {
-		dAtA22 := make([]byte, len(m.Flags)*10)
-		var j21 int
-		for _, num := range m.Flags {
-			for num >= 1<<7 {
-				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j21++
-			}
-			dAtA22[j21] = uint8(num)
-			j21++
-		}
-		i -= j21
-		copy(dAtA[i:], dAtA22[:j21])
-		i = encodeVarintEvents(dAtA, i, uint64(j21))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Hashes) > 0 {
-		for iNdEx := len(m.Hashes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Hashes[iNdEx])
-			copy(dAtA[i:], m.Hashes[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Hashes[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
-	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventCategoricalMarketUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3448,34 +3624,30 @@ func (m *EventAtomicMarketOrderFeeMultipliersUpdated) Marshal() (dAtA []byte, er
 	return dAtA[:n], nil
 }
 
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCategoricalMarketUpdate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCategoricalMarketUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MarketFeeMultipliers) > 0 {
-		for iNdEx := len(m.MarketFeeMultipliers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MarketFeeMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventOrderbookUpdate) Marshal() (dAtA []byte, err error) {
+func (m *EventExpiryFuturesMarketSeriesUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3485,48 +3657,30 @@ func (m *EventOrderbookUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventOrderbookUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventExpiryFuturesMarketSeriesUpdate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventOrderbookUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventExpiryFuturesMarketSeriesUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DerivativeUpdates) > 0 {
-		for iNdEx := len(m.DerivativeUpdates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DerivativeUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.SpotUpdates) > 0 {
-		for iNdEx := len(m.SpotUpdates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SpotUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Series.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *OrderbookUpdate) Marshal() (dAtA []byte, err error) {
+func (m *EventExpiryFuturesPositionRolled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3536,37 +3690,88 @@ func (m *OrderbookUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OrderbookUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventExpiryFuturesPositionRolled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OrderbookUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventExpiryFuturesPositionRolled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Orderbook != nil {
-		{
-			size, err := m.Orderbook.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+	{
+		size := m.Margin.Size()
+		i -= size
+		if _, err := m.Margin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.IsLong {
+		i--
+		if m.IsLong {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NextMarketId) > 0 {
+		i -= len(m.NextMarketId)
+		copy(dAtA[i:], m.NextMarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NextMarketId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubaccountId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Seq != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Seq))
+	if len(m.SeriesId) > 0 {
+		i -= len(m.SeriesId)
+		copy(dAtA[i:], m.SeriesId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SeriesId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Orderbook) Marshal() (dAtA []byte, err error) {
+func (m *EventExpiryFuturesPositionNotRolled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3576,716 +3781,5605 @@ func (m *Orderbook) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Orderbook) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventExpiryFuturesPositionNotRolled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Orderbook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventExpiryFuturesPositionNotRolled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SellLevels) > 0 {
-		for iNdEx := len(m.SellLevels) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SellLevels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if m.Reason != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.BuyLevels) > 0 {
-		for iNdEx := len(m.BuyLevels) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BuyLevels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.IsLong {
+		i--
+		if m.IsLong {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NextMarketId) > 0 {
+		i -= len(m.NextMarketId)
+		copy(dAtA[i:], m.NextMarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NextMarketId)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if len(m.MarketId) > 0 {
 		i -= len(m.MarketId)
 		copy(dAtA[i:], m.MarketId)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SeriesId) > 0 {
+		i -= len(m.SeriesId)
+		copy(dAtA[i:], m.SeriesId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SeriesId)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventCategoricalOutcomeSets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventBatchSpotExecution) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventCategoricalOutcomeSets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCategoricalOutcomeSets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.IsMint {
+		i--
+		if m.IsMint {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.IsBuy {
-		n += 2
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if m.ExecutionType != 0 {
-		n += 1 + sovEvents(uint64(m.ExecutionType))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Trades) > 0 {
-		for _, e := range m.Trades {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventBatchDerivativeExecution) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMultiLegOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMultiLegOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMultiLegOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.IsBuy {
-		n += 2
-	}
-	if m.IsLiquidation {
-		n += 2
+	{
+		size := m.NetPrice.Size()
+		i -= size
+		if _, err := m.NetPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if m.CumulativeFunding != nil {
-		l = m.CumulativeFunding.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if m.ExecutionType != 0 {
-		n += 1 + sovEvents(uint64(m.ExecutionType))
+	i--
+	dAtA[i] = 0x22
+	if len(m.OrderHashes) > 0 {
+		for iNdEx := len(m.OrderHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OrderHashes[iNdEx])
+			copy(dAtA[i:], m.OrderHashes[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.Trades) > 0 {
-		for _, e := range m.Trades {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if len(m.MarketIds) > 0 {
+		for iNdEx := len(m.MarketIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MarketIds[iNdEx])
+			copy(dAtA[i:], m.MarketIds[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventLostFundsFromLiquidation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.SubaccountId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventNewSpotOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.LostFundsFromAvailableDuringPayout.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.LostFundsFromOrderCancels.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventBatchDerivativePosition) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventNewSpotOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNewSpotOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.SellOrders) > 0 {
+		for iNdEx := len(m.SellOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SellOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if len(m.BuyOrders) > 0 {
+		for iNdEx := len(m.BuyOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuyOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDerivativeMarketPaused) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventNewDerivativeOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.SettlePrice)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.TotalMissingFunds)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.MissingFundsRate)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventMarketBeyondBankruptcy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.SettlePrice)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.MissingMarketFunds)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+func (m *EventNewDerivativeOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventAllPositionsHaircut) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventNewDerivativeOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.SellOrders) > 0 {
+		for iNdEx := len(m.SellOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SellOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.SettlePrice)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.BuyOrders) > 0 {
+		for iNdEx := len(m.BuyOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuyOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.MissingFundsRate)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventBinaryOptionsMarketUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventCancelSpotOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Market.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventNewSpotOrders) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.BuyOrders) > 0 {
-		for _, e := range m.BuyOrders {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
-	}
-	if len(m.SellOrders) > 0 {
-		for _, e := range m.SellOrders {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
-	}
-	return n
+func (m *EventCancelSpotOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventNewDerivativeOrders) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventCancelSpotOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.BuyOrders) > 0 {
-		for _, e := range m.BuyOrders {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if len(m.SellOrders) > 0 {
-		for _, e := range m.SellOrders {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCancelSpotOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventSpotMarketUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Order.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventSpotMarketUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Market.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+func (m *EventSpotMarketUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventPerpetualMarketUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventSpotMarketUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Market.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.PerpetualMarketInfo != nil {
-		l = m.PerpetualMarketInfo.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Funding != nil {
-		l = m.Funding.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EventExpiryFuturesMarketUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Market.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.ExpiryFuturesMarketInfo != nil {
-		l = m.ExpiryFuturesMarketInfo.Size()
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventPerpetualMarketUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventPerpetualMarketFundingUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventPerpetualMarketUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPerpetualMarketUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.Funding.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.IsHourlyFunding {
-		n += 2
+	if m.Funding != nil {
+		{
+			size, err := m.Funding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.FundingRate != nil {
-		l = m.FundingRate.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.PerpetualMarketInfo != nil {
+		{
+			size, err := m.PerpetualMarketInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.MarkPrice != nil {
-		l = m.MarkPrice.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSubaccountDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SrcAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.SubaccountId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventExpiryFuturesMarketUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventSubaccountWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SubaccountId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.DstAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+func (m *EventExpiryFuturesMarketUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSubaccountBalanceTransfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventExpiryFuturesMarketUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SrcSubaccountId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.ExpiryFuturesMarketInfo != nil {
+		{
+			size, err := m.ExpiryFuturesMarketInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.DstSubaccountId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EventBatchDepositUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.DepositUpdates) > 0 {
-		for _, e := range m.DepositUpdates {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+func (m *EventPerpetualMarketFundingUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DerivativeMarketOrderCancel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketOrder != nil {
-		l = m.MarketOrder.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.CancelQuantity.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+func (m *EventPerpetualMarketFundingUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCancelDerivativeOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventPerpetualMarketFundingUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.MarkPrice != nil {
+		{
+			size := m.MarkPrice.Size()
+			i -= size
+			if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.IsLimitCancel {
-		n += 2
+	if m.FundingRate != nil {
+		{
+			size := m.FundingRate.Size()
+			i -= size
+			if _, err := m.FundingRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.LimitOrder != nil {
-		l = m.LimitOrder.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.IsHourlyFunding {
+		i--
+		if m.IsHourlyFunding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.MarketOrderCancel != nil {
-		l = m.MarketOrderCancel.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.Funding.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventFeeDiscountSchedule) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventPreLaunchPerpetualMarketInfoUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPreLaunchPerpetualMarketInfoUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPreLaunchPerpetualMarketInfoUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Schedule != nil {
-		l = m.Schedule.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EventTradingRewardCampaignUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CampaignInfo != nil {
-		l = m.CampaignInfo.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.CampaignRewardPools) > 0 {
-		for _, e := range m.CampaignRewardPools {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+func (m *EventSubaccountDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventTradingRewardDistribution) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AccountRewards) > 0 {
-		for _, e := range m.AccountRewards {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
-	}
-	return n
+func (m *EventSubaccountDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventNewConditionalDerivativeOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventSubaccountDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Order != nil {
-		l = m.Order.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.IsMarket {
-		n += 2
+	if len(m.SrcAddress) > 0 {
+		i -= len(m.SrcAddress)
+		copy(dAtA[i:], m.SrcAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SrcAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCancelConditionalDerivativeOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.IsLimitCancel {
-		n += 2
-	}
-	if m.LimitOrder != nil {
-		l = m.LimitOrder.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.MarketOrder != nil {
-		l = m.MarketOrder.Size()
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventSubaccountWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventConditionalDerivativeOrderTrigger) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventSubaccountWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSubaccountWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.IsLimitTrigger {
-		n += 2
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.TriggeredOrderHash)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DstAddress) > 0 {
+		i -= len(m.DstAddress)
+		copy(dAtA[i:], m.DstAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DstAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.PlacedOrderHash)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventOrderFail) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSubaccountBalanceTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSubaccountBalanceTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSubaccountBalanceTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.Hashes) > 0 {
-		for _, b := range m.Hashes {
-			l = len(b)
-			n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if len(m.Flags) > 0 {
-		l = 0
-		for _, e := range m.Flags {
-			l += sovEvents(uint64(e))
-		}
-		n += 1 + sovEvents(uint64(l)) + l
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DstSubaccountId) > 0 {
+		i -= len(m.DstSubaccountId)
+		copy(dAtA[i:], m.DstSubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DstSubaccountId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.SrcSubaccountId) > 0 {
+		i -= len(m.SrcSubaccountId)
+		copy(dAtA[i:], m.SrcSubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SrcSubaccountId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventBatchDepositUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBatchDepositUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBatchDepositUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.MarketFeeMultipliers) > 0 {
-		for _, e := range m.MarketFeeMultipliers {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if len(m.DepositUpdates) > 0 {
+		for iNdEx := len(m.DepositUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventOrderbookUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *DerivativeMarketOrderCancel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DerivativeMarketOrderCancel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DerivativeMarketOrderCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	if len(m.SpotUpdates) > 0 {
-		for _, e := range m.SpotUpdates {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.CancelQuantity.Size()
+		i -= size
+		if _, err := m.CancelQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if len(m.DerivativeUpdates) > 0 {
-		for _, e := range m.DerivativeUpdates {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if m.MarketOrder != nil {
+		{
+			size, err := m.MarketOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelDerivativeOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelDerivativeOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelDerivativeOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketOrderCancel != nil {
+		{
+			size, err := m.MarketOrderCancel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LimitOrder != nil {
+		{
+			size, err := m.LimitOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.IsLimitCancel {
+		i--
+		if m.IsLimitCancel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFeeDiscountSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeeDiscountSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeeDiscountSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTradingRewardCampaignUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTradingRewardCampaignUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTradingRewardCampaignUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CampaignRewardPools) > 0 {
+		for iNdEx := len(m.CampaignRewardPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CampaignRewardPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CampaignInfo != nil {
+		{
+			size, err := m.CampaignInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTradingRewardDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTradingRewardDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTradingRewardDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountRewards) > 0 {
+		for iNdEx := len(m.AccountRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNewConditionalDerivativeOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNewConditionalDerivativeOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNewConditionalDerivativeOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsMarket {
+		i--
+		if m.IsMarket {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Order != nil {
+		{
+			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelConditionalDerivativeOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelConditionalDerivativeOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelConditionalDerivativeOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketOrder != nil {
+		{
+			size, err := m.MarketOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LimitOrder != nil {
+		{
+			size, err := m.LimitOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.IsLimitCancel {
+		i--
+		if m.IsLimitCancel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventConditionalDerivativeOrderTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventConditionalDerivativeOrderTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventConditionalDerivativeOrderTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlacedOrderHash) > 0 {
+		i -= len(m.PlacedOrderHash)
+		copy(dAtA[i:], m.PlacedOrderHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PlacedOrderHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TriggeredOrderHash) > 0 {
+		i -= len(m.TriggeredOrderHash)
+		copy(dAtA[i:], m.TriggeredOrderHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TriggeredOrderHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.IsLimitTrigger {
+		i--
+		if m.IsLimitTrigger {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventIcebergOrderRefill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventIcebergOrderRefill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventIcebergOrderRefill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlacedOrderHash) > 0 {
+		i -= len(m.PlacedOrderHash)
+		copy(dAtA[i:], m.PlacedOrderHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PlacedOrderHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ExhaustedOrderHash) > 0 {
+		i -= len(m.ExhaustedOrderHash)
+		copy(dAtA[i:], m.ExhaustedOrderHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExhaustedOrderHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNewTWAPOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNewTWAPOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNewTWAPOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTWAPOrderSlice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTWAPOrderSlice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTWAPOrderSlice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Slice != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Slice))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PlacedOrderHash) > 0 {
+		i -= len(m.PlacedOrderHash)
+		copy(dAtA[i:], m.PlacedOrderHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PlacedOrderHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TwapOrderHash) > 0 {
+		i -= len(m.TwapOrderHash)
+		copy(dAtA[i:], m.TwapOrderHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TwapOrderHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelTWAPOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelTWAPOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelTWAPOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTWAPOrderCompleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTWAPOrderCompleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTWAPOrderCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderFail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderFail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderFail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Flags) > 0 {
+		dAtA29 := make([]byte, len(m.Flags)*10)
+		var j28 int
+		for _, num := range m.Flags {
+			for num >= 1<<7 {
+				dAtA29[j28] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j28++
+			}
+			dAtA29[j28] = uint8(num)
+			j28++
+		}
+		i -= j28
+		copy(dAtA[i:], dAtA29[:j28])
+		i = encodeVarintEvents(dAtA, i, uint64(j28))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hashes) > 0 {
+		for iNdEx := len(m.Hashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Hashes[iNdEx])
+			copy(dAtA[i:], m.Hashes[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Hashes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketFeeMultipliers) > 0 {
+		for iNdEx := len(m.MarketFeeMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketFeeMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTradeRecordRetentionsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTradeRecordRetentionsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTradeRecordRetentionsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketTradeRecordRetentions) > 0 {
+		for iNdEx := len(m.MarketTradeRecordRetentions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketTradeRecordRetentions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderbookUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderbookUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderbookUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DerivativeUpdates) > 0 {
+		for iNdEx := len(m.DerivativeUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DerivativeUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SpotUpdates) > 0 {
+		for iNdEx := len(m.SpotUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpotUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderbookUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderbookUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderbookUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Orderbook != nil {
+		{
+			size, err := m.Orderbook.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Seq != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Orderbook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Orderbook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Orderbook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SellLevels) > 0 {
+		for iNdEx := len(m.SellLevels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SellLevels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BuyLevels) > 0 {
+		for iNdEx := len(m.BuyLevels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuyLevels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNewRFQRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNewRFQRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNewRFQRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelRFQRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelRFQRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelRFQRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsExpired {
+		i--
+		if m.IsExpired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RequestId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRFQQuoteAccepted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRFQQuoteAccepted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRFQQuoteAccepted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Quote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSignedOrderPlaced) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSignedOrderPlaced) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSignedOrderPlaced) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelSignedOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelSignedOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelSignedOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinNonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MinNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Nonces) > 0 {
+		dAtA35 := make([]byte, len(m.Nonces)*10)
+		var j34 int
+		for _, num := range m.Nonces {
+			for num >= 1<<7 {
+				dAtA35[j34] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j34++
+			}
+			dAtA35[j34] = uint8(num)
+			j34++
+		}
+		i -= j34
+		copy(dAtA[i:], dAtA35[:j34])
+		i = encodeVarintEvents(dAtA, i, uint64(j34))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventBatchSpotExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsBuy {
+		n += 2
+	}
+	if m.ExecutionType != 0 {
+		n += 1 + sovEvents(uint64(m.ExecutionType))
+	}
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventBatchDerivativeExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsBuy {
+		n += 2
+	}
+	if m.IsLiquidation {
+		n += 2
+	}
+	if m.CumulativeFunding != nil {
+		l = m.CumulativeFunding.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ExecutionType != 0 {
+		n += 1 + sovEvents(uint64(m.ExecutionType))
+	}
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventLostFundsFromLiquidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.LostFundsFromAvailableDuringPayout.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.LostFundsFromOrderCancels.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventBatchDerivativePosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventDerivativeMarketPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SettlePrice)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TotalMissingFunds)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MissingFundsRate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketBeyondBankruptcy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SettlePrice)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MissingMarketFunds)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAllPositionsHaircut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SettlePrice)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MissingFundsRate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBinaryOptionsMarketUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Market.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventVanillaOptionsMarketUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Market.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventCategoricalMarketUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Market.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventExpiryFuturesMarketSeriesUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Series.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventExpiryFuturesPositionRolled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SeriesId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NextMarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsLong {
+		n += 2
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Margin.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventExpiryFuturesPositionNotRolled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SeriesId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NextMarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsLong {
+		n += 2
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Reason != 0 {
+		n += 1 + sovEvents(uint64(m.Reason))
+	}
+	return n
+}
+
+func (m *EventCategoricalOutcomeSets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.IsMint {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventMultiLegOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.MarketIds) > 0 {
+		for _, s := range m.MarketIds {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.OrderHashes) > 0 {
+		for _, s := range m.OrderHashes {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.NetPrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventNewSpotOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.BuyOrders) > 0 {
+		for _, e := range m.BuyOrders {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.SellOrders) > 0 {
+		for _, e := range m.SellOrders {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventNewDerivativeOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.BuyOrders) > 0 {
+		for _, e := range m.BuyOrders {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.SellOrders) > 0 {
+		for _, e := range m.SellOrders {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventCancelSpotOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Order.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSpotMarketUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Market.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventPerpetualMarketUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Market.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.PerpetualMarketInfo != nil {
+		l = m.PerpetualMarketInfo.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Funding != nil {
+		l = m.Funding.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventExpiryFuturesMarketUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Market.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.ExpiryFuturesMarketInfo != nil {
+		l = m.ExpiryFuturesMarketInfo.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPerpetualMarketFundingUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Funding.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.IsHourlyFunding {
+		n += 2
+	}
+	if m.FundingRate != nil {
+		l = m.FundingRate.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarkPrice != nil {
+		l = m.MarkPrice.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPreLaunchPerpetualMarketInfoUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Info.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSubaccountDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SrcAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSubaccountWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DstAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSubaccountBalanceTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SrcSubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DstSubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventBatchDepositUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DepositUpdates) > 0 {
+		for _, e := range m.DepositUpdates {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DerivativeMarketOrderCancel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketOrder != nil {
+		l = m.MarketOrder.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.CancelQuantity.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventCancelDerivativeOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsLimitCancel {
+		n += 2
+	}
+	if m.LimitOrder != nil {
+		l = m.LimitOrder.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketOrderCancel != nil {
+		l = m.MarketOrderCancel.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFeeDiscountSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTradingRewardCampaignUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignInfo != nil {
+		l = m.CampaignInfo.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.CampaignRewardPools) > 0 {
+		for _, e := range m.CampaignRewardPools {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventTradingRewardDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccountRewards) > 0 {
+		for _, e := range m.AccountRewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventNewConditionalDerivativeOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Order != nil {
+		l = m.Order.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsMarket {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventCancelConditionalDerivativeOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsLimitCancel {
+		n += 2
+	}
+	if m.LimitOrder != nil {
+		l = m.LimitOrder.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketOrder != nil {
+		l = m.MarketOrder.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventConditionalDerivativeOrderTrigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsLimitTrigger {
+		n += 2
+	}
+	l = len(m.TriggeredOrderHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PlacedOrderHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventIcebergOrderRefill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExhaustedOrderHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PlacedOrderHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNewTWAPOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventTWAPOrderSlice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TwapOrderHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PlacedOrderHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Slice != 0 {
+		n += 1 + sovEvents(uint64(m.Slice))
+	}
+	return n
+}
+
+func (m *EventCancelTWAPOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventTWAPOrderCompleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventOrderFail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Hashes) > 0 {
+		for _, b := range m.Hashes {
+			l = len(b)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Flags) > 0 {
+		l = 0
+		for _, e := range m.Flags {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MarketFeeMultipliers) > 0 {
+		for _, e := range m.MarketFeeMultipliers {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventTradeRecordRetentionsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MarketTradeRecordRetentions) > 0 {
+		for _, e := range m.MarketTradeRecordRetentions {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventOrderbookUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpotUpdates) > 0 {
+		for _, e := range m.SpotUpdates {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.DerivativeUpdates) > 0 {
+		for _, e := range m.DerivativeUpdates {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OrderbookUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovEvents(uint64(m.Seq))
+	}
+	if m.Orderbook != nil {
+		l = m.Orderbook.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *Orderbook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.BuyLevels) > 0 {
+		for _, e := range m.BuyLevels {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.SellLevels) > 0 {
+		for _, e := range m.SellLevels {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventNewRFQRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Request.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventCancelRFQRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovEvents(uint64(m.RequestId))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsExpired {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventRFQQuoteAccepted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Request.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Quote.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSignedOrderPlaced) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovEvents(uint64(m.Nonce))
+	}
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCancelSignedOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Nonces) > 0 {
+		l = 0
+		for _, e := range m.Nonces {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	if m.MinNonce != 0 {
+		n += 1 + sovEvents(uint64(m.MinNonce))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventBatchSpotExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBatchSpotExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBatchSpotExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBuy = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionType", wireType)
+			}
+			m.ExecutionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionType |= ExecutionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, &TradeLog{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBatchDerivativeExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBatchDerivativeExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBatchDerivativeExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBuy = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLiquidation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLiquidation = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeFunding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.CumulativeFunding = &v
+			if err := m.CumulativeFunding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionType", wireType)
+			}
+			m.ExecutionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionType |= ExecutionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, &DerivativeTradeLog{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventLostFundsFromLiquidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventLostFundsFromLiquidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventLostFundsFromLiquidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubaccountId = append(m.SubaccountId[:0], dAtA[iNdEx:postIndex]...)
+			if m.SubaccountId == nil {
+				m.SubaccountId = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LostFundsFromAvailableDuringPayout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LostFundsFromAvailableDuringPayout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LostFundsFromOrderCancels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LostFundsFromOrderCancels.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBatchDerivativePosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBatchDerivativePosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBatchDerivativePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, &SubaccountPosition{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDerivativeMarketPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDerivativeMarketPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDerivativeMarketPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlePrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMissingFunds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalMissingFunds = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingFundsRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingFundsRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketBeyondBankruptcy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketBeyondBankruptcy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketBeyondBankruptcy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlePrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingMarketFunds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingMarketFunds = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAllPositionsHaircut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAllPositionsHaircut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAllPositionsHaircut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlePrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingFundsRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingFundsRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBinaryOptionsMarketUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBinaryOptionsMarketUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBinaryOptionsMarketUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventVanillaOptionsMarketUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVanillaOptionsMarketUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVanillaOptionsMarketUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCategoricalMarketUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCategoricalMarketUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCategoricalMarketUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventExpiryFuturesMarketSeriesUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventExpiryFuturesMarketSeriesUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventExpiryFuturesMarketSeriesUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Series", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Series.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventExpiryFuturesPositionRolled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventExpiryFuturesPositionRolled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventExpiryFuturesPositionRolled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeriesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextMarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextMarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLong", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLong = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventExpiryFuturesPositionNotRolled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventExpiryFuturesPositionNotRolled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventExpiryFuturesPositionNotRolled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeriesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextMarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextMarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLong", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLong = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= ExpiryFuturesRollFailureReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCategoricalOutcomeSets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCategoricalOutcomeSets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCategoricalOutcomeSets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsMint", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsMint = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMultiLegOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMultiLegOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMultiLegOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketIds = append(m.MarketIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderHashes = append(m.OrderHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNewSpotOrders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNewSpotOrders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNewSpotOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuyOrders = append(m.BuyOrders, &SpotLimitOrder{})
+			if err := m.BuyOrders[len(m.BuyOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SellOrders = append(m.SellOrders, &SpotLimitOrder{})
+			if err := m.SellOrders[len(m.SellOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNewDerivativeOrders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNewDerivativeOrders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNewDerivativeOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuyOrders = append(m.BuyOrders, &DerivativeLimitOrder{})
+			if err := m.BuyOrders[len(m.BuyOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SellOrders = append(m.SellOrders, &DerivativeLimitOrder{})
+			if err := m.SellOrders[len(m.SellOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *OrderbookUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Seq != 0 {
-		n += 1 + sovEvents(uint64(m.Seq))
-	}
-	if m.Orderbook != nil {
-		l = m.Orderbook.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
-}
 
-func (m *Orderbook) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.BuyLevels) > 0 {
-		for _, e := range m.BuyLevels {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
-	}
-	if len(m.SellLevels) > 0 {
-		for _, e := range m.SellLevels {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventBatchSpotExecution) Unmarshal(dAtA []byte) error {
+func (m *EventCancelSpotOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4308,10 +9402,10 @@ func (m *EventBatchSpotExecution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBatchSpotExecution: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCancelSpotOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBatchSpotExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCancelSpotOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4347,10 +9441,10 @@ func (m *EventBatchSpotExecution) Unmarshal(dAtA []byte) error {
 			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4360,34 +9454,78 @@ func (m *EventBatchSpotExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsBuy = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionType", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.ExecutionType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExecutionType |= ExecutionType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSpotMarketUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSpotMarketUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSpotMarketUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4414,8 +9552,7 @@ func (m *EventBatchSpotExecution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trades = append(m.Trades, &TradeLog{})
-			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4440,7 +9577,7 @@ func (m *EventBatchSpotExecution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBatchDerivativeExecution) Unmarshal(dAtA []byte) error {
+func (m *EventPerpetualMarketUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4463,17 +9600,17 @@ func (m *EventBatchDerivativeExecution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBatchDerivativeExecution: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPerpetualMarketUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBatchDerivativeExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPerpetualMarketUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4483,29 +9620,30 @@ func (m *EventBatchDerivativeExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualMarketInfo", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4515,37 +9653,119 @@ func (m *EventBatchDerivativeExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PerpetualMarketInfo == nil {
+				m.PerpetualMarketInfo = &PerpetualMarketInfo{}
+			}
+			if err := m.PerpetualMarketInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsBuy = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLiquidation", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Funding == nil {
+				m.Funding = &PerpetualMarketFunding{}
+			}
+			if err := m.Funding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventExpiryFuturesMarketUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.IsLiquidation = bool(v != 0)
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventExpiryFuturesMarketUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventExpiryFuturesMarketUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeFunding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4555,50 +9775,28 @@ func (m *EventBatchDerivativeExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.CumulativeFunding = &v
-			if err := m.CumulativeFunding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionType", wireType)
-			}
-			m.ExecutionType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExecutionType |= ExecutionType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryFuturesMarketInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4625,8 +9823,10 @@ func (m *EventBatchDerivativeExecution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trades = append(m.Trades, &DerivativeTradeLog{})
-			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ExpiryFuturesMarketInfo == nil {
+				m.ExpiryFuturesMarketInfo = &ExpiryFuturesMarketInfo{}
+			}
+			if err := m.ExpiryFuturesMarketInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4651,7 +9851,7 @@ func (m *EventBatchDerivativeExecution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventLostFundsFromLiquidation) Unmarshal(dAtA []byte) error {
+func (m *EventPerpetualMarketFundingUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4674,10 +9874,10 @@ func (m *EventLostFundsFromLiquidation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventLostFundsFromLiquidation: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPerpetualMarketFundingUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventLostFundsFromLiquidation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPerpetualMarketFundingUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4714,9 +9914,9 @@ func (m *EventLostFundsFromLiquidation) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funding", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4726,29 +9926,48 @@ func (m *EventLostFundsFromLiquidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubaccountId = append(m.SubaccountId[:0], dAtA[iNdEx:postIndex]...)
-			if m.SubaccountId == nil {
-				m.SubaccountId = []byte{}
+			if err := m.Funding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsHourlyFunding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsHourlyFunding = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LostFundsFromAvailableDuringPayout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4776,13 +9995,15 @@ func (m *EventLostFundsFromLiquidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LostFundsFromAvailableDuringPayout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.FundingRate = &v
+			if err := m.FundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LostFundsFromOrderCancels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4810,7 +10031,9 @@ func (m *EventLostFundsFromLiquidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LostFundsFromOrderCancels.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MarkPrice = &v
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4835,7 +10058,7 @@ func (m *EventLostFundsFromLiquidation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBatchDerivativePosition) Unmarshal(dAtA []byte) error {
+func (m *EventPreLaunchPerpetualMarketInfoUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4858,47 +10081,15 @@ func (m *EventBatchDerivativePosition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBatchDerivativePosition: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPreLaunchPerpetualMarketInfoUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBatchDerivativePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPreLaunchPerpetualMarketInfoUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4925,8 +10116,7 @@ func (m *EventBatchDerivativePosition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Positions = append(m.Positions, &SubaccountPosition{})
-			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4951,7 +10141,7 @@ func (m *EventBatchDerivativePosition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDerivativeMarketPaused) Unmarshal(dAtA []byte) error {
+func (m *EventSubaccountDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4974,15 +10164,15 @@ func (m *EventDerivativeMarketPaused) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDerivativeMarketPaused: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSubaccountDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDerivativeMarketPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSubaccountDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5010,13 +10200,13 @@ func (m *EventDerivativeMarketPaused) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			m.SrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5026,29 +10216,31 @@ func (m *EventDerivativeMarketPaused) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SettlePrice = string(dAtA[iNdEx:postIndex])
+			m.SubaccountId = append(m.SubaccountId[:0], dAtA[iNdEx:postIndex]...)
+			if m.SubaccountId == nil {
+				m.SubaccountId = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalMissingFunds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5058,55 +10250,24 @@ func (m *EventDerivativeMarketPaused) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalMissingFunds = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissingFundsRate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.MissingFundsRate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5129,7 +10290,7 @@ func (m *EventDerivativeMarketPaused) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarketBeyondBankruptcy) Unmarshal(dAtA []byte) error {
+func (m *EventSubaccountWithdraw) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5152,17 +10313,17 @@ func (m *EventMarketBeyondBankruptcy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketBeyondBankruptcy: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSubaccountWithdraw: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketBeyondBankruptcy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSubaccountWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5172,27 +10333,29 @@ func (m *EventMarketBeyondBankruptcy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			m.SubaccountId = append(m.SubaccountId[:0], dAtA[iNdEx:postIndex]...)
+			if m.SubaccountId == nil {
+				m.SubaccountId = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5220,13 +10383,13 @@ func (m *EventMarketBeyondBankruptcy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SettlePrice = string(dAtA[iNdEx:postIndex])
+			m.DstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissingMarketFunds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5236,23 +10399,24 @@ func (m *EventMarketBeyondBankruptcy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MissingMarketFunds = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5275,7 +10439,7 @@ func (m *EventMarketBeyondBankruptcy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAllPositionsHaircut) Unmarshal(dAtA []byte) error {
+func (m *EventSubaccountBalanceTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5298,15 +10462,15 @@ func (m *EventAllPositionsHaircut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAllPositionsHaircut: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSubaccountBalanceTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAllPositionsHaircut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSubaccountBalanceTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcSubaccountId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5334,11 +10498,11 @@ func (m *EventAllPositionsHaircut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			m.SrcSubaccountId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstSubaccountId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5366,13 +10530,13 @@ func (m *EventAllPositionsHaircut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SettlePrice = string(dAtA[iNdEx:postIndex])
+			m.DstSubaccountId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissingFundsRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5382,23 +10546,24 @@ func (m *EventAllPositionsHaircut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MissingFundsRate = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5421,7 +10586,7 @@ func (m *EventAllPositionsHaircut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBinaryOptionsMarketUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventBatchDepositUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5444,15 +10609,15 @@ func (m *EventBinaryOptionsMarketUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBinaryOptionsMarketUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBatchDepositUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBinaryOptionsMarketUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBatchDepositUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositUpdates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5479,7 +10644,8 @@ func (m *EventBinaryOptionsMarketUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DepositUpdates = append(m.DepositUpdates, &DepositUpdate{})
+			if err := m.DepositUpdates[len(m.DepositUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5504,7 +10670,7 @@ func (m *EventBinaryOptionsMarketUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNewSpotOrders) Unmarshal(dAtA []byte) error {
+func (m *DerivativeMarketOrderCancel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5527,47 +10693,15 @@ func (m *EventNewSpotOrders) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNewSpotOrders: wiretype end group for non-group")
+			return fmt.Errorf("proto: DerivativeMarketOrderCancel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNewSpotOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DerivativeMarketOrderCancel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuyOrders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketOrder", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5594,16 +10728,18 @@ func (m *EventNewSpotOrders) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuyOrders = append(m.BuyOrders, &SpotLimitOrder{})
-			if err := m.BuyOrders[len(m.BuyOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MarketOrder == nil {
+				m.MarketOrder = &DerivativeMarketOrder{}
+			}
+			if err := m.MarketOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellOrders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelQuantity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5613,23 +10749,23 @@ func (m *EventNewSpotOrders) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SellOrders = append(m.SellOrders, &SpotLimitOrder{})
-			if err := m.SellOrders[len(m.SellOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CancelQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5654,7 +10790,7 @@ func (m *EventNewSpotOrders) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNewDerivativeOrders) Unmarshal(dAtA []byte) error {
+func (m *EventCancelDerivativeOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5677,10 +10813,10 @@ func (m *EventNewDerivativeOrders) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNewDerivativeOrders: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCancelDerivativeOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNewDerivativeOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCancelDerivativeOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5716,8 +10852,28 @@ func (m *EventNewDerivativeOrders) Unmarshal(dAtA []byte) error {
 			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLimitCancel", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLimitCancel = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuyOrders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrder", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5744,14 +10900,102 @@ func (m *EventNewDerivativeOrders) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuyOrders = append(m.BuyOrders, &DerivativeLimitOrder{})
-			if err := m.BuyOrders[len(m.BuyOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LimitOrder == nil {
+				m.LimitOrder = &DerivativeLimitOrder{}
+			}
+			if err := m.LimitOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketOrderCancel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MarketOrderCancel == nil {
+				m.MarketOrderCancel = &DerivativeMarketOrderCancel{}
+			}
+			if err := m.MarketOrderCancel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFeeDiscountSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFeeDiscountSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFeeDiscountSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellOrders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5778,8 +11022,10 @@ func (m *EventNewDerivativeOrders) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SellOrders = append(m.SellOrders, &DerivativeLimitOrder{})
-			if err := m.SellOrders[len(m.SellOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Schedule == nil {
+				m.Schedule = &FeeDiscountSchedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5804,7 +11050,7 @@ func (m *EventNewDerivativeOrders) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCancelSpotOrder) Unmarshal(dAtA []byte) error {
+func (m *EventTradingRewardCampaignUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5827,17 +11073,17 @@ func (m *EventCancelSpotOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCancelSpotOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTradingRewardCampaignUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCancelSpotOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTradingRewardCampaignUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5847,27 +11093,31 @@ func (m *EventCancelSpotOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			if m.CampaignInfo == nil {
+				m.CampaignInfo = &TradingRewardCampaignInfo{}
+			}
+			if err := m.CampaignInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignRewardPools", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5894,7 +11144,8 @@ func (m *EventCancelSpotOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CampaignRewardPools = append(m.CampaignRewardPools, &CampaignRewardPool{})
+			if err := m.CampaignRewardPools[len(m.CampaignRewardPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5919,7 +11170,7 @@ func (m *EventCancelSpotOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSpotMarketUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventTradingRewardDistribution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5942,15 +11193,15 @@ func (m *EventSpotMarketUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSpotMarketUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTradingRewardDistribution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSpotMarketUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTradingRewardDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5977,7 +11228,8 @@ func (m *EventSpotMarketUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AccountRewards = append(m.AccountRewards, &AccountRewards{})
+			if err := m.AccountRewards[len(m.AccountRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6002,7 +11254,7 @@ func (m *EventSpotMarketUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPerpetualMarketUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventNewConditionalDerivativeOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6025,17 +11277,17 @@ func (m *EventPerpetualMarketUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPerpetualMarketUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNewConditionalDerivativeOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPerpetualMarketUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNewConditionalDerivativeOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6045,28 +11297,27 @@ func (m *EventPerpetualMarketUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualMarketInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6093,18 +11344,18 @@ func (m *EventPerpetualMarketUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PerpetualMarketInfo == nil {
-				m.PerpetualMarketInfo = &PerpetualMarketInfo{}
+			if m.Order == nil {
+				m.Order = &DerivativeOrder{}
 			}
-			if err := m.PerpetualMarketInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6114,28 +11365,46 @@ func (m *EventPerpetualMarketUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Funding == nil {
-				m.Funding = &PerpetualMarketFunding{}
-			}
-			if err := m.Funding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsMarket", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsMarket = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -6157,7 +11426,7 @@ func (m *EventPerpetualMarketUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventExpiryFuturesMarketUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventCancelConditionalDerivativeOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6180,15 +11449,67 @@ func (m *EventExpiryFuturesMarketUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventExpiryFuturesMarketUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCancelConditionalDerivativeOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventExpiryFuturesMarketUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCancelConditionalDerivativeOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLimitCancel", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLimitCancel = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrder", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6215,13 +11536,16 @@ func (m *EventExpiryFuturesMarketUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LimitOrder == nil {
+				m.LimitOrder = &DerivativeLimitOrder{}
+			}
+			if err := m.LimitOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryFuturesMarketInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketOrder", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6248,10 +11572,10 @@ func (m *EventExpiryFuturesMarketUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpiryFuturesMarketInfo == nil {
-				m.ExpiryFuturesMarketInfo = &ExpiryFuturesMarketInfo{}
+			if m.MarketOrder == nil {
+				m.MarketOrder = &DerivativeMarketOrder{}
 			}
-			if err := m.ExpiryFuturesMarketInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MarketOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6276,7 +11600,7 @@ func (m *EventExpiryFuturesMarketUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPerpetualMarketFundingUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventConditionalDerivativeOrderTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6299,49 +11623,17 @@ func (m *EventPerpetualMarketFundingUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPerpetualMarketFundingUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventConditionalDerivativeOrderTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPerpetualMarketFundingUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventConditionalDerivativeOrderTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funding", wireType)
-			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6351,28 +11643,29 @@ func (m *EventPerpetualMarketFundingUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Funding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MarketId = append(m.MarketId[:0], dAtA[iNdEx:postIndex]...)
+			if m.MarketId == nil {
+				m.MarketId = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsHourlyFunding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLimitTrigger", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -6389,12 +11682,12 @@ func (m *EventPerpetualMarketFundingUpdate) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IsHourlyFunding = bool(v != 0)
-		case 4:
+			m.IsLimitTrigger = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggeredOrderHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6404,33 +11697,31 @@ func (m *EventPerpetualMarketFundingUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.FundingRate = &v
-			if err := m.FundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TriggeredOrderHash = append(m.TriggeredOrderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TriggeredOrderHash == nil {
+				m.TriggeredOrderHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacedOrderHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6440,26 +11731,24 @@ func (m *EventPerpetualMarketFundingUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.MarkPrice = &v
-			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PlacedOrderHash = append(m.PlacedOrderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.PlacedOrderHash == nil {
+				m.PlacedOrderHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -6483,7 +11772,7 @@ func (m *EventPerpetualMarketFundingUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSubaccountDeposit) Unmarshal(dAtA []byte) error {
+func (m *EventIcebergOrderRefill) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6506,15 +11795,49 @@ func (m *EventSubaccountDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSubaccountDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventIcebergOrderRefill: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSubaccountDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventIcebergOrderRefill: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = append(m.MarketId[:0], dAtA[iNdEx:postIndex]...)
+			if m.MarketId == nil {
+				m.MarketId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6542,11 +11865,11 @@ func (m *EventSubaccountDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcAddress = string(dAtA[iNdEx:postIndex])
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExhaustedOrderHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6573,16 +11896,16 @@ func (m *EventSubaccountDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubaccountId = append(m.SubaccountId[:0], dAtA[iNdEx:postIndex]...)
-			if m.SubaccountId == nil {
-				m.SubaccountId = []byte{}
+			m.ExhaustedOrderHash = append(m.ExhaustedOrderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExhaustedOrderHash == nil {
+				m.ExhaustedOrderHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacedOrderHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6592,23 +11915,24 @@ func (m *EventSubaccountDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PlacedOrderHash = append(m.PlacedOrderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.PlacedOrderHash == nil {
+				m.PlacedOrderHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -6632,7 +11956,7 @@ func (m *EventSubaccountDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSubaccountWithdraw) Unmarshal(dAtA []byte) error {
+func (m *EventNewTWAPOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6655,81 +11979,15 @@ func (m *EventSubaccountWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSubaccountWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNewTWAPOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSubaccountWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNewTWAPOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SubaccountId = append(m.SubaccountId[:0], dAtA[iNdEx:postIndex]...)
-			if m.SubaccountId == nil {
-				m.SubaccountId = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DstAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6756,7 +12014,7 @@ func (m *EventSubaccountWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6781,7 +12039,7 @@ func (m *EventSubaccountWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSubaccountBalanceTransfer) Unmarshal(dAtA []byte) error {
+func (m *EventTWAPOrderSlice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6804,17 +12062,17 @@ func (m *EventSubaccountBalanceTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSubaccountBalanceTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTWAPOrderSlice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSubaccountBalanceTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTWAPOrderSlice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcSubaccountId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6824,27 +12082,29 @@ func (m *EventSubaccountBalanceTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcSubaccountId = string(dAtA[iNdEx:postIndex])
+			m.MarketId = append(m.MarketId[:0], dAtA[iNdEx:postIndex]...)
+			if m.MarketId == nil {
+				m.MarketId = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstSubaccountId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6872,13 +12132,13 @@ func (m *EventSubaccountBalanceTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstSubaccountId = string(dAtA[iNdEx:postIndex])
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapOrderHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6888,25 +12148,79 @@ func (m *EventSubaccountBalanceTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TwapOrderHash = append(m.TwapOrderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TwapOrderHash == nil {
+				m.TwapOrderHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacedOrderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlacedOrderHash = append(m.PlacedOrderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.PlacedOrderHash == nil {
+				m.PlacedOrderHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slice", wireType)
+			}
+			m.Slice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -6928,7 +12242,7 @@ func (m *EventSubaccountBalanceTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBatchDepositUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventCancelTWAPOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6951,15 +12265,15 @@ func (m *EventBatchDepositUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBatchDepositUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCancelTWAPOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBatchDepositUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCancelTWAPOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6986,8 +12300,7 @@ func (m *EventBatchDepositUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepositUpdates = append(m.DepositUpdates, &DepositUpdate{})
-			if err := m.DepositUpdates[len(m.DepositUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7012,7 +12325,7 @@ func (m *EventBatchDepositUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DerivativeMarketOrderCancel) Unmarshal(dAtA []byte) error {
+func (m *EventTWAPOrderCompleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7035,15 +12348,15 @@ func (m *DerivativeMarketOrderCancel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DerivativeMarketOrderCancel: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTWAPOrderCompleted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DerivativeMarketOrderCancel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTWAPOrderCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7070,44 +12383,7 @@ func (m *DerivativeMarketOrderCancel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MarketOrder == nil {
-				m.MarketOrder = &DerivativeMarketOrder{}
-			}
-			if err := m.MarketOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelQuantity", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CancelQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7132,7 +12408,7 @@ func (m *DerivativeMarketOrderCancel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCancelDerivativeOrder) Unmarshal(dAtA []byte) error {
+func (m *EventOrderFail) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7155,17 +12431,17 @@ func (m *EventCancelDerivativeOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCancelDerivativeOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOrderFail: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCancelDerivativeOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOrderFail: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7175,49 +12451,31 @@ func (m *EventCancelDerivativeOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			m.Account = append(m.Account[:0], dAtA[iNdEx:postIndex]...)
+			if m.Account == nil {
+				m.Account = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLimitCancel", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsLimitCancel = bool(v != 0)
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7227,150 +12485,100 @@ func (m *EventCancelDerivativeOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LimitOrder == nil {
-				m.LimitOrder = &DerivativeLimitOrder{}
-			}
-			if err := m.LimitOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Hashes = append(m.Hashes, make([]byte, postIndex-iNdEx))
+			copy(m.Hashes[len(m.Hashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketOrderCancel", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.Flags = append(m.Flags, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MarketOrderCancel == nil {
-				m.MarketOrderCancel = &DerivativeMarketOrderCancel{}
-			}
-			if err := m.MarketOrderCancel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventFeeDiscountSchedule) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventFeeDiscountSchedule: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventFeeDiscountSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Flags) == 0 {
+					m.Flags = make([]uint32, 0, elementCount)
 				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Flags = append(m.Flags, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Schedule == nil {
-				m.Schedule = &FeeDiscountSchedule{}
-			}
-			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -7392,7 +12600,7 @@ func (m *EventFeeDiscountSchedule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTradingRewardCampaignUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventAtomicMarketOrderFeeMultipliersUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7415,51 +12623,15 @@ func (m *EventTradingRewardCampaignUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTradingRewardCampaignUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAtomicMarketOrderFeeMultipliersUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTradingRewardCampaignUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAtomicMarketOrderFeeMultipliersUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CampaignInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CampaignInfo == nil {
-				m.CampaignInfo = &TradingRewardCampaignInfo{}
-			}
-			if err := m.CampaignInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CampaignRewardPools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketFeeMultipliers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7486,8 +12658,8 @@ func (m *EventTradingRewardCampaignUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CampaignRewardPools = append(m.CampaignRewardPools, &CampaignRewardPool{})
-			if err := m.CampaignRewardPools[len(m.CampaignRewardPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MarketFeeMultipliers = append(m.MarketFeeMultipliers, &MarketFeeMultiplier{})
+			if err := m.MarketFeeMultipliers[len(m.MarketFeeMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7512,7 +12684,7 @@ func (m *EventTradingRewardCampaignUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTradingRewardDistribution) Unmarshal(dAtA []byte) error {
+func (m *EventTradeRecordRetentionsUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7535,15 +12707,15 @@ func (m *EventTradingRewardDistribution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTradingRewardDistribution: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTradeRecordRetentionsUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTradingRewardDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTradeRecordRetentionsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketTradeRecordRetentions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7570,8 +12742,8 @@ func (m *EventTradingRewardDistribution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountRewards = append(m.AccountRewards, &AccountRewards{})
-			if err := m.AccountRewards[len(m.AccountRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MarketTradeRecordRetentions = append(m.MarketTradeRecordRetentions, &MarketTradeRecordRetention{})
+			if err := m.MarketTradeRecordRetentions[len(m.MarketTradeRecordRetentions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7596,7 +12768,7 @@ func (m *EventTradingRewardDistribution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNewConditionalDerivativeOrder) Unmarshal(dAtA []byte) error {
+func (m *EventOrderbookUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7619,47 +12791,15 @@ func (m *EventNewConditionalDerivativeOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNewConditionalDerivativeOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOrderbookUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNewConditionalDerivativeOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOrderbookUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotUpdates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7686,18 +12826,16 @@ func (m *EventNewConditionalDerivativeOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Order == nil {
-				m.Order = &DerivativeOrder{}
-			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SpotUpdates = append(m.SpotUpdates, &OrderbookUpdate{})
+			if err := m.SpotUpdates[len(m.SpotUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeUpdates", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7707,46 +12845,26 @@ func (m *EventNewConditionalDerivativeOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
+			m.DerivativeUpdates = append(m.DerivativeUpdates, &OrderbookUpdate{})
+			if err := m.DerivativeUpdates[len(m.DerivativeUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsMarket", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsMarket = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -7768,7 +12886,7 @@ func (m *EventNewConditionalDerivativeOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCancelConditionalDerivativeOrder) Unmarshal(dAtA []byte) error {
+func (m *OrderbookUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7776,84 +12894,32 @@ func (m *EventCancelConditionalDerivativeOrder) Unmarshal(dAtA []byte) error {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventCancelConditionalDerivativeOrder: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCancelConditionalDerivativeOrder: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLimitCancel", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return ErrIntOverflowEvents
 			}
-			m.IsLimitCancel = bool(v != 0)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrder", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderbookUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderbookUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7863,31 +12929,14 @@ func (m *EventCancelConditionalDerivativeOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Seq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LimitOrder == nil {
-				m.LimitOrder = &DerivativeLimitOrder{}
-			}
-			if err := m.LimitOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderbook", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7914,10 +12963,10 @@ func (m *EventCancelConditionalDerivativeOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MarketOrder == nil {
-				m.MarketOrder = &DerivativeMarketOrder{}
+			if m.Orderbook == nil {
+				m.Orderbook = &Orderbook{}
 			}
-			if err := m.MarketOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Orderbook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7942,7 +12991,7 @@ func (m *EventCancelConditionalDerivativeOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventConditionalDerivativeOrderTrigger) Unmarshal(dAtA []byte) error {
+func (m *Orderbook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7965,10 +13014,10 @@ func (m *EventConditionalDerivativeOrderTrigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventConditionalDerivativeOrderTrigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: Orderbook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventConditionalDerivativeOrderTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Orderbook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8006,30 +13055,10 @@ func (m *EventConditionalDerivativeOrderTrigger) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLimitTrigger", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsLimitTrigger = bool(v != 0)
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TriggeredOrderHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyLevels", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8039,31 +13068,31 @@ func (m *EventConditionalDerivativeOrderTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TriggeredOrderHash = append(m.TriggeredOrderHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.TriggeredOrderHash == nil {
-				m.TriggeredOrderHash = []byte{}
+			m.BuyLevels = append(m.BuyLevels, &Level{})
+			if err := m.BuyLevels[len(m.BuyLevels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlacedOrderHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SellLevels", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8073,24 +13102,24 @@ func (m *EventConditionalDerivativeOrderTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlacedOrderHash = append(m.PlacedOrderHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.PlacedOrderHash == nil {
-				m.PlacedOrderHash = []byte{}
+			m.SellLevels = append(m.SellLevels, &Level{})
+			if err := m.SellLevels[len(m.SellLevels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -8114,7 +13143,7 @@ func (m *EventConditionalDerivativeOrderTrigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOrderFail) Unmarshal(dAtA []byte) error {
+func (m *EventNewRFQRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8137,17 +13166,17 @@ func (m *EventOrderFail) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderFail: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNewRFQRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderFail: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNewRFQRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8157,134 +13186,25 @@ func (m *EventOrderFail) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = append(m.Account[:0], dAtA[iNdEx:postIndex]...)
-			if m.Account == nil {
-				m.Account = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Hashes = append(m.Hashes, make([]byte, postIndex-iNdEx))
-			copy(m.Hashes[len(m.Hashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Flags = append(m.Flags, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvents
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvents
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Flags) == 0 {
-					m.Flags = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Flags = append(m.Flags, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -8306,7 +13226,7 @@ func (m *EventOrderFail) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAtomicMarketOrderFeeMultipliersUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventCancelRFQRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8329,17 +13249,36 @@ func (m *EventAtomicMarketOrderFeeMultipliersUpdated) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAtomicMarketOrderFeeMultipliersUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCancelRFQRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAtomicMarketOrderFeeMultipliersUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCancelRFQRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketFeeMultipliers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8349,26 +13288,44 @@ func (m *EventAtomicMarketOrderFeeMultipliersUpdated) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketFeeMultipliers = append(m.MarketFeeMultipliers, &MarketFeeMultiplier{})
-			if err := m.MarketFeeMultipliers[len(m.MarketFeeMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsExpired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsExpired = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -8390,7 +13347,7 @@ func (m *EventAtomicMarketOrderFeeMultipliersUpdated) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *EventOrderbookUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventRFQQuoteAccepted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8413,15 +13370,15 @@ func (m *EventOrderbookUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderbookUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRFQQuoteAccepted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderbookUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRFQQuoteAccepted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8448,14 +13405,13 @@ func (m *EventOrderbookUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpotUpdates = append(m.SpotUpdates, &OrderbookUpdate{})
-			if err := m.SpotUpdates[len(m.SpotUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8482,8 +13438,7 @@ func (m *EventOrderbookUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DerivativeUpdates = append(m.DerivativeUpdates, &OrderbookUpdate{})
-			if err := m.DerivativeUpdates[len(m.DerivativeUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8508,7 +13463,7 @@ func (m *EventOrderbookUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OrderbookUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventSignedOrderPlaced) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8531,17 +13486,17 @@ func (m *OrderbookUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OrderbookUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSignedOrderPlaced: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrderbookUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSignedOrderPlaced: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
-			m.Seq = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8551,16 +13506,29 @@ func (m *OrderbookUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Seq |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orderbook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8570,26 +13538,75 @@ func (m *OrderbookUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Orderbook == nil {
-				m.Orderbook = &Orderbook{}
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			if err := m.Orderbook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderHash = append(m.OrderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.OrderHash == nil {
+				m.OrderHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -8613,7 +13630,7 @@ func (m *OrderbookUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Orderbook) Unmarshal(dAtA []byte) error {
+func (m *EventCancelSignedOrders) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8636,17 +13653,17 @@ func (m *Orderbook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Orderbook: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCancelSignedOrders: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Orderbook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCancelSignedOrders: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8656,65 +13673,105 @@ func (m *Orderbook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = append(m.MarketId[:0], dAtA[iNdEx:postIndex]...)
-			if m.MarketId == nil {
-				m.MarketId = []byte{}
-			}
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuyLevels", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Nonces = append(m.Nonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.Nonces) == 0 {
+					m.Nonces = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Nonces = append(m.Nonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BuyLevels = append(m.BuyLevels, &Level{})
-			if err := m.BuyLevels[len(m.BuyLevels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellLevels", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinNonce", wireType)
 			}
-			var msglen int
+			m.MinNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8724,26 +13781,11 @@ func (m *Orderbook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SellLevels = append(m.SellLevels, &Level{})
-			if err := m.SellLevels[len(m.SellLevels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -92,6 +92,71 @@ func (MarketStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2116e2804e9c53f9, []int{1}
 }
 
+type OptionType int32
+
+const (
+	OptionType_CallOption OptionType = 0
+	OptionType_PutOption  OptionType = 1
+)
+
+var OptionType_name = map[int32]string{
+	0: "CallOption",
+	1: "PutOption",
+}
+
+var OptionType_value = map[string]int32{
+	"CallOption": 0,
+	"PutOption":  1,
+}
+
+func (x OptionType) String() string {
+	return proto.EnumName(OptionType_name, int32(x))
+}
+
+func (OptionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{2}
+}
+
+// ExpiryFuturesRollFailureReason defines why (part of) a position opted into rolling wasn't rolled into the next
+// contract of its series.
+type ExpiryFuturesRollFailureReason int32
+
+const (
+	ExpiryFuturesRollFailureReason_UnspecifiedRollFailureReason ExpiryFuturesRollFailureReason = 0
+	// the next contract isn't active, e.g. because its insurance fund didn't exist when it was due to be launched
+	ExpiryFuturesRollFailureReason_NextContractNotActive ExpiryFuturesRollFailureReason = 1
+	// the deposits of the subaccount don't cover the initial margin of the rolled position
+	ExpiryFuturesRollFailureReason_InsufficientRollMargin ExpiryFuturesRollFailureReason = 2
+	// the subaccount holds a position in the opposite direction in the next contract
+	ExpiryFuturesRollFailureReason_OppositeNextContractPosition ExpiryFuturesRollFailureReason = 3
+	// the rolled positions of the other direction don't cover the quantity
+	ExpiryFuturesRollFailureReason_NoRollCounterparty ExpiryFuturesRollFailureReason = 4
+)
+
+var ExpiryFuturesRollFailureReason_name = map[int32]string{
+	0: "UnspecifiedRollFailureReason",
+	1: "NextContractNotActive",
+	2: "InsufficientRollMargin",
+	3: "OppositeNextContractPosition",
+	4: "NoRollCounterparty",
+}
+
+var ExpiryFuturesRollFailureReason_value = map[string]int32{
+	"UnspecifiedRollFailureReason": 0,
+	"NextContractNotActive":        1,
+	"InsufficientRollMargin":       2,
+	"OppositeNextContractPosition": 3,
+	"NoRollCounterparty":           4,
+}
+
+func (x ExpiryFuturesRollFailureReason) String() string {
+	return proto.EnumName(ExpiryFuturesRollFailureReason_name, int32(x))
+}
+
+func (ExpiryFuturesRollFailureReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{3}
+}
+
 type OrderType int32
 
 const (
@@ -141,7 +206,7 @@ func (x OrderType) String() string {
 }
 
 func (OrderType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{2}
+	return fileDescriptor_2116e2804e9c53f9, []int{4}
 }
 
 type ExecutionType int32
@@ -154,6 +219,7 @@ const (
 	ExecutionType_LimitMatchNewOrder       ExecutionType = 4
 	ExecutionType_MarketLiquidation        ExecutionType = 5
 	ExecutionType_ExpiryMarketSettlement   ExecutionType = 6
+	ExecutionType_BlockTrade               ExecutionType = 7
 )
 
 var ExecutionType_name = map[int32]string{
@@ -164,6 +230,7 @@ var ExecutionType_name = map[int32]string{
 	4: "LimitMatchNewOrder",
 	5: "MarketLiquidation",
 	6: "ExpiryMarketSettlement",
+	7: "BlockTrade",
 }
 
 var ExecutionType_value = map[string]int32{
@@ -174,6 +241,7 @@ var ExecutionType_value = map[string]int32{
 	"LimitMatchNewOrder":       4,
 	"MarketLiquidation":        5,
 	"ExpiryMarketSettlement":   6,
+	"BlockTrade":               7,
 }
 
 func (x ExecutionType) String() string {
@@ -181,7 +249,7 @@ func (x ExecutionType) String() string {
 }
 
 func (ExecutionType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{3}
+	return fileDescriptor_2116e2804e9c53f9, []int{5}
 }
 
 type OrderMask int32
@@ -224,7 +292,95 @@ func (x OrderMask) String() string {
 }
 
 func (OrderMask) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{4}
+	return fileDescriptor_2116e2804e9c53f9, []int{6}
+}
+
+type TerminalOrderStatus int32
+
+const (
+	TerminalOrderStatus_UnspecifiedTerminalStatus TerminalOrderStatus = 0
+	TerminalOrderStatus_TerminalFilled            TerminalOrderStatus = 1
+	TerminalOrderStatus_TerminalCancelled         TerminalOrderStatus = 2
+	TerminalOrderStatus_TerminalExpired           TerminalOrderStatus = 3
+	TerminalOrderStatus_TerminalLiquidated        TerminalOrderStatus = 4
+)
+
+var TerminalOrderStatus_name = map[int32]string{
+	0: "UnspecifiedTerminalStatus",
+	1: "TerminalFilled",
+	2: "TerminalCancelled",
+	3: "TerminalExpired",
+	4: "TerminalLiquidated",
+}
+
+var TerminalOrderStatus_value = map[string]int32{
+	"UnspecifiedTerminalStatus": 0,
+	"TerminalFilled":            1,
+	"TerminalCancelled":         2,
+	"TerminalExpired":           3,
+	"TerminalLiquidated":        4,
+}
+
+func (x TerminalOrderStatus) String() string {
+	return proto.EnumName(TerminalOrderStatus_name, int32(x))
+}
+
+func (TerminalOrderStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{7}
+}
+
+type OrderTerminationReason int32
+
+const (
+	OrderTerminationReason_UnspecifiedTermination OrderTerminationReason = 0
+	// the order was fully filled
+	OrderTerminationReason_FullyFilled OrderTerminationReason = 1
+	// the order was cancelled by its owner
+	OrderTerminationReason_UserCancellation OrderTerminationReason = 2
+	// the order was cancelled since its subaccount couldn't cover its margin or fees
+	OrderTerminationReason_InsufficientFundsCancellation OrderTerminationReason = 3
+	// the reduce-only order was cancelled since it no longer reduces the position
+	OrderTerminationReason_ReduceOnlyCancellation OrderTerminationReason = 4
+	// the order was cancelled by a market update, e.g. a demolished market or a fee increase
+	OrderTerminationReason_MarketUpdateCancellation OrderTerminationReason = 5
+	// the order was cancelled by the expiry or the settlement of its market
+	OrderTerminationReason_MarketExpiry OrderTerminationReason = 6
+	// the order was cancelled by the liquidation of a position
+	OrderTerminationReason_PositionLiquidation OrderTerminationReason = 7
+	// the signed order was cancelled since it expired
+	OrderTerminationReason_SignedOrderExpiry OrderTerminationReason = 8
+)
+
+var OrderTerminationReason_name = map[int32]string{
+	0: "UnspecifiedTermination",
+	1: "FullyFilled",
+	2: "UserCancellation",
+	3: "InsufficientFundsCancellation",
+	4: "ReduceOnlyCancellation",
+	5: "MarketUpdateCancellation",
+	6: "MarketExpiry",
+	7: "PositionLiquidation",
+	8: "SignedOrderExpiry",
+}
+
+var OrderTerminationReason_value = map[string]int32{
+	"UnspecifiedTermination":        0,
+	"FullyFilled":                   1,
+	"UserCancellation":              2,
+	"InsufficientFundsCancellation": 3,
+	"ReduceOnlyCancellation":        4,
+	"MarketUpdateCancellation":      5,
+	"MarketExpiry":                  6,
+	"PositionLiquidation":           7,
+	"SignedOrderExpiry":             8,
+}
+
+func (x OrderTerminationReason) String() string {
+	return proto.EnumName(OrderTerminationReason_name, int32(x))
+}
+
+func (OrderTerminationReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{8}
 }
 
 type Params struct {
@@ -276,6 +432,9 @@ type Params struct {
 	MinimalProtocolFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,23,opt,name=minimal_protocol_fee_rate,json=minimalProtocolFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"minimal_protocol_fee_rate"`
 	// is_instant_derivative_market_launch_enabled defines whether instant derivative market launch is enabled
 	IsInstantDerivativeMarketLaunchEnabled bool `protobuf:"varint,24,opt,name=is_instant_derivative_market_launch_enabled,json=isInstantDerivativeMarketLaunchEnabled,proto3" json:"is_instant_derivative_market_launch_enabled,omitempty"`
+	// terminal_order_retention_blocks defines the number of blocks for which the terminal states of the limit orders which
+	// left the orderbook are kept, zero disables it
+	TerminalOrderRetentionBlocks int64 `protobuf:"varint,25,opt,name=terminal_order_retention_blocks,json=terminalOrderRetentionBlocks,proto3" json:"terminal_order_retention_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -374,6 +533,13 @@ func (m *Params) GetIsInstantDerivativeMarketLaunchEnabled() bool {
 	return false
 }
 
+func (m *Params) GetTerminalOrderRetentionBlocks() int64 {
+	if m != nil {
+		return m.TerminalOrderRetentionBlocks
+	}
+	return 0
+}
+
 type MarketFeeMultiplier struct {
 	MarketId      string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 	FeeMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=fee_multiplier,json=feeMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_multiplier"`
@@ -516,6 +682,10 @@ type BinaryOptionsMarket struct {
 	// min_quantity_tick_size defines the minimum tick size of the quantity required for orders in the market
 	MinQuantityTickSize github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,16,opt,name=min_quantity_tick_size,json=minQuantityTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_quantity_tick_size"`
 	SettlementPrice     *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=settlement_price,json=settlementPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"settlement_price,omitempty"`
+	// scalar_lower_bound defines the oracle value at or below which a scalar market settles at 0, unset for binary markets
+	ScalarLowerBound *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=scalar_lower_bound,json=scalarLowerBound,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"scalar_lower_bound,omitempty"`
+	// scalar_upper_bound defines the oracle value at or above which a scalar market settles at 1, unset for binary markets
+	ScalarUpperBound *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=scalar_upper_bound,json=scalarUpperBound,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"scalar_upper_bound,omitempty"`
 }
 
 func (m *BinaryOptionsMarket) Reset()         { *m = BinaryOptionsMarket{} }
@@ -551,6 +721,151 @@ func (m *BinaryOptionsMarket) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BinaryOptionsMarket proto.InternalMessageInfo
 
+// VanillaOptionsMarket is a market of cash-settled European options on an oracle underlying. The price of its orders
+// is the premium of a contract, which pays out its intrinsic value at settlement.
+type VanillaOptionsMarket struct {
+	// Ticker for the options contract.
+	Ticker string `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// Oracle base currency of the underlying
+	OracleBase string `protobuf:"bytes,2,opt,name=oracle_base,json=oracleBase,proto3" json:"oracle_base,omitempty"`
+	// Oracle quote currency of the underlying
+	OracleQuote string `protobuf:"bytes,3,opt,name=oracle_quote,json=oracleQuote,proto3" json:"oracle_quote,omitempty"`
+	// Oracle type
+	OracleType types1.OracleType `protobuf:"varint,4,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty"`
+	// Scale factor for oracle prices.
+	OracleScaleFactor uint32 `protobuf:"varint,5,opt,name=oracle_scale_factor,json=oracleScaleFactor,proto3" json:"oracle_scale_factor,omitempty"`
+	// option_type defines whether the contracts are calls or puts
+	OptionType OptionType `protobuf:"varint,6,opt,name=option_type,json=optionType,proto3,enum=injective.exchange.v1beta1.OptionType" json:"option_type,omitempty"`
+	// strike_price defines the strike price of the contracts, in the unscaled oracle price of the underlying
+	StrikePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=strike_price,json=strikePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"strike_price"`
+	// contract_multiplier defines the quantity of the underlying a contract is written on
+	ContractMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=contract_multiplier,json=contractMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"contract_multiplier"`
+	// max_underlying_price caps the underlying price at settlement of call contracts, bounding their payoff
+	MaxUnderlyingPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=max_underlying_price,json=maxUnderlyingPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_underlying_price"`
+	// expiration timestamp after which no more trading is allowed
+	ExpirationTimestamp int64 `protobuf:"varint,10,opt,name=expiration_timestamp,json=expirationTimestamp,proto3" json:"expiration_timestamp,omitempty"`
+	// settlement timestamp at which the contracts are settled with the underlying oracle price
+	SettlementTimestamp int64 `protobuf:"varint,11,opt,name=settlement_timestamp,json=settlementTimestamp,proto3" json:"settlement_timestamp,omitempty"`
+	// Address of the quote currency denomination for the options contract
+	QuoteDenom string `protobuf:"bytes,12,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// Unique market ID.
+	MarketId string `protobuf:"bytes,13,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// maker_fee_rate defines the maker fee rate of a vanilla options market
+	MakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee_rate"`
+	// taker_fee_rate defines the taker fee rate of a vanilla options market
+	TakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_rate"`
+	// relayer_fee_share_rate defines the percentage of the transaction fee shared with the relayer in a vanilla options market
+	RelayerFeeShareRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=relayer_fee_share_rate,json=relayerFeeShareRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"relayer_fee_share_rate"`
+	// Status of the market
+	Status MarketStatus `protobuf:"varint,17,opt,name=status,proto3,enum=injective.exchange.v1beta1.MarketStatus" json:"status,omitempty"`
+	// min_price_tick_size defines the minimum tick size that the price and margin required for orders in the market
+	MinPriceTickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=min_price_tick_size,json=minPriceTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_price_tick_size"`
+	// min_quantity_tick_size defines the minimum tick size of the quantity required for orders in the market
+	MinQuantityTickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=min_quantity_tick_size,json=minQuantityTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_quantity_tick_size"`
+	// settlement_price defines the unscaled oracle price of the underlying the market was settled with
+	SettlementPrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,20,opt,name=settlement_price,json=settlementPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"settlement_price,omitempty"`
+}
+
+func (m *VanillaOptionsMarket) Reset()         { *m = VanillaOptionsMarket{} }
+func (m *VanillaOptionsMarket) String() string { return proto.CompactTextString(m) }
+func (*VanillaOptionsMarket) ProtoMessage()    {}
+func (*VanillaOptionsMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{4}
+}
+func (m *VanillaOptionsMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VanillaOptionsMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VanillaOptionsMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VanillaOptionsMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VanillaOptionsMarket.Merge(m, src)
+}
+func (m *VanillaOptionsMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *VanillaOptionsMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_VanillaOptionsMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VanillaOptionsMarket proto.InternalMessageInfo
+
+// CategoricalMarket groups one binary options market per outcome of an event with more than two outcomes. All outcome
+// markets share the oracle, admin, quote denom and timestamps of the categorical market, and exactly one of them
+// settles at 1 while the others settle at 0.
+type CategoricalMarket struct {
+	// Ticker for the categorical market, the outcome markets use the ticker suffixed with their outcome
+	Ticker string `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// Oracle symbol, reporting the index of the winning outcome at settlement
+	OracleSymbol string `protobuf:"bytes,2,opt,name=oracle_symbol,json=oracleSymbol,proto3" json:"oracle_symbol,omitempty"`
+	// Oracle Provider
+	OracleProvider string `protobuf:"bytes,3,opt,name=oracle_provider,json=oracleProvider,proto3" json:"oracle_provider,omitempty"`
+	// Oracle type
+	OracleType types1.OracleType `protobuf:"varint,4,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty"`
+	// Scale factor for oracle prices.
+	OracleScaleFactor uint32 `protobuf:"varint,5,opt,name=oracle_scale_factor,json=oracleScaleFactor,proto3" json:"oracle_scale_factor,omitempty"`
+	// expiration timestamp
+	ExpirationTimestamp int64 `protobuf:"varint,6,opt,name=expiration_timestamp,json=expirationTimestamp,proto3" json:"expiration_timestamp,omitempty"`
+	// settlement timestamp
+	SettlementTimestamp int64 `protobuf:"varint,7,opt,name=settlement_timestamp,json=settlementTimestamp,proto3" json:"settlement_timestamp,omitempty"`
+	// admin of the market
+	Admin string `protobuf:"bytes,8,opt,name=admin,proto3" json:"admin,omitempty"`
+	// Address of the quote currency denomination of the outcome markets
+	QuoteDenom string `protobuf:"bytes,9,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// Unique market ID.
+	MarketId string `protobuf:"bytes,10,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// outcomes defines the names of the outcomes of the event
+	Outcomes []string `protobuf:"bytes,11,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+	// outcome_market_ids defines the IDs of the binary options market of each outcome, in the order of the outcomes
+	OutcomeMarketIds []string `protobuf:"bytes,12,rep,name=outcome_market_ids,json=outcomeMarketIds,proto3" json:"outcome_market_ids,omitempty"`
+	// Status of the market
+	Status MarketStatus `protobuf:"varint,13,opt,name=status,proto3,enum=injective.exchange.v1beta1.MarketStatus" json:"status,omitempty"`
+	// winning_outcome defines the name of the outcome the market settled on, empty if not settled or voided
+	WinningOutcome string `protobuf:"bytes,14,opt,name=winning_outcome,json=winningOutcome,proto3" json:"winning_outcome,omitempty"`
+}
+
+func (m *CategoricalMarket) Reset()         { *m = CategoricalMarket{} }
+func (m *CategoricalMarket) String() string { return proto.CompactTextString(m) }
+func (*CategoricalMarket) ProtoMessage()    {}
+func (*CategoricalMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{5}
+}
+func (m *CategoricalMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CategoricalMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CategoricalMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CategoricalMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CategoricalMarket.Merge(m, src)
+}
+func (m *CategoricalMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *CategoricalMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_CategoricalMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CategoricalMarket proto.InternalMessageInfo
+
 type ExpiryFuturesMarketInfo struct {
 	// market ID.
 	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -568,7 +883,7 @@ func (m *ExpiryFuturesMarketInfo) Reset()         { *m = ExpiryFuturesMarketInfo
 func (m *ExpiryFuturesMarketInfo) String() string { return proto.CompactTextString(m) }
 func (*ExpiryFuturesMarketInfo) ProtoMessage()    {}
 func (*ExpiryFuturesMarketInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{4}
+	return fileDescriptor_2116e2804e9c53f9, []int{6}
 }
 func (m *ExpiryFuturesMarketInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -618,6 +933,134 @@ func (m *ExpiryFuturesMarketInfo) GetTwapStartTimestamp() int64 {
 	return 0
 }
 
+// ExpiryFuturesMarketSeries is the template of a rolling series of expiry futures markets. The next contract of the
+// series is launched lead_time seconds ahead of its expiration, and the contracts expire every interval seconds.
+type ExpiryFuturesMarketSeries struct {
+	// Unique series ID.
+	SeriesId string `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// ticker_prefix of the contracts, suffixed with their expiration date
+	TickerPrefix string `protobuf:"bytes,2,opt,name=ticker_prefix,json=tickerPrefix,proto3" json:"ticker_prefix,omitempty"`
+	// Address of the quote currency denomination of the contracts
+	QuoteDenom string `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// Oracle base currency
+	OracleBase string `protobuf:"bytes,4,opt,name=oracle_base,json=oracleBase,proto3" json:"oracle_base,omitempty"`
+	// Oracle quote currency
+	OracleQuote string `protobuf:"bytes,5,opt,name=oracle_quote,json=oracleQuote,proto3" json:"oracle_quote,omitempty"`
+	// Oracle type
+	OracleType types1.OracleType `protobuf:"varint,6,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty"`
+	// Scale factor for oracle prices.
+	OracleScaleFactor uint32 `protobuf:"varint,7,opt,name=oracle_scale_factor,json=oracleScaleFactor,proto3" json:"oracle_scale_factor,omitempty"`
+	// interval defines the time in seconds between the expirations of two consecutive contracts
+	Interval int64 `protobuf:"varint,8,opt,name=interval,proto3" json:"interval,omitempty"`
+	// lead_time defines how many seconds ahead of its expiration a contract is launched, more than one interval so that the
+	// next contract is live before the current one settles
+	LeadTime int64 `protobuf:"varint,9,opt,name=lead_time,json=leadTime,proto3" json:"lead_time,omitempty"`
+	// next_expiration_timestamp defines the expiration of the next contract to be launched
+	NextExpirationTimestamp int64 `protobuf:"varint,10,opt,name=next_expiration_timestamp,json=nextExpirationTimestamp,proto3" json:"next_expiration_timestamp,omitempty"`
+	// initial_margin_ratio defines the initial margin ratio of the contracts
+	InitialMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=initial_margin_ratio,json=initialMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"initial_margin_ratio"`
+	// maintenance_margin_ratio defines the maintenance margin ratio of the contracts
+	MaintenanceMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=maintenance_margin_ratio,json=maintenanceMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maintenance_margin_ratio"`
+	// maker_fee_rate defines the maker fee rate of the contracts
+	MakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee_rate"`
+	// taker_fee_rate defines the taker fee rate of the contracts
+	TakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_rate"`
+	// min_price_tick_size defines the minimum tick size of the order's price and margin of the contracts
+	MinPriceTickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=min_price_tick_size,json=minPriceTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_price_tick_size"`
+	// min_quantity_tick_size defines the minimum tick size of the order's quantity of the contracts
+	MinQuantityTickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=min_quantity_tick_size,json=minQuantityTickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_quantity_tick_size"`
+	// market_ids defines the IDs of the launched contracts that haven't been settled yet, in order of expiration
+	MarketIds []string `protobuf:"bytes,17,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+	// Status of the series, no new contracts are launched unless it is active
+	Status MarketStatus `protobuf:"varint,18,opt,name=status,proto3,enum=injective.exchange.v1beta1.MarketStatus" json:"status,omitempty"`
+}
+
+func (m *ExpiryFuturesMarketSeries) Reset()         { *m = ExpiryFuturesMarketSeries{} }
+func (m *ExpiryFuturesMarketSeries) String() string { return proto.CompactTextString(m) }
+func (*ExpiryFuturesMarketSeries) ProtoMessage()    {}
+func (*ExpiryFuturesMarketSeries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{7}
+}
+func (m *ExpiryFuturesMarketSeries) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpiryFuturesMarketSeries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpiryFuturesMarketSeries.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpiryFuturesMarketSeries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpiryFuturesMarketSeries.Merge(m, src)
+}
+func (m *ExpiryFuturesMarketSeries) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpiryFuturesMarketSeries) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpiryFuturesMarketSeries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpiryFuturesMarketSeries proto.InternalMessageInfo
+
+// ExpiryFuturesAutoRoll records that the positions of a subaccount are rolled into the next contract of a series
+type ExpiryFuturesAutoRoll struct {
+	SeriesId     string `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	SubaccountId string `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+}
+
+func (m *ExpiryFuturesAutoRoll) Reset()         { *m = ExpiryFuturesAutoRoll{} }
+func (m *ExpiryFuturesAutoRoll) String() string { return proto.CompactTextString(m) }
+func (*ExpiryFuturesAutoRoll) ProtoMessage()    {}
+func (*ExpiryFuturesAutoRoll) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{8}
+}
+func (m *ExpiryFuturesAutoRoll) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpiryFuturesAutoRoll) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpiryFuturesAutoRoll.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpiryFuturesAutoRoll) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpiryFuturesAutoRoll.Merge(m, src)
+}
+func (m *ExpiryFuturesAutoRoll) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpiryFuturesAutoRoll) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpiryFuturesAutoRoll.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpiryFuturesAutoRoll proto.InternalMessageInfo
+
+func (m *ExpiryFuturesAutoRoll) GetSeriesId() string {
+	if m != nil {
+		return m.SeriesId
+	}
+	return ""
+}
+
+func (m *ExpiryFuturesAutoRoll) GetSubaccountId() string {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return ""
+}
+
 type PerpetualMarketInfo struct {
 	// market ID.
 	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -635,7 +1078,7 @@ func (m *PerpetualMarketInfo) Reset()         { *m = PerpetualMarketInfo{} }
 func (m *PerpetualMarketInfo) String() string { return proto.CompactTextString(m) }
 func (*PerpetualMarketInfo) ProtoMessage()    {}
 func (*PerpetualMarketInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{5}
+	return fileDescriptor_2116e2804e9c53f9, []int{9}
 }
 func (m *PerpetualMarketInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -697,7 +1140,7 @@ func (m *PerpetualMarketFunding) Reset()         { *m = PerpetualMarketFunding{}
 func (m *PerpetualMarketFunding) String() string { return proto.CompactTextString(m) }
 func (*PerpetualMarketFunding) ProtoMessage()    {}
 func (*PerpetualMarketFunding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{6}
+	return fileDescriptor_2116e2804e9c53f9, []int{10}
 }
 func (m *PerpetualMarketFunding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,6 +1176,93 @@ func (m *PerpetualMarketFunding) GetLastTimestamp() int64 {
 	return 0
 }
 
+// PreLaunchPerpetualMarketInfo defines the mark price state of a perpetual market that has no oracle yet. The mark price
+// is the time-weighted average of the market's own trade prices over the last completed TWAP window.
+type PreLaunchPerpetualMarketInfo struct {
+	// market ID.
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// window defines the duration in seconds of the TWAP window the mark price is derived from
+	Window int64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	// mark_price defines the mark price set at the end of the last completed window
+	MarkPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=mark_price,json=markPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_price"`
+	// last_price defines the VWAP of the last block the market traded in, holding until the next block it trades in
+	LastPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=last_price,json=lastPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_price"`
+	// window_cumulative_price defines the sum of the last prices of the current window weighted by the seconds they held,
+	// until last_price_timestamp
+	WindowCumulativePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=window_cumulative_price,json=windowCumulativePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"window_cumulative_price"`
+	// window_quantity defines the quantity of the trades of the current window
+	WindowQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=window_quantity,json=windowQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"window_quantity"`
+	// window_start_timestamp defines the start time of the current window
+	WindowStartTimestamp int64 `protobuf:"varint,7,opt,name=window_start_timestamp,json=windowStartTimestamp,proto3" json:"window_start_timestamp,omitempty"`
+	// min_window_quantity defines the quantity which must be traded in a window for the mark price to be updated
+	MinWindowQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_window_quantity,json=minWindowQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_window_quantity"`
+	// max_mark_price_change_rate defines the maximum change of the mark price per window, relative to the previous one
+	MaxMarkPriceChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=max_mark_price_change_rate,json=maxMarkPriceChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_mark_price_change_rate"`
+	// last_price_timestamp defines the time since which the last price holds in the current window
+	LastPriceTimestamp int64 `protobuf:"varint,10,opt,name=last_price_timestamp,json=lastPriceTimestamp,proto3" json:"last_price_timestamp,omitempty"`
+}
+
+func (m *PreLaunchPerpetualMarketInfo) Reset()         { *m = PreLaunchPerpetualMarketInfo{} }
+func (m *PreLaunchPerpetualMarketInfo) String() string { return proto.CompactTextString(m) }
+func (*PreLaunchPerpetualMarketInfo) ProtoMessage()    {}
+func (*PreLaunchPerpetualMarketInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{11}
+}
+func (m *PreLaunchPerpetualMarketInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PreLaunchPerpetualMarketInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PreLaunchPerpetualMarketInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PreLaunchPerpetualMarketInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreLaunchPerpetualMarketInfo.Merge(m, src)
+}
+func (m *PreLaunchPerpetualMarketInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *PreLaunchPerpetualMarketInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreLaunchPerpetualMarketInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreLaunchPerpetualMarketInfo proto.InternalMessageInfo
+
+func (m *PreLaunchPerpetualMarketInfo) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+func (m *PreLaunchPerpetualMarketInfo) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *PreLaunchPerpetualMarketInfo) GetWindowStartTimestamp() int64 {
+	if m != nil {
+		return m.WindowStartTimestamp
+	}
+	return 0
+}
+
+func (m *PreLaunchPerpetualMarketInfo) GetLastPriceTimestamp() int64 {
+	if m != nil {
+		return m.LastPriceTimestamp
+	}
+	return 0
+}
+
 type DerivativeMarketSettlementInfo struct {
 	// market ID.
 	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -744,7 +1274,7 @@ func (m *DerivativeMarketSettlementInfo) Reset()         { *m = DerivativeMarket
 func (m *DerivativeMarketSettlementInfo) String() string { return proto.CompactTextString(m) }
 func (*DerivativeMarketSettlementInfo) ProtoMessage()    {}
 func (*DerivativeMarketSettlementInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{7}
+	return fileDescriptor_2116e2804e9c53f9, []int{12}
 }
 func (m *DerivativeMarketSettlementInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -788,7 +1318,7 @@ func (m *NextFundingTimestamp) Reset()         { *m = NextFundingTimestamp{} }
 func (m *NextFundingTimestamp) String() string { return proto.CompactTextString(m) }
 func (*NextFundingTimestamp) ProtoMessage()    {}
 func (*NextFundingTimestamp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{8}
+	return fileDescriptor_2116e2804e9c53f9, []int{13}
 }
 func (m *NextFundingTimestamp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -852,7 +1382,7 @@ func (m *SpotMarket) Reset()         { *m = SpotMarket{} }
 func (m *SpotMarket) String() string { return proto.CompactTextString(m) }
 func (*SpotMarket) ProtoMessage()    {}
 func (*SpotMarket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{9}
+	return fileDescriptor_2116e2804e9c53f9, []int{14}
 }
 func (m *SpotMarket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -926,7 +1456,7 @@ func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{10}
+	return fileDescriptor_2116e2804e9c53f9, []int{15}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -963,7 +1493,7 @@ func (m *SubaccountTradeNonce) Reset()         { *m = SubaccountTradeNonce{} }
 func (m *SubaccountTradeNonce) String() string { return proto.CompactTextString(m) }
 func (*SubaccountTradeNonce) ProtoMessage()    {}
 func (*SubaccountTradeNonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{11}
+	return fileDescriptor_2116e2804e9c53f9, []int{16}
 }
 func (m *SubaccountTradeNonce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1014,7 +1544,7 @@ func (m *OrderInfo) Reset()         { *m = OrderInfo{} }
 func (m *OrderInfo) String() string { return proto.CompactTextString(m) }
 func (*OrderInfo) ProtoMessage()    {}
 func (*OrderInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{12}
+	return fileDescriptor_2116e2804e9c53f9, []int{17}
 }
 func (m *OrderInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1066,13 +1596,15 @@ type SpotOrder struct {
 	OrderType OrderType `protobuf:"varint,3,opt,name=order_type,json=orderType,proto3,enum=injective.exchange.v1beta1.OrderType" json:"order_type,omitempty"`
 	// trigger_price is the trigger price used by stop/take orders
 	TriggerPrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price,omitempty"`
+	// visible_quantity is the displayed slice of an iceberg order, empty for regular orders
+	VisibleQuantity *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=visible_quantity,json=visibleQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"visible_quantity,omitempty"`
 }
 
 func (m *SpotOrder) Reset()         { *m = SpotOrder{} }
 func (m *SpotOrder) String() string { return proto.CompactTextString(m) }
 func (*SpotOrder) ProtoMessage()    {}
 func (*SpotOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{13}
+	return fileDescriptor_2116e2804e9c53f9, []int{18}
 }
 func (m *SpotOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1133,13 +1665,15 @@ type SpotLimitOrder struct {
 	// trigger_price is the trigger price used by stop/take orders
 	TriggerPrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price,omitempty"`
 	OrderHash    []byte                                  `protobuf:"bytes,5,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
+	// iceberg contains the hidden reserve of an iceberg order
+	Iceberg *IcebergOrderInfo `protobuf:"bytes,6,opt,name=iceberg,proto3" json:"iceberg,omitempty"`
 }
 
 func (m *SpotLimitOrder) Reset()         { *m = SpotLimitOrder{} }
 func (m *SpotLimitOrder) String() string { return proto.CompactTextString(m) }
 func (*SpotLimitOrder) ProtoMessage()    {}
 func (*SpotLimitOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{14}
+	return fileDescriptor_2116e2804e9c53f9, []int{19}
 }
 func (m *SpotLimitOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1189,6 +1723,13 @@ func (m *SpotLimitOrder) GetOrderHash() []byte {
 	return nil
 }
 
+func (m *SpotLimitOrder) GetIceberg() *IcebergOrderInfo {
+	if m != nil {
+		return m.Iceberg
+	}
+	return nil
+}
+
 // A valid Spot market order with Metadata.
 type SpotMarketOrder struct {
 	// order_info contains the information of the order
@@ -1199,13 +1740,16 @@ type SpotMarketOrder struct {
 	OrderType OrderType `protobuf:"varint,4,opt,name=order_type,json=orderType,proto3,enum=injective.exchange.v1beta1.OrderType" json:"order_type,omitempty"`
 	// trigger_price is the trigger price used by stop/take orders
 	TriggerPrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price,omitempty"`
+	// quote_amount is the quote amount to spend or receive of quote amount market orders, which are only filled up to
+	// it, the order quantity being the maximum quantity
+	QuoteAmount *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=quote_amount,json=quoteAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quote_amount,omitempty"`
 }
 
 func (m *SpotMarketOrder) Reset()         { *m = SpotMarketOrder{} }
 func (m *SpotMarketOrder) String() string { return proto.CompactTextString(m) }
 func (*SpotMarketOrder) ProtoMessage()    {}
 func (*SpotMarketOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{15}
+	return fileDescriptor_2116e2804e9c53f9, []int{20}
 }
 func (m *SpotMarketOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1266,13 +1810,15 @@ type DerivativeOrder struct {
 	Margin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=margin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"margin"`
 	// trigger_price is the trigger price used by stop/take orders
 	TriggerPrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price,omitempty"`
+	// visible_quantity is the displayed slice of an iceberg order, empty for regular orders
+	VisibleQuantity *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=visible_quantity,json=visibleQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"visible_quantity,omitempty"`
 }
 
 func (m *DerivativeOrder) Reset()         { *m = DerivativeOrder{} }
 func (m *DerivativeOrder) String() string { return proto.CompactTextString(m) }
 func (*DerivativeOrder) ProtoMessage()    {}
 func (*DerivativeOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{16}
+	return fileDescriptor_2116e2804e9c53f9, []int{21}
 }
 func (m *DerivativeOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1337,7 +1883,7 @@ func (m *SubaccountOrderbookMetadata) Reset()         { *m = SubaccountOrderbook
 func (m *SubaccountOrderbookMetadata) String() string { return proto.CompactTextString(m) }
 func (*SubaccountOrderbookMetadata) ProtoMessage()    {}
 func (*SubaccountOrderbookMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{17}
+	return fileDescriptor_2116e2804e9c53f9, []int{22}
 }
 func (m *SubaccountOrderbookMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1406,7 +1952,7 @@ func (m *SubaccountOrder) Reset()         { *m = SubaccountOrder{} }
 func (m *SubaccountOrder) String() string { return proto.CompactTextString(m) }
 func (*SubaccountOrder) ProtoMessage()    {}
 func (*SubaccountOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{18}
+	return fileDescriptor_2116e2804e9c53f9, []int{23}
 }
 func (m *SubaccountOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1451,7 +1997,7 @@ func (m *SubaccountOrderData) Reset()         { *m = SubaccountOrderData{} }
 func (m *SubaccountOrderData) String() string { return proto.CompactTextString(m) }
 func (*SubaccountOrderData) ProtoMessage()    {}
 func (*SubaccountOrderData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{19}
+	return fileDescriptor_2116e2804e9c53f9, []int{24}
 }
 func (m *SubaccountOrderData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1507,13 +2053,15 @@ type DerivativeLimitOrder struct {
 	// trigger_price is the trigger price used by stop/take orders
 	TriggerPrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price,omitempty"`
 	OrderHash    []byte                                  `protobuf:"bytes,6,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
+	// iceberg contains the hidden reserve of an iceberg order
+	Iceberg *IcebergOrderInfo `protobuf:"bytes,7,opt,name=iceberg,proto3" json:"iceberg,omitempty"`
 }
 
 func (m *DerivativeLimitOrder) Reset()         { *m = DerivativeLimitOrder{} }
 func (m *DerivativeLimitOrder) String() string { return proto.CompactTextString(m) }
 func (*DerivativeLimitOrder) ProtoMessage()    {}
 func (*DerivativeLimitOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{20}
+	return fileDescriptor_2116e2804e9c53f9, []int{25}
 }
 func (m *DerivativeLimitOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1563,6 +2111,57 @@ func (m *DerivativeLimitOrder) GetOrderHash() []byte {
 	return nil
 }
 
+func (m *DerivativeLimitOrder) GetIceberg() *IcebergOrderInfo {
+	if m != nil {
+		return m.Iceberg
+	}
+	return nil
+}
+
+type IcebergOrderInfo struct {
+	// visible_quantity is the quantity displayed on the orderbook after each refill
+	VisibleQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=visible_quantity,json=visibleQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"visible_quantity"`
+	// hidden_quantity is the remaining quantity not yet displayed on the orderbook
+	HiddenQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=hidden_quantity,json=hiddenQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"hidden_quantity"`
+	// hidden_margin is the margin reserved for the hidden quantity (derivatives only)
+	HiddenMargin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=hidden_margin,json=hiddenMargin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"hidden_margin"`
+	// hidden_balance_hold is the balance held for the hidden quantity
+	HiddenBalanceHold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=hidden_balance_hold,json=hiddenBalanceHold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"hidden_balance_hold"`
+}
+
+func (m *IcebergOrderInfo) Reset()         { *m = IcebergOrderInfo{} }
+func (m *IcebergOrderInfo) String() string { return proto.CompactTextString(m) }
+func (*IcebergOrderInfo) ProtoMessage()    {}
+func (*IcebergOrderInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{26}
+}
+func (m *IcebergOrderInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IcebergOrderInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IcebergOrderInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IcebergOrderInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IcebergOrderInfo.Merge(m, src)
+}
+func (m *IcebergOrderInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *IcebergOrderInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_IcebergOrderInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IcebergOrderInfo proto.InternalMessageInfo
+
 // A valid Derivative market order with Metadata.
 type DerivativeMarketOrder struct {
 	// order_info contains the information of the order
@@ -1580,7 +2179,7 @@ func (m *DerivativeMarketOrder) Reset()         { *m = DerivativeMarketOrder{} }
 func (m *DerivativeMarketOrder) String() string { return proto.CompactTextString(m) }
 func (*DerivativeMarketOrder) ProtoMessage()    {}
 func (*DerivativeMarketOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{21}
+	return fileDescriptor_2116e2804e9c53f9, []int{27}
 }
 func (m *DerivativeMarketOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1630,6 +2229,164 @@ func (m *DerivativeMarketOrder) GetOrderHash() []byte {
 	return nil
 }
 
+// A TWAP order, executed as a sequence of market order slices spread over multiple blocks.
+type TWAPOrder struct {
+	// market_id is the market the order is executed in
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// order_info contains the information of the parent order, the price being the worst execution price of each slice,
+	// or zero for an order without a limit price
+	OrderInfo OrderInfo `protobuf:"bytes,2,opt,name=order_info,json=orderInfo,proto3" json:"order_info"`
+	// order_type is the direction of the order, either BUY or SELL
+	OrderType OrderType `protobuf:"varint,3,opt,name=order_type,json=orderType,proto3,enum=injective.exchange.v1beta1.OrderType" json:"order_type,omitempty"`
+	// margin is the total margin of the order (derivatives only)
+	Margin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=margin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"margin"`
+	// slices is the number of child market orders the order is split into
+	Slices uint64 `protobuf:"varint,5,opt,name=slices,proto3" json:"slices,omitempty"`
+	// interval_blocks is the number of blocks between two slices
+	IntervalBlocks uint64 `protobuf:"varint,6,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// interval_seconds is the number of seconds between two slices
+	IntervalSeconds int64  `protobuf:"varint,7,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	OrderHash       []byte `protobuf:"bytes,8,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
+	// is_derivative is true if the order is executed in a derivative or binary options market
+	IsDerivative bool `protobuf:"varint,9,opt,name=is_derivative,json=isDerivative,proto3" json:"is_derivative,omitempty"`
+	// executed_slices is the number of slices already placed
+	ExecutedSlices uint64 `protobuf:"varint,10,opt,name=executed_slices,json=executedSlices,proto3" json:"executed_slices,omitempty"`
+	// next_slice_height is the first block height at which the next slice can be placed
+	NextSliceHeight int64 `protobuf:"varint,11,opt,name=next_slice_height,json=nextSliceHeight,proto3" json:"next_slice_height,omitempty"`
+	// next_slice_timestamp is the first block time at which the next slice can be placed
+	NextSliceTimestamp int64 `protobuf:"varint,12,opt,name=next_slice_timestamp,json=nextSliceTimestamp,proto3" json:"next_slice_timestamp,omitempty"`
+	// balance_hold is the balance still held for the slices not placed yet
+	BalanceHold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=balance_hold,json=balanceHold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"balance_hold"`
+	// filled_quantity is the aggregate quantity filled by the slices
+	FilledQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=filled_quantity,json=filledQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"filled_quantity"`
+	// filled_notional is the aggregate notional filled by the slices
+	FilledNotional github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=filled_notional,json=filledNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"filled_notional"`
+	// remaining_quantity is the quantity of the slices not placed yet
+	RemainingQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=remaining_quantity,json=remainingQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"remaining_quantity"`
+	// remaining_margin is the margin of the slices not placed yet (derivatives only)
+	RemainingMargin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=remaining_margin,json=remainingMargin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"remaining_margin"`
+	// max_slippage caps the worst price of each slice relative to the best price of the opposite side of the orderbook,
+	// only used by orders without a limit price
+	MaxSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=max_slippage,json=maxSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage"`
+	// pending_quantity is the quantity of the last placed slice not filled yet, carried into the remaining slices once
+	// the slice is settled
+	PendingQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=pending_quantity,json=pendingQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pending_quantity"`
+	// pending_margin is the margin share of the pending quantity (derivatives only)
+	PendingMargin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,20,opt,name=pending_margin,json=pendingMargin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pending_margin"`
+	// pending_balance_hold is the balance hold share of the pending quantity, charged again when it is carried
+	PendingBalanceHold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,21,opt,name=pending_balance_hold,json=pendingBalanceHold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pending_balance_hold"`
+}
+
+func (m *TWAPOrder) Reset()         { *m = TWAPOrder{} }
+func (m *TWAPOrder) String() string { return proto.CompactTextString(m) }
+func (*TWAPOrder) ProtoMessage()    {}
+func (*TWAPOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{28}
+}
+func (m *TWAPOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TWAPOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TWAPOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TWAPOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TWAPOrder.Merge(m, src)
+}
+func (m *TWAPOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *TWAPOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_TWAPOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TWAPOrder proto.InternalMessageInfo
+
+func (m *TWAPOrder) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+func (m *TWAPOrder) GetOrderInfo() OrderInfo {
+	if m != nil {
+		return m.OrderInfo
+	}
+	return OrderInfo{}
+}
+
+func (m *TWAPOrder) GetOrderType() OrderType {
+	if m != nil {
+		return m.OrderType
+	}
+	return OrderType_UNSPECIFIED
+}
+
+func (m *TWAPOrder) GetSlices() uint64 {
+	if m != nil {
+		return m.Slices
+	}
+	return 0
+}
+
+func (m *TWAPOrder) GetIntervalBlocks() uint64 {
+	if m != nil {
+		return m.IntervalBlocks
+	}
+	return 0
+}
+
+func (m *TWAPOrder) GetIntervalSeconds() int64 {
+	if m != nil {
+		return m.IntervalSeconds
+	}
+	return 0
+}
+
+func (m *TWAPOrder) GetOrderHash() []byte {
+	if m != nil {
+		return m.OrderHash
+	}
+	return nil
+}
+
+func (m *TWAPOrder) GetIsDerivative() bool {
+	if m != nil {
+		return m.IsDerivative
+	}
+	return false
+}
+
+func (m *TWAPOrder) GetExecutedSlices() uint64 {
+	if m != nil {
+		return m.ExecutedSlices
+	}
+	return 0
+}
+
+func (m *TWAPOrder) GetNextSliceHeight() int64 {
+	if m != nil {
+		return m.NextSliceHeight
+	}
+	return 0
+}
+
+func (m *TWAPOrder) GetNextSliceTimestamp() int64 {
+	if m != nil {
+		return m.NextSliceTimestamp
+	}
+	return 0
+}
+
 type Position struct {
 	IsLong                 bool                                   `protobuf:"varint,1,opt,name=isLong,proto3" json:"isLong,omitempty"`
 	Quantity               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
@@ -1642,7 +2399,7 @@ func (m *Position) Reset()         { *m = Position{} }
 func (m *Position) String() string { return proto.CompactTextString(m) }
 func (*Position) ProtoMessage()    {}
 func (*Position) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{22}
+	return fileDescriptor_2116e2804e9c53f9, []int{29}
 }
 func (m *Position) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1688,7 +2445,7 @@ func (m *MarketOrderIndicator) Reset()         { *m = MarketOrderIndicator{} }
 func (m *MarketOrderIndicator) String() string { return proto.CompactTextString(m) }
 func (*MarketOrderIndicator) ProtoMessage()    {}
 func (*MarketOrderIndicator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{23}
+	return fileDescriptor_2116e2804e9c53f9, []int{30}
 }
 func (m *MarketOrderIndicator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1745,7 +2502,7 @@ func (m *TradeLog) Reset()         { *m = TradeLog{} }
 func (m *TradeLog) String() string { return proto.CompactTextString(m) }
 func (*TradeLog) ProtoMessage()    {}
 func (*TradeLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{24}
+	return fileDescriptor_2116e2804e9c53f9, []int{31}
 }
 func (m *TradeLog) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1806,7 +2563,7 @@ func (m *PositionDelta) Reset()         { *m = PositionDelta{} }
 func (m *PositionDelta) String() string { return proto.CompactTextString(m) }
 func (*PositionDelta) ProtoMessage()    {}
 func (*PositionDelta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{25}
+	return fileDescriptor_2116e2804e9c53f9, []int{32}
 }
 func (m *PositionDelta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1849,13 +2606,15 @@ type DerivativeTradeLog struct {
 	Fee                 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee"`
 	OrderHash           []byte                                 `protobuf:"bytes,5,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
 	FeeRecipientAddress []byte                                 `protobuf:"bytes,6,opt,name=fee_recipient_address,json=feeRecipientAddress,proto3" json:"fee_recipient_address,omitempty"`
+	// the PnL realized by the closed position quantity, excluding the fee
+	Pnl github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=pnl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pnl"`
 }
 
 func (m *DerivativeTradeLog) Reset()         { *m = DerivativeTradeLog{} }
 func (m *DerivativeTradeLog) String() string { return proto.CompactTextString(m) }
 func (*DerivativeTradeLog) ProtoMessage()    {}
 func (*DerivativeTradeLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{26}
+	return fileDescriptor_2116e2804e9c53f9, []int{33}
 }
 func (m *DerivativeTradeLog) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1921,7 +2680,7 @@ func (m *SubaccountPosition) Reset()         { *m = SubaccountPosition{} }
 func (m *SubaccountPosition) String() string { return proto.CompactTextString(m) }
 func (*SubaccountPosition) ProtoMessage()    {}
 func (*SubaccountPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{27}
+	return fileDescriptor_2116e2804e9c53f9, []int{34}
 }
 func (m *SubaccountPosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1973,7 +2732,7 @@ func (m *SubaccountDeposit) Reset()         { *m = SubaccountDeposit{} }
 func (m *SubaccountDeposit) String() string { return proto.CompactTextString(m) }
 func (*SubaccountDeposit) ProtoMessage()    {}
 func (*SubaccountDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{28}
+	return fileDescriptor_2116e2804e9c53f9, []int{35}
 }
 func (m *SubaccountDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2025,7 +2784,7 @@ func (m *DepositUpdate) Reset()         { *m = DepositUpdate{} }
 func (m *DepositUpdate) String() string { return proto.CompactTextString(m) }
 func (*DepositUpdate) ProtoMessage()    {}
 func (*DepositUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{29}
+	return fileDescriptor_2116e2804e9c53f9, []int{36}
 }
 func (m *DepositUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2077,7 +2836,7 @@ func (m *PointsMultiplier) Reset()         { *m = PointsMultiplier{} }
 func (m *PointsMultiplier) String() string { return proto.CompactTextString(m) }
 func (*PointsMultiplier) ProtoMessage()    {}
 func (*PointsMultiplier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{30}
+	return fileDescriptor_2116e2804e9c53f9, []int{37}
 }
 func (m *PointsMultiplier) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2117,7 +2876,7 @@ func (m *TradingRewardCampaignBoostInfo) Reset()         { *m = TradingRewardCam
 func (m *TradingRewardCampaignBoostInfo) String() string { return proto.CompactTextString(m) }
 func (*TradingRewardCampaignBoostInfo) ProtoMessage()    {}
 func (*TradingRewardCampaignBoostInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{31}
+	return fileDescriptor_2116e2804e9c53f9, []int{38}
 }
 func (m *TradingRewardCampaignBoostInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2184,7 +2943,7 @@ func (m *CampaignRewardPool) Reset()         { *m = CampaignRewardPool{} }
 func (m *CampaignRewardPool) String() string { return proto.CompactTextString(m) }
 func (*CampaignRewardPool) ProtoMessage()    {}
 func (*CampaignRewardPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{32}
+	return fileDescriptor_2116e2804e9c53f9, []int{39}
 }
 func (m *CampaignRewardPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2242,7 +3001,7 @@ func (m *TradingRewardCampaignInfo) Reset()         { *m = TradingRewardCampaign
 func (m *TradingRewardCampaignInfo) String() string { return proto.CompactTextString(m) }
 func (*TradingRewardCampaignInfo) ProtoMessage()    {}
 func (*TradingRewardCampaignInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{33}
+	return fileDescriptor_2116e2804e9c53f9, []int{40}
 }
 func (m *TradingRewardCampaignInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2310,7 +3069,7 @@ func (m *FeeDiscountTierInfo) Reset()         { *m = FeeDiscountTierInfo{} }
 func (m *FeeDiscountTierInfo) String() string { return proto.CompactTextString(m) }
 func (*FeeDiscountTierInfo) ProtoMessage()    {}
 func (*FeeDiscountTierInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{34}
+	return fileDescriptor_2116e2804e9c53f9, []int{41}
 }
 func (m *FeeDiscountTierInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2354,7 +3113,7 @@ func (m *FeeDiscountSchedule) Reset()         { *m = FeeDiscountSchedule{} }
 func (m *FeeDiscountSchedule) String() string { return proto.CompactTextString(m) }
 func (*FeeDiscountSchedule) ProtoMessage()    {}
 func (*FeeDiscountSchedule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{35}
+	return fileDescriptor_2116e2804e9c53f9, []int{42}
 }
 func (m *FeeDiscountSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2427,7 +3186,7 @@ func (m *FeeDiscountTierTTL) Reset()         { *m = FeeDiscountTierTTL{} }
 func (m *FeeDiscountTierTTL) String() string { return proto.CompactTextString(m) }
 func (*FeeDiscountTierTTL) ProtoMessage()    {}
 func (*FeeDiscountTierTTL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{36}
+	return fileDescriptor_2116e2804e9c53f9, []int{43}
 }
 func (m *FeeDiscountTierTTL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2479,7 +3238,7 @@ func (m *VolumeRecord) Reset()         { *m = VolumeRecord{} }
 func (m *VolumeRecord) String() string { return proto.CompactTextString(m) }
 func (*VolumeRecord) ProtoMessage()    {}
 func (*VolumeRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{37}
+	return fileDescriptor_2116e2804e9c53f9, []int{44}
 }
 func (m *VolumeRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2517,7 +3276,7 @@ func (m *AccountRewards) Reset()         { *m = AccountRewards{} }
 func (m *AccountRewards) String() string { return proto.CompactTextString(m) }
 func (*AccountRewards) ProtoMessage()    {}
 func (*AccountRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{38}
+	return fileDescriptor_2116e2804e9c53f9, []int{45}
 }
 func (m *AccountRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2569,7 +3328,7 @@ func (m *TradeRecords) Reset()         { *m = TradeRecords{} }
 func (m *TradeRecords) String() string { return proto.CompactTextString(m) }
 func (*TradeRecords) ProtoMessage()    {}
 func (*TradeRecords) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{39}
+	return fileDescriptor_2116e2804e9c53f9, []int{46}
 }
 func (m *TradeRecords) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2620,7 +3379,7 @@ func (m *SubaccountIDs) Reset()         { *m = SubaccountIDs{} }
 func (m *SubaccountIDs) String() string { return proto.CompactTextString(m) }
 func (*SubaccountIDs) ProtoMessage()    {}
 func (*SubaccountIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{40}
+	return fileDescriptor_2116e2804e9c53f9, []int{47}
 }
 func (m *SubaccountIDs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2666,7 +3425,7 @@ func (m *TradeRecord) Reset()         { *m = TradeRecord{} }
 func (m *TradeRecord) String() string { return proto.CompactTextString(m) }
 func (*TradeRecord) ProtoMessage()    {}
 func (*TradeRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{41}
+	return fileDescriptor_2116e2804e9c53f9, []int{48}
 }
 func (m *TradeRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2702,6 +3461,45 @@ func (m *TradeRecord) GetTimestamp() int64 {
 	return 0
 }
 
+type MarketTradeRecordRetention struct {
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// how long the trade records of the market are kept, in seconds
+	RetentionSeconds int64 `protobuf:"varint,2,opt,name=retention_seconds,json=retentionSeconds,proto3" json:"retention_seconds,omitempty"`
+}
+
+func (m *MarketTradeRecordRetention) Reset()         { *m = MarketTradeRecordRetention{} }
+func (m *MarketTradeRecordRetention) String() string { return proto.CompactTextString(m) }
+func (*MarketTradeRecordRetention) ProtoMessage()    {}
+func (*MarketTradeRecordRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{49}
+}
+func (m *MarketTradeRecordRetention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketTradeRecordRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketTradeRecordRetention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketTradeRecordRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketTradeRecordRetention.Merge(m, src)
+}
+func (m *MarketTradeRecordRetention) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketTradeRecordRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketTradeRecordRetention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketTradeRecordRetention proto.InternalMessageInfo
+
 type Level struct {
 	// price
 	P github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=p,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p"`
@@ -2713,7 +3511,7 @@ func (m *Level) Reset()         { *m = Level{} }
 func (m *Level) String() string { return proto.CompactTextString(m) }
 func (*Level) ProtoMessage()    {}
 func (*Level) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{42}
+	return fileDescriptor_2116e2804e9c53f9, []int{50}
 }
 func (m *Level) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2751,7 +3549,7 @@ func (m *AggregateSubaccountVolumeRecord) Reset()         { *m = AggregateSubacc
 func (m *AggregateSubaccountVolumeRecord) String() string { return proto.CompactTextString(m) }
 func (*AggregateSubaccountVolumeRecord) ProtoMessage()    {}
 func (*AggregateSubaccountVolumeRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{43}
+	return fileDescriptor_2116e2804e9c53f9, []int{51}
 }
 func (m *AggregateSubaccountVolumeRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2803,7 +3601,7 @@ func (m *AggregateAccountVolumeRecord) Reset()         { *m = AggregateAccountVo
 func (m *AggregateAccountVolumeRecord) String() string { return proto.CompactTextString(m) }
 func (*AggregateAccountVolumeRecord) ProtoMessage()    {}
 func (*AggregateAccountVolumeRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{44}
+	return fileDescriptor_2116e2804e9c53f9, []int{52}
 }
 func (m *AggregateAccountVolumeRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2855,7 +3653,7 @@ func (m *MarketVolume) Reset()         { *m = MarketVolume{} }
 func (m *MarketVolume) String() string { return proto.CompactTextString(m) }
 func (*MarketVolume) ProtoMessage()    {}
 func (*MarketVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{45}
+	return fileDescriptor_2116e2804e9c53f9, []int{53}
 }
 func (m *MarketVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2907,7 +3705,7 @@ func (m *DenomDecimals) Reset()         { *m = DenomDecimals{} }
 func (m *DenomDecimals) String() string { return proto.CompactTextString(m) }
 func (*DenomDecimals) ProtoMessage()    {}
 func (*DenomDecimals) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2116e2804e9c53f9, []int{46}
+	return fileDescriptor_2116e2804e9c53f9, []int{54}
 }
 func (m *DenomDecimals) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2950,671 +3748,1065 @@ func (m *DenomDecimals) GetDecimals() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("injective.exchange.v1beta1.AtomicMarketOrderAccessLevel", AtomicMarketOrderAccessLevel_name, AtomicMarketOrderAccessLevel_value)
-	proto.RegisterEnum("injective.exchange.v1beta1.MarketStatus", MarketStatus_name, MarketStatus_value)
-	proto.RegisterEnum("injective.exchange.v1beta1.OrderType", OrderType_name, OrderType_value)
-	proto.RegisterEnum("injective.exchange.v1beta1.ExecutionType", ExecutionType_name, ExecutionType_value)
-	proto.RegisterEnum("injective.exchange.v1beta1.OrderMask", OrderMask_name, OrderMask_value)
-	proto.RegisterType((*Params)(nil), "injective.exchange.v1beta1.Params")
-	proto.RegisterType((*MarketFeeMultiplier)(nil), "injective.exchange.v1beta1.MarketFeeMultiplier")
-	proto.RegisterType((*DerivativeMarket)(nil), "injective.exchange.v1beta1.DerivativeMarket")
-	proto.RegisterType((*BinaryOptionsMarket)(nil), "injective.exchange.v1beta1.BinaryOptionsMarket")
-	proto.RegisterType((*ExpiryFuturesMarketInfo)(nil), "injective.exchange.v1beta1.ExpiryFuturesMarketInfo")
-	proto.RegisterType((*PerpetualMarketInfo)(nil), "injective.exchange.v1beta1.PerpetualMarketInfo")
-	proto.RegisterType((*PerpetualMarketFunding)(nil), "injective.exchange.v1beta1.PerpetualMarketFunding")
-	proto.RegisterType((*DerivativeMarketSettlementInfo)(nil), "injective.exchange.v1beta1.DerivativeMarketSettlementInfo")
-	proto.RegisterType((*NextFundingTimestamp)(nil), "injective.exchange.v1beta1.NextFundingTimestamp")
-	proto.RegisterType((*SpotMarket)(nil), "injective.exchange.v1beta1.SpotMarket")
-	proto.RegisterType((*Deposit)(nil), "injective.exchange.v1beta1.Deposit")
-	proto.RegisterType((*SubaccountTradeNonce)(nil), "injective.exchange.v1beta1.SubaccountTradeNonce")
-	proto.RegisterType((*OrderInfo)(nil), "injective.exchange.v1beta1.OrderInfo")
-	proto.RegisterType((*SpotOrder)(nil), "injective.exchange.v1beta1.SpotOrder")
-	proto.RegisterType((*SpotLimitOrder)(nil), "injective.exchange.v1beta1.SpotLimitOrder")
-	proto.RegisterType((*SpotMarketOrder)(nil), "injective.exchange.v1beta1.SpotMarketOrder")
-	proto.RegisterType((*DerivativeOrder)(nil), "injective.exchange.v1beta1.DerivativeOrder")
-	proto.RegisterType((*SubaccountOrderbookMetadata)(nil), "injective.exchange.v1beta1.SubaccountOrderbookMetadata")
-	proto.RegisterType((*SubaccountOrder)(nil), "injective.exchange.v1beta1.SubaccountOrder")
-	proto.RegisterType((*SubaccountOrderData)(nil), "injective.exchange.v1beta1.SubaccountOrderData")
-	proto.RegisterType((*DerivativeLimitOrder)(nil), "injective.exchange.v1beta1.DerivativeLimitOrder")
-	proto.RegisterType((*DerivativeMarketOrder)(nil), "injective.exchange.v1beta1.DerivativeMarketOrder")
-	proto.RegisterType((*Position)(nil), "injective.exchange.v1beta1.Position")
-	proto.RegisterType((*MarketOrderIndicator)(nil), "injective.exchange.v1beta1.MarketOrderIndicator")
-	proto.RegisterType((*TradeLog)(nil), "injective.exchange.v1beta1.TradeLog")
-	proto.RegisterType((*PositionDelta)(nil), "injective.exchange.v1beta1.PositionDelta")
-	proto.RegisterType((*DerivativeTradeLog)(nil), "injective.exchange.v1beta1.DerivativeTradeLog")
-	proto.RegisterType((*SubaccountPosition)(nil), "injective.exchange.v1beta1.SubaccountPosition")
-	proto.RegisterType((*SubaccountDeposit)(nil), "injective.exchange.v1beta1.SubaccountDeposit")
-	proto.RegisterType((*DepositUpdate)(nil), "injective.exchange.v1beta1.DepositUpdate")
-	proto.RegisterType((*PointsMultiplier)(nil), "injective.exchange.v1beta1.PointsMultiplier")
-	proto.RegisterType((*TradingRewardCampaignBoostInfo)(nil), "injective.exchange.v1beta1.TradingRewardCampaignBoostInfo")
-	proto.RegisterType((*CampaignRewardPool)(nil), "injective.exchange.v1beta1.CampaignRewardPool")
-	proto.RegisterType((*TradingRewardCampaignInfo)(nil), "injective.exchange.v1beta1.TradingRewardCampaignInfo")
-	proto.RegisterType((*FeeDiscountTierInfo)(nil), "injective.exchange.v1beta1.FeeDiscountTierInfo")
-	proto.RegisterType((*FeeDiscountSchedule)(nil), "injective.exchange.v1beta1.FeeDiscountSchedule")
-	proto.RegisterType((*FeeDiscountTierTTL)(nil), "injective.exchange.v1beta1.FeeDiscountTierTTL")
-	proto.RegisterType((*VolumeRecord)(nil), "injective.exchange.v1beta1.VolumeRecord")
-	proto.RegisterType((*AccountRewards)(nil), "injective.exchange.v1beta1.AccountRewards")
-	proto.RegisterType((*TradeRecords)(nil), "injective.exchange.v1beta1.TradeRecords")
-	proto.RegisterType((*SubaccountIDs)(nil), "injective.exchange.v1beta1.SubaccountIDs")
-	proto.RegisterType((*TradeRecord)(nil), "injective.exchange.v1beta1.TradeRecord")
-	proto.RegisterType((*Level)(nil), "injective.exchange.v1beta1.Level")
-	proto.RegisterType((*AggregateSubaccountVolumeRecord)(nil), "injective.exchange.v1beta1.AggregateSubaccountVolumeRecord")
-	proto.RegisterType((*AggregateAccountVolumeRecord)(nil), "injective.exchange.v1beta1.AggregateAccountVolumeRecord")
-	proto.RegisterType((*MarketVolume)(nil), "injective.exchange.v1beta1.MarketVolume")
-	proto.RegisterType((*DenomDecimals)(nil), "injective.exchange.v1beta1.DenomDecimals")
+// TerminalOrder is the final state of a limit order which left the orderbook
+type TerminalOrder struct {
+	OrderHash    string `protobuf:"bytes,1,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
+	MarketId     string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	SubaccountId string `protobuf:"bytes,3,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	// whether the order was placed in a spot market, otherwise in a derivative or binary options market
+	IsSpot         bool                                   `protobuf:"varint,4,opt,name=is_spot,json=isSpot,proto3" json:"is_spot,omitempty"`
+	OrderType      OrderType                              `protobuf:"varint,5,opt,name=order_type,json=orderType,proto3,enum=injective.exchange.v1beta1.OrderType" json:"order_type,omitempty"`
+	Price          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Quantity       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	Status         TerminalOrderStatus                    `protobuf:"varint,8,opt,name=status,proto3,enum=injective.exchange.v1beta1.TerminalOrderStatus" json:"status,omitempty"`
+	Reason         OrderTerminationReason                 `protobuf:"varint,9,opt,name=reason,proto3,enum=injective.exchange.v1beta1.OrderTerminationReason" json:"reason,omitempty"`
+	FilledQuantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=filled_quantity,json=filledQuantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"filled_quantity"`
+	// the average price of the fills, zero if the order wasn't filled
+	AveragePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=average_price,json=averagePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"average_price"`
+	// the height of the block in which the order left the orderbook
+	BlockHeight int64 `protobuf:"varint,12,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Timestamp   int64 `protobuf:"varint,13,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *TerminalOrder) Reset()         { *m = TerminalOrder{} }
+func (m *TerminalOrder) String() string { return proto.CompactTextString(m) }
+func (*TerminalOrder) ProtoMessage()    {}
+func (*TerminalOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{55}
+}
+func (m *TerminalOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() {
-	proto.RegisterFile("injective/exchange/v1beta1/exchange.proto", fileDescriptor_2116e2804e9c53f9)
+func (m *TerminalOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TerminalOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-var fileDescriptor_2116e2804e9c53f9 = []byte{
-	// 4022 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x4d, 0x6c, 0x1c, 0x59,
-	0x5e, 0x4f, 0x75, 0xb7, 0xed, 0xf6, 0xdf, 0xdd, 0xed, 0xce, 0x73, 0xc7, 0xee, 0x38, 0x89, 0xdd,
-	0xd3, 0x99, 0x4c, 0x3c, 0x99, 0x1d, 0x67, 0x27, 0xc0, 0x6a, 0x18, 0xb1, 0x52, 0xfc, 0x39, 0xe9,
-	0x59, 0x7f, 0x4d, 0x75, 0x67, 0x56, 0x61, 0x35, 0x5b, 0xfb, 0x5c, 0xf5, 0xec, 0x7e, 0x93, 0xea,
-	0xaa, 0x4e, 0xd5, 0x6b, 0xc7, 0x5e, 0x84, 0x84, 0x58, 0x84, 0x58, 0x0b, 0x69, 0x80, 0x03, 0x70,
-	0xb1, 0xb4, 0x07, 0x2e, 0x70, 0x00, 0x0e, 0x88, 0xcb, 0xc0, 0x99, 0x3d, 0xce, 0x11, 0xa1, 0x65,
-	0x41, 0x99, 0x03, 0x88, 0x03, 0x12, 0xdc, 0x10, 0x12, 0x42, 0xef, 0xa3, 0x3e, 0xfa, 0xc3, 0x1d,
-	0x4f, 0xb9, 0xa3, 0x1d, 0xd0, 0x9e, 0xdc, 0xef, 0xeb, 0xf7, 0x7f, 0xef, 0xff, 0xfd, 0x3e, 0xca,
-	0xf0, 0x26, 0x75, 0x3e, 0x21, 0x26, 0xa3, 0x47, 0xe4, 0x3e, 0x39, 0x36, 0x9b, 0xd8, 0x39, 0x24,
-	0xf7, 0x8f, 0xde, 0xd9, 0x27, 0x0c, 0xbf, 0x13, 0x56, 0x2c, 0xb7, 0x3d, 0x97, 0xb9, 0x68, 0x3e,
-	0xec, 0xba, 0x1c, 0xb6, 0xa8, 0xae, 0xf3, 0xa5, 0x43, 0xf7, 0xd0, 0x15, 0xdd, 0xee, 0xf3, 0x5f,
-	0x72, 0xc4, 0xfc, 0x82, 0xe9, 0xfa, 0x2d, 0xd7, 0xbf, 0xbf, 0x8f, 0xfd, 0x08, 0xd5, 0x74, 0xa9,
-	0xa3, 0xda, 0xef, 0x44, 0xc4, 0x5d, 0x0f, 0x9b, 0x76, 0xd4, 0x49, 0x16, 0x65, 0xb7, 0xea, 0xe7,
-	0x25, 0x18, 0xdf, 0xc3, 0x1e, 0x6e, 0xf9, 0x88, 0xc0, 0xa2, 0xdf, 0x76, 0x99, 0xd1, 0xc2, 0xde,
-	0x53, 0xc2, 0x0c, 0xea, 0xf8, 0x0c, 0x3b, 0xcc, 0xb0, 0xa9, 0xcf, 0xa8, 0x73, 0x68, 0x1c, 0x10,
-	0x52, 0xd6, 0x2a, 0xda, 0xd2, 0xd4, 0x83, 0xeb, 0xcb, 0x92, 0xf6, 0x32, 0xa7, 0x1d, 0x4c, 0x73,
-	0x79, 0xcd, 0xa5, 0xce, 0x6a, 0xe6, 0xc7, 0x3f, 0x5d, 0xbc, 0xa2, 0xdf, 0xe0, 0x38, 0xdb, 0x02,
-	0xa6, 0x26, 0x51, 0xb6, 0x24, 0xc8, 0x26, 0x21, 0xe8, 0x19, 0xdc, 0xb1, 0x88, 0x47, 0x8f, 0x30,
-	0x9f, 0xdb, 0x30, 0x62, 0xa9, 0x8b, 0x11, 0x7b, 0x2d, 0x42, 0x3b, 0x8f, 0xa4, 0x0d, 0x37, 0x2c,
-	0x72, 0x80, 0x3b, 0x36, 0x33, 0xd4, 0x0a, 0x9f, 0x12, 0x8f, 0xd3, 0x30, 0x3c, 0xcc, 0x48, 0x39,
-	0x5d, 0xd1, 0x96, 0x26, 0x57, 0x97, 0x39, 0xda, 0x3f, 0xfc, 0x74, 0xf1, 0x8d, 0x43, 0xca, 0x9a,
-	0x9d, 0xfd, 0x65, 0xd3, 0x6d, 0xdd, 0x57, 0x3c, 0x96, 0x7f, 0xde, 0xf6, 0xad, 0xa7, 0xf7, 0xd9,
-	0x49, 0x9b, 0xf8, 0xcb, 0xeb, 0xc4, 0xd4, 0xe7, 0x14, 0x64, 0x5d, 0xac, 0xf5, 0x29, 0xf1, 0x36,
-	0x09, 0xd1, 0x31, 0xeb, 0xa7, 0xc6, 0xba, 0xa9, 0x65, 0x2e, 0x4d, 0xad, 0x11, 0xa7, 0x76, 0x0c,
-	0xaf, 0x05, 0xd4, 0xba, 0xd8, 0xda, 0x45, 0x73, 0x2c, 0x11, 0xcd, 0x5b, 0x0a, 0x78, 0x3d, 0xc6,
-	0xe0, 0x97, 0x52, 0xee, 0x59, 0xed, 0xf8, 0x88, 0x28, 0x77, 0xad, 0xd9, 0x85, 0x9b, 0x01, 0x65,
-	0xea, 0x50, 0x46, 0xb1, 0xcd, 0xf5, 0xe8, 0x90, 0x3a, 0x9c, 0x26, 0x75, 0xcb, 0x13, 0x89, 0x88,
-	0x5e, 0x57, 0x98, 0x35, 0x09, 0xb9, 0x2d, 0x10, 0x75, 0x0e, 0x88, 0x9e, 0x43, 0x25, 0x20, 0xd8,
-	0xc2, 0xd4, 0x61, 0xc4, 0xc1, 0x8e, 0x49, 0xba, 0x89, 0x66, 0x2f, 0xb5, 0xd2, 0xed, 0x08, 0x36,
-	0x4e, 0xf8, 0x5d, 0x28, 0x07, 0x84, 0x0f, 0x3a, 0x8e, 0xc5, 0x4d, 0x83, 0xf7, 0xf3, 0x8e, 0xb0,
-	0x5d, 0x9e, 0xac, 0x68, 0x4b, 0x69, 0x7d, 0x56, 0xb5, 0x6f, 0xca, 0xe6, 0x9a, 0x6a, 0x45, 0x6f,
-	0x42, 0x31, 0x18, 0xd1, 0xea, 0xd8, 0x8c, 0xb6, 0x6d, 0x52, 0x06, 0x31, 0x62, 0x5a, 0xd5, 0x6f,
-	0xab, 0x6a, 0x64, 0xc2, 0xac, 0x47, 0x6c, 0x7c, 0xa2, 0xe4, 0xe6, 0x37, 0xb1, 0xa7, 0xa4, 0x37,
-	0x95, 0x68, 0x4d, 0x33, 0x0a, 0x6d, 0x93, 0x90, 0x3a, 0xc7, 0x12, 0x32, 0x63, 0xb0, 0x18, 0xac,
-	0xa4, 0xe9, 0x76, 0x3c, 0xfb, 0x24, 0x5c, 0x10, 0xa7, 0x64, 0x98, 0xb8, 0x5d, 0xce, 0x25, 0xa2,
-	0x16, 0x18, 0xdb, 0x23, 0x81, 0xaa, 0xd8, 0xc0, 0x49, 0xae, 0xe1, 0x76, 0x5c, 0x53, 0x14, 0x55,
-	0xc1, 0x3e, 0xe2, 0x33, 0xb9, 0xc0, 0xfc, 0xa5, 0x34, 0x45, 0x92, 0xac, 0x29, 0x44, 0xb1, 0xcc,
-	0x75, 0x58, 0x6c, 0xe1, 0xe3, 0xb8, 0x41, 0xb8, 0x9e, 0x45, 0x3c, 0xc3, 0xa7, 0x16, 0x31, 0x4c,
-	0xb7, 0xe3, 0xb0, 0x72, 0xa1, 0xa2, 0x2d, 0xe5, 0xf5, 0x1b, 0x2d, 0x7c, 0x1c, 0xa9, 0xf7, 0x2e,
-	0xef, 0x54, 0xa7, 0x16, 0x59, 0xe3, 0x5d, 0xd0, 0x6f, 0x69, 0x70, 0x97, 0x3a, 0x9f, 0x18, 0x1e,
-	0x79, 0x8e, 0x3d, 0xcb, 0xf0, 0xb9, 0x51, 0x59, 0x86, 0x47, 0x9e, 0x75, 0xa8, 0x47, 0x5a, 0xc4,
-	0x61, 0x06, 0x6b, 0x7a, 0xc4, 0x6f, 0xba, 0xb6, 0x55, 0x9e, 0xfe, 0xd2, 0x4b, 0xa8, 0x39, 0x4c,
-	0xbf, 0x4d, 0x9d, 0x4f, 0x74, 0x81, 0x5e, 0x17, 0xe0, 0x7a, 0x84, 0xdd, 0x08, 0xa0, 0xd1, 0xfb,
-	0x50, 0x61, 0x1e, 0x96, 0x42, 0x12, 0x7d, 0x7d, 0xe3, 0x88, 0x48, 0x07, 0x6d, 0x75, 0x84, 0xd6,
-	0x3b, 0xe5, 0xa2, 0xd0, 0xa9, 0x5b, 0xaa, 0x9f, 0x84, 0xf4, 0x3f, 0x92, 0xbd, 0xd6, 0x55, 0x27,
-	0x2e, 0x06, 0x9b, 0x3e, 0xeb, 0x50, 0x0b, 0x33, 0xd7, 0x0b, 0x57, 0x15, 0xe9, 0xd9, 0xd5, 0x64,
-	0x62, 0x88, 0x30, 0xd5, 0x52, 0x42, 0x6d, 0x3b, 0x86, 0x37, 0xf7, 0xa9, 0x83, 0xbd, 0x13, 0xc3,
-	0x6d, 0xf3, 0x19, 0xf8, 0xc3, 0x02, 0x0d, 0xba, 0x58, 0xa0, 0x79, 0x5d, 0x22, 0xee, 0x4a, 0xc0,
-	0xf3, 0x62, 0xcd, 0x6f, 0x68, 0x50, 0xc1, 0xcc, 0x6d, 0x51, 0x33, 0x20, 0x29, 0x15, 0x00, 0x9b,
-	0x26, 0xf1, 0x7d, 0xc3, 0x26, 0x47, 0xc4, 0x2e, 0xcf, 0x54, 0xb4, 0xa5, 0xc2, 0x83, 0x77, 0x97,
-	0xcf, 0x8f, 0xfa, 0xcb, 0x2b, 0x02, 0x43, 0x52, 0x11, 0xda, 0xb1, 0x22, 0x00, 0xb6, 0xf8, 0x78,
-	0xfd, 0x26, 0x1e, 0xd2, 0x8a, 0x7e, 0xa0, 0xc1, 0x5d, 0x11, 0x79, 0x06, 0xcd, 0x83, 0x5b, 0xb8,
-	0x72, 0x08, 0x94, 0x78, 0xe5, 0x52, 0x22, 0xce, 0x57, 0x39, 0x7c, 0xdf, 0x0c, 0x37, 0x09, 0xd9,
-	0x0e, 0x91, 0xd1, 0xa7, 0x1a, 0xbc, 0x1d, 0x33, 0x83, 0x0b, 0xcc, 0xe5, 0x5a, 0xa2, 0xb9, 0x2c,
-	0x45, 0x44, 0x5e, 0x32, 0xa3, 0x3f, 0xd4, 0xe0, 0x9d, 0x1e, 0xad, 0xb8, 0xc0, 0xac, 0x66, 0x13,
-	0xcd, 0xea, 0xad, 0x2e, 0x65, 0x79, 0xc9, 0xc4, 0x28, 0x5c, 0x6f, 0x51, 0x87, 0xb6, 0xb0, 0x6d,
-	0x88, 0xac, 0xcc, 0x74, 0xed, 0x28, 0x82, 0xce, 0x25, 0xa2, 0x3f, 0xab, 0x00, 0xf7, 0x14, 0x5e,
-	0x10, 0x3a, 0xbf, 0x03, 0x6f, 0x51, 0x3f, 0xb4, 0x82, 0xfe, 0x44, 0xcc, 0xc6, 0x1d, 0xc7, 0x6c,
-	0x1a, 0xc4, 0xc1, 0xfb, 0x36, 0xb1, 0xca, 0xe5, 0x8a, 0xb6, 0x94, 0xd5, 0xdf, 0xa0, 0xbe, 0x52,
-	0xf4, 0xf5, 0x9e, 0x5c, 0x6b, 0x4b, 0x74, 0xdf, 0x90, 0xbd, 0xdf, 0xcb, 0xfc, 0xeb, 0x8f, 0x16,
-	0xb5, 0xea, 0xa7, 0x1a, 0xcc, 0xc8, 0xd6, 0xee, 0x55, 0xde, 0x80, 0xc9, 0xc0, 0x08, 0x2d, 0x91,
-	0x49, 0x4e, 0xea, 0x59, 0x59, 0x51, 0xb3, 0xd0, 0x63, 0x28, 0xf4, 0xf0, 0x3d, 0x95, 0x68, 0xdd,
-	0xf9, 0x83, 0x38, 0xcd, 0xf7, 0x32, 0xbf, 0xf3, 0xa3, 0xc5, 0x2b, 0xd5, 0x3f, 0xcf, 0x42, 0xb1,
-	0x77, 0xe6, 0x68, 0x16, 0xc6, 0x19, 0x35, 0x9f, 0x12, 0x4f, 0xcd, 0x45, 0x95, 0xd0, 0x22, 0x4c,
-	0xc9, 0x0c, 0xd9, 0xe0, 0x8e, 0x40, 0x4e, 0x43, 0x07, 0x59, 0xb5, 0x8a, 0x7d, 0x82, 0x5e, 0x83,
-	0x9c, 0xea, 0xf0, 0xac, 0xe3, 0x06, 0xe9, 0xa3, 0xae, 0x06, 0x7d, 0xc8, 0xab, 0xd0, 0x46, 0x88,
-	0xc1, 0x67, 0x26, 0x52, 0xbe, 0xc2, 0x83, 0xd7, 0x63, 0xe6, 0xae, 0x72, 0xf0, 0xc0, 0xd8, 0x77,
-	0x45, 0xb1, 0x71, 0xd2, 0x26, 0x01, 0x25, 0xfe, 0x1b, 0x2d, 0xc3, 0x8c, 0x82, 0xf1, 0x4d, 0x6c,
-	0x13, 0xe3, 0x00, 0x9b, 0xcc, 0xf5, 0x44, 0x36, 0x97, 0xd7, 0xaf, 0xca, 0xa6, 0x3a, 0x6f, 0xd9,
-	0x14, 0x0d, 0x7c, 0xea, 0x62, 0x4a, 0x86, 0x45, 0x1c, 0xb7, 0x25, 0x73, 0x2f, 0x1d, 0x44, 0xd5,
-	0x3a, 0xaf, 0xe9, 0x16, 0xc1, 0x44, 0x8f, 0x08, 0xbe, 0x07, 0xa5, 0x81, 0xd9, 0x54, 0xb2, 0xc4,
-	0x06, 0xd1, 0xfe, 0x34, 0xaa, 0x09, 0xe5, 0x73, 0xd3, 0xa7, 0xc9, 0x84, 0x6a, 0x3e, 0x38, 0x6f,
-	0x6a, 0x40, 0xa1, 0x27, 0x05, 0x86, 0x44, 0xf8, 0xb9, 0x56, 0x3c, 0xef, 0x6c, 0x40, 0xa1, 0x27,
-	0xbd, 0x4d, 0x96, 0x20, 0xe5, 0x58, 0x1c, 0xf5, 0xfc, 0xf4, 0x2b, 0x37, 0xba, 0xf4, 0xab, 0x02,
-	0x53, 0xd4, 0xdf, 0x23, 0x5e, 0x9b, 0xb0, 0x0e, 0xb6, 0x45, 0xde, 0x93, 0xd5, 0xe3, 0x55, 0xe8,
-	0x21, 0x8c, 0xfb, 0x0c, 0xb3, 0x8e, 0x2f, 0x12, 0x94, 0xc2, 0x83, 0xa5, 0x61, 0xd1, 0x49, 0xda,
-	0x50, 0x5d, 0xf4, 0xd7, 0xd5, 0x38, 0xf4, 0x31, 0xcc, 0xb4, 0xa8, 0x63, 0xb4, 0x3d, 0x6a, 0x12,
-	0x83, 0x5b, 0x93, 0xe1, 0xd3, 0xef, 0x93, 0x04, 0x09, 0x0a, 0x5f, 0x45, 0xb1, 0x45, 0x9d, 0x3d,
-	0x8e, 0xd4, 0xa0, 0xe6, 0xd3, 0x3a, 0xfd, 0xbe, 0xe0, 0x13, 0x87, 0x7f, 0xd6, 0xc1, 0x0e, 0xa3,
-	0xec, 0x24, 0x46, 0xa1, 0x98, 0x8c, 0x4f, 0x2d, 0xea, 0x7c, 0xa8, 0xc0, 0x02, 0x22, 0xca, 0x61,
-	0xfc, 0x49, 0x16, 0x66, 0x56, 0xfb, 0xa3, 0xfd, 0xb9, 0x3e, 0xe3, 0x36, 0xe4, 0x03, 0x43, 0x3d,
-	0x69, 0xed, 0xbb, 0xb6, 0xf2, 0x1a, 0xca, 0x4f, 0xd4, 0x45, 0x1d, 0xba, 0x0b, 0xd3, 0xaa, 0x53,
-	0xdb, 0x73, 0x8f, 0xa8, 0x45, 0x3c, 0xe5, 0x3a, 0x0a, 0xb2, 0x7a, 0x4f, 0xd5, 0xfe, 0xac, 0xbc,
-	0xc7, 0x3b, 0x50, 0x22, 0xc7, 0x6d, 0x2a, 0x53, 0x36, 0x83, 0xd1, 0x16, 0xf1, 0x19, 0x6e, 0xb5,
-	0x85, 0x1b, 0x49, 0xeb, 0x33, 0x51, 0x5b, 0x23, 0x68, 0xe2, 0x43, 0x7c, 0xc2, 0x98, 0xad, 0x72,
-	0xd2, 0x70, 0xc8, 0x84, 0x1c, 0x12, 0xb5, 0x45, 0x43, 0x4a, 0x30, 0x86, 0xad, 0x16, 0x75, 0xa4,
-	0x5b, 0xd1, 0x65, 0xa1, 0xd7, 0x73, 0x4d, 0x0e, 0xf7, 0x5c, 0xd0, 0xe3, 0xb9, 0xfa, 0xad, 0x7d,
-	0xea, 0x95, 0x58, 0x7b, 0xee, 0x95, 0x5a, 0x7b, 0x7e, 0x74, 0xd6, 0xfe, 0x73, 0x5b, 0xe6, 0x44,
-	0x9e, 0x40, 0x31, 0xa6, 0x9d, 0x62, 0x29, 0xb1, 0x9d, 0x86, 0xf6, 0x25, 0xe0, 0xa7, 0x23, 0x1c,
-	0xb1, 0x0e, 0xe5, 0x26, 0xfe, 0x3b, 0x05, 0x73, 0x1b, 0xdc, 0x2c, 0x4e, 0x36, 0x3b, 0xac, 0xe3,
-	0x91, 0x70, 0x53, 0x70, 0xe0, 0x0e, 0xcf, 0x76, 0xce, 0x33, 0xb5, 0xd4, 0xf9, 0xa6, 0xf6, 0x75,
-	0x28, 0xb1, 0xe7, 0xb8, 0xcd, 0xf7, 0x82, 0x5e, 0xdc, 0xd4, 0xd2, 0x62, 0x08, 0xe2, 0x6d, 0x75,
-	0xde, 0x14, 0x8d, 0xf8, 0x4d, 0x0d, 0xde, 0x88, 0x53, 0x89, 0x46, 0x4b, 0xa9, 0x9a, 0x9d, 0x56,
-	0xc7, 0x16, 0x19, 0x51, 0xc2, 0x33, 0xa9, 0x6a, 0x6c, 0x9e, 0x01, 0x79, 0xc1, 0x9e, 0xb5, 0x10,
-	0x79, 0xa0, 0x0c, 0x92, 0x9d, 0x46, 0xf5, 0xca, 0xa0, 0xfa, 0x93, 0x14, 0xcc, 0x84, 0xe1, 0xeb,
-	0xa2, 0x9c, 0x27, 0x30, 0x77, 0xde, 0xf1, 0x43, 0xb2, 0x84, 0xb3, 0xd4, 0x1c, 0x74, 0xee, 0xf0,
-	0x3d, 0x28, 0x0d, 0x3c, 0x6f, 0x48, 0x76, 0xd4, 0x88, 0x9a, 0xfd, 0x07, 0x0d, 0xbf, 0x08, 0xb3,
-	0x0e, 0x39, 0x8e, 0x8e, 0x85, 0x22, 0x8d, 0xc8, 0x08, 0x8d, 0x28, 0xf1, 0x56, 0x35, 0xab, 0x48,
-	0x27, 0x62, 0xa7, 0x42, 0xe1, 0x39, 0xd2, 0x58, 0xd7, 0xa9, 0x50, 0x70, 0x80, 0x54, 0xfd, 0x2f,
-	0x0d, 0x66, 0x7b, 0xd8, 0xab, 0xe0, 0xd0, 0xc7, 0x80, 0x22, 0xe5, 0x09, 0x66, 0x20, 0x59, 0xfd,
-	0xa5, 0xd7, 0x76, 0x35, 0x42, 0x0a, 0xe0, 0x9f, 0x40, 0x31, 0x06, 0x2f, 0x75, 0x26, 0x99, 0x70,
-	0xa6, 0x23, 0x1c, 0xa1, 0x33, 0xe8, 0x0e, 0x14, 0x6c, 0xec, 0xf7, 0xdb, 0x4f, 0x9e, 0xd7, 0x86,
-	0x6c, 0xaa, 0xfe, 0xb1, 0x06, 0x0b, 0xbd, 0x1b, 0x86, 0x7a, 0xa8, 0x7e, 0x2f, 0xd7, 0xb2, 0x41,
-	0x5a, 0x9f, 0x1a, 0x8d, 0xd6, 0x7f, 0x13, 0x4a, 0x3b, 0x83, 0x24, 0x7b, 0x07, 0x0a, 0x42, 0x1f,
-	0xa2, 0x95, 0x69, 0x72, 0x65, 0xbc, 0x36, 0xb6, 0xb2, 0x31, 0x80, 0x7a, 0x78, 0x3a, 0x7f, 0x6e,
-	0x42, 0x73, 0x0b, 0x80, 0xef, 0x7e, 0x54, 0x38, 0x96, 0xd9, 0xcc, 0x24, 0xaf, 0x91, 0xd1, 0xb8,
-	0x27, 0x5c, 0xa7, 0xfb, 0xc2, 0x75, 0x7f, 0x44, 0xce, 0xbc, 0x92, 0x88, 0x3c, 0xf6, 0x4a, 0x23,
-	0xf2, 0xf8, 0xe8, 0x22, 0xf2, 0xd0, 0x9d, 0x57, 0x14, 0xae, 0xb3, 0xa3, 0x0d, 0xd7, 0x93, 0xaf,
-	0x3c, 0x5c, 0xc3, 0xc8, 0xc2, 0x75, 0xf5, 0x33, 0x0d, 0x26, 0xd6, 0x49, 0xdb, 0xf5, 0x29, 0x43,
-	0xdf, 0x81, 0xab, 0xf8, 0x08, 0x53, 0x1b, 0xef, 0x8b, 0x7d, 0xb8, 0xcd, 0xf7, 0x77, 0x09, 0x1d,
-	0x4c, 0x31, 0x04, 0x5a, 0x95, 0x38, 0xa8, 0x0e, 0x79, 0xe6, 0x32, 0x6c, 0x87, 0xc0, 0xa9, 0x84,
-	0x5a, 0xc4, 0x41, 0x14, 0x68, 0xf5, 0x6b, 0x50, 0xaa, 0x77, 0xf6, 0xb1, 0x29, 0xce, 0x78, 0x1b,
-	0x1e, 0xb6, 0xc8, 0x8e, 0xcb, 0x89, 0x95, 0x60, 0xcc, 0x71, 0x83, 0xd9, 0xe7, 0x75, 0x59, 0xa8,
-	0xfe, 0x8b, 0x06, 0x93, 0xe2, 0x20, 0x48, 0xf8, 0x92, 0xdb, 0x90, 0xf7, 0xc3, 0xb1, 0x91, 0x3f,
-	0xc9, 0x45, 0x95, 0x35, 0x8b, 0x77, 0x12, 0x6a, 0x4f, 0x4c, 0xda, 0xa6, 0xc4, 0x61, 0xc1, 0x1e,
-	0xe3, 0x80, 0x10, 0x3d, 0xa8, 0x43, 0xeb, 0x30, 0x26, 0xbd, 0x4d, 0xb2, 0x40, 0x23, 0x07, 0xa3,
-	0x0f, 0x20, 0x1b, 0x88, 0x3a, 0xa1, 0xdd, 0x86, 0xe3, 0xab, 0x9f, 0xa6, 0x60, 0x92, 0x3b, 0x1c,
-	0xb1, 0xda, 0xe1, 0x5e, 0xf3, 0x03, 0x00, 0x79, 0x02, 0x47, 0x9d, 0x03, 0x57, 0x5d, 0xff, 0xdd,
-	0x19, 0x66, 0x0a, 0x21, 0x07, 0xd5, 0x09, 0xed, 0xa4, 0x1b, 0xb2, 0x74, 0x3d, 0xc0, 0x12, 0x5b,
-	0xa8, 0xb4, 0x30, 0xab, 0x97, 0x63, 0x89, 0x3d, 0x94, 0x44, 0x11, 0x5b, 0x28, 0xae, 0x29, 0x1e,
-	0x3d, 0x3c, 0x24, 0x9e, 0x72, 0xe2, 0x99, 0x44, 0xe9, 0x63, 0x4e, 0x81, 0x48, 0x0f, 0xfe, 0x22,
-	0x05, 0x05, 0xce, 0x91, 0x2d, 0xda, 0xa2, 0x8a, 0x2d, 0xdd, 0x2b, 0xd7, 0x46, 0xb8, 0xf2, 0x54,
-	0xc2, 0x95, 0x7f, 0x00, 0xd9, 0x03, 0x6a, 0x0b, 0xb3, 0x49, 0xa8, 0x4b, 0xe1, 0xf8, 0x57, 0xc2,
-	0x45, 0x1e, 0xa1, 0xe4, 0x32, 0x9b, 0xd8, 0x6f, 0x8a, 0x38, 0x90, 0x53, 0xf3, 0x7f, 0x84, 0xfd,
-	0x66, 0xf5, 0xdf, 0x52, 0x30, 0x1d, 0xc5, 0xb9, 0xd1, 0x73, 0xf9, 0x43, 0xc8, 0x29, 0xef, 0x61,
-	0x88, 0x5b, 0x98, 0x64, 0x2e, 0x64, 0x4a, 0x61, 0x3c, 0x72, 0x6d, 0xab, 0x67, 0x45, 0xe9, 0x9e,
-	0x15, 0xf5, 0xc8, 0x35, 0x33, 0x2a, 0x8d, 0x1e, 0x1b, 0x81, 0x46, 0xff, 0x63, 0x0a, 0xa6, 0x7b,
-	0xee, 0xb2, 0xfe, 0xaf, 0x59, 0xfa, 0x26, 0x8c, 0xcb, 0xe3, 0xc8, 0x84, 0x0e, 0x4f, 0x8d, 0x7e,
-	0x35, 0xfc, 0xfd, 0x83, 0x0c, 0xdc, 0x88, 0x82, 0x8b, 0x98, 0xff, 0xbe, 0xeb, 0x3e, 0xdd, 0x26,
-	0x0c, 0x5b, 0x98, 0x61, 0xf4, 0xcb, 0x70, 0xfd, 0x08, 0x3b, 0xdc, 0xdc, 0x0c, 0x9b, 0x3b, 0x15,
-	0x75, 0x91, 0x21, 0xaf, 0x1b, 0x65, 0xdc, 0x99, 0x55, 0x1d, 0x22, 0xa7, 0x23, 0x6f, 0x1a, 0x1f,
-	0xc2, 0x2d, 0x8f, 0x58, 0x1d, 0x93, 0x18, 0xae, 0x63, 0x9f, 0x0c, 0x18, 0x9e, 0x12, 0xc3, 0xaf,
-	0xcb, 0x4e, 0xbb, 0x8e, 0x7d, 0xd2, 0x8b, 0xe0, 0xc3, 0x02, 0x3e, 0x3c, 0xf4, 0xc8, 0x21, 0xdf,
-	0x47, 0xc5, 0xb1, 0xc2, 0x10, 0x92, 0xcc, 0x7f, 0xdc, 0x08, 0x51, 0xf5, 0x90, 0x76, 0x90, 0x33,
-	0x20, 0x1b, 0xe6, 0x23, 0xa2, 0xc1, 0xda, 0x2f, 0x19, 0xb3, 0xca, 0x21, 0xe2, 0x47, 0x12, 0x30,
-	0xa4, 0xb6, 0x01, 0x8b, 0x01, 0x0d, 0xd3, 0x75, 0x2c, 0xca, 0x77, 0xbc, 0xd8, 0xee, 0x62, 0x93,
-	0x3c, 0x55, 0xbb, 0xa9, 0xba, 0xad, 0x45, 0xbd, 0x62, 0x9c, 0xda, 0x82, 0xdb, 0x71, 0xfe, 0x9c,
-	0x07, 0x35, 0x2e, 0xa0, 0x16, 0x23, 0x8e, 0x0f, 0x44, 0xab, 0xfe, 0x9d, 0x06, 0xd3, 0x3d, 0x4a,
-	0x11, 0x85, 0x7f, 0x6d, 0x54, 0xe1, 0x3f, 0x75, 0xb9, 0xf0, 0x8f, 0xaa, 0x90, 0xa3, 0x7e, 0x24,
-	0x40, 0xa1, 0x0b, 0x59, 0xbd, 0xab, 0xae, 0xfa, 0x1c, 0x66, 0x7a, 0x16, 0xb2, 0xce, 0xb5, 0x7a,
-	0x05, 0xc6, 0x04, 0x5b, 0x94, 0xa7, 0x7e, 0x6b, 0x98, 0x4d, 0xf7, 0x8c, 0xd7, 0xe5, 0xc8, 0x1e,
-	0x97, 0x9a, 0xea, 0x0d, 0x12, 0x7f, 0x99, 0x86, 0x52, 0xe4, 0xb7, 0xbe, 0xd2, 0xf1, 0x38, 0xf2,
-	0x4f, 0xe9, 0x4b, 0xf9, 0xa7, 0x78, 0x5c, 0xcf, 0x8c, 0x3a, 0xae, 0x8f, 0x8d, 0x3c, 0xae, 0x8f,
-	0xf7, 0x8a, 0xec, 0xaf, 0xd3, 0x70, 0xad, 0x77, 0x67, 0xfe, 0xff, 0x5d, 0x66, 0xbb, 0x30, 0xa5,
-	0xae, 0xca, 0x44, 0xaa, 0x91, 0x4c, 0x6c, 0x20, 0x21, 0x44, 0xa6, 0xf1, 0xb3, 0x10, 0xdc, 0x7f,
-	0xa4, 0x20, 0xbb, 0xc7, 0xf7, 0x76, 0xd4, 0x75, 0xd0, 0x2c, 0x8c, 0x53, 0x7f, 0xcb, 0x55, 0x87,
-	0x46, 0x59, 0x5d, 0x95, 0x46, 0xea, 0x79, 0x76, 0x61, 0x8a, 0x38, 0xcc, 0x3b, 0x31, 0x2e, 0xb3,
-	0x21, 0x02, 0x01, 0x21, 0x17, 0x38, 0xaa, 0x14, 0xa1, 0x09, 0xe5, 0xfe, 0xd3, 0x33, 0x43, 0x10,
-	0x4a, 0x78, 0x9e, 0x31, 0xdb, 0x77, 0x86, 0xb6, 0xc1, 0xd1, 0xaa, 0x35, 0x28, 0xc5, 0x2c, 0xa4,
-	0xe6, 0x58, 0xd4, 0xc4, 0xcc, 0x7d, 0x49, 0x6e, 0x56, 0x82, 0x31, 0xea, 0xaf, 0x76, 0xa4, 0x00,
-	0xb2, 0xba, 0x2c, 0x54, 0x7f, 0x92, 0x82, 0xac, 0xd8, 0xd5, 0x6e, 0xb9, 0xdd, 0x62, 0xd2, 0x2e,
-	0x29, 0xa6, 0x30, 0x64, 0xa5, 0x2e, 0x13, 0xb2, 0xfa, 0x76, 0xd0, 0x32, 0x7d, 0xee, 0xde, 0x41,
-	0x3f, 0x84, 0xf4, 0x01, 0x49, 0xea, 0xf6, 0xf8, 0xd0, 0x97, 0x6c, 0x3a, 0xd0, 0xbb, 0x70, 0xad,
-	0x6b, 0x8b, 0x6e, 0x60, 0xcb, 0xf2, 0x88, 0xef, 0x4b, 0x6b, 0x10, 0x6e, 0x46, 0xd3, 0x67, 0xe2,
-	0x1b, 0xf6, 0x15, 0xd9, 0xa1, 0xfa, 0x59, 0x0a, 0xf2, 0x81, 0x75, 0xac, 0x13, 0x9b, 0x61, 0x34,
-	0x07, 0x13, 0xd4, 0x37, 0xec, 0x7e, 0x1b, 0xf9, 0x18, 0x10, 0x39, 0x26, 0x66, 0x47, 0x1c, 0xea,
-	0x5f, 0xd2, 0x5a, 0xae, 0x86, 0x48, 0x61, 0xae, 0xf3, 0x04, 0x8a, 0x11, 0xfc, 0xa5, 0xdc, 0xd7,
-	0x74, 0x88, 0x23, 0x6f, 0xe6, 0xd1, 0xb7, 0x21, 0xaa, 0xea, 0xdb, 0x09, 0x7e, 0x19, 0xe4, 0x42,
-	0x08, 0x23, 0xf3, 0xe3, 0x7f, 0x4f, 0x01, 0x8a, 0x3d, 0x15, 0x0d, 0xd4, 0x74, 0xe0, 0xb1, 0x4a,
-	0xaf, 0x52, 0xec, 0x41, 0xa1, 0xad, 0x18, 0x6f, 0x58, 0x9c, 0xf3, 0x6a, 0x3b, 0xf2, 0xe6, 0x30,
-	0x77, 0xdf, 0x25, 0x2a, 0x3d, 0xdf, 0xee, 0x92, 0xdc, 0x26, 0x8c, 0xb7, 0xf1, 0x89, 0xdb, 0x61,
-	0x49, 0xdd, 0xbe, 0x1c, 0xfd, 0x55, 0x56, 0xd7, 0x5f, 0x03, 0x14, 0x65, 0x5c, 0xa1, 0x57, 0x7f,
-	0x08, 0xd9, 0x80, 0x13, 0x2a, 0xfe, 0xbe, 0x7e, 0x11, 0x26, 0xea, 0xe1, 0xa8, 0x7e, 0x89, 0xa5,
-	0xfa, 0x25, 0x56, 0x7d, 0x0e, 0x57, 0x23, 0xe2, 0xc1, 0x81, 0xe1, 0x85, 0x64, 0xfd, 0x4d, 0x98,
-	0xb0, 0x64, 0x7f, 0x25, 0xe4, 0xdb, 0xc3, 0xe6, 0xa7, 0xa0, 0xf5, 0x60, 0x4c, 0xb5, 0x0d, 0x79,
-	0x55, 0xf7, 0xb8, 0x6d, 0x61, 0x26, 0xce, 0xf6, 0xe4, 0x01, 0xb8, 0xf4, 0xa1, 0xb2, 0x80, 0x6a,
-	0x90, 0x55, 0x23, 0xfc, 0x72, 0xaa, 0x92, 0x5e, 0x9a, 0x7a, 0xf0, 0xf6, 0xc5, 0x52, 0xd7, 0x80,
-	0x60, 0x38, 0xbc, 0xfa, 0x42, 0x83, 0xe2, 0x9e, 0x4b, 0x1d, 0xe6, 0xc7, 0xde, 0x51, 0x1d, 0xc0,
-	0x9c, 0x3c, 0x5b, 0x6f, 0x8b, 0x96, 0xf8, 0x9b, 0xa9, 0x64, 0xce, 0xf8, 0x9a, 0x80, 0x1b, 0x44,
-	0x87, 0x9d, 0x43, 0x27, 0x99, 0xb7, 0xb9, 0xc6, 0x06, 0xd1, 0xa9, 0xfe, 0x4f, 0x0a, 0x16, 0x1a,
-	0xf1, 0xe7, 0xa3, 0x6b, 0xb8, 0xd5, 0xc6, 0xf4, 0xd0, 0x59, 0x75, 0x5d, 0x5f, 0x5e, 0xb6, 0xfc,
-	0x12, 0xcc, 0xed, 0xf3, 0x02, 0xb1, 0x8c, 0xae, 0x4f, 0x14, 0x2c, 0xbf, 0xac, 0x55, 0xd2, 0x4b,
-	0x93, 0x7a, 0x49, 0x35, 0x47, 0x47, 0x3e, 0x35, 0xcb, 0x47, 0x9f, 0xc0, 0x5c, 0xbc, 0x7b, 0xb4,
-	0x80, 0x40, 0x30, 0x5f, 0x1b, 0xae, 0x9f, 0xdd, 0x13, 0x55, 0x69, 0xe2, 0xb5, 0xe8, 0xe3, 0x86,
-	0xa8, 0xcd, 0x47, 0x2b, 0x70, 0x2b, 0x98, 0xe2, 0x80, 0xcf, 0x1b, 0x2c, 0xbf, 0x9c, 0x16, 0x13,
-	0x9d, 0x57, 0x9d, 0x7a, 0x73, 0x58, 0x3e, 0xdd, 0x23, 0xb8, 0xd5, 0x3f, 0x34, 0x3e, 0xe9, 0x4c,
-	0xe2, 0x49, 0xdf, 0xe8, 0xfd, 0x48, 0x22, 0x36, 0xf5, 0xea, 0xdf, 0x68, 0x80, 0x02, 0x9e, 0x4b,
-	0x09, 0xec, 0xb9, 0xf2, 0xbd, 0x4a, 0xef, 0x65, 0xb3, 0xbc, 0x52, 0x2a, 0xf8, 0xdd, 0x17, 0xcd,
-	0xbf, 0x0e, 0xa5, 0x16, 0x3e, 0x36, 0x4c, 0x05, 0x11, 0xbc, 0x15, 0x56, 0x3c, 0x1e, 0xf2, 0xae,
-	0xf6, 0xeb, 0x7c, 0x6e, 0x7f, 0xf6, 0x4f, 0x8b, 0x4b, 0x17, 0x50, 0x20, 0x3e, 0xc0, 0xd7, 0x51,
-	0x0b, 0x1f, 0x77, 0x4f, 0xd5, 0xaf, 0xfe, 0x69, 0x0a, 0xae, 0x0f, 0xd4, 0x1f, 0xa1, 0x3a, 0xef,
-	0xc1, 0xf5, 0x70, 0x62, 0xc1, 0xa3, 0x65, 0xc3, 0x27, 0x7c, 0xf3, 0xed, 0xab, 0xf5, 0xcc, 0x05,
-	0x1d, 0x82, 0xf7, 0xca, 0x75, 0xd9, 0x8c, 0x5e, 0x83, 0x5c, 0xec, 0x9a, 0x4b, 0x2e, 0x68, 0x52,
-	0x9f, 0x8a, 0xee, 0xb9, 0x7c, 0xd4, 0x81, 0xeb, 0xdd, 0x4f, 0xa4, 0x0d, 0x21, 0x60, 0xb9, 0x09,
-	0x49, 0x0b, 0x27, 0xf3, 0xde, 0x30, 0x79, 0x0d, 0x57, 0x7c, 0x7d, 0xb6, 0xeb, 0x5d, 0x75, 0x64,
-	0x10, 0xdf, 0x80, 0x39, 0x8b, 0xfa, 0xcf, 0x3a, 0xd8, 0xa6, 0x07, 0x94, 0x58, 0x71, 0x3d, 0xcb,
-	0x88, 0x49, 0x5e, 0x8b, 0x37, 0x87, 0x2a, 0x56, 0xfd, 0xcf, 0x14, 0xcc, 0x6c, 0x12, 0xb2, 0x4e,
-	0x7d, 0x79, 0x4f, 0x41, 0xd5, 0x86, 0xe7, 0xbb, 0x30, 0x23, 0x7d, 0x8a, 0xa5, 0x5a, 0xe4, 0x05,
-	0x58, 0xc2, 0x2b, 0x5d, 0x01, 0x15, 0xd0, 0x10, 0xd7, 0x5f, 0xdf, 0x85, 0x19, 0x36, 0x00, 0x3f,
-	0x61, 0xd6, 0xc2, 0xfa, 0xf0, 0xeb, 0x90, 0x57, 0x8f, 0xe4, 0x71, 0x4b, 0x1c, 0xa2, 0xa4, 0x13,
-	0xbd, 0x8a, 0xcf, 0x49, 0x90, 0x15, 0x81, 0xc1, 0x03, 0xf9, 0x91, 0x6b, 0x77, 0x5a, 0x49, 0x63,
-	0xb0, 0x1a, 0x5d, 0xfd, 0xdd, 0x6e, 0xa6, 0xd7, 0xcd, 0x26, 0xb1, 0x3a, 0xb6, 0x78, 0x48, 0xba,
-	0xdf, 0x31, 0xb9, 0xdc, 0xa2, 0x93, 0xba, 0x8c, 0x3e, 0x25, 0xeb, 0xe4, 0x91, 0xd1, 0x5d, 0x98,
-	0x56, 0x5d, 0xc2, 0x07, 0xf7, 0xf2, 0x8d, 0x48, 0x41, 0x56, 0x87, 0x2f, 0xec, 0x7b, 0x55, 0x35,
-	0xdd, 0xaf, 0xaa, 0x3b, 0x00, 0x8c, 0xaa, 0xfd, 0x71, 0xe0, 0x4b, 0xee, 0x0f, 0xd3, 0xcd, 0x01,
-	0x8a, 0xa2, 0x4f, 0x32, 0xf5, 0xcb, 0x1f, 0xa6, 0x83, 0x63, 0xc3, 0x74, 0x70, 0x1b, 0x50, 0x0f,
-	0x72, 0xa3, 0xb1, 0x85, 0x10, 0x64, 0x58, 0x10, 0xc2, 0x32, 0xba, 0xf8, 0xcd, 0x83, 0x3a, 0x63,
-	0x76, 0xdf, 0xfb, 0x98, 0x1c, 0x63, 0x76, 0x74, 0xa3, 0xfd, 0x57, 0x1a, 0xe4, 0x3e, 0x12, 0x8c,
-	0xd6, 0x89, 0xe9, 0x7a, 0x16, 0xfa, 0x10, 0xe4, 0xad, 0xb1, 0xa1, 0x84, 0x97, 0x4c, 0x89, 0xa7,
-	0x04, 0x86, 0x04, 0xe6, 0x90, 0x2c, 0x0e, 0x99, 0xf0, 0xb4, 0x9f, 0x45, 0x90, 0xd5, 0xdf, 0xd7,
-	0xa0, 0xb0, 0x22, 0xe3, 0xbe, 0x72, 0x64, 0xa8, 0x0c, 0x13, 0x2a, 0x13, 0x50, 0x09, 0x45, 0x50,
-	0x44, 0x04, 0x26, 0x5e, 0xa1, 0x53, 0x0d, 0xb0, 0xab, 0xbf, 0xad, 0x41, 0x4e, 0x64, 0xcf, 0x92,
-	0x93, 0xfe, 0xcb, 0x1e, 0x39, 0x94, 0x6c, 0xcc, 0x88, 0xcf, 0x0c, 0xee, 0xa4, 0x44, 0x1e, 0xe9,
-	0x46, 0x33, 0xbc, 0xfb, 0x32, 0xaf, 0xa7, 0x88, 0xe8, 0x48, 0x82, 0xc4, 0xe9, 0x56, 0xbf, 0x01,
-	0xf9, 0x28, 0x2d, 0xaa, 0xad, 0xfb, 0xe8, 0x0e, 0x14, 0xba, 0xd2, 0x3b, 0x19, 0xf7, 0x73, 0x7a,
-	0x3e, 0x9e, 0xdf, 0xf9, 0xd5, 0xbf, 0xd5, 0x60, 0x2a, 0x06, 0x84, 0x6e, 0xc2, 0x64, 0x6f, 0xf0,
-	0x8a, 0x2a, 0x46, 0xb4, 0xf5, 0x8c, 0x6f, 0x86, 0xd3, 0x97, 0xbc, 0x2c, 0xfd, 0x81, 0x06, 0x63,
-	0xf2, 0x1b, 0x8e, 0x5f, 0x01, 0xad, 0x9d, 0x50, 0x73, 0xb5, 0x36, 0x1f, 0xfd, 0x2c, 0xe1, 0xaa,
-	0xb4, 0x67, 0xd5, 0x3f, 0xd2, 0x60, 0x71, 0x25, 0x38, 0x0b, 0x8f, 0xe4, 0xd0, 0x65, 0x64, 0x17,
-	0xba, 0xb2, 0xde, 0x85, 0x82, 0x52, 0x1f, 0x69, 0x37, 0x81, 0x6e, 0x5c, 0xe0, 0x7d, 0x83, 0x22,
-	0x96, 0x6f, 0xc5, 0x4a, 0x7e, 0xf5, 0x87, 0x1a, 0xdc, 0x0c, 0x67, 0xb6, 0x32, 0x60, 0x5a, 0xe7,
-	0x9b, 0xd0, 0xc8, 0xe7, 0xe2, 0x43, 0x2e, 0xde, 0x3c, 0xdc, 0x56, 0xa2, 0x50, 0x22, 0x37, 0x1e,
-	0x43, 0xa9, 0xc6, 0x57, 0xa4, 0xf2, 0xb7, 0x20, 0x94, 0xac, 0xf0, 0x2d, 0x88, 0xe3, 0xb6, 0xd6,
-	0x89, 0x49, 0x5b, 0xd8, 0xf6, 0xcf, 0xd9, 0x82, 0xcc, 0xf3, 0x2d, 0x88, 0xec, 0x21, 0x08, 0x66,
-	0xf4, 0xb0, 0x7c, 0x8f, 0xc1, 0xcd, 0x61, 0xdf, 0x16, 0x21, 0x80, 0xf1, 0x1d, 0x77, 0xdf, 0xb5,
-	0x4e, 0x8a, 0x57, 0x50, 0x15, 0x16, 0x56, 0xc9, 0x21, 0x75, 0x56, 0x6d, 0xd7, 0x7c, 0x4a, 0xbc,
-	0x7a, 0x0b, 0x7b, 0x6c, 0xcd, 0x75, 0x98, 0x87, 0x4d, 0xe6, 0xef, 0x3a, 0xf6, 0x49, 0x51, 0x43,
-	0xb3, 0x80, 0x06, 0xd4, 0xa7, 0x50, 0x0e, 0xb2, 0x1b, 0x47, 0xc4, 0x3b, 0x71, 0x1d, 0x52, 0x4c,
-	0xdf, 0x6b, 0x04, 0xdc, 0x92, 0x0f, 0x57, 0xd0, 0x34, 0x4c, 0x3d, 0x76, 0xfc, 0x36, 0x31, 0x45,
-	0x70, 0x28, 0x5e, 0xe1, 0x64, 0x57, 0x04, 0x3f, 0x8a, 0x1a, 0xff, 0xbd, 0x87, 0x3b, 0x3e, 0xb1,
-	0x8a, 0x29, 0x54, 0x00, 0x58, 0x27, 0x2d, 0xd7, 0xa6, 0x7e, 0x93, 0x58, 0xc5, 0x34, 0x9a, 0x82,
-	0x09, 0xf1, 0xe4, 0x92, 0x58, 0xc5, 0xcc, 0xbd, 0xcf, 0x52, 0xea, 0x19, 0x85, 0x38, 0x6f, 0xad,
-	0xc0, 0xd4, 0xe3, 0x9d, 0xfa, 0xde, 0xc6, 0x5a, 0x6d, 0xb3, 0xb6, 0xb1, 0x5e, 0xbc, 0x32, 0x3f,
-	0x7d, 0x7a, 0x56, 0x89, 0x57, 0xa1, 0x22, 0xa4, 0x57, 0x1f, 0x3f, 0x29, 0x6a, 0xf3, 0x13, 0xa7,
-	0x67, 0x15, 0xfe, 0x93, 0x87, 0x9d, 0xfa, 0xc6, 0xd6, 0x56, 0x31, 0x35, 0x9f, 0x3d, 0x3d, 0xab,
-	0x88, 0xdf, 0x9c, 0x7b, 0xf5, 0xc6, 0xee, 0x9e, 0xc1, 0xbb, 0xa6, 0xe7, 0x73, 0xa7, 0x67, 0x95,
-	0xb0, 0xcc, 0x3d, 0x8a, 0xf8, 0x2d, 0x06, 0x65, 0xe6, 0xf3, 0xa7, 0x67, 0x95, 0xa8, 0x82, 0x8f,
-	0x6c, 0xac, 0x7c, 0x6b, 0x43, 0x8c, 0x1c, 0x93, 0x23, 0x83, 0x32, 0x1f, 0x29, 0x7e, 0x8b, 0x91,
-	0xe3, 0x72, 0x64, 0x58, 0x81, 0x66, 0x61, 0x7c, 0xf5, 0xf1, 0x13, 0x63, 0x6f, 0xb7, 0x38, 0x31,
-	0x0f, 0xa7, 0x67, 0x15, 0x55, 0xe2, 0x0a, 0xcd, 0xdb, 0x79, 0x43, 0x76, 0x7e, 0xea, 0xf4, 0xac,
-	0x12, 0x14, 0xd1, 0x02, 0x00, 0xef, 0xb3, 0xd2, 0xd8, 0xdd, 0xae, 0xad, 0x15, 0x27, 0xe7, 0x0b,
-	0xa7, 0x67, 0x95, 0x58, 0x0d, 0xe7, 0x86, 0xe8, 0xaa, 0x3a, 0x80, 0xe4, 0x46, 0xac, 0xea, 0xde,
-	0x5f, 0x68, 0x90, 0xdf, 0x08, 0x4e, 0x52, 0x04, 0x07, 0x6f, 0x42, 0x39, 0x26, 0x95, 0xae, 0x36,
-	0x29, 0x22, 0x29, 0xc3, 0xa2, 0x86, 0xf2, 0x30, 0x29, 0xee, 0x4b, 0x36, 0xa9, 0x6d, 0x17, 0x53,
-	0x68, 0x1e, 0x66, 0x45, 0x71, 0x1b, 0x33, 0xb3, 0xa9, 0xcb, 0xaf, 0xff, 0x84, 0x60, 0x8a, 0x69,
-	0xae, 0x20, 0x51, 0xdb, 0x0e, 0x79, 0x2e, 0xeb, 0x33, 0xe8, 0x1a, 0x5c, 0x55, 0x1f, 0x11, 0xa9,
-	0xcf, 0xf8, 0xa8, 0xeb, 0x14, 0xc7, 0x38, 0x94, 0x7c, 0x53, 0xdb, 0xfb, 0xec, 0xae, 0x38, 0x7e,
-	0xef, 0x87, 0x81, 0xbc, 0xb7, 0xb1, 0xff, 0x94, 0xf3, 0xec, 0xf1, 0xce, 0xe3, 0xba, 0x10, 0xb5,
-	0xe0, 0x99, 0x2c, 0x71, 0x29, 0xaf, 0xec, 0x84, 0x52, 0x5e, 0xd9, 0x79, 0xc2, 0xb9, 0xa8, 0x6f,
-	0xbc, 0xff, 0x78, 0x6b, 0x45, 0x2f, 0xa6, 0x24, 0x17, 0x55, 0x91, 0x73, 0x69, 0x6d, 0x77, 0x67,
-	0xbd, 0xd6, 0xa8, 0xed, 0xee, 0xac, 0x70, 0x89, 0x0a, 0x2e, 0xc5, 0xaa, 0xd0, 0x32, 0xcc, 0xad,
-	0xd7, 0xf4, 0x8d, 0x35, 0x5e, 0xe4, 0x82, 0x34, 0x76, 0x75, 0xe3, 0x51, 0xed, 0xfd, 0x47, 0x1b,
-	0x7a, 0x31, 0x3b, 0x7f, 0xf5, 0xf4, 0xac, 0x92, 0xef, 0xaa, 0xec, 0xee, 0x2f, 0xd8, 0xbd, 0xab,
-	0x1b, 0x5b, 0xbb, 0xdf, 0xde, 0xd0, 0x8b, 0x45, 0xd9, 0xbf, 0xab, 0x12, 0xdd, 0x80, 0xa9, 0xc6,
-	0x93, 0xbd, 0x0d, 0x63, 0x7b, 0x45, 0xff, 0xd6, 0x46, 0xa3, 0x58, 0x91, 0x4b, 0x91, 0x25, 0x74,
-	0x1d, 0x40, 0x34, 0x6e, 0xd5, 0xb6, 0x6b, 0x8d, 0xe2, 0xc3, 0xf9, 0xc9, 0xd3, 0xb3, 0xca, 0x98,
-	0x28, 0xac, 0x36, 0x7f, 0xfc, 0x62, 0x41, 0xfb, 0xfc, 0xc5, 0x82, 0xf6, 0xcf, 0x2f, 0x16, 0xb4,
-	0xdf, 0xfb, 0x62, 0xe1, 0xca, 0xe7, 0x5f, 0x2c, 0x5c, 0xf9, 0xfb, 0x2f, 0x16, 0xae, 0xfc, 0xea,
-	0x4e, 0xcc, 0xd5, 0xd7, 0x02, 0x37, 0xb3, 0x85, 0xf7, 0xfd, 0xfb, 0xa1, 0xd3, 0x79, 0xdb, 0x74,
-	0x3d, 0x12, 0x2f, 0x36, 0x31, 0x75, 0xee, 0xb7, 0x5c, 0x9e, 0x97, 0xfa, 0xd1, 0x7f, 0x2b, 0x10,
-	0x61, 0x61, 0x7f, 0x5c, 0x7c, 0x94, 0xf6, 0x0b, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xb1, 0xbb,
-	0x4c, 0xa0, 0xd0, 0x40, 0x00, 0x00,
+func (m *TerminalOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminalOrder.Merge(m, src)
+}
+func (m *TerminalOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *TerminalOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminalOrder.DiscardUnknown(m)
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+var xxx_messageInfo_TerminalOrder proto.InternalMessageInfo
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.SpotMarketInstantListingFee.Equal(&that1.SpotMarketInstantListingFee) {
-		return false
-	}
-	if !this.DerivativeMarketInstantListingFee.Equal(&that1.DerivativeMarketInstantListingFee) {
-		return false
-	}
-	if !this.DefaultSpotMakerFeeRate.Equal(that1.DefaultSpotMakerFeeRate) {
-		return false
-	}
-	if !this.DefaultSpotTakerFeeRate.Equal(that1.DefaultSpotTakerFeeRate) {
-		return false
-	}
-	if !this.DefaultDerivativeMakerFeeRate.Equal(that1.DefaultDerivativeMakerFeeRate) {
-		return false
-	}
-	if !this.DefaultDerivativeTakerFeeRate.Equal(that1.DefaultDerivativeTakerFeeRate) {
-		return false
-	}
-	if !this.DefaultInitialMarginRatio.Equal(that1.DefaultInitialMarginRatio) {
-		return false
-	}
-	if !this.DefaultMaintenanceMarginRatio.Equal(that1.DefaultMaintenanceMarginRatio) {
-		return false
-	}
-	if this.DefaultFundingInterval != that1.DefaultFundingInterval {
-		return false
-	}
-	if this.FundingMultiple != that1.FundingMultiple {
-		return false
-	}
-	if !this.RelayerFeeShareRate.Equal(that1.RelayerFeeShareRate) {
-		return false
-	}
-	if !this.DefaultHourlyFundingRateCap.Equal(that1.DefaultHourlyFundingRateCap) {
-		return false
+func (m *TerminalOrder) GetOrderHash() string {
+	if m != nil {
+		return m.OrderHash
 	}
-	if !this.DefaultHourlyInterestRate.Equal(that1.DefaultHourlyInterestRate) {
-		return false
+	return ""
+}
+
+func (m *TerminalOrder) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
 	}
-	if this.MaxDerivativeOrderSideCount != that1.MaxDerivativeOrderSideCount {
-		return false
+	return ""
+}
+
+func (m *TerminalOrder) GetSubaccountId() string {
+	if m != nil {
+		return m.SubaccountId
 	}
-	if !this.InjRewardStakedRequirementThreshold.Equal(that1.InjRewardStakedRequirementThreshold) {
-		return false
+	return ""
+}
+
+func (m *TerminalOrder) GetIsSpot() bool {
+	if m != nil {
+		return m.IsSpot
 	}
-	if this.TradingRewardsVestingDuration != that1.TradingRewardsVestingDuration {
-		return false
+	return false
+}
+
+func (m *TerminalOrder) GetOrderType() OrderType {
+	if m != nil {
+		return m.OrderType
 	}
-	if !this.LiquidatorRewardShareRate.Equal(that1.LiquidatorRewardShareRate) {
-		return false
+	return OrderType_UNSPECIFIED
+}
+
+func (m *TerminalOrder) GetStatus() TerminalOrderStatus {
+	if m != nil {
+		return m.Status
 	}
-	if !this.BinaryOptionsMarketInstantListingFee.Equal(&that1.BinaryOptionsMarketInstantListingFee) {
-		return false
+	return TerminalOrderStatus_UnspecifiedTerminalStatus
+}
+
+func (m *TerminalOrder) GetReason() OrderTerminationReason {
+	if m != nil {
+		return m.Reason
 	}
-	if this.AtomicMarketOrderAccessLevel != that1.AtomicMarketOrderAccessLevel {
-		return false
+	return OrderTerminationReason_UnspecifiedTermination
+}
+
+func (m *TerminalOrder) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if !this.SpotAtomicMarketOrderFeeMultiplier.Equal(that1.SpotAtomicMarketOrderFeeMultiplier) {
-		return false
+	return 0
+}
+
+func (m *TerminalOrder) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	if !this.DerivativeAtomicMarketOrderFeeMultiplier.Equal(that1.DerivativeAtomicMarketOrderFeeMultiplier) {
-		return false
+	return 0
+}
+
+// LimitOrderFill aggregates the fills of a limit order
+type LimitOrderFill struct {
+	OrderHash string                                 `protobuf:"bytes,1,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
+	Quantity  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	Notional  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=notional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"notional"`
+}
+
+func (m *LimitOrderFill) Reset()         { *m = LimitOrderFill{} }
+func (m *LimitOrderFill) String() string { return proto.CompactTextString(m) }
+func (*LimitOrderFill) ProtoMessage()    {}
+func (*LimitOrderFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{56}
+}
+func (m *LimitOrderFill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrderFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrderFill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.BinaryOptionsAtomicMarketOrderFeeMultiplier.Equal(that1.BinaryOptionsAtomicMarketOrderFeeMultiplier) {
-		return false
+}
+func (m *LimitOrderFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrderFill.Merge(m, src)
+}
+func (m *LimitOrderFill) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrderFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrderFill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrderFill proto.InternalMessageInfo
+
+func (m *LimitOrderFill) GetOrderHash() string {
+	if m != nil {
+		return m.OrderHash
 	}
-	if !this.MinimalProtocolFeeRate.Equal(that1.MinimalProtocolFeeRate) {
-		return false
+	return ""
+}
+
+// SubaccountPnl is the ledger of the cumulative realized PnL, trading fees and funding payments of a subaccount in a
+// derivative or binary options market
+type SubaccountPnl struct {
+	SubaccountId string `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	MarketId     string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// the PnL realized by the closed position quantities, excluding the fees and funding payments
+	RealizedPnl github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=realized_pnl,json=realizedPnl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"realized_pnl"`
+	// the trading fees paid, net of the maker rebates
+	FeesPaid github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=fees_paid,json=feesPaid,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fees_paid"`
+	// the funding paid, net of the funding received
+	FundingPaid github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=funding_paid,json=fundingPaid,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_paid"`
+	// the height of the last block in which the ledger was updated
+	LastUpdatedHeight int64 `protobuf:"varint,6,opt,name=last_updated_height,json=lastUpdatedHeight,proto3" json:"last_updated_height,omitempty"`
+}
+
+func (m *SubaccountPnl) Reset()         { *m = SubaccountPnl{} }
+func (m *SubaccountPnl) String() string { return proto.CompactTextString(m) }
+func (*SubaccountPnl) ProtoMessage()    {}
+func (*SubaccountPnl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{57}
+}
+func (m *SubaccountPnl) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubaccountPnl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubaccountPnl.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.IsInstantDerivativeMarketLaunchEnabled != that1.IsInstantDerivativeMarketLaunchEnabled {
-		return false
+}
+func (m *SubaccountPnl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubaccountPnl.Merge(m, src)
+}
+func (m *SubaccountPnl) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubaccountPnl) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubaccountPnl.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubaccountPnl proto.InternalMessageInfo
+
+func (m *SubaccountPnl) GetSubaccountId() string {
+	if m != nil {
+		return m.SubaccountId
 	}
-	return true
+	return ""
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *SubaccountPnl) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SubaccountPnl) GetLastUpdatedHeight() int64 {
+	if m != nil {
+		return m.LastUpdatedHeight
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsInstantDerivativeMarketLaunchEnabled {
-		i--
-		if m.IsInstantDerivativeMarketLaunchEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+// RFQRequest is a request for quote of a taker, asking makers to quote a price off-chain for a block trade settled
+// away from the orderbook
+type RFQRequest struct {
+	// request_id is the unique identifier of the request, the quotes signed for it can't be replayed on another request
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	MarketId  string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// taker is the address of the account which created the request
+	Taker string `protobuf:"bytes,3,opt,name=taker,proto3" json:"taker,omitempty"`
+	// subaccount_id is the taker subaccount the block trade is settled with
+	SubaccountId string `protobuf:"bytes,4,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	// is_buy is the direction of the taker
+	IsBuy    bool                                   `protobuf:"varint,5,opt,name=is_buy,json=isBuy,proto3" json:"is_buy,omitempty"`
+	Quantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	// expiry is the block time after which the request can't be accepted anymore
+	Expiry int64 `protobuf:"varint,7,opt,name=expiry,proto3" json:"expiry,omitempty"`
+}
+
+func (m *RFQRequest) Reset()         { *m = RFQRequest{} }
+func (m *RFQRequest) String() string { return proto.CompactTextString(m) }
+func (*RFQRequest) ProtoMessage()    {}
+func (*RFQRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{58}
+}
+func (m *RFQRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RFQRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RFQRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc0
+		return b[:n], nil
 	}
-	{
-		size := m.MinimalProtocolFeeRate.Size()
-		i -= size
-		if _, err := m.MinimalProtocolFeeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *RFQRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RFQRequest.Merge(m, src)
+}
+func (m *RFQRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RFQRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RFQRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RFQRequest proto.InternalMessageInfo
+
+// RFQQuote is the quote of a maker for an RFQ request, signed off-chain by the maker and submitted by the taker
+type RFQQuote struct {
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	MarketId  string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// maker_subaccount_id is the maker subaccount the block trade is settled with
+	MakerSubaccountId string                                 `protobuf:"bytes,3,opt,name=maker_subaccount_id,json=makerSubaccountId,proto3" json:"maker_subaccount_id,omitempty"`
+	Price             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// margin is the margin the maker commits to its side of the trade (derivatives only, zero for a pure reduction)
+	Margin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=margin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"margin"`
+	// expiry is the block time after which the quote can't be accepted anymore
+	Expiry int64 `protobuf:"varint,6,opt,name=expiry,proto3" json:"expiry,omitempty"`
+}
+
+func (m *RFQQuote) Reset()         { *m = RFQQuote{} }
+func (m *RFQQuote) String() string { return proto.CompactTextString(m) }
+func (*RFQQuote) ProtoMessage()    {}
+func (*RFQQuote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{59}
+}
+func (m *RFQQuote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RFQQuote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RFQQuote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xba
-	{
-		size := m.BinaryOptionsAtomicMarketOrderFeeMultiplier.Size()
-		i -= size
-		if _, err := m.BinaryOptionsAtomicMarketOrderFeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xb2
-	{
-		size := m.DerivativeAtomicMarketOrderFeeMultiplier.Size()
-		i -= size
-		if _, err := m.DerivativeAtomicMarketOrderFeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xaa
-	{
-		size := m.SpotAtomicMarketOrderFeeMultiplier.Size()
-		i -= size
-		if _, err := m.SpotAtomicMarketOrderFeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xa2
-	if m.AtomicMarketOrderAccessLevel != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.AtomicMarketOrderAccessLevel))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
-	}
-	{
-		size, err := m.BinaryOptionsMarketInstantListingFee.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *RFQQuote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RFQQuote.Merge(m, src)
+}
+func (m *RFQQuote) XXX_Size() int {
+	return m.Size()
+}
+func (m *RFQQuote) XXX_DiscardUnknown() {
+	xxx_messageInfo_RFQQuote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RFQQuote proto.InternalMessageInfo
+
+// SignedOrder is a limit order signed off-chain by the trader with EIP-712, placed on its behalf by a relayer
+type SignedOrder struct {
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// subaccount_id is the full subaccount ID of the trader, the account owning it must have signed the order
+	SubaccountId string `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	// fee_recipient is the address receiving the relayer fee share, the relayer submitting the order if empty
+	FeeRecipient string `protobuf:"bytes,3,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient,omitempty"`
+	// order_type is the type of the order, either BUY, SELL, BUY_PO or SELL_PO
+	OrderType OrderType                              `protobuf:"varint,4,opt,name=order_type,json=orderType,proto3,enum=injective.exchange.v1beta1.OrderType" json:"order_type,omitempty"`
+	Price     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Quantity  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	// margin is the margin of the order (derivatives only, zero for reduce-only orders)
+	Margin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=margin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"margin"`
+	// nonce is chosen by the trader, a nonce can only be used once per subaccount
+	Nonce uint64 `protobuf:"varint,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// expiry is the block time after which the order can't be submitted anymore
+	Expiry int64 `protobuf:"varint,9,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// signature is the [R || S || V] EIP-712 signature of the order
+	Signature []byte `protobuf:"bytes,10,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *SignedOrder) Reset()         { *m = SignedOrder{} }
+func (m *SignedOrder) String() string { return proto.CompactTextString(m) }
+func (*SignedOrder) ProtoMessage()    {}
+func (*SignedOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{60}
+}
+func (m *SignedOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignedOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignedOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintExchange(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x92
-	{
-		size := m.LiquidatorRewardShareRate.Size()
-		i -= size
-		if _, err := m.LiquidatorRewardShareRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x8a
-	if m.TradingRewardsVestingDuration != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.TradingRewardsVestingDuration))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
-	}
-	{
-		size := m.InjRewardStakedRequirementThreshold.Size()
-		i -= size
-		if _, err := m.InjRewardStakedRequirementThreshold.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x7a
-	if m.MaxDerivativeOrderSideCount != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.MaxDerivativeOrderSideCount))
-		i--
-		dAtA[i] = 0x70
-	}
-	{
-		size := m.DefaultHourlyInterestRate.Size()
-		i -= size
-		if _, err := m.DefaultHourlyInterestRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x6a
-	{
-		size := m.DefaultHourlyFundingRateCap.Size()
-		i -= size
-		if _, err := m.DefaultHourlyFundingRateCap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *SignedOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedOrder.Merge(m, src)
+}
+func (m *SignedOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignedOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedOrder proto.InternalMessageInfo
+
+// SignedOrderNonce is a nonce of a subaccount used by a placed or cancelled signed order, kept until the expiry of the
+// order. A placed order still resting on the orderbook is cancelled at its expiry
+type SignedOrderNonce struct {
+	SubaccountId string `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	Nonce        uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Expiry       int64  `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// market_id is the market of the placed order, empty for cancelled nonces
+	MarketId string `protobuf:"bytes,4,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// order_hash is the hash of the placed order, empty for cancelled nonces
+	OrderHash string `protobuf:"bytes,5,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
+}
+
+func (m *SignedOrderNonce) Reset()         { *m = SignedOrderNonce{} }
+func (m *SignedOrderNonce) String() string { return proto.CompactTextString(m) }
+func (*SignedOrderNonce) ProtoMessage()    {}
+func (*SignedOrderNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{61}
+}
+func (m *SignedOrderNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignedOrderNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignedOrderNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size := m.RelayerFeeShareRate.Size()
-		i -= size
-		if _, err := m.RelayerFeeShareRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
+}
+func (m *SignedOrderNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedOrderNonce.Merge(m, src)
+}
+func (m *SignedOrderNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignedOrderNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedOrderNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedOrderNonce proto.InternalMessageInfo
+
+func (m *SignedOrderNonce) GetSubaccountId() string {
+	if m != nil {
+		return m.SubaccountId
 	}
-	i--
-	dAtA[i] = 0x5a
-	if m.FundingMultiple != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.FundingMultiple))
-		i--
-		dAtA[i] = 0x50
+	return ""
+}
+
+func (m *SignedOrderNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	if m.DefaultFundingInterval != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.DefaultFundingInterval))
-		i--
-		dAtA[i] = 0x48
+	return 0
+}
+
+func (m *SignedOrderNonce) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
 	}
-	{
-		size := m.DefaultMaintenanceMarginRatio.Size()
-		i -= size
-		if _, err := m.DefaultMaintenanceMarginRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *SignedOrderNonce) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.DefaultInitialMarginRatio.Size()
-		i -= size
-		if _, err := m.DefaultInitialMarginRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *SignedOrderNonce) GetOrderHash() string {
+	if m != nil {
+		return m.OrderHash
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.DefaultDerivativeTakerFeeRate.Size()
-		i -= size
-		if _, err := m.DefaultDerivativeTakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return ""
+}
+
+// SignedOrderMinNonce is the lowest nonce of the signed orders a subaccount still accepts
+type SignedOrderMinNonce struct {
+	SubaccountId string `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	MinNonce     uint64 `protobuf:"varint,2,opt,name=min_nonce,json=minNonce,proto3" json:"min_nonce,omitempty"`
+}
+
+func (m *SignedOrderMinNonce) Reset()         { *m = SignedOrderMinNonce{} }
+func (m *SignedOrderMinNonce) String() string { return proto.CompactTextString(m) }
+func (*SignedOrderMinNonce) ProtoMessage()    {}
+func (*SignedOrderMinNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2116e2804e9c53f9, []int{62}
+}
+func (m *SignedOrderMinNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignedOrderMinNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignedOrderMinNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.DefaultDerivativeMakerFeeRate.Size()
-		i -= size
-		if _, err := m.DefaultDerivativeMakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
+}
+func (m *SignedOrderMinNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedOrderMinNonce.Merge(m, src)
+}
+func (m *SignedOrderMinNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignedOrderMinNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedOrderMinNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedOrderMinNonce proto.InternalMessageInfo
+
+func (m *SignedOrderMinNonce) GetSubaccountId() string {
+	if m != nil {
+		return m.SubaccountId
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.DefaultSpotTakerFeeRate.Size()
-		i -= size
-		if _, err := m.DefaultSpotTakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *SignedOrderMinNonce) GetMinNonce() uint64 {
+	if m != nil {
+		return m.MinNonce
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.DefaultSpotMakerFeeRate.Size()
-		i -= size
-		if _, err := m.DefaultSpotMakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("injective.exchange.v1beta1.AtomicMarketOrderAccessLevel", AtomicMarketOrderAccessLevel_name, AtomicMarketOrderAccessLevel_value)
+	proto.RegisterEnum("injective.exchange.v1beta1.MarketStatus", MarketStatus_name, MarketStatus_value)
+	proto.RegisterEnum("injective.exchange.v1beta1.OptionType", OptionType_name, OptionType_value)
+	proto.RegisterEnum("injective.exchange.v1beta1.ExpiryFuturesRollFailureReason", ExpiryFuturesRollFailureReason_name, ExpiryFuturesRollFailureReason_value)
+	proto.RegisterEnum("injective.exchange.v1beta1.OrderType", OrderType_name, OrderType_value)
+	proto.RegisterEnum("injective.exchange.v1beta1.ExecutionType", ExecutionType_name, ExecutionType_value)
+	proto.RegisterEnum("injective.exchange.v1beta1.OrderMask", OrderMask_name, OrderMask_value)
+	proto.RegisterEnum("injective.exchange.v1beta1.TerminalOrderStatus", TerminalOrderStatus_name, TerminalOrderStatus_value)
+	proto.RegisterEnum("injective.exchange.v1beta1.OrderTerminationReason", OrderTerminationReason_name, OrderTerminationReason_value)
+	proto.RegisterType((*Params)(nil), "injective.exchange.v1beta1.Params")
+	proto.RegisterType((*MarketFeeMultiplier)(nil), "injective.exchange.v1beta1.MarketFeeMultiplier")
+	proto.RegisterType((*DerivativeMarket)(nil), "injective.exchange.v1beta1.DerivativeMarket")
+	proto.RegisterType((*BinaryOptionsMarket)(nil), "injective.exchange.v1beta1.BinaryOptionsMarket")
+	proto.RegisterType((*VanillaOptionsMarket)(nil), "injective.exchange.v1beta1.VanillaOptionsMarket")
+	proto.RegisterType((*CategoricalMarket)(nil), "injective.exchange.v1beta1.CategoricalMarket")
+	proto.RegisterType((*ExpiryFuturesMarketInfo)(nil), "injective.exchange.v1beta1.ExpiryFuturesMarketInfo")
+	proto.RegisterType((*ExpiryFuturesMarketSeries)(nil), "injective.exchange.v1beta1.ExpiryFuturesMarketSeries")
+	proto.RegisterType((*ExpiryFuturesAutoRoll)(nil), "injective.exchange.v1beta1.ExpiryFuturesAutoRoll")
+	proto.RegisterType((*PerpetualMarketInfo)(nil), "injective.exchange.v1beta1.PerpetualMarketInfo")
+	proto.RegisterType((*PerpetualMarketFunding)(nil), "injective.exchange.v1beta1.PerpetualMarketFunding")
+	proto.RegisterType((*PreLaunchPerpetualMarketInfo)(nil), "injective.exchange.v1beta1.PreLaunchPerpetualMarketInfo")
+	proto.RegisterType((*DerivativeMarketSettlementInfo)(nil), "injective.exchange.v1beta1.DerivativeMarketSettlementInfo")
+	proto.RegisterType((*NextFundingTimestamp)(nil), "injective.exchange.v1beta1.NextFundingTimestamp")
+	proto.RegisterType((*SpotMarket)(nil), "injective.exchange.v1beta1.SpotMarket")
+	proto.RegisterType((*Deposit)(nil), "injective.exchange.v1beta1.Deposit")
+	proto.RegisterType((*SubaccountTradeNonce)(nil), "injective.exchange.v1beta1.SubaccountTradeNonce")
+	proto.RegisterType((*OrderInfo)(nil), "injective.exchange.v1beta1.OrderInfo")
+	proto.RegisterType((*SpotOrder)(nil), "injective.exchange.v1beta1.SpotOrder")
+	proto.RegisterType((*SpotLimitOrder)(nil), "injective.exchange.v1beta1.SpotLimitOrder")
+	proto.RegisterType((*SpotMarketOrder)(nil), "injective.exchange.v1beta1.SpotMarketOrder")
+	proto.RegisterType((*DerivativeOrder)(nil), "injective.exchange.v1beta1.DerivativeOrder")
+	proto.RegisterType((*SubaccountOrderbookMetadata)(nil), "injective.exchange.v1beta1.SubaccountOrderbookMetadata")
+	proto.RegisterType((*SubaccountOrder)(nil), "injective.exchange.v1beta1.SubaccountOrder")
+	proto.RegisterType((*SubaccountOrderData)(nil), "injective.exchange.v1beta1.SubaccountOrderData")
+	proto.RegisterType((*DerivativeLimitOrder)(nil), "injective.exchange.v1beta1.DerivativeLimitOrder")
+	proto.RegisterType((*IcebergOrderInfo)(nil), "injective.exchange.v1beta1.IcebergOrderInfo")
+	proto.RegisterType((*DerivativeMarketOrder)(nil), "injective.exchange.v1beta1.DerivativeMarketOrder")
+	proto.RegisterType((*TWAPOrder)(nil), "injective.exchange.v1beta1.TWAPOrder")
+	proto.RegisterType((*Position)(nil), "injective.exchange.v1beta1.Position")
+	proto.RegisterType((*MarketOrderIndicator)(nil), "injective.exchange.v1beta1.MarketOrderIndicator")
+	proto.RegisterType((*TradeLog)(nil), "injective.exchange.v1beta1.TradeLog")
+	proto.RegisterType((*PositionDelta)(nil), "injective.exchange.v1beta1.PositionDelta")
+	proto.RegisterType((*DerivativeTradeLog)(nil), "injective.exchange.v1beta1.DerivativeTradeLog")
+	proto.RegisterType((*SubaccountPosition)(nil), "injective.exchange.v1beta1.SubaccountPosition")
+	proto.RegisterType((*SubaccountDeposit)(nil), "injective.exchange.v1beta1.SubaccountDeposit")
+	proto.RegisterType((*DepositUpdate)(nil), "injective.exchange.v1beta1.DepositUpdate")
+	proto.RegisterType((*PointsMultiplier)(nil), "injective.exchange.v1beta1.PointsMultiplier")
+	proto.RegisterType((*TradingRewardCampaignBoostInfo)(nil), "injective.exchange.v1beta1.TradingRewardCampaignBoostInfo")
+	proto.RegisterType((*CampaignRewardPool)(nil), "injective.exchange.v1beta1.CampaignRewardPool")
+	proto.RegisterType((*TradingRewardCampaignInfo)(nil), "injective.exchange.v1beta1.TradingRewardCampaignInfo")
+	proto.RegisterType((*FeeDiscountTierInfo)(nil), "injective.exchange.v1beta1.FeeDiscountTierInfo")
+	proto.RegisterType((*FeeDiscountSchedule)(nil), "injective.exchange.v1beta1.FeeDiscountSchedule")
+	proto.RegisterType((*FeeDiscountTierTTL)(nil), "injective.exchange.v1beta1.FeeDiscountTierTTL")
+	proto.RegisterType((*VolumeRecord)(nil), "injective.exchange.v1beta1.VolumeRecord")
+	proto.RegisterType((*AccountRewards)(nil), "injective.exchange.v1beta1.AccountRewards")
+	proto.RegisterType((*TradeRecords)(nil), "injective.exchange.v1beta1.TradeRecords")
+	proto.RegisterType((*SubaccountIDs)(nil), "injective.exchange.v1beta1.SubaccountIDs")
+	proto.RegisterType((*TradeRecord)(nil), "injective.exchange.v1beta1.TradeRecord")
+	proto.RegisterType((*MarketTradeRecordRetention)(nil), "injective.exchange.v1beta1.MarketTradeRecordRetention")
+	proto.RegisterType((*Level)(nil), "injective.exchange.v1beta1.Level")
+	proto.RegisterType((*AggregateSubaccountVolumeRecord)(nil), "injective.exchange.v1beta1.AggregateSubaccountVolumeRecord")
+	proto.RegisterType((*AggregateAccountVolumeRecord)(nil), "injective.exchange.v1beta1.AggregateAccountVolumeRecord")
+	proto.RegisterType((*MarketVolume)(nil), "injective.exchange.v1beta1.MarketVolume")
+	proto.RegisterType((*DenomDecimals)(nil), "injective.exchange.v1beta1.DenomDecimals")
+	proto.RegisterType((*TerminalOrder)(nil), "injective.exchange.v1beta1.TerminalOrder")
+	proto.RegisterType((*LimitOrderFill)(nil), "injective.exchange.v1beta1.LimitOrderFill")
+	proto.RegisterType((*SubaccountPnl)(nil), "injective.exchange.v1beta1.SubaccountPnl")
+	proto.RegisterType((*RFQRequest)(nil), "injective.exchange.v1beta1.RFQRequest")
+	proto.RegisterType((*RFQQuote)(nil), "injective.exchange.v1beta1.RFQQuote")
+	proto.RegisterType((*SignedOrder)(nil), "injective.exchange.v1beta1.SignedOrder")
+	proto.RegisterType((*SignedOrderNonce)(nil), "injective.exchange.v1beta1.SignedOrderNonce")
+	proto.RegisterType((*SignedOrderMinNonce)(nil), "injective.exchange.v1beta1.SignedOrderMinNonce")
+}
+
+func init() {
+	proto.RegisterFile("injective/exchange/v1beta1/exchange.proto", fileDescriptor_2116e2804e9c53f9)
+}
+
+var fileDescriptor_2116e2804e9c53f9 = []byte{
+	// 5818 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5d, 0x6c, 0x24, 0xd9,
+	0x55, 0xff, 0x54, 0x7f, 0xb9, 0xfb, 0xf4, 0x87, 0xcb, 0xe5, 0xb6, 0xdd, 0xf6, 0xcc, 0xd8, 0xbd,
+	0xbd, 0xd9, 0x9d, 0xd9, 0xd9, 0x5d, 0x4f, 0x76, 0xfe, 0x7f, 0xa2, 0x30, 0x22, 0xd2, 0xfa, 0x73,
+	0xa7, 0x77, 0xfd, 0x35, 0xd5, 0xf6, 0x8e, 0x86, 0xb0, 0xa9, 0x2d, 0x77, 0x5d, 0xdb, 0x77, 0xa7,
+	0xba, 0xaa, 0xa7, 0xaa, 0xda, 0x63, 0x2f, 0x42, 0x42, 0x04, 0xa1, 0x64, 0x14, 0x29, 0xc0, 0x03,
+	0xe1, 0x65, 0xa4, 0xf0, 0x82, 0x44, 0x1e, 0x10, 0x12, 0x08, 0x21, 0x2d, 0x11, 0x8f, 0xe4, 0x31,
+	0xbc, 0x21, 0x84, 0x92, 0x68, 0x97, 0x40, 0x94, 0x37, 0x10, 0x0f, 0x40, 0x24, 0x84, 0xee, 0x57,
+	0x55, 0x75, 0x75, 0xbb, 0xed, 0xa9, 0x6e, 0xb3, 0x49, 0xc8, 0x93, 0xfb, 0x7e, 0xfd, 0xce, 0xbd,
+	0xe7, 0x9e, 0x7b, 0xce, 0xb9, 0xf7, 0xdc, 0x5b, 0x86, 0x57, 0xb0, 0xf5, 0x01, 0x6a, 0x7a, 0xf8,
+	0x18, 0xdd, 0x46, 0x27, 0xcd, 0x23, 0xdd, 0x3a, 0x44, 0xb7, 0x8f, 0xdf, 0xd8, 0x47, 0x9e, 0xfe,
+	0x86, 0x9f, 0xb1, 0xd8, 0x76, 0x6c, 0xcf, 0x56, 0xe6, 0xfc, 0xaa, 0x8b, 0x7e, 0x09, 0xaf, 0x3a,
+	0x57, 0x3e, 0xb4, 0x0f, 0x6d, 0x5a, 0xed, 0x36, 0xf9, 0xc5, 0x5a, 0xcc, 0xcd, 0x37, 0x6d, 0xb7,
+	0x65, 0xbb, 0xb7, 0xf7, 0x75, 0x37, 0x40, 0x6d, 0xda, 0xd8, 0xe2, 0xe5, 0x2f, 0x05, 0xc4, 0x6d,
+	0x47, 0x6f, 0x9a, 0x41, 0x25, 0x96, 0x64, 0xd5, 0x6a, 0xdf, 0x98, 0x82, 0xcc, 0x8e, 0xee, 0xe8,
+	0x2d, 0x57, 0x41, 0xb0, 0xe0, 0xb6, 0x6d, 0x4f, 0x6b, 0xe9, 0xce, 0x23, 0xe4, 0x69, 0xd8, 0x72,
+	0x3d, 0xdd, 0xf2, 0x34, 0x13, 0xbb, 0x1e, 0xb6, 0x0e, 0xb5, 0x03, 0x84, 0x2a, 0x52, 0x55, 0xba,
+	0x99, 0xbf, 0x33, 0xbb, 0xc8, 0x68, 0x2f, 0x12, 0xda, 0xa2, 0x9b, 0x8b, 0x2b, 0x36, 0xb6, 0x96,
+	0x53, 0xdf, 0xf9, 0xde, 0xc2, 0x15, 0xf5, 0x2a, 0xc1, 0xd9, 0xa4, 0x30, 0x75, 0x86, 0xb2, 0xc1,
+	0x40, 0xd6, 0x11, 0x52, 0x1e, 0xc3, 0x4b, 0x06, 0x72, 0xf0, 0xb1, 0x4e, 0xfa, 0x36, 0x88, 0x58,
+	0xe2, 0x62, 0xc4, 0x5e, 0x08, 0xd0, 0xce, 0x22, 0x69, 0xc2, 0x55, 0x03, 0x1d, 0xe8, 0x1d, 0xd3,
+	0xd3, 0xf8, 0x08, 0x1f, 0x21, 0x87, 0xd0, 0xd0, 0x1c, 0xdd, 0x43, 0x95, 0x64, 0x55, 0xba, 0x99,
+	0x5b, 0x5e, 0x24, 0x68, 0xff, 0xf0, 0xbd, 0x85, 0x97, 0x0f, 0xb1, 0x77, 0xd4, 0xd9, 0x5f, 0x6c,
+	0xda, 0xad, 0xdb, 0x9c, 0xc7, 0xec, 0xcf, 0xeb, 0xae, 0xf1, 0xe8, 0xb6, 0x77, 0xda, 0x46, 0xee,
+	0xe2, 0x2a, 0x6a, 0xaa, 0x33, 0x1c, 0xb2, 0x41, 0xc7, 0xfa, 0x08, 0x39, 0xeb, 0x08, 0xa9, 0xba,
+	0xd7, 0x4b, 0xcd, 0xeb, 0xa6, 0x96, 0x1a, 0x9a, 0xda, 0x6e, 0x98, 0xda, 0x09, 0xbc, 0x20, 0xa8,
+	0x75, 0xb1, 0xb5, 0x8b, 0x66, 0x3a, 0x16, 0xcd, 0xeb, 0x1c, 0x78, 0x35, 0xc4, 0xe0, 0x73, 0x29,
+	0x47, 0x46, 0x9b, 0x19, 0x11, 0xe5, 0xae, 0x31, 0xdb, 0x70, 0x4d, 0x50, 0xc6, 0x16, 0xf6, 0xb0,
+	0x6e, 0x12, 0x39, 0x3a, 0xc4, 0x16, 0xa1, 0x89, 0xed, 0xca, 0x58, 0x2c, 0xa2, 0xb3, 0x1c, 0xb3,
+	0xce, 0x20, 0x37, 0x29, 0xa2, 0x4a, 0x00, 0x95, 0x27, 0x50, 0x15, 0x04, 0x5b, 0x3a, 0xb6, 0x3c,
+	0x64, 0xe9, 0x56, 0x13, 0x75, 0x13, 0xcd, 0x0e, 0x35, 0xd2, 0xcd, 0x00, 0x36, 0x4c, 0xf8, 0xf3,
+	0x50, 0x11, 0x84, 0x0f, 0x3a, 0x96, 0x41, 0x96, 0x06, 0xa9, 0xe7, 0x1c, 0xeb, 0x66, 0x25, 0x57,
+	0x95, 0x6e, 0x26, 0xd5, 0x69, 0x5e, 0xbe, 0xce, 0x8a, 0xeb, 0xbc, 0x54, 0x79, 0x05, 0x64, 0xd1,
+	0xa2, 0xd5, 0x31, 0x3d, 0xdc, 0x36, 0x51, 0x05, 0x68, 0x8b, 0x71, 0x9e, 0xbf, 0xc9, 0xb3, 0x95,
+	0x26, 0x4c, 0x3b, 0xc8, 0xd4, 0x4f, 0xf9, 0xbc, 0xb9, 0x47, 0xba, 0xc3, 0x67, 0x2f, 0x1f, 0x6b,
+	0x4c, 0x93, 0x1c, 0x6d, 0x1d, 0xa1, 0x06, 0xc1, 0xa2, 0x73, 0xe6, 0xc1, 0x82, 0x18, 0xc9, 0x91,
+	0xdd, 0x71, 0xcc, 0x53, 0x7f, 0x40, 0x84, 0x92, 0xd6, 0xd4, 0xdb, 0x95, 0x42, 0x2c, 0x6a, 0x62,
+	0xb1, 0xdd, 0xa3, 0xa8, 0x9c, 0x0d, 0x84, 0xe4, 0x8a, 0xde, 0x0e, 0x4b, 0x0a, 0xa7, 0x4a, 0xd9,
+	0x87, 0x5c, 0x8f, 0x0d, 0xb0, 0x38, 0x94, 0xa4, 0x30, 0x92, 0x75, 0x8e, 0x48, 0x87, 0xb9, 0x0a,
+	0x0b, 0x2d, 0xfd, 0x24, 0xbc, 0x20, 0x6c, 0xc7, 0x40, 0x8e, 0xe6, 0x62, 0x03, 0x69, 0x4d, 0xbb,
+	0x63, 0x79, 0x95, 0x52, 0x55, 0xba, 0x59, 0x54, 0xaf, 0xb6, 0xf4, 0x93, 0x40, 0xbc, 0xb7, 0x49,
+	0xa5, 0x06, 0x36, 0xd0, 0x0a, 0xa9, 0xa2, 0xfc, 0xb6, 0x04, 0x37, 0xb0, 0xf5, 0x81, 0xe6, 0xa0,
+	0x27, 0xba, 0x63, 0x68, 0x2e, 0x59, 0x54, 0x86, 0xe6, 0xa0, 0xc7, 0x1d, 0xec, 0xa0, 0x16, 0xb2,
+	0x3c, 0xcd, 0x3b, 0x72, 0x90, 0x7b, 0x64, 0x9b, 0x46, 0x65, 0xfc, 0xb9, 0x87, 0x50, 0xb7, 0x3c,
+	0xf5, 0x45, 0x6c, 0x7d, 0xa0, 0x52, 0xf4, 0x06, 0x05, 0x57, 0x03, 0xec, 0x5d, 0x01, 0xad, 0xbc,
+	0x05, 0x55, 0xcf, 0xd1, 0xd9, 0x24, 0xd1, 0xba, 0xae, 0x76, 0x8c, 0x98, 0x82, 0x36, 0x3a, 0x54,
+	0xea, 0xad, 0x8a, 0x4c, 0x65, 0xea, 0x3a, 0xaf, 0xc7, 0x20, 0xdd, 0x77, 0x59, 0xad, 0x55, 0x5e,
+	0x89, 0x4c, 0x83, 0x89, 0x1f, 0x77, 0xb0, 0xa1, 0x7b, 0xb6, 0xe3, 0x8f, 0x2a, 0x90, 0xb3, 0x89,
+	0x78, 0xd3, 0x10, 0x60, 0xf2, 0xa1, 0xf8, 0xd2, 0x76, 0x02, 0xaf, 0xec, 0x63, 0x4b, 0x77, 0x4e,
+	0x35, 0xbb, 0x4d, 0x7a, 0xe0, 0x0e, 0x32, 0x34, 0xca, 0xc5, 0x0c, 0xcd, 0x67, 0x18, 0xe2, 0x36,
+	0x03, 0x3c, 0xcb, 0xd6, 0xfc, 0xa6, 0x04, 0x55, 0xdd, 0xb3, 0x5b, 0xb8, 0x29, 0x48, 0x32, 0x01,
+	0xd0, 0x9b, 0x4d, 0xe4, 0xba, 0x9a, 0x89, 0x8e, 0x91, 0x59, 0x99, 0xac, 0x4a, 0x37, 0x4b, 0x77,
+	0x3e, 0xbf, 0x78, 0xb6, 0xd5, 0x5f, 0x5c, 0xa2, 0x18, 0x8c, 0x0a, 0x95, 0x8e, 0x25, 0x0a, 0xb0,
+	0x41, 0xda, 0xab, 0xd7, 0xf4, 0x01, 0xa5, 0xca, 0x97, 0x25, 0xb8, 0x41, 0x2d, 0x4f, 0xbf, 0x7e,
+	0x90, 0x15, 0xce, 0x15, 0x02, 0x46, 0x4e, 0xa5, 0x1c, 0x8b, 0xf3, 0x35, 0x02, 0xdf, 0xd3, 0xc3,
+	0x75, 0x84, 0x36, 0x7d, 0x64, 0xe5, 0xeb, 0x12, 0xbc, 0x1e, 0x5a, 0x06, 0x17, 0xe8, 0xcb, 0x54,
+	0xac, 0xbe, 0xdc, 0x0c, 0x88, 0x9c, 0xd3, 0xa3, 0x3f, 0x90, 0xe0, 0x8d, 0x88, 0x54, 0x5c, 0xa0,
+	0x57, 0xd3, 0xb1, 0x7a, 0xf5, 0x6a, 0x97, 0xb0, 0x9c, 0xd3, 0x31, 0x0c, 0xb3, 0x2d, 0x6c, 0xe1,
+	0x96, 0x6e, 0x6a, 0xd4, 0x2b, 0x6b, 0xda, 0x66, 0x60, 0x41, 0x67, 0x62, 0xd1, 0x9f, 0xe6, 0x80,
+	0x3b, 0x1c, 0x4f, 0x98, 0xce, 0x2f, 0xc2, 0xab, 0xd8, 0xf5, 0x57, 0x41, 0xaf, 0x23, 0x66, 0xea,
+	0x1d, 0xab, 0x79, 0xa4, 0x21, 0x4b, 0xdf, 0x37, 0x91, 0x51, 0xa9, 0x54, 0xa5, 0x9b, 0x59, 0xf5,
+	0x65, 0xec, 0x72, 0x41, 0x5f, 0x8d, 0xf8, 0x5a, 0x1b, 0xb4, 0xfa, 0x1a, 0xab, 0xad, 0xac, 0xc1,
+	0x82, 0x87, 0x9c, 0x16, 0xb6, 0x74, 0x93, 0xf3, 0xd2, 0x41, 0x1e, 0xb2, 0x08, 0x0b, 0xb4, 0x7d,
+	0xd3, 0x6e, 0x3e, 0x72, 0x2b, 0xb3, 0x54, 0x5d, 0x5c, 0x13, 0xd5, 0x28, 0x33, 0x54, 0x51, 0x69,
+	0x99, 0xd6, 0xb9, 0x9b, 0xfa, 0xd1, 0x37, 0x17, 0xa4, 0xda, 0xd7, 0x25, 0x98, 0x64, 0x44, 0xba,
+	0x99, 0x75, 0x15, 0x72, 0x62, 0x2d, 0x1b, 0xd4, 0x21, 0xcd, 0xa9, 0x59, 0x96, 0x51, 0x37, 0x94,
+	0x3d, 0x28, 0x45, 0xa6, 0x2f, 0x11, 0x8b, 0x7d, 0xc5, 0x83, 0x30, 0xcd, 0xbb, 0xa9, 0xaf, 0x7c,
+	0x73, 0xe1, 0x4a, 0xed, 0x4f, 0xb3, 0x20, 0x47, 0x19, 0xa0, 0x4c, 0x43, 0xc6, 0xc3, 0xcd, 0x47,
+	0xc8, 0xe1, 0x7d, 0xe1, 0x29, 0x65, 0x01, 0xf2, 0xcc, 0xd1, 0xd6, 0x88, 0x3e, 0x61, 0xdd, 0x50,
+	0x81, 0x65, 0x2d, 0xeb, 0x2e, 0x52, 0x5e, 0x80, 0x02, 0xaf, 0xf0, 0xb8, 0x63, 0x0b, 0x2f, 0x54,
+	0xe5, 0x8d, 0xee, 0x93, 0x2c, 0x65, 0xcd, 0xc7, 0x20, 0x3d, 0xa3, 0x9e, 0x63, 0xe9, 0xce, 0x67,
+	0x42, 0x5a, 0x83, 0x95, 0xfa, 0x3a, 0x63, 0x9b, 0x26, 0x77, 0x4f, 0xdb, 0x48, 0x50, 0x22, 0xbf,
+	0x95, 0x45, 0x98, 0xe4, 0x30, 0x6e, 0x53, 0x37, 0x91, 0x76, 0xa0, 0x37, 0x3d, 0xdb, 0xa1, 0x4e,
+	0x61, 0x51, 0x9d, 0x60, 0x45, 0x0d, 0x52, 0xb2, 0x4e, 0x0b, 0x48, 0xd7, 0x69, 0x97, 0x34, 0x03,
+	0x59, 0x76, 0x8b, 0xb9, 0x70, 0x2a, 0xd0, 0xac, 0x55, 0x92, 0xd3, 0x3d, 0x05, 0x63, 0x91, 0x29,
+	0x78, 0x1f, 0xca, 0x7d, 0x9d, 0xb2, 0x78, 0xfe, 0x91, 0x82, 0x7b, 0xbd, 0xb1, 0x23, 0xa8, 0x9c,
+	0xe9, 0x85, 0xe5, 0x62, 0xae, 0x96, 0xfe, 0xee, 0xd7, 0x2e, 0x94, 0x22, 0x9e, 0x34, 0xc4, 0xc2,
+	0x2f, 0xb4, 0xc2, 0xee, 0xeb, 0x2e, 0x94, 0x22, 0x5e, 0x72, 0x3c, 0x3f, 0xab, 0xe0, 0x85, 0x51,
+	0xcf, 0xf6, 0xe2, 0x0a, 0xa3, 0xf3, 0xe2, 0xaa, 0x90, 0xc7, 0xee, 0x0e, 0x72, 0xda, 0xc8, 0xeb,
+	0xe8, 0x26, 0x75, 0x9f, 0xb2, 0x6a, 0x38, 0x4b, 0x79, 0x13, 0x32, 0xae, 0xa7, 0x7b, 0x1d, 0x97,
+	0xfa, 0x39, 0xa5, 0x3b, 0x37, 0x07, 0x19, 0x39, 0xb6, 0x86, 0x1a, 0xb4, 0xbe, 0xca, 0xdb, 0x29,
+	0xef, 0xc1, 0x64, 0x0b, 0x5b, 0x5a, 0xdb, 0xc1, 0x4d, 0xa4, 0x91, 0xd5, 0xa4, 0xb9, 0xf8, 0x43,
+	0x54, 0x19, 0x8f, 0x35, 0x0a, 0xb9, 0x85, 0xad, 0x1d, 0x82, 0xb4, 0x8b, 0x9b, 0x8f, 0x1a, 0xf8,
+	0x43, 0xca, 0x27, 0x02, 0xff, 0xb8, 0xa3, 0x5b, 0x1e, 0xf6, 0x4e, 0x43, 0x14, 0xe4, 0x78, 0x7c,
+	0x6a, 0x61, 0xeb, 0x3e, 0x07, 0x13, 0x44, 0xb8, 0xc2, 0xf8, 0x41, 0x0e, 0x26, 0x97, 0x7b, 0x9d,
+	0x86, 0x33, 0x75, 0xc6, 0x8b, 0x50, 0x14, 0x0b, 0xf5, 0xb4, 0xb5, 0x6f, 0x9b, 0x5c, 0x6b, 0x70,
+	0x3d, 0xd1, 0xa0, 0x79, 0xca, 0x0d, 0x18, 0xe7, 0x95, 0xda, 0x8e, 0x7d, 0x8c, 0x0d, 0xe4, 0x70,
+	0xd5, 0x51, 0x62, 0xd9, 0x3b, 0x3c, 0xf7, 0xd3, 0xd2, 0x1e, 0x6f, 0x40, 0x19, 0x9d, 0xb4, 0x31,
+	0xf3, 0xfc, 0x34, 0x0f, 0xb7, 0x90, 0xeb, 0xe9, 0xad, 0x36, 0x55, 0x23, 0x49, 0x75, 0x32, 0x28,
+	0xdb, 0x15, 0x45, 0xa4, 0x89, 0x8b, 0x3c, 0xcf, 0xe4, 0xae, 0xad, 0xdf, 0x64, 0x8c, 0x35, 0x09,
+	0xca, 0x82, 0x26, 0x65, 0x48, 0xeb, 0x46, 0x0b, 0x5b, 0x4c, 0xad, 0xa8, 0x2c, 0x11, 0xd5, 0x5c,
+	0xb9, 0xc1, 0x9a, 0x0b, 0x22, 0x9a, 0xab, 0x77, 0xb5, 0xe7, 0x2f, 0x65, 0xb5, 0x17, 0x2e, 0x75,
+	0xb5, 0x17, 0x47, 0xb7, 0xda, 0x7f, 0xb1, 0x96, 0x09, 0x91, 0x87, 0x20, 0x87, 0xa4, 0x93, 0x0e,
+	0x25, 0xb4, 0x61, 0x91, 0x9e, 0x03, 0x7e, 0x3c, 0xc0, 0xa1, 0xe3, 0x50, 0x7e, 0x0d, 0x14, 0xb2,
+	0xa8, 0x74, 0x47, 0x33, 0xed, 0x27, 0xc8, 0xd1, 0xf6, 0xed, 0x8e, 0x65, 0x54, 0x94, 0x58, 0xe0,
+	0x32, 0x43, 0xda, 0x20, 0x40, 0xcb, 0x04, 0x27, 0x84, 0xde, 0x69, 0xb7, 0x7d, 0xf4, 0xc9, 0x61,
+	0xd0, 0xf7, 0xda, 0x6d, 0x8e, 0xce, 0x55, 0xdc, 0x3f, 0x01, 0x94, 0xdf, 0xd5, 0x2d, 0x6c, 0x9a,
+	0xfa, 0xc5, 0x74, 0xdc, 0xcf, 0xb0, 0x5f, 0xf4, 0x16, 0xe4, 0xd9, 0xbe, 0x81, 0x91, 0xcd, 0x50,
+	0xb2, 0x2f, 0x0f, 0x5a, 0x13, 0x8c, 0x25, 0x9c, 0xb0, 0xff, 0x5b, 0xb9, 0x0f, 0x05, 0xd7, 0x73,
+	0xf0, 0x23, 0xc4, 0xa5, 0x29, 0xde, 0x79, 0x55, 0x9e, 0x61, 0x30, 0x49, 0xd2, 0x60, 0xb2, 0x69,
+	0x5b, 0x9e, 0xa3, 0x37, 0xbd, 0xb0, 0xf7, 0x1b, 0xd3, 0xe9, 0x12, 0x50, 0x21, 0xb7, 0xfb, 0x7d,
+	0x28, 0x93, 0x83, 0x8d, 0x8e, 0x65, 0x20, 0xc7, 0x3c, 0x25, 0x5b, 0x67, 0xd6, 0xf7, 0x78, 0x0e,
+	0x97, 0xd2, 0xd2, 0x4f, 0xf6, 0x7c, 0x28, 0x36, 0x84, 0xb3, 0x0c, 0x07, 0x3c, 0xbf, 0xe1, 0xc8,
+	0x9f, 0x6d, 0x38, 0x22, 0x26, 0xa2, 0x30, 0xd8, 0x44, 0x14, 0xcf, 0x35, 0x11, 0xa5, 0x4b, 0x31,
+	0x11, 0xe3, 0x97, 0x6a, 0x22, 0xe4, 0xcb, 0x30, 0x11, 0x13, 0xa3, 0x35, 0x11, 0xca, 0xa5, 0x9b,
+	0x88, 0xc9, 0xcb, 0x35, 0x11, 0xe5, 0x91, 0x98, 0x08, 0xae, 0x66, 0x7f, 0x98, 0x82, 0x89, 0x15,
+	0xdd, 0x43, 0x87, 0xb6, 0x83, 0x9b, 0xba, 0x79, 0x8e, 0x8e, 0xfd, 0x85, 0x1f, 0xf9, 0xa9, 0xfa,
+	0x91, 0x73, 0x90, 0xb5, 0x3b, 0x5e, 0xd3, 0x6e, 0x21, 0xb7, 0x92, 0xaf, 0x26, 0x49, 0x99, 0x48,
+	0x2b, 0xaf, 0x81, 0xc2, 0x7f, 0xfb, 0x27, 0x92, 0x86, 0x5b, 0x29, 0xd0, 0x5a, 0x32, 0x2f, 0xe1,
+	0x47, 0x8b, 0x86, 0x1b, 0x5a, 0x5d, 0xc5, 0x98, 0xab, 0xeb, 0x06, 0x8c, 0x3f, 0xc1, 0x96, 0x45,
+	0xf4, 0x35, 0x47, 0x67, 0x1a, 0x4b, 0x2d, 0xf1, 0xec, 0x6d, 0x96, 0xcb, 0xe5, 0xec, 0x27, 0x09,
+	0x98, 0x59, 0x23, 0x9c, 0x3d, 0x5d, 0xef, 0x78, 0x1d, 0x07, 0xf9, 0xc7, 0x9c, 0x07, 0xf6, 0xe0,
+	0x83, 0x97, 0xb3, 0x66, 0x2b, 0x71, 0xf6, 0x6c, 0x7d, 0x16, 0xca, 0xde, 0x13, 0xbd, 0x4d, 0x4e,
+	0xb7, 0x9d, 0xf0, 0x6c, 0x25, 0x69, 0x13, 0x85, 0x94, 0x35, 0x48, 0x51, 0xd0, 0xe2, 0xb7, 0x24,
+	0x78, 0x39, 0x4c, 0x25, 0x68, 0xcd, 0xb4, 0x47, 0xb3, 0xd3, 0xea, 0x98, 0xf4, 0x70, 0x26, 0x66,
+	0x94, 0xad, 0x16, 0xea, 0xa7, 0x20, 0x4f, 0x97, 0xe1, 0x8a, 0x8f, 0xdc, 0x77, 0xad, 0xc7, 0x8b,
+	0xaf, 0x45, 0xd7, 0x7a, 0xed, 0xef, 0xb2, 0x30, 0xdb, 0x87, 0xfb, 0x0d, 0xe4, 0x60, 0xe4, 0x12,
+	0xfe, 0xbb, 0xf4, 0x57, 0x88, 0xff, 0x2c, 0xa3, 0x6e, 0x90, 0x25, 0xcf, 0x16, 0xbf, 0xd6, 0x76,
+	0xd0, 0x01, 0x3e, 0x11, 0x4b, 0x9e, 0x65, 0xee, 0xd0, 0xbc, 0xa8, 0x58, 0x27, 0x7b, 0xc4, 0x3a,
+	0xe2, 0x9c, 0xa5, 0xce, 0x75, 0xce, 0xd2, 0xe7, 0x3a, 0x67, 0x99, 0xd1, 0xaa, 0x8b, 0xb1, 0xb3,
+	0xd4, 0xc5, 0x1c, 0x64, 0xfd, 0xc8, 0x58, 0x96, 0x4a, 0x90, 0x9f, 0x26, 0x9c, 0x33, 0x91, 0x6e,
+	0x50, 0x19, 0xe3, 0x61, 0xb3, 0x2c, 0xc9, 0x20, 0x92, 0xa5, 0xdc, 0x85, 0x59, 0x0b, 0x9d, 0x78,
+	0xda, 0x00, 0xdf, 0x63, 0x86, 0x54, 0x58, 0xeb, 0x23, 0xc2, 0x67, 0x9d, 0x75, 0xe5, 0xff, 0x57,
+	0xce, 0xba, 0x0a, 0x97, 0x7c, 0xd6, 0x55, 0xbc, 0x14, 0xd7, 0xa6, 0x34, 0x02, 0xd7, 0xe6, 0xe7,
+	0x61, 0x5b, 0x79, 0x1d, 0x20, 0x64, 0x01, 0x26, 0xa8, 0x05, 0xc8, 0xb5, 0xfa, 0xa8, 0x7e, 0x25,
+	0x9e, 0xea, 0xe7, 0x1a, 0xfd, 0x21, 0x4c, 0x75, 0xa9, 0x94, 0xa5, 0x8e, 0x67, 0xab, 0xb6, 0x69,
+	0x9e, 0xab, 0x4e, 0xdc, 0xce, 0xbe, 0xde, 0xa4, 0x11, 0x4b, 0x52, 0x81, 0xab, 0x93, 0x20, 0xb3,
+	0x6e, 0xd4, 0xfe, 0x31, 0x01, 0x93, 0xfe, 0xc1, 0xdf, 0x45, 0x0d, 0x05, 0x82, 0x99, 0xb3, 0xe2,
+	0xbf, 0xf1, 0x8e, 0xea, 0xcb, 0x47, 0xfd, 0x02, 0xbf, 0xef, 0x43, 0xb9, 0x6f, 0xc0, 0x37, 0xde,
+	0x5d, 0x0f, 0xe5, 0xa8, 0x37, 0xd2, 0xfb, 0xff, 0x61, 0x9a, 0xea, 0x0d, 0x31, 0x8c, 0x40, 0x69,
+	0xa4, 0xa8, 0xd2, 0x28, 0x93, 0x52, 0xde, 0xab, 0x40, 0x63, 0x84, 0xc2, 0xf2, 0xbe, 0xba, 0x4a,
+	0x77, 0x85, 0xe5, 0x45, 0x04, 0xbf, 0xf6, 0x9f, 0x12, 0x4c, 0x47, 0xd8, 0xcb, 0xe1, 0x94, 0xf7,
+	0x40, 0x09, 0x6c, 0x9d, 0xe8, 0x41, 0x45, 0x8a, 0x35, 0xb6, 0x89, 0x00, 0x49, 0xc0, 0x3f, 0x04,
+	0x39, 0x04, 0xcf, 0x4c, 0x5c, 0xbc, 0xc9, 0x19, 0x0f, 0x70, 0xd8, 0x26, 0xef, 0x25, 0x28, 0x99,
+	0xba, 0xdb, 0x6b, 0xee, 0x8b, 0x24, 0xd7, 0x67, 0x53, 0xed, 0x9f, 0xd3, 0x70, 0x6d, 0xc7, 0x41,
+	0x2c, 0xbc, 0xf4, 0xdc, 0x32, 0x36, 0x0d, 0x99, 0x27, 0xd8, 0x32, 0xec, 0x27, 0xdc, 0xfd, 0xe0,
+	0x29, 0x65, 0x93, 0x2d, 0x39, 0x3e, 0xa2, 0x78, 0xa2, 0x40, 0xc9, 0xb2, 0xb1, 0x6c, 0x02, 0xd0,
+	0xb1, 0x30, 0xb8, 0x78, 0x1e, 0x47, 0x8e, 0x20, 0x30, 0xb8, 0x03, 0x98, 0x61, 0xfd, 0xd4, 0x7a,
+	0x98, 0x1f, 0xcf, 0xbf, 0x98, 0x62, 0x70, 0x2b, 0x91, 0x29, 0x78, 0x00, 0xe3, 0x9c, 0x8e, 0x50,
+	0x70, 0x31, 0x6f, 0xe9, 0x94, 0x18, 0x8c, 0xd0, 0x6c, 0x64, 0x45, 0x70, 0xe0, 0xa8, 0x4b, 0xc7,
+	0x1c, 0xf0, 0x32, 0x2b, 0x8d, 0x38, 0x75, 0x5f, 0x62, 0xba, 0x3c, 0xda, 0xa5, 0x78, 0x27, 0x17,
+	0x13, 0x2d, 0x6c, 0x3d, 0xe8, 0xee, 0xd5, 0x07, 0x30, 0x47, 0x0e, 0x2e, 0x82, 0x89, 0xd7, 0x98,
+	0xf2, 0x64, 0xfa, 0x20, 0x76, 0xbc, 0xe8, 0x64, 0x53, 0xc8, 0xc1, 0x0a, 0x85, 0xa3, 0x3a, 0xe1,
+	0xb3, 0x50, 0x0e, 0x24, 0xa2, 0xc7, 0x8d, 0x50, 0xfc, 0xb9, 0x0e, 0x04, 0xfd, 0x0f, 0x25, 0x98,
+	0x8f, 0xc6, 0x14, 0x1b, 0xbe, 0x5b, 0x78, 0xbe, 0xa8, 0xf7, 0xf3, 0x46, 0x13, 0xa3, 0xf1, 0x46,
+	0xbf, 0x00, 0xe5, 0xad, 0x7e, 0x2a, 0xec, 0x25, 0x28, 0x51, 0xc5, 0x17, 0x0c, 0x4f, 0x62, 0x4b,
+	0x98, 0xe4, 0x86, 0x46, 0x96, 0x06, 0x68, 0xf8, 0xf7, 0x00, 0xcf, 0xdc, 0xab, 0x5e, 0x07, 0x20,
+	0xbe, 0x26, 0x77, 0x49, 0x99, 0x99, 0xc9, 0x91, 0x1c, 0xdf, 0x23, 0x1d, 0xec, 0xb2, 0xf6, 0xba,
+	0x2d, 0xa9, 0x4b, 0x71, 0x5b, 0xd2, 0x97, 0x7a, 0x22, 0x93, 0x19, 0xdd, 0x89, 0xcc, 0xc0, 0xe0,
+	0x6c, 0xe0, 0x55, 0x64, 0x47, 0x7b, 0x5c, 0x93, 0xbb, 0x74, 0xd7, 0x0b, 0x46, 0xe6, 0x7a, 0xd5,
+	0x3e, 0x92, 0x60, 0x6c, 0x15, 0xb5, 0x6d, 0x17, 0x7b, 0xca, 0x17, 0x61, 0x42, 0x3f, 0xd6, 0xb1,
+	0x49, 0x6e, 0x30, 0x68, 0xfb, 0xba, 0x49, 0xdc, 0xe2, 0x98, 0x96, 0x54, 0xf6, 0x81, 0x96, 0x19,
+	0x8e, 0xd2, 0x80, 0xa2, 0x67, 0x7b, 0xba, 0xe9, 0x03, 0x27, 0x62, 0x4a, 0x11, 0x01, 0xe1, 0xa0,
+	0xb5, 0xd7, 0xa0, 0xdc, 0xf0, 0xdd, 0xb0, 0x5d, 0x47, 0x37, 0xd0, 0x96, 0x4d, 0x88, 0x95, 0x21,
+	0x6d, 0xd9, 0xa2, 0xf7, 0x45, 0x95, 0x25, 0x6a, 0xff, 0x22, 0x41, 0x8e, 0xde, 0xb2, 0xa0, 0xba,
+	0xa4, 0xc7, 0xaf, 0x93, 0x7a, 0xfd, 0x3a, 0x52, 0x89, 0x8a, 0x3d, 0x6a, 0xe2, 0x36, 0x46, 0x96,
+	0x27, 0x9c, 0xbf, 0x03, 0x84, 0x54, 0x91, 0xa7, 0xac, 0x42, 0x7a, 0x18, 0x33, 0xca, 0x1a, 0x2b,
+	0x6f, 0x43, 0xd6, 0xd7, 0xf8, 0xf1, 0xd6, 0xad, 0xdf, 0xbe, 0xf6, 0xe3, 0x04, 0xe4, 0x88, 0xc2,
+	0xa1, 0xa3, 0x1d, 0xac, 0x35, 0xdf, 0x06, 0x60, 0xf7, 0x53, 0xb0, 0x75, 0x60, 0xf3, 0x8b, 0xc6,
+	0x2f, 0x0d, 0x3c, 0xc8, 0x17, 0x1c, 0xe4, 0x77, 0xc1, 0x72, 0xb6, 0xcf, 0xd2, 0x55, 0x81, 0x45,
+	0xb7, 0xbb, 0x49, 0xba, 0xac, 0xce, 0xc7, 0xa2, 0xfb, 0xdd, 0x9c, 0x2d, 0x7e, 0x52, 0x49, 0x71,
+	0xf0, 0xe1, 0x21, 0xdd, 0xc0, 0x77, 0xbb, 0x13, 0xd2, 0x73, 0x49, 0x0a, 0x03, 0x61, 0x96, 0xfe,
+	0x21, 0xc8, 0xc7, 0xd8, 0xc5, 0xfb, 0x74, 0xbb, 0xce, 0xb9, 0x9c, 0x8e, 0x77, 0x2c, 0xc9, 0x71,
+	0xc4, 0x52, 0xaa, 0x7d, 0x2b, 0x09, 0x25, 0xc2, 0xec, 0x0d, 0xdc, 0xc2, 0x9c, 0xe3, 0xdd, 0x4c,
+	0x95, 0x46, 0xc8, 0xd4, 0x44, 0x4c, 0xa6, 0xbe, 0x0d, 0xd9, 0x03, 0x12, 0x99, 0xda, 0x37, 0xe3,
+	0x8a, 0xa9, 0xdf, 0xfe, 0x72, 0x26, 0xe8, 0xba, 0x18, 0xe6, 0x91, 0xee, 0x1e, 0xd1, 0xa9, 0x29,
+	0xf0, 0xfe, 0xdf, 0xd3, 0xdd, 0x23, 0x65, 0x1d, 0xc6, 0x70, 0x13, 0xed, 0x23, 0xe7, 0x90, 0x1a,
+	0x88, 0xfc, 0x9d, 0xd7, 0x06, 0xb1, 0xa0, 0xce, 0xaa, 0xfa, 0x5c, 0x55, 0x45, 0xe3, 0xda, 0xb7,
+	0x93, 0x30, 0x1e, 0x98, 0xe2, 0xd1, 0xcf, 0xd6, 0x7d, 0x28, 0x70, 0x05, 0xa7, 0xd1, 0x2b, 0xa9,
+	0xf1, 0xb4, 0x5c, 0x9e, 0x63, 0xdc, 0x23, 0x57, 0x4f, 0xbb, 0x39, 0x93, 0x8c, 0x72, 0xa6, 0x5b,
+	0x3e, 0x52, 0xa3, 0x5a, 0x74, 0xe9, 0x11, 0xcc, 0xe9, 0x7d, 0x28, 0x30, 0x8f, 0x45, 0x6f, 0xd1,
+	0xeb, 0xbe, 0x99, 0x58, 0x98, 0xcc, 0xeb, 0x59, 0xa2, 0x10, 0xb5, 0xbf, 0x4a, 0xc2, 0x78, 0xe4,
+	0xae, 0xf0, 0xcf, 0x9a, 0x7e, 0x5b, 0x87, 0x0c, 0x3b, 0xbb, 0x8a, 0xa9, 0xe6, 0x79, 0xeb, 0xcb,
+	0x99, 0xb2, 0x7e, 0x7a, 0x32, 0x33, 0x1a, 0x3d, 0xf9, 0xfb, 0x29, 0xb8, 0x1a, 0x58, 0x6b, 0xca,
+	0x9a, 0x7d, 0xdb, 0x7e, 0xb4, 0x89, 0x3c, 0xdd, 0xd0, 0x3d, 0x5d, 0xf9, 0x65, 0x98, 0x3d, 0x66,
+	0xe1, 0x73, 0xcd, 0x24, 0xaa, 0x94, 0xdf, 0x9b, 0xa4, 0xb5, 0xb9, 0x21, 0x9f, 0xe6, 0x15, 0x02,
+	0x55, 0xcb, 0x2e, 0x89, 0xbf, 0x09, 0xd7, 0x1d, 0x64, 0x74, 0x9a, 0x48, 0xb3, 0x2d, 0xf3, 0xb4,
+	0x4f, 0xf3, 0x04, 0x6d, 0x3e, 0xcb, 0x2a, 0x6d, 0x5b, 0xe6, 0x69, 0x14, 0xc1, 0x85, 0x79, 0xfd,
+	0xf0, 0xd0, 0x41, 0x87, 0xe4, 0x04, 0x26, 0x8c, 0xe5, 0x73, 0x21, 0x9e, 0xd6, 0xbc, 0xea, 0xa3,
+	0xaa, 0x3e, 0x6d, 0x7f, 0x3f, 0x66, 0xc2, 0x5c, 0x40, 0x54, 0x8c, 0x7d, 0x48, 0x27, 0xa0, 0xe2,
+	0x23, 0xf2, 0xbb, 0x08, 0x3e, 0xb5, 0x35, 0x58, 0x10, 0x34, 0x9a, 0xb6, 0x65, 0x60, 0x0f, 0xdb,
+	0xc1, 0xed, 0x54, 0xc6, 0x26, 0x16, 0x81, 0xba, 0xc6, 0xab, 0xad, 0x04, 0xb5, 0x42, 0x9c, 0xda,
+	0x80, 0x17, 0xc3, 0xfc, 0x39, 0x0b, 0x2a, 0x43, 0xa1, 0x16, 0x02, 0x8e, 0xf7, 0x45, 0xab, 0xfd,
+	0xad, 0x04, 0xe3, 0x11, 0xa1, 0x08, 0xfc, 0x29, 0x69, 0x54, 0xfe, 0x54, 0x62, 0x38, 0x7f, 0x4a,
+	0xa9, 0x41, 0x01, 0xbb, 0xc1, 0x04, 0x52, 0x59, 0xc8, 0xaa, 0x5d, 0x79, 0xb5, 0x27, 0x30, 0x19,
+	0x19, 0xc8, 0x2a, 0x91, 0xea, 0x25, 0x48, 0x53, 0xb6, 0x70, 0xbb, 0xf2, 0xea, 0x20, 0x75, 0x11,
+	0x69, 0xaf, 0xb2, 0x96, 0x11, 0x03, 0x90, 0x88, 0x18, 0x80, 0xda, 0x7f, 0x24, 0xa1, 0x1c, 0xa8,
+	0xc4, 0x9f, 0x6a, 0x2f, 0x24, 0x50, 0x7d, 0xc9, 0xa1, 0x54, 0x5f, 0xd8, 0x9b, 0x49, 0x8d, 0xda,
+	0x9b, 0x49, 0x8f, 0xdc, 0x9b, 0xc9, 0x0c, 0xf0, 0x66, 0xc6, 0x86, 0xf1, 0x66, 0x7e, 0x92, 0x00,
+	0x39, 0x5a, 0xda, 0x57, 0x85, 0xc7, 0x5b, 0x49, 0x51, 0x15, 0x4e, 0xce, 0xcb, 0x8e, 0xb0, 0x61,
+	0xa0, 0x60, 0x57, 0x1a, 0x73, 0x69, 0x95, 0x18, 0x8c, 0x0f, 0xdc, 0x80, 0x22, 0x07, 0x1e, 0x4a,
+	0x3e, 0x0a, 0x0c, 0x84, 0xc5, 0x72, 0xc8, 0x71, 0x1a, 0x07, 0xed, 0x72, 0xc9, 0xe2, 0x09, 0xcc,
+	0x04, 0x83, 0x5a, 0x0e, 0x1c, 0xb3, 0xda, 0x5f, 0x26, 0x61, 0x2a, 0x7a, 0x60, 0xf5, 0xf3, 0xbe,
+	0xf2, 0xb6, 0x21, 0xcf, 0x7e, 0x0d, 0xc3, 0x4b, 0x60, 0x10, 0xd4, 0xbb, 0xfd, 0x14, 0x96, 0x5f,
+	0xed, 0xfb, 0x00, 0xb9, 0xdd, 0x07, 0x4b, 0x3b, 0xff, 0xa7, 0xdd, 0xc7, 0x69, 0xc8, 0xb8, 0x26,
+	0x6e, 0x22, 0x97, 0x72, 0x3c, 0xa5, 0xf2, 0x14, 0xb9, 0x26, 0x21, 0xc2, 0x31, 0xe2, 0xa5, 0x4a,
+	0x86, 0x56, 0x28, 0x89, 0x6c, 0xf6, 0x36, 0x85, 0xc4, 0x6f, 0xfc, 0x8a, 0x2e, 0x22, 0x7e, 0x80,
+	0xcb, 0x4f, 0xb7, 0x7d, 0x80, 0x06, 0xcb, 0x8e, 0xcc, 0x47, 0x36, 0xaa, 0x0e, 0x5f, 0x84, 0x22,
+	0x76, 0x43, 0x2f, 0x70, 0x2a, 0x39, 0x61, 0x5f, 0x83, 0xe5, 0x45, 0xfa, 0x85, 0x4e, 0x50, 0xb3,
+	0xe3, 0x21, 0x43, 0xe3, 0x1d, 0x07, 0xd6, 0x2f, 0x91, 0xdd, 0x60, 0x03, 0xb8, 0x05, 0x13, 0xf4,
+	0x50, 0x96, 0x56, 0xd2, 0x8e, 0x10, 0x3e, 0x3c, 0xf2, 0xf8, 0x35, 0xb8, 0x71, 0x52, 0x40, 0xab,
+	0xdd, 0xa3, 0xd9, 0xe4, 0x94, 0x3a, 0x54, 0x37, 0x38, 0xc6, 0x2d, 0xd0, 0xea, 0x8a, 0x5f, 0x3d,
+	0x38, 0xf2, 0x8d, 0x6e, 0xf0, 0x8a, 0xc3, 0x6f, 0xf0, 0x1e, 0xc0, 0x38, 0xb1, 0x46, 0xc8, 0x08,
+	0xb4, 0x6a, 0xbc, 0xc8, 0x70, 0x89, 0xc1, 0x84, 0xd5, 0x35, 0x07, 0xb6, 0x6c, 0xe6, 0x79, 0x55,
+	0xc6, 0x87, 0x01, 0xde, 0xe2, 0x28, 0x24, 0xe8, 0xe6, 0x20, 0x12, 0x3c, 0x27, 0xc1, 0x3b, 0xbf,
+	0xd3, 0xf1, 0x22, 0xc2, 0x13, 0x3e, 0x92, 0xdf, 0xef, 0x87, 0x20, 0x07, 0xf0, 0x5c, 0xd8, 0xe3,
+	0xbd, 0x8b, 0x1c, 0xf7, 0x71, 0xb8, 0x4d, 0xb8, 0x0f, 0x05, 0x12, 0x02, 0x71, 0x4d, 0xdc, 0x6e,
+	0xeb, 0x87, 0x71, 0xef, 0xd6, 0xe5, 0x5b, 0xfa, 0x49, 0x83, 0x43, 0x90, 0xde, 0xb6, 0x91, 0x65,
+	0x74, 0xb1, 0x22, 0xde, 0x85, 0xba, 0x71, 0x8e, 0xe3, 0x33, 0x62, 0x0f, 0x4a, 0x02, 0x9a, 0xb3,
+	0x21, 0xde, 0x23, 0xc5, 0x22, 0x47, 0xe1, 0x4c, 0x78, 0x1f, 0xca, 0x02, 0xb6, 0x4b, 0x96, 0xe3,
+	0xbd, 0x3a, 0x54, 0x38, 0x56, 0xd8, 0x34, 0xfe, 0x6b, 0x02, 0xb2, 0x3b, 0xb6, 0x4b, 0xfd, 0x7d,
+	0xa2, 0x69, 0xb0, 0xbb, 0x61, 0xf3, 0xb0, 0x6c, 0x56, 0xe5, 0xa9, 0x91, 0x7a, 0xe8, 0xdb, 0x90,
+	0x47, 0x96, 0xe7, 0x9c, 0x0e, 0x15, 0xd0, 0x04, 0x0a, 0xc1, 0x4c, 0xc8, 0xa8, 0xd4, 0xec, 0x11,
+	0x54, 0x7a, 0xe3, 0xd3, 0x1a, 0x25, 0x14, 0x33, 0x90, 0x32, 0xdd, 0x13, 0xa5, 0x5e, 0x23, 0x68,
+	0xb5, 0x3a, 0x94, 0x43, 0x3e, 0x48, 0xdd, 0x32, 0x70, 0x53, 0xf7, 0xec, 0x73, 0xec, 0x5b, 0x19,
+	0xd2, 0xd8, 0x5d, 0xee, 0xb0, 0x09, 0xc8, 0xaa, 0x2c, 0x41, 0xae, 0x33, 0x64, 0xe9, 0x71, 0xfa,
+	0x86, 0xdd, 0x3d, 0x4d, 0xd2, 0x90, 0xd3, 0xe4, 0x6f, 0xed, 0x12, 0xc3, 0x6c, 0xed, 0x7a, 0x8e,
+	0xee, 0xd9, 0xa1, 0x58, 0xf7, 0xd1, 0xfd, 0x9b, 0x90, 0x24, 0x2f, 0x9a, 0xe3, 0xcd, 0x1e, 0x69,
+	0x7a, 0xde, 0x91, 0xe4, 0xe7, 0x61, 0xaa, 0x2b, 0x36, 0xa0, 0xe9, 0x86, 0xe1, 0x20, 0x97, 0x99,
+	0xcb, 0x02, 0x35, 0xff, 0x92, 0x3a, 0x19, 0x8e, 0x14, 0x2c, 0xb1, 0x0a, 0xb5, 0x8f, 0x12, 0x50,
+	0x14, 0xab, 0x63, 0x15, 0x99, 0x9e, 0xae, 0xcc, 0xc0, 0x18, 0x76, 0x35, 0xb3, 0x77, 0x8d, 0xbc,
+	0x07, 0x0a, 0x33, 0x6f, 0xd8, 0x1e, 0xda, 0xe9, 0x9e, 0xf0, 0x91, 0xc2, 0x9a, 0x36, 0x80, 0x1f,
+	0xca, 0x41, 0x1c, 0xf7, 0x71, 0xb8, 0x92, 0x79, 0x00, 0x41, 0xd6, 0x50, 0xf7, 0x02, 0x4a, 0x3e,
+	0x0c, 0x0b, 0xc6, 0xfe, 0x59, 0x12, 0x94, 0xd0, 0xd7, 0x30, 0x84, 0x98, 0xf6, 0x8d, 0xe7, 0x44,
+	0x85, 0x62, 0x07, 0x4a, 0x6d, 0xce, 0x78, 0xcd, 0x20, 0x9c, 0xe7, 0x2e, 0xdd, 0x2b, 0x83, 0xdc,
+	0xb0, 0xae, 0xa9, 0x52, 0x8b, 0xed, 0xae, 0x99, 0x5b, 0x87, 0x4c, 0x5b, 0x3f, 0xb5, 0x3b, 0x5e,
+	0x5c, 0xc7, 0x9a, 0xb5, 0xfe, 0x29, 0x16, 0x57, 0xd2, 0xb5, 0xb6, 0x65, 0xc6, 0x7c, 0x9a, 0x41,
+	0x9a, 0xd6, 0x7e, 0x1d, 0x94, 0xe0, 0x6c, 0xc3, 0xb7, 0x0b, 0x6f, 0x42, 0x56, 0xf0, 0x92, 0xef,
+	0x91, 0x3e, 0x73, 0x91, 0x69, 0x50, 0xfd, 0x56, 0xfd, 0xef, 0x66, 0x45, 0xe6, 0xbc, 0xf6, 0x04,
+	0x26, 0x02, 0xe2, 0x22, 0xd6, 0x79, 0x21, 0x69, 0xf9, 0x02, 0x8c, 0x19, 0xac, 0x3e, 0x17, 0x93,
+	0x17, 0x07, 0xf5, 0x8f, 0x43, 0xab, 0xa2, 0x4d, 0xad, 0x0d, 0x45, 0x9e, 0xb7, 0xd7, 0x36, 0x48,
+	0x3c, 0xba, 0x0c, 0x69, 0x16, 0xbb, 0x67, 0x5a, 0x98, 0x25, 0x94, 0x3a, 0x64, 0x79, 0x0b, 0xb7,
+	0x92, 0xa8, 0x26, 0x6f, 0xe6, 0xef, 0xbc, 0x7e, 0xb1, 0x43, 0x22, 0x41, 0xd0, 0x6f, 0x5e, 0xfb,
+	0x58, 0x02, 0x79, 0xc7, 0xc6, 0x96, 0xe7, 0x86, 0x9e, 0xab, 0x1c, 0xc0, 0x0c, 0xbb, 0x16, 0xd0,
+	0xa6, 0x25, 0xe1, 0x37, 0x31, 0xf1, 0xd4, 0xf9, 0x14, 0x85, 0xeb, 0x47, 0xc7, 0x3b, 0x83, 0x4e,
+	0x3c, 0x7d, 0x35, 0xe5, 0xf5, 0xa3, 0x53, 0xfb, 0xef, 0x04, 0xcc, 0xef, 0x86, 0xbf, 0xb1, 0xb1,
+	0xa2, 0xb7, 0xda, 0x3a, 0x3e, 0xb4, 0x96, 0x6d, 0xdb, 0x65, 0xf7, 0x44, 0x7e, 0x09, 0x66, 0xf6,
+	0x49, 0x82, 0x6c, 0x15, 0xc2, 0xdf, 0x71, 0x32, 0xdc, 0x8a, 0x44, 0x6f, 0x17, 0x96, 0x79, 0x71,
+	0x10, 0x0a, 0x22, 0x17, 0x0d, 0x3f, 0x80, 0x99, 0x70, 0xf5, 0x60, 0x00, 0x62, 0x62, 0x5e, 0x1b,
+	0x2c, 0x9f, 0xdd, 0x1d, 0xe5, 0x1b, 0xc0, 0xa9, 0xe0, 0x0b, 0x50, 0x41, 0x99, 0xab, 0x2c, 0xc1,
+	0x75, 0xd1, 0xc5, 0x3e, 0xdf, 0x80, 0x32, 0xdc, 0x4a, 0x92, 0x76, 0x74, 0x8e, 0x57, 0x8a, 0x9e,
+	0x33, 0x90, 0xee, 0x1e, 0xc3, 0xf5, 0xde, 0xa6, 0xe1, 0x4e, 0xa7, 0x62, 0x77, 0xfa, 0x6a, 0xf4,
+	0x4b, 0x52, 0xa1, 0xae, 0xd7, 0xfe, 0x5a, 0x02, 0x45, 0xf0, 0x9c, 0xcd, 0xc0, 0x8e, 0xcd, 0x5e,
+	0x51, 0x44, 0x2f, 0x3b, 0xb1, 0xdb, 0x30, 0x25, 0xb7, 0xfb, 0x9a, 0xd3, 0x6f, 0xb0, 0xf7, 0x53,
+	0x4d, 0x0e, 0x21, 0x3e, 0xa8, 0xc2, 0x79, 0x3c, 0xe0, 0xe3, 0x23, 0x9f, 0x25, 0x7d, 0xfb, 0xd6,
+	0xf7, 0x17, 0x6e, 0x5e, 0x40, 0x80, 0x48, 0x03, 0x97, 0x3e, 0xae, 0xea, 0xee, 0xaa, 0x5b, 0xfb,
+	0x93, 0x04, 0xcc, 0xf6, 0x95, 0x1f, 0x2a, 0x3a, 0x77, 0x61, 0xd6, 0xef, 0x98, 0xf8, 0xb2, 0x8b,
+	0xbf, 0xbd, 0x65, 0xe3, 0x99, 0x11, 0x15, 0xc4, 0x47, 0x5d, 0xc4, 0x36, 0xf7, 0x05, 0x11, 0xef,
+	0xa2, 0x0b, 0x9b, 0x0d, 0x28, 0xa7, 0xe6, 0x83, 0x2b, 0x3a, 0xae, 0xd2, 0x81, 0xd9, 0xee, 0xef,
+	0xc8, 0x68, 0x74, 0x82, 0xd9, 0xf1, 0x42, 0x92, 0x2a, 0x99, 0xbb, 0x83, 0xe6, 0x6b, 0xb0, 0xe0,
+	0xab, 0xd3, 0x5d, 0x1f, 0x9f, 0x09, 0x16, 0xc4, 0xe7, 0x60, 0xc6, 0xc0, 0xee, 0xe3, 0x8e, 0x6e,
+	0xe2, 0x03, 0x8c, 0x8c, 0xb0, 0x9c, 0xa5, 0x68, 0x27, 0xa7, 0xc2, 0xc5, 0xbe, 0x88, 0xd5, 0xfe,
+	0x2d, 0x01, 0x93, 0xeb, 0x08, 0xad, 0x62, 0x97, 0x5d, 0xb1, 0xc0, 0xfc, 0x28, 0x83, 0xdc, 0x54,
+	0xa3, 0x6b, 0xdd, 0xe0, 0x25, 0xec, 0xee, 0x4e, 0xcc, 0x6b, 0x97, 0x14, 0x4a, 0xd0, 0xa0, 0x37,
+	0x77, 0xbe, 0x04, 0x93, 0x5e, 0x1f, 0xfc, 0x98, 0x7e, 0x8f, 0xd7, 0x83, 0xdf, 0x80, 0x22, 0xff,
+	0x92, 0x10, 0x0f, 0x4d, 0x26, 0x63, 0x7d, 0x3a, 0xa8, 0xc0, 0x40, 0x58, 0x6c, 0x92, 0xb8, 0x02,
+	0xc7, 0xb6, 0xd9, 0x69, 0xc5, 0xb5, 0xe2, 0xbc, 0x75, 0xed, 0x6b, 0xdd, 0x4c, 0x6f, 0x34, 0x8f,
+	0x90, 0xd1, 0x31, 0xe9, 0x8b, 0x83, 0xfd, 0x4e, 0x93, 0xcc, 0x5b, 0x10, 0x13, 0x4b, 0xa9, 0x79,
+	0x96, 0xc7, 0x82, 0x33, 0x37, 0x60, 0x9c, 0x57, 0xf1, 0xbf, 0x4a, 0xc4, 0xee, 0x7d, 0x96, 0x58,
+	0xb6, 0xff, 0x19, 0xa2, 0xa8, 0xa8, 0x26, 0x7b, 0x45, 0x75, 0x0b, 0xc0, 0xc3, 0xfc, 0xe4, 0x4b,
+	0xe8, 0x92, 0xdb, 0x83, 0x64, 0xb3, 0x8f, 0xa0, 0xa8, 0x39, 0x8f, 0xff, 0x72, 0x07, 0xc9, 0x60,
+	0x7a, 0x90, 0x0c, 0x6e, 0x82, 0x12, 0x41, 0xde, 0xdd, 0xdd, 0x50, 0x14, 0x48, 0x79, 0xc2, 0x84,
+	0xa5, 0x54, 0xfa, 0x9b, 0xbe, 0xfc, 0xf0, 0xcc, 0x9e, 0x27, 0x37, 0x05, 0xcf, 0x33, 0x83, 0xcb,
+	0x78, 0x7f, 0x21, 0x41, 0xe1, 0x5d, 0xca, 0x68, 0x15, 0x35, 0x6d, 0xc7, 0x60, 0x47, 0x02, 0x44,
+	0xd6, 0xf8, 0xe4, 0x49, 0x71, 0x8f, 0x04, 0x1e, 0x21, 0x87, 0x01, 0x13, 0x48, 0x2f, 0x0c, 0x19,
+	0xf3, 0x16, 0x80, 0x17, 0x40, 0xd6, 0x7e, 0x4f, 0x82, 0xd2, 0x12, 0xb3, 0xfb, 0x5c, 0x91, 0x29,
+	0x15, 0x18, 0xe3, 0x9e, 0x00, 0x77, 0x28, 0x44, 0x52, 0x41, 0x30, 0x76, 0x89, 0x4a, 0x55, 0x60,
+	0xd7, 0x7e, 0x47, 0x82, 0x02, 0xf5, 0xbf, 0x19, 0x27, 0xdd, 0xf3, 0xee, 0x67, 0x96, 0x4d, 0xdd,
+	0x43, 0xae, 0xa7, 0x11, 0x25, 0x45, 0x3d, 0x51, 0x3b, 0xe8, 0xe1, 0x8d, 0xf3, 0xb4, 0x1e, 0x27,
+	0xa2, 0x2a, 0x0c, 0x24, 0x4c, 0xb7, 0xf6, 0x39, 0x28, 0x06, 0x6e, 0x51, 0x7d, 0xd5, 0x25, 0x17,
+	0x33, 0xbb, 0xdc, 0x3b, 0x66, 0xf7, 0x0b, 0x6a, 0x31, 0xec, 0xdf, 0xb9, 0xb5, 0x6f, 0x4b, 0x90,
+	0x0f, 0x01, 0x29, 0xd7, 0x20, 0x17, 0x35, 0x5e, 0x41, 0xc6, 0x88, 0x36, 0xaf, 0xe1, 0xed, 0x74,
+	0x72, 0xc8, 0x7b, 0x5e, 0x26, 0xcc, 0xb1, 0x75, 0x12, 0x66, 0x90, 0xf8, 0x84, 0xd0, 0xe0, 0xd9,
+	0x78, 0x15, 0x26, 0x82, 0x2f, 0x12, 0x09, 0xfb, 0xc6, 0xd6, 0x8b, 0xec, 0x17, 0x70, 0xc3, 0xc6,
+	0xdf, 0x4f, 0x7c, 0x59, 0x82, 0x34, 0xfb, 0xac, 0xd6, 0xaf, 0x80, 0xd4, 0x8e, 0xb9, 0x4e, 0xa4,
+	0x36, 0x69, 0xfd, 0x38, 0x26, 0x0f, 0xa5, 0xc7, 0xb5, 0x6f, 0x48, 0xb0, 0xb0, 0x24, 0x62, 0xdc,
+	0xc1, 0xac, 0x77, 0x2d, 0xe9, 0x0b, 0xdd, 0xed, 0xdb, 0x86, 0x12, 0xe3, 0x06, 0x5f, 0xa5, 0x42,
+	0x12, 0x2f, 0x70, 0x11, 0x94, 0x13, 0x2b, 0xb6, 0x42, 0x29, 0xb7, 0xf6, 0x55, 0x09, 0xae, 0xf9,
+	0x3d, 0x5b, 0xea, 0xd3, 0xad, 0xb3, 0x17, 0xec, 0xc8, 0xfb, 0xe2, 0x42, 0x21, 0x5c, 0x3c, 0x58,
+	0x16, 0x02, 0xc3, 0xc5, 0xb6, 0x39, 0x03, 0xa9, 0x86, 0x47, 0xc4, 0xbd, 0x45, 0x61, 0xb8, 0x96,
+	0xc8, 0x86, 0xc7, 0xb2, 0x5b, 0xab, 0xa8, 0x89, 0x5b, 0xba, 0xe9, 0x9e, 0xb1, 0xe1, 0x99, 0x23,
+	0x1b, 0x1e, 0x56, 0x83, 0x12, 0x4c, 0xa9, 0x7e, 0xba, 0xf6, 0xc3, 0x34, 0x14, 0x77, 0xc3, 0x5f,
+	0xc4, 0x8a, 0x6c, 0x6b, 0x19, 0x50, 0x68, 0x5b, 0xdb, 0x35, 0xb0, 0x44, 0x64, 0x60, 0x7d, 0x0f,
+	0x8a, 0xa2, 0x72, 0xc0, 0xce, 0x5e, 0x88, 0x97, 0x5e, 0x49, 0x89, 0xb3, 0x17, 0xb2, 0x2f, 0x88,
+	0xc4, 0x6b, 0xd2, 0x31, 0xe3, 0x35, 0xbe, 0xd6, 0xc8, 0x8c, 0x4a, 0x6b, 0x8c, 0x0d, 0x79, 0x08,
+	0xf7, 0x56, 0xe4, 0xe6, 0xf3, 0x40, 0xa3, 0xde, 0x35, 0x19, 0x91, 0x0b, 0xd0, 0x6f, 0x43, 0xc6,
+	0x41, 0xba, 0x6b, 0x5b, 0x34, 0x60, 0x53, 0xba, 0x73, 0xe7, 0x7c, 0xe6, 0x30, 0x34, 0xba, 0x8d,
+	0xa7, 0x2d, 0x55, 0x8e, 0xd0, 0x2f, 0x08, 0x02, 0x23, 0x09, 0x82, 0x34, 0xa0, 0xa8, 0x1f, 0x23,
+	0x47, 0x3f, 0x14, 0x2f, 0x48, 0x62, 0x7e, 0xc9, 0x86, 0x83, 0xb0, 0xd3, 0x61, 0xe2, 0x8a, 0x91,
+	0x28, 0x98, 0x08, 0x2f, 0xb1, 0x78, 0x51, 0x9e, 0xe6, 0xf1, 0xd0, 0x52, 0x97, 0x2d, 0x29, 0x46,
+	0x6c, 0x09, 0xb9, 0xf7, 0x52, 0x0a, 0xae, 0x6a, 0xac, 0x63, 0xd3, 0x3c, 0x4f, 0xd0, 0x47, 0x79,
+	0x5a, 0xfe, 0x36, 0x64, 0xfd, 0x88, 0x50, 0x4c, 0x1b, 0x24, 0xda, 0xd7, 0xfe, 0x3d, 0x11, 0x36,
+	0xbe, 0x3b, 0x96, 0x79, 0x31, 0xed, 0x3b, 0x70, 0xdd, 0xde, 0x87, 0x82, 0x83, 0x74, 0x13, 0x7f,
+	0x88, 0x0c, 0xad, 0x6d, 0xc5, 0xed, 0x63, 0x5e, 0x60, 0x90, 0x4e, 0xbd, 0x03, 0xb9, 0x03, 0x84,
+	0x5c, 0xad, 0xad, 0x63, 0x23, 0xf6, 0x9d, 0x11, 0x84, 0xdc, 0x1d, 0x1d, 0xd3, 0xfe, 0x89, 0x93,
+	0x7c, 0x8a, 0x17, 0xef, 0x20, 0x3f, 0xcf, 0x31, 0x28, 0xe4, 0x22, 0x4c, 0xd2, 0xf7, 0x32, 0x1d,
+	0x7a, 0x54, 0x64, 0x08, 0xc1, 0x62, 0x4f, 0xfc, 0x27, 0x48, 0x11, 0x3b, 0x44, 0x32, 0x98, 0x78,
+	0xd5, 0xbe, 0x92, 0x00, 0x50, 0xd7, 0xef, 0x93, 0x8f, 0x95, 0x22, 0xd7, 0x23, 0xc2, 0xe3, 0xb0,
+	0x9f, 0x82, 0xe1, 0x29, 0x35, 0xc7, 0x73, 0xce, 0xe3, 0x76, 0x19, 0xd2, 0xd4, 0xd3, 0xe4, 0xda,
+	0x91, 0x25, 0x7a, 0x67, 0x31, 0xd5, 0x67, 0x16, 0xa7, 0x48, 0x68, 0x47, 0xdb, 0xef, 0xb0, 0x58,
+	0x86, 0x88, 0x1f, 0x74, 0xc9, 0x6a, 0x66, 0x48, 0x59, 0x9d, 0x86, 0x0c, 0x7d, 0x8f, 0x7c, 0xca,
+	0x83, 0xcb, 0x3c, 0x75, 0x37, 0x4b, 0x7c, 0x92, 0x1f, 0x11, 0xbf, 0xe4, 0x8f, 0x13, 0x90, 0x55,
+	0xd7, 0xef, 0xb3, 0x37, 0xd7, 0xc3, 0x30, 0x62, 0x51, 0xec, 0x6a, 0xfb, 0x19, 0x0d, 0xb6, 0x4b,
+	0x6d, 0x84, 0x47, 0xef, 0xab, 0xf6, 0xd4, 0x30, 0xaa, 0x3d, 0x88, 0x34, 0xa5, 0x87, 0x0d, 0xe8,
+	0x73, 0x46, 0x65, 0xce, 0x60, 0xd4, 0x8f, 0x93, 0x90, 0x6f, 0xe0, 0x43, 0x0b, 0x19, 0x17, 0xb8,
+	0xf9, 0x70, 0x91, 0x77, 0xaf, 0xbd, 0xef, 0x23, 0x92, 0x7d, 0xdf, 0x47, 0x8c, 0xe2, 0x86, 0xb2,
+	0xcf, 0xec, 0xf4, 0xa8, 0xec, 0xe8, 0xb0, 0x92, 0x19, 0x4c, 0xdc, 0xd8, 0x50, 0x13, 0xe7, 0xbf,
+	0x56, 0xc9, 0x52, 0x69, 0x65, 0x89, 0xd0, 0x74, 0xe6, 0xc2, 0xd3, 0x49, 0xec, 0x8a, 0x8b, 0x0f,
+	0x2d, 0xdd, 0xeb, 0x38, 0xec, 0x25, 0x50, 0x41, 0x0d, 0x32, 0x42, 0x93, 0xfd, 0x47, 0x12, 0xc8,
+	0xa1, 0xc9, 0x66, 0x0f, 0x63, 0x2e, 0xa4, 0x9a, 0xfd, 0xfe, 0x24, 0xfa, 0xf7, 0x27, 0xd9, 0xd5,
+	0x9f, 0x2e, 0x21, 0x4a, 0x45, 0x84, 0xa8, 0x37, 0x26, 0x11, 0xb6, 0x69, 0xb5, 0x07, 0x30, 0x19,
+	0xea, 0xe2, 0x26, 0xb6, 0x9e, 0xa3, 0x97, 0x84, 0x2e, 0xb6, 0xb4, 0x70, 0x4f, 0xb3, 0x2d, 0x8e,
+	0x70, 0xcb, 0x83, 0x6b, 0x83, 0xbe, 0x1a, 0xac, 0x00, 0x64, 0xb6, 0xec, 0x7d, 0xdb, 0x38, 0x95,
+	0xaf, 0x28, 0x35, 0x98, 0x5f, 0x46, 0x87, 0x98, 0x7d, 0x72, 0x15, 0x39, 0x8d, 0x96, 0xee, 0x78,
+	0x2b, 0xfc, 0xab, 0x4f, 0x2e, 0xb9, 0xda, 0x29, 0x4b, 0xca, 0x34, 0x28, 0x7d, 0xf2, 0x13, 0x4a,
+	0x01, 0xb2, 0x6b, 0xc7, 0xc8, 0x39, 0xb5, 0x2d, 0x24, 0x27, 0x6f, 0xed, 0x0a, 0xa7, 0x9b, 0xf9,
+	0x49, 0xca, 0x38, 0xe4, 0xf7, 0x2c, 0xb7, 0x8d, 0x9a, 0xf4, 0x44, 0x43, 0xbe, 0x42, 0xc8, 0x2e,
+	0x51, 0x99, 0x97, 0x25, 0xf2, 0x7b, 0x47, 0xef, 0xb8, 0xc8, 0x90, 0x13, 0x4a, 0x09, 0x60, 0x15,
+	0xb5, 0x6c, 0x13, 0xbb, 0x47, 0xc8, 0x90, 0x93, 0x4a, 0x1e, 0xc6, 0xe8, 0x4b, 0x75, 0x64, 0xc8,
+	0xa9, 0x5b, 0xaf, 0x02, 0x04, 0x1f, 0xcf, 0x22, 0x55, 0x57, 0x74, 0xd3, 0x64, 0x39, 0xf2, 0x15,
+	0xa5, 0x08, 0xb9, 0x9d, 0x8e, 0xc7, 0x93, 0xd2, 0xad, 0x3f, 0x97, 0x60, 0xbe, 0xeb, 0x91, 0x3b,
+	0x79, 0xe0, 0xbe, 0xae, 0x63, 0xb3, 0xe3, 0x20, 0xe6, 0x71, 0x29, 0x55, 0xb8, 0x16, 0xea, 0x55,
+	0x4f, 0xb9, 0x7c, 0x45, 0x99, 0x85, 0x29, 0xf2, 0xdc, 0x51, 0x0c, 0x76, 0xcb, 0xf6, 0xfc, 0x5e,
+	0xcf, 0xc1, 0x74, 0xdd, 0x72, 0x3b, 0x07, 0x07, 0xb8, 0x49, 0xd6, 0x36, 0x69, 0xcd, 0xe2, 0x7d,
+	0x72, 0x82, 0x00, 0x6f, 0xb7, 0x69, 0x24, 0x02, 0x85, 0x9b, 0x8b, 0xb8, 0x8d, 0x9c, 0x24, 0x6c,
+	0xdc, 0xa2, 0x4f, 0xee, 0xe9, 0x69, 0x15, 0x72, 0xda, 0xba, 0xe3, 0x9d, 0xca, 0xa9, 0x5b, 0x1f,
+	0x25, 0xf8, 0xcb, 0x2c, 0x3a, 0xc4, 0x2a, 0xe4, 0xf7, 0xb6, 0x1a, 0x3b, 0x6b, 0x2b, 0xf5, 0xf5,
+	0xfa, 0xda, 0xaa, 0x7c, 0x65, 0x6e, 0xfc, 0xe9, 0xb3, 0x6a, 0x38, 0x4b, 0x91, 0x21, 0xb9, 0xbc,
+	0xf7, 0x50, 0x96, 0xe6, 0xc6, 0x9e, 0x3e, 0xab, 0x92, 0x9f, 0xe4, 0x38, 0xa8, 0xb1, 0xb6, 0xb1,
+	0x21, 0x27, 0xe6, 0xb2, 0x4f, 0x9f, 0x55, 0xe9, 0x6f, 0xb2, 0xcf, 0x68, 0xec, 0x6e, 0xef, 0x68,
+	0xa4, 0x6a, 0x72, 0xae, 0xf0, 0xf4, 0x59, 0xd5, 0x4f, 0x93, 0x55, 0x44, 0x7f, 0xd3, 0x46, 0xa9,
+	0xb9, 0xe2, 0xd3, 0x67, 0xd5, 0x20, 0x83, 0xb4, 0xdc, 0x5d, 0x7a, 0x67, 0x8d, 0xb6, 0x4c, 0xb3,
+	0x96, 0x22, 0x4d, 0x5a, 0xd2, 0xdf, 0xb4, 0x65, 0x86, 0xb5, 0xf4, 0x33, 0xc8, 0x2a, 0x59, 0xde,
+	0x7b, 0xa8, 0xed, 0x6c, 0xcb, 0x63, 0x73, 0xf0, 0xf4, 0x59, 0x95, 0xa7, 0xc8, 0xd6, 0x8f, 0x94,
+	0x93, 0x82, 0xec, 0x5c, 0xfe, 0xe9, 0xb3, 0xaa, 0x48, 0x2a, 0xf3, 0x00, 0xa4, 0xce, 0xd2, 0xee,
+	0xf6, 0x66, 0x7d, 0x45, 0xce, 0xcd, 0x95, 0x9e, 0x3e, 0xab, 0x86, 0x72, 0x08, 0x37, 0x68, 0x55,
+	0x5e, 0x01, 0x18, 0x37, 0x42, 0x59, 0xb7, 0xfe, 0x46, 0x82, 0xe2, 0x9a, 0x88, 0x91, 0x52, 0x0e,
+	0x5e, 0x83, 0x4a, 0x68, 0x8a, 0xbb, 0xca, 0x98, 0x14, 0x32, 0x31, 0x95, 0x25, 0x22, 0x3e, 0xd4,
+	0x0d, 0x25, 0x1e, 0xa8, 0x9c, 0x20, 0xd3, 0x4b, 0x93, 0x9b, 0xba, 0xd7, 0x3c, 0x52, 0xd9, 0xa7,
+	0xcb, 0xe9, 0xc4, 0xb0, 0xc9, 0x0b, 0xca, 0xb6, 0xd0, 0x13, 0x96, 0x9f, 0x52, 0xa6, 0x60, 0x82,
+	0x7f, 0x01, 0x99, 0x7f, 0x83, 0x9c, 0xcc, 0x75, 0x9a, 0x40, 0x31, 0x41, 0x8c, 0xbe, 0xe4, 0x95,
+	0x33, 0x44, 0x88, 0xe9, 0x6a, 0xa3, 0xc7, 0x16, 0xf2, 0xd8, 0xad, 0xaf, 0x8a, 0xf9, 0xdf, 0xd4,
+	0xdd, 0x47, 0x84, 0x87, 0x7b, 0x5b, 0x7b, 0x0d, 0x3a, 0xf5, 0x94, 0x87, 0x2c, 0x45, 0x66, 0x7d,
+	0x69, 0xcb, 0x9f, 0xf5, 0xa5, 0xad, 0x87, 0x84, 0xab, 0xea, 0xda, 0x5b, 0x7b, 0x1b, 0x4b, 0xaa,
+	0x9c, 0x60, 0x5c, 0xe5, 0x49, 0xc2, 0xb5, 0x95, 0xed, 0xad, 0xd5, 0xfa, 0x6e, 0x7d, 0x7b, 0x6b,
+	0x89, 0xcc, 0x30, 0xe5, 0x5a, 0x28, 0x4b, 0x59, 0x84, 0x99, 0xd5, 0xba, 0xba, 0xb6, 0x42, 0x92,
+	0x64, 0x62, 0xb5, 0x6d, 0x55, 0xbb, 0x57, 0x7f, 0xeb, 0xde, 0x9a, 0x2a, 0x67, 0xe7, 0x26, 0x9e,
+	0x3e, 0xab, 0x16, 0xbb, 0x32, 0xbb, 0xeb, 0x53, 0xf6, 0x6f, 0xab, 0xda, 0xc6, 0xf6, 0x83, 0x35,
+	0x55, 0x96, 0x59, 0xfd, 0xae, 0x4c, 0xe5, 0x2a, 0xe4, 0x77, 0x1f, 0xee, 0xac, 0x69, 0x9b, 0x4b,
+	0xea, 0x3b, 0x6b, 0xbb, 0x72, 0x95, 0x0d, 0x85, 0xa5, 0x94, 0x59, 0x00, 0x5a, 0xb8, 0x51, 0xdf,
+	0xac, 0xef, 0xca, 0x6f, 0xce, 0xe5, 0x9e, 0x3e, 0xab, 0xa6, 0x69, 0xe2, 0xd6, 0xd7, 0x24, 0x98,
+	0xec, 0xb3, 0xe9, 0x52, 0xae, 0xc3, 0x6c, 0x68, 0x4e, 0x45, 0x0d, 0x56, 0x28, 0x5f, 0x51, 0x14,
+	0x28, 0x89, 0xbc, 0x75, 0xba, 0x01, 0x92, 0x25, 0x32, 0x33, 0x22, 0x6f, 0x45, 0xb7, 0x9a, 0x88,
+	0x66, 0x27, 0x94, 0x49, 0x18, 0x17, 0xd9, 0x42, 0xcb, 0xd0, 0xd9, 0x15, 0x99, 0x62, 0x1e, 0xa9,
+	0xf6, 0xf9, 0x2f, 0x09, 0xa6, 0xfb, 0x6f, 0xdd, 0xc8, 0x0c, 0xf7, 0xf6, 0x88, 0xab, 0xa5, 0x71,
+	0xc8, 0xaf, 0x77, 0x4c, 0xf3, 0xd4, 0xef, 0x4b, 0x19, 0xe4, 0x3d, 0x17, 0x39, 0xbc, 0x1f, 0xac,
+	0x5a, 0x42, 0x79, 0x01, 0xae, 0x87, 0xd5, 0x09, 0xb9, 0xcf, 0xe2, 0x76, 0x55, 0x49, 0x12, 0x2a,
+	0xc1, 0x2d, 0xfb, 0xae, 0xb2, 0x14, 0x91, 0x73, 0x26, 0x5d, 0xcc, 0x37, 0xee, 0x2a, 0x4d, 0x2b,
+	0xb2, 0x50, 0xc7, 0x4c, 0x0e, 0xe5, 0x8c, 0x32, 0x03, 0x93, 0x42, 0x1b, 0x85, 0x85, 0x75, 0x8c,
+	0x70, 0x2a, 0x64, 0x88, 0x78, 0xfd, 0xec, 0xf2, 0xd1, 0x77, 0x3e, 0x9e, 0x97, 0xbe, 0xfb, 0xf1,
+	0xbc, 0xf4, 0x83, 0x8f, 0xe7, 0xa5, 0xdf, 0xfd, 0x64, 0xfe, 0xca, 0x77, 0x3f, 0x99, 0xbf, 0xf2,
+	0xf7, 0x9f, 0xcc, 0x5f, 0xf9, 0xd5, 0xad, 0x90, 0x8d, 0xaf, 0x0b, 0x4f, 0x66, 0x43, 0xdf, 0x77,
+	0x6f, 0xfb, 0x7e, 0xcd, 0xeb, 0x4d, 0xdb, 0x41, 0xe1, 0xe4, 0x91, 0x8e, 0xad, 0xdb, 0x2d, 0x9b,
+	0x9c, 0xe5, 0xbb, 0xc1, 0xbf, 0xc1, 0xa1, 0xfe, 0xc0, 0x7e, 0x86, 0x7e, 0xed, 0xfc, 0xff, 0xfd,
+	0xcf, 0x00, 0x3c, 0xf1, 0xc8, 0x7e, 0x29, 0x67, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.DerivativeMarketInstantListingFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.SpotMarketInstantListingFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintExchange(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if !this.SpotMarketInstantListingFee.Equal(&that1.SpotMarketInstantListingFee) {
+		return false
+	}
+	if !this.DerivativeMarketInstantListingFee.Equal(&that1.DerivativeMarketInstantListingFee) {
+		return false
+	}
+	if !this.DefaultSpotMakerFeeRate.Equal(that1.DefaultSpotMakerFeeRate) {
+		return false
+	}
+	if !this.DefaultSpotTakerFeeRate.Equal(that1.DefaultSpotTakerFeeRate) {
+		return false
+	}
+	if !this.DefaultDerivativeMakerFeeRate.Equal(that1.DefaultDerivativeMakerFeeRate) {
+		return false
+	}
+	if !this.DefaultDerivativeTakerFeeRate.Equal(that1.DefaultDerivativeTakerFeeRate) {
+		return false
+	}
+	if !this.DefaultInitialMarginRatio.Equal(that1.DefaultInitialMarginRatio) {
+		return false
+	}
+	if !this.DefaultMaintenanceMarginRatio.Equal(that1.DefaultMaintenanceMarginRatio) {
+		return false
+	}
+	if this.DefaultFundingInterval != that1.DefaultFundingInterval {
+		return false
+	}
+	if this.FundingMultiple != that1.FundingMultiple {
+		return false
+	}
+	if !this.RelayerFeeShareRate.Equal(that1.RelayerFeeShareRate) {
+		return false
+	}
+	if !this.DefaultHourlyFundingRateCap.Equal(that1.DefaultHourlyFundingRateCap) {
+		return false
+	}
+	if !this.DefaultHourlyInterestRate.Equal(that1.DefaultHourlyInterestRate) {
+		return false
+	}
+	if this.MaxDerivativeOrderSideCount != that1.MaxDerivativeOrderSideCount {
+		return false
+	}
+	if !this.InjRewardStakedRequirementThreshold.Equal(that1.InjRewardStakedRequirementThreshold) {
+		return false
+	}
+	if this.TradingRewardsVestingDuration != that1.TradingRewardsVestingDuration {
+		return false
+	}
+	if !this.LiquidatorRewardShareRate.Equal(that1.LiquidatorRewardShareRate) {
+		return false
+	}
+	if !this.BinaryOptionsMarketInstantListingFee.Equal(&that1.BinaryOptionsMarketInstantListingFee) {
+		return false
+	}
+	if this.AtomicMarketOrderAccessLevel != that1.AtomicMarketOrderAccessLevel {
+		return false
+	}
+	if !this.SpotAtomicMarketOrderFeeMultiplier.Equal(that1.SpotAtomicMarketOrderFeeMultiplier) {
+		return false
+	}
+	if !this.DerivativeAtomicMarketOrderFeeMultiplier.Equal(that1.DerivativeAtomicMarketOrderFeeMultiplier) {
+		return false
+	}
+	if !this.BinaryOptionsAtomicMarketOrderFeeMultiplier.Equal(that1.BinaryOptionsAtomicMarketOrderFeeMultiplier) {
+		return false
+	}
+	if !this.MinimalProtocolFeeRate.Equal(that1.MinimalProtocolFeeRate) {
+		return false
+	}
+	if this.IsInstantDerivativeMarketLaunchEnabled != that1.IsInstantDerivativeMarketLaunchEnabled {
+		return false
+	}
+	if this.TerminalOrderRetentionBlocks != that1.TerminalOrderRetentionBlocks {
+		return false
+	}
+	return true
 }
-
-func (m *MarketFeeMultiplier) Marshal() (dAtA []byte, err error) {
+func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3624,60 +4816,51 @@ func (m *MarketFeeMultiplier) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MarketFeeMultiplier) MarshalTo(dAtA []byte) (int, error) {
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MarketFeeMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.TerminalOrderRetentionBlocks != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.TerminalOrderRetentionBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.IsInstantDerivativeMarketLaunchEnabled {
+		i--
+		if m.IsInstantDerivativeMarketLaunchEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
 	{
-		size := m.FeeMultiplier.Size()
+		size := m.MinimalProtocolFeeRate.Size()
 		i -= size
-		if _, err := m.FeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MinimalProtocolFeeRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DerivativeMarket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *DerivativeMarket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DerivativeMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
 	{
-		size := m.MinQuantityTickSize.Size()
+		size := m.BinaryOptionsAtomicMarketOrderFeeMultiplier.Size()
 		i -= size
-		if _, err := m.MinQuantityTickSize.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.BinaryOptionsAtomicMarketOrderFeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
@@ -3685,131 +4868,208 @@ func (m *DerivativeMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x1
 	i--
-	dAtA[i] = 0x82
+	dAtA[i] = 0xb2
 	{
-		size := m.MinPriceTickSize.Size()
+		size := m.DerivativeAtomicMarketOrderFeeMultiplier.Size()
 		i -= size
-		if _, err := m.MinPriceTickSize.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.DerivativeAtomicMarketOrderFeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x7a
-	if m.Status != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x70
-	}
-	if m.IsPerpetual {
-		i--
-		if m.IsPerpetual {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.SpotAtomicMarketOrderFeeMultiplier.Size()
+		i -= size
+		if _, err := m.SpotAtomicMarketOrderFeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if m.AtomicMarketOrderAccessLevel != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.AtomicMarketOrderAccessLevel))
 		i--
-		dAtA[i] = 0x68
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
 	{
-		size := m.RelayerFeeShareRate.Size()
+		size, err := m.BinaryOptionsMarketInstantListingFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
 		i -= size
-		if _, err := m.RelayerFeeShareRate.MarshalTo(dAtA[i:]); err != nil {
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.LiquidatorRewardShareRate.Size()
+		i -= size
+		if _, err := m.LiquidatorRewardShareRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x62
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.TradingRewardsVestingDuration != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.TradingRewardsVestingDuration))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	{
-		size := m.TakerFeeRate.Size()
+		size := m.InjRewardStakedRequirementThreshold.Size()
 		i -= size
-		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.InjRewardStakedRequirementThreshold.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x5a
+	dAtA[i] = 0x7a
+	if m.MaxDerivativeOrderSideCount != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.MaxDerivativeOrderSideCount))
+		i--
+		dAtA[i] = 0x70
+	}
 	{
-		size := m.MakerFeeRate.Size()
+		size := m.DefaultHourlyInterestRate.Size()
 		i -= size
-		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.DefaultHourlyInterestRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x52
+	dAtA[i] = 0x6a
 	{
-		size := m.MaintenanceMarginRatio.Size()
+		size := m.DefaultHourlyFundingRateCap.Size()
 		i -= size
-		if _, err := m.MaintenanceMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.DefaultHourlyFundingRateCap.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x4a
+	dAtA[i] = 0x62
 	{
-		size := m.InitialMarginRatio.Size()
+		size := m.RelayerFeeShareRate.Size()
 		i -= size
-		if _, err := m.InitialMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.RelayerFeeShareRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x42
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+	dAtA[i] = 0x5a
+	if m.FundingMultiple != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.FundingMultiple))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x50
 	}
-	if len(m.QuoteDenom) > 0 {
-		i -= len(m.QuoteDenom)
-		copy(dAtA[i:], m.QuoteDenom)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.QuoteDenom)))
+	if m.DefaultFundingInterval != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.DefaultFundingInterval))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x48
 	}
-	if m.OracleScaleFactor != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.OracleScaleFactor))
-		i--
-		dAtA[i] = 0x28
+	{
+		size := m.DefaultMaintenanceMarginRatio.Size()
+		i -= size
+		if _, err := m.DefaultMaintenanceMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	if m.OracleType != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.OracleType))
-		i--
-		dAtA[i] = 0x20
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.DefaultInitialMarginRatio.Size()
+		i -= size
+		if _, err := m.DefaultInitialMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	if len(m.OracleQuote) > 0 {
-		i -= len(m.OracleQuote)
-		copy(dAtA[i:], m.OracleQuote)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.OracleQuote)))
-		i--
-		dAtA[i] = 0x1a
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.DefaultDerivativeTakerFeeRate.Size()
+		i -= size
+		if _, err := m.DefaultDerivativeTakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	if len(m.OracleBase) > 0 {
-		i -= len(m.OracleBase)
-		copy(dAtA[i:], m.OracleBase)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.OracleBase)))
-		i--
-		dAtA[i] = 0x12
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.DefaultDerivativeMakerFeeRate.Size()
+		i -= size
+		if _, err := m.DefaultDerivativeMakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	if len(m.Ticker) > 0 {
-		i -= len(m.Ticker)
-		copy(dAtA[i:], m.Ticker)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.Ticker)))
-		i--
-		dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.DefaultSpotTakerFeeRate.Size()
+		i -= size
+		if _, err := m.DefaultSpotTakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.DefaultSpotMakerFeeRate.Size()
+		i -= size
+		if _, err := m.DefaultSpotMakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.DerivativeMarketInstantListingFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.SpotMarketInstantListingFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *BinaryOptionsMarket) Marshal() (dAtA []byte, err error) {
+func (m *MarketFeeMultiplier) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3819,30 +5079,56 @@ func (m *BinaryOptionsMarket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BinaryOptionsMarket) MarshalTo(dAtA []byte) (int, error) {
+func (m *MarketFeeMultiplier) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BinaryOptionsMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MarketFeeMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SettlementPrice != nil {
-		{
-			size := m.SettlementPrice.Size()
-			i -= size
-			if _, err := m.SettlementPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintExchange(dAtA, i, uint64(size))
+	{
+		size := m.FeeMultiplier.Size()
+		i -= size
+		if _, err := m.FeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DerivativeMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DerivativeMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DerivativeMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
 		size := m.MinQuantityTickSize.Size()
 		i -= size
@@ -3870,16 +5156,26 @@ func (m *BinaryOptionsMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x70
 	}
-	{
-		size := m.RelayerFeeShareRate.Size()
-		i -= size
-		if _, err := m.RelayerFeeShareRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.IsPerpetual {
+		i--
+		if m.IsPerpetual {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.RelayerFeeShareRate.Size()
+		i -= size
+		if _, err := m.RelayerFeeShareRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x6a
+	dAtA[i] = 0x62
 	{
 		size := m.TakerFeeRate.Size()
 		i -= size
@@ -3889,7 +5185,7 @@ func (m *BinaryOptionsMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x62
+	dAtA[i] = 0x5a
 	{
 		size := m.MakerFeeRate.Size()
 		i -= size
@@ -3899,37 +5195,40 @@ func (m *BinaryOptionsMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x5a
+	dAtA[i] = 0x52
+	{
+		size := m.MaintenanceMarginRatio.Size()
+		i -= size
+		if _, err := m.MaintenanceMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.InitialMarginRatio.Size()
+		i -= size
+		if _, err := m.InitialMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	if len(m.MarketId) > 0 {
 		i -= len(m.MarketId)
 		copy(dAtA[i:], m.MarketId)
 		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x3a
 	}
 	if len(m.QuoteDenom) > 0 {
 		i -= len(m.QuoteDenom)
 		copy(dAtA[i:], m.QuoteDenom)
 		i = encodeVarintExchange(dAtA, i, uint64(len(m.QuoteDenom)))
 		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.SettlementTimestamp != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.SettlementTimestamp))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.ExpirationTimestamp != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.ExpirationTimestamp))
-		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x32
 	}
 	if m.OracleScaleFactor != 0 {
 		i = encodeVarintExchange(dAtA, i, uint64(m.OracleScaleFactor))
@@ -3941,17 +5240,17 @@ func (m *BinaryOptionsMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x20
 	}
-	if len(m.OracleProvider) > 0 {
-		i -= len(m.OracleProvider)
-		copy(dAtA[i:], m.OracleProvider)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.OracleProvider)))
+	if len(m.OracleQuote) > 0 {
+		i -= len(m.OracleQuote)
+		copy(dAtA[i:], m.OracleQuote)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.OracleQuote)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.OracleSymbol) > 0 {
-		i -= len(m.OracleSymbol)
-		copy(dAtA[i:], m.OracleSymbol)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.OracleSymbol)))
+	if len(m.OracleBase) > 0 {
+		i -= len(m.OracleBase)
+		copy(dAtA[i:], m.OracleBase)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.OracleBase)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3965,7 +5264,7 @@ func (m *BinaryOptionsMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ExpiryFuturesMarketInfo) Marshal() (dAtA []byte, err error) {
+func (m *BinaryOptionsMarket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3975,57 +5274,181 @@ func (m *ExpiryFuturesMarketInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExpiryFuturesMarketInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *BinaryOptionsMarket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExpiryFuturesMarketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BinaryOptionsMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ScalarUpperBound != nil {
+		{
+			size := m.ScalarUpperBound.Size()
+			i -= size
+			if _, err := m.ScalarUpperBound.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintExchange(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.ScalarLowerBound != nil {
+		{
+			size := m.ScalarLowerBound.Size()
+			i -= size
+			if _, err := m.ScalarLowerBound.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintExchange(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.SettlementPrice != nil {
+		{
+			size := m.SettlementPrice.Size()
+			i -= size
+			if _, err := m.SettlementPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintExchange(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	{
-		size := m.SettlementPrice.Size()
+		size := m.MinQuantityTickSize.Size()
 		i -= size
-		if _, err := m.SettlementPrice.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MinQuantityTickSize.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
 	{
-		size := m.ExpirationTwapStartPriceCumulative.Size()
+		size := m.MinPriceTickSize.Size()
 		i -= size
-		if _, err := m.ExpirationTwapStartPriceCumulative.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MinPriceTickSize.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if m.TwapStartTimestamp != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.TwapStartTimestamp))
+	dAtA[i] = 0x7a
+	if m.Status != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x70
 	}
-	if m.ExpirationTimestamp != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.ExpirationTimestamp))
-		i--
-		dAtA[i] = 0x10
+	{
+		size := m.RelayerFeeShareRate.Size()
+		i -= size
+		if _, err := m.RelayerFeeShareRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.TakerFeeRate.Size()
+		i -= size
+		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.MakerFeeRate.Size()
+		i -= size
+		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x5a
 	if len(m.MarketId) > 0 {
 		i -= len(m.MarketId)
 		copy(dAtA[i:], m.MarketId)
 		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
 		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.SettlementTimestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.SettlementTimestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExpirationTimestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.ExpirationTimestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OracleScaleFactor != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.OracleScaleFactor))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OracleType != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.OracleType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OracleProvider) > 0 {
+		i -= len(m.OracleProvider)
+		copy(dAtA[i:], m.OracleProvider)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.OracleProvider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OracleSymbol) > 0 {
+		i -= len(m.OracleSymbol)
+		copy(dAtA[i:], m.OracleSymbol)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.OracleSymbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.Ticker)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PerpetualMarketInfo) Marshal() (dAtA []byte, err error) {
+func (m *VanillaOptionsMarket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4035,265 +5458,173 @@ func (m *PerpetualMarketInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PerpetualMarketInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *VanillaOptionsMarket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PerpetualMarketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VanillaOptionsMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.FundingInterval != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.FundingInterval))
+	if m.SettlementPrice != nil {
+		{
+			size := m.SettlementPrice.Size()
+			i -= size
+			if _, err := m.SettlementPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintExchange(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x28
-	}
-	if m.NextFundingTimestamp != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.NextFundingTimestamp))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0xa2
 	}
 	{
-		size := m.HourlyInterestRate.Size()
+		size := m.MinQuantityTickSize.Size()
 		i -= size
-		if _, err := m.HourlyInterestRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MinQuantityTickSize.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
 	{
-		size := m.HourlyFundingRateCap.Size()
+		size := m.MinPriceTickSize.Size()
 		i -= size
-		if _, err := m.HourlyFundingRateCap.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MinPriceTickSize.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.Status != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PerpetualMarketFunding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *PerpetualMarketFunding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PerpetualMarketFunding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastTimestamp != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.LastTimestamp))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x88
 	}
 	{
-		size := m.CumulativePrice.Size()
+		size := m.RelayerFeeShareRate.Size()
 		i -= size
-		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.RelayerFeeShareRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
 	{
-		size := m.CumulativeFunding.Size()
+		size := m.TakerFeeRate.Size()
 		i -= size
-		if _, err := m.CumulativeFunding.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *DerivativeMarketSettlementInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *DerivativeMarketSettlementInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DerivativeMarketSettlementInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
+	dAtA[i] = 0x7a
 	{
-		size := m.SettlementPrice.Size()
+		size := m.MakerFeeRate.Size()
 		i -= size
-		if _, err := m.SettlementPrice.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x72
 	if len(m.MarketId) > 0 {
 		i -= len(m.MarketId)
 		copy(dAtA[i:], m.MarketId)
 		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *NextFundingTimestamp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x6a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *NextFundingTimestamp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *NextFundingTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NextTimestamp != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.NextTimestamp))
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.QuoteDenom)))
 		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SpotMarket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *SpotMarket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SpotMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MinQuantityTickSize.Size()
-		i -= size
-		if _, err := m.MinQuantityTickSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
+		dAtA[i] = 0x62
 	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.MinPriceTickSize.Size()
-		i -= size
-		if _, err := m.MinPriceTickSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if m.Status != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.Status))
+	if m.SettlementTimestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.SettlementTimestamp))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x58
 	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+	if m.ExpirationTimestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.ExpirationTimestamp))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x50
 	}
 	{
-		size := m.RelayerFeeShareRate.Size()
+		size := m.MaxUnderlyingPrice.Size()
 		i -= size
-		if _, err := m.RelayerFeeShareRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxUnderlyingPrice.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
+	dAtA[i] = 0x4a
 	{
-		size := m.TakerFeeRate.Size()
+		size := m.ContractMultiplier.Size()
 		i -= size
-		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ContractMultiplier.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
+	dAtA[i] = 0x42
 	{
-		size := m.MakerFeeRate.Size()
+		size := m.StrikePrice.Size()
 		i -= size
-		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.StrikePrice.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if len(m.QuoteDenom) > 0 {
-		i -= len(m.QuoteDenom)
-		copy(dAtA[i:], m.QuoteDenom)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.QuoteDenom)))
+	dAtA[i] = 0x3a
+	if m.OptionType != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.OptionType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OracleScaleFactor != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.OracleScaleFactor))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OracleType != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.OracleType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OracleQuote) > 0 {
+		i -= len(m.OracleQuote)
+		copy(dAtA[i:], m.OracleQuote)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.OracleQuote)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.BaseDenom)))
+	if len(m.OracleBase) > 0 {
+		i -= len(m.OracleBase)
+		copy(dAtA[i:], m.OracleBase)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.OracleBase)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4307,7 +5638,7 @@ func (m *SpotMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Deposit) Marshal() (dAtA []byte, err error) {
+func (m *CategoricalMarket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4317,68 +5648,112 @@ func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *CategoricalMarket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CategoricalMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.TotalBalance.Size()
-		i -= size
-		if _, err := m.TotalBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.WinningOutcome) > 0 {
+		i -= len(m.WinningOutcome)
+		copy(dAtA[i:], m.WinningOutcome)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.WinningOutcome)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Status != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.OutcomeMarketIds) > 0 {
+		for iNdEx := len(m.OutcomeMarketIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OutcomeMarketIds[iNdEx])
+			copy(dAtA[i:], m.OutcomeMarketIds[iNdEx])
+			i = encodeVarintExchange(dAtA, i, uint64(len(m.OutcomeMarketIds[iNdEx])))
+			i--
+			dAtA[i] = 0x62
 		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.AvailableBalance.Size()
-		i -= size
-		if _, err := m.AvailableBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Outcomes) > 0 {
+		for iNdEx := len(m.Outcomes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Outcomes[iNdEx])
+			copy(dAtA[i:], m.Outcomes[iNdEx])
+			i = encodeVarintExchange(dAtA, i, uint64(len(m.Outcomes[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
 		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *SubaccountTradeNonce) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x52
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SubaccountTradeNonce) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SubaccountTradeNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.Nonce))
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.QuoteDenom)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x4a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.SettlementTimestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.SettlementTimestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExpirationTimestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.ExpirationTimestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OracleScaleFactor != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.OracleScaleFactor))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OracleType != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.OracleType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OracleProvider) > 0 {
+		i -= len(m.OracleProvider)
+		copy(dAtA[i:], m.OracleProvider)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.OracleProvider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OracleSymbol) > 0 {
+		i -= len(m.OracleSymbol)
+		copy(dAtA[i:], m.OracleSymbol)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.OracleSymbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OrderInfo) Marshal() (dAtA []byte, err error) {
+func (m *ExpiryFuturesMarketInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4388,100 +5763,46 @@ func (m *OrderInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OrderInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExpiryFuturesMarketInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OrderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExpiryFuturesMarketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Quantity.Size()
+		size := m.SettlementPrice.Size()
 		i -= size
-		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.SettlementPrice.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0x2a
 	{
-		size := m.Price.Size()
+		size := m.ExpirationTwapStartPriceCumulative.Size()
 		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ExpirationTwapStartPriceCumulative.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.FeeRecipient) > 0 {
-		i -= len(m.FeeRecipient)
-		copy(dAtA[i:], m.FeeRecipient)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.FeeRecipient)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SubaccountId) > 0 {
-		i -= len(m.SubaccountId)
-		copy(dAtA[i:], m.SubaccountId)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.SubaccountId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SpotOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *SpotOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SpotOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TriggerPrice != nil {
-		{
-			size := m.TriggerPrice.Size()
-			i -= size
-			if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintExchange(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.OrderType != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.OrderType))
+	dAtA[i] = 0x22
+	if m.TwapStartTimestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.TwapStartTimestamp))
 		i--
 		dAtA[i] = 0x18
 	}
-	{
-		size, err := m.OrderInfo.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintExchange(dAtA, i, uint64(size))
+	if m.ExpirationTimestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.ExpirationTimestamp))
+		i--
+		dAtA[i] = 0x10
 	}
-	i--
-	dAtA[i] = 0x12
 	if len(m.MarketId) > 0 {
 		i -= len(m.MarketId)
 		copy(dAtA[i:], m.MarketId)
@@ -4492,7 +5813,7 @@ func (m *SpotOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SpotLimitOrder) Marshal() (dAtA []byte, err error) {
+func (m *ExpiryFuturesMarketSeries) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4502,131 +5823,160 @@ func (m *SpotLimitOrder) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SpotLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExpiryFuturesMarketSeries) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SpotLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExpiryFuturesMarketSeries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.OrderHash) > 0 {
-		i -= len(m.OrderHash)
-		copy(dAtA[i:], m.OrderHash)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.OrderHash)))
+	if m.Status != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.TriggerPrice != nil {
-		{
-			size := m.TriggerPrice.Size()
-			i -= size
-			if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintExchange(dAtA, i, uint64(size))
+	if len(m.MarketIds) > 0 {
+		for iNdEx := len(m.MarketIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MarketIds[iNdEx])
+			copy(dAtA[i:], m.MarketIds[iNdEx])
+			i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
 		}
-		i--
-		dAtA[i] = 0x22
 	}
 	{
-		size := m.Fillable.Size()
+		size := m.MinQuantityTickSize.Size()
 		i -= size
-		if _, err := m.Fillable.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MinQuantityTickSize.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if m.OrderType != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.OrderType))
-		i--
-		dAtA[i] = 0x10
-	}
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
 	{
-		size, err := m.OrderInfo.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.MinPriceTickSize.Size()
+		i -= size
+		if _, err := m.MinPriceTickSize.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *SpotMarketOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *SpotMarketOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SpotMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TriggerPrice != nil {
-		{
-			size := m.TriggerPrice.Size()
-			i -= size
-			if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintExchange(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.OrderType != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.OrderType))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.OrderHash) > 0 {
-		i -= len(m.OrderHash)
-		copy(dAtA[i:], m.OrderHash)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.OrderHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
+	dAtA[i] = 0x7a
 	{
-		size := m.BalanceHold.Size()
+		size := m.TakerFeeRate.Size()
 		i -= size
-		if _, err := m.BalanceHold.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x72
 	{
-		size, err := m.OrderInfo.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.MakerFeeRate.Size()
+		i -= size
+		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
+	dAtA[i] = 0x6a
+	{
+		size := m.MaintenanceMarginRatio.Size()
+		i -= size
+		if _, err := m.MaintenanceMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.InitialMarginRatio.Size()
+		i -= size
+		if _, err := m.InitialMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.NextExpirationTimestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.NextExpirationTimestamp))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LeadTime != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.LeadTime))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Interval != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.OracleScaleFactor != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.OracleScaleFactor))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.OracleType != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.OracleType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.OracleQuote) > 0 {
+		i -= len(m.OracleQuote)
+		copy(dAtA[i:], m.OracleQuote)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.OracleQuote)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OracleBase) > 0 {
+		i -= len(m.OracleBase)
+		copy(dAtA[i:], m.OracleBase)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.OracleBase)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TickerPrefix) > 0 {
+		i -= len(m.TickerPrefix)
+		copy(dAtA[i:], m.TickerPrefix)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.TickerPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SeriesId) > 0 {
+		i -= len(m.SeriesId)
+		copy(dAtA[i:], m.SeriesId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.SeriesId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
 
-func (m *DerivativeOrder) Marshal() (dAtA []byte, err error) {
+func (m *ExpiryFuturesAutoRoll) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4636,64 +5986,34 @@ func (m *DerivativeOrder) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DerivativeOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExpiryFuturesAutoRoll) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DerivativeOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExpiryFuturesAutoRoll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TriggerPrice != nil {
-		{
-			size := m.TriggerPrice.Size()
-			i -= size
-			if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintExchange(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size := m.Margin.Size()
-		i -= size
-		if _, err := m.Margin.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.OrderType != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.OrderType))
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.SubaccountId)))
 		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size, err := m.OrderInfo.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintExchange(dAtA, i, uint64(size))
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+	if len(m.SeriesId) > 0 {
+		i -= len(m.SeriesId)
+		copy(dAtA[i:], m.SeriesId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.SeriesId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SubaccountOrderbookMetadata) Marshal() (dAtA []byte, err error) {
+func (m *PerpetualMarketInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4703,60 +6023,57 @@ func (m *SubaccountOrderbookMetadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubaccountOrderbookMetadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *PerpetualMarketInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubaccountOrderbookMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PerpetualMarketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ReduceOnlyConditionalOrderCount != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.ReduceOnlyConditionalOrderCount))
+	if m.FundingInterval != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.FundingInterval))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x28
 	}
-	if m.VanillaConditionalOrderCount != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.VanillaConditionalOrderCount))
+	if m.NextFundingTimestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.NextFundingTimestamp))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x20
 	}
 	{
-		size := m.AggregateVanillaQuantity.Size()
+		size := m.HourlyInterestRate.Size()
 		i -= size
-		if _, err := m.AggregateVanillaQuantity.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.HourlyInterestRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0x1a
 	{
-		size := m.AggregateReduceOnlyQuantity.Size()
+		size := m.HourlyFundingRateCap.Size()
 		i -= size
-		if _, err := m.AggregateReduceOnlyQuantity.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.HourlyFundingRateCap.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if m.ReduceOnlyLimitOrderCount != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.ReduceOnlyLimitOrderCount))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.VanillaLimitOrderCount != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.VanillaLimitOrderCount))
+	dAtA[i] = 0x12
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SubaccountOrder) Marshal() (dAtA []byte, err error) {
+func (m *PerpetualMarketFunding) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4766,30 +6083,25 @@ func (m *SubaccountOrder) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubaccountOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *PerpetualMarketFunding) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubaccountOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PerpetualMarketFunding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IsReduceOnly {
-		i--
-		if m.IsReduceOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.LastTimestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.LastTimestamp))
 		i--
 		dAtA[i] = 0x18
 	}
 	{
-		size := m.Quantity.Size()
+		size := m.CumulativePrice.Size()
 		i -= size
-		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
@@ -4797,9 +6109,9 @@ func (m *SubaccountOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x12
 	{
-		size := m.Price.Size()
+		size := m.CumulativeFunding.Size()
 		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.CumulativeFunding.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
@@ -4809,49 +6121,7 @@ func (m *SubaccountOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SubaccountOrderData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *SubaccountOrderData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SubaccountOrderData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OrderHash) > 0 {
-		i -= len(m.OrderHash)
-		copy(dAtA[i:], m.OrderHash)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.OrderHash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Order != nil {
-		{
-			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintExchange(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DerivativeLimitOrder) Marshal() (dAtA []byte, err error) {
+func (m *PreLaunchPerpetualMarketInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4861,116 +6131,70 @@ func (m *DerivativeLimitOrder) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DerivativeLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *PreLaunchPerpetualMarketInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DerivativeLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PreLaunchPerpetualMarketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.OrderHash) > 0 {
-		i -= len(m.OrderHash)
-		copy(dAtA[i:], m.OrderHash)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.OrderHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.TriggerPrice != nil {
-		{
-			size := m.TriggerPrice.Size()
-			i -= size
-			if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintExchange(dAtA, i, uint64(size))
-		}
+	if m.LastPriceTimestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.LastPriceTimestamp))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x50
 	}
 	{
-		size := m.Fillable.Size()
+		size := m.MaxMarkPriceChangeRate.Size()
 		i -= size
-		if _, err := m.Fillable.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxMarkPriceChangeRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0x4a
 	{
-		size := m.Margin.Size()
+		size := m.MinWindowQuantity.Size()
 		i -= size
-		if _, err := m.Margin.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MinWindowQuantity.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if m.OrderType != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.OrderType))
+	dAtA[i] = 0x42
+	if m.WindowStartTimestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.WindowStartTimestamp))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x38
 	}
 	{
-		size, err := m.OrderInfo.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.WindowQuantity.Size()
+		i -= size
+		if _, err := m.WindowQuantity.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *DerivativeMarketOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *DerivativeMarketOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DerivativeMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OrderHash) > 0 {
-		i -= len(m.OrderHash)
-		copy(dAtA[i:], m.OrderHash)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.OrderHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.TriggerPrice != nil {
-		{
-			size := m.TriggerPrice.Size()
-			i -= size
-			if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintExchange(dAtA, i, uint64(size))
+	dAtA[i] = 0x32
+	{
+		size := m.WindowCumulativePrice.Size()
+		i -= size
+		if _, err := m.WindowCumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x2a
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x2a
 	{
-		size := m.MarginHold.Size()
+		size := m.LastPrice.Size()
 		i -= size
-		if _, err := m.MarginHold.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.LastPrice.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
@@ -4978,34 +6202,31 @@ func (m *DerivativeMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x22
 	{
-		size := m.Margin.Size()
+		size := m.MarkPrice.Size()
 		i -= size
-		if _, err := m.Margin.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
-	if m.OrderType != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.OrderType))
+	if m.Window != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Window))
 		i--
 		dAtA[i] = 0x10
 	}
-	{
-		size, err := m.OrderInfo.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintExchange(dAtA, i, uint64(size))
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Position) Marshal() (dAtA []byte, err error) {
+func (m *DerivativeMarketSettlementInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5015,70 +6236,37 @@ func (m *Position) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Position) MarshalTo(dAtA []byte) (int, error) {
+func (m *DerivativeMarketSettlementInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DerivativeMarketSettlementInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.CumulativeFundingEntry.Size()
-		i -= size
-		if _, err := m.CumulativeFundingEntry.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.Margin.Size()
-		i -= size
-		if _, err := m.Margin.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.EntryPrice.Size()
-		i -= size
-		if _, err := m.EntryPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Quantity.Size()
+		size := m.SettlementPrice.Size()
 		i -= size
-		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.SettlementPrice.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	if m.IsLong {
-		i--
-		if m.IsLong {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MarketOrderIndicator) Marshal() (dAtA []byte, err error) {
+func (m *NextFundingTimestamp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5088,37 +6276,25 @@ func (m *MarketOrderIndicator) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MarketOrderIndicator) MarshalTo(dAtA []byte) (int, error) {
+func (m *NextFundingTimestamp) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MarketOrderIndicator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NextFundingTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IsBuy {
-		i--
-		if m.IsBuy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+	if m.NextTimestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.NextTimestamp))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TradeLog) Marshal() (dAtA []byte, err error) {
+func (m *SpotMarket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5128,71 +6304,103 @@ func (m *TradeLog) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TradeLog) MarshalTo(dAtA []byte) (int, error) {
+func (m *SpotMarket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TradeLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SpotMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.FeeRecipientAddress) > 0 {
-		i -= len(m.FeeRecipientAddress)
-		copy(dAtA[i:], m.FeeRecipientAddress)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.FeeRecipientAddress)))
+	{
+		size := m.MinQuantityTickSize.Size()
+		i -= size
+		if _, err := m.MinQuantityTickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MinPriceTickSize.Size()
+		i -= size
+		if _, err := m.MinPriceTickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Status != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x40
 	}
-	if len(m.OrderHash) > 0 {
-		i -= len(m.OrderHash)
-		copy(dAtA[i:], m.OrderHash)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.OrderHash)))
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x3a
 	}
 	{
-		size := m.Fee.Size()
+		size := m.RelayerFeeShareRate.Size()
 		i -= size
-		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.RelayerFeeShareRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if len(m.SubaccountId) > 0 {
-		i -= len(m.SubaccountId)
-		copy(dAtA[i:], m.SubaccountId)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.SubaccountId)))
-		i--
-		dAtA[i] = 0x1a
-	}
+	dAtA[i] = 0x32
 	{
-		size := m.Price.Size()
+		size := m.TakerFeeRate.Size()
 		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x2a
 	{
-		size := m.Quantity.Size()
+		size := m.MakerFeeRate.Size()
 		i -= size
-		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x22
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PositionDelta) Marshal() (dAtA []byte, err error) {
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5202,60 +6410,68 @@ func (m *PositionDelta) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PositionDelta) MarshalTo(dAtA []byte) (int, error) {
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PositionDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.ExecutionPrice.Size()
+		size := m.TotalBalance.Size()
 		i -= size
-		if _, err := m.ExecutionPrice.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TotalBalance.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0x12
 	{
-		size := m.ExecutionMargin.Size()
+		size := m.AvailableBalance.Size()
 		i -= size
-		if _, err := m.ExecutionMargin.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.AvailableBalance.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.ExecutionQuantity.Size()
-		i -= size
-		if _, err := m.ExecutionQuantity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SubaccountTradeNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.IsLong {
-		i--
-		if m.IsLong {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	return dAtA[:n], nil
+}
+
+func (m *SubaccountTradeNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubaccountTradeNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Nonce))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DerivativeTradeLog) Marshal() (dAtA []byte, err error) {
+func (m *OrderInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5265,34 +6481,20 @@ func (m *DerivativeTradeLog) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DerivativeTradeLog) MarshalTo(dAtA []byte) (int, error) {
+func (m *OrderInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DerivativeTradeLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OrderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.FeeRecipientAddress) > 0 {
-		i -= len(m.FeeRecipientAddress)
-		copy(dAtA[i:], m.FeeRecipientAddress)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.FeeRecipientAddress)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.OrderHash) > 0 {
-		i -= len(m.OrderHash)
-		copy(dAtA[i:], m.OrderHash)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.OrderHash)))
-		i--
-		dAtA[i] = 0x2a
-	}
 	{
-		size := m.Fee.Size()
+		size := m.Quantity.Size()
 		i -= size
-		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
@@ -5300,24 +6502,19 @@ func (m *DerivativeTradeLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x22
 	{
-		size := m.Payout.Size()
+		size := m.Price.Size()
 		i -= size
-		if _, err := m.Payout.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
-	if m.PositionDelta != nil {
-		{
-			size, err := m.PositionDelta.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintExchange(dAtA, i, uint64(size))
-		}
+	if len(m.FeeRecipient) > 0 {
+		i -= len(m.FeeRecipient)
+		copy(dAtA[i:], m.FeeRecipient)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.FeeRecipient)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -5331,7 +6528,7 @@ func (m *DerivativeTradeLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SubaccountPosition) Marshal() (dAtA []byte, err error) {
+func (m *SpotOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5341,39 +6538,66 @@ func (m *SubaccountPosition) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubaccountPosition) MarshalTo(dAtA []byte) (int, error) {
+func (m *SpotOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubaccountPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SpotOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SubaccountId) > 0 {
-		i -= len(m.SubaccountId)
-		copy(dAtA[i:], m.SubaccountId)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.SubaccountId)))
+	if m.VisibleQuantity != nil {
+		{
+			size := m.VisibleQuantity.Size()
+			i -= size
+			if _, err := m.VisibleQuantity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintExchange(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x2a
 	}
-	if m.Position != nil {
+	if m.TriggerPrice != nil {
 		{
-			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+			size := m.TriggerPrice.Size()
+			i -= size
+			if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i -= size
 			i = encodeVarintExchange(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x22
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.OrderInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SubaccountDeposit) Marshal() (dAtA []byte, err error) {
+func (m *SpotLimitOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5383,19 +6607,19 @@ func (m *SubaccountDeposit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubaccountDeposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *SpotLimitOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubaccountDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SpotLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Deposit != nil {
+	if m.Iceberg != nil {
 		{
-			size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Iceberg.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5403,98 +6627,48 @@ func (m *SubaccountDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintExchange(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x32
 	}
-	if len(m.SubaccountId) > 0 {
-		i -= len(m.SubaccountId)
-		copy(dAtA[i:], m.SubaccountId)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.SubaccountId)))
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.OrderHash)))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DepositUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x2a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DepositUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DepositUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Deposits) > 0 {
-		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintExchange(dAtA, i, uint64(size))
+	if m.TriggerPrice != nil {
+		{
+			size := m.TriggerPrice.Size()
+			i -= size
+			if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
+			i = encodeVarintExchange(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.Denom)))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PointsMultiplier) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x22
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PointsMultiplier) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PointsMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	{
-		size := m.TakerPointsMultiplier.Size()
+		size := m.Fillable.Size()
 		i -= size
-		if _, err := m.TakerPointsMultiplier.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Fillable.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x1a
+	if m.OrderType != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
-		size := m.MakerPointsMultiplier.Size()
-		i -= size
-		if _, err := m.MakerPointsMultiplier.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.OrderInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
@@ -5502,7 +6676,7 @@ func (m *PointsMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TradingRewardCampaignBoostInfo) Marshal() (dAtA []byte, err error) {
+func (m *SpotMarketOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5512,66 +6686,76 @@ func (m *TradingRewardCampaignBoostInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TradingRewardCampaignBoostInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *SpotMarketOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TradingRewardCampaignBoostInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SpotMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DerivativeMarketMultipliers) > 0 {
-		for iNdEx := len(m.DerivativeMarketMultipliers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DerivativeMarketMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintExchange(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	if m.QuoteAmount != nil {
+		{
+			size := m.QuoteAmount.Size()
+			i -= size
+			if _, err := m.QuoteAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintExchange(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.BoostedDerivativeMarketIds) > 0 {
-		for iNdEx := len(m.BoostedDerivativeMarketIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.BoostedDerivativeMarketIds[iNdEx])
-			copy(dAtA[i:], m.BoostedDerivativeMarketIds[iNdEx])
-			i = encodeVarintExchange(dAtA, i, uint64(len(m.BoostedDerivativeMarketIds[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	if m.TriggerPrice != nil {
+		{
+			size := m.TriggerPrice.Size()
+			i -= size
+			if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintExchange(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.SpotMarketMultipliers) > 0 {
-		for iNdEx := len(m.SpotMarketMultipliers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SpotMarketMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintExchange(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	if m.OrderType != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.OrderHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.BalanceHold.Size()
+		i -= size
+		if _, err := m.BalanceHold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	if len(m.BoostedSpotMarketIds) > 0 {
-		for iNdEx := len(m.BoostedSpotMarketIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.BoostedSpotMarketIds[iNdEx])
-			copy(dAtA[i:], m.BoostedSpotMarketIds[iNdEx])
-			i = encodeVarintExchange(dAtA, i, uint64(len(m.BoostedSpotMarketIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.OrderInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *CampaignRewardPool) Marshal() (dAtA []byte, err error) {
+func (m *DerivativeOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5581,39 +6765,76 @@ func (m *CampaignRewardPool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CampaignRewardPool) MarshalTo(dAtA []byte) (int, error) {
+func (m *DerivativeOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CampaignRewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DerivativeOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MaxCampaignRewards) > 0 {
-		for iNdEx := len(m.MaxCampaignRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MaxCampaignRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintExchange(dAtA, i, uint64(size))
+	if m.VisibleQuantity != nil {
+		{
+			size := m.VisibleQuantity.Size()
+			i -= size
+			if _, err := m.VisibleQuantity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
+			i = encodeVarintExchange(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TriggerPrice != nil {
+		{
+			size := m.TriggerPrice.Size()
+			i -= size
+			if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintExchange(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.StartTimestamp != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.StartTimestamp))
+	{
+		size := m.Margin.Size()
+		i -= size
+		if _, err := m.Margin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.OrderType != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.OrderType))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.OrderInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TradingRewardCampaignInfo) Marshal() (dAtA []byte, err error) {
+func (m *SubaccountOrderbookMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5623,55 +6844,60 @@ func (m *TradingRewardCampaignInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TradingRewardCampaignInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubaccountOrderbookMetadata) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TradingRewardCampaignInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubaccountOrderbookMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DisqualifiedMarketIds) > 0 {
-		for iNdEx := len(m.DisqualifiedMarketIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DisqualifiedMarketIds[iNdEx])
-			copy(dAtA[i:], m.DisqualifiedMarketIds[iNdEx])
-			i = encodeVarintExchange(dAtA, i, uint64(len(m.DisqualifiedMarketIds[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+	if m.ReduceOnlyConditionalOrderCount != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.ReduceOnlyConditionalOrderCount))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.TradingRewardBoostInfo != nil {
-		{
-			size, err := m.TradingRewardBoostInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintExchange(dAtA, i, uint64(size))
-		}
+	if m.VanillaConditionalOrderCount != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.VanillaConditionalOrderCount))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x28
 	}
-	if len(m.QuoteDenoms) > 0 {
-		for iNdEx := len(m.QuoteDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.QuoteDenoms[iNdEx])
-			copy(dAtA[i:], m.QuoteDenoms[iNdEx])
-			i = encodeVarintExchange(dAtA, i, uint64(len(m.QuoteDenoms[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+	{
+		size := m.AggregateVanillaQuantity.Size()
+		i -= size
+		if _, err := m.AggregateVanillaQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	if m.CampaignDurationSeconds != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.CampaignDurationSeconds))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AggregateReduceOnlyQuantity.Size()
+		i -= size
+		if _, err := m.AggregateReduceOnlyQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ReduceOnlyLimitOrderCount != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.ReduceOnlyLimitOrderCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.VanillaLimitOrderCount != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.VanillaLimitOrderCount))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FeeDiscountTierInfo) Marshal() (dAtA []byte, err error) {
+func (m *SubaccountOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5681,40 +6907,30 @@ func (m *FeeDiscountTierInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FeeDiscountTierInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubaccountOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FeeDiscountTierInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubaccountOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Volume.Size()
-		i -= size
-		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.StakedAmount.Size()
-		i -= size
-		if _, err := m.StakedAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.IsReduceOnly {
+		i--
+		if m.IsReduceOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i = encodeVarintExchange(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x18
 	}
-	i--
-	dAtA[i] = 0x1a
 	{
-		size := m.TakerDiscountRate.Size()
+		size := m.Quantity.Size()
 		i -= size
-		if _, err := m.TakerDiscountRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
@@ -5722,9 +6938,9 @@ func (m *FeeDiscountTierInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x12
 	{
-		size := m.MakerDiscountRate.Size()
+		size := m.Price.Size()
 		i -= size
-		if _, err := m.MakerDiscountRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
@@ -5734,7 +6950,7 @@ func (m *FeeDiscountTierInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *FeeDiscountSchedule) Marshal() (dAtA []byte, err error) {
+func (m *SubaccountOrderData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5744,62 +6960,39 @@ func (m *FeeDiscountSchedule) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FeeDiscountSchedule) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubaccountOrderData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FeeDiscountSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubaccountOrderData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DisqualifiedMarketIds) > 0 {
-		for iNdEx := len(m.DisqualifiedMarketIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DisqualifiedMarketIds[iNdEx])
-			copy(dAtA[i:], m.DisqualifiedMarketIds[iNdEx])
-			i = encodeVarintExchange(dAtA, i, uint64(len(m.DisqualifiedMarketIds[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.OrderHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.TierInfos) > 0 {
-		for iNdEx := len(m.TierInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TierInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintExchange(dAtA, i, uint64(size))
+	if m.Order != nil {
+		{
+			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.QuoteDenoms) > 0 {
-		for iNdEx := len(m.QuoteDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.QuoteDenoms[iNdEx])
-			copy(dAtA[i:], m.QuoteDenoms[iNdEx])
-			i = encodeVarintExchange(dAtA, i, uint64(len(m.QuoteDenoms[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+			i -= size
+			i = encodeVarintExchange(dAtA, i, uint64(size))
 		}
-	}
-	if m.BucketDuration != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.BucketDuration))
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.BucketCount != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.BucketCount))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FeeDiscountTierTTL) Marshal() (dAtA []byte, err error) {
+func (m *DerivativeLimitOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5809,30 +7002,86 @@ func (m *FeeDiscountTierTTL) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FeeDiscountTierTTL) MarshalTo(dAtA []byte) (int, error) {
+func (m *DerivativeLimitOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FeeDiscountTierTTL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DerivativeLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TtlTimestamp != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.TtlTimestamp))
+	if m.Iceberg != nil {
+		{
+			size, err := m.Iceberg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExchange(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x3a
 	}
-	if m.Tier != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.Tier))
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.OrderHash)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x32
+	}
+	if m.TriggerPrice != nil {
+		{
+			size := m.TriggerPrice.Size()
+			i -= size
+			if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintExchange(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Fillable.Size()
+		i -= size
+		if _, err := m.Fillable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Margin.Size()
+		i -= size
+		if _, err := m.Margin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.OrderType != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.OrderInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *VolumeRecord) Marshal() (dAtA []byte, err error) {
+func (m *IcebergOrderInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5842,20 +7091,40 @@ func (m *VolumeRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VolumeRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *IcebergOrderInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VolumeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IcebergOrderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.TakerVolume.Size()
+		size := m.HiddenBalanceHold.Size()
 		i -= size
-		if _, err := m.TakerVolume.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.HiddenBalanceHold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.HiddenMargin.Size()
+		i -= size
+		if _, err := m.HiddenMargin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.HiddenQuantity.Size()
+		i -= size
+		if _, err := m.HiddenQuantity.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
@@ -5863,9 +7132,9 @@ func (m *VolumeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x12
 	{
-		size := m.MakerVolume.Size()
+		size := m.VisibleQuantity.Size()
 		i -= size
-		if _, err := m.MakerVolume.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.VisibleQuantity.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
@@ -5875,7 +7144,7 @@ func (m *VolumeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountRewards) Marshal() (dAtA []byte, err error) {
+func (m *DerivativeMarketOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5885,85 +7154,74 @@ func (m *AccountRewards) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountRewards) MarshalTo(dAtA []byte) (int, error) {
+func (m *DerivativeMarketOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DerivativeMarketOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintExchange(dAtA, i, uint64(size))
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.OrderHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TriggerPrice != nil {
+		{
+			size := m.TriggerPrice.Size()
+			i -= size
+			if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
+			i = encodeVarintExchange(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.Account)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x2a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TradeRecords) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	{
+		size := m.MarginHold.Size()
+		i -= size
+		if _, err := m.MarginHold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TradeRecords) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TradeRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LatestTradeRecords) > 0 {
-		for iNdEx := len(m.LatestTradeRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LatestTradeRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintExchange(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Margin.Size()
+		i -= size
+		if _, err := m.Margin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+	i--
+	dAtA[i] = 0x1a
+	if m.OrderType != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.OrderType))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.OrderInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *SubaccountIDs) Marshal() (dAtA []byte, err error) {
+func (m *TWAPOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5973,77 +7231,201 @@ func (m *SubaccountIDs) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubaccountIDs) MarshalTo(dAtA []byte) (int, error) {
+func (m *TWAPOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubaccountIDs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TWAPOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SubaccountIds) > 0 {
-		for iNdEx := len(m.SubaccountIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SubaccountIds[iNdEx])
-			copy(dAtA[i:], m.SubaccountIds[iNdEx])
-			i = encodeVarintExchange(dAtA, i, uint64(len(m.SubaccountIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	{
+		size := m.PendingBalanceHold.Size()
+		i -= size
+		if _, err := m.PendingBalanceHold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TradeRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.PendingMargin.Size()
+		i -= size
+		if _, err := m.PendingMargin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TradeRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TradeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
 	{
-		size := m.Quantity.Size()
+		size := m.PendingQuantity.Size()
 		i -= size
-		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.PendingQuantity.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
 	{
-		size := m.Price.Size()
+		size := m.MaxSlippage.Size()
 		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.RemainingMargin.Size()
+		i -= size
+		if _, err := m.RemainingMargin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.RemainingQuantity.Size()
+		i -= size
+		if _, err := m.RemainingQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.FilledNotional.Size()
+		i -= size
+		if _, err := m.FilledNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.FilledQuantity.Size()
+		i -= size
+		if _, err := m.FilledQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.BalanceHold.Size()
+		i -= size
+		if _, err := m.BalanceHold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.NextSliceTimestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.NextSliceTimestamp))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.NextSliceHeight != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.NextSliceHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ExecutedSlices != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.ExecutedSlices))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.IsDerivative {
+		i--
+		if m.IsDerivative {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.OrderHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.IntervalSeconds != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.IntervalSeconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.IntervalBlocks != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.IntervalBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Slices != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Slices))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Margin.Size()
+		i -= size
+		if _, err := m.Margin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.OrderType != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.OrderInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	if m.Timestamp != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.Timestamp))
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Level) Marshal() (dAtA []byte, err error) {
+func (m *Position) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6053,40 +7435,70 @@ func (m *Level) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Level) MarshalTo(dAtA []byte) (int, error) {
+func (m *Position) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Level) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Q.Size()
+		size := m.CumulativeFundingEntry.Size()
 		i -= size
-		if _, err := m.Q.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.CumulativeFundingEntry.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x2a
 	{
-		size := m.P.Size()
+		size := m.Margin.Size()
 		i -= size
-		if _, err := m.P.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Margin.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x22
+	{
+		size := m.EntryPrice.Size()
+		i -= size
+		if _, err := m.EntryPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.IsLong {
+		i--
+		if m.IsLong {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AggregateSubaccountVolumeRecord) Marshal() (dAtA []byte, err error) {
+func (m *MarketOrderIndicator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6096,41 +7508,37 @@ func (m *AggregateSubaccountVolumeRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AggregateSubaccountVolumeRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *MarketOrderIndicator) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AggregateSubaccountVolumeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MarketOrderIndicator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MarketVolumes) > 0 {
-		for iNdEx := len(m.MarketVolumes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MarketVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintExchange(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	if m.IsBuy {
+		i--
+		if m.IsBuy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.SubaccountId) > 0 {
-		i -= len(m.SubaccountId)
-		copy(dAtA[i:], m.SubaccountId)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.SubaccountId)))
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AggregateAccountVolumeRecord) Marshal() (dAtA []byte, err error) {
+func (m *TradeLog) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6140,41 +7548,71 @@ func (m *AggregateAccountVolumeRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AggregateAccountVolumeRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *TradeLog) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AggregateAccountVolumeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TradeLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MarketVolumes) > 0 {
-		for iNdEx := len(m.MarketVolumes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MarketVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintExchange(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	if len(m.FeeRecipientAddress) > 0 {
+		i -= len(m.FeeRecipientAddress)
+		copy(dAtA[i:], m.FeeRecipientAddress)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.FeeRecipientAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.OrderHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.Account)))
+	i--
+	dAtA[i] = 0x22
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.SubaccountId)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MarketVolume) Marshal() (dAtA []byte, err error) {
+func (m *PositionDelta) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6184,37 +7622,60 @@ func (m *MarketVolume) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MarketVolume) MarshalTo(dAtA []byte) (int, error) {
+func (m *PositionDelta) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MarketVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PositionDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Volume.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.ExecutionPrice.Size()
+		i -= size
+		if _, err := m.ExecutionPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExecutionMargin.Size()
+		i -= size
+		if _, err := m.ExecutionMargin.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExecutionQuantity.Size()
 		i -= size
+		if _, err := m.ExecutionQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	if len(m.MarketId) > 0 {
-		i -= len(m.MarketId)
-		copy(dAtA[i:], m.MarketId)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+	if m.IsLong {
 		i--
-		dAtA[i] = 0xa
+		if m.IsLong {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DenomDecimals) Marshal() (dAtA []byte, err error) {
+func (m *DerivativeTradeLog) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6224,1105 +7685,7928 @@ func (m *DenomDecimals) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DenomDecimals) MarshalTo(dAtA []byte) (int, error) {
+func (m *DerivativeTradeLog) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DenomDecimals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DerivativeTradeLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Decimals != 0 {
-		i = encodeVarintExchange(dAtA, i, uint64(m.Decimals))
+	{
+		size := m.Pnl.Size()
+		i -= size
+		if _, err := m.Pnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.FeeRecipientAddress) > 0 {
+		i -= len(m.FeeRecipientAddress)
+		copy(dAtA[i:], m.FeeRecipientAddress)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.FeeRecipientAddress)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x32
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintExchange(dAtA, i, uint64(len(m.Denom)))
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.OrderHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Payout.Size()
+		i -= size
+		if _, err := m.Payout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PositionDelta != nil {
+		{
+			size, err := m.PositionDelta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExchange(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.SubaccountId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintExchange(dAtA []byte, offset int, v uint64) int {
-	offset -= sovExchange(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SubaccountPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SubaccountPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubaccountPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.SpotMarketInstantListingFee.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.DerivativeMarketInstantListingFee.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.DefaultSpotMakerFeeRate.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.DefaultSpotTakerFeeRate.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.DefaultDerivativeMakerFeeRate.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.DefaultDerivativeTakerFeeRate.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.DefaultInitialMarginRatio.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.DefaultMaintenanceMarginRatio.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	if m.DefaultFundingInterval != 0 {
-		n += 1 + sovExchange(uint64(m.DefaultFundingInterval))
-	}
-	if m.FundingMultiple != 0 {
-		n += 1 + sovExchange(uint64(m.FundingMultiple))
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.RelayerFeeShareRate.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.DefaultHourlyFundingRateCap.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.DefaultHourlyInterestRate.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	if m.MaxDerivativeOrderSideCount != 0 {
-		n += 1 + sovExchange(uint64(m.MaxDerivativeOrderSideCount))
+	if m.Position != nil {
+		{
+			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExchange(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.InjRewardStakedRequirementThreshold.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	if m.TradingRewardsVestingDuration != 0 {
-		n += 2 + sovExchange(uint64(m.TradingRewardsVestingDuration))
+	return len(dAtA) - i, nil
+}
+
+func (m *SubaccountDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.LiquidatorRewardShareRate.Size()
-	n += 2 + l + sovExchange(uint64(l))
-	l = m.BinaryOptionsMarketInstantListingFee.Size()
-	n += 2 + l + sovExchange(uint64(l))
-	if m.AtomicMarketOrderAccessLevel != 0 {
-		n += 2 + sovExchange(uint64(m.AtomicMarketOrderAccessLevel))
-	}
-	l = m.SpotAtomicMarketOrderFeeMultiplier.Size()
-	n += 2 + l + sovExchange(uint64(l))
-	l = m.DerivativeAtomicMarketOrderFeeMultiplier.Size()
-	n += 2 + l + sovExchange(uint64(l))
-	l = m.BinaryOptionsAtomicMarketOrderFeeMultiplier.Size()
-	n += 2 + l + sovExchange(uint64(l))
-	l = m.MinimalProtocolFeeRate.Size()
-	n += 2 + l + sovExchange(uint64(l))
-	if m.IsInstantDerivativeMarketLaunchEnabled {
-		n += 3
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MarketFeeMultiplier) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	l = m.FeeMultiplier.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	return n
+func (m *SubaccountDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DerivativeMarket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SubaccountDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Ticker)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	l = len(m.OracleBase)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	l = len(m.OracleQuote)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	if m.OracleType != 0 {
-		n += 1 + sovExchange(uint64(m.OracleType))
-	}
-	if m.OracleScaleFactor != 0 {
-		n += 1 + sovExchange(uint64(m.OracleScaleFactor))
-	}
-	l = len(m.QuoteDenom)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	l = m.InitialMarginRatio.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.MaintenanceMarginRatio.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.MakerFeeRate.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.TakerFeeRate.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.RelayerFeeShareRate.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	if m.IsPerpetual {
-		n += 2
+	if m.Deposit != nil {
+		{
+			size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExchange(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Status != 0 {
-		n += 1 + sovExchange(uint64(m.Status))
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.MinPriceTickSize.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.MinQuantityTickSize.Size()
-	n += 2 + l + sovExchange(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BinaryOptionsMarket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Ticker)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	l = len(m.OracleSymbol)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	l = len(m.OracleProvider)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	if m.OracleType != 0 {
-		n += 1 + sovExchange(uint64(m.OracleType))
-	}
-	if m.OracleScaleFactor != 0 {
-		n += 1 + sovExchange(uint64(m.OracleScaleFactor))
-	}
-	if m.ExpirationTimestamp != 0 {
-		n += 1 + sovExchange(uint64(m.ExpirationTimestamp))
-	}
-	if m.SettlementTimestamp != 0 {
-		n += 1 + sovExchange(uint64(m.SettlementTimestamp))
-	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	l = len(m.QuoteDenom)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	l = m.MakerFeeRate.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.TakerFeeRate.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.RelayerFeeShareRate.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovExchange(uint64(m.Status))
-	}
-	l = m.MinPriceTickSize.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.MinQuantityTickSize.Size()
-	n += 2 + l + sovExchange(uint64(l))
-	if m.SettlementPrice != nil {
-		l = m.SettlementPrice.Size()
-		n += 2 + l + sovExchange(uint64(l))
+func (m *DepositUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ExpiryFuturesMarketInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	if m.ExpirationTimestamp != 0 {
-		n += 1 + sovExchange(uint64(m.ExpirationTimestamp))
-	}
-	if m.TwapStartTimestamp != 0 {
-		n += 1 + sovExchange(uint64(m.TwapStartTimestamp))
-	}
-	l = m.ExpirationTwapStartPriceCumulative.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.SettlementPrice.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	return n
+func (m *DepositUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PerpetualMarketInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DepositUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	l = m.HourlyFundingRateCap.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.HourlyInterestRate.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	if m.NextFundingTimestamp != 0 {
-		n += 1 + sovExchange(uint64(m.NextFundingTimestamp))
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExchange(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.FundingInterval != 0 {
-		n += 1 + sovExchange(uint64(m.FundingInterval))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PerpetualMarketFunding) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CumulativeFunding.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.CumulativePrice.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	if m.LastTimestamp != 0 {
-		n += 1 + sovExchange(uint64(m.LastTimestamp))
+func (m *PointsMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DerivativeMarketSettlementInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	l = m.SettlementPrice.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	return n
+func (m *PointsMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NextFundingTimestamp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PointsMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NextTimestamp != 0 {
-		n += 1 + sovExchange(uint64(m.NextTimestamp))
+	{
+		size := m.TakerPointsMultiplier.Size()
+		i -= size
+		if _, err := m.TakerPointsMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MakerPointsMultiplier.Size()
+		i -= size
+		if _, err := m.MakerPointsMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *SpotMarket) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TradingRewardCampaignBoostInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TradingRewardCampaignBoostInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradingRewardCampaignBoostInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Ticker)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
+	if len(m.DerivativeMarketMultipliers) > 0 {
+		for iNdEx := len(m.DerivativeMarketMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DerivativeMarketMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExchange(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.QuoteDenom)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
+	if len(m.BoostedDerivativeMarketIds) > 0 {
+		for iNdEx := len(m.BoostedDerivativeMarketIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BoostedDerivativeMarketIds[iNdEx])
+			copy(dAtA[i:], m.BoostedDerivativeMarketIds[iNdEx])
+			i = encodeVarintExchange(dAtA, i, uint64(len(m.BoostedDerivativeMarketIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = m.MakerFeeRate.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.TakerFeeRate.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.RelayerFeeShareRate.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
+	if len(m.SpotMarketMultipliers) > 0 {
+		for iNdEx := len(m.SpotMarketMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpotMarketMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExchange(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Status != 0 {
-		n += 1 + sovExchange(uint64(m.Status))
+	if len(m.BoostedSpotMarketIds) > 0 {
+		for iNdEx := len(m.BoostedSpotMarketIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BoostedSpotMarketIds[iNdEx])
+			copy(dAtA[i:], m.BoostedSpotMarketIds[iNdEx])
+			i = encodeVarintExchange(dAtA, i, uint64(len(m.BoostedSpotMarketIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	l = m.MinPriceTickSize.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.MinQuantityTickSize.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Deposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CampaignRewardPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AvailableBalance.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.TotalBalance.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SubaccountTradeNonce) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovExchange(uint64(m.Nonce))
-	}
-	return n
+func (m *CampaignRewardPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OrderInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CampaignRewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SubaccountId)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
+	if len(m.MaxCampaignRewards) > 0 {
+		for iNdEx := len(m.MaxCampaignRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxCampaignRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExchange(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.FeeRecipient)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
+	if m.StartTimestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.StartTimestamp))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.Quantity.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SpotOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	l = m.OrderInfo.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	if m.OrderType != 0 {
-		n += 1 + sovExchange(uint64(m.OrderType))
-	}
-	if m.TriggerPrice != nil {
-		l = m.TriggerPrice.Size()
-		n += 1 + l + sovExchange(uint64(l))
+func (m *TradingRewardCampaignInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SpotLimitOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TradingRewardCampaignInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradingRewardCampaignInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.OrderInfo.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	if m.OrderType != 0 {
-		n += 1 + sovExchange(uint64(m.OrderType))
+	if len(m.DisqualifiedMarketIds) > 0 {
+		for iNdEx := len(m.DisqualifiedMarketIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisqualifiedMarketIds[iNdEx])
+			copy(dAtA[i:], m.DisqualifiedMarketIds[iNdEx])
+			i = encodeVarintExchange(dAtA, i, uint64(len(m.DisqualifiedMarketIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = m.Fillable.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	if m.TriggerPrice != nil {
-		l = m.TriggerPrice.Size()
-		n += 1 + l + sovExchange(uint64(l))
+	if m.TradingRewardBoostInfo != nil {
+		{
+			size, err := m.TradingRewardBoostInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExchange(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.OrderHash)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
+	if len(m.QuoteDenoms) > 0 {
+		for iNdEx := len(m.QuoteDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.QuoteDenoms[iNdEx])
+			copy(dAtA[i:], m.QuoteDenoms[iNdEx])
+			i = encodeVarintExchange(dAtA, i, uint64(len(m.QuoteDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if m.CampaignDurationSeconds != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.CampaignDurationSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SpotMarketOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.OrderInfo.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.BalanceHold.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = len(m.OrderHash)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	if m.OrderType != 0 {
-		n += 1 + sovExchange(uint64(m.OrderType))
-	}
-	if m.TriggerPrice != nil {
-		l = m.TriggerPrice.Size()
-		n += 1 + l + sovExchange(uint64(l))
+func (m *FeeDiscountTierInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DerivativeOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *FeeDiscountTierInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDiscountTierInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	l = m.OrderInfo.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	if m.OrderType != 0 {
-		n += 1 + sovExchange(uint64(m.OrderType))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.StakedAmount.Size()
+		i -= size
+		if _, err := m.StakedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	l = m.Margin.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	if m.TriggerPrice != nil {
-		l = m.TriggerPrice.Size()
-		n += 1 + l + sovExchange(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TakerDiscountRate.Size()
+		i -= size
+		if _, err := m.TakerDiscountRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MakerDiscountRate.Size()
+		i -= size
+		if _, err := m.MakerDiscountRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *SubaccountOrderbookMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FeeDiscountSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDiscountSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDiscountSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.VanillaLimitOrderCount != 0 {
-		n += 1 + sovExchange(uint64(m.VanillaLimitOrderCount))
+	if len(m.DisqualifiedMarketIds) > 0 {
+		for iNdEx := len(m.DisqualifiedMarketIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisqualifiedMarketIds[iNdEx])
+			copy(dAtA[i:], m.DisqualifiedMarketIds[iNdEx])
+			i = encodeVarintExchange(dAtA, i, uint64(len(m.DisqualifiedMarketIds[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.ReduceOnlyLimitOrderCount != 0 {
-		n += 1 + sovExchange(uint64(m.ReduceOnlyLimitOrderCount))
+	if len(m.TierInfos) > 0 {
+		for iNdEx := len(m.TierInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TierInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExchange(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = m.AggregateReduceOnlyQuantity.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.AggregateVanillaQuantity.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	if m.VanillaConditionalOrderCount != 0 {
-		n += 1 + sovExchange(uint64(m.VanillaConditionalOrderCount))
+	if len(m.QuoteDenoms) > 0 {
+		for iNdEx := len(m.QuoteDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.QuoteDenoms[iNdEx])
+			copy(dAtA[i:], m.QuoteDenoms[iNdEx])
+			i = encodeVarintExchange(dAtA, i, uint64(len(m.QuoteDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.ReduceOnlyConditionalOrderCount != 0 {
-		n += 1 + sovExchange(uint64(m.ReduceOnlyConditionalOrderCount))
+	if m.BucketDuration != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.BucketDuration))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.BucketCount != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.BucketCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SubaccountOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.Quantity.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	if m.IsReduceOnly {
-		n += 2
+func (m *FeeDiscountTierTTL) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SubaccountOrderData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *FeeDiscountTierTTL) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDiscountTierTTL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Order != nil {
-		l = m.Order.Size()
-		n += 1 + l + sovExchange(uint64(l))
+	if m.TtlTimestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.TtlTimestamp))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.OrderHash)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
+	if m.Tier != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Tier))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DerivativeLimitOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.OrderInfo.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	if m.OrderType != 0 {
-		n += 1 + sovExchange(uint64(m.OrderType))
+func (m *VolumeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Margin.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.Fillable.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	if m.TriggerPrice != nil {
-		l = m.TriggerPrice.Size()
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	l = len(m.OrderHash)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DerivativeMarketOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *VolumeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolumeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.OrderInfo.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	if m.OrderType != 0 {
-		n += 1 + sovExchange(uint64(m.OrderType))
-	}
-	l = m.Margin.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.MarginHold.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	if m.TriggerPrice != nil {
-		l = m.TriggerPrice.Size()
-		n += 1 + l + sovExchange(uint64(l))
+	{
+		size := m.TakerVolume.Size()
+		i -= size
+		if _, err := m.TakerVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	l = len(m.OrderHash)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MakerVolume.Size()
+		i -= size
+		if _, err := m.MakerVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Position) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IsLong {
-		n += 2
+func (m *AccountRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Quantity.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.EntryPrice.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.Margin.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.CumulativeFundingEntry.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MarketOrderIndicator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	if m.IsBuy {
-		n += 2
-	}
-	return n
+func (m *AccountRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TradeLog) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AccountRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Quantity.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.Price.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = len(m.SubaccountId)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	l = m.Fee.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = len(m.OrderHash)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExchange(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.FeeRecipientAddress)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PositionDelta) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IsLong {
-		n += 2
+func (m *TradeRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.ExecutionQuantity.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.ExecutionMargin.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.ExecutionPrice.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DerivativeTradeLog) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TradeRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradeRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SubaccountId)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	if m.PositionDelta != nil {
-		l = m.PositionDelta.Size()
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	l = m.Payout.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.Fee.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = len(m.OrderHash)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
+	if len(m.LatestTradeRecords) > 0 {
+		for iNdEx := len(m.LatestTradeRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LatestTradeRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExchange(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.FeeRecipientAddress)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SubaccountPosition) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SubaccountIDs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SubaccountIDs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubaccountIDs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Position != nil {
-		l = m.Position.Size()
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	l = len(m.SubaccountId)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
+	if len(m.SubaccountIds) > 0 {
+		for iNdEx := len(m.SubaccountIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SubaccountIds[iNdEx])
+			copy(dAtA[i:], m.SubaccountIds[iNdEx])
+			i = encodeVarintExchange(dAtA, i, uint64(len(m.SubaccountIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SubaccountDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SubaccountId)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	if m.Deposit != nil {
-		l = m.Deposit.Size()
-		n += 1 + l + sovExchange(uint64(l))
+func (m *TradeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DepositUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TradeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	if len(m.Deposits) > 0 {
-		for _, e := range m.Deposits {
-			l = e.Size()
-			n += 1 + l + sovExchange(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if m.Timestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PointsMultiplier) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MarketTradeRecordRetention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.MakerPointsMultiplier.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.TakerPointsMultiplier.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TradingRewardCampaignBoostInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MarketTradeRecordRetention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketTradeRecordRetention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BoostedSpotMarketIds) > 0 {
-		for _, s := range m.BoostedSpotMarketIds {
-			l = len(s)
-			n += 1 + l + sovExchange(uint64(l))
-		}
-	}
-	if len(m.SpotMarketMultipliers) > 0 {
-		for _, e := range m.SpotMarketMultipliers {
-			l = e.Size()
-			n += 1 + l + sovExchange(uint64(l))
-		}
-	}
-	if len(m.BoostedDerivativeMarketIds) > 0 {
-		for _, s := range m.BoostedDerivativeMarketIds {
-			l = len(s)
-			n += 1 + l + sovExchange(uint64(l))
-		}
+	if m.RetentionSeconds != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.RetentionSeconds))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.DerivativeMarketMultipliers) > 0 {
-		for _, e := range m.DerivativeMarketMultipliers {
-			l = e.Size()
-			n += 1 + l + sovExchange(uint64(l))
-		}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CampaignRewardPool) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Level) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Level) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Level) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StartTimestamp != 0 {
-		n += 1 + sovExchange(uint64(m.StartTimestamp))
+	{
+		size := m.Q.Size()
+		i -= size
+		if _, err := m.Q.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	if len(m.MaxCampaignRewards) > 0 {
-		for _, e := range m.MaxCampaignRewards {
-			l = e.Size()
-			n += 1 + l + sovExchange(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.P.Size()
+		i -= size
+		if _, err := m.P.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *TradingRewardCampaignInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AggregateSubaccountVolumeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateSubaccountVolumeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateSubaccountVolumeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CampaignDurationSeconds != 0 {
-		n += 1 + sovExchange(uint64(m.CampaignDurationSeconds))
-	}
-	if len(m.QuoteDenoms) > 0 {
-		for _, s := range m.QuoteDenoms {
-			l = len(s)
-			n += 1 + l + sovExchange(uint64(l))
+	if len(m.MarketVolumes) > 0 {
+		for iNdEx := len(m.MarketVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExchange(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.TradingRewardBoostInfo != nil {
-		l = m.TradingRewardBoostInfo.Size()
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	if len(m.DisqualifiedMarketIds) > 0 {
-		for _, s := range m.DisqualifiedMarketIds {
-			l = len(s)
-			n += 1 + l + sovExchange(uint64(l))
-		}
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FeeDiscountTierInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AggregateAccountVolumeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.MakerDiscountRate.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.TakerDiscountRate.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.StakedAmount.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.Volume.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *FeeDiscountSchedule) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AggregateAccountVolumeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateAccountVolumeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BucketCount != 0 {
-		n += 1 + sovExchange(uint64(m.BucketCount))
-	}
-	if m.BucketDuration != 0 {
-		n += 1 + sovExchange(uint64(m.BucketDuration))
-	}
-	if len(m.QuoteDenoms) > 0 {
-		for _, s := range m.QuoteDenoms {
-			l = len(s)
-			n += 1 + l + sovExchange(uint64(l))
-		}
-	}
-	if len(m.TierInfos) > 0 {
-		for _, e := range m.TierInfos {
-			l = e.Size()
-			n += 1 + l + sovExchange(uint64(l))
+	if len(m.MarketVolumes) > 0 {
+		for iNdEx := len(m.MarketVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExchange(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.DisqualifiedMarketIds) > 0 {
-		for _, s := range m.DisqualifiedMarketIds {
-			l = len(s)
-			n += 1 + l + sovExchange(uint64(l))
-		}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FeeDiscountTierTTL) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Tier != 0 {
-		n += 1 + sovExchange(uint64(m.Tier))
-	}
-	if m.TtlTimestamp != 0 {
-		n += 1 + sovExchange(uint64(m.TtlTimestamp))
+func (m *MarketVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *VolumeRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.MakerVolume.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.TakerVolume.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	return n
+func (m *MarketVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountRewards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MarketVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovExchange(uint64(l))
+	{
+		size, err := m.Volume.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TradeRecords) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	if len(m.LatestTradeRecords) > 0 {
-		for _, e := range m.LatestTradeRecords {
-			l = e.Size()
-			n += 1 + l + sovExchange(uint64(l))
-		}
+func (m *DenomDecimals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SubaccountIDs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SubaccountIds) > 0 {
-		for _, b := range m.SubaccountIds {
-			l = len(b)
-			n += 1 + l + sovExchange(uint64(l))
-		}
-	}
-	return n
+func (m *DenomDecimals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TradeRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DenomDecimals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovExchange(uint64(m.Timestamp))
+	if m.Decimals != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.Quantity.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Level) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TerminalOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.P.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	l = m.Q.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AggregateSubaccountVolumeRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TerminalOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TerminalOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SubaccountId)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
+	if m.Timestamp != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x68
 	}
-	if len(m.MarketVolumes) > 0 {
-		for _, e := range m.MarketVolumes {
-			l = e.Size()
-			n += 1 + l + sovExchange(uint64(l))
+	if m.BlockHeight != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.AveragePrice.Size()
+		i -= size
+		if _, err := m.AveragePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *AggregateAccountVolumeRecord) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.FilledQuantity.Size()
+		i -= size
+		if _, err := m.FilledQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
+	i--
+	dAtA[i] = 0x52
+	if m.Reason != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x48
 	}
-	if len(m.MarketVolumes) > 0 {
-		for _, e := range m.MarketVolumes {
-			l = e.Size()
-			n += 1 + l + sovExchange(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.OrderType != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IsSpot {
+		i--
+		if m.IsSpot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.OrderHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrderFill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrderFill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrderFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Notional.Size()
+		i -= size
+		if _, err := m.Notional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.OrderHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubaccountPnl) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubaccountPnl) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubaccountPnl) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdatedHeight != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.LastUpdatedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.FundingPaid.Size()
+		i -= size
+		if _, err := m.FundingPaid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FeesPaid.Size()
+		i -= size
+		if _, err := m.FeesPaid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RFQRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RFQRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RFQRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiry != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.IsBuy {
+		i--
+		if m.IsBuy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Taker) > 0 {
+		i -= len(m.Taker)
+		copy(dAtA[i:], m.Taker)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.Taker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RequestId != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RFQQuote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RFQQuote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RFQQuote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiry != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Margin.Size()
+		i -= size
+		if _, err := m.Margin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.MakerSubaccountId) > 0 {
+		i -= len(m.MakerSubaccountId)
+		copy(dAtA[i:], m.MakerSubaccountId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MakerSubaccountId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RequestId != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignedOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Expiry != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.Margin.Size()
+		i -= size
+		if _, err := m.Margin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExchange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.OrderType != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FeeRecipient) > 0 {
+		i -= len(m.FeeRecipient)
+		copy(dAtA[i:], m.FeeRecipient)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.FeeRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignedOrderNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedOrderNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedOrderNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.OrderHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Expiry != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignedOrderMinNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedOrderMinNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedOrderMinNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinNonce != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.MinNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SubaccountId) > 0 {
+		i -= len(m.SubaccountId)
+		copy(dAtA[i:], m.SubaccountId)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.SubaccountId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExchange(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExchange(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpotMarketInstantListingFee.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.DerivativeMarketInstantListingFee.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.DefaultSpotMakerFeeRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.DefaultSpotTakerFeeRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.DefaultDerivativeMakerFeeRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.DefaultDerivativeTakerFeeRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.DefaultInitialMarginRatio.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.DefaultMaintenanceMarginRatio.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.DefaultFundingInterval != 0 {
+		n += 1 + sovExchange(uint64(m.DefaultFundingInterval))
+	}
+	if m.FundingMultiple != 0 {
+		n += 1 + sovExchange(uint64(m.FundingMultiple))
+	}
+	l = m.RelayerFeeShareRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.DefaultHourlyFundingRateCap.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.DefaultHourlyInterestRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.MaxDerivativeOrderSideCount != 0 {
+		n += 1 + sovExchange(uint64(m.MaxDerivativeOrderSideCount))
+	}
+	l = m.InjRewardStakedRequirementThreshold.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.TradingRewardsVestingDuration != 0 {
+		n += 2 + sovExchange(uint64(m.TradingRewardsVestingDuration))
+	}
+	l = m.LiquidatorRewardShareRate.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	l = m.BinaryOptionsMarketInstantListingFee.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	if m.AtomicMarketOrderAccessLevel != 0 {
+		n += 2 + sovExchange(uint64(m.AtomicMarketOrderAccessLevel))
+	}
+	l = m.SpotAtomicMarketOrderFeeMultiplier.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	l = m.DerivativeAtomicMarketOrderFeeMultiplier.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	l = m.BinaryOptionsAtomicMarketOrderFeeMultiplier.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	l = m.MinimalProtocolFeeRate.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	if m.IsInstantDerivativeMarketLaunchEnabled {
+		n += 3
+	}
+	if m.TerminalOrderRetentionBlocks != 0 {
+		n += 2 + sovExchange(uint64(m.TerminalOrderRetentionBlocks))
+	}
+	return n
+}
+
+func (m *MarketFeeMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = m.FeeMultiplier.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *DerivativeMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.OracleBase)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.OracleQuote)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.OracleType != 0 {
+		n += 1 + sovExchange(uint64(m.OracleType))
+	}
+	if m.OracleScaleFactor != 0 {
+		n += 1 + sovExchange(uint64(m.OracleScaleFactor))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = m.InitialMarginRatio.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.MaintenanceMarginRatio.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.MakerFeeRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.TakerFeeRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.RelayerFeeShareRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.IsPerpetual {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovExchange(uint64(m.Status))
+	}
+	l = m.MinPriceTickSize.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.MinQuantityTickSize.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *BinaryOptionsMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.OracleSymbol)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.OracleProvider)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.OracleType != 0 {
+		n += 1 + sovExchange(uint64(m.OracleType))
+	}
+	if m.OracleScaleFactor != 0 {
+		n += 1 + sovExchange(uint64(m.OracleScaleFactor))
+	}
+	if m.ExpirationTimestamp != 0 {
+		n += 1 + sovExchange(uint64(m.ExpirationTimestamp))
+	}
+	if m.SettlementTimestamp != 0 {
+		n += 1 + sovExchange(uint64(m.SettlementTimestamp))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = m.MakerFeeRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.TakerFeeRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.RelayerFeeShareRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovExchange(uint64(m.Status))
+	}
+	l = m.MinPriceTickSize.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.MinQuantityTickSize.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	if m.SettlementPrice != nil {
+		l = m.SettlementPrice.Size()
+		n += 2 + l + sovExchange(uint64(l))
+	}
+	if m.ScalarLowerBound != nil {
+		l = m.ScalarLowerBound.Size()
+		n += 2 + l + sovExchange(uint64(l))
+	}
+	if m.ScalarUpperBound != nil {
+		l = m.ScalarUpperBound.Size()
+		n += 2 + l + sovExchange(uint64(l))
+	}
+	return n
+}
+
+func (m *VanillaOptionsMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.OracleBase)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.OracleQuote)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.OracleType != 0 {
+		n += 1 + sovExchange(uint64(m.OracleType))
+	}
+	if m.OracleScaleFactor != 0 {
+		n += 1 + sovExchange(uint64(m.OracleScaleFactor))
+	}
+	if m.OptionType != 0 {
+		n += 1 + sovExchange(uint64(m.OptionType))
+	}
+	l = m.StrikePrice.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.ContractMultiplier.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.MaxUnderlyingPrice.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.ExpirationTimestamp != 0 {
+		n += 1 + sovExchange(uint64(m.ExpirationTimestamp))
+	}
+	if m.SettlementTimestamp != 0 {
+		n += 1 + sovExchange(uint64(m.SettlementTimestamp))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = m.MakerFeeRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.TakerFeeRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.RelayerFeeShareRate.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	if m.Status != 0 {
+		n += 2 + sovExchange(uint64(m.Status))
+	}
+	l = m.MinPriceTickSize.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	l = m.MinQuantityTickSize.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	if m.SettlementPrice != nil {
+		l = m.SettlementPrice.Size()
+		n += 2 + l + sovExchange(uint64(l))
+	}
+	return n
+}
+
+func (m *CategoricalMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.OracleSymbol)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.OracleProvider)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.OracleType != 0 {
+		n += 1 + sovExchange(uint64(m.OracleType))
+	}
+	if m.OracleScaleFactor != 0 {
+		n += 1 + sovExchange(uint64(m.OracleScaleFactor))
+	}
+	if m.ExpirationTimestamp != 0 {
+		n += 1 + sovExchange(uint64(m.ExpirationTimestamp))
+	}
+	if m.SettlementTimestamp != 0 {
+		n += 1 + sovExchange(uint64(m.SettlementTimestamp))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if len(m.Outcomes) > 0 {
+		for _, s := range m.Outcomes {
+			l = len(s)
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	if len(m.OutcomeMarketIds) > 0 {
+		for _, s := range m.OutcomeMarketIds {
+			l = len(s)
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovExchange(uint64(m.Status))
+	}
+	l = len(m.WinningOutcome)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	return n
+}
+
+func (m *ExpiryFuturesMarketInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.ExpirationTimestamp != 0 {
+		n += 1 + sovExchange(uint64(m.ExpirationTimestamp))
+	}
+	if m.TwapStartTimestamp != 0 {
+		n += 1 + sovExchange(uint64(m.TwapStartTimestamp))
+	}
+	l = m.ExpirationTwapStartPriceCumulative.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.SettlementPrice.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *ExpiryFuturesMarketSeries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SeriesId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.TickerPrefix)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.OracleBase)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.OracleQuote)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.OracleType != 0 {
+		n += 1 + sovExchange(uint64(m.OracleType))
+	}
+	if m.OracleScaleFactor != 0 {
+		n += 1 + sovExchange(uint64(m.OracleScaleFactor))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovExchange(uint64(m.Interval))
+	}
+	if m.LeadTime != 0 {
+		n += 1 + sovExchange(uint64(m.LeadTime))
+	}
+	if m.NextExpirationTimestamp != 0 {
+		n += 1 + sovExchange(uint64(m.NextExpirationTimestamp))
+	}
+	l = m.InitialMarginRatio.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.MaintenanceMarginRatio.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.MakerFeeRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.TakerFeeRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.MinPriceTickSize.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.MinQuantityTickSize.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	if len(m.MarketIds) > 0 {
+		for _, s := range m.MarketIds {
+			l = len(s)
+			n += 2 + l + sovExchange(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 2 + sovExchange(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *ExpiryFuturesAutoRoll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SeriesId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	return n
+}
+
+func (m *PerpetualMarketInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = m.HourlyFundingRateCap.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.HourlyInterestRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.NextFundingTimestamp != 0 {
+		n += 1 + sovExchange(uint64(m.NextFundingTimestamp))
+	}
+	if m.FundingInterval != 0 {
+		n += 1 + sovExchange(uint64(m.FundingInterval))
+	}
+	return n
+}
+
+func (m *PerpetualMarketFunding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CumulativeFunding.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.LastTimestamp != 0 {
+		n += 1 + sovExchange(uint64(m.LastTimestamp))
+	}
+	return n
+}
+
+func (m *PreLaunchPerpetualMarketInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovExchange(uint64(m.Window))
+	}
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.LastPrice.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.WindowCumulativePrice.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.WindowQuantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.WindowStartTimestamp != 0 {
+		n += 1 + sovExchange(uint64(m.WindowStartTimestamp))
+	}
+	l = m.MinWindowQuantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.MaxMarkPriceChangeRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.LastPriceTimestamp != 0 {
+		n += 1 + sovExchange(uint64(m.LastPriceTimestamp))
+	}
+	return n
+}
+
+func (m *DerivativeMarketSettlementInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = m.SettlementPrice.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *NextFundingTimestamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextTimestamp != 0 {
+		n += 1 + sovExchange(uint64(m.NextTimestamp))
+	}
+	return n
+}
+
+func (m *SpotMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = m.MakerFeeRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.TakerFeeRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.RelayerFeeShareRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovExchange(uint64(m.Status))
+	}
+	l = m.MinPriceTickSize.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.MinQuantityTickSize.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AvailableBalance.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.TotalBalance.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *SubaccountTradeNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovExchange(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *OrderInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.FeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *SpotOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = m.OrderInfo.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.OrderType != 0 {
+		n += 1 + sovExchange(uint64(m.OrderType))
+	}
+	if m.TriggerPrice != nil {
+		l = m.TriggerPrice.Size()
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.VisibleQuantity != nil {
+		l = m.VisibleQuantity.Size()
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	return n
+}
+
+func (m *SpotLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OrderInfo.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.OrderType != 0 {
+		n += 1 + sovExchange(uint64(m.OrderType))
+	}
+	l = m.Fillable.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.TriggerPrice != nil {
+		l = m.TriggerPrice.Size()
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.Iceberg != nil {
+		l = m.Iceberg.Size()
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	return n
+}
+
+func (m *SpotMarketOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OrderInfo.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.BalanceHold.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.OrderType != 0 {
+		n += 1 + sovExchange(uint64(m.OrderType))
+	}
+	if m.TriggerPrice != nil {
+		l = m.TriggerPrice.Size()
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.QuoteAmount != nil {
+		l = m.QuoteAmount.Size()
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	return n
+}
+
+func (m *DerivativeOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = m.OrderInfo.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.OrderType != 0 {
+		n += 1 + sovExchange(uint64(m.OrderType))
+	}
+	l = m.Margin.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.TriggerPrice != nil {
+		l = m.TriggerPrice.Size()
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.VisibleQuantity != nil {
+		l = m.VisibleQuantity.Size()
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	return n
+}
+
+func (m *SubaccountOrderbookMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VanillaLimitOrderCount != 0 {
+		n += 1 + sovExchange(uint64(m.VanillaLimitOrderCount))
+	}
+	if m.ReduceOnlyLimitOrderCount != 0 {
+		n += 1 + sovExchange(uint64(m.ReduceOnlyLimitOrderCount))
+	}
+	l = m.AggregateReduceOnlyQuantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.AggregateVanillaQuantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.VanillaConditionalOrderCount != 0 {
+		n += 1 + sovExchange(uint64(m.VanillaConditionalOrderCount))
+	}
+	if m.ReduceOnlyConditionalOrderCount != 0 {
+		n += 1 + sovExchange(uint64(m.ReduceOnlyConditionalOrderCount))
+	}
+	return n
+}
+
+func (m *SubaccountOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.IsReduceOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *SubaccountOrderData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Order != nil {
+		l = m.Order.Size()
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	return n
+}
+
+func (m *DerivativeLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OrderInfo.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.OrderType != 0 {
+		n += 1 + sovExchange(uint64(m.OrderType))
+	}
+	l = m.Margin.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.Fillable.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.TriggerPrice != nil {
+		l = m.TriggerPrice.Size()
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.Iceberg != nil {
+		l = m.Iceberg.Size()
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	return n
+}
+
+func (m *IcebergOrderInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VisibleQuantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.HiddenQuantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.HiddenMargin.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.HiddenBalanceHold.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *DerivativeMarketOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OrderInfo.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.OrderType != 0 {
+		n += 1 + sovExchange(uint64(m.OrderType))
+	}
+	l = m.Margin.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.MarginHold.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.TriggerPrice != nil {
+		l = m.TriggerPrice.Size()
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	return n
+}
+
+func (m *TWAPOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = m.OrderInfo.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.OrderType != 0 {
+		n += 1 + sovExchange(uint64(m.OrderType))
+	}
+	l = m.Margin.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.Slices != 0 {
+		n += 1 + sovExchange(uint64(m.Slices))
+	}
+	if m.IntervalBlocks != 0 {
+		n += 1 + sovExchange(uint64(m.IntervalBlocks))
+	}
+	if m.IntervalSeconds != 0 {
+		n += 1 + sovExchange(uint64(m.IntervalSeconds))
+	}
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.IsDerivative {
+		n += 2
+	}
+	if m.ExecutedSlices != 0 {
+		n += 1 + sovExchange(uint64(m.ExecutedSlices))
+	}
+	if m.NextSliceHeight != 0 {
+		n += 1 + sovExchange(uint64(m.NextSliceHeight))
+	}
+	if m.NextSliceTimestamp != 0 {
+		n += 1 + sovExchange(uint64(m.NextSliceTimestamp))
+	}
+	l = m.BalanceHold.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.FilledQuantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.FilledNotional.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.RemainingQuantity.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	l = m.RemainingMargin.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	l = m.MaxSlippage.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	l = m.PendingQuantity.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	l = m.PendingMargin.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	l = m.PendingBalanceHold.Size()
+	n += 2 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *Position) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsLong {
+		n += 2
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.EntryPrice.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.Margin.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.CumulativeFundingEntry.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *MarketOrderIndicator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.IsBuy {
+		n += 2
+	}
+	return n
+}
+
+func (m *TradeLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Quantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.FeeRecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	return n
+}
+
+func (m *PositionDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsLong {
+		n += 2
+	}
+	l = m.ExecutionQuantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.ExecutionMargin.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.ExecutionPrice.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *DerivativeTradeLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.PositionDelta != nil {
+		l = m.PositionDelta.Size()
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = m.Payout.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.FeeRecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = m.Pnl.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *SubaccountPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Position != nil {
+		l = m.Position.Size()
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	return n
+}
+
+func (m *SubaccountDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	return n
+}
+
+func (m *DepositUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PointsMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MakerPointsMultiplier.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.TakerPointsMultiplier.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *TradingRewardCampaignBoostInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BoostedSpotMarketIds) > 0 {
+		for _, s := range m.BoostedSpotMarketIds {
+			l = len(s)
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	if len(m.SpotMarketMultipliers) > 0 {
+		for _, e := range m.SpotMarketMultipliers {
+			l = e.Size()
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	if len(m.BoostedDerivativeMarketIds) > 0 {
+		for _, s := range m.BoostedDerivativeMarketIds {
+			l = len(s)
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	if len(m.DerivativeMarketMultipliers) > 0 {
+		for _, e := range m.DerivativeMarketMultipliers {
+			l = e.Size()
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CampaignRewardPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTimestamp != 0 {
+		n += 1 + sovExchange(uint64(m.StartTimestamp))
+	}
+	if len(m.MaxCampaignRewards) > 0 {
+		for _, e := range m.MaxCampaignRewards {
+			l = e.Size()
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TradingRewardCampaignInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignDurationSeconds != 0 {
+		n += 1 + sovExchange(uint64(m.CampaignDurationSeconds))
+	}
+	if len(m.QuoteDenoms) > 0 {
+		for _, s := range m.QuoteDenoms {
+			l = len(s)
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	if m.TradingRewardBoostInfo != nil {
+		l = m.TradingRewardBoostInfo.Size()
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if len(m.DisqualifiedMarketIds) > 0 {
+		for _, s := range m.DisqualifiedMarketIds {
+			l = len(s)
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeDiscountTierInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MakerDiscountRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.TakerDiscountRate.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.StakedAmount.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.Volume.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *FeeDiscountSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BucketCount != 0 {
+		n += 1 + sovExchange(uint64(m.BucketCount))
+	}
+	if m.BucketDuration != 0 {
+		n += 1 + sovExchange(uint64(m.BucketDuration))
+	}
+	if len(m.QuoteDenoms) > 0 {
+		for _, s := range m.QuoteDenoms {
+			l = len(s)
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	if len(m.TierInfos) > 0 {
+		for _, e := range m.TierInfos {
+			l = e.Size()
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	if len(m.DisqualifiedMarketIds) > 0 {
+		for _, s := range m.DisqualifiedMarketIds {
+			l = len(s)
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeDiscountTierTTL) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tier != 0 {
+		n += 1 + sovExchange(uint64(m.Tier))
+	}
+	if m.TtlTimestamp != 0 {
+		n += 1 + sovExchange(uint64(m.TtlTimestamp))
+	}
+	return n
+}
+
+func (m *VolumeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MakerVolume.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.TakerVolume.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *AccountRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TradeRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if len(m.LatestTradeRecords) > 0 {
+		for _, e := range m.LatestTradeRecords {
+			l = e.Size()
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubaccountIDs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SubaccountIds) > 0 {
+		for _, b := range m.SubaccountIds {
+			l = len(b)
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TradeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovExchange(uint64(m.Timestamp))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *MarketTradeRecordRetention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.RetentionSeconds != 0 {
+		n += 1 + sovExchange(uint64(m.RetentionSeconds))
+	}
+	return n
+}
+
+func (m *Level) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.P.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.Q.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *AggregateSubaccountVolumeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if len(m.MarketVolumes) > 0 {
+		for _, e := range m.MarketVolumes {
+			l = e.Size()
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AggregateAccountVolumeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if len(m.MarketVolumes) > 0 {
+		for _, e := range m.MarketVolumes {
+			l = e.Size()
+			n += 1 + l + sovExchange(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *DenomDecimals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovExchange(uint64(m.Decimals))
+	}
+	return n
+}
+
+func (m *TerminalOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.IsSpot {
+		n += 2
+	}
+	if m.OrderType != 0 {
+		n += 1 + sovExchange(uint64(m.OrderType))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovExchange(uint64(m.Status))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovExchange(uint64(m.Reason))
+	}
+	l = m.FilledQuantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.AveragePrice.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovExchange(uint64(m.BlockHeight))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovExchange(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *LimitOrderFill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.Notional.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	return n
+}
+
+func (m *SubaccountPnl) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.FeesPaid.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.FundingPaid.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.LastUpdatedHeight != 0 {
+		n += 1 + sovExchange(uint64(m.LastUpdatedHeight))
+	}
+	return n
+}
+
+func (m *RFQRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovExchange(uint64(m.RequestId))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.Taker)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.IsBuy {
+		n += 2
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.Expiry != 0 {
+		n += 1 + sovExchange(uint64(m.Expiry))
+	}
+	return n
+}
+
+func (m *RFQQuote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovExchange(uint64(m.RequestId))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.MakerSubaccountId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.Margin.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.Expiry != 0 {
+		n += 1 + sovExchange(uint64(m.Expiry))
+	}
+	return n
+}
+
+func (m *SignedOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.FeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.OrderType != 0 {
+		n += 1 + sovExchange(uint64(m.OrderType))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	l = m.Margin.Size()
+	n += 1 + l + sovExchange(uint64(l))
+	if m.Nonce != 0 {
+		n += 1 + sovExchange(uint64(m.Nonce))
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovExchange(uint64(m.Expiry))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	return n
+}
+
+func (m *SignedOrderNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovExchange(uint64(m.Nonce))
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovExchange(uint64(m.Expiry))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	return n
+}
+
+func (m *SignedOrderMinNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubaccountId)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.MinNonce != 0 {
+		n += 1 + sovExchange(uint64(m.MinNonce))
+	}
+	return n
+}
+
+func sovExchange(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExchange(x uint64) (n int) {
+	return sovExchange(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotMarketInstantListingFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotMarketInstantListingFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeMarketInstantListingFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DerivativeMarketInstantListingFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSpotMakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultSpotMakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSpotTakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultSpotTakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultDerivativeMakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultDerivativeMakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultDerivativeTakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultDerivativeTakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultInitialMarginRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultInitialMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMaintenanceMarginRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultMaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFundingInterval", wireType)
+			}
+			m.DefaultFundingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultFundingInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingMultiple", wireType)
+			}
+			m.FundingMultiple = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundingMultiple |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFeeShareRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RelayerFeeShareRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultHourlyFundingRateCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultHourlyFundingRateCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultHourlyInterestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultHourlyInterestRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDerivativeOrderSideCount", wireType)
+			}
+			m.MaxDerivativeOrderSideCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDerivativeOrderSideCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InjRewardStakedRequirementThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InjRewardStakedRequirementThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingRewardsVestingDuration", wireType)
+			}
+			m.TradingRewardsVestingDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradingRewardsVestingDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorRewardShareRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidatorRewardShareRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryOptionsMarketInstantListingFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BinaryOptionsMarketInstantListingFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtomicMarketOrderAccessLevel", wireType)
+			}
+			m.AtomicMarketOrderAccessLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtomicMarketOrderAccessLevel |= AtomicMarketOrderAccessLevel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotAtomicMarketOrderFeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotAtomicMarketOrderFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeAtomicMarketOrderFeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DerivativeAtomicMarketOrderFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryOptionsAtomicMarketOrderFeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BinaryOptionsAtomicMarketOrderFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimalProtocolFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimalProtocolFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInstantDerivativeMarketLaunchEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsInstantDerivativeMarketLaunchEnabled = bool(v != 0)
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminalOrderRetentionBlocks", wireType)
+			}
+			m.TerminalOrderRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TerminalOrderRetentionBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketFeeMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketFeeMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketFeeMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DerivativeMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DerivativeMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleBase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleBase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleQuote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleQuote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+			}
+			m.OracleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleType |= types1.OracleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScaleFactor", wireType)
+			}
+			m.OracleScaleFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScaleFactor |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFeeShareRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RelayerFeeShareRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPerpetual", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPerpetual = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceTickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPriceTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuantityTickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinQuantityTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BinaryOptionsMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BinaryOptionsMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleSymbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleSymbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+			}
+			m.OracleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleType |= types1.OracleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScaleFactor", wireType)
+			}
+			m.OracleScaleFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScaleFactor |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+			}
+			m.ExpirationTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementTimestamp", wireType)
+			}
+			m.SettlementTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFeeShareRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RelayerFeeShareRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceTickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPriceTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuantityTickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinQuantityTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.SettlementPrice = &v
+			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalarLowerBound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.ScalarLowerBound = &v
+			if err := m.ScalarLowerBound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalarUpperBound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.ScalarUpperBound = &v
+			if err := m.ScalarUpperBound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VanillaOptionsMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VanillaOptionsMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VanillaOptionsMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleBase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleBase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleQuote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleQuote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+			}
+			m.OracleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleType |= types1.OracleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScaleFactor", wireType)
+			}
+			m.OracleScaleFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScaleFactor |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionType", wireType)
+			}
+			m.OptionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OptionType |= OptionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrikePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StrikePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ContractMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnderlyingPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxUnderlyingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+			}
+			m.ExpirationTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementTimestamp", wireType)
+			}
+			m.SettlementTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFeeShareRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RelayerFeeShareRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceTickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPriceTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuantityTickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinQuantityTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.SettlementPrice = &v
+			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CategoricalMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CategoricalMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CategoricalMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleSymbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleSymbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+			}
+			m.OracleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleType |= types1.OracleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScaleFactor", wireType)
+			}
+			m.OracleScaleFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScaleFactor |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+			}
+			m.ExpirationTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementTimestamp", wireType)
+			}
+			m.SettlementTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcomes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outcomes = append(m.Outcomes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutcomeMarketIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutcomeMarketIds = append(m.OutcomeMarketIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningOutcome", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WinningOutcome = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpiryFuturesMarketInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpiryFuturesMarketInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpiryFuturesMarketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+			}
+			m.ExpirationTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapStartTimestamp", wireType)
+			}
+			m.TwapStartTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwapStartTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTwapStartPriceCumulative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpirationTwapStartPriceCumulative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpiryFuturesMarketSeries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpiryFuturesMarketSeries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpiryFuturesMarketSeries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeriesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickerPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TickerPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleBase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleBase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleQuote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleQuote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+			}
+			m.OracleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleType |= types1.OracleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScaleFactor", wireType)
+			}
+			m.OracleScaleFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScaleFactor |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeadTime", wireType)
+			}
+			m.LeadTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeadTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExpirationTimestamp", wireType)
+			}
+			m.NextExpirationTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextExpirationTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceTickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPriceTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuantityTickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinQuantityTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketIds = append(m.MarketIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpiryFuturesAutoRoll) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpiryFuturesAutoRoll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpiryFuturesAutoRoll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeriesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PerpetualMarketInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PerpetualMarketInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PerpetualMarketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HourlyFundingRateCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HourlyFundingRateCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HourlyInterestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HourlyInterestRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextFundingTimestamp", wireType)
+			}
+			m.NextFundingTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextFundingTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingInterval", wireType)
+			}
+			m.FundingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundingInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PerpetualMarketFunding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PerpetualMarketFunding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PerpetualMarketFunding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeFunding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeFunding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTimestamp", wireType)
+			}
+			m.LastTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PreLaunchPerpetualMarketInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PreLaunchPerpetualMarketInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PreLaunchPerpetualMarketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowCumulativePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WindowCumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WindowQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartTimestamp", wireType)
+			}
+			m.WindowStartTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWindowQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinWindowQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMarkPriceChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMarkPriceChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPriceTimestamp", wireType)
+			}
+			m.LastPriceTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPriceTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *MarketVolume) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	l = m.Volume.Size()
-	n += 1 + l + sovExchange(uint64(l))
-	return n
-}
 
-func (m *DenomDecimals) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovExchange(uint64(l))
-	}
-	if m.Decimals != 0 {
-		n += 1 + sovExchange(uint64(m.Decimals))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovExchange(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozExchange(x uint64) (n int) {
-	return sovExchange(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DerivativeMarketSettlementInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7345,17 +15629,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: DerivativeMarketSettlementInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DerivativeMarketSettlementInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotMarketInstantListingFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -7365,30 +15649,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpotMarketInstantListingFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeMarketInstantListingFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -7398,28 +15681,148 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NextFundingTimestamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NextFundingTimestamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NextFundingTimestamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTimestamp", wireType)
+			}
+			m.NextTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpotMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DerivativeMarketInstantListingFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpotMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpotMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSpotMakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7447,13 +15850,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultSpotMakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSpotTakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7481,13 +15882,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultSpotTakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultDerivativeMakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7515,13 +15914,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultDerivativeMakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultDerivativeTakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7549,13 +15946,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultDerivativeTakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultInitialMarginRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7583,13 +15980,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultInitialMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMaintenanceMarginRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFeeShareRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7617,51 +16014,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultMaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RelayerFeeShareRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFundingInterval", wireType)
-			}
-			m.DefaultFundingInterval = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DefaultFundingInterval |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingMultiple", wireType)
-			}
-			m.FundingMultiple = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FundingMultiple |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFeeShareRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7689,15 +16048,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RelayerFeeShareRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultHourlyFundingRateCap", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -7707,29 +16064,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= MarketStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DefaultHourlyFundingRateCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultHourlyInterestRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceTickSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7757,32 +16099,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultHourlyInterestRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinPriceTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDerivativeOrderSideCount", wireType)
-			}
-			m.MaxDerivativeOrderSideCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxDerivativeOrderSideCount |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 15:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InjRewardStakedRequirementThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuantityTickSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7810,32 +16133,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InjRewardStakedRequirementThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinQuantityTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradingRewardsVestingDuration", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.TradingRewardsVestingDuration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TradingRewardsVestingDuration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
 			}
-		case 17:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorRewardShareRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7863,15 +16217,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidatorRewardShareRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AvailableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryOptionsMarketInstantListingFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBalance", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -7881,30 +16235,81 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BinaryOptionsMarketInstantListingFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubaccountTradeNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubaccountTradeNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubaccountTradeNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AtomicMarketOrderAccessLevel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.AtomicMarketOrderAccessLevel = 0
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -7914,14 +16319,64 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AtomicMarketOrderAccessLevel |= AtomicMarketOrderAccessLevel(b&0x7F) << shift
+				m.Nonce |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotAtomicMarketOrderFeeMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7949,13 +16404,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpotAtomicMarketOrderFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 21:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeAtomicMarketOrderFeeMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7983,13 +16436,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DerivativeAtomicMarketOrderFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FeeRecipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 22:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryOptionsAtomicMarketOrderFeeMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8017,13 +16468,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BinaryOptionsAtomicMarketOrderFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimalProtocolFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8051,30 +16502,10 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinimalProtocolFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsInstantDerivativeMarketLaunchEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsInstantDerivativeMarketLaunchEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExchange(dAtA[iNdEx:])
@@ -8096,7 +16527,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketFeeMultiplier) Unmarshal(dAtA []byte) error {
+func (m *SpotOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8115,19 +16546,103 @@ func (m *MarketFeeMultiplier) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MarketFeeMultiplier: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketFeeMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpotOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpotOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8155,11 +16670,15 @@ func (m *MarketFeeMultiplier) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TriggerPrice = &v
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibleQuantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8187,7 +16706,9 @@ func (m *MarketFeeMultiplier) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.VisibleQuantity = &v
+			if err := m.VisibleQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8212,7 +16733,7 @@ func (m *MarketFeeMultiplier) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
+func (m *SpotLimitOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8235,17 +16756,17 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DerivativeMarket: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpotLimitOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DerivativeMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpotLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -8255,27 +16776,47 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ticker = string(dAtA[iNdEx:postIndex])
+			if err := m.OrderInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleBase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fillable", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8303,11 +16844,13 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleBase = string(dAtA[iNdEx:postIndex])
+			if err := m.Fillable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleQuote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8335,13 +16878,17 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleQuote = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TriggerPrice = &v
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
 			}
-			m.OracleType = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -8351,16 +16898,31 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleType |= types1.OracleType(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScaleFactor", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthExchange
 			}
-			m.OracleScaleFactor = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderHash = append(m.OrderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.OrderHash == nil {
+				m.OrderHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iceberg", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -8370,16 +16932,83 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleScaleFactor |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Iceberg == nil {
+				m.Iceberg = &IcebergOrderInfo{}
+			}
+			if err := m.Iceberg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpotMarketOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpotMarketOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpotMarketOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -8389,27 +17018,28 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.OrderInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceHold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8437,13 +17067,15 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			if err := m.BalanceHold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -8453,29 +17085,48 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.OrderHash = append(m.OrderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.OrderHash == nil {
+				m.OrderHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8503,13 +17154,15 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TriggerPrice = &v
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8537,13 +17190,65 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.QuoteAmount = &v
+			if err := m.QuoteAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DerivativeOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DerivativeOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DerivativeOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8571,15 +17276,13 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFeeShareRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -8589,31 +17292,30 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RelayerFeeShareRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OrderInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsPerpetual", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
 			}
-			var v int
+			m.OrderType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -8623,17 +17325,16 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.OrderType |= OrderType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsPerpetual = bool(v != 0)
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -8643,14 +17344,29 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= MarketStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceTickSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8678,13 +17394,15 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinPriceTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TriggerPrice = &v
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinQuantityTickSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibleQuantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8712,7 +17430,9 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinQuantityTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.VisibleQuantity = &v
+			if err := m.VisibleQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8737,7 +17457,7 @@ func (m *DerivativeMarket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
+func (m *SubaccountOrderbookMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8760,17 +17480,17 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BinaryOptionsMarket: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubaccountOrderbookMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BinaryOptionsMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubaccountOrderbookMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VanillaLimitOrderCount", wireType)
 			}
-			var stringLen uint64
+			m.VanillaLimitOrderCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -8780,27 +17500,33 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.VanillaLimitOrderCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReduceOnlyLimitOrderCount", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ReduceOnlyLimitOrderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReduceOnlyLimitOrderCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Ticker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleSymbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateReduceOnlyQuantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8828,11 +17554,13 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleSymbol = string(dAtA[iNdEx:postIndex])
+			if err := m.AggregateReduceOnlyQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVanillaQuantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8860,13 +17588,15 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleProvider = string(dAtA[iNdEx:postIndex])
+			if err := m.AggregateVanillaQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VanillaConditionalOrderCount", wireType)
 			}
-			m.OracleType = 0
+			m.VanillaConditionalOrderCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -8876,16 +17606,16 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleType |= types1.OracleType(b&0x7F) << shift
+				m.VanillaConditionalOrderCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScaleFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReduceOnlyConditionalOrderCount", wireType)
 			}
-			m.OracleScaleFactor = 0
+			m.ReduceOnlyConditionalOrderCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -8895,16 +17625,66 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleScaleFactor |= uint32(b&0x7F) << shift
+				m.ReduceOnlyConditionalOrderCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubaccountOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubaccountOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubaccountOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			m.ExpirationTimestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -8914,33 +17694,29 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpirationTimestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementTimestamp", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
 			}
-			m.SettlementTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SettlementTimestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
 			}
-		case 8:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8968,13 +17744,15 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReduceOnly", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -8984,29 +17762,67 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExchange
+			m.IsReduceOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthExchange
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubaccountOrderData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubaccountOrderData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubaccountOrderData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9016,29 +17832,33 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			if m.Order == nil {
+				m.Order = &SubaccountOrder{}
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9048,31 +17868,81 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.OrderHash = append(m.OrderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.OrderHash == nil {
+				m.OrderHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DerivativeLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DerivativeLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DerivativeLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9082,29 +17952,47 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OrderInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFeeShareRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9132,15 +18020,15 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RelayerFeeShareRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fillable", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9150,14 +18038,29 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= MarketStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fillable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceTickSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9185,15 +18088,17 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinPriceTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TriggerPrice = &v
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinQuantityTickSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9203,31 +18108,31 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinQuantityTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.OrderHash = append(m.OrderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.OrderHash == nil {
+				m.OrderHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 17:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Iceberg", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9237,25 +18142,25 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.SettlementPrice = &v
-			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Iceberg == nil {
+				m.Iceberg = &IcebergOrderInfo{}
+			}
+			if err := m.Iceberg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9280,7 +18185,7 @@ func (m *BinaryOptionsMarket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExpiryFuturesMarketInfo) Unmarshal(dAtA []byte) error {
+func (m *IcebergOrderInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9303,15 +18208,15 @@ func (m *ExpiryFuturesMarketInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExpiryFuturesMarketInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: IcebergOrderInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExpiryFuturesMarketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IcebergOrderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibleQuantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9339,13 +18244,15 @@ func (m *ExpiryFuturesMarketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			if err := m.VisibleQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HiddenQuantity", wireType)
 			}
-			m.ExpirationTimestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9355,33 +18262,29 @@ func (m *ExpiryFuturesMarketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpirationTimestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TwapStartTimestamp", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
 			}
-			m.TwapStartTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TwapStartTimestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HiddenQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTwapStartPriceCumulative", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HiddenMargin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9409,13 +18312,13 @@ func (m *ExpiryFuturesMarketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExpirationTwapStartPriceCumulative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HiddenMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HiddenBalanceHold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9443,7 +18346,7 @@ func (m *ExpiryFuturesMarketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HiddenBalanceHold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9468,7 +18371,7 @@ func (m *ExpiryFuturesMarketInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PerpetualMarketInfo) Unmarshal(dAtA []byte) error {
+func (m *DerivativeMarketOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9491,17 +18394,17 @@ func (m *PerpetualMarketInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PerpetualMarketInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DerivativeMarketOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PerpetualMarketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DerivativeMarketOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9511,29 +18414,30 @@ func (m *PerpetualMarketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			if err := m.OrderInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HourlyFundingRateCap", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
 			}
-			var stringLen uint64
+			m.OrderType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9543,29 +18447,14 @@ func (m *PerpetualMarketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OrderType |= OrderType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.HourlyFundingRateCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HourlyInterestRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9593,101 +18482,13 @@ func (m *PerpetualMarketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HourlyInterestRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextFundingTimestamp", wireType)
-			}
-			m.NextFundingTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextFundingTimestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingInterval", wireType)
-			}
-			m.FundingInterval = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FundingInterval |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExchange(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PerpetualMarketFunding) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExchange
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PerpetualMarketFunding: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PerpetualMarketFunding: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeFunding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginHold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9715,13 +18516,13 @@ func (m *PerpetualMarketFunding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CumulativeFunding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MarginHold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9749,15 +18550,17 @@ func (m *PerpetualMarketFunding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TriggerPrice = &v
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTimestamp", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
 			}
-			m.LastTimestamp = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9767,11 +18570,26 @@ func (m *PerpetualMarketFunding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastTimestamp |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderHash = append(m.OrderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.OrderHash == nil {
+				m.OrderHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExchange(dAtA[iNdEx:])
@@ -9793,7 +18611,7 @@ func (m *PerpetualMarketFunding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DerivativeMarketSettlementInfo) Unmarshal(dAtA []byte) error {
+func (m *TWAPOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9816,10 +18634,10 @@ func (m *DerivativeMarketSettlementInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DerivativeMarketSettlementInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TWAPOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DerivativeMarketSettlementInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TWAPOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9856,7 +18674,59 @@ func (m *DerivativeMarketSettlementInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9884,65 +18754,72 @@ func (m *DerivativeMarketSettlementInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExchange(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slices", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthExchange
+			m.Slices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slices |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NextFundingTimestamp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExchange
+			m.IntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.IntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NextFundingTimestamp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NextFundingTimestamp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextTimestamp", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
 			}
-			m.NextTimestamp = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -9952,64 +18829,106 @@ func (m *NextFundingTimestamp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextTimestamp |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExchange(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if byteLen < 0 {
+				return ErrInvalidLengthExchange
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SpotMarket) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExchange
+			m.OrderHash = append(m.OrderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.OrderHash == nil {
+				m.OrderHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDerivative", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDerivative = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedSlices", wireType)
+			}
+			m.ExecutedSlices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutedSlices |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSliceHeight", wireType)
+			}
+			m.NextSliceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSliceHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSliceTimestamp", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.NextSliceTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSliceTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SpotMarket: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpotMarket: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceHold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10037,11 +18956,13 @@ func (m *SpotMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ticker = string(dAtA[iNdEx:postIndex])
+			if err := m.BalanceHold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledQuantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10069,11 +18990,13 @@ func (m *SpotMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.FilledQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledNotional", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10101,11 +19024,13 @@ func (m *SpotMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.FilledNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingQuantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10133,13 +19058,13 @@ func (m *SpotMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemainingQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingMargin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10167,13 +19092,13 @@ func (m *SpotMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemainingMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFeeShareRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10201,13 +19126,13 @@ func (m *SpotMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RelayerFeeShareRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingQuantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10235,30 +19160,13 @@ func (m *SpotMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= MarketStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.PendingQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 9:
+			iNdEx = postIndex
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceTickSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMargin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10286,13 +19194,13 @@ func (m *SpotMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinPriceTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PendingMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinQuantityTickSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBalanceHold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10320,7 +19228,7 @@ func (m *SpotMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinQuantityTickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PendingBalanceHold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10345,7 +19253,7 @@ func (m *SpotMarket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Deposit) Unmarshal(dAtA []byte) error {
+func (m *Position) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10368,15 +19276,35 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLong", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLong = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10404,13 +19332,13 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AvailableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10438,7 +19366,75 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EntryPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeFundingEntry", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeFundingEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10463,7 +19459,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubaccountTradeNonce) Unmarshal(dAtA []byte) error {
+func (m *MarketOrderIndicator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10486,17 +19482,49 @@ func (m *SubaccountTradeNonce) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubaccountTradeNonce: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketOrderIndicator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubaccountTradeNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketOrderIndicator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
 			}
-			m.Nonce = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -10506,11 +19534,12 @@ func (m *SubaccountTradeNonce) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsBuy = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExchange(dAtA[iNdEx:])
@@ -10532,7 +19561,7 @@ func (m *SubaccountTradeNonce) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OrderInfo) Unmarshal(dAtA []byte) error {
+func (m *TradeLog) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10555,15 +19584,15 @@ func (m *OrderInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OrderInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TradeLog: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TradeLog: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10591,11 +19620,13 @@ func (m *OrderInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubaccountId = string(dAtA[iNdEx:postIndex])
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10623,13 +19654,15 @@ func (m *OrderInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeRecipient = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -10639,29 +19672,29 @@ func (m *OrderInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SubaccountId = append(m.SubaccountId[:0], dAtA[iNdEx:postIndex]...)
+			if m.SubaccountId == nil {
+				m.SubaccountId = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10689,10 +19722,78 @@ func (m *OrderInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderHash = append(m.OrderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.OrderHash == nil {
+				m.OrderHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipientAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipientAddress = append(m.FeeRecipientAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeeRecipientAddress == nil {
+				m.FeeRecipientAddress = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExchange(dAtA[iNdEx:])
@@ -10714,7 +19815,7 @@ func (m *OrderInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpotOrder) Unmarshal(dAtA []byte) error {
+func (m *PositionDelta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10737,17 +19838,17 @@ func (m *SpotOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpotOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionDelta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpotOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionDelta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLong", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -10757,29 +19858,17 @@ func (m *SpotOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IsLong = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionQuantity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -10789,30 +19878,31 @@ func (m *SpotOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OrderInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExecutionQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionMargin", wireType)
 			}
-			m.OrderType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -10822,14 +19912,29 @@ func (m *SpotOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderType |= OrderType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExecutionMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10857,9 +19962,7 @@ func (m *SpotOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.TriggerPrice = &v
-			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExecutionPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10884,7 +19987,7 @@ func (m *SpotOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpotLimitOrder) Unmarshal(dAtA []byte) error {
+func (m *DerivativeTradeLog) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10907,15 +20010,49 @@ func (m *SpotLimitOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpotLimitOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: DerivativeTradeLog: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpotLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DerivativeTradeLog: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubaccountId = append(m.SubaccountId[:0], dAtA[iNdEx:postIndex]...)
+			if m.SubaccountId == nil {
+				m.SubaccountId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionDelta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10942,15 +20079,52 @@ func (m *SpotLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OrderInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PositionDelta == nil {
+				m.PositionDelta = &PositionDelta{}
+			}
+			if err := m.PositionDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			m.OrderType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -10960,16 +20134,31 @@ func (m *SpotLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderType |= OrderType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fillable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -10979,31 +20168,31 @@ func (m *SpotLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fillable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.OrderHash = append(m.OrderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.OrderHash == nil {
+				m.OrderHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipientAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -11013,33 +20202,31 @@ func (m *SpotLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.TriggerPrice = &v
-			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FeeRecipientAddress = append(m.FeeRecipientAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeeRecipientAddress == nil {
+				m.FeeRecipientAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pnl", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -11049,24 +20236,24 @@ func (m *SpotLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderHash = append(m.OrderHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.OrderHash == nil {
-				m.OrderHash = []byte{}
+			if err := m.Pnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -11090,7 +20277,7 @@ func (m *SpotLimitOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpotMarketOrder) Unmarshal(dAtA []byte) error {
+func (m *SubaccountPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11113,15 +20300,15 @@ func (m *SpotMarketOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpotMarketOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubaccountPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpotMarketOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubaccountPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11148,47 +20335,16 @@ func (m *SpotMarketOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OrderInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BalanceHold", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Position == nil {
+				m.Position = &Position{}
 			}
-			if err := m.BalanceHold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -11215,64 +20371,9 @@ func (m *SpotMarketOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderHash = append(m.OrderHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.OrderHash == nil {
-				m.OrderHash = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
-			}
-			m.OrderType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrderType |= OrderType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.TriggerPrice = &v
-			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SubaccountId = append(m.SubaccountId[:0], dAtA[iNdEx:postIndex]...)
+			if m.SubaccountId == nil {
+				m.SubaccountId = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -11296,7 +20397,7 @@ func (m *SpotMarketOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DerivativeOrder) Unmarshal(dAtA []byte) error {
+func (m *SubaccountDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11319,17 +20420,17 @@ func (m *DerivativeOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DerivativeOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubaccountDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DerivativeOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubaccountDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -11339,27 +20440,29 @@ func (m *DerivativeOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			m.SubaccountId = append(m.SubaccountId[:0], dAtA[iNdEx:postIndex]...)
+			if m.SubaccountId == nil {
+				m.SubaccountId = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11386,32 +20489,66 @@ func (m *DerivativeOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OrderInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Deposit == nil {
+				m.Deposit = &Deposit{}
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.OrderType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrderType |= OrderType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
 			}
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11439,15 +20576,13 @@ func (m *DerivativeOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -11457,25 +20592,23 @@ func (m *DerivativeOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.TriggerPrice = &v
-			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Deposits = append(m.Deposits, &SubaccountDeposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11500,7 +20633,7 @@ func (m *DerivativeOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubaccountOrderbookMetadata) Unmarshal(dAtA []byte) error {
+func (m *PointsMultiplier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11523,53 +20656,15 @@ func (m *SubaccountOrderbookMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubaccountOrderbookMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: PointsMultiplier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubaccountOrderbookMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PointsMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VanillaLimitOrderCount", wireType)
-			}
-			m.VanillaLimitOrderCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VanillaLimitOrderCount |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReduceOnlyLimitOrderCount", wireType)
-			}
-			m.ReduceOnlyLimitOrderCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReduceOnlyLimitOrderCount |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateReduceOnlyQuantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerPointsMultiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11597,13 +20692,13 @@ func (m *SubaccountOrderbookMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AggregateReduceOnlyQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MakerPointsMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVanillaQuantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerPointsMultiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11631,48 +20726,10 @@ func (m *SubaccountOrderbookMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AggregateVanillaQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TakerPointsMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VanillaConditionalOrderCount", wireType)
-			}
-			m.VanillaConditionalOrderCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VanillaConditionalOrderCount |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReduceOnlyConditionalOrderCount", wireType)
-			}
-			m.ReduceOnlyConditionalOrderCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReduceOnlyConditionalOrderCount |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExchange(dAtA[iNdEx:])
@@ -11694,7 +20751,7 @@ func (m *SubaccountOrderbookMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubaccountOrder) Unmarshal(dAtA []byte) error {
+func (m *TradingRewardCampaignBoostInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11717,15 +20774,15 @@ func (m *SubaccountOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubaccountOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: TradingRewardCampaignBoostInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubaccountOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TradingRewardCampaignBoostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BoostedSpotMarketIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11753,13 +20810,45 @@ func (m *SubaccountOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BoostedSpotMarketIds = append(m.BoostedSpotMarketIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotMarketMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpotMarketMultipliers = append(m.SpotMarketMultipliers, PointsMultiplier{})
+			if err := m.SpotMarketMultipliers[len(m.SpotMarketMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BoostedDerivativeMarketIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11787,15 +20876,13 @@ func (m *SubaccountOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BoostedDerivativeMarketIds = append(m.BoostedDerivativeMarketIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsReduceOnly", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeMarketMultipliers", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -11805,12 +20892,26 @@ func (m *SubaccountOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsReduceOnly = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivativeMarketMultipliers = append(m.DerivativeMarketMultipliers, PointsMultiplier{})
+			if err := m.DerivativeMarketMultipliers[len(m.DerivativeMarketMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExchange(dAtA[iNdEx:])
@@ -11832,7 +20933,7 @@ func (m *SubaccountOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubaccountOrderData) Unmarshal(dAtA []byte) error {
+func (m *CampaignRewardPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11855,17 +20956,17 @@ func (m *SubaccountOrderData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubaccountOrderData: wiretype end group for non-group")
+			return fmt.Errorf("proto: CampaignRewardPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubaccountOrderData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CampaignRewardPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
 			}
-			var msglen int
+			m.StartTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -11875,33 +20976,16 @@ func (m *SubaccountOrderData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StartTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Order == nil {
-				m.Order = &SubaccountOrder{}
-			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCampaignRewards", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -11911,24 +20995,24 @@ func (m *SubaccountOrderData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderHash = append(m.OrderHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.OrderHash == nil {
-				m.OrderHash = []byte{}
+			m.MaxCampaignRewards = append(m.MaxCampaignRewards, types.Coin{})
+			if err := m.MaxCampaignRewards[len(m.MaxCampaignRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -11952,7 +21036,7 @@ func (m *SubaccountOrderData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DerivativeLimitOrder) Unmarshal(dAtA []byte) error {
+func (m *TradingRewardCampaignInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11975,50 +21059,17 @@ func (m *DerivativeLimitOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DerivativeLimitOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: TradingRewardCampaignInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DerivativeLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TradingRewardCampaignInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.OrderInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignDurationSeconds", wireType)
 			}
-			m.OrderType = 0
+			m.CampaignDurationSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -12028,14 +21079,14 @@ func (m *DerivativeLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderType |= OrderType(b&0x7F) << shift
+				m.CampaignDurationSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12063,15 +21114,13 @@ func (m *DerivativeLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.QuoteDenoms = append(m.QuoteDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fillable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingRewardBoostInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -12081,29 +21130,31 @@ func (m *DerivativeLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fillable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TradingRewardBoostInfo == nil {
+				m.TradingRewardBoostInfo = &TradingRewardCampaignBoostInfo{}
+			}
+			if err := m.TradingRewardBoostInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisqualifiedMarketIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12131,45 +21182,7 @@ func (m *DerivativeLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.TriggerPrice = &v
-			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OrderHash = append(m.OrderHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.OrderHash == nil {
-				m.OrderHash = []byte{}
-			}
+			m.DisqualifiedMarketIds = append(m.DisqualifiedMarketIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12192,7 +21205,7 @@ func (m *DerivativeLimitOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DerivativeMarketOrder) Unmarshal(dAtA []byte) error {
+func (m *FeeDiscountTierInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12215,17 +21228,17 @@ func (m *DerivativeMarketOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DerivativeMarketOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeDiscountTierInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DerivativeMarketOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeDiscountTierInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerDiscountRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -12235,47 +21248,29 @@ func (m *DerivativeMarketOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OrderInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MakerDiscountRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
-			}
-			m.OrderType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrderType |= OrderType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerDiscountRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12303,13 +21298,13 @@ func (m *DerivativeMarketOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TakerDiscountRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarginHold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12337,13 +21332,13 @@ func (m *DerivativeMarketOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarginHold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12371,46 +21366,10 @@ func (m *DerivativeMarketOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.TriggerPrice = &v
-			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OrderHash = append(m.OrderHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.OrderHash == nil {
-				m.OrderHash = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExchange(dAtA[iNdEx:])
@@ -12432,7 +21391,7 @@ func (m *DerivativeMarketOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Position) Unmarshal(dAtA []byte) error {
+func (m *FeeDiscountSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12455,17 +21414,17 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeDiscountSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeDiscountSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLong", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketCount", wireType)
 			}
-			var v int
+			m.BucketCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -12475,49 +21434,33 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.BucketCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsLong = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketDuration", wireType)
 			}
-			var stringLen uint64
+			m.BucketDuration = 0
 			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EntryPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12545,15 +21488,13 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EntryPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.QuoteDenoms = append(m.QuoteDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TierInfos", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -12563,29 +21504,29 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TierInfos = append(m.TierInfos, &FeeDiscountTierInfo{})
+			if err := m.TierInfos[len(m.TierInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeFundingEntry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisqualifiedMarketIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12613,9 +21554,7 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CumulativeFundingEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DisqualifiedMarketIds = append(m.DisqualifiedMarketIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12638,7 +21577,7 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketOrderIndicator) Unmarshal(dAtA []byte) error {
+func (m *FeeDiscountTierTTL) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12661,17 +21600,17 @@ func (m *MarketOrderIndicator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketOrderIndicator: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeDiscountTierTTL: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketOrderIndicator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeDiscountTierTTL: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
 			}
-			var stringLen uint64
+			m.Tier = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -12681,29 +21620,16 @@ func (m *MarketOrderIndicator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Tier |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TtlTimestamp", wireType)
 			}
-			var v int
+			m.TtlTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -12713,12 +21639,11 @@ func (m *MarketOrderIndicator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TtlTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsBuy = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExchange(dAtA[iNdEx:])
@@ -12740,7 +21665,7 @@ func (m *MarketOrderIndicator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TradeLog) Unmarshal(dAtA []byte) error {
+func (m *VolumeRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12763,15 +21688,15 @@ func (m *TradeLog) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TradeLog: wiretype end group for non-group")
+			return fmt.Errorf("proto: VolumeRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradeLog: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VolumeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerVolume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12799,13 +21724,13 @@ func (m *TradeLog) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MakerVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerVolume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12833,15 +21758,65 @@ func (m *TradeLog) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TakerVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -12851,29 +21826,111 @@ func (m *TradeLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubaccountId = append(m.SubaccountId[:0], dAtA[iNdEx:postIndex]...)
-			if m.SubaccountId == nil {
-				m.SubaccountId = []byte{}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradeRecords) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradeRecords: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradeRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12901,15 +21958,13 @@ func (m *TradeLog) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestTradeRecords", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -12919,29 +21974,79 @@ func (m *TradeLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderHash = append(m.OrderHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.OrderHash == nil {
-				m.OrderHash = []byte{}
+			m.LatestTradeRecords = append(m.LatestTradeRecords, &TradeRecord{})
+			if err := m.LatestTradeRecords[len(m.LatestTradeRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubaccountIDs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubaccountIDs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubaccountIDs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipientAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountIds", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -12968,10 +22073,8 @@ func (m *TradeLog) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeRecipientAddress = append(m.FeeRecipientAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.FeeRecipientAddress == nil {
-				m.FeeRecipientAddress = []byte{}
-			}
+			m.SubaccountIds = append(m.SubaccountIds, make([]byte, postIndex-iNdEx))
+			copy(m.SubaccountIds[len(m.SubaccountIds)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12994,7 +22097,7 @@ func (m *TradeLog) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionDelta) Unmarshal(dAtA []byte) error {
+func (m *TradeRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13017,17 +22120,17 @@ func (m *PositionDelta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionDelta: wiretype end group for non-group")
+			return fmt.Errorf("proto: TradeRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TradeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLong", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var v int
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -13037,15 +22140,14 @@ func (m *PositionDelta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsLong = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionQuantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13073,47 +22175,13 @@ func (m *PositionDelta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExecutionQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionMargin", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ExecutionMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13141,7 +22209,7 @@ func (m *PositionDelta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExecutionPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13166,7 +22234,7 @@ func (m *PositionDelta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DerivativeTradeLog) Unmarshal(dAtA []byte) error {
+func (m *MarketTradeRecordRetention) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13189,85 +22257,15 @@ func (m *DerivativeTradeLog) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DerivativeTradeLog: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketTradeRecordRetention: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DerivativeTradeLog: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketTradeRecordRetention: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SubaccountId = append(m.SubaccountId[:0], dAtA[iNdEx:postIndex]...)
-			if m.SubaccountId == nil {
-				m.SubaccountId = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionDelta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PositionDelta == nil {
-				m.PositionDelta = &PositionDelta{}
-			}
-			if err := m.PositionDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13295,15 +22293,13 @@ func (m *DerivativeTradeLog) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Payout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionSeconds", wireType)
 			}
-			var stringLen uint64
+			m.RetentionSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -13313,31 +22309,66 @@ func (m *DerivativeTradeLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RetentionSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExchange
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthExchange
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Level) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
 			}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Level: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Level: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field P", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -13347,31 +22378,31 @@ func (m *DerivativeTradeLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderHash = append(m.OrderHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.OrderHash == nil {
-				m.OrderHash = []byte{}
+			if err := m.P.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipientAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Q", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -13381,24 +22412,24 @@ func (m *DerivativeTradeLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeRecipientAddress = append(m.FeeRecipientAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.FeeRecipientAddress == nil {
-				m.FeeRecipientAddress = []byte{}
+			if err := m.Q.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -13422,7 +22453,7 @@ func (m *DerivativeTradeLog) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubaccountPosition) Unmarshal(dAtA []byte) error {
+func (m *AggregateSubaccountVolumeRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13445,17 +22476,17 @@ func (m *SubaccountPosition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubaccountPosition: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregateSubaccountVolumeRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubaccountPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregateSubaccountVolumeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -13465,33 +22496,29 @@ func (m *SubaccountPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Position == nil {
-				m.Position = &Position{}
-			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketVolumes", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -13501,24 +22528,24 @@ func (m *SubaccountPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubaccountId = append(m.SubaccountId[:0], dAtA[iNdEx:postIndex]...)
-			if m.SubaccountId == nil {
-				m.SubaccountId = []byte{}
+			m.MarketVolumes = append(m.MarketVolumes, &MarketVolume{})
+			if err := m.MarketVolumes[len(m.MarketVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -13542,7 +22569,7 @@ func (m *SubaccountPosition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubaccountDeposit) Unmarshal(dAtA []byte) error {
+func (m *AggregateAccountVolumeRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13565,17 +22592,17 @@ func (m *SubaccountDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubaccountDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregateAccountVolumeRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubaccountDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregateAccountVolumeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -13585,29 +22612,27 @@ func (m *SubaccountDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubaccountId = append(m.SubaccountId[:0], dAtA[iNdEx:postIndex]...)
-			if m.SubaccountId == nil {
-				m.SubaccountId = []byte{}
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketVolumes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13634,10 +22659,8 @@ func (m *SubaccountDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Deposit == nil {
-				m.Deposit = &Deposit{}
-			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MarketVolumes = append(m.MarketVolumes, &MarketVolume{})
+			if err := m.MarketVolumes[len(m.MarketVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13662,7 +22685,7 @@ func (m *SubaccountDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DepositUpdate) Unmarshal(dAtA []byte) error {
+func (m *MarketVolume) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13685,15 +22708,15 @@ func (m *DepositUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DepositUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketVolume: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketVolume: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13721,11 +22744,11 @@ func (m *DepositUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13752,8 +22775,7 @@ func (m *DepositUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposits = append(m.Deposits, &SubaccountDeposit{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13778,7 +22800,7 @@ func (m *DepositUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PointsMultiplier) Unmarshal(dAtA []byte) error {
+func (m *DenomDecimals) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13801,15 +22823,15 @@ func (m *PointsMultiplier) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PointsMultiplier: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomDecimals: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PointsMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomDecimals: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MakerPointsMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13837,15 +22859,13 @@ func (m *PointsMultiplier) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MakerPointsMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerPointsMultiplier", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
 			}
-			var stringLen uint64
+			m.Decimals = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -13855,26 +22875,11 @@ func (m *PointsMultiplier) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Decimals |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TakerPointsMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExchange(dAtA[iNdEx:])
@@ -13896,7 +22901,7 @@ func (m *PointsMultiplier) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TradingRewardCampaignBoostInfo) Unmarshal(dAtA []byte) error {
+func (m *TerminalOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13919,15 +22924,15 @@ func (m *TradingRewardCampaignBoostInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TradingRewardCampaignBoostInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TerminalOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradingRewardCampaignBoostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TerminalOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BoostedSpotMarketIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13955,13 +22960,13 @@ func (m *TradingRewardCampaignBoostInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BoostedSpotMarketIds = append(m.BoostedSpotMarketIds, string(dAtA[iNdEx:postIndex]))
+			m.OrderHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotMarketMultipliers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -13971,29 +22976,27 @@ func (m *TradingRewardCampaignBoostInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpotMarketMultipliers = append(m.SpotMarketMultipliers, PointsMultiplier{})
-			if err := m.SpotMarketMultipliers[len(m.SpotMarketMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BoostedDerivativeMarketIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14018,100 +23021,36 @@ func (m *TradingRewardCampaignBoostInfo) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BoostedDerivativeMarketIds = append(m.BoostedDerivativeMarketIds, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeMarketMultipliers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DerivativeMarketMultipliers = append(m.DerivativeMarketMultipliers, PointsMultiplier{})
-			if err := m.DerivativeMarketMultipliers[len(m.DerivativeMarketMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExchange(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CampaignRewardPool) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExchange
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSpot", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CampaignRewardPool: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CampaignRewardPool: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSpot = bool(v != 0)
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
 			}
-			m.StartTimestamp = 0
+			m.OrderType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -14121,16 +23060,16 @@ func (m *CampaignRewardPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTimestamp |= int64(b&0x7F) << shift
+				m.OrderType |= OrderType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxCampaignRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -14140,81 +23079,84 @@ func (m *CampaignRewardPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxCampaignRewards = append(m.MaxCampaignRewards, types.Coin{})
-			if err := m.MaxCampaignRewards[len(m.MaxCampaignRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExchange(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TradingRewardCampaignInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExchange
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TerminalOrderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TradingRewardCampaignInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradingRewardCampaignInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CampaignDurationSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.CampaignDurationSeconds = 0
+			m.Reason = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -14224,14 +23166,14 @@ func (m *TradingRewardCampaignInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CampaignDurationSeconds |= int64(b&0x7F) << shift
+				m.Reason |= OrderTerminationReason(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledQuantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14259,13 +23201,15 @@ func (m *TradingRewardCampaignInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteDenoms = append(m.QuoteDenoms, string(dAtA[iNdEx:postIndex]))
+			if err := m.FilledQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradingRewardBoostInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AveragePrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -14275,33 +23219,31 @@ func (m *TradingRewardCampaignInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TradingRewardBoostInfo == nil {
-				m.TradingRewardBoostInfo = &TradingRewardCampaignBoostInfo{}
-			}
-			if err := m.TradingRewardBoostInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AveragePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisqualifiedMarketIds", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -14311,24 +23253,30 @@ func (m *TradingRewardCampaignInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.DisqualifiedMarketIds = append(m.DisqualifiedMarketIds, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExchange(dAtA[iNdEx:])
@@ -14350,7 +23298,7 @@ func (m *TradingRewardCampaignInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeeDiscountTierInfo) Unmarshal(dAtA []byte) error {
+func (m *LimitOrderFill) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14363,59 +23311,25 @@ func (m *FeeDiscountTierInfo) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FeeDiscountTierInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeDiscountTierInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MakerDiscountRate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExchange
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MakerDiscountRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrderFill: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrderFill: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerDiscountRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14443,13 +23357,11 @@ func (m *FeeDiscountTierInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerDiscountRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OrderHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14477,13 +23389,13 @@ func (m *FeeDiscountTierInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Notional", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14511,7 +23423,7 @@ func (m *FeeDiscountTierInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Notional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14536,7 +23448,7 @@ func (m *FeeDiscountTierInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeeDiscountSchedule) Unmarshal(dAtA []byte) error {
+func (m *SubaccountPnl) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14559,17 +23471,17 @@ func (m *FeeDiscountSchedule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeDiscountSchedule: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubaccountPnl: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeDiscountSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubaccountPnl: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
-			m.BucketCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -14579,16 +23491,29 @@ func (m *FeeDiscountSchedule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BucketCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketDuration", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			m.BucketDuration = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -14598,14 +23523,27 @@ func (m *FeeDiscountSchedule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BucketDuration |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14633,13 +23571,15 @@ func (m *FeeDiscountSchedule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteDenoms = append(m.QuoteDenoms, string(dAtA[iNdEx:postIndex]))
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TierInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesPaid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -14649,29 +23589,29 @@ func (m *FeeDiscountSchedule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TierInfos = append(m.TierInfos, &FeeDiscountTierInfo{})
-			if err := m.TierInfos[len(m.TierInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeesPaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisqualifiedMarketIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPaid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14699,8 +23639,29 @@ func (m *FeeDiscountSchedule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisqualifiedMarketIds = append(m.DisqualifiedMarketIds, string(dAtA[iNdEx:postIndex]))
+			if err := m.FundingPaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedHeight", wireType)
+			}
+			m.LastUpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExchange(dAtA[iNdEx:])
@@ -14722,7 +23683,7 @@ func (m *FeeDiscountSchedule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeeDiscountTierTTL) Unmarshal(dAtA []byte) error {
+func (m *RFQRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14745,17 +23706,17 @@ func (m *FeeDiscountTierTTL) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeDiscountTierTTL: wiretype end group for non-group")
+			return fmt.Errorf("proto: RFQRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeDiscountTierTTL: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RFQRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			m.Tier = 0
+			m.RequestId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -14765,16 +23726,16 @@ func (m *FeeDiscountTierTTL) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Tier |= uint64(b&0x7F) << shift
+				m.RequestId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TtlTimestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			m.TtlTimestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -14784,64 +23745,27 @@ func (m *FeeDiscountTierTTL) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TtlTimestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExchange(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *VolumeRecord) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExchange
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VolumeRecord: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VolumeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MakerVolume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Taker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14869,13 +23793,11 @@ func (m *VolumeRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MakerVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Taker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerVolume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14903,63 +23825,31 @@ func (m *VolumeRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExchange(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AccountRewards) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExchange
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AccountRewards: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountRewards: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBuy = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14987,13 +23877,15 @@ func (m *AccountRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
 			}
-			var msglen int
+			m.Expiry = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -15003,26 +23895,11 @@ func (m *AccountRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Expiry |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Rewards = append(m.Rewards, types.Coin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExchange(dAtA[iNdEx:])
@@ -15044,7 +23921,7 @@ func (m *AccountRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TradeRecords) Unmarshal(dAtA []byte) error {
+func (m *RFQQuote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15067,13 +23944,32 @@ func (m *TradeRecords) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TradeRecords: wiretype end group for non-group")
+			return fmt.Errorf("proto: RFQQuote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradeRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RFQQuote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
@@ -15105,11 +24001,11 @@ func (m *TradeRecords) Unmarshal(dAtA []byte) error {
 			}
 			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestTradeRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerSubaccountId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -15119,81 +24015,63 @@ func (m *TradeRecords) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestTradeRecords = append(m.LatestTradeRecords, &TradeRecord{})
-			if err := m.LatestTradeRecords[len(m.LatestTradeRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MakerSubaccountId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExchange(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthExchange
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SubaccountIDs) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExchange
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SubaccountIDs: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubaccountIDs: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -15203,24 +24081,45 @@ func (m *SubaccountIDs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubaccountIds = append(m.SubaccountIds, make([]byte, postIndex-iNdEx))
-			copy(m.SubaccountIds[len(m.SubaccountIds)-1], dAtA[iNdEx:postIndex])
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExchange(dAtA[iNdEx:])
@@ -15242,7 +24141,7 @@ func (m *SubaccountIDs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TradeRecord) Unmarshal(dAtA []byte) error {
+func (m *SignedOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15265,17 +24164,17 @@ func (m *TradeRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TradeRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignedOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignedOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			m.Timestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -15285,14 +24184,27 @@ func (m *TradeRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15320,13 +24232,11 @@ func (m *TradeRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15354,63 +24264,30 @@ func (m *TradeRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FeeRecipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExchange(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Level) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExchange
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Level: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Level: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field P", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15438,13 +24315,13 @@ func (m *Level) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.P.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Q", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15472,63 +24349,13 @@ func (m *Level) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Q.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExchange(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AggregateSubaccountVolumeRecord) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExchange
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateSubaccountVolumeRecord: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateSubaccountVolumeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15556,13 +24383,53 @@ func (m *AggregateSubaccountVolumeRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubaccountId = string(dAtA[iNdEx:postIndex])
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketVolumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -15572,24 +24439,24 @@ func (m *AggregateSubaccountVolumeRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketVolumes = append(m.MarketVolumes, &MarketVolume{})
-			if err := m.MarketVolumes[len(m.MarketVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -15613,7 +24480,7 @@ func (m *AggregateSubaccountVolumeRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateAccountVolumeRecord) Unmarshal(dAtA []byte) error {
+func (m *SignedOrderNonce) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15636,15 +24503,15 @@ func (m *AggregateAccountVolumeRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateAccountVolumeRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignedOrderNonce: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateAccountVolumeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignedOrderNonce: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15672,13 +24539,13 @@ func (m *AggregateAccountVolumeRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketVolumes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var msglen int
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -15688,77 +24555,31 @@ func (m *AggregateAccountVolumeRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExchange
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MarketVolumes = append(m.MarketVolumes, &MarketVolume{})
-			if err := m.MarketVolumes[len(m.MarketVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExchange(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthExchange
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MarketVolume) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExchange
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MarketVolume: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketVolume: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
@@ -15790,11 +24611,11 @@ func (m *MarketVolume) Unmarshal(dAtA []byte) error {
 			}
 			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -15804,24 +24625,23 @@ func (m *MarketVolume) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExchange
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExchange
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OrderHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15844,7 +24664,7 @@ func (m *MarketVolume) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomDecimals) Unmarshal(dAtA []byte) error {
+func (m *SignedOrderMinNonce) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15867,15 +24687,15 @@ func (m *DenomDecimals) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomDecimals: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignedOrderMinNonce: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomDecimals: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignedOrderMinNonce: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15903,13 +24723,13 @@ func (m *DenomDecimals) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.SubaccountId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinNonce", wireType)
 			}
-			m.Decimals = 0
+			m.MinNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExchange
@@ -15919,7 +24739,7 @@ func (m *DenomDecimals) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decimals |= uint64(b&0x7F) << shift
+				m.MinNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -90,6 +90,40 @@ type GenesisState struct {
 	OrderbookSequences   []*OrderbookSequence               `protobuf:"bytes,32,rep,name=orderbook_sequences,json=orderbookSequences,proto3" json:"orderbook_sequences,omitempty"`
 	SubaccountVolumes    []*AggregateSubaccountVolumeRecord `protobuf:"bytes,33,rep,name=subaccount_volumes,json=subaccountVolumes,proto3" json:"subaccount_volumes,omitempty"`
 	MarketVolumes        []*MarketVolume                    `protobuf:"bytes,34,rep,name=market_volumes,json=marketVolumes,proto3" json:"market_volumes,omitempty"`
+	// spot_iceberg_refills contains the exhausted spot iceberg orders waiting to be refilled from their hidden reserve
+	SpotIcebergRefills []SpotOrderBook `protobuf:"bytes,35,rep,name=spot_iceberg_refills,json=spotIcebergRefills,proto3" json:"spot_iceberg_refills"`
+	// derivative_iceberg_refills contains the exhausted derivative iceberg orders waiting to be refilled from their hidden reserve
+	DerivativeIcebergRefills []DerivativeOrderBook `protobuf:"bytes,36,rep,name=derivative_iceberg_refills,json=derivativeIcebergRefills,proto3" json:"derivative_iceberg_refills"`
+	// twap_orders contains the TWAP orders which are not fully executed yet
+	TwapOrders []*TWAPOrder `protobuf:"bytes,37,rep,name=twap_orders,json=twapOrders,proto3" json:"twap_orders,omitempty"`
+	// market_trade_record_retentions contains any non-default trade record retentions
+	MarketTradeRecordRetentions []*MarketTradeRecordRetention `protobuf:"bytes,38,rep,name=market_trade_record_retentions,json=marketTradeRecordRetentions,proto3" json:"market_trade_record_retentions,omitempty"`
+	// order_insertion_sequences contains the orderbook sequence at which every resting limit order entered the orderbook
+	OrderInsertionSequences []*OrderInsertionSequence `protobuf:"bytes,39,rep,name=order_insertion_sequences,json=orderInsertionSequences,proto3" json:"order_insertion_sequences,omitempty"`
+	// terminal_orders contains the terminal states of the limit orders which left the orderbook within the retention
+	TerminalOrders []*TerminalOrder `protobuf:"bytes,40,rep,name=terminal_orders,json=terminalOrders,proto3" json:"terminal_orders,omitempty"`
+	// limit_order_fills contains the aggregated fills of the resting limit orders
+	LimitOrderFills []*LimitOrderFill `protobuf:"bytes,41,rep,name=limit_order_fills,json=limitOrderFills,proto3" json:"limit_order_fills,omitempty"`
+	// subaccount_pnls contains the realized PnL, fees and funding ledgers of the subaccounts
+	SubaccountPnls []*SubaccountPnl `protobuf:"bytes,42,rep,name=subaccount_pnls,json=subaccountPnls,proto3" json:"subaccount_pnls,omitempty"`
+	// vanilla_options_markets is an array containing the genesis vanilla options markets
+	VanillaOptionsMarkets []*VanillaOptionsMarket `protobuf:"bytes,43,rep,name=vanilla_options_markets,json=vanillaOptionsMarkets,proto3" json:"vanilla_options_markets,omitempty"`
+	// categorical_markets is an array containing the genesis categorical markets
+	CategoricalMarkets []*CategoricalMarket `protobuf:"bytes,44,rep,name=categorical_markets,json=categoricalMarkets,proto3" json:"categorical_markets,omitempty"`
+	// expiry_futures_market_series is an array containing the genesis rolling series of expiry futures markets
+	ExpiryFuturesMarketSeries []*ExpiryFuturesMarketSeries `protobuf:"bytes,45,rep,name=expiry_futures_market_series,json=expiryFuturesMarketSeries,proto3" json:"expiry_futures_market_series,omitempty"`
+	// expiry_futures_auto_rolls is an array containing the subaccounts that opted into rolling their positions
+	ExpiryFuturesAutoRolls []*ExpiryFuturesAutoRoll `protobuf:"bytes,46,rep,name=expiry_futures_auto_rolls,json=expiryFuturesAutoRolls,proto3" json:"expiry_futures_auto_rolls,omitempty"`
+	// pre_launch_perpetual_market_infos is an array containing the mark price states of the pre-launch perpetual markets
+	PreLaunchPerpetualMarketInfos []PreLaunchPerpetualMarketInfo `protobuf:"bytes,47,rep,name=pre_launch_perpetual_market_infos,json=preLaunchPerpetualMarketInfos,proto3" json:"pre_launch_perpetual_market_infos"`
+	// rfq_requests contains the RFQ requests which are not accepted, cancelled or expired yet
+	RfqRequests []*RFQRequest `protobuf:"bytes,48,rep,name=rfq_requests,json=rfqRequests,proto3" json:"rfq_requests,omitempty"`
+	// next_rfq_request_id is the id of the next RFQ request
+	NextRfqRequestId uint64 `protobuf:"varint,49,opt,name=next_rfq_request_id,json=nextRfqRequestId,proto3" json:"next_rfq_request_id,omitempty"`
+	// signed_order_nonces contains the nonces used or cancelled by signed orders which haven't expired yet
+	SignedOrderNonces []SignedOrderNonce `protobuf:"bytes,50,rep,name=signed_order_nonces,json=signedOrderNonces,proto3" json:"signed_order_nonces"`
+	// signed_order_min_nonces contains the min nonces of the signed orders of the subaccounts
+	SignedOrderMinNonces []SignedOrderMinNonce `protobuf:"bytes,51,rep,name=signed_order_min_nonces,json=signedOrderMinNonces,proto3" json:"signed_order_min_nonces"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -363,6 +397,125 @@ func (m *GenesisState) GetMarketVolumes() []*MarketVolume {
 	return nil
 }
 
+func (m *GenesisState) GetSpotIcebergRefills() []SpotOrderBook {
+	if m != nil {
+		return m.SpotIcebergRefills
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDerivativeIcebergRefills() []DerivativeOrderBook {
+	if m != nil {
+		return m.DerivativeIcebergRefills
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTwapOrders() []*TWAPOrder {
+	if m != nil {
+		return m.TwapOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMarketTradeRecordRetentions() []*MarketTradeRecordRetention {
+	if m != nil {
+		return m.MarketTradeRecordRetentions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOrderInsertionSequences() []*OrderInsertionSequence {
+	if m != nil {
+		return m.OrderInsertionSequences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTerminalOrders() []*TerminalOrder {
+	if m != nil {
+		return m.TerminalOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLimitOrderFills() []*LimitOrderFill {
+	if m != nil {
+		return m.LimitOrderFills
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSubaccountPnls() []*SubaccountPnl {
+	if m != nil {
+		return m.SubaccountPnls
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVanillaOptionsMarkets() []*VanillaOptionsMarket {
+	if m != nil {
+		return m.VanillaOptionsMarkets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCategoricalMarkets() []*CategoricalMarket {
+	if m != nil {
+		return m.CategoricalMarkets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExpiryFuturesMarketSeries() []*ExpiryFuturesMarketSeries {
+	if m != nil {
+		return m.ExpiryFuturesMarketSeries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExpiryFuturesAutoRolls() []*ExpiryFuturesAutoRoll {
+	if m != nil {
+		return m.ExpiryFuturesAutoRolls
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPreLaunchPerpetualMarketInfos() []PreLaunchPerpetualMarketInfo {
+	if m != nil {
+		return m.PreLaunchPerpetualMarketInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRfqRequests() []*RFQRequest {
+	if m != nil {
+		return m.RfqRequests
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextRfqRequestId() uint64 {
+	if m != nil {
+		return m.NextRfqRequestId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSignedOrderNonces() []SignedOrderNonce {
+	if m != nil {
+		return m.SignedOrderNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSignedOrderMinNonces() []SignedOrderMinNonce {
+	if m != nil {
+		return m.SignedOrderMinNonces
+	}
+	return nil
+}
+
 type OrderbookSequence struct {
 	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	MarketId string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -415,6 +568,58 @@ func (m *OrderbookSequence) GetMarketId() string {
 	return ""
 }
 
+type OrderInsertionSequence struct {
+	OrderHash string `protobuf:"bytes,1,opt,name=order_hash,json=orderHash,proto3" json:"order_hash,omitempty"`
+	Sequence  uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *OrderInsertionSequence) Reset()         { *m = OrderInsertionSequence{} }
+func (m *OrderInsertionSequence) String() string { return proto.CompactTextString(m) }
+func (*OrderInsertionSequence) ProtoMessage()    {}
+func (*OrderInsertionSequence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c47ec6b98758ed05, []int{2}
+}
+func (m *OrderInsertionSequence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderInsertionSequence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderInsertionSequence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderInsertionSequence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderInsertionSequence.Merge(m, src)
+}
+func (m *OrderInsertionSequence) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderInsertionSequence) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderInsertionSequence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderInsertionSequence proto.InternalMessageInfo
+
+func (m *OrderInsertionSequence) GetOrderHash() string {
+	if m != nil {
+		return m.OrderHash
+	}
+	return ""
+}
+
+func (m *OrderInsertionSequence) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 type FeeDiscountAccountTierTTL struct {
 	Account string              `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	TierTtl *FeeDiscountTierTTL `protobuf:"bytes,2,opt,name=tier_ttl,json=tierTtl,proto3" json:"tier_ttl,omitempty"`
@@ -424,7 +629,7 @@ func (m *FeeDiscountAccountTierTTL) Reset()         { *m = FeeDiscountAccountTie
 func (m *FeeDiscountAccountTierTTL) String() string { return proto.CompactTextString(m) }
 func (*FeeDiscountAccountTierTTL) ProtoMessage()    {}
 func (*FeeDiscountAccountTierTTL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c47ec6b98758ed05, []int{2}
+	return fileDescriptor_c47ec6b98758ed05, []int{3}
 }
 func (m *FeeDiscountAccountTierTTL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -476,7 +681,7 @@ func (m *FeeDiscountBucketVolumeAccounts) Reset()         { *m = FeeDiscountBuck
 func (m *FeeDiscountBucketVolumeAccounts) String() string { return proto.CompactTextString(m) }
 func (*FeeDiscountBucketVolumeAccounts) ProtoMessage()    {}
 func (*FeeDiscountBucketVolumeAccounts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c47ec6b98758ed05, []int{3}
+	return fileDescriptor_c47ec6b98758ed05, []int{4}
 }
 func (m *FeeDiscountBucketVolumeAccounts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -528,7 +733,7 @@ func (m *AccountVolume) Reset()         { *m = AccountVolume{} }
 func (m *AccountVolume) String() string { return proto.CompactTextString(m) }
 func (*AccountVolume) ProtoMessage()    {}
 func (*AccountVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c47ec6b98758ed05, []int{4}
+	return fileDescriptor_c47ec6b98758ed05, []int{5}
 }
 func (m *AccountVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -573,7 +778,7 @@ func (m *TradingRewardCampaignAccountPoints) Reset()         { *m = TradingRewar
 func (m *TradingRewardCampaignAccountPoints) String() string { return proto.CompactTextString(m) }
 func (*TradingRewardCampaignAccountPoints) ProtoMessage()    {}
 func (*TradingRewardCampaignAccountPoints) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c47ec6b98758ed05, []int{5}
+	return fileDescriptor_c47ec6b98758ed05, []int{6}
 }
 func (m *TradingRewardCampaignAccountPoints) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -622,7 +827,7 @@ func (m *TradingRewardCampaignAccountPendingPoints) String() string {
 }
 func (*TradingRewardCampaignAccountPendingPoints) ProtoMessage() {}
 func (*TradingRewardCampaignAccountPendingPoints) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c47ec6b98758ed05, []int{6}
+	return fileDescriptor_c47ec6b98758ed05, []int{7}
 }
 func (m *TradingRewardCampaignAccountPendingPoints) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -676,7 +881,7 @@ func (m *SpotOrderBook) Reset()         { *m = SpotOrderBook{} }
 func (m *SpotOrderBook) String() string { return proto.CompactTextString(m) }
 func (*SpotOrderBook) ProtoMessage()    {}
 func (*SpotOrderBook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c47ec6b98758ed05, []int{7}
+	return fileDescriptor_c47ec6b98758ed05, []int{8}
 }
 func (m *SpotOrderBook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,7 +921,7 @@ func (m *DerivativeOrderBook) Reset()         { *m = DerivativeOrderBook{} }
 func (m *DerivativeOrderBook) String() string { return proto.CompactTextString(m) }
 func (*DerivativeOrderBook) ProtoMessage()    {}
 func (*DerivativeOrderBook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c47ec6b98758ed05, []int{8}
+	return fileDescriptor_c47ec6b98758ed05, []int{9}
 }
 func (m *DerivativeOrderBook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -758,7 +963,7 @@ func (m *ConditionalDerivativeOrderBook) Reset()         { *m = ConditionalDeriv
 func (m *ConditionalDerivativeOrderBook) String() string { return proto.CompactTextString(m) }
 func (*ConditionalDerivativeOrderBook) ProtoMessage()    {}
 func (*ConditionalDerivativeOrderBook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c47ec6b98758ed05, []int{9}
+	return fileDescriptor_c47ec6b98758ed05, []int{10}
 }
 func (m *ConditionalDerivativeOrderBook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -797,7 +1002,7 @@ func (m *Balance) Reset()         { *m = Balance{} }
 func (m *Balance) String() string { return proto.CompactTextString(m) }
 func (*Balance) ProtoMessage()    {}
 func (*Balance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c47ec6b98758ed05, []int{10}
+	return fileDescriptor_c47ec6b98758ed05, []int{11}
 }
 func (m *Balance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -836,7 +1041,7 @@ func (m *DerivativePosition) Reset()         { *m = DerivativePosition{} }
 func (m *DerivativePosition) String() string { return proto.CompactTextString(m) }
 func (*DerivativePosition) ProtoMessage()    {}
 func (*DerivativePosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c47ec6b98758ed05, []int{11}
+	return fileDescriptor_c47ec6b98758ed05, []int{12}
 }
 func (m *DerivativePosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -874,7 +1079,7 @@ func (m *SubaccountNonce) Reset()         { *m = SubaccountNonce{} }
 func (m *SubaccountNonce) String() string { return proto.CompactTextString(m) }
 func (*SubaccountNonce) ProtoMessage()    {}
 func (*SubaccountNonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c47ec6b98758ed05, []int{12}
+	return fileDescriptor_c47ec6b98758ed05, []int{13}
 }
 func (m *SubaccountNonce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -912,7 +1117,7 @@ func (m *ExpiryFuturesMarketInfoState) Reset()         { *m = ExpiryFuturesMarke
 func (m *ExpiryFuturesMarketInfoState) String() string { return proto.CompactTextString(m) }
 func (*ExpiryFuturesMarketInfoState) ProtoMessage()    {}
 func (*ExpiryFuturesMarketInfoState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c47ec6b98758ed05, []int{13}
+	return fileDescriptor_c47ec6b98758ed05, []int{14}
 }
 func (m *ExpiryFuturesMarketInfoState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -964,7 +1169,7 @@ func (m *PerpetualMarketFundingState) Reset()         { *m = PerpetualMarketFund
 func (m *PerpetualMarketFundingState) String() string { return proto.CompactTextString(m) }
 func (*PerpetualMarketFundingState) ProtoMessage()    {}
 func (*PerpetualMarketFundingState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c47ec6b98758ed05, []int{14}
+	return fileDescriptor_c47ec6b98758ed05, []int{15}
 }
 func (m *PerpetualMarketFundingState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1010,6 +1215,7 @@ func (m *PerpetualMarketFundingState) GetFunding() *PerpetualMarketFunding {
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "injective.exchange.v1beta1.GenesisState")
 	proto.RegisterType((*OrderbookSequence)(nil), "injective.exchange.v1beta1.OrderbookSequence")
+	proto.RegisterType((*OrderInsertionSequence)(nil), "injective.exchange.v1beta1.OrderInsertionSequence")
 	proto.RegisterType((*FeeDiscountAccountTierTTL)(nil), "injective.exchange.v1beta1.FeeDiscountAccountTierTTL")
 	proto.RegisterType((*FeeDiscountBucketVolumeAccounts)(nil), "injective.exchange.v1beta1.FeeDiscountBucketVolumeAccounts")
 	proto.RegisterType((*AccountVolume)(nil), "injective.exchange.v1beta1.AccountVolume")
@@ -1030,123 +1236,152 @@ func init() {
 }
 
 var fileDescriptor_c47ec6b98758ed05 = []byte{
-	// 1851 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcb, 0x6f, 0xe3, 0xc6,
-	0x19, 0x37, 0xd7, 0x1b, 0x5b, 0xfe, 0xbc, 0xf6, 0xae, 0xc7, 0x8f, 0xa5, 0x1f, 0x91, 0xbc, 0x72,
-	0xbb, 0xd0, 0xb6, 0x59, 0x29, 0xbb, 0x69, 0x90, 0x36, 0x7d, 0x65, 0xb5, 0xb6, 0x5a, 0x03, 0x4e,
-	0x6c, 0xd0, 0x42, 0x0e, 0xe9, 0x83, 0xa0, 0xc8, 0x91, 0x34, 0x59, 0x92, 0xc3, 0x70, 0x86, 0xce,
-	0xfa, 0x16, 0xf4, 0x10, 0xa4, 0xa7, 0xb4, 0x05, 0x0a, 0xf4, 0x18, 0x14, 0x3d, 0xb4, 0x97, 0xfe,
-	0x0f, 0xbd, 0xe5, 0x98, 0xde, 0x8a, 0x1e, 0x82, 0x62, 0xf7, 0xd2, 0x6b, 0xff, 0x83, 0x82, 0x33,
-	0xc3, 0x87, 0x5e, 0x94, 0xec, 0xf6, 0x24, 0x71, 0xe6, 0xfb, 0x7e, 0xbf, 0xdf, 0x3c, 0x3e, 0x7e,
-	0x1f, 0x3f, 0xa8, 0x11, 0xff, 0x43, 0x6c, 0x73, 0x72, 0x81, 0x1b, 0xf8, 0xb9, 0xdd, 0xb7, 0xfc,
-	0x1e, 0x6e, 0x5c, 0x3c, 0xea, 0x60, 0x6e, 0x3d, 0x6a, 0xf4, 0xb0, 0x8f, 0x19, 0x61, 0xf5, 0x20,
-	0xa4, 0x9c, 0xa2, 0x9d, 0xd4, 0xb2, 0x9e, 0x58, 0xd6, 0x95, 0xe5, 0xce, 0x83, 0x02, 0x94, 0xd4,
-	0x58, 0xc0, 0xec, 0x1c, 0x14, 0x98, 0xf2, 0xe7, 0xca, 0x68, 0xa3, 0x47, 0x7b, 0x54, 0xfc, 0x6d,
-	0xc4, 0xff, 0xe4, 0x68, 0xf5, 0x3f, 0xbb, 0x70, 0xeb, 0x27, 0x52, 0xd3, 0x39, 0xb7, 0x38, 0x46,
-	0xef, 0xc0, 0x42, 0x60, 0x85, 0x96, 0xc7, 0x74, 0x6d, 0x5f, 0xab, 0x2d, 0x3f, 0xae, 0xd6, 0x27,
-	0x6b, 0xac, 0x9f, 0x09, 0xcb, 0xe6, 0xcd, 0x2f, 0xbf, 0xae, 0xcc, 0x19, 0xca, 0x0f, 0x1d, 0xc3,
-	0x2d, 0x16, 0x50, 0x6e, 0x7a, 0x56, 0xf8, 0x0c, 0x73, 0xa6, 0xdf, 0xd8, 0x9f, 0xaf, 0x2d, 0x3f,
-	0xbe, 0x5f, 0x84, 0x73, 0x1e, 0x50, 0xfe, 0xae, 0x30, 0x37, 0x96, 0x59, 0xfa, 0x9f, 0xa1, 0x9f,
-	0x01, 0x72, 0x70, 0x48, 0x2e, 0xac, 0xd8, 0x2d, 0x05, 0x9c, 0x17, 0x80, 0xaf, 0x15, 0x01, 0x1e,
-	0xa6, 0x5e, 0x0a, 0x76, 0xcd, 0x19, 0x1a, 0x61, 0xe8, 0x7d, 0x58, 0x15, 0x3a, 0x69, 0xe8, 0xe0,
-	0xb0, 0x43, 0xe9, 0x33, 0xfd, 0xa6, 0x00, 0x7e, 0x30, 0x4d, 0xe9, 0x69, 0xec, 0xd0, 0xa4, 0xf4,
-	0x99, 0x5a, 0xf8, 0x0a, 0x4b, 0x06, 0x63, 0x14, 0xd4, 0x87, 0x8d, 0x9c, 0xe8, 0x0c, 0xfd, 0x15,
-	0x81, 0xde, 0x98, 0x4d, 0xf6, 0x30, 0xc7, 0xba, 0x33, 0x38, 0x25, 0x98, 0x8e, 0xa0, 0xd4, 0xb1,
-	0x5c, 0xcb, 0xb7, 0x31, 0xd3, 0x17, 0x04, 0xfa, 0x41, 0x11, 0x7a, 0x53, 0xda, 0x2a, 0xc4, 0xd4,
-	0x15, 0x19, 0xb0, 0x14, 0x50, 0x46, 0x38, 0xa1, 0x3e, 0xd3, 0x17, 0x05, 0x4e, 0x7d, 0x36, 0x95,
-	0x67, 0xca, 0x4d, 0x41, 0x66, 0x30, 0x88, 0xc0, 0x5d, 0x16, 0x75, 0x2c, 0xdb, 0xa6, 0x91, 0xcf,
-	0x4d, 0x1e, 0x5a, 0x0e, 0x36, 0x7d, 0x2a, 0x94, 0x96, 0x04, 0xc3, 0xb7, 0x0b, 0x77, 0x39, 0x75,
-	0x7d, 0x8f, 0x66, 0x8a, 0x37, 0x33, 0xc4, 0x76, 0x0c, 0x28, 0xe6, 0x18, 0xfa, 0x54, 0x83, 0x7d,
-	0xfc, 0x3c, 0x20, 0xe1, 0xa5, 0xd9, 0x8d, 0x78, 0x14, 0x62, 0xa6, 0x6e, 0x8a, 0x49, 0xfc, 0x2e,
-	0x35, 0x59, 0x7c, 0xad, 0xf5, 0x25, 0x41, 0xfa, 0xdd, 0x22, 0xd2, 0x23, 0x81, 0xd1, 0x92, 0x10,
-	0xf2, 0x92, 0x1c, 0xfb, 0x5d, 0x2a, 0xc2, 0x42, 0x29, 0xd8, 0xc3, 0x05, 0x36, 0x88, 0xc0, 0x66,
-	0x80, 0xc3, 0x00, 0xf3, 0xc8, 0x72, 0xf3, 0x12, 0x74, 0x98, 0x7e, 0xf2, 0x67, 0x89, 0x63, 0x06,
-	0x9a, 0x9c, 0x7c, 0x30, 0x3a, 0x85, 0x7e, 0xa5, 0x41, 0x79, 0x84, 0xab, 0x1b, 0xf9, 0x0e, 0xf1,
-	0x7b, 0x6a, 0xc5, 0xcb, 0x82, 0xf4, 0xad, 0x2b, 0x90, 0xb6, 0xa4, 0x7f, 0x7e, 0xc1, 0xbb, 0xc1,
-	0x64, 0x13, 0xf4, 0x7b, 0x0d, 0xee, 0x8f, 0x84, 0xa7, 0xc9, 0x30, 0xe7, 0x2e, 0xf6, 0xb0, 0xcf,
-	0x4d, 0x66, 0xf7, 0xb1, 0x13, 0xb9, 0xd8, 0xd1, 0x6f, 0x09, 0x31, 0x6f, 0x5f, 0x25, 0x64, 0xcf,
-	0x53, 0x9c, 0xdc, 0x66, 0x1c, 0x38, 0x13, 0xad, 0xce, 0x13, 0x32, 0xf4, 0x16, 0xe8, 0x84, 0x99,
-	0x22, 0xb6, 0x13, 0x16, 0x13, 0xfb, 0x56, 0x27, 0x16, 0xb2, 0xb2, 0xaf, 0xd5, 0x4a, 0xc6, 0x26,
-	0x61, 0x71, 0x20, 0x1f, 0xa9, 0xd9, 0x23, 0x39, 0x89, 0x8e, 0xa0, 0x42, 0x98, 0x99, 0x51, 0xb0,
-	0x51, 0xff, 0x55, 0xe1, 0xbf, 0x47, 0x58, 0x26, 0x97, 0x0d, 0xc3, 0x5c, 0xc0, 0x5e, 0x7c, 0xe1,
-	0xe3, 0xa3, 0x08, 0xf1, 0xc7, 0x56, 0xe8, 0x98, 0xb6, 0xe5, 0x05, 0x16, 0xe9, 0xf9, 0xf2, 0x3a,
-	0xdc, 0x16, 0x2f, 0xd6, 0x37, 0x8b, 0x36, 0xa3, 0x2d, 0xfd, 0x0d, 0xe1, 0xfe, 0x54, 0x79, 0xc7,
-	0xfb, 0x60, 0x6c, 0xf3, 0x49, 0x53, 0xe8, 0x13, 0x0d, 0xbe, 0x39, 0x44, 0x1c, 0x50, 0xea, 0x66,
-	0xec, 0xc9, 0x79, 0xe8, 0x77, 0xa6, 0x07, 0x79, 0x82, 0x2c, 0x79, 0xce, 0x28, 0x75, 0x8d, 0x7b,
-	0x03, 0xd4, 0xf1, 0x50, 0x62, 0x94, 0xec, 0x3d, 0xfa, 0x9d, 0x06, 0xf7, 0x27, 0xad, 0x3d, 0x79,
-	0x19, 0x04, 0x94, 0xf8, 0x9c, 0xe9, 0x6b, 0x42, 0xc3, 0x8f, 0xae, 0xbc, 0x0b, 0x4f, 0x24, 0xcc,
-	0x99, 0x40, 0x31, 0xaa, 0x7c, 0xaa, 0x0d, 0xb2, 0x61, 0xb3, 0x8b, 0xb1, 0xe9, 0x10, 0x26, 0x05,
-	0xa4, 0xdb, 0x80, 0xc4, 0x41, 0x14, 0xc6, 0x65, 0x0b, 0xe3, 0x43, 0xe5, 0x97, 0x2c, 0xd2, 0x58,
-	0xef, 0x8e, 0x0e, 0xa2, 0x8f, 0xe1, 0xd5, 0x01, 0x92, 0xf4, 0xd5, 0x47, 0x70, 0x68, 0x72, 0xee,
-	0xea, 0xeb, 0x62, 0xbd, 0x6f, 0xce, 0x48, 0xa6, 0x56, 0xd0, 0x26, 0x38, 0x6c, 0xb7, 0x4f, 0x8c,
-	0xed, 0xee, 0xf8, 0x29, 0xee, 0xa2, 0x5f, 0x6b, 0x70, 0x30, 0xc0, 0xdc, 0x89, 0xec, 0x38, 0x0e,
-	0x2f, 0xa8, 0x1b, 0x79, 0x38, 0xd1, 0xc1, 0xf4, 0x0d, 0xc1, 0xff, 0xfd, 0x19, 0xf9, 0x9b, 0x02,
-	0xe4, 0x7d, 0x81, 0xa1, 0x08, 0x99, 0x51, 0xe9, 0x16, 0x1b, 0xa0, 0x1f, 0xc0, 0x2e, 0x61, 0x66,
-	0x97, 0x84, 0x8c, 0x9b, 0xb1, 0x26, 0xfb, 0xd2, 0x76, 0xb1, 0xd9, 0x25, 0x3e, 0x61, 0x7d, 0xec,
-	0xe8, 0x9b, 0x22, 0x78, 0xee, 0x12, 0xd6, 0x8a, 0x2d, 0x5a, 0x18, 0x3f, 0x8d, 0xe7, 0x5b, 0x6a,
-	0x1a, 0x7d, 0xae, 0xc1, 0xc3, 0x00, 0xcb, 0x77, 0xd8, 0x6c, 0xf7, 0x78, 0xeb, 0x5a, 0xf7, 0xb8,
-	0xa6, 0x48, 0xda, 0x53, 0xaf, 0xf3, 0x9f, 0x35, 0xa8, 0x4f, 0x50, 0x34, 0xe9, 0x5a, 0xdf, 0x15,
-	0x92, 0x8e, 0xae, 0x7d, 0xad, 0x25, 0x9b, 0xba, 0xdd, 0x0f, 0xc6, 0x29, 0x1d, 0x7f, 0xc9, 0xbf,
-	0x07, 0xdb, 0x52, 0x19, 0x33, 0x69, 0xc0, 0x4d, 0x1a, 0x71, 0xd3, 0x72, 0x9c, 0x10, 0x33, 0x86,
-	0x99, 0xae, 0xef, 0xcf, 0xd7, 0x96, 0x8c, 0x2d, 0x65, 0x70, 0x1a, 0xf0, 0xd3, 0x88, 0x3f, 0x49,
-	0x66, 0x51, 0x07, 0xf4, 0x3e, 0x61, 0x9c, 0x86, 0xc4, 0xb6, 0x5c, 0x95, 0xab, 0x43, 0x6c, 0xd3,
-	0xd0, 0x61, 0xfa, 0xb6, 0x58, 0x4e, 0x6d, 0xda, 0x72, 0xb0, 0x21, 0xed, 0x8d, 0xad, 0x0c, 0x29,
-	0x3f, 0x8e, 0x30, 0x6c, 0x75, 0x88, 0x6f, 0x85, 0x97, 0xb1, 0xba, 0xb8, 0x42, 0x48, 0xab, 0xb9,
-	0x9d, 0xe9, 0xc9, 0xb1, 0x29, 0x3c, 0x4f, 0xa5, 0xa3, 0x2a, 0xe8, 0x36, 0x3a, 0xa3, 0x83, 0x0c,
-	0xf5, 0xe1, 0xf1, 0x58, 0x1a, 0x93, 0x38, 0x2c, 0x4b, 0x47, 0x66, 0x97, 0x86, 0xb9, 0x3c, 0xa5,
-	0xef, 0x8a, 0xed, 0x79, 0x6d, 0x0c, 0xe2, 0xb1, 0xc3, 0xd2, 0xbc, 0xd2, 0xa2, 0x61, 0x96, 0x6d,
-	0x50, 0x1b, 0x6a, 0xb9, 0x2a, 0x77, 0x08, 0x9f, 0xd3, 0x98, 0xc2, 0xc6, 0xa6, 0xed, 0x52, 0x86,
-	0xf5, 0x3d, 0x81, 0x5f, 0xcd, 0x2a, 0xdb, 0x3c, 0x6c, 0x9b, 0xb6, 0x62, 0xd3, 0xa7, 0xb1, 0x65,
-	0x5c, 0x93, 0x3a, 0xd8, 0xa7, 0x9e, 0xe9, 0x60, 0x9b, 0x78, 0x96, 0xcb, 0xf4, 0x57, 0xa7, 0xd7,
-	0xa4, 0x87, 0xb1, 0xc7, 0xa1, 0x72, 0x48, 0x6a, 0x52, 0x27, 0x3f, 0x18, 0xd7, 0x48, 0xf7, 0x6c,
-	0xea, 0x3b, 0xa2, 0x3a, 0xb3, 0x5c, 0x73, 0x5c, 0x81, 0xca, 0xf4, 0xf2, 0xf4, 0x2c, 0xfd, 0x34,
-	0x03, 0x19, 0x53, 0xac, 0x1a, 0x15, 0x7b, 0xe2, 0xbc, 0xa0, 0x88, 0xef, 0x41, 0x52, 0xad, 0x60,
-	0x6c, 0x7a, 0x91, 0xcb, 0x49, 0xe0, 0x12, 0x1c, 0x32, 0xbd, 0x32, 0xfd, 0x1e, 0xa8, 0x1a, 0x04,
-	0xe3, 0x77, 0x53, 0x3f, 0x63, 0xc3, 0x1b, 0x1d, 0x64, 0xe8, 0x97, 0xb0, 0x9e, 0xae, 0xcb, 0x64,
-	0xf8, 0xa3, 0x08, 0x8b, 0xd2, 0x73, 0x5f, 0x70, 0x3c, 0x2c, 0xe2, 0x48, 0xb5, 0x9e, 0x2b, 0x2f,
-	0x03, 0xd1, 0xe1, 0x21, 0x86, 0x3e, 0x04, 0x94, 0x2b, 0x6f, 0xe5, 0xab, 0x96, 0xe9, 0xf7, 0xa6,
-	0xbf, 0x62, 0x9f, 0xf4, 0x7a, 0x21, 0xee, 0x59, 0x1c, 0x67, 0x25, 0xae, 0x7c, 0x87, 0xca, 0x40,
-	0x31, 0xd6, 0xd8, 0xd0, 0x38, 0x43, 0xa7, 0xb0, 0xaa, 0xb6, 0x2c, 0xe1, 0xa9, 0x4e, 0x0f, 0x4a,
-	0xb9, 0x55, 0x0a, 0x7a, 0xc5, 0xcb, 0x3d, 0xb1, 0xea, 0x09, 0xac, 0x8d, 0xac, 0x12, 0xed, 0x40,
-	0x29, 0xd9, 0x27, 0xf1, 0xe5, 0x77, 0xd3, 0x48, 0x9f, 0xd1, 0x2e, 0x2c, 0xa5, 0xd7, 0x5c, 0xbf,
-	0xb1, 0xaf, 0xd5, 0x96, 0x8c, 0x92, 0xa7, 0x2e, 0x72, 0xf5, 0x13, 0x0d, 0xb6, 0x27, 0x26, 0x2e,
-	0xa4, 0xc3, 0xa2, 0x5a, 0x8e, 0x40, 0x5d, 0x32, 0x92, 0x47, 0x74, 0x0c, 0xa5, 0x34, 0x37, 0xde,
-	0x10, 0x89, 0xb8, 0x3e, 0x63, 0x6e, 0x4a, 0x92, 0xe2, 0x22, 0x97, 0x29, 0xb0, 0xfa, 0x17, 0x0d,
-	0x2a, 0x53, 0x72, 0x17, 0xfa, 0x0e, 0x6c, 0xa9, 0xc4, 0xc8, 0xb8, 0x15, 0xc6, 0x79, 0xd9, 0xc3,
-	0x8c, 0x5b, 0x5e, 0x20, 0x74, 0xcd, 0x1b, 0x1b, 0x72, 0xf6, 0x3c, 0x9e, 0x6c, 0x27, 0x73, 0xe8,
-	0x0c, 0x56, 0x07, 0x0f, 0x59, 0x7d, 0xcd, 0x16, 0xc6, 0xe3, 0x93, 0x81, 0x73, 0x5d, 0x19, 0x38,
-	0xce, 0xea, 0x47, 0xb0, 0x32, 0x30, 0x5f, 0xb0, 0x43, 0x2d, 0x58, 0x48, 0x49, 0xb5, 0xda, 0x52,
-	0xb3, 0x1e, 0x47, 0xf6, 0x3f, 0xbf, 0xae, 0xdc, 0xef, 0x11, 0xde, 0x8f, 0x3a, 0x75, 0x9b, 0x7a,
-	0x0d, 0x9b, 0x32, 0x8f, 0x32, 0xf5, 0xf3, 0x90, 0x39, 0xcf, 0x1a, 0xfc, 0x32, 0xc0, 0xac, 0x7e,
-	0x88, 0x6d, 0x43, 0x79, 0x57, 0x3f, 0xd5, 0xa0, 0x3a, 0x43, 0x06, 0x29, 0x14, 0xa2, 0xb2, 0xdb,
-	0x35, 0x85, 0x48, 0xef, 0xea, 0xdf, 0x35, 0x78, 0x30, 0x73, 0xf2, 0x43, 0x3f, 0x84, 0xdd, 0x7c,
-	0xf6, 0x1f, 0x7f, 0x6c, 0x7a, 0x98, 0x66, 0xef, 0xa1, 0xa3, 0xc3, 0xd9, 0xd1, 0xa5, 0xe2, 0xff,
-	0x1f, 0x15, 0x67, 0x72, 0x9e, 0xf2, 0xb1, 0xfa, 0x07, 0x0d, 0x56, 0x06, 0x9a, 0x02, 0x83, 0xd1,
-	0xa2, 0x0d, 0x46, 0x0b, 0xda, 0x83, 0x25, 0xc2, 0x9a, 0xd1, 0xe5, 0x39, 0x71, 0xe4, 0xb1, 0x96,
-	0x8c, 0x6c, 0x00, 0x35, 0x61, 0x41, 0xbc, 0x6c, 0x92, 0x1e, 0xc7, 0xb7, 0xa6, 0xb5, 0x22, 0x4e,
-	0x88, 0x47, 0x24, 0xb5, 0xa1, 0x3c, 0xdf, 0x2e, 0x7d, 0xf6, 0x45, 0x65, 0xee, 0xdf, 0x5f, 0x54,
-	0xe6, 0xaa, 0x7f, 0xd2, 0x60, 0x7d, 0xcc, 0x4b, 0xfa, 0x7f, 0x11, 0xf8, 0xd3, 0x21, 0x81, 0xaf,
-	0xcf, 0xf6, 0x45, 0x57, 0x28, 0xf3, 0x6f, 0xf3, 0x50, 0x2e, 0x4e, 0x2b, 0xc5, 0x8a, 0x3f, 0x80,
-	0x3b, 0x6e, 0x8c, 0x6f, 0x76, 0xa2, 0x4b, 0x53, 0xa9, 0xbb, 0x71, 0x4d, 0x75, 0xab, 0x02, 0xa9,
-	0x19, 0x5d, 0x8a, 0x47, 0x86, 0x7e, 0x01, 0x6b, 0x8a, 0x38, 0x07, 0x2e, 0x97, 0xfe, 0xe8, 0x2a,
-	0x1f, 0xb3, 0x12, 0xfd, 0xb6, 0xc4, 0xca, 0xe0, 0x7f, 0x0e, 0x6b, 0x52, 0x3a, 0xc3, 0xae, 0x9b,
-	0xc0, 0xdf, 0xbc, 0xa6, 0xf6, 0xdb, 0x02, 0xea, 0x1c, 0xbb, 0xae, 0x42, 0x37, 0x01, 0xa5, 0xdf,
-	0xe4, 0x19, 0xfc, 0x2b, 0xd7, 0x55, 0x7f, 0xc7, 0x53, 0x5f, 0xdc, 0x09, 0x41, 0xee, 0x0c, 0x3f,
-	0xd7, 0x60, 0x51, 0xb5, 0x97, 0xd0, 0x01, 0xac, 0xe4, 0x72, 0x63, 0x7a, 0x60, 0xb7, 0xb2, 0xc1,
-	0x63, 0x07, 0x6d, 0xc0, 0x2b, 0xa2, 0x42, 0x51, 0xe9, 0x44, 0x3e, 0xa0, 0x1f, 0x43, 0xc9, 0xc1,
-	0xa2, 0x89, 0x14, 0xef, 0xb2, 0x36, 0xad, 0xa1, 0x75, 0x28, 0x6d, 0x8d, 0xd4, 0x29, 0xa7, 0xe8,
-	0x8f, 0x1a, 0xa0, 0xd1, 0x46, 0xd5, 0x6c, 0xe2, 0x8a, 0xf2, 0x1d, 0x7a, 0x07, 0x4a, 0x49, 0x9b,
-	0x4b, 0x69, 0xfc, 0x46, 0x61, 0x8f, 0x45, 0xd9, 0x1a, 0xa9, 0x57, 0x4e, 0xe4, 0x5f, 0x35, 0xb8,
-	0x3d, 0xd4, 0xeb, 0x9a, 0x4d, 0xa1, 0x0b, 0x5b, 0xe3, 0xdb, 0x6b, 0x2a, 0x95, 0xbe, 0x3e, 0x5b,
-	0x77, 0x2d, 0x6b, 0xa3, 0xa9, 0xb2, 0x71, 0x63, 0x5c, 0x8b, 0x2d, 0x27, 0xf8, 0xb7, 0x1a, 0xec,
-	0x15, 0xf5, 0xc9, 0x8a, 0x23, 0xb5, 0x0d, 0xcb, 0xf9, 0xb6, 0x98, 0x94, 0xfa, 0xc6, 0x35, 0x7a,
-	0x72, 0x06, 0x78, 0xe9, 0xff, 0xea, 0x67, 0x1a, 0xec, 0x16, 0x74, 0xb2, 0x8a, 0x25, 0x9d, 0xc0,
-	0xa2, 0x6a, 0x9b, 0x29, 0x39, 0x8f, 0xaf, 0xde, 0x30, 0x33, 0x12, 0x88, 0x66, 0xff, 0xcb, 0x17,
-	0x65, 0xed, 0xab, 0x17, 0x65, 0xed, 0x5f, 0x2f, 0xca, 0xda, 0x6f, 0x5e, 0x96, 0xe7, 0xbe, 0x7a,
-	0x59, 0x9e, 0xfb, 0xc7, 0xcb, 0xf2, 0xdc, 0x07, 0xef, 0xe5, 0x52, 0xe5, 0x71, 0x42, 0x70, 0x62,
-	0x75, 0x58, 0x23, 0xa5, 0x7b, 0x68, 0xd3, 0x10, 0xe7, 0x1f, 0xfb, 0x16, 0xf1, 0x1b, 0x1e, 0x8d,
-	0xbf, 0x12, 0x58, 0xd6, 0xd8, 0x17, 0x69, 0xb5, 0xb3, 0x20, 0xda, 0xf7, 0x6f, 0xfc, 0x37, 0x00,
-	0x00, 0xff, 0xff, 0xa6, 0x40, 0x0d, 0xb7, 0x6c, 0x18, 0x00, 0x00,
+	// 2318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcb, 0x6f, 0xdc, 0xb8,
+	0x19, 0xb7, 0xec, 0x3c, 0x6c, 0x3a, 0x76, 0x62, 0xfa, 0x25, 0x3f, 0x62, 0x4f, 0xc6, 0x9b, 0x74,
+	0xbc, 0x1b, 0x8f, 0x13, 0xa7, 0xed, 0xb6, 0xdb, 0xd7, 0xda, 0xb1, 0xa7, 0x6b, 0xc0, 0xd9, 0xb8,
+	0x9c, 0x41, 0x0a, 0x6c, 0x1f, 0x82, 0x46, 0xe2, 0xcc, 0x70, 0x23, 0x89, 0x8a, 0x48, 0x39, 0x31,
+	0x7a, 0x59, 0x14, 0xe8, 0x22, 0x3d, 0x6d, 0x5b, 0xa0, 0x40, 0x8f, 0x8b, 0xa2, 0x87, 0xf6, 0xd2,
+	0xff, 0xa1, 0xb7, 0x3d, 0x6e, 0x6f, 0x45, 0x0f, 0x8b, 0x22, 0xb9, 0xf4, 0xcf, 0x28, 0x48, 0x51,
+	0x8f, 0x79, 0x49, 0x13, 0x6f, 0x4f, 0x33, 0x22, 0xbf, 0xef, 0xf7, 0xfb, 0x89, 0xfc, 0x48, 0x7e,
+	0x9f, 0x08, 0x2a, 0xc4, 0xfb, 0x18, 0x5b, 0x9c, 0x9c, 0xe1, 0x5d, 0xfc, 0xc2, 0xea, 0x98, 0x5e,
+	0x1b, 0xef, 0x9e, 0xdd, 0x6f, 0x62, 0x6e, 0xde, 0xdf, 0x6d, 0x63, 0x0f, 0x33, 0xc2, 0xaa, 0x7e,
+	0x40, 0x39, 0x85, 0xab, 0x89, 0x65, 0x35, 0xb6, 0xac, 0x2a, 0xcb, 0xd5, 0xed, 0x1c, 0x94, 0xc4,
+	0x58, 0xc2, 0xac, 0x6e, 0xe5, 0x98, 0xf2, 0x17, 0xca, 0x68, 0xa1, 0x4d, 0xdb, 0x54, 0xfe, 0xdd,
+	0x15, 0xff, 0xa2, 0xd6, 0xf2, 0x6f, 0xb6, 0xc1, 0xb5, 0x1f, 0x47, 0x9a, 0xea, 0xdc, 0xe4, 0x18,
+	0xbe, 0x0f, 0xae, 0xf8, 0x66, 0x60, 0xba, 0x4c, 0xd7, 0x4a, 0x5a, 0x65, 0x7a, 0xaf, 0x5c, 0x1d,
+	0xae, 0xb1, 0x7a, 0x2a, 0x2d, 0x0f, 0x2e, 0x7d, 0xf1, 0xd5, 0xe6, 0x18, 0x52, 0x7e, 0xf0, 0x18,
+	0x5c, 0x63, 0x3e, 0xe5, 0x86, 0x6b, 0x06, 0x4f, 0x31, 0x67, 0xfa, 0x78, 0x69, 0xa2, 0x32, 0xbd,
+	0x77, 0x27, 0x0f, 0xa7, 0xee, 0x53, 0xfe, 0x48, 0x9a, 0xa3, 0x69, 0x96, 0xfc, 0x67, 0xf0, 0x67,
+	0x00, 0xda, 0x38, 0x20, 0x67, 0xa6, 0x70, 0x4b, 0x00, 0x27, 0x24, 0xe0, 0xdd, 0x3c, 0xc0, 0xc3,
+	0xc4, 0x4b, 0xc1, 0xce, 0xd9, 0x3d, 0x2d, 0x0c, 0x3e, 0x01, 0xb3, 0x52, 0x27, 0x0d, 0x6c, 0x1c,
+	0x34, 0x29, 0x7d, 0xaa, 0x5f, 0x92, 0xc0, 0xdb, 0x45, 0x4a, 0x1f, 0x0b, 0x87, 0x03, 0x4a, 0x9f,
+	0xaa, 0x17, 0x9f, 0x61, 0x71, 0xa3, 0x40, 0x81, 0x1d, 0xb0, 0x90, 0x11, 0x9d, 0xa2, 0x5f, 0x96,
+	0xe8, 0xbb, 0xa3, 0xc9, 0xee, 0xe5, 0x98, 0xb7, 0xbb, 0xbb, 0x24, 0xd3, 0x11, 0x98, 0x6c, 0x9a,
+	0x8e, 0xe9, 0x59, 0x98, 0xe9, 0x57, 0x24, 0xfa, 0x56, 0x1e, 0xfa, 0x41, 0x64, 0xab, 0x10, 0x13,
+	0x57, 0x88, 0xc0, 0x94, 0x4f, 0x19, 0xe1, 0x84, 0x7a, 0x4c, 0xbf, 0x2a, 0x71, 0xaa, 0xa3, 0xa9,
+	0x3c, 0x55, 0x6e, 0x0a, 0x32, 0x85, 0x81, 0x04, 0x2c, 0xb3, 0xb0, 0x69, 0x5a, 0x16, 0x0d, 0x3d,
+	0x6e, 0xf0, 0xc0, 0xb4, 0xb1, 0xe1, 0x51, 0xa9, 0x74, 0x52, 0x32, 0xbc, 0x93, 0x3b, 0xca, 0x89,
+	0xeb, 0x87, 0x34, 0x55, 0xbc, 0x98, 0x22, 0x36, 0x04, 0xa0, 0xec, 0x63, 0xf0, 0x53, 0x0d, 0x94,
+	0xf0, 0x0b, 0x9f, 0x04, 0xe7, 0x46, 0x2b, 0xe4, 0x61, 0x80, 0x99, 0x8a, 0x14, 0x83, 0x78, 0x2d,
+	0x6a, 0x30, 0x6e, 0x72, 0xac, 0x4f, 0x49, 0xd2, 0xef, 0xe4, 0x91, 0x1e, 0x49, 0x8c, 0x5a, 0x04,
+	0x11, 0x05, 0xc9, 0xb1, 0xd7, 0xa2, 0x72, 0x59, 0x28, 0x05, 0xeb, 0x38, 0xc7, 0x06, 0x12, 0xb0,
+	0xe8, 0xe3, 0xc0, 0xc7, 0x3c, 0x34, 0x9d, 0xac, 0x04, 0x1d, 0x14, 0xcf, 0xfc, 0x69, 0xec, 0x98,
+	0x82, 0xc6, 0x33, 0xef, 0xf7, 0x77, 0xc1, 0x5f, 0x6b, 0x60, 0xa3, 0x8f, 0xab, 0x15, 0x7a, 0x36,
+	0xf1, 0xda, 0xea, 0x8d, 0xa7, 0x25, 0xe9, 0xbb, 0x6f, 0x40, 0x5a, 0x8b, 0xfc, 0xb3, 0x2f, 0xbc,
+	0xe6, 0x0f, 0x37, 0x81, 0x7f, 0xd4, 0xc0, 0x9d, 0xbe, 0xe5, 0x69, 0x30, 0xcc, 0xb9, 0x83, 0x5d,
+	0xec, 0x71, 0x83, 0x59, 0x1d, 0x6c, 0x87, 0x0e, 0xb6, 0xf5, 0x6b, 0x52, 0xcc, 0x7b, 0x6f, 0xb2,
+	0x64, 0xeb, 0x09, 0x4e, 0x66, 0x30, 0xb6, 0xec, 0xa1, 0x56, 0xf5, 0x98, 0x0c, 0xbe, 0x0b, 0x74,
+	0xc2, 0x0c, 0xb9, 0xb6, 0x63, 0x16, 0x03, 0x7b, 0x66, 0x53, 0x08, 0x99, 0x29, 0x69, 0x95, 0x49,
+	0xb4, 0x48, 0x98, 0x58, 0xc8, 0x47, 0xaa, 0xf7, 0x28, 0xea, 0x84, 0x47, 0x60, 0x93, 0x30, 0x23,
+	0xa5, 0x60, 0xfd, 0xfe, 0xb3, 0xd2, 0x7f, 0x9d, 0xb0, 0x54, 0x2e, 0xeb, 0x85, 0x39, 0x03, 0xeb,
+	0x22, 0xe0, 0xc5, 0x54, 0x04, 0xf8, 0xb9, 0x19, 0xd8, 0x86, 0x65, 0xba, 0xbe, 0x49, 0xda, 0x5e,
+	0x14, 0x0e, 0xd7, 0xe5, 0xc6, 0xfa, 0xad, 0xbc, 0xc1, 0x68, 0x44, 0xfe, 0x48, 0xba, 0x3f, 0x54,
+	0xde, 0x62, 0x1c, 0xd0, 0x0a, 0x1f, 0xd6, 0x05, 0x3f, 0xd1, 0xc0, 0xed, 0x1e, 0x62, 0x9f, 0x52,
+	0x27, 0x65, 0x8f, 0xe7, 0x43, 0xbf, 0x51, 0xbc, 0xc8, 0x63, 0xe4, 0x88, 0xe7, 0x94, 0x52, 0x07,
+	0xdd, 0xea, 0xa2, 0x16, 0x4d, 0xb1, 0x51, 0x3c, 0xf6, 0xf0, 0x0f, 0x1a, 0xb8, 0x33, 0xec, 0xdd,
+	0xe3, 0xcd, 0xc0, 0xa7, 0xc4, 0xe3, 0x4c, 0x9f, 0x93, 0x1a, 0x7e, 0xf8, 0xc6, 0xa3, 0xb0, 0x1f,
+	0xc1, 0x9c, 0x4a, 0x14, 0x54, 0xe6, 0x85, 0x36, 0xd0, 0x02, 0x8b, 0x2d, 0x8c, 0x0d, 0x9b, 0xb0,
+	0x48, 0x40, 0x32, 0x0c, 0xb0, 0xa4, 0x15, 0xad, 0xcb, 0x1a, 0xc6, 0x87, 0xca, 0x2f, 0x7e, 0x49,
+	0x34, 0xdf, 0xea, 0x6f, 0x84, 0xcf, 0xc1, 0xcd, 0x2e, 0x92, 0x64, 0xeb, 0x23, 0x38, 0x30, 0x38,
+	0x77, 0xf4, 0xf9, 0xd2, 0x44, 0xd1, 0xac, 0x67, 0xc8, 0xd4, 0x1b, 0x34, 0x08, 0x0e, 0x1a, 0x8d,
+	0x13, 0xb4, 0xd2, 0x1a, 0xdc, 0xc5, 0x1d, 0xf8, 0x5b, 0x0d, 0x6c, 0x75, 0x31, 0x37, 0x43, 0x4b,
+	0xac, 0xc3, 0x33, 0xea, 0x84, 0x2e, 0x8e, 0x75, 0x30, 0x7d, 0x41, 0xf2, 0x7f, 0x6f, 0x44, 0xfe,
+	0x03, 0x09, 0xf2, 0x44, 0x62, 0x28, 0x42, 0x86, 0x36, 0x5b, 0xf9, 0x06, 0xf0, 0xfb, 0x60, 0x8d,
+	0x30, 0xa3, 0x45, 0x02, 0xc6, 0x0d, 0xa1, 0xc9, 0x3a, 0xb7, 0x1c, 0x6c, 0xb4, 0x88, 0x47, 0x58,
+	0x07, 0xdb, 0xfa, 0xa2, 0x5c, 0x3c, 0xcb, 0x84, 0xd5, 0x84, 0x45, 0x0d, 0xe3, 0x87, 0xa2, 0xbf,
+	0xa6, 0xba, 0xe1, 0x67, 0x1a, 0xd8, 0xf1, 0x71, 0xb4, 0x87, 0x8d, 0x16, 0xc7, 0x4b, 0x17, 0x8a,
+	0xe3, 0x8a, 0x22, 0x69, 0x14, 0x86, 0xf3, 0x5f, 0x35, 0x50, 0x1d, 0xa2, 0x68, 0x58, 0x58, 0x2f,
+	0x4b, 0x49, 0x47, 0x17, 0x0e, 0xeb, 0x88, 0x4d, 0x45, 0xf7, 0xf6, 0x20, 0xa5, 0x83, 0x83, 0xfc,
+	0xbb, 0x60, 0x25, 0x52, 0xc6, 0x0c, 0xea, 0x73, 0x83, 0x86, 0xdc, 0x30, 0x6d, 0x3b, 0xc0, 0x8c,
+	0x61, 0xa6, 0xeb, 0xa5, 0x89, 0xca, 0x14, 0x5a, 0x52, 0x06, 0x8f, 0x7d, 0xfe, 0x38, 0xe4, 0xfb,
+	0x71, 0x2f, 0x6c, 0x02, 0xbd, 0x43, 0x18, 0xa7, 0x01, 0xb1, 0x4c, 0x47, 0x9d, 0xd5, 0x01, 0xb6,
+	0x68, 0x60, 0x33, 0x7d, 0x45, 0xbe, 0x4e, 0xa5, 0xe8, 0x75, 0x30, 0x8a, 0xec, 0xd1, 0x52, 0x8a,
+	0x94, 0x6d, 0x87, 0x18, 0x2c, 0x35, 0x89, 0x67, 0x06, 0xe7, 0x42, 0x9d, 0xc8, 0x10, 0x92, 0x6c,
+	0x6e, 0xb5, 0xf8, 0x70, 0x3c, 0x90, 0x9e, 0x8f, 0x23, 0x47, 0x95, 0xd0, 0x2d, 0x34, 0xfb, 0x1b,
+	0x19, 0xec, 0x80, 0xbd, 0x81, 0x34, 0x06, 0xb1, 0x59, 0x7a, 0x1c, 0x19, 0x2d, 0x1a, 0x64, 0xce,
+	0x29, 0x7d, 0x4d, 0x0e, 0xcf, 0xdd, 0x01, 0x88, 0xc7, 0x36, 0x4b, 0xce, 0x95, 0x1a, 0x0d, 0xd2,
+	0xd3, 0x06, 0x36, 0x40, 0x25, 0x93, 0xe5, 0xf6, 0xe0, 0x73, 0x2a, 0x28, 0x2c, 0x6c, 0x58, 0x0e,
+	0x65, 0x58, 0x5f, 0x97, 0xf8, 0xe5, 0x34, 0xb3, 0xcd, 0xc2, 0x36, 0x68, 0x4d, 0x98, 0x3e, 0x14,
+	0x96, 0x22, 0x27, 0xb5, 0xb1, 0x47, 0x5d, 0xc3, 0xc6, 0x16, 0x71, 0x4d, 0x87, 0xe9, 0x37, 0x8b,
+	0x73, 0xd2, 0x43, 0xe1, 0x71, 0xa8, 0x1c, 0xe2, 0x9c, 0xd4, 0xce, 0x36, 0x8a, 0x1c, 0xe9, 0x96,
+	0x45, 0x3d, 0x5b, 0x66, 0x67, 0xa6, 0x63, 0x0c, 0x4a, 0x50, 0x99, 0xbe, 0x51, 0x7c, 0x4a, 0x3f,
+	0x4c, 0x41, 0x06, 0x24, 0xab, 0x68, 0xd3, 0x1a, 0xda, 0x2f, 0x29, 0x44, 0x1c, 0xc4, 0xd9, 0x0a,
+	0xc6, 0x86, 0x1b, 0x3a, 0x9c, 0xf8, 0x0e, 0xc1, 0x01, 0xd3, 0x37, 0x8b, 0xe3, 0x40, 0xe5, 0x20,
+	0x18, 0x3f, 0x4a, 0xfc, 0xd0, 0x82, 0xdb, 0xdf, 0xc8, 0xe0, 0x2f, 0xc1, 0x7c, 0xf2, 0x5e, 0x06,
+	0xc3, 0xcf, 0x42, 0x2c, 0x53, 0xcf, 0x92, 0xe4, 0xd8, 0xc9, 0xe3, 0x48, 0xb4, 0xd6, 0x95, 0x17,
+	0x82, 0xb4, 0xb7, 0x89, 0xc1, 0x8f, 0x01, 0xcc, 0xa4, 0xb7, 0xd1, 0x56, 0xcb, 0xf4, 0x5b, 0xc5,
+	0x5b, 0xec, 0x7e, 0xbb, 0x1d, 0xe0, 0xb6, 0xc9, 0x71, 0x9a, 0xe2, 0x46, 0x7b, 0x68, 0xb4, 0x50,
+	0xd0, 0x1c, 0xeb, 0x69, 0x67, 0xf0, 0x31, 0x98, 0x55, 0x43, 0x16, 0xf3, 0x94, 0x8b, 0x17, 0x65,
+	0x34, 0x54, 0x0a, 0x7a, 0xc6, 0xcd, 0x3c, 0x31, 0x68, 0x82, 0x05, 0x19, 0xba, 0xc4, 0xc2, 0x4d,
+	0x1c, 0x88, 0x1d, 0xad, 0x45, 0x1c, 0x87, 0xe9, 0x5b, 0x17, 0x2b, 0x7f, 0xa0, 0x00, 0x3b, 0x8e,
+	0xb0, 0x50, 0x04, 0x05, 0x19, 0x58, 0xcd, 0x84, 0x58, 0x2f, 0xd1, 0x5b, 0x5f, 0xa7, 0x12, 0xd2,
+	0x53, 0xe0, 0x1e, 0xd2, 0x1a, 0x98, 0xe6, 0xcf, 0x4d, 0x3f, 0x8a, 0x68, 0xa6, 0xdf, 0x96, 0x2c,
+	0xb7, 0x73, 0xb7, 0xae, 0x9f, 0xee, 0x9f, 0x4a, 0x7c, 0x04, 0x84, 0xa7, 0xfc, 0xcb, 0xe0, 0xaf,
+	0xc0, 0x86, 0x1a, 0xf0, 0xec, 0x5e, 0x68, 0x04, 0x98, 0x63, 0x2f, 0x2a, 0x92, 0xee, 0x48, 0xe8,
+	0x6f, 0x17, 0x4f, 0x40, 0x66, 0x0f, 0x44, 0xb1, 0x3b, 0x5a, 0x73, 0x87, 0xf6, 0x31, 0xe8, 0x81,
+	0x15, 0xa9, 0xdf, 0x20, 0x1e, 0xc3, 0x81, 0x68, 0xcb, 0xc4, 0xef, 0x37, 0x24, 0xef, 0x5e, 0x61,
+	0xfc, 0x1e, 0xc7, 0xbe, 0x49, 0x10, 0x2f, 0xd3, 0x81, 0xed, 0xa2, 0xf8, 0xbb, 0xce, 0x71, 0xe0,
+	0x12, 0xb1, 0x2b, 0xa8, 0x81, 0xab, 0x14, 0xc7, 0x41, 0x43, 0xb9, 0x44, 0x83, 0x37, 0xcb, 0xb3,
+	0x8f, 0xa2, 0xb2, 0x9e, 0x73, 0x88, 0x4b, 0x54, 0x69, 0x6d, 0x44, 0x93, 0xbe, 0x2d, 0x51, 0xdf,
+	0xce, 0x43, 0x3d, 0x11, 0x4e, 0x12, 0xa3, 0x46, 0x1c, 0x07, 0x5d, 0x77, 0xba, 0x9e, 0xa5, 0xd6,
+	0xcc, 0xaa, 0xf3, 0x3d, 0x87, 0xe9, 0x6f, 0x8f, 0x10, 0xb3, 0x89, 0xcb, 0xa9, 0xe7, 0xa0, 0x59,
+	0x96, 0x7d, 0x14, 0x27, 0xc6, 0xf2, 0x99, 0xe9, 0x11, 0xc7, 0x31, 0xfb, 0x4e, 0xa6, 0x77, 0x24,
+	0xf6, 0xbd, 0x3c, 0xec, 0x27, 0x91, 0x6b, 0xf7, 0xd1, 0xb4, 0x78, 0x36, 0xa0, 0x55, 0xee, 0x49,
+	0x96, 0xc9, 0x71, 0x5b, 0x9d, 0xb3, 0x31, 0xcb, 0xdd, 0xe2, 0x3d, 0xe9, 0x61, 0xea, 0xa6, 0x28,
+	0xa0, 0xd5, 0xdb, 0xc4, 0x44, 0xd9, 0x31, 0xb8, 0x0c, 0x66, 0x38, 0x20, 0x98, 0xe9, 0x3b, 0xc5,
+	0x09, 0xe8, 0x80, 0x12, 0xb8, 0x2e, 0x9d, 0xd1, 0x0a, 0x1e, 0xd6, 0x05, 0x1d, 0xb0, 0xd2, 0xc3,
+	0x6b, 0x86, 0x9c, 0x1a, 0x01, 0x15, 0xb3, 0x5e, 0x95, 0xa4, 0xf7, 0x47, 0x26, 0xdd, 0x0f, 0x39,
+	0x45, 0xd4, 0x71, 0xd0, 0x12, 0x1e, 0xd4, 0xcc, 0xe0, 0x4b, 0x0d, 0xdc, 0xf2, 0x03, 0x6c, 0x38,
+	0x66, 0xe8, 0x59, 0x1d, 0x63, 0x60, 0xc1, 0xcd, 0xf4, 0xdd, 0xe2, 0x72, 0xff, 0x34, 0xc0, 0x27,
+	0x12, 0x63, 0x78, 0xe9, 0x7d, 0xd3, 0xcf, 0xb1, 0x91, 0x1f, 0xba, 0x82, 0xd6, 0x33, 0x23, 0x10,
+	0x6b, 0x89, 0x71, 0xa6, 0xdf, 0x2b, 0xfe, 0xd0, 0x85, 0x6a, 0x3f, 0x41, 0x91, 0x39, 0x9a, 0x0e,
+	0x5a, 0xcf, 0xd4, 0x7f, 0x06, 0x77, 0xc0, 0xbc, 0x87, 0x5f, 0x70, 0x23, 0x83, 0x67, 0x10, 0x5b,
+	0xbf, 0x5f, 0xd2, 0x2a, 0x97, 0xd0, 0x0d, 0xd1, 0x85, 0x12, 0xeb, 0x63, 0x1b, 0x36, 0xc1, 0x3c,
+	0x23, 0x6d, 0x0f, 0xdb, 0x6a, 0x85, 0xa9, 0x2f, 0x2b, 0x7b, 0xc5, 0x1f, 0xc6, 0xea, 0xd2, 0x4d,
+	0xae, 0xa9, 0xec, 0xa7, 0x95, 0x39, 0xd6, 0xd3, 0x2e, 0xa6, 0x75, 0xb9, 0x8b, 0xc3, 0x25, 0x5e,
+	0xcc, 0xf3, 0xa0, 0x78, 0xff, 0xce, 0xf0, 0x3c, 0x22, 0x5e, 0x96, 0x6a, 0x81, 0xf5, 0x77, 0xb1,
+	0xf2, 0x09, 0x98, 0xeb, 0x3b, 0x79, 0xe1, 0x2a, 0x98, 0x8c, 0xf7, 0x3e, 0xf9, 0x35, 0xf2, 0x12,
+	0x4a, 0x9e, 0xe1, 0x1a, 0x98, 0x4a, 0x52, 0x2f, 0x7d, 0xbc, 0xa4, 0x55, 0xa6, 0xd0, 0xa4, 0xab,
+	0x92, 0xab, 0x72, 0x1d, 0x2c, 0x0d, 0xde, 0x07, 0xe1, 0x4d, 0x00, 0xa2, 0xd7, 0xe9, 0x98, 0xac,
+	0x23, 0x41, 0xa7, 0xd0, 0x94, 0x6c, 0xf9, 0xc0, 0x64, 0x9d, 0x2e, 0xc6, 0xf1, 0x6e, 0xc6, 0xf2,
+	0x27, 0x1a, 0x58, 0x19, 0x5a, 0xa1, 0x41, 0x1d, 0x5c, 0x55, 0xdb, 0x8a, 0x42, 0x8d, 0x1f, 0xe1,
+	0x31, 0x98, 0x4c, 0x8a, 0xc0, 0xf1, 0x92, 0x56, 0x54, 0xb0, 0x64, 0x28, 0xe2, 0xea, 0xef, 0x2a,
+	0x8f, 0x6a, 0xbd, 0xf2, 0xdf, 0x34, 0xb0, 0x59, 0x50, 0xa4, 0xc1, 0x6f, 0x82, 0x25, 0x55, 0x01,
+	0x32, 0x6e, 0x06, 0xa2, 0x00, 0x75, 0x31, 0xe3, 0xa6, 0xeb, 0x4b, 0x5d, 0x13, 0x68, 0x21, 0xea,
+	0xad, 0x8b, 0xce, 0x46, 0xdc, 0x07, 0x4f, 0xc1, 0x6c, 0x77, 0x36, 0xa3, 0x8f, 0x17, 0xef, 0xac,
+	0xfb, 0x5d, 0x09, 0xcc, 0x4c, 0x57, 0xde, 0x52, 0x7e, 0x06, 0x66, 0xba, 0xfa, 0x73, 0x46, 0xa8,
+	0x06, 0xae, 0x24, 0xa4, 0x5a, 0x65, 0xea, 0xa0, 0x2a, 0x02, 0xe5, 0xdf, 0x5f, 0x6d, 0xde, 0x69,
+	0x13, 0xde, 0x09, 0x9b, 0x55, 0x8b, 0xba, 0xbb, 0x16, 0x65, 0x2e, 0x65, 0xea, 0x67, 0x87, 0xd9,
+	0x4f, 0x77, 0xf9, 0xb9, 0x8f, 0x59, 0xf5, 0x10, 0x5b, 0x48, 0x79, 0x97, 0x3f, 0xd5, 0x40, 0x79,
+	0x84, 0x52, 0x29, 0x57, 0x88, 0x2a, 0xe3, 0x2e, 0x28, 0x24, 0xf2, 0x2e, 0xff, 0x53, 0x03, 0xdb,
+	0x23, 0x57, 0x79, 0xf0, 0x07, 0x60, 0x2d, 0x5b, 0xe6, 0x0e, 0x9e, 0x36, 0x3d, 0x48, 0xca, 0xd4,
+	0x9e, 0xa9, 0xc3, 0xe9, 0xd4, 0x25, 0xe2, 0xff, 0x1f, 0x9f, 0x56, 0x66, 0xcc, 0xec, 0x63, 0xf9,
+	0x4f, 0x1a, 0x98, 0xe9, 0x4a, 0xff, 0xba, 0x97, 0xa0, 0xd6, 0xbd, 0x04, 0xe1, 0x3a, 0x98, 0x22,
+	0xec, 0x20, 0x3c, 0xaf, 0x13, 0x3b, 0x9a, 0xd6, 0x49, 0x94, 0x36, 0xc0, 0x03, 0x70, 0x45, 0x25,
+	0x1b, 0x13, 0xc5, 0x69, 0x81, 0x60, 0x4d, 0x53, 0x03, 0xa4, 0x3c, 0xdf, 0x9b, 0x7c, 0xf9, 0xf9,
+	0xe6, 0xd8, 0x7f, 0x3f, 0xdf, 0x1c, 0x2b, 0xff, 0x45, 0x03, 0xf3, 0x03, 0x12, 0xc6, 0xaf, 0x23,
+	0xf0, 0x83, 0x1e, 0x81, 0xf7, 0x46, 0x4b, 0x56, 0x73, 0x65, 0xfe, 0x63, 0x02, 0x6c, 0xe4, 0xd7,
+	0x4f, 0xf9, 0x8a, 0x3f, 0x02, 0x37, 0xa2, 0xb4, 0xaa, 0x19, 0x9e, 0xc7, 0xb9, 0xda, 0xf8, 0x05,
+	0xd5, 0xcd, 0x4a, 0xa4, 0x83, 0xf0, 0x5c, 0xa5, 0x6c, 0xbf, 0x00, 0x73, 0x8a, 0x38, 0x03, 0x3e,
+	0x51, 0x7c, 0x78, 0xf7, 0x7e, 0xb5, 0x8d, 0xd0, 0xaf, 0x47, 0x58, 0x29, 0xfc, 0xcf, 0xe3, 0x8c,
+	0x90, 0x61, 0x27, 0xc9, 0x33, 0x2f, 0x5d, 0x50, 0x7b, 0x94, 0x17, 0xd6, 0xb1, 0x13, 0xe7, 0x9b,
+	0x06, 0x80, 0x49, 0xaa, 0x93, 0xc2, 0x5f, 0xbe, 0xa8, 0xfa, 0x1b, 0xae, 0x4a, 0x6f, 0x62, 0x82,
+	0xcc, 0x1c, 0x7e, 0xa6, 0x81, 0xab, 0xea, 0x1e, 0x05, 0x6e, 0x81, 0x99, 0x4c, 0x3a, 0x9a, 0x4c,
+	0xd8, 0xb5, 0xb4, 0xf1, 0xd8, 0x86, 0x0b, 0xe0, 0xb2, 0x2c, 0xc5, 0xd5, 0x19, 0x15, 0x3d, 0xc0,
+	0x1f, 0x81, 0x49, 0x1b, 0xcb, 0xdb, 0x12, 0x31, 0xca, 0x5a, 0xd1, 0xcd, 0xcd, 0x61, 0x64, 0x8b,
+	0x12, 0xa7, 0x8c, 0xa2, 0x3f, 0x6b, 0x00, 0xf6, 0xdf, 0xc8, 0x8c, 0x26, 0x2e, 0xef, 0x10, 0x85,
+	0xef, 0x83, 0xc9, 0xf8, 0x3e, 0x47, 0x69, 0x7c, 0x2b, 0x37, 0x9f, 0x52, 0xb6, 0x28, 0xf1, 0xca,
+	0x88, 0xfc, 0xbb, 0x06, 0xae, 0xf7, 0x5c, 0xea, 0x8c, 0xa6, 0xd0, 0x01, 0x4b, 0x83, 0xef, 0x91,
+	0xd4, 0x51, 0x7a, 0x6f, 0xb4, 0xcc, 0x3f, 0xbd, 0x2f, 0x4a, 0xb2, 0x90, 0x01, 0x7d, 0x19, 0xc1,
+	0xbf, 0xd7, 0xc0, 0x7a, 0xde, 0x85, 0x50, 0xfe, 0x4a, 0x6d, 0x80, 0xe9, 0xec, 0xfd, 0x4f, 0x24,
+	0xf5, 0xc1, 0x05, 0x2e, 0x9f, 0x10, 0x70, 0x93, 0xff, 0xe5, 0x97, 0x1a, 0x58, 0xcb, 0xb9, 0xb2,
+	0xc9, 0x97, 0x74, 0x02, 0xae, 0xaa, 0xfb, 0x21, 0x25, 0x67, 0xef, 0xcd, 0x6f, 0x86, 0x50, 0x0c,
+	0x71, 0xd0, 0xf9, 0xe2, 0xd5, 0x86, 0xf6, 0xe5, 0xab, 0x0d, 0xed, 0x3f, 0xaf, 0x36, 0xb4, 0xdf,
+	0xbd, 0xde, 0x18, 0xfb, 0xf2, 0xf5, 0xc6, 0xd8, 0xbf, 0x5e, 0x6f, 0x8c, 0x7d, 0xf4, 0x61, 0xe6,
+	0xa8, 0x3c, 0x8e, 0x09, 0x4e, 0xcc, 0x26, 0xdb, 0x4d, 0xe8, 0x76, 0x2c, 0x1a, 0xe0, 0xec, 0x63,
+	0xc7, 0x24, 0xde, 0xae, 0x4b, 0xc5, 0xe7, 0x30, 0x96, 0xde, 0x60, 0xcb, 0x63, 0xb5, 0x79, 0x45,
+	0xde, 0x53, 0x3f, 0xf8, 0xdf, 0x00, 0xbc, 0x56, 0xdc, 0x16, 0x55, 0x1f, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -1169,10 +1404,10 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.MarketVolumes) > 0 {
-		for iNdEx := len(m.MarketVolumes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.SignedOrderMinNonces) > 0 {
+		for iNdEx := len(m.SignedOrderMinNonces) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MarketVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.SignedOrderMinNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1180,15 +1415,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0x92
+			dAtA[i] = 0x9a
 		}
 	}
-	if len(m.SubaccountVolumes) > 0 {
-		for iNdEx := len(m.SubaccountVolumes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.SignedOrderNonces) > 0 {
+		for iNdEx := len(m.SignedOrderNonces) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.SubaccountVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.SignedOrderNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1196,15 +1431,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0x8a
+			dAtA[i] = 0x92
 		}
 	}
-	if len(m.OrderbookSequences) > 0 {
-		for iNdEx := len(m.OrderbookSequences) - 1; iNdEx >= 0; iNdEx-- {
+	if m.NextRfqRequestId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextRfqRequestId))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.RfqRequests) > 0 {
+		for iNdEx := len(m.RfqRequests) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.OrderbookSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RfqRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1212,15 +1454,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0x82
 		}
 	}
-	if len(m.MarketFeeMultipliers) > 0 {
-		for iNdEx := len(m.MarketFeeMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PreLaunchPerpetualMarketInfos) > 0 {
+		for iNdEx := len(m.PreLaunchPerpetualMarketInfos) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MarketFeeMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PreLaunchPerpetualMarketInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1228,15 +1470,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x2
 			i--
 			dAtA[i] = 0xfa
 		}
 	}
-	if len(m.ConditionalDerivativeOrderbooks) > 0 {
-		for iNdEx := len(m.ConditionalDerivativeOrderbooks) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ExpiryFuturesAutoRolls) > 0 {
+		for iNdEx := len(m.ExpiryFuturesAutoRolls) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ConditionalDerivativeOrderbooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ExpiryFuturesAutoRolls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1244,15 +1486,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x2
 			i--
 			dAtA[i] = 0xf2
 		}
 	}
-	if len(m.DenomDecimals) > 0 {
-		for iNdEx := len(m.DenomDecimals) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ExpiryFuturesMarketSeries) > 0 {
+		for iNdEx := len(m.ExpiryFuturesMarketSeries) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DenomDecimals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ExpiryFuturesMarketSeries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1260,37 +1502,47 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x2
 			i--
 			dAtA[i] = 0xea
 		}
 	}
-	if len(m.SpotMarketIdsScheduledToForceClose) > 0 {
-		for iNdEx := len(m.SpotMarketIdsScheduledToForceClose) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SpotMarketIdsScheduledToForceClose[iNdEx])
-			copy(dAtA[i:], m.SpotMarketIdsScheduledToForceClose[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.SpotMarketIdsScheduledToForceClose[iNdEx])))
+	if len(m.CategoricalMarkets) > 0 {
+		for iNdEx := len(m.CategoricalMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CategoricalMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x2
 			i--
 			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.BinaryOptionsMarketIdsScheduledForSettlement) > 0 {
-		for iNdEx := len(m.BinaryOptionsMarketIdsScheduledForSettlement) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.BinaryOptionsMarketIdsScheduledForSettlement[iNdEx])
-			copy(dAtA[i:], m.BinaryOptionsMarketIdsScheduledForSettlement[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BinaryOptionsMarketIdsScheduledForSettlement[iNdEx])))
+	if len(m.VanillaOptionsMarkets) > 0 {
+		for iNdEx := len(m.VanillaOptionsMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VanillaOptionsMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x2
 			i--
 			dAtA[i] = 0xda
 		}
 	}
-	if len(m.BinaryOptionsMarkets) > 0 {
-		for iNdEx := len(m.BinaryOptionsMarkets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.SubaccountPnls) > 0 {
+		for iNdEx := len(m.SubaccountPnls) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.BinaryOptionsMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.SubaccountPnls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1298,15 +1550,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x2
 			i--
 			dAtA[i] = 0xd2
 		}
 	}
-	if len(m.HistoricalTradeRecords) > 0 {
-		for iNdEx := len(m.HistoricalTradeRecords) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LimitOrderFills) > 0 {
+		for iNdEx := len(m.LimitOrderFills) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.HistoricalTradeRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LimitOrderFills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1314,26 +1566,31 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x2
 			i--
 			dAtA[i] = 0xca
 		}
 	}
-	if len(m.RewardsOptOutAddresses) > 0 {
-		for iNdEx := len(m.RewardsOptOutAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RewardsOptOutAddresses[iNdEx])
-			copy(dAtA[i:], m.RewardsOptOutAddresses[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RewardsOptOutAddresses[iNdEx])))
+	if len(m.TerminalOrders) > 0 {
+		for iNdEx := len(m.TerminalOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TerminalOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x2
 			i--
 			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.PendingTradingRewardCampaignAccountPoints) > 0 {
-		for iNdEx := len(m.PendingTradingRewardCampaignAccountPoints) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.OrderInsertionSequences) > 0 {
+		for iNdEx := len(m.OrderInsertionSequences) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PendingTradingRewardCampaignAccountPoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.OrderInsertionSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1341,15 +1598,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x2
 			i--
 			dAtA[i] = 0xba
 		}
 	}
-	if len(m.PendingTradingRewardPoolCampaignSchedule) > 0 {
-		for iNdEx := len(m.PendingTradingRewardPoolCampaignSchedule) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.MarketTradeRecordRetentions) > 0 {
+		for iNdEx := len(m.MarketTradeRecordRetentions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PendingTradingRewardPoolCampaignSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.MarketTradeRecordRetentions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1357,27 +1614,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x2
 			i--
 			dAtA[i] = 0xb2
 		}
 	}
-	if m.IsFirstFeeCycleFinished {
-		i--
-		if m.IsFirstFeeCycleFinished {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa8
-	}
-	if len(m.FeeDiscountBucketVolumeAccounts) > 0 {
-		for iNdEx := len(m.FeeDiscountBucketVolumeAccounts) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TwapOrders) > 0 {
+		for iNdEx := len(m.TwapOrders) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.FeeDiscountBucketVolumeAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TwapOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1385,7 +1630,260 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.DerivativeIcebergRefills) > 0 {
+		for iNdEx := len(m.DerivativeIcebergRefills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DerivativeIcebergRefills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.SpotIcebergRefills) > 0 {
+		for iNdEx := len(m.SpotIcebergRefills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpotIcebergRefills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.MarketVolumes) > 0 {
+		for iNdEx := len(m.MarketVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.SubaccountVolumes) > 0 {
+		for iNdEx := len(m.SubaccountVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubaccountVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.OrderbookSequences) > 0 {
+		for iNdEx := len(m.OrderbookSequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderbookSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.MarketFeeMultipliers) > 0 {
+		for iNdEx := len(m.MarketFeeMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketFeeMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.ConditionalDerivativeOrderbooks) > 0 {
+		for iNdEx := len(m.ConditionalDerivativeOrderbooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConditionalDerivativeOrderbooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.DenomDecimals) > 0 {
+		for iNdEx := len(m.DenomDecimals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomDecimals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.SpotMarketIdsScheduledToForceClose) > 0 {
+		for iNdEx := len(m.SpotMarketIdsScheduledToForceClose) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SpotMarketIdsScheduledToForceClose[iNdEx])
+			copy(dAtA[i:], m.SpotMarketIdsScheduledToForceClose[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.SpotMarketIdsScheduledToForceClose[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.BinaryOptionsMarketIdsScheduledForSettlement) > 0 {
+		for iNdEx := len(m.BinaryOptionsMarketIdsScheduledForSettlement) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BinaryOptionsMarketIdsScheduledForSettlement[iNdEx])
+			copy(dAtA[i:], m.BinaryOptionsMarketIdsScheduledForSettlement[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BinaryOptionsMarketIdsScheduledForSettlement[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.BinaryOptionsMarkets) > 0 {
+		for iNdEx := len(m.BinaryOptionsMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BinaryOptionsMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.HistoricalTradeRecords) > 0 {
+		for iNdEx := len(m.HistoricalTradeRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoricalTradeRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.RewardsOptOutAddresses) > 0 {
+		for iNdEx := len(m.RewardsOptOutAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RewardsOptOutAddresses[iNdEx])
+			copy(dAtA[i:], m.RewardsOptOutAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RewardsOptOutAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.PendingTradingRewardCampaignAccountPoints) > 0 {
+		for iNdEx := len(m.PendingTradingRewardCampaignAccountPoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingTradingRewardCampaignAccountPoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.PendingTradingRewardPoolCampaignSchedule) > 0 {
+		for iNdEx := len(m.PendingTradingRewardPoolCampaignSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingTradingRewardPoolCampaignSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.IsFirstFeeCycleFinished {
+		i--
+		if m.IsFirstFeeCycleFinished {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.FeeDiscountBucketVolumeAccounts) > 0 {
+		for iNdEx := len(m.FeeDiscountBucketVolumeAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDiscountBucketVolumeAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
 			i--
 			dAtA[i] = 0xa2
 		}
@@ -1686,7 +2184,7 @@ func (m *OrderbookSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *FeeDiscountAccountTierTTL) Marshal() (dAtA []byte, err error) {
+func (m *OrderInsertionSequence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1696,23 +2194,58 @@ func (m *FeeDiscountAccountTierTTL) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FeeDiscountAccountTierTTL) MarshalTo(dAtA []byte) (int, error) {
+func (m *OrderInsertionSequence) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FeeDiscountAccountTierTTL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OrderInsertionSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TierTtl != nil {
-		{
-			size, err := m.TierTtl.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OrderHash) > 0 {
+		i -= len(m.OrderHash)
+		copy(dAtA[i:], m.OrderHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OrderHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDiscountAccountTierTTL) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDiscountAccountTierTTL) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDiscountAccountTierTTL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TierTtl != nil {
+		{
+			size, err := m.TierTtl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
@@ -2512,6 +3045,105 @@ func (m *GenesisState) Size() (n int) {
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SpotIcebergRefills) > 0 {
+		for _, e := range m.SpotIcebergRefills {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DerivativeIcebergRefills) > 0 {
+		for _, e := range m.DerivativeIcebergRefills {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TwapOrders) > 0 {
+		for _, e := range m.TwapOrders {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MarketTradeRecordRetentions) > 0 {
+		for _, e := range m.MarketTradeRecordRetentions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OrderInsertionSequences) > 0 {
+		for _, e := range m.OrderInsertionSequences {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TerminalOrders) > 0 {
+		for _, e := range m.TerminalOrders {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LimitOrderFills) > 0 {
+		for _, e := range m.LimitOrderFills {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SubaccountPnls) > 0 {
+		for _, e := range m.SubaccountPnls {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VanillaOptionsMarkets) > 0 {
+		for _, e := range m.VanillaOptionsMarkets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CategoricalMarkets) > 0 {
+		for _, e := range m.CategoricalMarkets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExpiryFuturesMarketSeries) > 0 {
+		for _, e := range m.ExpiryFuturesMarketSeries {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExpiryFuturesAutoRolls) > 0 {
+		for _, e := range m.ExpiryFuturesAutoRolls {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PreLaunchPerpetualMarketInfos) > 0 {
+		for _, e := range m.PreLaunchPerpetualMarketInfos {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RfqRequests) > 0 {
+		for _, e := range m.RfqRequests {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextRfqRequestId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextRfqRequestId))
+	}
+	if len(m.SignedOrderNonces) > 0 {
+		for _, e := range m.SignedOrderNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SignedOrderMinNonces) > 0 {
+		for _, e := range m.SignedOrderMinNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2531,6 +3163,22 @@ func (m *OrderbookSequence) Size() (n int) {
 	return n
 }
 
+func (m *OrderInsertionSequence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	return n
+}
+
 func (m *FeeDiscountAccountTierTTL) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2805,25 +3453,472 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpotMarkets = append(m.SpotMarkets, &SpotMarket{})
+			if err := m.SpotMarkets[len(m.SpotMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivativeMarkets = append(m.DerivativeMarkets, &DerivativeMarket{})
+			if err := m.DerivativeMarkets[len(m.DerivativeMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotOrderbook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpotOrderbook = append(m.SpotOrderbook, SpotOrderBook{})
+			if err := m.SpotOrderbook[len(m.SpotOrderbook)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeOrderbook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivativeOrderbook = append(m.DerivativeOrderbook, DerivativeOrderBook{})
+			if err := m.DerivativeOrderbook[len(m.DerivativeOrderbook)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, Balance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, DerivativePosition{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountTradeNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubaccountTradeNonces = append(m.SubaccountTradeNonces, SubaccountNonce{})
+			if err := m.SubaccountTradeNonces[len(m.SubaccountTradeNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryFuturesMarketInfoState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiryFuturesMarketInfoState = append(m.ExpiryFuturesMarketInfoState, ExpiryFuturesMarketInfoState{})
+			if err := m.ExpiryFuturesMarketInfoState[len(m.ExpiryFuturesMarketInfoState)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualMarketInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerpetualMarketInfo = append(m.PerpetualMarketInfo, PerpetualMarketInfo{})
+			if err := m.PerpetualMarketInfo[len(m.PerpetualMarketInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualMarketFundingState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerpetualMarketFundingState = append(m.PerpetualMarketFundingState, PerpetualMarketFundingState{})
+			if err := m.PerpetualMarketFundingState[len(m.PerpetualMarketFundingState)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeMarketSettlementScheduled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivativeMarketSettlementScheduled = append(m.DerivativeMarketSettlementScheduled, DerivativeMarketSettlementInfo{})
+			if err := m.DerivativeMarketSettlementScheduled[len(m.DerivativeMarketSettlementScheduled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSpotExchangeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSpotExchangeEnabled = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDerivativesExchangeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.IsDerivativesExchangeEnabled = bool(v != 0)
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingRewardCampaignInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2850,13 +3945,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TradingRewardCampaignInfo == nil {
+				m.TradingRewardCampaignInfo = &TradingRewardCampaignInfo{}
+			}
+			if err := m.TradingRewardCampaignInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotMarkets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingRewardPoolCampaignSchedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2883,14 +3981,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpotMarkets = append(m.SpotMarkets, &SpotMarket{})
-			if err := m.SpotMarkets[len(m.SpotMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TradingRewardPoolCampaignSchedule = append(m.TradingRewardPoolCampaignSchedule, &CampaignRewardPool{})
+			if err := m.TradingRewardPoolCampaignSchedule[len(m.TradingRewardPoolCampaignSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeMarkets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingRewardCampaignAccountPoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2917,14 +4015,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DerivativeMarkets = append(m.DerivativeMarkets, &DerivativeMarket{})
-			if err := m.DerivativeMarkets[len(m.DerivativeMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TradingRewardCampaignAccountPoints = append(m.TradingRewardCampaignAccountPoints, &TradingRewardCampaignAccountPoints{})
+			if err := m.TradingRewardCampaignAccountPoints[len(m.TradingRewardCampaignAccountPoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotOrderbook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDiscountSchedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2951,14 +4049,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpotOrderbook = append(m.SpotOrderbook, SpotOrderBook{})
-			if err := m.SpotOrderbook[len(m.SpotOrderbook)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FeeDiscountSchedule == nil {
+				m.FeeDiscountSchedule = &FeeDiscountSchedule{}
+			}
+			if err := m.FeeDiscountSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeOrderbook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDiscountAccountTierTtl", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2985,14 +4085,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DerivativeOrderbook = append(m.DerivativeOrderbook, DerivativeOrderBook{})
-			if err := m.DerivativeOrderbook[len(m.DerivativeOrderbook)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeeDiscountAccountTierTtl = append(m.FeeDiscountAccountTierTtl, &FeeDiscountAccountTierTTL{})
+			if err := m.FeeDiscountAccountTierTtl[len(m.FeeDiscountAccountTierTtl)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDiscountBucketVolumeAccounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3019,14 +4119,34 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, Balance{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeeDiscountBucketVolumeAccounts = append(m.FeeDiscountBucketVolumeAccounts, &FeeDiscountBucketVolumeAccounts{})
+			if err := m.FeeDiscountBucketVolumeAccounts[len(m.FeeDiscountBucketVolumeAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFirstFeeCycleFinished", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFirstFeeCycleFinished = bool(v != 0)
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTradingRewardPoolCampaignSchedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3053,14 +4173,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Positions = append(m.Positions, DerivativePosition{})
-			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingTradingRewardPoolCampaignSchedule = append(m.PendingTradingRewardPoolCampaignSchedule, &CampaignRewardPool{})
+			if err := m.PendingTradingRewardPoolCampaignSchedule[len(m.PendingTradingRewardPoolCampaignSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountTradeNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTradingRewardCampaignAccountPoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3087,16 +4207,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubaccountTradeNonces = append(m.SubaccountTradeNonces, SubaccountNonce{})
-			if err := m.SubaccountTradeNonces[len(m.SubaccountTradeNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingTradingRewardCampaignAccountPoints = append(m.PendingTradingRewardCampaignAccountPoints, &TradingRewardCampaignAccountPendingPoints{})
+			if err := m.PendingTradingRewardCampaignAccountPoints[len(m.PendingTradingRewardCampaignAccountPoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryFuturesMarketInfoState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsOptOutAddresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3106,29 +4226,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpiryFuturesMarketInfoState = append(m.ExpiryFuturesMarketInfoState, ExpiryFuturesMarketInfoState{})
-			if err := m.ExpiryFuturesMarketInfoState[len(m.ExpiryFuturesMarketInfoState)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RewardsOptOutAddresses = append(m.RewardsOptOutAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 10:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualMarketInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalTradeRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3155,14 +4273,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PerpetualMarketInfo = append(m.PerpetualMarketInfo, PerpetualMarketInfo{})
-			if err := m.PerpetualMarketInfo[len(m.PerpetualMarketInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HistoricalTradeRecords = append(m.HistoricalTradeRecords, &TradeRecords{})
+			if err := m.HistoricalTradeRecords[len(m.HistoricalTradeRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualMarketFundingState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryOptionsMarkets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3189,16 +4307,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PerpetualMarketFundingState = append(m.PerpetualMarketFundingState, PerpetualMarketFundingState{})
-			if err := m.PerpetualMarketFundingState[len(m.PerpetualMarketFundingState)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BinaryOptionsMarkets = append(m.BinaryOptionsMarkets, &BinaryOptionsMarket{})
+			if err := m.BinaryOptionsMarkets[len(m.BinaryOptionsMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeMarketSettlementScheduled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryOptionsMarketIdsScheduledForSettlement", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3208,31 +4326,61 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DerivativeMarketSettlementScheduled = append(m.DerivativeMarketSettlementScheduled, DerivativeMarketSettlementInfo{})
-			if err := m.DerivativeMarketSettlementScheduled[len(m.DerivativeMarketSettlementScheduled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BinaryOptionsMarketIdsScheduledForSettlement = append(m.BinaryOptionsMarketIdsScheduledForSettlement, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotMarketIdsScheduledToForceClose", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.SpotMarketIdsScheduledToForceClose = append(m.SpotMarketIdsScheduledToForceClose, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsSpotExchangeEnabled", wireType)
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomDecimals", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3242,17 +4390,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsSpotExchangeEnabled = bool(v != 0)
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsDerivativesExchangeEnabled", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomDecimals = append(m.DenomDecimals, DenomDecimals{})
+			if err := m.DenomDecimals[len(m.DenomDecimals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalDerivativeOrderbooks", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3262,15 +4424,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsDerivativesExchangeEnabled = bool(v != 0)
-		case 15:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConditionalDerivativeOrderbooks = append(m.ConditionalDerivativeOrderbooks, &ConditionalDerivativeOrderBook{})
+			if err := m.ConditionalDerivativeOrderbooks[len(m.ConditionalDerivativeOrderbooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradingRewardCampaignInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketFeeMultipliers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3297,16 +4473,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TradingRewardCampaignInfo == nil {
-				m.TradingRewardCampaignInfo = &TradingRewardCampaignInfo{}
-			}
-			if err := m.TradingRewardCampaignInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MarketFeeMultipliers = append(m.MarketFeeMultipliers, &MarketFeeMultiplier{})
+			if err := m.MarketFeeMultipliers[len(m.MarketFeeMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradingRewardPoolCampaignSchedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderbookSequences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3333,14 +4507,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TradingRewardPoolCampaignSchedule = append(m.TradingRewardPoolCampaignSchedule, &CampaignRewardPool{})
-			if err := m.TradingRewardPoolCampaignSchedule[len(m.TradingRewardPoolCampaignSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OrderbookSequences = append(m.OrderbookSequences, &OrderbookSequence{})
+			if err := m.OrderbookSequences[len(m.OrderbookSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradingRewardCampaignAccountPoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountVolumes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3367,14 +4541,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TradingRewardCampaignAccountPoints = append(m.TradingRewardCampaignAccountPoints, &TradingRewardCampaignAccountPoints{})
-			if err := m.TradingRewardCampaignAccountPoints[len(m.TradingRewardCampaignAccountPoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SubaccountVolumes = append(m.SubaccountVolumes, &AggregateSubaccountVolumeRecord{})
+			if err := m.SubaccountVolumes[len(m.SubaccountVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeDiscountSchedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketVolumes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3401,16 +4575,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FeeDiscountSchedule == nil {
-				m.FeeDiscountSchedule = &FeeDiscountSchedule{}
-			}
-			if err := m.FeeDiscountSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MarketVolumes = append(m.MarketVolumes, &MarketVolume{})
+			if err := m.MarketVolumes[len(m.MarketVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeDiscountAccountTierTtl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotIcebergRefills", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3437,14 +4609,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeDiscountAccountTierTtl = append(m.FeeDiscountAccountTierTtl, &FeeDiscountAccountTierTTL{})
-			if err := m.FeeDiscountAccountTierTtl[len(m.FeeDiscountAccountTierTtl)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SpotIcebergRefills = append(m.SpotIcebergRefills, SpotOrderBook{})
+			if err := m.SpotIcebergRefills[len(m.SpotIcebergRefills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeDiscountBucketVolumeAccounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeIcebergRefills", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3471,16 +4643,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeDiscountBucketVolumeAccounts = append(m.FeeDiscountBucketVolumeAccounts, &FeeDiscountBucketVolumeAccounts{})
-			if err := m.FeeDiscountBucketVolumeAccounts[len(m.FeeDiscountBucketVolumeAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DerivativeIcebergRefills = append(m.DerivativeIcebergRefills, DerivativeOrderBook{})
+			if err := m.DerivativeIcebergRefills[len(m.DerivativeIcebergRefills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFirstFeeCycleFinished", wireType)
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapOrders", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3490,15 +4662,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsFirstFeeCycleFinished = bool(v != 0)
-		case 22:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TwapOrders = append(m.TwapOrders, &TWAPOrder{})
+			if err := m.TwapOrders[len(m.TwapOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingTradingRewardPoolCampaignSchedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketTradeRecordRetentions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3525,14 +4711,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingTradingRewardPoolCampaignSchedule = append(m.PendingTradingRewardPoolCampaignSchedule, &CampaignRewardPool{})
-			if err := m.PendingTradingRewardPoolCampaignSchedule[len(m.PendingTradingRewardPoolCampaignSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MarketTradeRecordRetentions = append(m.MarketTradeRecordRetentions, &MarketTradeRecordRetention{})
+			if err := m.MarketTradeRecordRetentions[len(m.MarketTradeRecordRetentions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingTradingRewardCampaignAccountPoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderInsertionSequences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3559,16 +4745,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingTradingRewardCampaignAccountPoints = append(m.PendingTradingRewardCampaignAccountPoints, &TradingRewardCampaignAccountPendingPoints{})
-			if err := m.PendingTradingRewardCampaignAccountPoints[len(m.PendingTradingRewardCampaignAccountPoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OrderInsertionSequences = append(m.OrderInsertionSequences, &OrderInsertionSequence{})
+			if err := m.OrderInsertionSequences[len(m.OrderInsertionSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsOptOutAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminalOrders", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3578,27 +4764,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardsOptOutAddresses = append(m.RewardsOptOutAddresses, string(dAtA[iNdEx:postIndex]))
+			m.TerminalOrders = append(m.TerminalOrders, &TerminalOrder{})
+			if err := m.TerminalOrders[len(m.TerminalOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 25:
+		case 41:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalTradeRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderFills", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3625,14 +4813,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HistoricalTradeRecords = append(m.HistoricalTradeRecords, &TradeRecords{})
-			if err := m.HistoricalTradeRecords[len(m.HistoricalTradeRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LimitOrderFills = append(m.LimitOrderFills, &LimitOrderFill{})
+			if err := m.LimitOrderFills[len(m.LimitOrderFills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryOptionsMarkets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountPnls", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3659,16 +4847,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BinaryOptionsMarkets = append(m.BinaryOptionsMarkets, &BinaryOptionsMarket{})
-			if err := m.BinaryOptionsMarkets[len(m.BinaryOptionsMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SubaccountPnls = append(m.SubaccountPnls, &SubaccountPnl{})
+			if err := m.SubaccountPnls[len(m.SubaccountPnls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 27:
+		case 43:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryOptionsMarketIdsScheduledForSettlement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VanillaOptionsMarkets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3678,29 +4866,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BinaryOptionsMarketIdsScheduledForSettlement = append(m.BinaryOptionsMarketIdsScheduledForSettlement, string(dAtA[iNdEx:postIndex]))
+			m.VanillaOptionsMarkets = append(m.VanillaOptionsMarkets, &VanillaOptionsMarket{})
+			if err := m.VanillaOptionsMarkets[len(m.VanillaOptionsMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 28:
+		case 44:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotMarketIdsScheduledToForceClose", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoricalMarkets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3710,27 +4900,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpotMarketIdsScheduledToForceClose = append(m.SpotMarketIdsScheduledToForceClose, string(dAtA[iNdEx:postIndex]))
+			m.CategoricalMarkets = append(m.CategoricalMarkets, &CategoricalMarket{})
+			if err := m.CategoricalMarkets[len(m.CategoricalMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 29:
+		case 45:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomDecimals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryFuturesMarketSeries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3757,14 +4949,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomDecimals = append(m.DenomDecimals, DenomDecimals{})
-			if err := m.DenomDecimals[len(m.DenomDecimals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExpiryFuturesMarketSeries = append(m.ExpiryFuturesMarketSeries, &ExpiryFuturesMarketSeries{})
+			if err := m.ExpiryFuturesMarketSeries[len(m.ExpiryFuturesMarketSeries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 30:
+		case 46:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalDerivativeOrderbooks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryFuturesAutoRolls", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3791,14 +4983,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConditionalDerivativeOrderbooks = append(m.ConditionalDerivativeOrderbooks, &ConditionalDerivativeOrderBook{})
-			if err := m.ConditionalDerivativeOrderbooks[len(m.ConditionalDerivativeOrderbooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExpiryFuturesAutoRolls = append(m.ExpiryFuturesAutoRolls, &ExpiryFuturesAutoRoll{})
+			if err := m.ExpiryFuturesAutoRolls[len(m.ExpiryFuturesAutoRolls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 31:
+		case 47:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketFeeMultipliers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreLaunchPerpetualMarketInfos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3825,14 +5017,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketFeeMultipliers = append(m.MarketFeeMultipliers, &MarketFeeMultiplier{})
-			if err := m.MarketFeeMultipliers[len(m.MarketFeeMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PreLaunchPerpetualMarketInfos = append(m.PreLaunchPerpetualMarketInfos, PreLaunchPerpetualMarketInfo{})
+			if err := m.PreLaunchPerpetualMarketInfos[len(m.PreLaunchPerpetualMarketInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 32:
+		case 48:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderbookSequences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RfqRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3859,14 +5051,33 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderbookSequences = append(m.OrderbookSequences, &OrderbookSequence{})
-			if err := m.OrderbookSequences[len(m.OrderbookSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RfqRequests = append(m.RfqRequests, &RFQRequest{})
+			if err := m.RfqRequests[len(m.RfqRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 33:
+		case 49:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRfqRequestId", wireType)
+			}
+			m.NextRfqRequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRfqRequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 50:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountVolumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedOrderNonces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3893,14 +5104,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubaccountVolumes = append(m.SubaccountVolumes, &AggregateSubaccountVolumeRecord{})
-			if err := m.SubaccountVolumes[len(m.SubaccountVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SignedOrderNonces = append(m.SignedOrderNonces, SignedOrderNonce{})
+			if err := m.SignedOrderNonces[len(m.SignedOrderNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 34:
+		case 51:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketVolumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedOrderMinNonces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3927,8 +5138,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketVolumes = append(m.MarketVolumes, &MarketVolume{})
-			if err := m.MarketVolumes[len(m.MarketVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SignedOrderMinNonces = append(m.SignedOrderMinNonces, SignedOrderMinNonce{})
+			if err := m.SignedOrderMinNonces[len(m.SignedOrderMinNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4054,6 +5265,107 @@ func (m *OrderbookSequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OrderInsertionSequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderInsertionSequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderInsertionSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *FeeDiscountAccountTierTTL) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
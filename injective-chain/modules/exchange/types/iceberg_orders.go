@@ -0,0 +1,159 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (o *SpotOrder) IsIceberg() bool {
+	return o.VisibleQuantity != nil
+}
+
+func (o *DerivativeOrder) IsIceberg() bool {
+	return o.VisibleQuantity != nil
+}
+
+func (o *SpotLimitOrder) IsIceberg() bool {
+	return o.Iceberg != nil
+}
+
+func (o *DerivativeLimitOrder) IsIceberg() bool {
+	return o.Iceberg != nil
+}
+
+// HasHiddenQuantity returns true if the order is an iceberg order which still has a hidden reserve to be displayed
+func (o *SpotLimitOrder) HasHiddenQuantity() bool {
+	return o.IsIceberg() && o.Iceberg.HiddenQuantity.IsPositive()
+}
+
+// HasHiddenQuantity returns true if the order is an iceberg order which still has a hidden reserve to be displayed
+func (o *DerivativeLimitOrder) HasHiddenQuantity() bool {
+	return o.IsIceberg() && o.Iceberg.HiddenQuantity.IsPositive()
+}
+
+// GetHiddenBalanceHold returns the balance held for the hidden reserve of an iceberg order, zero for regular orders
+func (o *SpotLimitOrder) GetHiddenBalanceHold() sdk.Dec {
+	if !o.IsIceberg() {
+		return sdk.ZeroDec()
+	}
+	return o.Iceberg.HiddenBalanceHold
+}
+
+// GetHiddenBalanceHold returns the balance held for the hidden reserve of an iceberg order, zero for regular orders
+func (o *DerivativeLimitOrder) GetHiddenBalanceHold() sdk.Dec {
+	if !o.IsIceberg() {
+		return sdk.ZeroDec()
+	}
+	return o.Iceberg.HiddenBalanceHold
+}
+
+func validateIcebergVisibleQuantity(visibleQuantity *sdk.Dec, orderType OrderType, quantity sdk.Dec) error {
+	if visibleQuantity == nil {
+		return nil
+	}
+
+	if orderType != OrderType_BUY && orderType != OrderType_SELL {
+		return sdkerrors.Wrapf(ErrInvalidIcebergOrder, "iceberg orders must be regular limit buy or sell orders, got %s", orderType.String())
+	}
+
+	if visibleQuantity.IsNil() || !visibleQuantity.IsPositive() || visibleQuantity.GTE(quantity) {
+		return sdkerrors.Wrapf(ErrInvalidIcebergOrder, "visible quantity must be positive and less than the order quantity %s", quantity.String())
+	}
+	return nil
+}
+
+func checkIcebergVisibleQuantityTickSize(visibleQuantity *sdk.Dec, minQuantityTickSize sdk.Dec) error {
+	if visibleQuantity != nil && BreachesMinimumTickSize(*visibleQuantity, minQuantityTickSize) {
+		return sdkerrors.Wrapf(ErrInvalidIcebergOrder, "visible quantity %s must be a multiple of the minimum quantity tick size %s", visibleQuantity.String(), minQuantityTickSize.String())
+	}
+	return nil
+}
+
+// ApplyIcebergSlice reduces a new iceberg order to its visible slice and moves the remaining quantity together
+// with its share of the balance hold into the hidden reserve.
+func (o *SpotLimitOrder) ApplyIcebergSlice(visibleQuantity, balanceHold sdk.Dec) {
+	quantity := o.OrderInfo.Quantity
+	hiddenQuantity := quantity.Sub(visibleQuantity)
+
+	o.Iceberg = &IcebergOrderInfo{
+		VisibleQuantity:   visibleQuantity,
+		HiddenQuantity:    hiddenQuantity,
+		HiddenMargin:      sdk.ZeroDec(),
+		HiddenBalanceHold: balanceHold.Sub(balanceHold.Mul(visibleQuantity).Quo(quantity)),
+	}
+	o.OrderInfo.Quantity = visibleQuantity
+	o.Fillable = visibleQuantity
+}
+
+// GetIcebergVisibleMargin returns the margin assigned to the visible slice of an iceberg order, the margin being split
+// pro rata between the visible and the hidden quantity.
+func (o *DerivativeOrder) GetIcebergVisibleMargin() (sdk.Dec, error) {
+	visibleMargin := o.Margin.Mul(*o.VisibleQuantity).Quo(o.OrderInfo.Quantity)
+
+	if !o.Margin.Sub(visibleMargin).IsPositive() {
+		return sdk.Dec{}, sdkerrors.Wrapf(ErrInvalidIcebergOrder, "margin %s is too small to be split between the visible and the hidden quantity", o.Margin.String())
+	}
+	return visibleMargin, nil
+}
+
+// ApplyIcebergSlice reduces a new iceberg order to its visible slice and moves the remaining quantity together
+// with its share of the margin and of the margin hold into the hidden reserve.
+func (o *DerivativeLimitOrder) ApplyIcebergSlice(visibleQuantity, visibleMargin, marginHold sdk.Dec) {
+	quantity := o.OrderInfo.Quantity
+	hiddenQuantity := quantity.Sub(visibleQuantity)
+
+	// MarginHold = Margin + Fee, the fee part being proportional to the quantity
+	feeHold := marginHold.Sub(o.Margin)
+	visibleHold := visibleMargin.Add(feeHold.Mul(visibleQuantity).Quo(quantity))
+
+	o.Iceberg = &IcebergOrderInfo{
+		VisibleQuantity:   visibleQuantity,
+		HiddenQuantity:    hiddenQuantity,
+		HiddenMargin:      o.Margin.Sub(visibleMargin),
+		HiddenBalanceHold: marginHold.Sub(visibleHold),
+	}
+	o.OrderInfo.Quantity = visibleQuantity
+	o.Margin = visibleMargin
+	o.Fillable = visibleQuantity
+}
+
+func getIcebergRefillVisibleQuantity(iceberg *IcebergOrderInfo) *sdk.Dec {
+	// the last slice is a regular order
+	if iceberg.HiddenQuantity.LTE(iceberg.VisibleQuantity) {
+		return nil
+	}
+	visibleQuantity := iceberg.VisibleQuantity
+	return &visibleQuantity
+}
+
+// GetIcebergRefillOrder returns the order replenishing an exhausted iceberg order from its hidden reserve
+func (o *SpotLimitOrder) GetIcebergRefillOrder(marketID common.Hash) *SpotOrder {
+	return &SpotOrder{
+		MarketId: marketID.Hex(),
+		OrderInfo: OrderInfo{
+			SubaccountId: o.OrderInfo.SubaccountId,
+			FeeRecipient: o.OrderInfo.FeeRecipient,
+			Price:        o.OrderInfo.Price,
+			Quantity:     o.Iceberg.HiddenQuantity,
+		},
+		OrderType:       o.OrderType,
+		VisibleQuantity: getIcebergRefillVisibleQuantity(o.Iceberg),
+	}
+}
+
+// GetIcebergRefillOrder returns the order replenishing an exhausted iceberg order from its hidden reserve
+func (o *DerivativeLimitOrder) GetIcebergRefillOrder(marketID common.Hash) *DerivativeOrder {
+	return &DerivativeOrder{
+		MarketId: marketID.Hex(),
+		OrderInfo: OrderInfo{
+			SubaccountId: o.OrderInfo.SubaccountId,
+			FeeRecipient: o.OrderInfo.FeeRecipient,
+			Price:        o.OrderInfo.Price,
+			Quantity:     o.Iceberg.HiddenQuantity,
+		},
+		OrderType:       o.OrderType,
+		Margin:          o.Iceberg.HiddenMargin,
+		VisibleQuantity: getIcebergRefillVisibleQuantity(o.Iceberg),
+	}
+}
@@ -33,7 +33,6 @@ var (
 	SubaccountLimitOrderIndicatorPrefix  = []byte{0x06} // prefix for each key to a Subaccount limit order indicator
 	SpotExchangeEnabledKey               = []byte{0x07} // key for whether spot exchange is enabled
 	DerivativeExchangeEnabledKey         = []byte{0x08} // key for whether derivative exchange is enabled
-	MarketHistoricalTradeRecordsPrefix   = []byte{0x09} // prefix for each key to a market's historical trade records
 	OrderbookSequencePrefix              = []byte{0x0a} // prefix for each key to a market's orderbook sequence
 	SubaccountMarketVolumePrefix         = []byte{0x0b} // prefix for each key to the aggregate volume for a subaccount in a market
 	MarketVolumePrefix                   = []byte{0x0c} // prefix for each key to the aggregate volume for a market
@@ -47,6 +46,7 @@ var (
 	SpotMarketParamUpdateScheduleKey = []byte{0x16} // prefix for a key to save scheduled spot market params update
 	SpotMarketForceCloseInfoKey      = []byte{0x17} // prefix for a key to save scheduled spot market closures
 	SpotOrderbookLevelsPrefix        = []byte{0x18} // prefix for each key to the spot orderbook for a given marketID and direction
+	SpotIcebergRefillsPrefix         = []byte{0x19} // prefix for each key to an exhausted spot iceberg order awaiting refill, by (marketID, direction, subaccountID, order hash)
 
 	DerivativeMarketPrefix                     = []byte{0x21} // prefix for each key to a derivative market by (isEnabled, marketID)
 	DerivativeLimitOrdersPrefix                = []byte{0x22} // prefix for each key to a derivative limit order, by (marketID, direction, price level, order hash)
@@ -59,6 +59,7 @@ var (
 	DerivativeMarketScheduledSettlementInfo    = []byte{0x29} // prefix for a key to save scheduled derivative market settlements
 	DerivativePositionModifiedSubaccountPrefix = []byte{0x2a} // prefix for a key to save a list of subaccountIDs by marketID
 	DerivativeOrderbookLevelsPrefix            = []byte{0x2b} // prefix for each key to the derivative orderbook for a given marketID and direction
+	DerivativeIcebergRefillsPrefix             = []byte{0x2c} // prefix for each key to an exhausted derivative iceberg order awaiting refill, by (marketID, direction, subaccountID, order hash)
 
 	PerpetualMarketFundingPrefix             = []byte{0x31} // prefix for each key to a perpetual market's funding state
 	PerpetualMarketInfoPrefix                = []byte{0x32} // prefix for each key to a perpetual market's market info
@@ -108,6 +109,48 @@ var (
 	ConditionalOrderInvalidationFlagPrefix       = []byte{0x78} // prefix for a key to save flags to invalidate conditional orders
 
 	AtomicMarketOrderTakerFeeMultiplierKey = []byte{0x79} // key to store individual market atomic take fee multiplier
+
+	TWAPOrdersPrefix      = []byte{0x7a} // prefix for a key to save TWAP orders: marketID + subaccountID + orderHash ⇒ twapOrder
+	TWAPChildOrdersPrefix = []byte{0x7b} // prefix for a transient key to save the TWAP order of a child order: childOrderHash ⇒ marketID + subaccountID + orderHash
+
+	TradeRecordRetentionPrefix = []byte{0x7c} // prefix for a key to save the trade record retention of a market: marketID ⇒ retention
+
+	OrderInsertionSequencePrefix = []byte{0x7d} // prefix for a key to save the orderbook sequence at which a limit order entered the orderbook: orderHash ⇒ sequence
+
+	LimitOrderFillPrefix             = []byte{0x7e} // prefix for a key to save the aggregated fills of a resting limit order: orderHash ⇒ limitOrderFill
+	TerminalOrdersPrefix             = []byte{0x7f} // prefix for a key to save the terminal state of a limit order which left the orderbook: orderHash ⇒ terminalOrder
+	TerminalOrdersBySubaccountPrefix = []byte{0x80} // prefix for a key to index the terminal orders of a subaccount: subaccountID + blockHeight + orderHash
+	TerminalOrdersByHeightPrefix     = []byte{0x81} // prefix for a key to index the terminal orders by the height at which they left the orderbook: blockHeight + orderHash
+
+	SubaccountPnlPrefix = []byte{0x82} // prefix for a key to save the realized PnL, fees and funding ledger of a subaccount in a market: subaccountID + marketID ⇒ subaccountPnl
+
+	SpotQuoteMarketOrdersPrefix = []byte{0x83} // prefix for a transient key to save the spot quote market orders: marketID + orderHash ⇒ spotMarketOrder
+
+	VanillaOptionsMarketPrefix                    = []byte{0x84} // prefix for each key to a vanilla options market by (isEnabled, marketID)
+	VanillaOptionsMarketExpiryTimestampPrefix     = []byte{0x85} // prefix for each key to a vanilla options marketID by expiration timestamp
+	VanillaOptionsMarketSettlementTimestampPrefix = []byte{0x86} // prefix for each key to a vanilla options marketID by settlement timestamp
+	VanillaOptionsMarketParamUpdateSchedulePrefix = []byte{0x87} // prefix for a key to save scheduled vanilla options market params update
+
+	CategoricalMarketPrefix = []byte{0x88} // prefix for each key to a categorical market by marketID
+
+	ExpiryFuturesMarketSeriesPrefix = []byte{0x89} // prefix for each key to a rolling series of expiry futures markets by seriesID
+	ExpiryFuturesAutoRollPrefix     = []byte{0x8a} // prefix for a key to save the subaccounts rolling their positions in a series: seriesID + subaccountID
+
+	PreLaunchPerpetualMarketInfoPrefix = []byte{0x8b} // prefix for each key to the mark price state of a pre-launch perpetual market by marketID
+
+	RFQRequestPrefix         = []byte{0x8c} // prefix for each key to an RFQ request by requestID
+	NextRFQRequestIDKey      = []byte{0x8d} // key for the id of the next RFQ request
+	RFQRequestByExpiryPrefix = []byte{0x8e} // prefix for a key to index the RFQ requests by expiry: expiry + requestID
+
+	SignedOrderNoncePrefix         = []byte{0x8f} // prefix for a key to save the nonces used by signed orders: subaccountID + nonce ⇒ signedOrderNonce
+	SignedOrderNonceByExpiryPrefix = []byte{0x90} // prefix for a key to index the used signed order nonces by expiry: expiry + subaccountID + nonce
+	SignedOrderMinNoncePrefix      = []byte{0x91} // prefix for a key to save the min nonce of the signed orders of a subaccount: subaccountID ⇒ minNonce
+
+	MarketTradeRecordPrefix = []byte{0x92} // prefix for a key to save a historical trade record of a market: marketID + timestamp ⇒ tradeRecord
+
+	// LegacyMarketHistoricalTradeRecordsPrefix is the prefix under which all the trade records of a market were stored
+	// together before they were stored per timestamp, only kept to migrate them on upgrade
+	LegacyMarketHistoricalTradeRecordsPrefix = []byte{0x09}
 )
 
 // GetFeeDiscountAccountVolumeInBucketKey provides the key for the account's volume in the given bucket
@@ -371,6 +414,14 @@ func GetBinaryOptionsMarketKey(isEnabled bool, marketID common.Hash) []byte {
 	return append(GetBinaryOptionsMarketPrefix(isEnabled), marketID.Bytes()...)
 }
 
+func GetVanillaOptionsMarketPrefix(isEnabled bool) []byte {
+	return append(VanillaOptionsMarketPrefix, getBoolPrefix(isEnabled)...)
+}
+
+func GetVanillaOptionsMarketKey(isEnabled bool, marketID common.Hash) []byte {
+	return append(GetVanillaOptionsMarketPrefix(isEnabled), marketID.Bytes()...)
+}
+
 func getBoolPrefix(isEnabled bool) []byte {
 	isEnabledByte := byte(0)
 	if isEnabled {
@@ -480,8 +531,20 @@ func GetBinaryOptionsMarketSettlementTimestampKey(timestamp int64, marketID comm
 	return append(BinaryOptionsMarketSettlementTimestampPrefix, append(sdk.Uint64ToBigEndian(uint64(timestamp)), marketID.Bytes()...)...)
 }
 
-func GetMarketHistoricalTradeRecordsKey(marketID common.Hash) []byte {
-	return append(MarketHistoricalTradeRecordsPrefix, marketID.Bytes()...)
+func GetVanillaOptionsMarketExpiryTimestampKey(timestamp int64, marketID common.Hash) []byte {
+	return append(VanillaOptionsMarketExpiryTimestampPrefix, append(sdk.Uint64ToBigEndian(uint64(timestamp)), marketID.Bytes()...)...)
+}
+
+func GetVanillaOptionsMarketSettlementTimestampKey(timestamp int64, marketID common.Hash) []byte {
+	return append(VanillaOptionsMarketSettlementTimestampPrefix, append(sdk.Uint64ToBigEndian(uint64(timestamp)), marketID.Bytes()...)...)
+}
+
+func GetMarketTradeRecordPrefix(marketID common.Hash) []byte {
+	return append(MarketTradeRecordPrefix, marketID.Bytes()...)
+}
+
+func GetMarketTradeRecordKey(marketID common.Hash, timestamp int64) []byte {
+	return append(GetMarketTradeRecordPrefix(marketID), sdk.Uint64ToBigEndian(uint64(timestamp))...)
 }
 
 func GetDenomDecimalsKey(denom string) []byte {
@@ -500,3 +563,43 @@ func GetDerivativeOrderbookLevelsKey(marketID common.Hash, isBuy bool) []byte {
 func GetDerivativeOrderbookLevelsForPriceKey(marketID common.Hash, isBuy bool, price sdk.Dec) []byte {
 	return append(GetDerivativeOrderbookLevelsKey(marketID, isBuy), GetPaddedPrice(price)...)
 }
+
+// GetTWAPOrderKey provides the key of a TWAP order within the TWAP orders store
+func GetTWAPOrderKey(marketID, subaccountID, orderHash common.Hash) []byte {
+	return append(MarketSubaccountInfix(marketID, subaccountID), orderHash.Bytes()...)
+}
+
+// GetTerminalOrderBySubaccountKey provides the key of a terminal order within the terminal orders by subaccount store
+func GetTerminalOrderBySubaccountKey(subaccountID common.Hash, blockHeight int64, orderHash common.Hash) []byte {
+	return append(append(subaccountID.Bytes(), sdk.Uint64ToBigEndian(uint64(blockHeight))...), orderHash.Bytes()...)
+}
+
+// GetTerminalOrderByHeightKey provides the key of a terminal order within the terminal orders by height store
+func GetTerminalOrderByHeightKey(blockHeight int64, orderHash common.Hash) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(blockHeight)), orderHash.Bytes()...)
+}
+
+// GetSubaccountPnlKey provides the key of the realized PnL, fees and funding ledger of a subaccount in a market
+func GetSubaccountPnlKey(subaccountID, marketID common.Hash) []byte {
+	return append(subaccountID.Bytes(), marketID.Bytes()...)
+}
+
+// GetExpiryFuturesAutoRollKey provides the key of a subaccount rolling its positions in a series of expiry futures markets
+func GetExpiryFuturesAutoRollKey(seriesID, subaccountID common.Hash) []byte {
+	return append(seriesID.Bytes(), subaccountID.Bytes()...)
+}
+
+// GetRFQRequestByExpiryKey provides the key of an RFQ request within the RFQ requests by expiry store
+func GetRFQRequestByExpiryKey(expiry int64, requestID uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(expiry)), sdk.Uint64ToBigEndian(requestID)...)
+}
+
+// GetSignedOrderNonceKey provides the key of a nonce used by a signed order within the signed order nonces store
+func GetSignedOrderNonceKey(subaccountID common.Hash, nonce uint64) []byte {
+	return append(subaccountID.Bytes(), sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// GetSignedOrderNonceByExpiryKey provides the key of a nonce used by a signed order within the nonces by expiry store
+func GetSignedOrderNonceByExpiryKey(expiry int64, subaccountID common.Hash, nonce uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(expiry)), GetSignedOrderNonceKey(subaccountID, nonce)...)
+}
@@ -0,0 +1,174 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MaxLadderOrderLevels is the maximum number of orders a ladder can be made of
+const MaxLadderOrderLevels = 100
+
+func (msg *MsgCreateLadderOrders) MarketID() common.Hash {
+	return common.HexToHash(msg.MarketId)
+}
+
+// GetPriceStep returns the price difference between two consecutive levels of the ladder, negative for descending ladders
+func (msg *MsgCreateLadderOrders) GetPriceStep() sdk.Dec {
+	return msg.EndPrice.Sub(msg.StartPrice).QuoInt64(int64(msg.Levels - 1))
+}
+
+// CheckPriceStep checks that every level of the ladder is on the minimum price tick size
+func (msg *MsgCreateLadderOrders) CheckPriceStep(minPriceTickSize sdk.Dec) error {
+	priceStep := msg.GetPriceStep()
+	if BreachesMinimumTickSize(msg.StartPrice, minPriceTickSize) || BreachesMinimumTickSize(priceStep.Abs(), minPriceTickSize) {
+		return sdkerrors.Wrapf(ErrInvalidLadderOrder, "start price %s and price step %s must be multiples of the minimum price tick size %s", msg.StartPrice.String(), priceStep.String(), minPriceTickSize.String())
+	}
+	return nil
+}
+
+// getLevelWeights returns the relative size of every level of the ladder
+func (msg *MsgCreateLadderOrders) getLevelWeights() ([]sdk.Dec, error) {
+	var (
+		weights   = make([]sdk.Dec, msg.Levels)
+		lastLevel = int64(msg.Levels - 1)
+		growth    sdk.Dec
+		err       error
+	)
+
+	if msg.SizeDistribution == LadderSizeDistribution_Exponential {
+		// the growth factor between two consecutive levels
+		if growth, err = msg.SizeRatio.ApproxRoot(uint64(lastLevel)); err != nil {
+			return nil, sdkerrors.Wrap(ErrInvalidLadderOrder, err.Error())
+		}
+	}
+
+	for idx := range weights {
+		switch msg.SizeDistribution {
+		case LadderSizeDistribution_Linear:
+			weights[idx] = sdk.OneDec().Add(msg.SizeRatio.Sub(sdk.OneDec()).MulInt64(int64(idx)).QuoInt64(lastLevel))
+		case LadderSizeDistribution_Exponential:
+			weights[idx] = growth.Power(uint64(idx))
+		default:
+			weights[idx] = sdk.OneDec()
+		}
+	}
+	return weights, nil
+}
+
+// GetLevelQuantities splits the quantity of the ladder between its levels according to the size distribution. The
+// quantity of every level is rounded down to the minimum quantity tick size, the last level taking whatever is left.
+func (msg *MsgCreateLadderOrders) GetLevelQuantities(minQuantityTickSize sdk.Dec) ([]sdk.Dec, error) {
+	if BreachesMinimumTickSize(msg.Quantity, minQuantityTickSize) {
+		return nil, sdkerrors.Wrapf(ErrInvalidQuantity, "quantity %s must be a multiple of the minimum quantity tick size %s", msg.Quantity.String(), minQuantityTickSize.String())
+	}
+
+	weights, err := msg.getLevelWeights()
+	if err != nil {
+		return nil, err
+	}
+
+	totalWeight := sdk.ZeroDec()
+	for _, weight := range weights {
+		totalWeight = totalWeight.Add(weight)
+	}
+
+	var (
+		quantities        = make([]sdk.Dec, msg.Levels)
+		remainingQuantity = msg.Quantity
+		lastLevel         = len(quantities) - 1
+	)
+
+	for idx := range quantities {
+		if idx == lastLevel {
+			quantities[idx] = remainingQuantity
+		} else {
+			quantities[idx] = RoundDownToTickSize(msg.Quantity.Mul(weights[idx]).Quo(totalWeight), minQuantityTickSize)
+			remainingQuantity = remainingQuantity.Sub(quantities[idx])
+		}
+
+		if !quantities[idx].IsPositive() {
+			return nil, sdkerrors.Wrapf(ErrInvalidLadderOrder, "quantity %s is too small to be split into %d levels of at least the minimum quantity tick size %s", msg.Quantity.String(), msg.Levels, minQuantityTickSize.String())
+		}
+	}
+	return quantities, nil
+}
+
+// GetLevelPrices returns the price of every level of the ladder
+func (msg *MsgCreateLadderOrders) GetLevelPrices() []sdk.Dec {
+	var (
+		prices    = make([]sdk.Dec, msg.Levels)
+		priceStep = msg.GetPriceStep()
+	)
+
+	for idx := range prices {
+		prices[idx] = msg.StartPrice.Add(priceStep.MulInt64(int64(idx)))
+	}
+	return prices
+}
+
+func (msg *MsgCreateLadderOrders) getLevelOrderInfo(price, quantity sdk.Dec) OrderInfo {
+	return OrderInfo{
+		SubaccountId: msg.SubaccountId,
+		FeeRecipient: msg.FeeRecipient,
+		Price:        price,
+		Quantity:     quantity,
+	}
+}
+
+// ToSpotOrders returns the spot limit orders of every level of the ladder
+func (msg *MsgCreateLadderOrders) ToSpotOrders(minQuantityTickSize sdk.Dec) ([]SpotOrder, error) {
+	quantities, err := msg.GetLevelQuantities(minQuantityTickSize)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := msg.GetLevelPrices()
+	orders := make([]SpotOrder, msg.Levels)
+
+	for idx := range orders {
+		orders[idx] = SpotOrder{
+			MarketId:  msg.MarketId,
+			OrderInfo: msg.getLevelOrderInfo(prices[idx], quantities[idx]),
+			OrderType: msg.OrderType,
+		}
+	}
+	return orders, nil
+}
+
+// ToDerivativeOrders returns the derivative limit orders of every level of the ladder. The margin is split between the
+// levels pro rata of their notional, the last level taking whatever is left.
+func (msg *MsgCreateLadderOrders) ToDerivativeOrders(minQuantityTickSize sdk.Dec) ([]DerivativeOrder, error) {
+	quantities, err := msg.GetLevelQuantities(minQuantityTickSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		prices          = msg.GetLevelPrices()
+		orders          = make([]DerivativeOrder, msg.Levels)
+		totalNotional   = sdk.ZeroDec()
+		remainingMargin = msg.Margin
+		lastLevel       = len(orders) - 1
+	)
+
+	for idx := range orders {
+		totalNotional = totalNotional.Add(prices[idx].Mul(quantities[idx]))
+	}
+
+	for idx := range orders {
+		margin := remainingMargin
+		if idx != lastLevel {
+			margin = msg.Margin.Mul(prices[idx].Mul(quantities[idx])).Quo(totalNotional)
+			remainingMargin = remainingMargin.Sub(margin)
+		}
+
+		orders[idx] = DerivativeOrder{
+			MarketId:  msg.MarketId,
+			OrderInfo: msg.getLevelOrderInfo(prices[idx], quantities[idx]),
+			OrderType: msg.OrderType,
+			Margin:    margin,
+		}
+	}
+	return orders, nil
+}
@@ -2,8 +2,11 @@ package types
 
 import (
 	"strconv"
+	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 
@@ -13,6 +16,12 @@ import (
 
 var BinaryOptionsMarketRefundFlagPrice = sdk.NewDec(-1)
 
+const expiryFuturesSeriesTickerDateLayout = "02Jan2006"
+
+// MaxExpiryFuturesSeriesLiveContracts is the maximum number of contracts of an expiry futures market series which are
+// live at once.
+const MaxExpiryFuturesSeriesLiveContracts = 12
+
 type DerivativeMarketInfo struct {
 	Market    *DerivativeMarket
 	MarkPrice sdk.Dec
@@ -51,6 +60,59 @@ func NewBinaryOptionsMarketID(ticker, quoteDenom, oracleSymbol, oracleProvider s
 	return crypto.Keccak256Hash([]byte((oracleType.String() + ticker + quoteDenom + oracleSymbol + oracleProvider)))
 }
 
+// NewCategoricalMarketID returns the ID of a categorical market, which can't collide with the ID of a binary options market
+// launched with the same parameters.
+func NewCategoricalMarketID(ticker, quoteDenom, oracleSymbol, oracleProvider string, oracleType oracletypes.OracleType) common.Hash {
+	quotePeggyDenom, err := peggytypes.NewPeggyDenomFromString(quoteDenom)
+	if err == nil {
+		quoteDenom = quotePeggyDenom.String()
+	}
+
+	return crypto.Keccak256Hash([]byte(("categorical" + oracleType.String() + ticker + quoteDenom + oracleSymbol + oracleProvider)))
+}
+
+// GetCategoricalOutcomeTicker returns the ticker of the binary options market of an outcome of a categorical market.
+func GetCategoricalOutcomeTicker(ticker, outcome string) string {
+	return ticker + "-" + outcome
+}
+
+// GetCategoricalOutcomeOracleSymbol returns the oracle symbol of the binary options market of an outcome of a
+// categorical market. It isn't used for the settlement of the outcome market, which is driven by the categorical market.
+func GetCategoricalOutcomeOracleSymbol(oracleSymbol, outcome string) string {
+	return oracleSymbol + "/" + outcome
+}
+
+// ValidateCategoricalOutcomes checks that a categorical market has between 2 and MaxCategoricalMarketOutcomes unique
+// outcomes, each giving a valid outcome market ticker.
+func ValidateCategoricalOutcomes(ticker string, outcomes []string) error {
+	if len(outcomes) < 2 || len(outcomes) > MaxCategoricalMarketOutcomes {
+		return sdkerrors.Wrapf(ErrInvalidCategoricalOutcomes, "number of outcomes must be between 2 and %d", MaxCategoricalMarketOutcomes)
+	}
+
+	seenOutcomes := make(map[string]struct{}, len(outcomes))
+	for _, outcome := range outcomes {
+		if outcome == "" {
+			return sdkerrors.Wrap(ErrInvalidCategoricalOutcomes, "outcome should not be empty")
+		}
+		if _, ok := seenOutcomes[outcome]; ok {
+			return sdkerrors.Wrapf(ErrInvalidCategoricalOutcomes, "duplicate outcome %s", outcome)
+		}
+		if len(GetCategoricalOutcomeTicker(ticker, outcome)) > MaxTickerLength {
+			return sdkerrors.Wrapf(ErrInvalidTicker, "outcome ticker of %s should not exceed %d characters", outcome, MaxTickerLength)
+		}
+		seenOutcomes[outcome] = struct{}{}
+	}
+	return nil
+}
+
+func NewVanillaOptionsMarketID(ticker, quoteDenom, oracleBase, oracleQuote string, oracleType oracletypes.OracleType, optionType OptionType, strikePrice sdk.Dec, expiry int64) common.Hash {
+	quotePeggyDenom, err := peggytypes.NewPeggyDenomFromString(quoteDenom)
+	if err == nil {
+		quoteDenom = quotePeggyDenom.String()
+	}
+	return crypto.Keccak256Hash([]byte((oracleType.String() + ticker + quoteDenom + oracleBase + oracleQuote + optionType.String() + strikePrice.String() + strconv.Itoa(int(expiry)))))
+}
+
 func NewExpiryFuturesMarketID(ticker, quoteDenom, oracleBase, oracleQuote string, oracleType oracletypes.OracleType, expiry int64) common.Hash {
 	quotePeggyDenom, err := peggytypes.NewPeggyDenomFromString(quoteDenom)
 	if err == nil {
@@ -59,6 +121,34 @@ func NewExpiryFuturesMarketID(ticker, quoteDenom, oracleBase, oracleQuote string
 	return crypto.Keccak256Hash([]byte((oracleType.String() + ticker + quoteDenom + oracleBase + oracleQuote + strconv.Itoa(int(expiry)))))
 }
 
+// NewExpiryFuturesMarketSeriesID returns the ID of a rolling series of expiry futures markets.
+func NewExpiryFuturesMarketSeriesID(tickerPrefix, quoteDenom, oracleBase, oracleQuote string, oracleType oracletypes.OracleType) common.Hash {
+	quotePeggyDenom, err := peggytypes.NewPeggyDenomFromString(quoteDenom)
+	if err == nil {
+		quoteDenom = quotePeggyDenom.String()
+	}
+	return crypto.Keccak256Hash([]byte(("series" + oracleType.String() + tickerPrefix + quoteDenom + oracleBase + oracleQuote)))
+}
+
+// GetExpiryFuturesSeriesContractTicker returns the ticker of the contract of a series expiring at expiry, e.g.
+// INJ/USDT-26JUN2026.
+func GetExpiryFuturesSeriesContractTicker(tickerPrefix string, expiry int64) string {
+	return tickerPrefix + "-" + strings.ToUpper(time.Unix(expiry, 0).UTC().Format(expiryFuturesSeriesTickerDateLayout))
+}
+
+// ValidateExpiryFuturesSeriesSchedule checks that the contracts of a series expire at a positive interval and are
+// launched more than one interval ahead of their expiration, so that the next contract is live before the current one
+// settles, with at most MaxExpiryFuturesSeriesLiveContracts contracts live at once.
+func ValidateExpiryFuturesSeriesSchedule(interval, leadTime int64) error {
+	if interval <= 0 {
+		return sdkerrors.Wrap(ErrInvalidExpiryFuturesMarketSeries, "interval must be positive")
+	}
+	if leadTime <= interval || leadTime > MaxExpiryFuturesSeriesLiveContracts*interval {
+		return sdkerrors.Wrapf(ErrInvalidExpiryFuturesMarketSeries, "lead time %d must exceed the interval %d and not exceed %d intervals", leadTime, interval, MaxExpiryFuturesSeriesLiveContracts)
+	}
+	return nil
+}
+
 func NewDerivativesMarketID(ticker, quoteDenom, oracleBase, oracleQuote string, oracleType oracletypes.OracleType, expiry int64) common.Hash {
 	if expiry == -1 {
 		return NewPerpetualMarketID(ticker, quoteDenom, oracleBase, oracleQuote, oracleType)
@@ -113,6 +203,11 @@ func (m *DerivativeMarket) IsTimeExpiry() bool {
 	return !m.IsPerpetual
 }
 
+// IsPreLaunch returns true if the market is a perpetual market that has no oracle yet and is marked to its own trade TWAP
+func (m *DerivativeMarket) IsPreLaunch() bool {
+	return m.IsPerpetual && m.OracleType == oracletypes.OracleType_Unspecified
+}
+
 func (m *DerivativeMarket) IsActive() bool {
 	return m.Status == MarketStatus_Active
 }
@@ -133,6 +228,7 @@ const (
 	MarketType_Perpetual
 	MarketType_Expiry
 	MarketType_BinaryOption
+	MarketType_VanillaOption
 )
 
 func (m MarketType) IsSpot() bool {
@@ -151,6 +247,16 @@ func (m MarketType) IsBinaryOptions() bool {
 	return m == MarketType_BinaryOption
 }
 
+func (m MarketType) IsVanillaOptions() bool {
+	return m == MarketType_VanillaOption
+}
+
+// IsFullyCollateralized returns true for the markets whose contracts have a bounded value, for which both sides of
+// a trade lock their maximum loss as margin and positions can never be liquidated.
+func (m MarketType) IsFullyCollateralized() bool {
+	return m.IsBinaryOptions() || m.IsVanillaOptions()
+}
+
 func (m *DerivativeMarket) GetMarketType() MarketType {
 	if m.IsPerpetual {
 		return MarketType_Perpetual
@@ -199,6 +305,11 @@ func (m *DerivativeMarket) GetMarketStatus() MarketStatus {
 	return m.Status
 }
 
+// GetMaxPrice returns a nil Dec since the prices of derivative markets are unbounded.
+func (m *DerivativeMarket) GetMaxPrice() sdk.Dec {
+	return sdk.Dec{}
+}
+
 /// Binary Options Markets
 //
 
@@ -267,3 +378,208 @@ func (m *BinaryOptionsMarket) GetOracleScaleFactor() uint32 {
 func (m *BinaryOptionsMarket) GetMarketStatus() MarketStatus {
 	return m.Status
 }
+
+// GetMaxPrice returns the price of a contract settled as true, i.e. 1 (scaled).
+func (m *BinaryOptionsMarket) GetMaxPrice() sdk.Dec {
+	return GetScaledPrice(sdk.OneDec(), m.OracleScaleFactor)
+}
+
+// IsScalar returns true if the market settles linearly between its scalar bounds rather than on a binary outcome.
+func (m *BinaryOptionsMarket) IsScalar() bool {
+	return m.ScalarLowerBound != nil && !m.ScalarLowerBound.IsNil() && m.ScalarUpperBound != nil && !m.ScalarUpperBound.IsNil()
+}
+
+// GetOutcomePrice converts an oracle value into the (unscaled) price band [0, 1] of the market. Binary markets take the
+// oracle value as is, while scalar markets interpolate it linearly between their lower and upper bounds.
+func (m *BinaryOptionsMarket) GetOutcomePrice(oraclePrice sdk.Dec) sdk.Dec {
+	outcomePrice := oraclePrice
+	if m.IsScalar() {
+		outcomePrice = oraclePrice.Sub(*m.ScalarLowerBound).Quo(m.ScalarUpperBound.Sub(*m.ScalarLowerBound))
+	}
+	return sdk.MinDec(sdk.MaxDec(outcomePrice, sdk.ZeroDec()), sdk.OneDec())
+}
+
+// GetMarkPrice returns the (unscaled) mark price of the market for an oracle value, which is the outcome price of scalar
+// markets and the oracle value as is for binary markets.
+func (m *BinaryOptionsMarket) GetMarkPrice(oraclePrice sdk.Dec) sdk.Dec {
+	if m.IsScalar() {
+		return m.GetOutcomePrice(oraclePrice)
+	}
+	return oraclePrice
+}
+
+// ValidateScalarBounds checks that the scalar bounds of a binary options market are either both unset or form a
+// non-empty range.
+func ValidateScalarBounds(lowerBound, upperBound *sdk.Dec) error {
+	isLowerBoundSet := lowerBound != nil && !lowerBound.IsNil()
+	isUpperBoundSet := upperBound != nil && !upperBound.IsNil()
+
+	switch {
+	case !isLowerBoundSet && !isUpperBoundSet:
+		return nil
+	case !isLowerBoundSet || !isUpperBoundSet:
+		return sdkerrors.Wrap(ErrInvalidScalarBounds, "both scalar bounds must be set")
+	case lowerBound.GTE(*upperBound):
+		return sdkerrors.Wrapf(ErrInvalidScalarBounds, "lower bound %s must be less than upper bound %s", lowerBound.String(), upperBound.String())
+	}
+	return nil
+}
+
+/// Vanilla Options Markets
+//
+
+func (m *VanillaOptionsMarket) GetMarketType() MarketType {
+	return MarketType_VanillaOption
+}
+
+func (m *VanillaOptionsMarket) GetInitialMarginRatio() sdk.Dec {
+	return sdk.OneDec()
+}
+
+func (m *VanillaOptionsMarket) IsInactive() bool {
+	return !m.IsActive()
+}
+
+func (m *VanillaOptionsMarket) IsActive() bool {
+	return m.Status == MarketStatus_Active
+}
+
+func (m *VanillaOptionsMarket) MarketID() common.Hash {
+	return common.HexToHash(m.MarketId)
+}
+
+func (m *VanillaOptionsMarket) GetMinPriceTickSize() sdk.Dec {
+	return m.MinPriceTickSize
+}
+
+func (m *VanillaOptionsMarket) GetMinQuantityTickSize() sdk.Dec {
+	return m.MinQuantityTickSize
+}
+
+func (m *VanillaOptionsMarket) GetTicker() string {
+	return m.Ticker
+}
+
+func (m *VanillaOptionsMarket) GetQuoteDenom() string {
+	return m.QuoteDenom
+}
+
+func (m *VanillaOptionsMarket) GetMakerFeeRate() sdk.Dec {
+	return m.MakerFeeRate
+}
+
+func (m *VanillaOptionsMarket) GetTakerFeeRate() sdk.Dec {
+	return m.TakerFeeRate
+}
+
+func (m *VanillaOptionsMarket) GetRelayerFeeShareRate() sdk.Dec {
+	return m.RelayerFeeShareRate
+}
+
+func (m *VanillaOptionsMarket) GetIsPerpetual() bool {
+	return false
+}
+
+func (m *VanillaOptionsMarket) StatusSupportsOrderCancellations() bool {
+	if m == nil {
+		return false
+	}
+	return m.Status.SupportsOrderCancellations()
+}
+
+func (m *VanillaOptionsMarket) GetOracleScaleFactor() uint32 {
+	return m.OracleScaleFactor
+}
+
+func (m *VanillaOptionsMarket) GetMarketStatus() MarketStatus {
+	return m.Status
+}
+
+func (m *VanillaOptionsMarket) IsCall() bool {
+	return m.OptionType == OptionType_CallOption
+}
+
+// GetMaxPrice returns the (scaled) maximum payoff of a contract, i.e. strike * multiplier for puts and
+// (maxUnderlyingPrice - strike) * multiplier for calls, which both sides of a trade lock their maximum loss against.
+func (m *VanillaOptionsMarket) GetMaxPrice() sdk.Dec {
+	maxIntrinsicValue := m.StrikePrice
+	if m.IsCall() {
+		maxIntrinsicValue = m.MaxUnderlyingPrice.Sub(m.StrikePrice)
+	}
+	return GetScaledPrice(maxIntrinsicValue.Mul(m.ContractMultiplier), m.OracleScaleFactor)
+}
+
+// GetPayoff returns the (scaled) payoff of a contract for the given unscaled underlying price, i.e. its intrinsic
+// value bounded by the max price of the market.
+func (m *VanillaOptionsMarket) GetPayoff(underlyingPrice sdk.Dec) sdk.Dec {
+	intrinsicValue := m.StrikePrice.Sub(underlyingPrice)
+	if m.IsCall() {
+		intrinsicValue = underlyingPrice.Sub(m.StrikePrice)
+	}
+
+	payoff := GetScaledPrice(sdk.MaxDec(intrinsicValue, sdk.ZeroDec()).Mul(m.ContractMultiplier), m.OracleScaleFactor)
+	return sdk.MinDec(payoff, m.GetMaxPrice())
+}
+
+/// Categorical Markets
+//
+
+func (m *CategoricalMarket) MarketID() common.Hash {
+	return common.HexToHash(m.MarketId)
+}
+
+// GetOutcomeIndex returns the index of an outcome of the market, or -1 if the market has no such outcome.
+func (m *CategoricalMarket) GetOutcomeIndex(outcome string) int {
+	for idx := range m.Outcomes {
+		if m.Outcomes[idx] == outcome {
+			return idx
+		}
+	}
+	return -1
+}
+
+// GetOutcomeSettlementPrices returns the (unscaled) settlement price of every outcome market when the market settles on
+// the outcome with the given index: 1 for the winning outcome and 0 for the others. A voided market (index out of
+// range) settles every outcome at 1/N so that the complete outcome sets are still worth par.
+func (m *CategoricalMarket) GetOutcomeSettlementPrices(winningOutcomeIndex int) []sdk.Dec {
+	settlementPrices := make([]sdk.Dec, len(m.Outcomes))
+	isVoided := winningOutcomeIndex < 0 || winningOutcomeIndex >= len(m.Outcomes)
+
+	for idx := range settlementPrices {
+		switch {
+		case isVoided:
+			settlementPrices[idx] = sdk.OneDec().QuoInt64(int64(len(m.Outcomes)))
+		case idx == winningOutcomeIndex:
+			settlementPrices[idx] = sdk.OneDec()
+		default:
+			settlementPrices[idx] = sdk.ZeroDec()
+		}
+	}
+	return settlementPrices
+}
+
+/// Expiry Futures Market Series
+
+func (s *ExpiryFuturesMarketSeries) SeriesID() common.Hash {
+	return common.HexToHash(s.SeriesId)
+}
+
+// GetContractTicker returns the ticker of the contract of the series expiring at expiry.
+func (s *ExpiryFuturesMarketSeries) GetContractTicker(expiry int64) string {
+	return GetExpiryFuturesSeriesContractTicker(s.TickerPrefix, expiry)
+}
+
+// GetContractMarketID returns the market ID of the contract of the series expiring at expiry.
+func (s *ExpiryFuturesMarketSeries) GetContractMarketID(expiry int64) common.Hash {
+	return NewExpiryFuturesMarketID(s.GetContractTicker(expiry), s.QuoteDenom, s.OracleBase, s.OracleQuote, s.OracleType, expiry)
+}
+
+// HasMarket returns true if the contract with the marketID was launched by the series and isn't settled yet.
+func (s *ExpiryFuturesMarketSeries) HasMarket(marketID common.Hash) bool {
+	for _, id := range s.MarketIds {
+		if common.HexToHash(id) == marketID {
+			return true
+		}
+	}
+	return false
+}
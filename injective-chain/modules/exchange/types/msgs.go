@@ -49,6 +49,23 @@ var (
 	_ sdk.Msg = &MsgAdminUpdateBinaryOptionsMarket{}
 	_ sdk.Msg = &MsgBatchCancelBinaryOptionsOrders{}
 	_ sdk.Msg = &MsgReclaimLockedFunds{}
+	_ sdk.Msg = &MsgCreateTWAPOrder{}
+	_ sdk.Msg = &MsgCancelTWAPOrder{}
+	_ sdk.Msg = &MsgCreateLadderOrders{}
+	_ sdk.Msg = &MsgCreateSpotQuoteMarketOrder{}
+	_ sdk.Msg = &MsgCreateVanillaOptionsLimitOrder{}
+	_ sdk.Msg = &MsgCreateVanillaOptionsMarketOrder{}
+	_ sdk.Msg = &MsgCancelVanillaOptionsOrder{}
+	_ sdk.Msg = &MsgMintCategoricalOutcomeSet{}
+	_ sdk.Msg = &MsgRedeemCategoricalOutcomeSet{}
+	_ sdk.Msg = &MsgAdminUpdateCategoricalMarket{}
+	_ sdk.Msg = &MsgUpdateExpiryFuturesAutoRoll{}
+	_ sdk.Msg = &MsgCreateMultiLegOrder{}
+	_ sdk.Msg = &MsgCreateRFQRequest{}
+	_ sdk.Msg = &MsgCancelRFQRequest{}
+	_ sdk.Msg = &MsgAcceptQuote{}
+	_ sdk.Msg = &MsgSubmitSignedOrders{}
+	_ sdk.Msg = &MsgCancelSignedOrders{}
 )
 
 // exchange message types
@@ -82,6 +99,23 @@ const (
 	TypeMsgAdminUpdateBinaryOptionsMarket   = "adminUpdateBinaryOptionsMarket"
 	TypeMsgBatchCancelBinaryOptionsOrders   = "batchCancelBinaryOptionsOrders"
 	TypeMsgReclaimLockedFunds               = "reclaimLockedFunds"
+	TypeMsgCreateTWAPOrder                  = "createTWAPOrder"
+	TypeMsgCancelTWAPOrder                  = "cancelTWAPOrder"
+	TypeMsgCreateLadderOrders               = "createLadderOrders"
+	TypeMsgCreateSpotQuoteMarketOrder       = "createSpotQuoteMarketOrder"
+	TypeMsgCreateVanillaOptionsLimitOrder   = "createVanillaOptionsLimitOrder"
+	TypeMsgCreateVanillaOptionsMarketOrder  = "createVanillaOptionsMarketOrder"
+	TypeMsgCancelVanillaOptionsOrder        = "cancelVanillaOptionsOrder"
+	TypeMsgMintCategoricalOutcomeSet        = "mintCategoricalOutcomeSet"
+	TypeMsgRedeemCategoricalOutcomeSet      = "redeemCategoricalOutcomeSet"
+	TypeMsgAdminUpdateCategoricalMarket     = "adminUpdateCategoricalMarket"
+	TypeMsgUpdateExpiryFuturesAutoRoll      = "updateExpiryFuturesAutoRoll"
+	TypeMsgCreateMultiLegOrder              = "createMultiLegOrder"
+	TypeMsgCreateRFQRequest                 = "createRFQRequest"
+	TypeMsgCancelRFQRequest                 = "cancelRFQRequest"
+	TypeMsgAcceptQuote                      = "acceptQuote"
+	TypeMsgSubmitSignedOrders               = "submitSignedOrders"
+	TypeMsgCancelSignedOrders               = "cancelSignedOrders"
 )
 
 func (o *SpotOrder) ValidateBasic(senderAddr sdk.AccAddress) error {
@@ -106,7 +140,11 @@ func (o *SpotOrder) ValidateBasic(senderAddr sdk.AccAddress) error {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, o.OrderInfo.FeeRecipient)
 		}
 	}
-	return o.OrderInfo.ValidateBasic(senderAddr, false, false)
+
+	if err := o.OrderInfo.ValidateBasic(senderAddr, false, false); err != nil {
+		return err
+	}
+	return validateIcebergVisibleQuantity(o.VisibleQuantity, o.OrderType, o.OrderInfo.Quantity)
 }
 
 func (o *OrderInfo) ValidateBasic(senderAddr sdk.AccAddress, hasBinaryPriceBand, isDerivative bool) error {
@@ -174,7 +212,15 @@ func (o *DerivativeOrder) ValidateBasic(senderAddr sdk.AccAddress, hasBinaryPric
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, o.OrderInfo.FeeRecipient)
 		}
 	}
-	return o.OrderInfo.ValidateBasic(senderAddr, hasBinaryPriceBand, !hasBinaryPriceBand)
+
+	if err := o.OrderInfo.ValidateBasic(senderAddr, hasBinaryPriceBand, !hasBinaryPriceBand); err != nil {
+		return err
+	}
+
+	if o.IsIceberg() && o.Margin.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidIcebergOrder, "reduce-only orders can't be iceberg orders")
+	}
+	return validateIcebergVisibleQuantity(o.VisibleQuantity, o.OrderType, o.OrderInfo.Quantity)
 }
 
 func (o *OrderData) ValidateBasic(senderAddr sdk.AccAddress) error {
@@ -469,6 +515,9 @@ func (msg MsgInstantBinaryOptionsMarketLaunch) ValidateBasic() error {
 	if err := ValidateTickSize(msg.MinQuantityTickSize); err != nil {
 		return sdkerrors.Wrap(ErrInvalidQuantityTickSize, err.Error())
 	}
+	if err := ValidateScalarBounds(msg.ScalarLowerBound, msg.ScalarUpperBound); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -646,6 +695,10 @@ func (msg MsgCreateSpotMarketOrder) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidOrderTypeForMessage, "Spot market order can't be a post only order")
 	}
 
+	if msg.Order.IsIceberg() {
+		return sdkerrors.Wrap(ErrInvalidOrderTypeForMessage, "Spot market order can't be an iceberg order")
+	}
+
 	if err := msg.Order.ValidateBasic(senderAddr); err != nil {
 		return err
 	}
@@ -903,6 +956,10 @@ func (msg MsgCreateDerivativeMarketOrder) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidOrderTypeForMessage, "Derivative market order can't be a post only order")
 	}
 
+	if msg.Order.IsIceberg() {
+		return sdkerrors.Wrap(ErrInvalidOrderTypeForMessage, "Derivative market order can't be an iceberg order")
+	}
+
 	if err := msg.Order.ValidateBasic(senderAddr, false); err != nil {
 		return err
 	}
@@ -969,6 +1026,10 @@ func (msg MsgCreateBinaryOptionsMarketOrder) ValidateBasic() error {
 	if msg.Order.OrderType == OrderType_BUY_PO || msg.Order.OrderType == OrderType_SELL_PO {
 		return sdkerrors.Wrap(ErrInvalidOrderTypeForMessage, "market order can't be a post only order")
 	}
+
+	if msg.Order.IsIceberg() {
+		return sdkerrors.Wrap(ErrInvalidOrderTypeForMessage, "market order can't be an iceberg order")
+	}
 	if msg.Order.OrderType.IsConditional() {
 		return sdkerrors.Wrap(ErrUnrecognizedOrderType, string(msg.Order.OrderType))
 	}
@@ -1448,6 +1509,10 @@ func (msg *MsgLiquidatePosition) ValidateBasic() error {
 			return ErrInvalidLiquidationOrder
 		}
 
+		if msg.Order.IsIceberg() {
+			return sdkerrors.Wrap(ErrInvalidLiquidationOrder, "liquidation order can't be an iceberg order")
+		}
+
 		if err := msg.Order.ValidateBasic(senderAddr, false); err != nil {
 			return err
 		}
@@ -1810,6 +1875,282 @@ func (msg *MsgReclaimLockedFunds) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg *MsgCreateTWAPOrder) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg *MsgCreateTWAPOrder) Type() string {
+	return TypeMsgCreateTWAPOrder
+}
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg *MsgCreateTWAPOrder) ValidateBasic() error {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	if !IsHexHash(msg.MarketId) {
+		return sdkerrors.Wrap(ErrMarketInvalid, msg.MarketId)
+	}
+
+	if msg.OrderType != OrderType_BUY && msg.OrderType != OrderType_SELL {
+		return sdkerrors.Wrapf(ErrInvalidOrderTypeForMessage, "TWAP orders must be buy or sell orders, got %s", msg.OrderType.String())
+	}
+
+	if msg.Margin.IsNil() || msg.Margin.IsNegative() {
+		return sdkerrors.Wrap(ErrInsufficientOrderMargin, msg.Margin.String())
+	}
+
+	if msg.Margin.GT(MaxOrderMargin) {
+		return sdkerrors.Wrap(ErrTooMuchOrderMargin, msg.Margin.String())
+	}
+
+	if msg.OrderInfo.FeeRecipient != "" {
+		_, err := sdk.AccAddressFromBech32(msg.OrderInfo.FeeRecipient)
+		if err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.OrderInfo.FeeRecipient)
+		}
+	}
+
+	if msg.HasLimitPrice() {
+		if err := msg.OrderInfo.ValidateBasic(senderAddr, false, false); err != nil {
+			return err
+		}
+
+		if !msg.MaxSlippage.IsNil() && !msg.MaxSlippage.IsZero() {
+			return sdkerrors.Wrap(ErrInvalidTWAPOrder, "max slippage can only be set for orders without a limit price")
+		}
+	} else {
+		// the slices of an order without a limit price are capped by the max slippage instead
+		orderInfo := msg.OrderInfo
+		orderInfo.Price = sdk.ZeroDec()
+		if err := orderInfo.ValidateBasic(senderAddr, true, false); err != nil {
+			return err
+		}
+
+		if msg.MaxSlippage.IsNil() || !msg.MaxSlippage.IsPositive() || msg.MaxSlippage.GTE(sdk.OneDec()) {
+			return sdkerrors.Wrap(ErrInvalidTWAPOrder, "max slippage must be between 0 and 1 for orders without a limit price")
+		}
+	}
+
+	if msg.Slices < 2 || msg.Slices > MaxTWAPOrderSlices {
+		return sdkerrors.Wrapf(ErrInvalidTWAPOrder, "slices must be between 2 and %d, got %d", MaxTWAPOrderSlices, msg.Slices)
+	}
+
+	if msg.IntervalSeconds < 0 || (msg.IntervalBlocks > 0) == (msg.IntervalSeconds > 0) {
+		return sdkerrors.Wrap(ErrInvalidTWAPOrder, "exactly one of interval_blocks or interval_seconds must be positive")
+	}
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgCreateTWAPOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg *MsgCreateTWAPOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg *MsgCancelTWAPOrder) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg *MsgCancelTWAPOrder) Type() string {
+	return TypeMsgCancelTWAPOrder
+}
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg *MsgCancelTWAPOrder) ValidateBasic() error {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	orderData := OrderData{
+		MarketId:     msg.MarketId,
+		SubaccountId: msg.SubaccountId,
+		OrderHash:    msg.OrderHash,
+	}
+	return orderData.ValidateBasic(senderAddr)
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgCancelTWAPOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg *MsgCancelTWAPOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg *MsgCreateLadderOrders) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg *MsgCreateLadderOrders) Type() string {
+	return TypeMsgCreateLadderOrders
+}
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg *MsgCreateLadderOrders) ValidateBasic() error {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	if !IsHexHash(msg.MarketId) {
+		return sdkerrors.Wrap(ErrMarketInvalid, msg.MarketId)
+	}
+
+	switch msg.OrderType {
+	case OrderType_BUY, OrderType_SELL, OrderType_BUY_PO, OrderType_SELL_PO:
+		// do nothing
+	default:
+		return sdkerrors.Wrapf(ErrInvalidOrderTypeForMessage, "ladder orders must be buy or sell limit orders, got %s", msg.OrderType.String())
+	}
+
+	if msg.FeeRecipient != "" {
+		_, err := sdk.AccAddressFromBech32(msg.FeeRecipient)
+		if err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.FeeRecipient)
+		}
+	}
+
+	for _, price := range []sdk.Dec{msg.StartPrice, msg.EndPrice} {
+		orderInfo := OrderInfo{
+			SubaccountId: msg.SubaccountId,
+			Price:        price,
+			Quantity:     msg.Quantity,
+		}
+		if err := orderInfo.ValidateBasic(senderAddr, false, false); err != nil {
+			return err
+		}
+	}
+
+	if msg.Levels < 2 || msg.Levels > MaxLadderOrderLevels {
+		return sdkerrors.Wrapf(ErrInvalidLadderOrder, "levels must be between 2 and %d, got %d", MaxLadderOrderLevels, msg.Levels)
+	}
+
+	if msg.StartPrice.Equal(msg.EndPrice) {
+		return sdkerrors.Wrap(ErrInvalidLadderOrder, "start price and end price must differ")
+	}
+
+	switch msg.SizeDistribution {
+	case LadderSizeDistribution_Flat:
+		// do nothing
+	case LadderSizeDistribution_Linear, LadderSizeDistribution_Exponential:
+		if msg.SizeRatio.IsNil() || !msg.SizeRatio.IsPositive() || msg.SizeRatio.GT(MaxOrderQuantity) {
+			return sdkerrors.Wrapf(ErrInvalidLadderOrder, "size ratio must be positive, got %s", msg.SizeRatio.String())
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidLadderOrder, "unrecognized size distribution %d", msg.SizeDistribution)
+	}
+
+	if msg.Margin.IsNil() || msg.Margin.IsNegative() {
+		return sdkerrors.Wrap(ErrInsufficientOrderMargin, msg.Margin.String())
+	}
+
+	if msg.Margin.GT(MaxOrderMargin) {
+		return sdkerrors.Wrap(ErrTooMuchOrderMargin, msg.Margin.String())
+	}
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgCreateLadderOrders) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg *MsgCreateLadderOrders) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg *MsgCreateSpotQuoteMarketOrder) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg *MsgCreateSpotQuoteMarketOrder) Type() string {
+	return TypeMsgCreateSpotQuoteMarketOrder
+}
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg *MsgCreateSpotQuoteMarketOrder) ValidateBasic() error {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	if !IsHexHash(msg.MarketId) {
+		return sdkerrors.Wrap(ErrMarketInvalid, msg.MarketId)
+	}
+
+	if err := CheckValidSubaccountIDOrNonce(senderAddr, msg.SubaccountId); err != nil {
+		return err
+	}
+
+	switch msg.OrderType {
+	case OrderType_BUY, OrderType_SELL, OrderType_BUY_ATOMIC, OrderType_SELL_ATOMIC:
+		// do nothing
+	default:
+		return sdkerrors.Wrapf(ErrInvalidOrderTypeForMessage, "quote market orders must be buy or sell market orders, got %s", msg.OrderType.String())
+	}
+
+	if msg.FeeRecipient != "" {
+		_, err := sdk.AccAddressFromBech32(msg.FeeRecipient)
+		if err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.FeeRecipient)
+		}
+	}
+
+	if msg.QuoteAmount.IsNil() || !msg.QuoteAmount.IsPositive() || msg.QuoteAmount.GT(MaxOrderQuantity) {
+		return sdkerrors.Wrap(ErrInvalidQuoteMarketOrder, "quote amount must be positive")
+	}
+
+	if msg.MaxSlippage.IsNil() || msg.MaxSlippage.IsNegative() || msg.MaxSlippage.GTE(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrInvalidQuoteMarketOrder, "max slippage must be at least 0 and less than 1")
+	}
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgCreateSpotQuoteMarketOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg *MsgCreateSpotQuoteMarketOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
 // / Skeleton sdk.Msg interface implementation
 var _ sdk.Msg = &MsgSignData{}
 var _ legacytx.LegacyMsg = &MsgSignData{}
@@ -1861,3 +2202,618 @@ func (m *MsgSignDoc) Route() string {
 func (m *MsgSignDoc) Type() string {
 	return "signDoc"
 }
+
+// Route should return the name of the module
+func (msg MsgCreateVanillaOptionsLimitOrder) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCreateVanillaOptionsLimitOrder) Type() string {
+	return TypeMsgCreateVanillaOptionsLimitOrder
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCreateVanillaOptionsLimitOrder) ValidateBasic() error {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	if msg.Order.OrderType.IsConditional() {
+		return sdkerrors.Wrap(ErrUnrecognizedOrderType, string(msg.Order.OrderType))
+	}
+	if err := msg.Order.ValidateBasic(senderAddr, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCreateVanillaOptionsLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateVanillaOptionsLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route should return the name of the module
+func (msg MsgCreateVanillaOptionsMarketOrder) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCreateVanillaOptionsMarketOrder) Type() string {
+	return TypeMsgCreateVanillaOptionsMarketOrder
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCreateVanillaOptionsMarketOrder) ValidateBasic() error {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	if msg.Order.OrderType == OrderType_BUY_PO || msg.Order.OrderType == OrderType_SELL_PO {
+		return sdkerrors.Wrap(ErrInvalidOrderTypeForMessage, "market order can't be a post only order")
+	}
+
+	if msg.Order.IsIceberg() {
+		return sdkerrors.Wrap(ErrInvalidOrderTypeForMessage, "market order can't be an iceberg order")
+	}
+	if msg.Order.OrderType.IsConditional() {
+		return sdkerrors.Wrap(ErrUnrecognizedOrderType, string(msg.Order.OrderType))
+	}
+
+	if err := msg.Order.ValidateBasic(senderAddr, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCreateVanillaOptionsMarketOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateVanillaOptionsMarketOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg *MsgCancelVanillaOptionsOrder) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg *MsgCancelVanillaOptionsOrder) Type() string {
+	return TypeMsgCancelVanillaOptionsOrder
+}
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg *MsgCancelVanillaOptionsOrder) ValidateBasic() error {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	orderData := OrderData{
+		MarketId:     msg.MarketId,
+		SubaccountId: msg.SubaccountId,
+		OrderHash:    msg.OrderHash,
+	}
+	return orderData.ValidateBasic(senderAddr)
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgCancelVanillaOptionsOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg *MsgCancelVanillaOptionsOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg *MsgMintCategoricalOutcomeSet) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg *MsgMintCategoricalOutcomeSet) Type() string {
+	return TypeMsgMintCategoricalOutcomeSet
+}
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg *MsgMintCategoricalOutcomeSet) ValidateBasic() error {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	if !IsHexHash(msg.MarketId) {
+		return sdkerrors.Wrap(ErrMarketInvalid, msg.MarketId)
+	}
+
+	if err := CheckValidSubaccountIDOrNonce(senderAddr, msg.SubaccountId); err != nil {
+		return err
+	}
+
+	if msg.Quantity.IsNil() || !msg.Quantity.IsPositive() || msg.Quantity.GT(MaxOrderQuantity) {
+		return sdkerrors.Wrap(ErrInvalidQuantity, msg.Quantity.String())
+	}
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgMintCategoricalOutcomeSet) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg *MsgMintCategoricalOutcomeSet) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg *MsgRedeemCategoricalOutcomeSet) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg *MsgRedeemCategoricalOutcomeSet) Type() string {
+	return TypeMsgRedeemCategoricalOutcomeSet
+}
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg *MsgRedeemCategoricalOutcomeSet) ValidateBasic() error {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	if !IsHexHash(msg.MarketId) {
+		return sdkerrors.Wrap(ErrMarketInvalid, msg.MarketId)
+	}
+
+	if err := CheckValidSubaccountIDOrNonce(senderAddr, msg.SubaccountId); err != nil {
+		return err
+	}
+
+	if msg.Quantity.IsNil() || !msg.Quantity.IsPositive() || msg.Quantity.GT(MaxOrderQuantity) {
+		return sdkerrors.Wrap(ErrInvalidQuantity, msg.Quantity.String())
+	}
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgRedeemCategoricalOutcomeSet) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg *MsgRedeemCategoricalOutcomeSet) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgAdminUpdateCategoricalMarket) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAdminUpdateCategoricalMarket) Type() string {
+	return TypeMsgAdminUpdateCategoricalMarket
+}
+
+func (msg *MsgAdminUpdateCategoricalMarket) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	if !IsHexHash(msg.MarketId) {
+		return sdkerrors.Wrap(ErrMarketInvalid, msg.MarketId)
+	}
+
+	if (msg.SettlementTimestamp > 0 && msg.ExpirationTimestamp >= msg.SettlementTimestamp) ||
+		msg.ExpirationTimestamp < 0 {
+		return ErrInvalidExpiry
+	}
+
+	if msg.SettlementTimestamp < 0 {
+		return ErrInvalidSettlement
+	}
+
+	// admin can only change status to demolished, which settles the market on the winning outcome
+	switch msg.Status {
+	case MarketStatus_Unspecified:
+		if msg.WinningOutcome != "" {
+			return sdkerrors.Wrapf(ErrInvalidMarketStatus, "status should be set to demolished when the winning outcome is set, status: %s", msg.Status.String())
+		}
+	case MarketStatus_Demolished:
+	default:
+		return sdkerrors.Wrap(ErrInvalidMarketStatus, msg.Status.String())
+	}
+
+	return nil
+}
+
+func (msg *MsgAdminUpdateCategoricalMarket) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgAdminUpdateCategoricalMarket) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg *MsgUpdateExpiryFuturesAutoRoll) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg *MsgUpdateExpiryFuturesAutoRoll) Type() string {
+	return TypeMsgUpdateExpiryFuturesAutoRoll
+}
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg *MsgUpdateExpiryFuturesAutoRoll) ValidateBasic() error {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	if !IsHexHash(msg.SeriesId) {
+		return sdkerrors.Wrap(ErrInvalidExpiryFuturesMarketSeries, msg.SeriesId)
+	}
+
+	if err := CheckValidSubaccountIDOrNonce(senderAddr, msg.SubaccountId); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgUpdateExpiryFuturesAutoRoll) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg *MsgUpdateExpiryFuturesAutoRoll) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg *MsgCreateMultiLegOrder) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg *MsgCreateMultiLegOrder) Type() string {
+	return TypeMsgCreateMultiLegOrder
+}
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg *MsgCreateMultiLegOrder) ValidateBasic() error {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	if err := CheckValidSubaccountIDOrNonce(senderAddr, msg.SubaccountId); err != nil {
+		return err
+	}
+
+	if msg.FeeRecipient != "" {
+		_, err := sdk.AccAddressFromBech32(msg.FeeRecipient)
+		if err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.FeeRecipient)
+		}
+	}
+
+	if len(msg.Legs) < 2 || len(msg.Legs) > MaxMultiLegOrderLegs {
+		return sdkerrors.Wrapf(ErrInvalidMultiLegOrder, "legs must be between 2 and %d, got %d", MaxMultiLegOrderLegs, len(msg.Legs))
+	}
+
+	if msg.Quantity.IsNil() || !msg.Quantity.IsPositive() || msg.Quantity.GT(MaxOrderQuantity) {
+		return sdkerrors.Wrap(ErrInvalidQuantity, msg.Quantity.String())
+	}
+
+	if msg.NetPrice.IsNil() || msg.NetPrice.Abs().GT(MaxOrderPrice) {
+		return sdkerrors.Wrap(ErrInvalidMultiLegOrder, "invalid net price")
+	}
+
+	marketIDs := make(map[common.Hash]struct{}, len(msg.Legs))
+	for idx := range msg.Legs {
+		leg := &msg.Legs[idx]
+
+		if !IsHexHash(leg.MarketId) {
+			return sdkerrors.Wrap(ErrMarketInvalid, leg.MarketId)
+		}
+
+		// legs in the same market would be matched against each other's liquidity
+		if _, ok := marketIDs[leg.MarketID()]; ok {
+			return sdkerrors.Wrapf(ErrInvalidMultiLegOrder, "duplicate leg for market %s", leg.MarketId)
+		}
+		marketIDs[leg.MarketID()] = struct{}{}
+
+		if leg.Ratio.IsNil() || !leg.Ratio.IsPositive() || leg.GetQuantity(msg.Quantity).GT(MaxOrderQuantity) {
+			return sdkerrors.Wrapf(ErrInvalidMultiLegOrder, "invalid ratio for leg %d", idx)
+		}
+
+		if leg.WorstPrice.IsNil() || !leg.WorstPrice.IsPositive() || leg.WorstPrice.GT(MaxOrderPrice) {
+			return sdkerrors.Wrapf(ErrInvalidPrice, "invalid worst price for leg %d", idx)
+		}
+
+		if leg.Margin.IsNil() || leg.Margin.IsNegative() || leg.Margin.GT(MaxOrderMargin) {
+			return sdkerrors.Wrapf(ErrInsufficientOrderMargin, "invalid margin for leg %d", idx)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgCreateMultiLegOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg *MsgCreateMultiLegOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg *MsgCreateRFQRequest) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg *MsgCreateRFQRequest) Type() string {
+	return TypeMsgCreateRFQRequest
+}
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg *MsgCreateRFQRequest) ValidateBasic() error {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	if !IsHexHash(msg.MarketId) {
+		return sdkerrors.Wrap(ErrMarketInvalid, msg.MarketId)
+	}
+
+	if err := CheckValidSubaccountIDOrNonce(senderAddr, msg.SubaccountId); err != nil {
+		return err
+	}
+
+	if msg.Quantity.IsNil() || !msg.Quantity.IsPositive() || msg.Quantity.GT(MaxOrderQuantity) {
+		return sdkerrors.Wrap(ErrInvalidQuantity, msg.Quantity.String())
+	}
+
+	if msg.Expiry <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidRFQRequest, "expiry must be positive, got %d", msg.Expiry)
+	}
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgCreateRFQRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg *MsgCreateRFQRequest) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg *MsgCancelRFQRequest) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg *MsgCancelRFQRequest) Type() string {
+	return TypeMsgCancelRFQRequest
+}
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg *MsgCancelRFQRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	if msg.RequestId == 0 {
+		return sdkerrors.Wrap(ErrInvalidRFQRequest, "request id must be positive")
+	}
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgCancelRFQRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg *MsgCancelRFQRequest) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg *MsgAcceptQuote) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg *MsgAcceptQuote) Type() string {
+	return TypeMsgAcceptQuote
+}
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg *MsgAcceptQuote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	if msg.RequestId == 0 {
+		return sdkerrors.Wrap(ErrInvalidRFQRequest, "request id must be positive")
+	}
+
+	if msg.Quote.RequestId != msg.RequestId {
+		return sdkerrors.Wrapf(ErrInvalidRFQQuote, "quote is for request %d, not %d", msg.Quote.RequestId, msg.RequestId)
+	}
+
+	if err := msg.Quote.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if len(msg.MakerSignature) == 0 {
+		return sdkerrors.Wrap(ErrInvalidRFQQuote, "maker signature is missing")
+	}
+
+	if msg.Margin.IsNil() || msg.Margin.IsNegative() || msg.Margin.GT(MaxOrderMargin) {
+		return sdkerrors.Wrap(ErrInsufficientOrderMargin, msg.Margin.String())
+	}
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgAcceptQuote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg *MsgAcceptQuote) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg *MsgSubmitSignedOrders) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg *MsgSubmitSignedOrders) Type() string {
+	return TypeMsgSubmitSignedOrders
+}
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg *MsgSubmitSignedOrders) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	if len(msg.Orders) == 0 {
+		return sdkerrors.Wrap(ErrInvalidSignedOrder, "no orders to submit")
+	}
+
+	for idx := range msg.Orders {
+		if err := msg.Orders[idx].ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgSubmitSignedOrders) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg *MsgSubmitSignedOrders) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg *MsgCancelSignedOrders) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg *MsgCancelSignedOrders) Type() string {
+	return TypeMsgCancelSignedOrders
+}
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg *MsgCancelSignedOrders) ValidateBasic() error {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	if err := CheckValidSubaccountIDOrNonce(senderAddr, msg.SubaccountId); err != nil {
+		return err
+	}
+
+	if len(msg.Orders) == 0 && msg.MinNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalidSignedOrder, "no nonces to cancel")
+	}
+
+	for idx := range msg.Orders {
+		if err := msg.Orders[idx].ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgCancelSignedOrders) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg *MsgCancelSignedOrders) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
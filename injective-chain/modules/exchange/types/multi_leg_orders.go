@@ -0,0 +1,65 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MaxMultiLegOrderLegs is the maximum number of legs a multi-leg order can be made of
+const MaxMultiLegOrderLegs = 4
+
+func (l *MultiLegOrderLeg) MarketID() common.Hash {
+	return common.HexToHash(l.MarketId)
+}
+
+// GetQuantity returns the quantity of the leg for the given quantity of the multi-leg order.
+func (l *MultiLegOrderLeg) GetQuantity(quantity sdk.Dec) sdk.Dec {
+	return l.Ratio.Mul(quantity)
+}
+
+// GetOrderType returns the type of the atomic market order the leg is executed as.
+func (l *MultiLegOrderLeg) GetOrderType() OrderType {
+	if l.IsBuy {
+		return OrderType_BUY_ATOMIC
+	}
+	return OrderType_SELL_ATOMIC
+}
+
+// GetNetPriceContribution returns the ratio weighted execution price of the leg, positive for buys and negative for
+// sells.
+func (l *MultiLegOrderLeg) GetNetPriceContribution(price sdk.Dec) sdk.Dec {
+	contribution := l.Ratio.Mul(price)
+	if l.IsBuy {
+		return contribution
+	}
+	return contribution.Neg()
+}
+
+// ToSpotOrder returns the atomic spot market order the leg is executed as.
+func (l *MultiLegOrderLeg) ToSpotOrder(subaccountID string, feeRecipient string, quantity sdk.Dec) *SpotOrder {
+	return &SpotOrder{
+		MarketId: l.MarketId,
+		OrderInfo: OrderInfo{
+			SubaccountId: subaccountID,
+			FeeRecipient: feeRecipient,
+			Price:        l.WorstPrice,
+			Quantity:     l.GetQuantity(quantity),
+		},
+		OrderType: l.GetOrderType(),
+	}
+}
+
+// ToDerivativeOrder returns the atomic derivative market order the leg is executed as.
+func (l *MultiLegOrderLeg) ToDerivativeOrder(subaccountID string, feeRecipient string, quantity sdk.Dec) *DerivativeOrder {
+	return &DerivativeOrder{
+		MarketId: l.MarketId,
+		OrderInfo: OrderInfo{
+			SubaccountId: subaccountID,
+			FeeRecipient: feeRecipient,
+			Price:        l.WorstPrice,
+			Quantity:     l.GetQuantity(quantity),
+		},
+		OrderType: l.GetOrderType(),
+		Margin:    l.Margin,
+	}
+}
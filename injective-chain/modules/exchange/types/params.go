@@ -36,9 +36,21 @@ const (
 
 	MaxTickerLength int = 40
 
+	// MaxCategoricalMarketOutcomes is the maximum number of outcomes of a categorical market
+	MaxCategoricalMarketOutcomes = 20
+
 	// MaxHistoricalTradeRecordAge is the maximum age of trade records to track.
 	MaxHistoricalTradeRecordAge = 60 * 5
 
+	// TradeRecordPruneIntervalBlocks is how often the trade records older than the retention of their market are pruned
+	TradeRecordPruneIntervalBlocks = 1000
+
+	// MaxTradeRecordRetention is the maximum retention of trade records a market can be configured with (7 days)
+	MaxTradeRecordRetention = 60 * 60 * 24 * 7
+
+	// DefaultTerminalOrderRetentionBlocks is 100000, which keeps the terminal states of the limit orders for about a day
+	DefaultTerminalOrderRetentionBlocks int64 = 100000
+
 	// MaxSubaccountNonceLength restricts the size of a subaccount number from 0 to 999
 	MaxSubaccountNonceLength = 3
 )
@@ -85,6 +97,7 @@ var (
 	KeyBinaryOptionsAtomicMarketOrderFeeMultiplier = []byte("BinaryOptionsAtomicMarketOrderFeeMultiplier")
 	KeyMinimalProtocolFeeRate                      = []byte("MinimalProtocolFeeRate")
 	KeyIsInstantDerivativeMarketLaunchEnabled      = []byte("IsInstantDerivativeMarketLaunchEnabled")
+	KeyTerminalOrderRetentionBlocks                = []byte("TerminalOrderRetentionBlocks")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -172,6 +185,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyBinaryOptionsAtomicMarketOrderFeeMultiplier, &p.BinaryOptionsAtomicMarketOrderFeeMultiplier, validateAtomicMarketOrderFeeMultiplier),
 		paramtypes.NewParamSetPair(KeyMinimalProtocolFeeRate, &p.MinimalProtocolFeeRate, ValidateFee),
 		paramtypes.NewParamSetPair(KeyIsInstantDerivativeMarketLaunchEnabled, &p.IsInstantDerivativeMarketLaunchEnabled, validateBool),
+		paramtypes.NewParamSetPair(KeyTerminalOrderRetentionBlocks, &p.TerminalOrderRetentionBlocks, validateTerminalOrderRetentionBlocks),
 	}
 }
 
@@ -201,6 +215,7 @@ func DefaultParams() Params {
 		DerivativeAtomicMarketOrderFeeMultiplier:    sdk.NewDecWithPrec(25, 1),        // default 2.5 multiplier
 		BinaryOptionsAtomicMarketOrderFeeMultiplier: sdk.NewDecWithPrec(25, 1),        // default 2.5 multiplier
 		MinimalProtocolFeeRate:                      sdk.MustNewDecFromStr("0.00005"), // default 0.005% minimal fee rate
+		TerminalOrderRetentionBlocks:                DefaultTerminalOrderRetentionBlocks,
 	}
 }
 
@@ -272,6 +287,9 @@ func (p Params) Validate() error {
 	if err := ValidateFee(p.MinimalProtocolFeeRate); err != nil {
 		return fmt.Errorf("minimal_protocol_fee_rate is incorrect: %w", err)
 	}
+	if err := validateTerminalOrderRetentionBlocks(p.TerminalOrderRetentionBlocks); err != nil {
+		return fmt.Errorf("terminal_order_retention_blocks is incorrect: %w", err)
+	}
 	return nil
 }
 
@@ -588,3 +606,16 @@ func validateBool(i interface{}) error {
 	}
 	return nil
 }
+
+func validateTerminalOrderRetentionBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("terminal order retention blocks must be non-negative: %d", v)
+	}
+
+	return nil
+}
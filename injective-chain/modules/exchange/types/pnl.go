@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func NewSubaccountPnl(subaccountID, marketID common.Hash) *SubaccountPnl {
+	return &SubaccountPnl{
+		SubaccountId: subaccountID.Hex(),
+		MarketId:     marketID.Hex(),
+		RealizedPnl:  sdk.ZeroDec(),
+		FeesPaid:     sdk.ZeroDec(),
+		FundingPaid:  sdk.ZeroDec(),
+	}
+}
+
+func (p *SubaccountPnl) SubaccountID() common.Hash {
+	return common.HexToHash(p.SubaccountId)
+}
+
+func (p *SubaccountPnl) MarketID() common.Hash {
+	return common.HexToHash(p.MarketId)
+}
@@ -86,7 +86,9 @@ func (p *Position) ApplyTotalPositionPayoutHaircut(deficitAmount, totalPayouts,
 	p.Margin = p.Margin.Sub(removedMargin)
 }
 
-func (p *Position) ApplyProfitHaircutForBinaryOptions(deficitAmount, totalAssets sdk.Dec, oracleScaleFactor uint32) {
+// ApplyProfitHaircutForFullyCollateralizedMarkets applies the haircut of the refunds of a fully collateralized market,
+// e.g. binary options, whose contracts are worth at most maxPrice.
+func (p *Position) ApplyProfitHaircutForFullyCollateralizedMarkets(deficitAmount, totalAssets, maxPrice sdk.Dec) {
 	// haircutPercentage = deficitAmount / totalAssets
 	// To preserve precision, the division by totalAssets is done last.
 	// newMargin =  p.Margin - p.Margin * haircutPercentage
@@ -97,8 +99,7 @@ func (p *Position) ApplyProfitHaircutForBinaryOptions(deficitAmount, totalAssets
 	if p.IsLong {
 		p.EntryPrice = p.Margin.Quo(p.Quantity)
 	} else {
-		scaledOne := GetScaledPrice(sdk.OneDec(), oracleScaleFactor)
-		p.EntryPrice = scaledOne.Sub(p.Margin.Quo(p.Quantity))
+		p.EntryPrice = maxPrice.Sub(p.Margin.Quo(p.Quantity))
 	}
 }
 
@@ -124,12 +125,13 @@ func (p *Position) ClosePositionWithSettlePrice(settlementPrice, closingFeeRate
 	return payout, closeTradingFee, positionDelta
 }
 
+// ClosePositionWithoutPayouts closes the position, keeping its cumulative funding entry since the funding has already
+// been applied to it.
 func (p *Position) ClosePositionWithoutPayouts() {
 	p.IsLong = false
 	p.EntryPrice = sdk.ZeroDec()
 	p.Quantity = sdk.ZeroDec()
 	p.Margin = sdk.ZeroDec()
-	p.CumulativeFundingEntry = sdk.ZeroDec()
 }
 
 func (p *Position) ClosePositionByRefunding(closingFeeRate sdk.Dec) (payout, closeTradingFee sdk.Dec, positionDelta *PositionDelta) {
@@ -156,7 +158,7 @@ func (p *Position) CheckValidPositionToReduce(
 		return ErrInvalidReduceOnlyPositionDirection
 	}
 
-	if marketType == MarketType_BinaryOption {
+	if marketType.IsFullyCollateralized() {
 		return nil
 	}
 
@@ -311,6 +313,30 @@ func (p *Position) GetPayoutFromPnl(closingPrice, closingQuantity sdk.Dec) sdk.D
 	return pnlNotional
 }
 
+// GetRealizedPnl returns the PnL realized by applying the position delta, i.e. the PnL of the closed quantity,
+// excluding the trading fee.
+func (p *Position) GetRealizedPnl(delta *PositionDelta) sdk.Dec {
+	if delta == nil || p == nil || p.Quantity.IsZero() || p.IsLong == delta.IsLong {
+		return sdk.ZeroDec()
+	}
+
+	closingQuantity := sdk.MinDec(p.Quantity, delta.ExecutionQuantity)
+	return p.GetPayoutFromPnl(delta.ExecutionPrice, closingQuantity)
+}
+
+// GetFundingPayment returns the funding paid by the position, negative if received, since its cumulative funding entry.
+func (p *Position) GetFundingPayment(cumulativeFunding sdk.Dec) sdk.Dec {
+	if p == nil || p.CumulativeFundingEntry.IsNil() || cumulativeFunding.IsNil() {
+		return sdk.ZeroDec()
+	}
+
+	payment := p.Quantity.Mul(cumulativeFunding.Sub(p.CumulativeFundingEntry))
+	if p.IsLong {
+		return payment
+	}
+	return payment.Neg()
+}
+
 func (p *Position) ApplyPositionDelta(delta *PositionDelta, tradingFeeForReduceOnly sdk.Dec) (
 	payout, closeExecutionMargin, collateralizationMargin sdk.Dec,
 ) {
@@ -0,0 +1,122 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// MinPreLaunchPerpetualMarkPriceWindow is the shortest window a pre-launch perpetual market can be marked to
+	MinPreLaunchPerpetualMarkPriceWindow int64 = 15 * 60
+	// MaxPreLaunchPerpetualMarkPriceWindow is the longest window a pre-launch perpetual market can be marked to
+	MaxPreLaunchPerpetualMarkPriceWindow int64 = 24 * 60 * 60
+)
+
+var (
+	// MinPreLaunchPerpetualInitialMarginRatio caps the leverage of pre-launch perpetual markets to 5x
+	MinPreLaunchPerpetualInitialMarginRatio = sdk.NewDecWithPrec(2, 1)
+	// MinPreLaunchPerpetualMaintenanceMarginRatio is the lowest maintenance margin ratio of pre-launch perpetual markets
+	MinPreLaunchPerpetualMaintenanceMarginRatio = sdk.NewDecWithPrec(1, 1)
+	// MaxPreLaunchPerpetualMarkPriceChangeRate is the highest per window change of the mark price of pre-launch
+	// perpetual markets (5%), so that trading at an off-market price can only move the mark price slowly
+	MaxPreLaunchPerpetualMarkPriceChangeRate = sdk.NewDecWithPrec(5, 2)
+)
+
+// ValidatePreLaunchPerpetualMarginRatios checks that the margin ratios respect the leverage caps of pre-launch perpetual markets
+func ValidatePreLaunchPerpetualMarginRatios(initialMarginRatio, maintenanceMarginRatio sdk.Dec) error {
+	if initialMarginRatio.LT(MinPreLaunchPerpetualInitialMarginRatio) {
+		return sdkerrors.Wrapf(ErrInvalidPreLaunchPerpetualMarket, "initial margin ratio %s must be at least %s", initialMarginRatio.String(), MinPreLaunchPerpetualInitialMarginRatio.String())
+	}
+	if maintenanceMarginRatio.LT(MinPreLaunchPerpetualMaintenanceMarginRatio) {
+		return sdkerrors.Wrapf(ErrInvalidPreLaunchPerpetualMarket, "maintenance margin ratio %s must be at least %s", maintenanceMarginRatio.String(), MinPreLaunchPerpetualMaintenanceMarginRatio.String())
+	}
+	return nil
+}
+
+// ValidatePreLaunchPerpetualMarkPriceWindow checks that the mark price window is within the allowed bounds
+func ValidatePreLaunchPerpetualMarkPriceWindow(window int64) error {
+	if window < MinPreLaunchPerpetualMarkPriceWindow || window > MaxPreLaunchPerpetualMarkPriceWindow {
+		return sdkerrors.Wrapf(ErrInvalidPreLaunchPerpetualMarket, "window %d must be between %d and %d seconds", window, MinPreLaunchPerpetualMarkPriceWindow, MaxPreLaunchPerpetualMarkPriceWindow)
+	}
+	return nil
+}
+
+// ValidatePreLaunchPerpetualMarkPriceBounds checks that a positive quantity must be traded in a window to update the
+// mark price and that the per window change of the mark price is positive and within the allowed bound
+func ValidatePreLaunchPerpetualMarkPriceBounds(minWindowQuantity, maxMarkPriceChangeRate sdk.Dec) error {
+	if minWindowQuantity.IsNil() || !minWindowQuantity.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidPreLaunchPerpetualMarket, "min window quantity must be positive")
+	}
+	if maxMarkPriceChangeRate.IsNil() || !maxMarkPriceChangeRate.IsPositive() || maxMarkPriceChangeRate.GT(MaxPreLaunchPerpetualMarkPriceChangeRate) {
+		return sdkerrors.Wrapf(ErrInvalidPreLaunchPerpetualMarket, "max mark price change rate must be positive and not exceed %s", MaxPreLaunchPerpetualMarkPriceChangeRate.String())
+	}
+	return nil
+}
+
+func NewPreLaunchPerpetualMarketInfo(
+	marketID common.Hash,
+	window int64,
+	initialMarkPrice, minWindowQuantity, maxMarkPriceChangeRate sdk.Dec,
+	blockTime int64,
+) *PreLaunchPerpetualMarketInfo {
+	return &PreLaunchPerpetualMarketInfo{
+		MarketId:               marketID.Hex(),
+		Window:                 window,
+		MarkPrice:              initialMarkPrice,
+		LastPrice:              initialMarkPrice,
+		WindowCumulativePrice:  sdk.ZeroDec(),
+		WindowQuantity:         sdk.ZeroDec(),
+		WindowStartTimestamp:   blockTime,
+		MinWindowQuantity:      minWindowQuantity,
+		MaxMarkPriceChangeRate: maxMarkPriceChangeRate,
+		LastPriceTimestamp:     blockTime,
+	}
+}
+
+func (p *PreLaunchPerpetualMarketInfo) MarketID() common.Hash {
+	return common.HexToHash(p.MarketId)
+}
+
+// ApplyTrade records the VWAP and quantity of the market's trades in the block into the current window. The previous
+// last price is weighted by the seconds it held, and the new one holds from the block time.
+func (p *PreLaunchPerpetualMarketInfo) ApplyTrade(price, quantity sdk.Dec, blockTime int64) {
+	if price.IsNil() || !price.IsPositive() || quantity.IsNil() || !quantity.IsPositive() {
+		return
+	}
+
+	p.WindowCumulativePrice = p.cumulativePriceAt(blockTime)
+	p.WindowQuantity = p.WindowQuantity.Add(quantity)
+	p.LastPrice = price
+	p.LastPriceTimestamp = blockTime
+}
+
+// cumulativePriceAt returns the cumulative price of the current window at the given time, the last price holding since
+// it was set.
+func (p *PreLaunchPerpetualMarketInfo) cumulativePriceAt(blockTime int64) sdk.Dec {
+	return p.WindowCumulativePrice.Add(p.LastPrice.MulInt64(blockTime - p.LastPriceTimestamp))
+}
+
+// IsWindowCompleted returns true if the current window has elapsed
+func (p *PreLaunchPerpetualMarketInfo) IsWindowCompleted(blockTime int64) bool {
+	return blockTime-p.WindowStartTimestamp >= p.Window
+}
+
+// RollWindow sets the mark price to the TWAP of the last prices of the completed window, bounded by the max change
+// rate, if the window's quantity reached the minimum, and starts a new window. The last price of the previous window
+// holds from the start of the window until the first trade, so a trade only weighs for as long as its price held.
+func (p *PreLaunchPerpetualMarketInfo) RollWindow(blockTime int64) {
+	duration := blockTime - p.WindowStartTimestamp
+
+	if p.WindowQuantity.GTE(p.MinWindowQuantity) && p.WindowQuantity.IsPositive() && duration > 0 {
+		// markPrice = ∑(lastPrice * ∆t) / windowDuration, within markPrice * (1 ± maxMarkPriceChangeRate)
+		twap := p.cumulativePriceAt(blockTime).QuoInt64(duration)
+		maxChange := p.MarkPrice.Mul(p.MaxMarkPriceChangeRate)
+		p.MarkPrice = sdk.MaxDec(sdk.MinDec(twap, p.MarkPrice.Add(maxChange)), p.MarkPrice.Sub(maxChange))
+	}
+
+	p.WindowCumulativePrice = sdk.ZeroDec()
+	p.WindowQuantity = sdk.ZeroDec()
+	p.WindowStartTimestamp = blockTime
+	p.LastPriceTimestamp = blockTime
+}
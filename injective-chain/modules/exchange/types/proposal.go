@@ -32,6 +32,13 @@ const (
 	ProposalTypeBinaryOptionsMarketLaunch          string = "ProposalTypeBinaryOptionsMarketLaunch"
 	ProposalTypeBinaryOptionsMarketParamUpdate     string = "ProposalTypeBinaryOptionsMarketParamUpdate"
 	ProposalAtomicMarketOrderFeeMultiplierSchedule string = "ProposalAtomicMarketOrderFeeMultiplierSchedule"
+	ProposalTradeRecordRetentionSchedule           string = "ProposalTradeRecordRetentionSchedule"
+	ProposalTypeVanillaOptionsMarketLaunch         string = "ProposalTypeVanillaOptionsMarketLaunch"
+	ProposalTypeVanillaOptionsMarketParamUpdate    string = "ProposalTypeVanillaOptionsMarketParamUpdate"
+	ProposalTypeCategoricalMarketLaunch            string = "ProposalTypeCategoricalMarketLaunch"
+	ProposalTypeExpiryFuturesMarketSeriesLaunch    string = "ProposalTypeExpiryFuturesMarketSeriesLaunch"
+	ProposalTypeExpiryFuturesMarketSeriesUpdate    string = "ProposalTypeExpiryFuturesMarketSeriesUpdate"
+	ProposalTypePreLaunchPerpetualMarketLaunch     string = "ProposalTypePreLaunchPerpetualMarketLaunch"
 )
 
 func init() {
@@ -69,6 +76,20 @@ func init() {
 	gov.RegisterProposalTypeCodec(&BinaryOptionsMarketParamUpdateProposal{}, "injective/BinaryOptionsMarketParamUpdateProposal")
 	gov.RegisterProposalType(ProposalAtomicMarketOrderFeeMultiplierSchedule)
 	gov.RegisterProposalTypeCodec(&AtomicMarketOrderFeeMultiplierScheduleProposal{}, "injective/AtomicMarketOrderFeeMultiplierScheduleProposal")
+	gov.RegisterProposalType(ProposalTradeRecordRetentionSchedule)
+	gov.RegisterProposalTypeCodec(&TradeRecordRetentionScheduleProposal{}, "injective/TradeRecordRetentionScheduleProposal")
+	gov.RegisterProposalType(ProposalTypeVanillaOptionsMarketLaunch)
+	gov.RegisterProposalTypeCodec(&VanillaOptionsMarketLaunchProposal{}, "injective/VanillaOptionsMarketLaunchProposal")
+	gov.RegisterProposalType(ProposalTypeVanillaOptionsMarketParamUpdate)
+	gov.RegisterProposalTypeCodec(&VanillaOptionsMarketParamUpdateProposal{}, "injective/VanillaOptionsMarketParamUpdateProposal")
+	gov.RegisterProposalType(ProposalTypeCategoricalMarketLaunch)
+	gov.RegisterProposalTypeCodec(&CategoricalMarketLaunchProposal{}, "injective/CategoricalMarketLaunchProposal")
+	gov.RegisterProposalType(ProposalTypeExpiryFuturesMarketSeriesLaunch)
+	gov.RegisterProposalTypeCodec(&ExpiryFuturesMarketSeriesLaunchProposal{}, "injective/ExpiryFuturesMarketSeriesLaunchProposal")
+	gov.RegisterProposalType(ProposalTypeExpiryFuturesMarketSeriesUpdate)
+	gov.RegisterProposalTypeCodec(&ExpiryFuturesMarketSeriesParamUpdateProposal{}, "injective/ExpiryFuturesMarketSeriesParamUpdateProposal")
+	gov.RegisterProposalType(ProposalTypePreLaunchPerpetualMarketLaunch)
+	gov.RegisterProposalTypeCodec(&PreLaunchPerpetualMarketLaunchProposal{}, "injective/PreLaunchPerpetualMarketLaunchProposal")
 }
 
 func SafeIsPositiveInt(v sdk.Int) bool {
@@ -661,7 +682,8 @@ func (p *OracleParams) ValidateBasic() error {
 	}
 	switch p.OracleType {
 	case oracletypes.OracleType_Band, oracletypes.OracleType_PriceFeed, oracletypes.OracleType_Coinbase, oracletypes.OracleType_Chainlink, oracletypes.OracleType_Razor,
-		oracletypes.OracleType_Dia, oracletypes.OracleType_API3, oracletypes.OracleType_Uma, oracletypes.OracleType_Pyth, oracletypes.OracleType_BandIBC, oracletypes.OracleType_Provider:
+		oracletypes.OracleType_Dia, oracletypes.OracleType_API3, oracletypes.OracleType_Uma, oracletypes.OracleType_Pyth, oracletypes.OracleType_BandIBC, oracletypes.OracleType_Provider,
+		oracletypes.OracleType_Composite, oracletypes.OracleType_ExchangeTwap:
 
 	default:
 		return sdkerrors.Wrap(ErrInvalidOracleType, p.OracleType.String())
@@ -1376,7 +1398,7 @@ func NewBinaryOptionsMarketLaunchProposal(
 	expirationTimestamp, settlementTimestamp int64,
 	admin, quoteDenom string,
 	makerFeeRate, takerFeeRate, minPriceTickSize, minQuantityTickSize sdk.Dec,
-
+	scalarLowerBound, scalarUpperBound *sdk.Dec,
 ) *BinaryOptionsMarketLaunchProposal {
 	return &BinaryOptionsMarketLaunchProposal{
 		Title:               title,
@@ -1394,6 +1416,8 @@ func NewBinaryOptionsMarketLaunchProposal(
 		TakerFeeRate:        takerFeeRate,
 		MinPriceTickSize:    minPriceTickSize,
 		MinQuantityTickSize: minQuantityTickSize,
+		ScalarLowerBound:    scalarLowerBound,
+		ScalarUpperBound:    scalarUpperBound,
 	}
 }
 
@@ -1467,6 +1491,10 @@ func (p *BinaryOptionsMarketLaunchProposal) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidQuantityTickSize, err.Error())
 	}
 
+	if err := ValidateScalarBounds(p.ScalarLowerBound, p.ScalarUpperBound); err != nil {
+		return err
+	}
+
 	return gov.ValidateAbstract(p)
 }
 
@@ -1612,6 +1640,619 @@ func (p *BinaryOptionsMarketParamUpdateProposal) ValidateBasic() error {
 	return gov.ValidateAbstract(p)
 }
 
+// NewVanillaOptionsMarketLaunchProposal returns new instance of VanillaOptionsMarketLaunchProposal
+func NewVanillaOptionsMarketLaunchProposal(
+	title, description, ticker, oracleBase, oracleQuote string,
+	oracleType oracletypes.OracleType, oracleScaleFactor uint32,
+	optionType OptionType, strikePrice, contractMultiplier, maxUnderlyingPrice sdk.Dec,
+	expirationTimestamp, settlementTimestamp int64,
+	quoteDenom string,
+	makerFeeRate, takerFeeRate, minPriceTickSize, minQuantityTickSize sdk.Dec,
+) *VanillaOptionsMarketLaunchProposal {
+	return &VanillaOptionsMarketLaunchProposal{
+		Title:               title,
+		Description:         description,
+		Ticker:              ticker,
+		OracleBase:          oracleBase,
+		OracleQuote:         oracleQuote,
+		OracleType:          oracleType,
+		OracleScaleFactor:   oracleScaleFactor,
+		OptionType:          optionType,
+		StrikePrice:         strikePrice,
+		ContractMultiplier:  contractMultiplier,
+		MaxUnderlyingPrice:  maxUnderlyingPrice,
+		ExpirationTimestamp: expirationTimestamp,
+		SettlementTimestamp: settlementTimestamp,
+		QuoteDenom:          quoteDenom,
+		MakerFeeRate:        makerFeeRate,
+		TakerFeeRate:        takerFeeRate,
+		MinPriceTickSize:    minPriceTickSize,
+		MinQuantityTickSize: minQuantityTickSize,
+	}
+}
+
+// Implements Proposal Interface
+var _ gov.Content = &VanillaOptionsMarketLaunchProposal{}
+
+// GetTitle returns the title of this proposal.
+func (p *VanillaOptionsMarketLaunchProposal) GetTitle() string {
+	return p.Title
+}
+
+// GetDescription returns the description of this proposal.
+func (p *VanillaOptionsMarketLaunchProposal) GetDescription() string {
+	return p.Description
+}
+
+// ProposalRoute returns router key of this proposal.
+func (p *VanillaOptionsMarketLaunchProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type of this proposal.
+func (p *VanillaOptionsMarketLaunchProposal) ProposalType() string {
+	return ProposalTypeVanillaOptionsMarketLaunch
+}
+
+// ValidateBasic returns ValidateBasic result of a vanilla options market launch proposal.
+func (p *VanillaOptionsMarketLaunchProposal) ValidateBasic() error {
+	if p.Ticker == "" || len(p.Ticker) > MaxTickerLength {
+		return sdkerrors.Wrap(ErrInvalidTicker, "ticker should not be empty or exceed 30 characters")
+	}
+	if p.QuoteDenom == "" {
+		return sdkerrors.Wrap(ErrInvalidQuoteDenom, "quote denom should not be empty")
+	}
+
+	oracleParams := NewOracleParams(p.OracleBase, p.OracleQuote, p.OracleScaleFactor, p.OracleType)
+	if err := oracleParams.ValidateBasic(); err != nil {
+		return err
+	}
+
+	switch p.OptionType {
+	case OptionType_CallOption:
+		if p.MaxUnderlyingPrice.IsNil() || p.MaxUnderlyingPrice.LTE(p.StrikePrice) {
+			return sdkerrors.Wrap(ErrInvalidPrice, "max underlying price of call options must be greater than the strike price")
+		}
+	case OptionType_PutOption:
+	default:
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, p.OptionType.String())
+	}
+
+	if !SafeIsPositiveDec(p.StrikePrice) || p.StrikePrice.GT(MaxOrderPrice) {
+		return sdkerrors.Wrap(ErrInvalidPrice, "strike price must be positive")
+	}
+	if !SafeIsPositiveDec(p.ContractMultiplier) || p.ContractMultiplier.GT(MaxOrderQuantity) {
+		return sdkerrors.Wrap(ErrInvalidQuantity, "contract multiplier must be positive")
+	}
+
+	if p.ExpirationTimestamp >= p.SettlementTimestamp || p.ExpirationTimestamp <= 0 || p.SettlementTimestamp <= 0 {
+		return ErrInvalidExpiry
+	}
+
+	if err := ValidateMakerFee(p.MakerFeeRate); err != nil {
+		return err
+	}
+	if err := ValidateFee(p.TakerFeeRate); err != nil {
+		return err
+	}
+
+	if p.MakerFeeRate.GT(p.TakerFeeRate) {
+		return ErrFeeRatesRelation
+	}
+
+	if err := ValidateTickSize(p.MinPriceTickSize); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPriceTickSize, err.Error())
+	}
+	if err := ValidateTickSize(p.MinQuantityTickSize); err != nil {
+		return sdkerrors.Wrap(ErrInvalidQuantityTickSize, err.Error())
+	}
+
+	return gov.ValidateAbstract(p)
+}
+
+// NewVanillaOptionsMarketParamUpdateProposal returns new instance of VanillaOptionsMarketParamUpdateProposal
+func NewVanillaOptionsMarketParamUpdateProposal(
+	title, description string, marketID string,
+	makerFeeRate, takerFeeRate, relayerFeeShareRate, minPriceTickSize, minQuantityTickSize *sdk.Dec,
+	expirationTimestamp, settlementTimestamp int64,
+) *VanillaOptionsMarketParamUpdateProposal {
+	return &VanillaOptionsMarketParamUpdateProposal{
+		Title:               title,
+		Description:         description,
+		MarketId:            marketID,
+		MakerFeeRate:        makerFeeRate,
+		TakerFeeRate:        takerFeeRate,
+		RelayerFeeShareRate: relayerFeeShareRate,
+		MinPriceTickSize:    minPriceTickSize,
+		MinQuantityTickSize: minQuantityTickSize,
+		ExpirationTimestamp: expirationTimestamp,
+		SettlementTimestamp: settlementTimestamp,
+	}
+}
+
+// Implements Proposal Interface
+var _ gov.Content = &VanillaOptionsMarketParamUpdateProposal{}
+
+// GetTitle returns the title of this proposal
+func (p *VanillaOptionsMarketParamUpdateProposal) GetTitle() string {
+	return p.Title
+}
+
+// GetDescription returns the description of this proposal
+func (p *VanillaOptionsMarketParamUpdateProposal) GetDescription() string {
+	return p.Description
+}
+
+// ProposalRoute returns router key of this proposal.
+func (p *VanillaOptionsMarketParamUpdateProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type of this proposal.
+func (p *VanillaOptionsMarketParamUpdateProposal) ProposalType() string {
+	return ProposalTypeVanillaOptionsMarketParamUpdate
+}
+
+// ValidateBasic returns ValidateBasic result of this proposal.
+func (p *VanillaOptionsMarketParamUpdateProposal) ValidateBasic() error {
+	if !IsHexHash(p.MarketId) {
+		return sdkerrors.Wrap(ErrMarketInvalid, p.MarketId)
+	}
+	if p.MakerFeeRate == nil &&
+		p.TakerFeeRate == nil &&
+		p.RelayerFeeShareRate == nil &&
+		p.MinPriceTickSize == nil &&
+		p.MinQuantityTickSize == nil &&
+		p.ExpirationTimestamp == 0 &&
+		p.SettlementTimestamp == 0 {
+		return sdkerrors.Wrap(gov.ErrInvalidProposalContent, "At least one field should not be nil")
+	}
+
+	if p.MakerFeeRate != nil {
+		if err := ValidateMakerFee(*p.MakerFeeRate); err != nil {
+			return err
+		}
+	}
+	if p.TakerFeeRate != nil {
+		if err := ValidateFee(*p.TakerFeeRate); err != nil {
+			return err
+		}
+	}
+
+	if p.RelayerFeeShareRate != nil {
+		if err := ValidateFee(*p.RelayerFeeShareRate); err != nil {
+			return err
+		}
+	}
+
+	if p.MinPriceTickSize != nil {
+		if err := ValidateTickSize(*p.MinPriceTickSize); err != nil {
+			return sdkerrors.Wrap(ErrInvalidPriceTickSize, err.Error())
+		}
+	}
+
+	if p.MinQuantityTickSize != nil {
+		if err := ValidateTickSize(*p.MinQuantityTickSize); err != nil {
+			return sdkerrors.Wrap(ErrInvalidQuantityTickSize, err.Error())
+		}
+	}
+
+	if p.ExpirationTimestamp < 0 {
+		return ErrInvalidExpiry
+	}
+
+	if p.SettlementTimestamp < 0 {
+		return ErrInvalidSettlement
+	}
+
+	if p.ExpirationTimestamp != 0 && p.SettlementTimestamp != 0 && p.ExpirationTimestamp >= p.SettlementTimestamp {
+		return ErrInvalidExpiry
+	}
+
+	return gov.ValidateAbstract(p)
+}
+
+// NewCategoricalMarketLaunchProposal returns new instance of CategoricalMarketLaunchProposal
+func NewCategoricalMarketLaunchProposal(
+	title, description, ticker, oracleSymbol, oracleProvider string,
+	oracleType oracletypes.OracleType, oracleScaleFactor uint32,
+	expirationTimestamp, settlementTimestamp int64,
+	admin, quoteDenom string,
+	outcomes []string,
+	makerFeeRate, takerFeeRate, minPriceTickSize, minQuantityTickSize sdk.Dec,
+) *CategoricalMarketLaunchProposal {
+	return &CategoricalMarketLaunchProposal{
+		Title:               title,
+		Description:         description,
+		Ticker:              ticker,
+		OracleSymbol:        oracleSymbol,
+		OracleProvider:      oracleProvider,
+		OracleType:          oracleType,
+		OracleScaleFactor:   oracleScaleFactor,
+		ExpirationTimestamp: expirationTimestamp,
+		SettlementTimestamp: settlementTimestamp,
+		Admin:               admin,
+		QuoteDenom:          quoteDenom,
+		Outcomes:            outcomes,
+		MakerFeeRate:        makerFeeRate,
+		TakerFeeRate:        takerFeeRate,
+		MinPriceTickSize:    minPriceTickSize,
+		MinQuantityTickSize: minQuantityTickSize,
+	}
+}
+
+// Implements Proposal Interface
+var _ gov.Content = &CategoricalMarketLaunchProposal{}
+
+// GetTitle returns the title of this proposal.
+func (p *CategoricalMarketLaunchProposal) GetTitle() string {
+	return p.Title
+}
+
+// GetDescription returns the description of this proposal.
+func (p *CategoricalMarketLaunchProposal) GetDescription() string {
+	return p.Description
+}
+
+// ProposalRoute returns router key of this proposal.
+func (p *CategoricalMarketLaunchProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type of this proposal.
+func (p *CategoricalMarketLaunchProposal) ProposalType() string {
+	return ProposalTypeCategoricalMarketLaunch
+}
+
+// ValidateBasic returns ValidateBasic result of this proposal.
+func (p *CategoricalMarketLaunchProposal) ValidateBasic() error {
+	if p.Ticker == "" || len(p.Ticker) > MaxTickerLength {
+		return sdkerrors.Wrapf(ErrInvalidTicker, "ticker should not be empty or exceed %d characters", MaxTickerLength)
+	}
+	if err := ValidateCategoricalOutcomes(p.Ticker, p.Outcomes); err != nil {
+		return err
+	}
+	if p.OracleSymbol == "" {
+		return sdkerrors.Wrap(ErrInvalidOracle, "oracle symbol should not be empty")
+	}
+	if p.OracleProvider == "" {
+		return sdkerrors.Wrap(ErrInvalidOracle, "oracle provider should not be empty")
+	}
+	if p.OracleType != oracletypes.OracleType_Provider {
+		return sdkerrors.Wrap(ErrInvalidOracleType, p.OracleType.String())
+	}
+	if p.OracleScaleFactor > MaxOracleScaleFactor {
+		return ErrExceedsMaxOracleScaleFactor
+	}
+
+	if p.ExpirationTimestamp >= p.SettlementTimestamp || p.ExpirationTimestamp < 0 || p.SettlementTimestamp < 0 {
+		return ErrInvalidExpiry
+	}
+
+	if p.Admin != "" {
+		_, err := sdk.AccAddressFromBech32(p.Admin)
+		if err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, p.Admin)
+		}
+	}
+	if p.QuoteDenom == "" {
+		return sdkerrors.Wrap(ErrInvalidQuoteDenom, "quote denom should not be empty")
+	}
+	if err := ValidateMakerFee(p.MakerFeeRate); err != nil {
+		return err
+	}
+	if err := ValidateFee(p.TakerFeeRate); err != nil {
+		return err
+	}
+
+	if p.MakerFeeRate.GT(p.TakerFeeRate) {
+		return ErrFeeRatesRelation
+	}
+
+	if err := ValidateTickSize(p.MinPriceTickSize); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPriceTickSize, err.Error())
+	}
+	if err := ValidateTickSize(p.MinQuantityTickSize); err != nil {
+		return sdkerrors.Wrap(ErrInvalidQuantityTickSize, err.Error())
+	}
+
+	return gov.ValidateAbstract(p)
+}
+
+// NewExpiryFuturesMarketSeriesLaunchProposal returns new instance of ExpiryFuturesMarketSeriesLaunchProposal
+func NewExpiryFuturesMarketSeriesLaunchProposal(
+	title, description, tickerPrefix, quoteDenom, oracleBase, oracleQuote string, oracleScaleFactor uint32, oracleType oracletypes.OracleType,
+	firstExpirationTimestamp, interval, leadTime int64,
+	initialMarginRatio, maintenanceMarginRatio, makerFeeRate, takerFeeRate, minPriceTickSize, minQuantityTickSize sdk.Dec,
+) *ExpiryFuturesMarketSeriesLaunchProposal {
+	return &ExpiryFuturesMarketSeriesLaunchProposal{
+		Title:                    title,
+		Description:              description,
+		TickerPrefix:             tickerPrefix,
+		QuoteDenom:               quoteDenom,
+		OracleBase:               oracleBase,
+		OracleQuote:              oracleQuote,
+		OracleScaleFactor:        oracleScaleFactor,
+		OracleType:               oracleType,
+		FirstExpirationTimestamp: firstExpirationTimestamp,
+		Interval:                 interval,
+		LeadTime:                 leadTime,
+		InitialMarginRatio:       initialMarginRatio,
+		MaintenanceMarginRatio:   maintenanceMarginRatio,
+		MakerFeeRate:             makerFeeRate,
+		TakerFeeRate:             takerFeeRate,
+		MinPriceTickSize:         minPriceTickSize,
+		MinQuantityTickSize:      minQuantityTickSize,
+	}
+}
+
+// Implements Proposal Interface
+var _ gov.Content = &ExpiryFuturesMarketSeriesLaunchProposal{}
+
+// GetTitle returns the title of this proposal.
+func (p *ExpiryFuturesMarketSeriesLaunchProposal) GetTitle() string {
+	return p.Title
+}
+
+// GetDescription returns the description of this proposal.
+func (p *ExpiryFuturesMarketSeriesLaunchProposal) GetDescription() string {
+	return p.Description
+}
+
+// ProposalRoute returns router key of this proposal.
+func (p *ExpiryFuturesMarketSeriesLaunchProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type of this proposal.
+func (p *ExpiryFuturesMarketSeriesLaunchProposal) ProposalType() string {
+	return ProposalTypeExpiryFuturesMarketSeriesLaunch
+}
+
+// ValidateBasic returns ValidateBasic result of this proposal.
+func (p *ExpiryFuturesMarketSeriesLaunchProposal) ValidateBasic() error {
+	if p.TickerPrefix == "" || len(GetExpiryFuturesSeriesContractTicker(p.TickerPrefix, p.FirstExpirationTimestamp)) > MaxTickerLength {
+		return sdkerrors.Wrapf(ErrInvalidTicker, "ticker prefix should not be empty and the contract tickers should not exceed %d characters", MaxTickerLength)
+	}
+	if p.QuoteDenom == "" {
+		return sdkerrors.Wrap(ErrInvalidQuoteDenom, "quote denom should not be empty")
+	}
+
+	oracleParams := NewOracleParams(p.OracleBase, p.OracleQuote, p.OracleScaleFactor, p.OracleType)
+	if err := oracleParams.ValidateBasic(); err != nil {
+		return err
+	}
+	if p.FirstExpirationTimestamp <= 0 {
+		return sdkerrors.Wrap(ErrInvalidExpiry, "first expiration timestamp should not be empty")
+	}
+	if err := ValidateExpiryFuturesSeriesSchedule(p.Interval, p.LeadTime); err != nil {
+		return err
+	}
+	if err := ValidateMakerFee(p.MakerFeeRate); err != nil {
+		return err
+	}
+	if err := ValidateFee(p.TakerFeeRate); err != nil {
+		return err
+	}
+	if err := ValidateMarginRatio(p.InitialMarginRatio); err != nil {
+		return err
+	}
+	if err := ValidateMarginRatio(p.MaintenanceMarginRatio); err != nil {
+		return err
+	}
+	if p.MakerFeeRate.GT(p.TakerFeeRate) {
+		return ErrFeeRatesRelation
+	}
+	if p.InitialMarginRatio.LT(p.MaintenanceMarginRatio) {
+		return ErrMarginsRelation
+	}
+
+	if err := ValidateTickSize(p.MinPriceTickSize); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPriceTickSize, err.Error())
+	}
+	if err := ValidateTickSize(p.MinQuantityTickSize); err != nil {
+		return sdkerrors.Wrap(ErrInvalidQuantityTickSize, err.Error())
+	}
+
+	return gov.ValidateAbstract(p)
+}
+
+// NewExpiryFuturesMarketSeriesParamUpdateProposal returns new instance of ExpiryFuturesMarketSeriesParamUpdateProposal
+func NewExpiryFuturesMarketSeriesParamUpdateProposal(
+	title, description, seriesID string,
+	initialMarginRatio, maintenanceMarginRatio, makerFeeRate, takerFeeRate, minPriceTickSize, minQuantityTickSize *sdk.Dec,
+	leadTime int64, status MarketStatus,
+) *ExpiryFuturesMarketSeriesParamUpdateProposal {
+	return &ExpiryFuturesMarketSeriesParamUpdateProposal{
+		Title:                  title,
+		Description:            description,
+		SeriesId:               seriesID,
+		InitialMarginRatio:     initialMarginRatio,
+		MaintenanceMarginRatio: maintenanceMarginRatio,
+		MakerFeeRate:           makerFeeRate,
+		TakerFeeRate:           takerFeeRate,
+		MinPriceTickSize:       minPriceTickSize,
+		MinQuantityTickSize:    minQuantityTickSize,
+		LeadTime:               leadTime,
+		Status:                 status,
+	}
+}
+
+// Implements Proposal Interface
+var _ gov.Content = &ExpiryFuturesMarketSeriesParamUpdateProposal{}
+
+// GetTitle returns the title of this proposal.
+func (p *ExpiryFuturesMarketSeriesParamUpdateProposal) GetTitle() string {
+	return p.Title
+}
+
+// GetDescription returns the description of this proposal.
+func (p *ExpiryFuturesMarketSeriesParamUpdateProposal) GetDescription() string {
+	return p.Description
+}
+
+// ProposalRoute returns router key of this proposal.
+func (p *ExpiryFuturesMarketSeriesParamUpdateProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type of this proposal.
+func (p *ExpiryFuturesMarketSeriesParamUpdateProposal) ProposalType() string {
+	return ProposalTypeExpiryFuturesMarketSeriesUpdate
+}
+
+// ValidateBasic returns ValidateBasic result of this proposal.
+func (p *ExpiryFuturesMarketSeriesParamUpdateProposal) ValidateBasic() error {
+	if !IsHexHash(p.SeriesId) {
+		return sdkerrors.Wrap(ErrInvalidExpiryFuturesMarketSeries, p.SeriesId)
+	}
+	if p.MakerFeeRate == nil &&
+		p.TakerFeeRate == nil &&
+		p.MinPriceTickSize == nil &&
+		p.MinQuantityTickSize == nil &&
+		p.InitialMarginRatio == nil &&
+		p.MaintenanceMarginRatio == nil &&
+		p.LeadTime == 0 &&
+		p.Status == MarketStatus_Unspecified {
+		return sdkerrors.Wrap(gov.ErrInvalidProposalContent, "At least one field should not be nil")
+	}
+
+	if p.MakerFeeRate != nil {
+		if err := ValidateMakerFee(*p.MakerFeeRate); err != nil {
+			return err
+		}
+	}
+	if p.TakerFeeRate != nil {
+		if err := ValidateFee(*p.TakerFeeRate); err != nil {
+			return err
+		}
+	}
+
+	if p.InitialMarginRatio != nil {
+		if err := ValidateMarginRatio(*p.InitialMarginRatio); err != nil {
+			return err
+		}
+	}
+	if p.MaintenanceMarginRatio != nil {
+		if err := ValidateMarginRatio(*p.MaintenanceMarginRatio); err != nil {
+			return err
+		}
+	}
+
+	if p.MinPriceTickSize != nil {
+		if err := ValidateTickSize(*p.MinPriceTickSize); err != nil {
+			return sdkerrors.Wrap(ErrInvalidPriceTickSize, err.Error())
+		}
+	}
+	if p.MinQuantityTickSize != nil {
+		if err := ValidateTickSize(*p.MinQuantityTickSize); err != nil {
+			return sdkerrors.Wrap(ErrInvalidQuantityTickSize, err.Error())
+		}
+	}
+
+	if p.LeadTime < 0 {
+		return sdkerrors.Wrap(ErrInvalidExpiryFuturesMarketSeries, "lead time cannot be negative")
+	}
+
+	switch p.Status {
+	case
+		MarketStatus_Unspecified,
+		MarketStatus_Active,
+		MarketStatus_Paused:
+
+	default:
+		return sdkerrors.Wrap(ErrInvalidMarketStatus, p.Status.String())
+	}
+
+	return gov.ValidateAbstract(p)
+}
+
+// NewPreLaunchPerpetualMarketLaunchProposal returns new instance of PreLaunchPerpetualMarketLaunchProposal
+func NewPreLaunchPerpetualMarketLaunchProposal(
+	title, description, ticker, quoteDenom string,
+	initialMarkPrice sdk.Dec, window int64,
+	initialMarginRatio, maintenanceMarginRatio, makerFeeRate, takerFeeRate, minPriceTickSize, minQuantityTickSize sdk.Dec,
+	minWindowQuantity, maxMarkPriceChangeRate sdk.Dec,
+) *PreLaunchPerpetualMarketLaunchProposal {
+	return &PreLaunchPerpetualMarketLaunchProposal{
+		Title:                  title,
+		Description:            description,
+		Ticker:                 ticker,
+		QuoteDenom:             quoteDenom,
+		InitialMarkPrice:       initialMarkPrice,
+		Window:                 window,
+		InitialMarginRatio:     initialMarginRatio,
+		MaintenanceMarginRatio: maintenanceMarginRatio,
+		MakerFeeRate:           makerFeeRate,
+		TakerFeeRate:           takerFeeRate,
+		MinPriceTickSize:       minPriceTickSize,
+		MinQuantityTickSize:    minQuantityTickSize,
+		MinWindowQuantity:      minWindowQuantity,
+		MaxMarkPriceChangeRate: maxMarkPriceChangeRate,
+	}
+}
+
+// Implements Proposal Interface
+var _ gov.Content = &PreLaunchPerpetualMarketLaunchProposal{}
+
+// GetTitle returns the title of this proposal.
+func (p *PreLaunchPerpetualMarketLaunchProposal) GetTitle() string {
+	return p.Title
+}
+
+// GetDescription returns the description of this proposal.
+func (p *PreLaunchPerpetualMarketLaunchProposal) GetDescription() string {
+	return p.Description
+}
+
+// ProposalRoute returns router key of this proposal.
+func (p *PreLaunchPerpetualMarketLaunchProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type of this proposal.
+func (p *PreLaunchPerpetualMarketLaunchProposal) ProposalType() string {
+	return ProposalTypePreLaunchPerpetualMarketLaunch
+}
+
+// ValidateBasic returns ValidateBasic result of a pre-launch perpetual market launch proposal.
+func (p *PreLaunchPerpetualMarketLaunchProposal) ValidateBasic() error {
+	if p.Ticker == "" || len(p.Ticker) > MaxTickerLength {
+		return sdkerrors.Wrap(ErrInvalidTicker, "ticker should not be empty or exceed 30 characters")
+	}
+	if p.QuoteDenom == "" {
+		return sdkerrors.Wrap(ErrInvalidQuoteDenom, "quote denom should not be empty")
+	}
+	if p.InitialMarkPrice.IsNil() || !p.InitialMarkPrice.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidPrice, "initial mark price must be positive")
+	}
+	if err := ValidatePreLaunchPerpetualMarkPriceWindow(p.Window); err != nil {
+		return err
+	}
+	if err := ValidatePreLaunchPerpetualMarkPriceBounds(p.MinWindowQuantity, p.MaxMarkPriceChangeRate); err != nil {
+		return err
+	}
+
+	if err := ValidateMakerFee(p.MakerFeeRate); err != nil {
+		return err
+	}
+	if err := ValidateFee(p.TakerFeeRate); err != nil {
+		return err
+	}
+	if err := ValidateMarginRatio(p.InitialMarginRatio); err != nil {
+		return err
+	}
+	if err := ValidateMarginRatio(p.MaintenanceMarginRatio); err != nil {
+		return err
+	}
+	if p.MakerFeeRate.GT(p.TakerFeeRate) {
+		return ErrFeeRatesRelation
+	}
+	if p.InitialMarginRatio.LT(p.MaintenanceMarginRatio) {
+		return ErrMarginsRelation
+	}
+	if err := ValidatePreLaunchPerpetualMarginRatios(p.InitialMarginRatio, p.MaintenanceMarginRatio); err != nil {
+		return err
+	}
+
+	if err := ValidateTickSize(p.MinPriceTickSize); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPriceTickSize, err.Error())
+	}
+	if err := ValidateTickSize(p.MinQuantityTickSize); err != nil {
+		return sdkerrors.Wrap(ErrInvalidQuantityTickSize, err.Error())
+	}
+
+	return gov.ValidateAbstract(p)
+}
+
 // Implements Proposal Interface
 var _ gov.Content = &AtomicMarketOrderFeeMultiplierScheduleProposal{}
 
@@ -1656,3 +2297,44 @@ func (p *AtomicMarketOrderFeeMultiplierScheduleProposal) ValidateBasic() error {
 	}
 	return gov.ValidateAbstract(p)
 }
+
+// Implements Proposal Interface
+var _ gov.Content = &TradeRecordRetentionScheduleProposal{}
+
+// GetTitle returns the title of this proposal
+func (p *TradeRecordRetentionScheduleProposal) GetTitle() string {
+	return p.Title
+}
+
+// GetDescription returns the description of this proposal
+func (p *TradeRecordRetentionScheduleProposal) GetDescription() string {
+	return p.Description
+}
+
+// ProposalRoute returns router key of this proposal.
+func (p *TradeRecordRetentionScheduleProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type of this proposal.
+func (p *TradeRecordRetentionScheduleProposal) ProposalType() string {
+	return ProposalTradeRecordRetentionSchedule
+}
+
+func (p *TradeRecordRetentionScheduleProposal) ValidateBasic() error {
+	if len(p.MarketTradeRecordRetentions) == 0 {
+		return sdkerrors.Wrap(gov.ErrInvalidProposalContent, "At least one trade record retention should be provided")
+	}
+	for _, r := range p.MarketTradeRecordRetentions {
+		if !IsHexHash(r.MarketId) {
+			return sdkerrors.Wrap(ErrMarketInvalid, r.MarketId)
+		}
+
+		if r.RetentionSeconds < MaxHistoricalTradeRecordAge {
+			return fmt.Errorf("trade record retention cannot be less than %d seconds: %d", MaxHistoricalTradeRecordAge, r.RetentionSeconds)
+		}
+
+		if r.RetentionSeconds > MaxTradeRecordRetention {
+			return fmt.Errorf("trade record retention cannot be bigger than %d seconds: %d", MaxTradeRecordRetention, r.RetentionSeconds)
+		}
+	}
+	return gov.ValidateAbstract(p)
+}
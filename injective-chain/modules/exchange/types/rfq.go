@@ -0,0 +1,90 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MaxRFQRequestDuration is the longest time an RFQ request can stay open (1 day)
+const MaxRFQRequestDuration int64 = 24 * 60 * 60
+
+// RFQQuoteSignDoc is the document a maker signs to quote an RFQ request, binding the quote to the chain
+type RFQQuoteSignDoc struct {
+	ChainID string   `json:"chain_id"`
+	Quote   RFQQuote `json:"quote"`
+}
+
+// GetRFQQuoteSignBytes returns the bytes a maker signs to quote an RFQ request on the given chain
+func GetRFQQuoteSignBytes(chainID string, quote *RFQQuote) []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&RFQQuoteSignDoc{
+		ChainID: chainID,
+		Quote:   *quote,
+	}))
+}
+
+func NewRFQRequest(requestID uint64, taker sdk.AccAddress, subaccountID common.Hash, msg *MsgCreateRFQRequest) *RFQRequest {
+	return &RFQRequest{
+		RequestId:    requestID,
+		MarketId:     msg.MarketId,
+		Taker:        taker.String(),
+		SubaccountId: subaccountID.Hex(),
+		IsBuy:        msg.IsBuy,
+		Quantity:     msg.Quantity,
+		Expiry:       msg.Expiry,
+	}
+}
+
+func (r *RFQRequest) MarketID() common.Hash {
+	return common.HexToHash(r.MarketId)
+}
+
+func (r *RFQRequest) SubaccountID() common.Hash {
+	return common.HexToHash(r.SubaccountId)
+}
+
+// IsExpired returns true if the request can't be accepted anymore at the given block time
+func (r *RFQRequest) IsExpired(blockTime int64) bool {
+	return blockTime > r.Expiry
+}
+
+func (q *RFQQuote) MarketID() common.Hash {
+	return common.HexToHash(q.MarketId)
+}
+
+func (q *RFQQuote) MakerSubaccountID() common.Hash {
+	return common.HexToHash(q.MakerSubaccountId)
+}
+
+// MakerAddress returns the address of the account owning the maker subaccount, which must sign the quote
+func (q *RFQQuote) MakerAddress() sdk.AccAddress {
+	return SubaccountIDToSdkAddress(q.MakerSubaccountID())
+}
+
+// IsExpired returns true if the quote can't be accepted anymore at the given block time
+func (q *RFQQuote) IsExpired(blockTime int64) bool {
+	return blockTime > q.Expiry
+}
+
+func (q *RFQQuote) ValidateBasic() error {
+	if !IsHexHash(q.MarketId) {
+		return sdkerrors.Wrap(ErrMarketInvalid, q.MarketId)
+	}
+
+	if _, ok := IsValidSubaccountID(q.MakerSubaccountId); !ok {
+		return sdkerrors.Wrap(ErrBadSubaccountID, q.MakerSubaccountId)
+	}
+
+	if q.Price.IsNil() || !q.Price.IsPositive() || q.Price.GT(MaxOrderPrice) {
+		return sdkerrors.Wrap(ErrInvalidPrice, q.Price.String())
+	}
+
+	if q.Margin.IsNil() || q.Margin.IsNegative() || q.Margin.GT(MaxOrderMargin) {
+		return sdkerrors.Wrap(ErrInsufficientOrderMargin, q.Margin.String())
+	}
+
+	if q.Expiry <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidRFQQuote, "expiry must be positive, got %d", q.Expiry)
+	}
+	return nil
+}
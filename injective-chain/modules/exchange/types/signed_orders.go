@@ -0,0 +1,188 @@
+package types
+
+import (
+	"strconv"
+
+	"github.com/InjectiveLabs/sdk-go/typeddata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethmath "github.com/ethereum/go-ethereum/common/math"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/secp256k1"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/crypto/ethsecp256k1"
+	chaintypes "github.com/InjectiveLabs/injective-core/injective-chain/types"
+)
+
+// MaxSignedOrderDuration is the longest time a signed order can be submitted for (7 days), which bounds how long its
+// nonce is kept
+const MaxSignedOrderDuration int64 = 7 * 24 * 60 * 60
+
+var eip712SignedOrderTypes = typeddata.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "salt", Type: "bytes32"},
+	},
+	"SignedOrder": {
+		{Name: "MarketId", Type: "string"},
+		{Name: "SubaccountId", Type: "string"},
+		{Name: "FeeRecipient", Type: "string"},
+		{Name: "OrderType", Type: "string"},
+		{Name: "Price", Type: "string"},
+		{Name: "Quantity", Type: "string"},
+		{Name: "Margin", Type: "string"},
+		{Name: "Nonce", Type: "uint64"},
+		{Name: "Expiry", Type: "int64"},
+	},
+}
+
+// ComputeSignHash returns the EIP-712 hash of the order signed by the trader. The domain is derived from the chain ID of
+// the Cosmos chain: its epoch is the EIP-712 chain ID and the keccak256 of the full chain ID is the salt, so that an
+// order can't be replayed on another chain.
+func (o *SignedOrder) ComputeSignHash(chainID string) ([]byte, error) {
+	ethChainID, err := chaintypes.ParseChainID(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	typedData := typeddata.TypedData{
+		Types:       eip712SignedOrderTypes,
+		PrimaryType: "SignedOrder",
+		Domain: typeddata.TypedDataDomain{
+			Name:    "Injective Protocol",
+			Version: "2.0.0",
+			ChainId: (*ethmath.HexOrDecimal256)(ethChainID),
+			Salt:    ethcrypto.Keccak256Hash([]byte(chainID)).Hex(),
+		},
+		Message: typeddata.TypedDataMessage{
+			"MarketId":     o.MarketId,
+			"SubaccountId": o.SubaccountId,
+			"FeeRecipient": o.FeeRecipient,
+			"OrderType":    o.OrderType.String(),
+			"Price":        o.Price.String(),
+			"Quantity":     o.Quantity.String(),
+			"Margin":       o.Margin.String(),
+			"Nonce":        strconv.FormatUint(o.Nonce, 10),
+			"Expiry":       strconv.FormatInt(o.Expiry, 10),
+		},
+	}
+
+	return typeddata.ComputeTypedDataHash(typedData)
+}
+
+// RecoverSigner returns the address of the account which signed the order on the given chain.
+func (o *SignedOrder) RecoverSigner(chainID string) (sdk.AccAddress, error) {
+	signHash, err := o.ComputeSignHash(chainID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidSignedOrder, err.Error())
+	}
+
+	// wallets set the recovery ID to 27 or 28
+	signature := append([]byte{}, o.Signature...)
+	if signature[64] >= 27 {
+		signature[64] -= 27
+	}
+
+	pubKey, err := secp256k1.RecoverPubkey(signHash, signature)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidSignedOrder, "failed to recover signer: %s", err.Error())
+	}
+
+	ecPubKey, err := ethcrypto.UnmarshalPubkey(pubKey)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidSignedOrder, "failed to unmarshal signer public key: %s", err.Error())
+	}
+
+	signer := (&ethsecp256k1.PubKey{
+		Key: ethcrypto.CompressPubkey(ecPubKey),
+	}).Address()
+	return sdk.AccAddress(signer.Bytes()), nil
+}
+
+func (o *SignedOrder) MarketID() common.Hash {
+	return common.HexToHash(o.MarketId)
+}
+
+func (o *SignedOrder) SubaccountID() common.Hash {
+	return common.HexToHash(o.SubaccountId)
+}
+
+// Trader returns the address of the account owning the subaccount of the order
+func (o *SignedOrder) Trader() sdk.AccAddress {
+	return SubaccountIDToSdkAddress(o.SubaccountID())
+}
+
+func (o *SignedOrder) ValidateBasic() error {
+	if !IsHexHash(o.MarketId) {
+		return sdkerrors.Wrap(ErrMarketInvalid, o.MarketId)
+	}
+
+	if _, ok := IsValidSubaccountID(o.SubaccountId); !ok {
+		return sdkerrors.Wrap(ErrBadSubaccountID, o.SubaccountId)
+	}
+
+	if o.FeeRecipient != "" {
+		if _, err := sdk.AccAddressFromBech32(o.FeeRecipient); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, o.FeeRecipient)
+		}
+	}
+
+	switch o.OrderType {
+	case OrderType_BUY, OrderType_SELL, OrderType_BUY_PO, OrderType_SELL_PO:
+	default:
+		return sdkerrors.Wrapf(ErrInvalidOrderTypeForMessage, "signed orders must be BUY, SELL, BUY_PO or SELL_PO orders, got %s", o.OrderType.String())
+	}
+
+	if o.Price.IsNil() || !o.Price.IsPositive() || o.Price.GT(MaxOrderPrice) {
+		return sdkerrors.Wrap(ErrInvalidPrice, o.Price.String())
+	}
+
+	if o.Quantity.IsNil() || !o.Quantity.IsPositive() || o.Quantity.GT(MaxOrderQuantity) {
+		return sdkerrors.Wrap(ErrInvalidQuantity, o.Quantity.String())
+	}
+
+	if o.Margin.IsNil() || o.Margin.IsNegative() || o.Margin.GT(MaxOrderMargin) {
+		return sdkerrors.Wrap(ErrInsufficientOrderMargin, o.Margin.String())
+	}
+
+	if o.Expiry <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidSignedOrder, "expiry must be positive, got %d", o.Expiry)
+	}
+
+	if len(o.Signature) != 65 {
+		return sdkerrors.Wrap(ErrInvalidSignedOrder, "signature must be 65 bytes [R || S || V]")
+	}
+	return nil
+}
+
+// ToSpotOrder returns the spot limit order placed for the signed order, with the given fee recipient
+func (o *SignedOrder) ToSpotOrder(feeRecipient string) *SpotOrder {
+	return &SpotOrder{
+		MarketId: o.MarketId,
+		OrderInfo: OrderInfo{
+			SubaccountId: o.SubaccountId,
+			FeeRecipient: feeRecipient,
+			Price:        o.Price,
+			Quantity:     o.Quantity,
+		},
+		OrderType: o.OrderType,
+	}
+}
+
+// ToDerivativeOrder returns the derivative limit order placed for the signed order, with the given fee recipient
+func (o *SignedOrder) ToDerivativeOrder(feeRecipient string) *DerivativeOrder {
+	return &DerivativeOrder{
+		MarketId: o.MarketId,
+		OrderInfo: OrderInfo{
+			SubaccountId: o.SubaccountId,
+			FeeRecipient: feeRecipient,
+			Price:        o.Price,
+			Quantity:     o.Quantity,
+		},
+		OrderType: o.OrderType,
+		Margin:    o.Margin,
+	}
+}
@@ -84,7 +84,7 @@ func (o *SpotOrder) CheckTickSize(minPriceTickSize, minQuantityTickSize sdk.Dec)
 	if BreachesMinimumTickSize(o.OrderInfo.Quantity, minQuantityTickSize) {
 		return sdkerrors.Wrapf(ErrInvalidQuantity, "quantity %s must be a multiple of the minimum quantity tick size %s", o.OrderInfo.Quantity.String(), minQuantityTickSize.String())
 	}
-	return nil
+	return checkIcebergVisibleQuantityTickSize(o.VisibleQuantity, minQuantityTickSize)
 }
 
 func (o *SpotOrder) IsBuy() bool {
@@ -167,6 +167,9 @@ func (m *SpotLimitOrder) GetUnfilledMarginHoldAndMarginDenom(market *SpotMarket,
 		balanceHold = m.Fillable
 	}
 
+	// the hidden reserve of iceberg orders is held separately from the visible slice
+	balanceHold = balanceHold.Add(m.GetHiddenBalanceHold())
+
 	return balanceHold, denom
 }
 
@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (msg *MsgCreateSpotQuoteMarketOrder) MarketID() common.Hash {
+	return common.HexToHash(msg.MarketId)
+}
+
+// GetWorstPrice returns the worst execution price allowed by the max slippage from the best price of the opposite side
+// of the orderbook, rounded to the minimum price tick size towards the best price.
+func (msg *MsgCreateSpotQuoteMarketOrder) GetWorstPrice(bestPrice, minPriceTickSize sdk.Dec) sdk.Dec {
+	return GetMaxSlippageWorstPrice(msg.OrderType.IsBuy(), bestPrice, msg.MaxSlippage, minPriceTickSize)
+}
+
+// GetBalanceHold returns the balance held by the order in its margin denom: the quote amount for buys, and for sells
+// the base quantity to sell at the worst price to receive the quote amount.
+func (msg *MsgCreateSpotQuoteMarketOrder) GetBalanceHold(worstPrice, feeRate, minQuantityTickSize sdk.Dec) sdk.Dec {
+	if msg.OrderType.IsBuy() {
+		return msg.QuoteAmount
+	}
+	return GetQuoteAmountQuantity(false, msg.QuoteAmount, worstPrice, feeRate, minQuantityTickSize)
+}
+
+// ToSpotOrder returns the spot market order the quote market order is executed as, for the given worst price and
+// maximum quantity.
+func (msg *MsgCreateSpotQuoteMarketOrder) ToSpotOrder(subaccountID common.Hash, worstPrice, quantity sdk.Dec) *SpotOrder {
+	return &SpotOrder{
+		MarketId: msg.MarketId,
+		OrderInfo: OrderInfo{
+			SubaccountId: subaccountID.Hex(),
+			FeeRecipient: msg.FeeRecipient,
+			Price:        worstPrice,
+			Quantity:     quantity,
+		},
+		OrderType: msg.OrderType,
+	}
+}
+
+// IsQuoteMarketOrder returns true if the market order is filled up to a quote amount rather than its quantity.
+func (o *SpotMarketOrder) IsQuoteMarketOrder() bool {
+	return o.QuoteAmount != nil && !o.QuoteAmount.IsNil()
+}
+
+// GetQuoteAmountQuantity returns the base quantity bought by a quote amount including the fee, rounded down to the
+// minimum quantity tick size, or sold for a quote amount net of the fee, rounded up to the minimum quantity tick size,
+// at the given price.
+func GetQuoteAmountQuantity(isBuy bool, quoteAmount, price, feeRate, minQuantityTickSize sdk.Dec) sdk.Dec {
+	if isBuy {
+		return RoundDownToTickSize(quoteAmount.QuoTruncate(price.Mul(sdk.OneDec().Add(feeRate))), minQuantityTickSize)
+	}
+	return RoundUpToTickSize(quoteAmount.QuoRoundUp(price.Mul(sdk.OneDec().Sub(feeRate))), minQuantityTickSize)
+}
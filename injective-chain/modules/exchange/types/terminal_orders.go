@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TerminalStatus returns the terminal status of an order which left the orderbook for the given reason
+func (r OrderTerminationReason) TerminalStatus() TerminalOrderStatus {
+	switch r {
+	case OrderTerminationReason_FullyFilled:
+		return TerminalOrderStatus_TerminalFilled
+	case OrderTerminationReason_MarketExpiry, OrderTerminationReason_SignedOrderExpiry:
+		return TerminalOrderStatus_TerminalExpired
+	case OrderTerminationReason_PositionLiquidation:
+		return TerminalOrderStatus_TerminalLiquidated
+	default:
+		return TerminalOrderStatus_TerminalCancelled
+	}
+}
+
+func (o *TerminalOrder) Hash() common.Hash {
+	return common.HexToHash(o.OrderHash)
+}
+
+func (o *TerminalOrder) MarketID() common.Hash {
+	return common.HexToHash(o.MarketId)
+}
+
+func (o *TerminalOrder) SubaccountID() common.Hash {
+	return common.HexToHash(o.SubaccountId)
+}
+
+func NewLimitOrderFill(orderHash common.Hash) *LimitOrderFill {
+	return &LimitOrderFill{
+		OrderHash: orderHash.Hex(),
+		Quantity:  sdk.ZeroDec(),
+		Notional:  sdk.ZeroDec(),
+	}
+}
+
+func (f *LimitOrderFill) Hash() common.Hash {
+	return common.HexToHash(f.OrderHash)
+}
+
+// AddFill adds a fill of the given quantity at the given price
+func (f *LimitOrderFill) AddFill(quantity, price sdk.Dec) {
+	f.Quantity = f.Quantity.Add(quantity)
+	f.Notional = f.Notional.Add(quantity.Mul(price))
+}
+
+// AveragePrice returns the average price of the fills, or zero if the order wasn't filled
+func (f *LimitOrderFill) AveragePrice() sdk.Dec {
+	if f.Quantity.IsZero() {
+		return sdk.ZeroDec()
+	}
+	return f.Notional.Quo(f.Quantity)
+}
@@ -0,0 +1,217 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MaxTWAPOrderSlices is the maximum number of slices a TWAP order can be split into
+const MaxTWAPOrderSlices = 1000
+
+func (o *TWAPOrder) MarketID() common.Hash {
+	return common.HexToHash(o.MarketId)
+}
+
+func (o *TWAPOrder) SubaccountID() common.Hash {
+	return o.OrderInfo.SubaccountID()
+}
+
+func (o *TWAPOrder) SdkAccAddress() sdk.AccAddress {
+	return SubaccountIDToSdkAddress(o.SubaccountID())
+}
+
+func (o *TWAPOrder) Hash() common.Hash {
+	return common.BytesToHash(o.OrderHash)
+}
+
+func (o *TWAPOrder) IsBuy() bool {
+	return o.OrderType.IsBuy()
+}
+
+func (o *TWAPOrder) IsCompleted() bool {
+	return o.ExecutedSlices >= o.Slices
+}
+
+// IsSliceDue returns true if the next slice of the order can be placed at the given block height and time
+func (o *TWAPOrder) IsSliceDue(blockHeight, blockTime int64) bool {
+	return !o.IsCompleted() && blockHeight >= o.NextSliceHeight && blockTime >= o.NextSliceTimestamp
+}
+
+// HasLimitPrice returns true if the slices of the order are capped by its limit price rather than by its max slippage
+func (o *TWAPOrder) HasLimitPrice() bool {
+	return o.OrderInfo.Price.IsPositive()
+}
+
+// HasPendingSlice returns true if the last placed slice of the order has not been settled yet
+func (o *TWAPOrder) HasPendingSlice() bool {
+	return !o.PendingQuantity.IsNil() && o.PendingQuantity.IsPositive()
+}
+
+// GetAveragePrice returns the volume weighted average execution price of the slices, zero if nothing was filled yet
+func (o *TWAPOrder) GetAveragePrice() sdk.Dec {
+	if o.FilledQuantity.IsZero() {
+		return sdk.ZeroDec()
+	}
+	return o.FilledNotional.Quo(o.FilledQuantity)
+}
+
+// GetNextSlice returns the quantity and margin of the next slice together with the balance hold to release for it.
+// The remaining quantity is spread evenly over the remaining slices, rounded down to the minimum quantity tick size,
+// and the last slice takes whatever is left. The margin and the balance hold are split pro rata of the quantity.
+func (o *TWAPOrder) GetNextSlice(minQuantityTickSize sdk.Dec) (quantity, margin, balanceHold sdk.Dec) {
+	if o.Slices-o.ExecutedSlices <= 1 {
+		return o.RemainingQuantity, o.RemainingMargin, o.BalanceHold
+	}
+
+	remainingSlices := sdk.NewDec(int64(o.Slices - o.ExecutedSlices))
+	quantity = RoundDownToTickSize(o.RemainingQuantity.Quo(remainingSlices), minQuantityTickSize)
+	if quantity.IsZero() {
+		quantity = sdk.MinDec(minQuantityTickSize, o.RemainingQuantity)
+	}
+
+	if quantity.IsZero() {
+		return sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()
+	}
+
+	margin = o.RemainingMargin.Mul(quantity).Quo(o.RemainingQuantity)
+	balanceHold = o.BalanceHold.Mul(quantity).Quo(o.RemainingQuantity)
+	return quantity, margin, balanceHold
+}
+
+// ApplySlice moves the order to its next slice once the given slice has been placed. The slice stays pending until it
+// is settled, so that its unfilled quantity can be carried into the remaining slices.
+func (o *TWAPOrder) ApplySlice(quantity, margin, balanceHold sdk.Dec, blockHeight, blockTime int64) {
+	o.RemainingQuantity = o.RemainingQuantity.Sub(quantity)
+	o.RemainingMargin = o.RemainingMargin.Sub(margin)
+	o.BalanceHold = o.BalanceHold.Sub(balanceHold)
+
+	o.PendingQuantity = quantity
+	o.PendingMargin = margin
+	o.PendingBalanceHold = balanceHold
+
+	o.SkipSlice(blockHeight, blockTime)
+}
+
+// SkipSlice moves the order to its next slice without placing the current one, its quantity being kept for the
+// remaining slices
+func (o *TWAPOrder) SkipSlice(blockHeight, blockTime int64) {
+	o.ExecutedSlices++
+
+	if o.IntervalBlocks > 0 {
+		o.NextSliceHeight = blockHeight + int64(o.IntervalBlocks)
+	} else {
+		o.NextSliceHeight = blockHeight + 1
+		o.NextSliceTimestamp = blockTime + o.IntervalSeconds
+	}
+}
+
+// TakePendingSlice clears the pending slice of the order and returns its unfilled quantity with its margin and
+// balance hold shares
+func (o *TWAPOrder) TakePendingSlice() (quantity, margin, balanceHold sdk.Dec) {
+	quantity, margin, balanceHold = o.PendingQuantity, o.PendingMargin, o.PendingBalanceHold
+
+	o.PendingQuantity = sdk.ZeroDec()
+	o.PendingMargin = sdk.ZeroDec()
+	o.PendingBalanceHold = sdk.ZeroDec()
+	return quantity, margin, balanceHold
+}
+
+// CarrySlice adds the unfilled quantity of a settled slice back to the remaining slices
+func (o *TWAPOrder) CarrySlice(quantity, margin, balanceHold sdk.Dec) {
+	o.RemainingQuantity = o.RemainingQuantity.Add(quantity)
+	o.RemainingMargin = o.RemainingMargin.Add(margin)
+	o.BalanceHold = o.BalanceHold.Add(balanceHold)
+}
+
+// AddFill records the fill of the pending slice of the order, reducing its unfilled quantity pro rata
+func (o *TWAPOrder) AddFill(quantity, price sdk.Dec) {
+	o.FilledQuantity = o.FilledQuantity.Add(quantity)
+	o.FilledNotional = o.FilledNotional.Add(quantity.Mul(price))
+
+	if !o.HasPendingSlice() {
+		return
+	}
+
+	filledQuantity := sdk.MinDec(quantity, o.PendingQuantity)
+	o.PendingMargin = o.PendingMargin.Sub(o.PendingMargin.Mul(filledQuantity).Quo(o.PendingQuantity))
+	o.PendingBalanceHold = o.PendingBalanceHold.Sub(o.PendingBalanceHold.Mul(filledQuantity).Quo(o.PendingQuantity))
+	o.PendingQuantity = o.PendingQuantity.Sub(filledQuantity)
+}
+
+// GetSpotSliceOrder returns the spot market order placed for a slice of the order at the given worst price
+func (o *TWAPOrder) GetSpotSliceOrder(quantity, worstPrice sdk.Dec) *SpotOrder {
+	return &SpotOrder{
+		MarketId: o.MarketId,
+		OrderInfo: OrderInfo{
+			SubaccountId: o.OrderInfo.SubaccountId,
+			FeeRecipient: o.OrderInfo.FeeRecipient,
+			Price:        worstPrice,
+			Quantity:     quantity,
+		},
+		OrderType: o.OrderType,
+	}
+}
+
+// GetDerivativeSliceOrder returns the derivative market order placed for a slice of the order at the given worst price
+func (o *TWAPOrder) GetDerivativeSliceOrder(quantity, margin, worstPrice sdk.Dec) *DerivativeOrder {
+	return &DerivativeOrder{
+		MarketId: o.MarketId,
+		OrderInfo: OrderInfo{
+			SubaccountId: o.OrderInfo.SubaccountId,
+			FeeRecipient: o.OrderInfo.FeeRecipient,
+			Price:        worstPrice,
+			Quantity:     quantity,
+		},
+		OrderType: o.OrderType,
+		Margin:    margin,
+	}
+}
+
+func (o *TWAPOrder) ToTrimmed() *TrimmedTWAPOrder {
+	return &TrimmedTWAPOrder{
+		Price:          o.OrderInfo.Price,
+		Quantity:       o.OrderInfo.Quantity,
+		Margin:         o.Margin,
+		IsBuy:          o.IsBuy(),
+		Slices:         o.Slices,
+		ExecutedSlices: o.ExecutedSlices,
+		FilledQuantity: o.FilledQuantity,
+		AveragePrice:   o.GetAveragePrice(),
+		OrderHash:      o.Hash().Hex(),
+	}
+}
+
+// HasLimitPrice returns true if the slices of the order are capped by its limit price rather than by its max slippage
+func (msg *MsgCreateTWAPOrder) HasLimitPrice() bool {
+	return !msg.OrderInfo.Price.IsNil() && msg.OrderInfo.Price.IsPositive()
+}
+
+// ToSpotOrder returns the parent order as a spot order, used to compute the TWAP order hash and check its tick sizes
+func (msg *MsgCreateTWAPOrder) ToSpotOrder() *SpotOrder {
+	return &SpotOrder{
+		MarketId:  msg.MarketId,
+		OrderInfo: msg.OrderInfo,
+		OrderType: msg.OrderType,
+	}
+}
+
+// ToDerivativeOrder returns the parent order as a derivative order, used to compute the TWAP order hash, check its
+// tick sizes and its margin requirements
+func (msg *MsgCreateTWAPOrder) ToDerivativeOrder() *DerivativeOrder {
+	return &DerivativeOrder{
+		MarketId:  msg.MarketId,
+		OrderInfo: msg.OrderInfo,
+		OrderType: msg.OrderType,
+		Margin:    msg.Margin,
+	}
+}
+
+// CheckSliceQuantity checks that every slice of the order is at least one minimum quantity tick size
+func (msg *MsgCreateTWAPOrder) CheckSliceQuantity(minQuantityTickSize sdk.Dec) error {
+	sliceQuantity := msg.OrderInfo.Quantity.Quo(sdk.NewDec(int64(msg.Slices)))
+	if sliceQuantity.LT(minQuantityTickSize) {
+		return sdkerrors.Wrapf(ErrInvalidTWAPOrder, "quantity %s is too small to be split into %d slices of at least the minimum quantity tick size %s", msg.OrderInfo.Quantity.String(), msg.Slices, minQuantityTickSize.String())
+	}
+	return nil
+}
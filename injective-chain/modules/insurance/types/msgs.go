@@ -32,14 +32,22 @@ func (msg MsgCreateInsuranceFund) ValidateBasic() error {
 	if msg.QuoteDenom == "" {
 		return sdkerrors.Wrap(ErrInvalidQuoteDenom, "quote denom should not be empty")
 	}
-	if msg.OracleBase == "" {
-		return sdkerrors.Wrap(ErrInvalidOracle, "oracle base should not be empty")
-	}
-	if msg.OracleQuote == "" {
-		return sdkerrors.Wrap(ErrInvalidOracle, "oracle quote should not be empty")
-	}
-	if msg.OracleType == oracletypes.OracleType_Unspecified {
-		return sdkerrors.Wrap(ErrInvalidOracle, "oracle type should not be unspecified")
+	// pre-launch perpetual markets have no oracle until they are converted to oracle-backed markets
+	isPreLaunchPerpetual := msg.OracleType == oracletypes.OracleType_Unspecified && msg.Expiry == PerpetualExpiryFlag
+	if isPreLaunchPerpetual {
+		if msg.OracleBase != "" || msg.OracleQuote != "" {
+			return sdkerrors.Wrap(ErrInvalidOracle, "oracle base and quote should be empty for pre-launch perpetual markets")
+		}
+	} else {
+		if msg.OracleBase == "" {
+			return sdkerrors.Wrap(ErrInvalidOracle, "oracle base should not be empty")
+		}
+		if msg.OracleQuote == "" {
+			return sdkerrors.Wrap(ErrInvalidOracle, "oracle quote should not be empty")
+		}
+		if msg.OracleType == oracletypes.OracleType_Unspecified {
+			return sdkerrors.Wrap(ErrInvalidOracle, "oracle type should not be unspecified")
+		}
 	}
 	if msg.QuoteDenom != msg.InitialDeposit.Denom {
 		return sdkerrors.Wrapf(ErrInvalidDepositDenom, "oracle quote denom %s does not match deposit denom %s", msg.QuoteDenom, msg.InitialDeposit.Denom)
@@ -36,6 +36,12 @@ func (h *BlockHandler) BeginBlocker(ctx sdk.Context) {
 		h.RequestAllBandIBCRates(ctx)
 	}
 
+	// record the exchange TWAPs before the composite indices which can include them
+	h.k.UpdateExchangeTwapPriceStates(ctx)
+
+	// record the composite index prices to accumulate their cumulative prices
+	h.k.UpdateCompositeIndexPriceStates(ctx)
+
 	if ctx.BlockHeight()%100000 == 0 {
 		h.k.CleanupHistoricalPriceRecords(ctx)
 	}
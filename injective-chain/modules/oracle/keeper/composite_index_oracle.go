@@ -0,0 +1,263 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+// GetCompositeIndex reads the stored composite index.
+func (k *Keeper) GetCompositeIndex(ctx sdk.Context, symbol string) *types.CompositeIndex {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz := k.getStore(ctx).Get(types.GetCompositeIndexStoreKey(symbol))
+	if bz == nil {
+		return nil
+	}
+
+	var index types.CompositeIndex
+	k.cdc.MustUnmarshal(bz, &index)
+	return &index
+}
+
+// SetCompositeIndex sets the composite index.
+func (k *Keeper) SetCompositeIndex(ctx sdk.Context, index *types.CompositeIndex) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz := k.cdc.MustMarshal(index)
+	k.getStore(ctx).Set(types.GetCompositeIndexStoreKey(index.Symbol), bz)
+}
+
+// DeleteCompositeIndex deletes the composite index along with its price state.
+func (k *Keeper) DeleteCompositeIndex(ctx sdk.Context, symbol string) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	store.Delete(types.GetCompositeIndexStoreKey(symbol))
+	store.Delete(types.GetCompositeIndexPriceStoreKey(symbol))
+}
+
+// GetAllCompositeIndices fetches all composite indices in the store
+func (k *Keeper) GetAllCompositeIndices(ctx sdk.Context) []*types.CompositeIndex {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	indexStore := prefix.NewStore(k.getStore(ctx), types.CompositeIndexKey)
+
+	iter := indexStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	indices := make([]*types.CompositeIndex, 0)
+	for ; iter.Valid(); iter.Next() {
+		var index types.CompositeIndex
+		k.cdc.MustUnmarshal(iter.Value(), &index)
+
+		indices = append(indices, &index)
+	}
+
+	return indices
+}
+
+// GetCompositeIndexPriceState reads the stored composite index price state.
+func (k *Keeper) GetCompositeIndexPriceState(ctx sdk.Context, symbol string) *types.CompositeIndexPriceState {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz := k.getStore(ctx).Get(types.GetCompositeIndexPriceStoreKey(symbol))
+	if bz == nil {
+		return nil
+	}
+
+	var priceState types.CompositeIndexPriceState
+	k.cdc.MustUnmarshal(bz, &priceState)
+	return &priceState
+}
+
+// SetCompositeIndexPriceState sets the composite index price state.
+func (k *Keeper) SetCompositeIndexPriceState(ctx sdk.Context, priceState *types.CompositeIndexPriceState) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz := k.cdc.MustMarshal(priceState)
+	k.getStore(ctx).Set(types.GetCompositeIndexPriceStoreKey(priceState.Symbol), bz)
+}
+
+// GetAllCompositeIndexPriceStates fetches all composite index price states in the store
+func (k *Keeper) GetAllCompositeIndexPriceStates(ctx sdk.Context) []*types.CompositeIndexPriceState {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	priceStore := prefix.NewStore(k.getStore(ctx), types.CompositeIndexPriceKey)
+
+	iter := priceStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	priceStates := make([]*types.CompositeIndexPriceState, 0)
+	for ; iter.Valid(); iter.Next() {
+		var priceState types.CompositeIndexPriceState
+		k.cdc.MustUnmarshal(iter.Value(), &priceState)
+
+		priceStates = append(priceStates, &priceState)
+	}
+
+	return priceStates
+}
+
+// UpdateCompositeIndexPriceStates records the current price of every composite index, accumulating the cumulative
+// price of the previous one. Indices without enough fresh components keep their last price.
+func (k *Keeper) UpdateCompositeIndexPriceStates(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	blockTime := ctx.BlockTime().Unix()
+
+	for _, index := range k.GetAllCompositeIndices(ctx) {
+		price := k.computeCompositeIndexPrice(ctx, index, k.GetCompositeIndexComponentPrices(ctx, index))
+		if price == nil {
+			continue
+		}
+
+		priceState := k.GetCompositeIndexPriceState(ctx, index.Symbol)
+		if priceState == nil {
+			priceState = &types.CompositeIndexPriceState{
+				Symbol:     index.Symbol,
+				PriceState: *types.NewPriceState(*price, blockTime),
+			}
+		} else {
+			priceState.PriceState.UpdatePrice(*price, blockTime)
+		}
+
+		k.SetCompositeIndexPriceState(ctx, priceState)
+	}
+}
+
+// GetCompositeIndexPrice returns the current price of the composite index for the given symbol and quote, or nil if
+// it doesn't exist or not enough of its components have a fresh price.
+func (k *Keeper) GetCompositeIndexPrice(ctx sdk.Context, symbol, quote string) *sdk.Dec {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	index := k.GetCompositeIndex(ctx, symbol)
+	if index == nil || index.Quote != quote {
+		return nil
+	}
+
+	return k.computeCompositeIndexPrice(ctx, index, k.GetCompositeIndexComponentPrices(ctx, index))
+}
+
+// GetCompositeIndexComponentPrices returns the current prices of the components of the index, in the same order.
+func (k *Keeper) GetCompositeIndexComponentPrices(ctx sdk.Context, index *types.CompositeIndex) []types.CompositeIndexComponentPrice {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	blockTime := ctx.BlockTime().Unix()
+	componentPrices := make([]types.CompositeIndexComponentPrice, 0, len(index.Components))
+
+	for idx := range index.Components {
+		component := &index.Components[idx]
+		price, timestamp := k.getCompositeIndexComponentPrice(ctx, component)
+
+		componentPrices = append(componentPrices, types.CompositeIndexComponentPrice{
+			Price:     price,
+			Timestamp: timestamp,
+			Included:  price != nil && component.IsFresh(timestamp, blockTime),
+		})
+	}
+	return componentPrices
+}
+
+func (k *Keeper) computeCompositeIndexPrice(ctx sdk.Context, index *types.CompositeIndex, componentPrices []types.CompositeIndexComponentPrice) *sdk.Dec {
+	prices := make([]sdk.Dec, 0, len(componentPrices))
+	weights := make([]sdk.Dec, 0, len(componentPrices))
+
+	for idx, componentPrice := range componentPrices {
+		if !componentPrice.Included {
+			continue
+		}
+		prices = append(prices, *componentPrice.Price)
+		weights = append(weights, index.Components[idx].Weight)
+	}
+
+	if len(prices) < int(index.MinComponents) {
+		k.Logger(ctx).Debug("not enough fresh components for composite index", "symbol", index.Symbol, "fresh", len(prices), "min", index.MinComponents)
+		return nil
+	}
+
+	price := types.AggregateCompositeIndexPrices(index.Aggregation, prices, weights)
+	return &price
+}
+
+// getCompositeIndexComponentPrice returns the price of the component along with the time of its last update, which is
+// the oldest of the base and quote updates for oracles pricing them separately.
+func (k *Keeper) getCompositeIndexComponentPrice(ctx sdk.Context, component *types.CompositeIndexComponent) (*sdk.Dec, int64) {
+	var (
+		price     *sdk.Dec
+		timestamp int64
+	)
+
+	switch component.OracleType {
+	case types.OracleType_Provider:
+		priceState := k.GetProviderPriceState(ctx, component.Base, component.Quote)
+		if priceState == nil || priceState.State == nil {
+			return nil, 0
+		}
+		price, timestamp = &priceState.State.Price, priceState.State.Timestamp
+	case types.OracleType_PriceFeed:
+		priceState := k.GetPriceFeedPriceState(ctx, component.Base, component.Quote)
+		if priceState == nil {
+			return nil, 0
+		}
+		price, timestamp = &priceState.Price, priceState.Timestamp
+	case types.OracleType_ExchangeTwap:
+		priceState := k.GetExchangeTwapPriceState(ctx, component.Base, component.Quote)
+		if priceState == nil {
+			return nil, 0
+		}
+		price, timestamp = &priceState.PriceState.Price, priceState.PriceState.Timestamp
+	default:
+		price = k.GetPrice(ctx, component.OracleType, component.Base, component.Quote)
+
+		var ok bool
+		timestamp, ok = k.getSymbolPriceTimestamp(ctx, component.OracleType, component.Base)
+		if !ok {
+			return nil, 0
+		}
+
+		if component.Quote != types.QuoteUSD {
+			quoteTimestamp, ok := k.getSymbolPriceTimestamp(ctx, component.OracleType, component.Quote)
+			if !ok {
+				return nil, 0
+			}
+			if quoteTimestamp < timestamp {
+				timestamp = quoteTimestamp
+			}
+		}
+	}
+
+	if price == nil || price.IsNil() || !price.IsPositive() {
+		return nil, timestamp
+	}
+	return price, timestamp
+}
+
+func (k *Keeper) getSymbolPriceTimestamp(ctx sdk.Context, oracleType types.OracleType, symbol string) (int64, bool) {
+	switch oracleType {
+	case types.OracleType_Band:
+		if priceState := k.GetBandPriceState(ctx, symbol); priceState != nil {
+			return priceState.PriceState.Timestamp, true
+		}
+	case types.OracleType_BandIBC:
+		if priceState := k.GetBandIBCPriceState(ctx, symbol); priceState != nil {
+			return priceState.PriceState.Timestamp, true
+		}
+	case types.OracleType_Coinbase:
+		if priceState := k.getLastCoinbasePriceState(ctx, symbol); priceState != nil {
+			return priceState.PriceState.Timestamp, true
+		}
+	case types.OracleType_Chainlink:
+		if priceState := k.GetChainlinkPriceState(ctx, symbol); priceState != nil {
+			return priceState.PriceState.Timestamp, true
+		}
+	case types.OracleType_Pyth:
+		if priceState := k.GetPythPriceState(ctx, common.HexToHash(symbol)); priceState != nil {
+			return priceState.PriceState.Timestamp, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,295 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+// GetExchangeTwapConfig reads the stored exchange TWAP config of the base/quote pair.
+func (k *Keeper) GetExchangeTwapConfig(ctx sdk.Context, baseDenom, quoteDenom string) *types.ExchangeTwapConfig {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz := k.getStore(ctx).Get(types.GetExchangeTwapConfigStoreKey(baseDenom, quoteDenom))
+	if bz == nil {
+		return nil
+	}
+
+	var config types.ExchangeTwapConfig
+	k.cdc.MustUnmarshal(bz, &config)
+	return &config
+}
+
+// SetExchangeTwapConfig sets the exchange TWAP config.
+func (k *Keeper) SetExchangeTwapConfig(ctx sdk.Context, config *types.ExchangeTwapConfig) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz := k.cdc.MustMarshal(config)
+	k.getStore(ctx).Set(types.GetExchangeTwapConfigStoreKey(config.BaseDenom, config.QuoteDenom), bz)
+}
+
+// DeleteExchangeTwapConfig deletes the exchange TWAP config along with its price state and snapshots.
+func (k *Keeper) DeleteExchangeTwapConfig(ctx sdk.Context, baseDenom, quoteDenom string) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	store := k.getStore(ctx)
+	store.Delete(types.GetExchangeTwapConfigStoreKey(baseDenom, quoteDenom))
+	store.Delete(types.GetExchangeTwapPriceStoreKey(baseDenom, quoteDenom))
+	k.deleteExchangeTwapSnapshots(ctx, baseDenom, quoteDenom, 0)
+}
+
+// ValidateExchangeTwapConfigWindow checks that the trade records of the spot market of the exchange TWAP config are
+// retained for the whole TWAP window.
+func (k *Keeper) ValidateExchangeTwapConfigWindow(ctx sdk.Context, config *types.ExchangeTwapConfig) error {
+	if k.exchangeKeeper == nil {
+		return sdkerrors.Wrap(types.ErrInvalidExchangeTwapConfig, "exchange keeper not set")
+	}
+
+	retention, found := k.exchangeKeeper.GetSpotMarketTradeRecordRetention(ctx, config.BaseDenom, config.QuoteDenom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidExchangeTwapConfig, "no active spot market for base %s quote %s", config.BaseDenom, config.QuoteDenom)
+	}
+
+	if config.TwapWindow > retention {
+		return sdkerrors.Wrapf(types.ErrInvalidExchangeTwapConfig, "TWAP window of %d seconds is longer than the trade record retention of %d seconds of the spot market", config.TwapWindow, retention)
+	}
+	return nil
+}
+
+// GetAllExchangeTwapConfigs fetches all exchange TWAP configs in the store
+func (k *Keeper) GetAllExchangeTwapConfigs(ctx sdk.Context) []*types.ExchangeTwapConfig {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	configStore := prefix.NewStore(k.getStore(ctx), types.ExchangeTwapConfigKey)
+
+	iter := configStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	configs := make([]*types.ExchangeTwapConfig, 0)
+	for ; iter.Valid(); iter.Next() {
+		var config types.ExchangeTwapConfig
+		k.cdc.MustUnmarshal(iter.Value(), &config)
+
+		configs = append(configs, &config)
+	}
+
+	return configs
+}
+
+// GetExchangeTwapPriceState reads the stored exchange TWAP price state of the base/quote pair.
+func (k *Keeper) GetExchangeTwapPriceState(ctx sdk.Context, baseDenom, quoteDenom string) *types.ExchangeTwapPriceState {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz := k.getStore(ctx).Get(types.GetExchangeTwapPriceStoreKey(baseDenom, quoteDenom))
+	if bz == nil {
+		return nil
+	}
+
+	var priceState types.ExchangeTwapPriceState
+	k.cdc.MustUnmarshal(bz, &priceState)
+	return &priceState
+}
+
+// SetExchangeTwapPriceState sets the exchange TWAP price state.
+func (k *Keeper) SetExchangeTwapPriceState(ctx sdk.Context, priceState *types.ExchangeTwapPriceState) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz := k.cdc.MustMarshal(priceState)
+	k.getStore(ctx).Set(types.GetExchangeTwapPriceStoreKey(priceState.BaseDenom, priceState.QuoteDenom), bz)
+}
+
+// GetAllExchangeTwapPriceStates fetches all exchange TWAP price states in the store
+func (k *Keeper) GetAllExchangeTwapPriceStates(ctx sdk.Context) []*types.ExchangeTwapPriceState {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	priceStore := prefix.NewStore(k.getStore(ctx), types.ExchangeTwapPriceKey)
+
+	iter := priceStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	priceStates := make([]*types.ExchangeTwapPriceState, 0)
+	for ; iter.Valid(); iter.Next() {
+		var priceState types.ExchangeTwapPriceState
+		k.cdc.MustUnmarshal(iter.Value(), &priceState)
+
+		priceStates = append(priceStates, &priceState)
+	}
+
+	return priceStates
+}
+
+// SetExchangeTwapSnapshot sets the exchange TWAP snapshot.
+func (k *Keeper) SetExchangeTwapSnapshot(ctx sdk.Context, snapshot *types.ExchangeTwapSnapshot) {
+	bz := k.cdc.MustMarshal(snapshot)
+	k.getStore(ctx).Set(types.GetExchangeTwapSnapshotStoreKey(snapshot.BaseDenom, snapshot.QuoteDenom, snapshot.Timestamp), bz)
+}
+
+// GetAllExchangeTwapSnapshots fetches all exchange TWAP snapshots in the store
+func (k *Keeper) GetAllExchangeTwapSnapshots(ctx sdk.Context) []*types.ExchangeTwapSnapshot {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	snapshotStore := prefix.NewStore(k.getStore(ctx), types.ExchangeTwapSnapshotKey)
+
+	iter := snapshotStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	snapshots := make([]*types.ExchangeTwapSnapshot, 0)
+	for ; iter.Valid(); iter.Next() {
+		var snapshot types.ExchangeTwapSnapshot
+		k.cdc.MustUnmarshal(iter.Value(), &snapshot)
+
+		snapshots = append(snapshots, &snapshot)
+	}
+
+	return snapshots
+}
+
+// getLatestExchangeTwapSnapshot returns the latest snapshot of the pair, or nil if it has none.
+func (k *Keeper) getLatestExchangeTwapSnapshot(ctx sdk.Context, baseDenom, quoteDenom string) *types.ExchangeTwapSnapshot {
+	snapshotStore := prefix.NewStore(k.getStore(ctx), types.GetExchangeTwapSnapshotsPrefix(baseDenom, quoteDenom))
+
+	iter := snapshotStore.ReverseIterator(nil, nil)
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil
+	}
+
+	var snapshot types.ExchangeTwapSnapshot
+	k.cdc.MustUnmarshal(iter.Value(), &snapshot)
+	return &snapshot
+}
+
+// getExchangeTwapWindowStartSnapshot returns the last snapshot of the pair at or before the start of the window, or the
+// first one after it if there is none, or nil if the pair has no snapshot.
+func (k *Keeper) getExchangeTwapWindowStartSnapshot(ctx sdk.Context, baseDenom, quoteDenom string, windowStart int64) *types.ExchangeTwapSnapshot {
+	snapshotStore := prefix.NewStore(k.getStore(ctx), types.GetExchangeTwapSnapshotsPrefix(baseDenom, quoteDenom))
+	windowStartKey := sdk.Uint64ToBigEndian(uint64(windowStart + 1))
+
+	iter := snapshotStore.ReverseIterator(nil, windowStartKey)
+	if !iter.Valid() {
+		iter.Close()
+		iter = snapshotStore.Iterator(windowStartKey, nil)
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil
+	}
+
+	var snapshot types.ExchangeTwapSnapshot
+	k.cdc.MustUnmarshal(iter.Value(), &snapshot)
+	return &snapshot
+}
+
+// deleteExchangeTwapSnapshots deletes the snapshots of the pair older than the `before` time, or all of them if zero.
+func (k *Keeper) deleteExchangeTwapSnapshots(ctx sdk.Context, baseDenom, quoteDenom string, before int64) {
+	snapshotStore := prefix.NewStore(k.getStore(ctx), types.GetExchangeTwapSnapshotsPrefix(baseDenom, quoteDenom))
+
+	var end []byte
+	if before > 0 {
+		end = sdk.Uint64ToBigEndian(uint64(before))
+	}
+
+	iter := snapshotStore.Iterator(nil, end)
+	keys := make([][]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		snapshotStore.Delete(key)
+	}
+}
+
+// UpdateExchangeTwapPriceStates snapshots the new trade records of the spot market of every exchange TWAP pair and
+// records its current TWAP, accumulating the cumulative price of the previous one. Only the trade records since the
+// latest snapshot are read, and the snapshots before the window are pruned. Pairs without a valid TWAP keep their last
+// price.
+func (k *Keeper) UpdateExchangeTwapPriceStates(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	if k.exchangeKeeper == nil {
+		return
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+
+	for _, config := range k.GetAllExchangeTwapConfigs(ctx) {
+		latest := k.getLatestExchangeTwapSnapshot(ctx, config.BaseDenom, config.QuoteDenom)
+
+		// the trade record of the latest snapshot is read again since the exchange merges the records of a timestamp
+		from := blockTime - config.TwapWindow
+		if latest != nil {
+			from = latest.Timestamp
+		}
+
+		records, found := k.exchangeKeeper.GetSpotMarketTradeRecords(ctx, config.BaseDenom, config.QuoteDenom, from)
+		if !found {
+			k.Logger(ctx).Debug("no active spot market for exchange TWAP", "base", config.BaseDenom, "quote", config.QuoteDenom)
+			k.deleteExchangeTwapSnapshots(ctx, config.BaseDenom, config.QuoteDenom, 0)
+			continue
+		}
+
+		for idx := range records {
+			latest = types.NewExchangeTwapSnapshot(config.BaseDenom, config.QuoteDenom, &records[idx], latest)
+			k.SetExchangeTwapSnapshot(ctx, latest)
+		}
+
+		if latest == nil {
+			continue
+		}
+
+		start := k.getExchangeTwapWindowStartSnapshot(ctx, config.BaseDenom, config.QuoteDenom, blockTime-config.TwapWindow)
+		k.deleteExchangeTwapSnapshots(ctx, config.BaseDenom, config.QuoteDenom, start.Timestamp)
+
+		price := config.ComputeTwap(start, latest, blockTime)
+		if price == nil {
+			continue
+		}
+
+		priceState := k.GetExchangeTwapPriceState(ctx, config.BaseDenom, config.QuoteDenom)
+		if priceState == nil {
+			priceState = &types.ExchangeTwapPriceState{
+				BaseDenom:  config.BaseDenom,
+				QuoteDenom: config.QuoteDenom,
+				PriceState: *types.NewPriceState(*price, blockTime),
+			}
+		} else {
+			priceState.PriceState.UpdatePrice(*price, blockTime)
+		}
+
+		k.SetExchangeTwapPriceState(ctx, priceState)
+	}
+}
+
+// GetExchangeTwapPrice returns the TWAP of the spot market with the given base and quote denoms, or nil if the pair
+// has no exchange TWAP config, the market doesn't exist or not enough volume was traded in the TWAP window.
+func (k *Keeper) GetExchangeTwapPrice(ctx sdk.Context, baseDenom, quoteDenom string) *sdk.Dec {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	config := k.GetExchangeTwapConfig(ctx, baseDenom, quoteDenom)
+	if config == nil {
+		return nil
+	}
+
+	return k.computeExchangeTwap(ctx, config)
+}
+
+// computeExchangeTwap returns the TWAP of the pair from its snapshots, which cover the trade records until the
+// BeginBlocker of the current block.
+func (k *Keeper) computeExchangeTwap(ctx sdk.Context, config *types.ExchangeTwapConfig) *sdk.Dec {
+	latest := k.getLatestExchangeTwapSnapshot(ctx, config.BaseDenom, config.QuoteDenom)
+	if latest == nil {
+		return nil
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+	start := k.getExchangeTwapWindowStartSnapshot(ctx, config.BaseDenom, config.QuoteDenom, blockTime-config.TwapWindow)
+
+	return config.ComputeTwap(start, latest, blockTime)
+}
@@ -100,24 +100,49 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	for _, pythPriceState := range data.PythPriceStates {
 		k.SetPythPriceState(ctx, pythPriceState)
 	}
+
+	for _, index := range data.CompositeIndices {
+		k.SetCompositeIndex(ctx, index)
+	}
+
+	for _, priceState := range data.CompositeIndexPriceStates {
+		k.SetCompositeIndexPriceState(ctx, priceState)
+	}
+
+	for _, config := range data.ExchangeTwapConfigs {
+		k.SetExchangeTwapConfig(ctx, config)
+	}
+
+	for _, priceState := range data.ExchangeTwapPriceStates {
+		k.SetExchangeTwapPriceState(ctx, priceState)
+	}
+
+	for _, snapshot := range data.ExchangeTwapSnapshots {
+		k.SetExchangeTwapSnapshot(ctx, snapshot)
+	}
 }
 
 func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		Params:                 k.GetParams(ctx),
-		BandRelayers:           k.GetAllBandRelayers(ctx),
-		BandPriceStates:        k.GetAllBandPriceStates(ctx),
-		PriceFeedPriceStates:   k.GetAllPriceFeedStates(ctx),
-		CoinbasePriceStates:    k.GetAllCoinbasePriceStates(ctx),
-		BandIbcPriceStates:     k.GetAllBandIBCPriceStates(ctx),
-		BandIbcOracleRequests:  k.GetAllBandIBCOracleRequests(ctx),
-		BandIbcParams:          k.GetBandIBCParams(ctx),
-		BandIbcLatestClientId:  k.GetBandIBCLatestClientID(ctx),
-		CalldataRecords:        k.GetAllBandCalldataRecords(ctx),
-		BandIbcLatestRequestId: k.GetBandIBCLatestRequestID(ctx),
-		ChainlinkPriceStates:   k.GetAllChainlinkPriceStates(ctx),
-		HistoricalPriceRecords: k.GetAllHistoricalPriceRecords(ctx),
-		ProviderStates:         k.GetAllProviderStates(ctx),
-		PythPriceStates:        k.GetAllPythPriceStates(ctx),
+		Params:                    k.GetParams(ctx),
+		BandRelayers:              k.GetAllBandRelayers(ctx),
+		BandPriceStates:           k.GetAllBandPriceStates(ctx),
+		PriceFeedPriceStates:      k.GetAllPriceFeedStates(ctx),
+		CoinbasePriceStates:       k.GetAllCoinbasePriceStates(ctx),
+		BandIbcPriceStates:        k.GetAllBandIBCPriceStates(ctx),
+		BandIbcOracleRequests:     k.GetAllBandIBCOracleRequests(ctx),
+		BandIbcParams:             k.GetBandIBCParams(ctx),
+		BandIbcLatestClientId:     k.GetBandIBCLatestClientID(ctx),
+		CalldataRecords:           k.GetAllBandCalldataRecords(ctx),
+		BandIbcLatestRequestId:    k.GetBandIBCLatestRequestID(ctx),
+		ChainlinkPriceStates:      k.GetAllChainlinkPriceStates(ctx),
+		HistoricalPriceRecords:    k.GetAllHistoricalPriceRecords(ctx),
+		ProviderStates:            k.GetAllProviderStates(ctx),
+		PythPriceStates:           k.GetAllPythPriceStates(ctx),
+		CompositeIndices:          k.GetAllCompositeIndices(ctx),
+		CompositeIndexPriceStates: k.GetAllCompositeIndexPriceStates(ctx),
+		ExchangeTwapConfigs:       k.GetAllExchangeTwapConfigs(ctx),
+		ExchangeTwapPriceStates:   k.GetAllExchangeTwapPriceStates(ctx),
+		ExchangeTwapSnapshots:     k.GetAllExchangeTwapSnapshots(ctx),
 	}
 }
@@ -254,3 +254,46 @@ func (k *Keeper) PythPrice(c context.Context, req *types.QueryPythPriceRequest)
 
 	return &types.QueryPythPriceResponse{PriceState: priceState}, nil
 }
+
+// CompositeIndex fetches a composite index along with the current prices of its components
+func (k *Keeper) CompositeIndex(c context.Context, req *types.QueryCompositeIndexRequest) (*types.QueryCompositeIndexResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	index := k.GetCompositeIndex(ctx, req.Symbol)
+	if index == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, sdkerrors.Wrapf(types.ErrCompositeIndexNotFound, "symbol %s", req.Symbol)
+	}
+
+	componentPrices := k.GetCompositeIndexComponentPrices(ctx, index)
+
+	return &types.QueryCompositeIndexResponse{
+		Index:           index,
+		ComponentPrices: componentPrices,
+		Price:           k.computeCompositeIndexPrice(ctx, index, componentPrices),
+	}, nil
+}
+
+func (k *Keeper) CompositeIndices(c context.Context, _ *types.QueryCompositeIndicesRequest) (*types.QueryCompositeIndicesResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QueryCompositeIndicesResponse{
+		Indices: k.GetAllCompositeIndices(ctx),
+	}
+
+	return res, nil
+}
+
+func (k *Keeper) ExchangeTwapConfigs(c context.Context, _ *types.QueryExchangeTwapConfigsRequest) (*types.QueryExchangeTwapConfigsResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QueryExchangeTwapConfigsResponse{
+		Configs: k.GetAllExchangeTwapConfigs(ctx),
+	}
+
+	return res, nil
+}
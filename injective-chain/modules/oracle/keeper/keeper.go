@@ -34,7 +34,8 @@ type Keeper struct {
 	portKeeper    types.PortKeeper
 	scopedKeeper  capabilitykeeper.ScopedKeeper
 
-	ocrKeeper types.OcrKeeper
+	ocrKeeper      types.OcrKeeper
+	exchangeKeeper types.ExchangeKeeper
 
 	svcTags metrics.Tags
 }
@@ -78,6 +79,12 @@ func NewKeeper(
 	}
 }
 
+// SetExchangeKeeper sets the exchange keeper the ExchangeTwap oracle reads the spot market trades from. It can't be
+// passed to NewKeeper as the exchange keeper depends on the oracle keeper.
+func (k *Keeper) SetExchangeKeeper(ek types.ExchangeKeeper) {
+	k.exchangeKeeper = ek
+}
+
 func (k *Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
 }
@@ -44,6 +44,10 @@ func (k *Keeper) GetPrice(ctx sdk.Context, oracletype types.OracleType, base, qu
 	case types.OracleType_Provider:
 		// GetProviderPrice should be called instead
 		return nil
+	case types.OracleType_Composite:
+		return k.GetCompositeIndexPrice(ctx, base, quote)
+	case types.OracleType_ExchangeTwap:
+		return k.GetExchangeTwapPrice(ctx, base, quote)
 	}
 
 	return nil
@@ -67,6 +71,40 @@ func (k *Keeper) GetPricePairState(ctx sdk.Context, oracletype types.OracleType,
 		return &pricePairPriceFeedState
 	}
 
+	if oracletype == types.OracleType_Composite {
+		price := k.GetCompositeIndexPrice(ctx, base, quote)
+		priceState := k.GetCompositeIndexPriceState(ctx, base)
+		if price == nil || priceState == nil {
+			return nil
+		}
+		return &types.PricePairState{
+			PairPrice:            *price,
+			BasePrice:            *price,
+			QuotePrice:           sdk.Dec{},
+			BaseCumulativePrice:  priceState.PriceState.CumulativePrice,
+			QuoteCumulativePrice: sdk.Dec{},
+			BaseTimestamp:        priceState.PriceState.Timestamp,
+			QuoteTimestamp:       0,
+		}
+	}
+
+	if oracletype == types.OracleType_ExchangeTwap {
+		price := k.GetExchangeTwapPrice(ctx, base, quote)
+		priceState := k.GetExchangeTwapPriceState(ctx, base, quote)
+		if price == nil || priceState == nil {
+			return nil
+		}
+		return &types.PricePairState{
+			PairPrice:            *price,
+			BasePrice:            *price,
+			QuotePrice:           sdk.Dec{},
+			BaseCumulativePrice:  priceState.PriceState.CumulativePrice,
+			QuoteCumulativePrice: sdk.Dec{},
+			BaseTimestamp:        priceState.PriceState.Timestamp,
+			QuoteTimestamp:       0,
+		}
+	}
+
 	basePriceState := k.GetPriceState(ctx, base, oracletype)
 	if basePriceState == nil {
 		return nil
@@ -197,6 +235,23 @@ func (k *Keeper) GetCumulativePrice(ctx sdk.Context, oracleType types.OracleType
 	case types.OracleType_Provider:
 		// GetCumulativeProviderPrice should be called instead
 		return nil
+	case types.OracleType_Composite:
+		index := k.GetCompositeIndex(ctx, base)
+		if index == nil || index.Quote != quote {
+			return nil
+		}
+
+		indexPriceState := k.GetCompositeIndexPriceState(ctx, base)
+		if indexPriceState == nil {
+			return nil
+		}
+		priceState = &indexPriceState.PriceState
+	case types.OracleType_ExchangeTwap:
+		exchangeTwapPriceState := k.GetExchangeTwapPriceState(ctx, base, quote)
+		if exchangeTwapPriceState == nil {
+			return nil
+		}
+		priceState = &exchangeTwapPriceState.PriceState
 	default:
 		return nil
 	}
@@ -32,6 +32,14 @@ func NewOracleProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return handleGrantProviderPrivilegeProposal(ctx, k, c)
 		case *types.RevokeProviderPrivilegeProposal:
 			return handleRevokeProviderPrivilegeProposal(ctx, k, c)
+		case *types.SetCompositeIndexProposal:
+			return handleSetCompositeIndexProposal(ctx, k, c)
+		case *types.RemoveCompositeIndexProposal:
+			return handleRemoveCompositeIndexProposal(ctx, k, c)
+		case *types.SetExchangeTwapConfigProposal:
+			return handleSetExchangeTwapConfigProposal(ctx, k, c)
+		case *types.RemoveExchangeTwapConfigProposal:
+			return handleRemoveExchangeTwapConfigProposal(ctx, k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized oracle proposal content type: %T", c)
 		}
@@ -231,3 +239,51 @@ func handleRevokeProviderPrivilegeProposal(ctx sdk.Context, k keeper.Keeper, p *
 	}
 	return k.DeleteProviderRelayers(ctx, p.Provider, p.Relayers)
 }
+
+func handleSetCompositeIndexProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetCompositeIndexProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	k.SetCompositeIndex(ctx, &p.Index)
+	return nil
+}
+
+func handleRemoveCompositeIndexProposal(ctx sdk.Context, k keeper.Keeper, p *types.RemoveCompositeIndexProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if k.GetCompositeIndex(ctx, p.Symbol) == nil {
+		return sdkerrors.Wrapf(types.ErrCompositeIndexNotFound, "symbol %s", p.Symbol)
+	}
+
+	k.DeleteCompositeIndex(ctx, p.Symbol)
+	return nil
+}
+
+func handleSetExchangeTwapConfigProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetExchangeTwapConfigProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := k.ValidateExchangeTwapConfigWindow(ctx, &p.Config); err != nil {
+		return err
+	}
+
+	k.SetExchangeTwapConfig(ctx, &p.Config)
+	return nil
+}
+
+func handleRemoveExchangeTwapConfigProposal(ctx sdk.Context, k keeper.Keeper, p *types.RemoveExchangeTwapConfigProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if k.GetExchangeTwapConfig(ctx, p.BaseDenom, p.QuoteDenom) == nil {
+		return sdkerrors.Wrapf(types.ErrExchangeTwapConfigNotFound, "base %s quote %s", p.BaseDenom, p.QuoteDenom)
+	}
+
+	k.DeleteExchangeTwapConfig(ctx, p.BaseDenom, p.QuoteDenom)
+	return nil
+}
@@ -28,6 +28,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&EnableBandIBCProposal{}, "oracle/EnableBandIBCProposal", nil)
 	cdc.RegisterConcrete(&GrantProviderPrivilegeProposal{}, "oracle/GrantProviderPrivilegeProposal", nil)
 	cdc.RegisterConcrete(&RevokeProviderPrivilegeProposal{}, "oracle/RevokeProviderPrivilegeProposal", nil)
+	cdc.RegisterConcrete(&SetCompositeIndexProposal{}, "oracle/SetCompositeIndexProposal", nil)
+	cdc.RegisterConcrete(&RemoveCompositeIndexProposal{}, "oracle/RemoveCompositeIndexProposal", nil)
+	cdc.RegisterConcrete(&SetExchangeTwapConfigProposal{}, "oracle/SetExchangeTwapConfigProposal", nil)
+	cdc.RegisterConcrete(&RemoveExchangeTwapConfigProposal{}, "oracle/RemoveExchangeTwapConfigProposal", nil)
 
 }
 
@@ -52,6 +56,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&EnableBandIBCProposal{},
 		&GrantProviderPrivilegeProposal{},
 		&RevokeProviderPrivilegeProposal{},
+		&SetCompositeIndexProposal{},
+		&RemoveCompositeIndexProposal{},
+		&SetExchangeTwapConfigProposal{},
+		&RemoveExchangeTwapConfigProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxCompositeIndexComponents is the maximum number of components a composite index can be made of
+const MaxCompositeIndexComponents = 10
+
+func (i *CompositeIndex) Validate() error {
+	if i.Symbol == "" {
+		return sdkerrors.Wrap(ErrInvalidCompositeIndex, "symbol should not be empty")
+	}
+	if i.Quote == "" {
+		return sdkerrors.Wrap(ErrInvalidCompositeIndex, "quote should not be empty")
+	}
+	if i.Symbol == i.Quote {
+		return sdkerrors.Wrap(ErrInvalidCompositeIndex, "symbol and quote should be different")
+	}
+
+	if _, ok := CompositeAggregation_name[int32(i.Aggregation)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidCompositeIndex, "unknown aggregation %d", i.Aggregation)
+	}
+
+	if len(i.Components) == 0 || len(i.Components) > MaxCompositeIndexComponents {
+		return sdkerrors.Wrapf(ErrInvalidCompositeIndex, "components must be between 1 and %d, got %d", MaxCompositeIndexComponents, len(i.Components))
+	}
+
+	if i.MinComponents == 0 || int(i.MinComponents) > len(i.Components) {
+		return sdkerrors.Wrapf(ErrInvalidCompositeIndex, "min components must be between 1 and %d, got %d", len(i.Components), i.MinComponents)
+	}
+
+	seen := make(map[string]struct{}, len(i.Components))
+	for idx := range i.Components {
+		component := &i.Components[idx]
+		if err := component.Validate(); err != nil {
+			return err
+		}
+
+		key := component.OracleType.String() + "/" + component.Base + "/" + component.Quote
+		if _, ok := seen[key]; ok {
+			return sdkerrors.Wrapf(ErrInvalidCompositeIndex, "duplicate component %s", key)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+func (c *CompositeIndexComponent) Validate() error {
+	switch c.OracleType {
+	case OracleType_Band, OracleType_PriceFeed, OracleType_Coinbase, OracleType_Chainlink, OracleType_Pyth, OracleType_BandIBC, OracleType_Provider, OracleType_ExchangeTwap:
+		// do nothing, composite indices can't be nested
+	default:
+		return sdkerrors.Wrapf(ErrUnsupportedOracleType, "composite index component of type %s", c.OracleType.String())
+	}
+
+	if c.Base == "" || c.Quote == "" {
+		return sdkerrors.Wrap(ErrInvalidCompositeIndex, "component base and quote should not be empty")
+	}
+
+	if c.Weight.IsNil() || !c.Weight.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidCompositeIndex, "component %s/%s weight must be positive", c.Base, c.Quote)
+	}
+
+	if c.MaxStaleness <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidCompositeIndex, "component %s/%s max staleness must be positive", c.Base, c.Quote)
+	}
+	return nil
+}
+
+// IsFresh returns true if a price of the component updated at the given timestamp is recent enough to be included in
+// the index.
+func (c *CompositeIndexComponent) IsFresh(timestamp, blockTime int64) bool {
+	return blockTime-timestamp <= c.MaxStaleness
+}
+
+// AggregateCompositeIndexPrices combines the prices of the components of an index with their weights.
+func AggregateCompositeIndexPrices(aggregation CompositeAggregation, prices, weights []sdk.Dec) sdk.Dec {
+	totalWeight := sdk.ZeroDec()
+	for _, weight := range weights {
+		totalWeight = totalWeight.Add(weight)
+	}
+
+	if aggregation == CompositeAggregation_WeightedMean {
+		weightedSum := sdk.ZeroDec()
+		for idx, price := range prices {
+			weightedSum = weightedSum.Add(price.Mul(weights[idx]))
+		}
+		return weightedSum.Quo(totalWeight)
+	}
+
+	order := make([]int, len(prices))
+	for idx := range order {
+		order[idx] = idx
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return prices[order[i]].LT(prices[order[j]])
+	})
+
+	// the weighted median is the first price at which the cumulative weight reaches half of the total weight, or the
+	// midpoint with the next price if it lands exactly on half
+	halfWeight := totalWeight.QuoInt64(2)
+	cumulativeWeight := sdk.ZeroDec()
+	for pos, idx := range order {
+		cumulativeWeight = cumulativeWeight.Add(weights[idx])
+		if cumulativeWeight.LT(halfWeight) {
+			continue
+		}
+		if cumulativeWeight.Equal(halfWeight) && pos+1 < len(order) {
+			return prices[idx].Add(prices[order[pos+1]]).QuoInt64(2)
+		}
+		return prices[idx]
+	}
+	return prices[order[len(order)-1]]
+}
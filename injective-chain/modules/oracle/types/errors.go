@@ -44,4 +44,8 @@ var (
 	ErrInvalidPythExponent         = sdkerrors.Register(ModuleName, 37, "unauthorized Pyth price relay")
 	ErrInvalidPythPublishTime      = sdkerrors.Register(ModuleName, 38, "unauthorized Pyth price relay")
 	ErrEmptyPriceAttestations      = sdkerrors.Register(ModuleName, 39, "empty price attestations")
+	ErrInvalidCompositeIndex       = sdkerrors.Register(ModuleName, 40, "invalid composite index")
+	ErrCompositeIndexNotFound      = sdkerrors.Register(ModuleName, 41, "composite index not found")
+	ErrInvalidExchangeTwapConfig   = sdkerrors.Register(ModuleName, 42, "invalid exchange TWAP config")
+	ErrExchangeTwapConfigNotFound  = sdkerrors.Register(ModuleName, 43, "exchange TWAP config not found")
 )
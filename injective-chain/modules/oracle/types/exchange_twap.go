@@ -0,0 +1,104 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MinExchangeTwapWindow is the minimum TWAP window of an exchange TWAP config (1 minute)
+	MinExchangeTwapWindow = 60
+	// MaxExchangeTwapWindow is the maximum TWAP window of an exchange TWAP config (7 days), the maximum trade record
+	// retention of an exchange market
+	MaxExchangeTwapWindow = 60 * 60 * 24 * 7
+)
+
+// SpotTradeRecord is the volume weighted average price and quantity of the trades of a spot market in a block, with
+// the price in human readable format and the quantity in base denom units.
+type SpotTradeRecord struct {
+	Timestamp int64
+	Price     sdk.Dec
+	Quantity  sdk.Dec
+}
+
+func (c *ExchangeTwapConfig) Validate() error {
+	if c.BaseDenom == "" || c.QuoteDenom == "" {
+		return sdkerrors.Wrap(ErrInvalidExchangeTwapConfig, "base and quote denoms should not be empty")
+	}
+	if c.BaseDenom == c.QuoteDenom {
+		return sdkerrors.Wrap(ErrInvalidExchangeTwapConfig, "base and quote denoms should be different")
+	}
+
+	if c.TwapWindow < MinExchangeTwapWindow || c.TwapWindow > MaxExchangeTwapWindow {
+		return sdkerrors.Wrapf(ErrInvalidExchangeTwapConfig, "TWAP window must be between %d and %d seconds, got %d", MinExchangeTwapWindow, MaxExchangeTwapWindow, c.TwapWindow)
+	}
+
+	if c.MinVolume.IsNil() || c.MinVolume.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidExchangeTwapConfig, "min volume must not be negative")
+	}
+	return nil
+}
+
+// NewExchangeTwapSnapshot returns the snapshot of the trade record following the previous snapshot of the pair. A trade
+// record with the timestamp of the previous snapshot was merged with it by the exchange, so it replaces the snapshot.
+func NewExchangeTwapSnapshot(baseDenom, quoteDenom string, record *SpotTradeRecord, previous *ExchangeTwapSnapshot) *ExchangeTwapSnapshot {
+	snapshot := &ExchangeTwapSnapshot{
+		BaseDenom:        baseDenom,
+		QuoteDenom:       quoteDenom,
+		Timestamp:        record.Timestamp,
+		Price:            record.Price,
+		Quantity:         record.Quantity,
+		CumulativePrice:  sdk.ZeroDec(),
+		CumulativeVolume: record.Quantity,
+	}
+
+	switch {
+	case previous == nil:
+	case previous.Timestamp == record.Timestamp:
+		snapshot.CumulativePrice = previous.CumulativePrice
+		snapshot.CumulativeVolume = previous.CumulativeVolume.Sub(previous.Quantity).Add(record.Quantity)
+	default:
+		snapshot.CumulativePrice = previous.CumulativePriceAt(record.Timestamp)
+		snapshot.CumulativeVolume = previous.CumulativeVolume.Add(record.Quantity)
+	}
+
+	return snapshot
+}
+
+// CumulativePriceAt returns the cumulative price at the given time, the price of the snapshot holding since its
+// timestamp. The time must not be past the timestamp of the next snapshot.
+func (s *ExchangeTwapSnapshot) CumulativePriceAt(timestamp int64) sdk.Dec {
+	return s.CumulativePrice.Add(s.Price.MulInt64(timestamp - s.Timestamp))
+}
+
+// ComputeTwap returns the time weighted average of the prices of the trade records of the window ending at blockTime,
+// each price holding until the next record and the last one until blockTime. The start snapshot is the last one before
+// the window, whose price holds at the start of the window, or the first one in the window if the pair has no earlier
+// snapshot. It returns nil if less than the min volume was traded in the window.
+func (c *ExchangeTwapConfig) ComputeTwap(start, latest *ExchangeTwapSnapshot, blockTime int64) *sdk.Dec {
+	windowStart := blockTime - c.TwapWindow
+
+	// the cumulative volume of a snapshot includes its own quantity, which only counts if the snapshot is in the window
+	volume := latest.CumulativeVolume.Sub(start.CumulativeVolume)
+	if start.Timestamp >= windowStart {
+		volume = volume.Add(start.Quantity)
+	}
+
+	if volume.LT(c.MinVolume) {
+		return nil
+	}
+
+	from := windowStart
+	if start.Timestamp > from {
+		from = start.Timestamp
+	}
+
+	// all the records were made at blockTime
+	if blockTime <= from {
+		price := latest.Price
+		return &price
+	}
+
+	twap := latest.CumulativePriceAt(blockTime).Sub(start.CumulativePriceAt(from)).QuoInt64(blockTime - from)
+	return &twap
+}
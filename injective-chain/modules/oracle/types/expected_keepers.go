@@ -34,3 +34,13 @@ type PortKeeper interface {
 type OcrKeeper interface {
 	GetTransmission(ctx sdk.Context, feedId string) *ocrtypes.Transmission
 }
+
+// ExchangeKeeper defines the expected exchange keeper methods
+type ExchangeKeeper interface {
+	// GetSpotMarketTradeRecords returns the trade records since from of the active spot market with the given base and
+	// quote denoms, or false if there is no such market.
+	GetSpotMarketTradeRecords(ctx sdk.Context, baseDenom, quoteDenom string, from int64) ([]SpotTradeRecord, bool)
+	// GetSpotMarketTradeRecordRetention returns the trade record retention in seconds of the active spot market with the
+	// given base and quote denoms, or false if there is no such market.
+	GetSpotMarketTradeRecordRetention(ctx sdk.Context, baseDenom, quoteDenom string) (int64, bool)
+}
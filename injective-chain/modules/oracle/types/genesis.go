@@ -12,6 +12,18 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+
+	for _, index := range gs.CompositeIndices {
+		if err := index.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, config := range gs.ExchangeTwapConfigs {
+		if err := config.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
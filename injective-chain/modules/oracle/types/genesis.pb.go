@@ -26,21 +26,26 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the oracle module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of related to oracle.
-	Params                 Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	BandRelayers           []string               `protobuf:"bytes,2,rep,name=band_relayers,json=bandRelayers,proto3" json:"band_relayers,omitempty"`
-	BandPriceStates        []*BandPriceState      `protobuf:"bytes,3,rep,name=band_price_states,json=bandPriceStates,proto3" json:"band_price_states,omitempty"`
-	PriceFeedPriceStates   []*PriceFeedState      `protobuf:"bytes,4,rep,name=price_feed_price_states,json=priceFeedPriceStates,proto3" json:"price_feed_price_states,omitempty"`
-	CoinbasePriceStates    []*CoinbasePriceState  `protobuf:"bytes,5,rep,name=coinbase_price_states,json=coinbasePriceStates,proto3" json:"coinbase_price_states,omitempty"`
-	BandIbcPriceStates     []*BandPriceState      `protobuf:"bytes,6,rep,name=band_ibc_price_states,json=bandIbcPriceStates,proto3" json:"band_ibc_price_states,omitempty"`
-	BandIbcOracleRequests  []*BandOracleRequest   `protobuf:"bytes,7,rep,name=band_ibc_oracle_requests,json=bandIbcOracleRequests,proto3" json:"band_ibc_oracle_requests,omitempty"`
-	BandIbcParams          BandIBCParams          `protobuf:"bytes,8,opt,name=band_ibc_params,json=bandIbcParams,proto3" json:"band_ibc_params"`
-	BandIbcLatestClientId  uint64                 `protobuf:"varint,9,opt,name=band_ibc_latest_client_id,json=bandIbcLatestClientId,proto3" json:"band_ibc_latest_client_id,omitempty"`
-	CalldataRecords        []*CalldataRecord      `protobuf:"bytes,10,rep,name=calldata_records,json=calldataRecords,proto3" json:"calldata_records,omitempty"`
-	BandIbcLatestRequestId uint64                 `protobuf:"varint,11,opt,name=band_ibc_latest_request_id,json=bandIbcLatestRequestId,proto3" json:"band_ibc_latest_request_id,omitempty"`
-	ChainlinkPriceStates   []*ChainlinkPriceState `protobuf:"bytes,12,rep,name=chainlink_price_states,json=chainlinkPriceStates,proto3" json:"chainlink_price_states,omitempty"`
-	HistoricalPriceRecords []*PriceRecords        `protobuf:"bytes,13,rep,name=historical_price_records,json=historicalPriceRecords,proto3" json:"historical_price_records,omitempty"`
-	ProviderStates         []*ProviderState       `protobuf:"bytes,14,rep,name=provider_states,json=providerStates,proto3" json:"provider_states,omitempty"`
-	PythPriceStates        []*PythPriceState      `protobuf:"bytes,15,rep,name=pyth_price_states,json=pythPriceStates,proto3" json:"pyth_price_states,omitempty"`
+	Params                    Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	BandRelayers              []string                    `protobuf:"bytes,2,rep,name=band_relayers,json=bandRelayers,proto3" json:"band_relayers,omitempty"`
+	BandPriceStates           []*BandPriceState           `protobuf:"bytes,3,rep,name=band_price_states,json=bandPriceStates,proto3" json:"band_price_states,omitempty"`
+	PriceFeedPriceStates      []*PriceFeedState           `protobuf:"bytes,4,rep,name=price_feed_price_states,json=priceFeedPriceStates,proto3" json:"price_feed_price_states,omitempty"`
+	CoinbasePriceStates       []*CoinbasePriceState       `protobuf:"bytes,5,rep,name=coinbase_price_states,json=coinbasePriceStates,proto3" json:"coinbase_price_states,omitempty"`
+	BandIbcPriceStates        []*BandPriceState           `protobuf:"bytes,6,rep,name=band_ibc_price_states,json=bandIbcPriceStates,proto3" json:"band_ibc_price_states,omitempty"`
+	BandIbcOracleRequests     []*BandOracleRequest        `protobuf:"bytes,7,rep,name=band_ibc_oracle_requests,json=bandIbcOracleRequests,proto3" json:"band_ibc_oracle_requests,omitempty"`
+	BandIbcParams             BandIBCParams               `protobuf:"bytes,8,opt,name=band_ibc_params,json=bandIbcParams,proto3" json:"band_ibc_params"`
+	BandIbcLatestClientId     uint64                      `protobuf:"varint,9,opt,name=band_ibc_latest_client_id,json=bandIbcLatestClientId,proto3" json:"band_ibc_latest_client_id,omitempty"`
+	CalldataRecords           []*CalldataRecord           `protobuf:"bytes,10,rep,name=calldata_records,json=calldataRecords,proto3" json:"calldata_records,omitempty"`
+	BandIbcLatestRequestId    uint64                      `protobuf:"varint,11,opt,name=band_ibc_latest_request_id,json=bandIbcLatestRequestId,proto3" json:"band_ibc_latest_request_id,omitempty"`
+	ChainlinkPriceStates      []*ChainlinkPriceState      `protobuf:"bytes,12,rep,name=chainlink_price_states,json=chainlinkPriceStates,proto3" json:"chainlink_price_states,omitempty"`
+	HistoricalPriceRecords    []*PriceRecords             `protobuf:"bytes,13,rep,name=historical_price_records,json=historicalPriceRecords,proto3" json:"historical_price_records,omitempty"`
+	ProviderStates            []*ProviderState            `protobuf:"bytes,14,rep,name=provider_states,json=providerStates,proto3" json:"provider_states,omitempty"`
+	PythPriceStates           []*PythPriceState           `protobuf:"bytes,15,rep,name=pyth_price_states,json=pythPriceStates,proto3" json:"pyth_price_states,omitempty"`
+	CompositeIndices          []*CompositeIndex           `protobuf:"bytes,16,rep,name=composite_indices,json=compositeIndices,proto3" json:"composite_indices,omitempty"`
+	CompositeIndexPriceStates []*CompositeIndexPriceState `protobuf:"bytes,17,rep,name=composite_index_price_states,json=compositeIndexPriceStates,proto3" json:"composite_index_price_states,omitempty"`
+	ExchangeTwapConfigs       []*ExchangeTwapConfig       `protobuf:"bytes,18,rep,name=exchange_twap_configs,json=exchangeTwapConfigs,proto3" json:"exchange_twap_configs,omitempty"`
+	ExchangeTwapPriceStates   []*ExchangeTwapPriceState   `protobuf:"bytes,19,rep,name=exchange_twap_price_states,json=exchangeTwapPriceStates,proto3" json:"exchange_twap_price_states,omitempty"`
+	ExchangeTwapSnapshots     []*ExchangeTwapSnapshot     `protobuf:"bytes,20,rep,name=exchange_twap_snapshots,json=exchangeTwapSnapshots,proto3" json:"exchange_twap_snapshots,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -181,6 +186,41 @@ func (m *GenesisState) GetPythPriceStates() []*PythPriceState {
 	return nil
 }
 
+func (m *GenesisState) GetCompositeIndices() []*CompositeIndex {
+	if m != nil {
+		return m.CompositeIndices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCompositeIndexPriceStates() []*CompositeIndexPriceState {
+	if m != nil {
+		return m.CompositeIndexPriceStates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExchangeTwapConfigs() []*ExchangeTwapConfig {
+	if m != nil {
+		return m.ExchangeTwapConfigs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExchangeTwapPriceStates() []*ExchangeTwapPriceState {
+	if m != nil {
+		return m.ExchangeTwapPriceStates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExchangeTwapSnapshots() []*ExchangeTwapSnapshot {
+	if m != nil {
+		return m.ExchangeTwapSnapshots
+	}
+	return nil
+}
+
 type CalldataRecord struct {
 	ClientId uint64 `protobuf:"varint,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	Calldata []byte `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
@@ -243,47 +283,56 @@ func init() {
 }
 
 var fileDescriptor_f7e14cf80151b4d2 = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xc7, 0xe3, 0xb6, 0xbf, 0xfe, 0x9a, 0x6d, 0xda, 0xc0, 0xd2, 0x16, 0x13, 0x24, 0x63, 0x15,
-	0xd1, 0x5a, 0x82, 0xda, 0x6a, 0xb9, 0x20, 0x0e, 0x1c, 0x12, 0x09, 0x64, 0xa9, 0x12, 0x95, 0x0b,
-	0x17, 0x38, 0x98, 0xf5, 0x7a, 0x9b, 0x2c, 0x38, 0x5e, 0xb3, 0xbb, 0x89, 0x94, 0xb7, 0xe0, 0xb1,
-	0x7a, 0xec, 0x91, 0x13, 0xaa, 0x92, 0x17, 0x41, 0x5e, 0xff, 0x89, 0xdd, 0x2a, 0x89, 0xb8, 0x79,
-	0xc6, 0x33, 0x9f, 0xf9, 0xee, 0xcc, 0xec, 0x82, 0x23, 0x1a, 0x7f, 0x27, 0x58, 0xd2, 0x31, 0x71,
-	0x18, 0x47, 0x38, 0x22, 0xce, 0xf8, 0x34, 0x20, 0x12, 0x9d, 0x3a, 0x7d, 0x12, 0x13, 0x41, 0x85,
-	0x9d, 0x70, 0x26, 0x19, 0xd4, 0xcb, 0x38, 0x3b, 0x8b, 0xb3, 0xf3, 0xb8, 0xce, 0x8b, 0x85, 0x84,
-	0x3c, 0x50, 0x01, 0x3a, 0x7b, 0x7d, 0xd6, 0x67, 0xea, 0xd3, 0x49, 0xbf, 0x32, 0xef, 0xe1, 0x6d,
-	0x13, 0xb4, 0x3e, 0x64, 0x85, 0x2e, 0x25, 0x92, 0x04, 0xbe, 0x03, 0x9b, 0x09, 0xe2, 0x68, 0x28,
-	0x74, 0xcd, 0xd4, 0xac, 0xed, 0x33, 0xd3, 0x5e, 0x54, 0xd8, 0xbe, 0x50, 0x71, 0xdd, 0x8d, 0xeb,
-	0x3f, 0xcf, 0x1a, 0x5e, 0x9e, 0x05, 0x9f, 0x83, 0x9d, 0x00, 0xc5, 0xa1, 0xcf, 0x49, 0x84, 0x26,
-	0x84, 0x0b, 0x7d, 0xcd, 0x5c, 0xb7, 0x9a, 0x5e, 0x2b, 0x75, 0x7a, 0xb9, 0x0f, 0x7e, 0x02, 0x0f,
-	0x55, 0x50, 0xc2, 0x29, 0x26, 0xbe, 0x48, 0x0b, 0x0b, 0x7d, 0xdd, 0x5c, 0xb7, 0xb6, 0xcf, 0xac,
-	0xc5, 0xf5, 0xba, 0x28, 0x0e, 0x2f, 0xd2, 0x0c, 0xa5, 0xd4, 0x6b, 0x07, 0x35, 0x5b, 0x40, 0x1f,
-	0x3c, 0xce, 0x80, 0x57, 0x84, 0xdc, 0x61, 0x6f, 0xac, 0x62, 0x2b, 0xce, 0x7b, 0x42, 0xc2, 0x8c,
-	0xbd, 0x97, 0x14, 0x76, 0xb5, 0xc0, 0x37, 0xb0, 0x8f, 0x19, 0x8d, 0x03, 0x24, 0x48, 0x1d, 0xff,
-	0x9f, 0xc2, 0xbf, 0x5a, 0x8c, 0xef, 0xe5, 0x69, 0x15, 0xf9, 0x8f, 0xf0, 0x3d, 0x9f, 0x80, 0x5f,
-	0xc1, 0xbe, 0x6a, 0x0c, 0x0d, 0x70, 0xbd, 0xc2, 0xe6, 0x3f, 0x36, 0x07, 0xa6, 0x18, 0x37, 0xc0,
-	0x55, 0x78, 0x08, 0xf4, 0x12, 0x9e, 0x65, 0xfb, 0x9c, 0xfc, 0x1c, 0x11, 0x21, 0x85, 0xfe, 0xbf,
-	0xe2, 0xbf, 0x5c, 0xce, 0xff, 0xa8, 0x5c, 0x5e, 0x96, 0xe3, 0xed, 0xe7, 0x25, 0x6a, 0x5e, 0x01,
-	0x3f, 0x83, 0xf6, 0xfc, 0x08, 0xd9, 0x26, 0x6d, 0xa9, 0x4d, 0x3a, 0x5e, 0x0e, 0x77, 0xbb, 0xbd,
-	0xda, 0x42, 0xed, 0x14, 0x27, 0xc8, 0xf6, 0xea, 0x0d, 0x78, 0x52, 0x62, 0xa3, 0xf4, 0x38, 0xd2,
-	0xc7, 0x11, 0x25, 0xb1, 0xf4, 0x69, 0xa8, 0x37, 0x4d, 0xcd, 0xda, 0x28, 0x05, 0x9d, 0xab, 0xdf,
-	0x3d, 0xf5, 0xd7, 0x0d, 0xe1, 0x25, 0x78, 0x80, 0x51, 0x14, 0x85, 0x48, 0x22, 0x9f, 0x13, 0xcc,
-	0x78, 0x28, 0x74, 0xb0, 0xaa, 0x9d, 0xbd, 0x3c, 0xc3, 0x53, 0x09, 0x5e, 0x1b, 0xd7, 0x6c, 0x01,
-	0xdf, 0x82, 0xce, 0x5d, 0x39, 0x79, 0x2f, 0x53, 0x3d, 0xdb, 0x4a, 0xcf, 0x41, 0x4d, 0x4f, 0xde,
-	0x20, 0x37, 0x84, 0x18, 0x1c, 0xe0, 0x01, 0xa2, 0x71, 0x44, 0xe3, 0x1f, 0xf5, 0x29, 0xb7, 0x94,
-	0xac, 0x93, 0x25, 0xb2, 0x8a, 0xbc, 0xca, 0xa8, 0xf7, 0xf0, 0x7d, 0x67, 0xba, 0xab, 0xfa, 0x80,
-	0x0a, 0xc9, 0x38, 0xc5, 0x28, 0xca, 0xab, 0x14, 0xa7, 0xdf, 0x51, 0x65, 0x8e, 0x56, 0xdc, 0x86,
-	0xfc, 0xa8, 0xde, 0xc1, 0x9c, 0x53, 0xf5, 0xc3, 0x0b, 0xd0, 0x4e, 0x38, 0x1b, 0xd3, 0x90, 0xf0,
-	0x42, 0xff, 0xae, 0x02, 0x1f, 0x2f, 0x03, 0x67, 0x09, 0x99, 0xf2, 0xdd, 0xa4, 0x6a, 0xaa, 0x67,
-	0x21, 0x99, 0xc8, 0x41, 0xbd, 0x27, 0xed, 0x95, 0x57, 0x77, 0x22, 0x07, 0xd5, 0x67, 0x21, 0xa9,
-	0xd9, 0xe2, 0xd0, 0x05, 0xbb, 0xf5, 0x69, 0xc2, 0xa7, 0xa0, 0x39, 0xdf, 0x1d, 0x4d, 0xcd, 0x6a,
-	0x0b, 0x17, 0xeb, 0xd2, 0x01, 0x5b, 0xc5, 0xb0, 0xf5, 0x35, 0x53, 0xb3, 0x5a, 0x5e, 0x69, 0x77,
-	0xaf, 0xae, 0xa7, 0x86, 0x76, 0x33, 0x35, 0xb4, 0xdb, 0xa9, 0xa1, 0xfd, 0x9a, 0x19, 0x8d, 0x9b,
-	0x99, 0xd1, 0xf8, 0x3d, 0x33, 0x1a, 0x5f, 0xce, 0xfb, 0x54, 0x0e, 0x46, 0x81, 0x8d, 0xd9, 0xd0,
-	0x71, 0x0b, 0xa5, 0xe7, 0x28, 0x10, 0x4e, 0xa9, 0xfb, 0x04, 0x33, 0x4e, 0xaa, 0x66, 0x3a, 0x36,
-	0x67, 0xc8, 0xc2, 0x51, 0x44, 0x44, 0xf1, 0x74, 0xcb, 0x49, 0x42, 0x44, 0xb0, 0xa9, 0x1e, 0xe7,
-	0xd7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x53, 0x49, 0x4f, 0x18, 0x1d, 0x06, 0x00, 0x00,
+	// 772 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x4f, 0xdb, 0x48,
+	0x14, 0x8f, 0x09, 0xcb, 0xc2, 0x90, 0x10, 0x18, 0x12, 0x30, 0xd9, 0x55, 0x36, 0x62, 0xb5, 0x6c,
+	0xa4, 0x5d, 0x92, 0x42, 0x2f, 0x55, 0x0f, 0x3d, 0x24, 0x6a, 0xab, 0x48, 0x48, 0x8d, 0x0c, 0xbd,
+	0xb4, 0x07, 0x77, 0x3c, 0x7e, 0x49, 0xa6, 0x75, 0x3c, 0xae, 0x67, 0xf8, 0x93, 0x6f, 0xd1, 0x8f,
+	0xc5, 0x91, 0x63, 0x4f, 0x55, 0x05, 0xea, 0xf7, 0xa8, 0x3c, 0xb6, 0x83, 0x07, 0x48, 0x42, 0x6f,
+	0x7e, 0xcf, 0xf3, 0xfb, 0xf3, 0xc6, 0xef, 0x3d, 0xa3, 0x3d, 0xe6, 0x7f, 0x04, 0x2a, 0xd9, 0x19,
+	0xb4, 0x78, 0x48, 0xa8, 0x07, 0xad, 0xb3, 0x03, 0x07, 0x24, 0x39, 0x68, 0x0d, 0xc0, 0x07, 0xc1,
+	0x44, 0x33, 0x08, 0xb9, 0xe4, 0xd8, 0x9c, 0x9c, 0x6b, 0xc6, 0xe7, 0x9a, 0xc9, 0xb9, 0xea, 0x3f,
+	0x53, 0x19, 0x92, 0x83, 0x8a, 0xa0, 0x5a, 0x1e, 0xf0, 0x01, 0x57, 0x8f, 0xad, 0xe8, 0x29, 0xce,
+	0xee, 0xfe, 0x28, 0xa2, 0xc2, 0xeb, 0x58, 0xe8, 0x58, 0x12, 0x09, 0xf8, 0x05, 0x5a, 0x0a, 0x48,
+	0x48, 0x46, 0xc2, 0x34, 0xea, 0x46, 0x63, 0xf5, 0xb0, 0xde, 0x9c, 0x26, 0xdc, 0xec, 0xa9, 0x73,
+	0xed, 0xc5, 0xcb, 0x6f, 0x7f, 0xe5, 0xac, 0x04, 0x85, 0xff, 0x46, 0x45, 0x87, 0xf8, 0xae, 0x1d,
+	0x82, 0x47, 0xc6, 0x10, 0x0a, 0x73, 0xa1, 0x9e, 0x6f, 0xac, 0x58, 0x85, 0x28, 0x69, 0x25, 0x39,
+	0x7c, 0x82, 0x36, 0xd4, 0xa1, 0x20, 0x64, 0x14, 0x6c, 0x11, 0x09, 0x0b, 0x33, 0x5f, 0xcf, 0x37,
+	0x56, 0x0f, 0x1b, 0xd3, 0xf5, 0xda, 0xc4, 0x77, 0x7b, 0x11, 0x42, 0x39, 0xb5, 0x4a, 0x8e, 0x16,
+	0x0b, 0x6c, 0xa3, 0xed, 0x98, 0xb0, 0x0f, 0x70, 0x87, 0x7b, 0x71, 0x1e, 0xb7, 0xe2, 0x79, 0x05,
+	0xe0, 0xc6, 0xdc, 0xe5, 0x20, 0x8d, 0xb3, 0x02, 0x1f, 0x50, 0x85, 0x72, 0xe6, 0x3b, 0x44, 0x80,
+	0x4e, 0xff, 0x9b, 0xa2, 0xff, 0x7f, 0x3a, 0x7d, 0x27, 0x81, 0x65, 0xec, 0x6f, 0xd2, 0x7b, 0x39,
+	0x81, 0xdf, 0xa3, 0x8a, 0xba, 0x18, 0xe6, 0x50, 0x5d, 0x61, 0xe9, 0x17, 0x2f, 0x07, 0x47, 0x34,
+	0x5d, 0x87, 0x66, 0xc9, 0x5d, 0x64, 0x4e, 0xc8, 0x63, 0xb4, 0x1d, 0xc2, 0xe7, 0x53, 0x10, 0x52,
+	0x98, 0xbf, 0x2b, 0xfe, 0xff, 0x66, 0xf3, 0xbf, 0x51, 0x29, 0x2b, 0xc6, 0x58, 0x95, 0x44, 0x42,
+	0xcb, 0x0a, 0xfc, 0x16, 0x95, 0x6e, 0x4b, 0x88, 0x3b, 0x69, 0x59, 0x75, 0xd2, 0xbf, 0xb3, 0xc9,
+	0xbb, 0xed, 0x8e, 0xd6, 0x50, 0xc5, 0xb4, 0x82, 0xb8, 0xaf, 0x9e, 0xa1, 0x9d, 0x09, 0xad, 0x17,
+	0x95, 0x23, 0x6d, 0xea, 0x31, 0xf0, 0xa5, 0xcd, 0x5c, 0x73, 0xa5, 0x6e, 0x34, 0x16, 0x27, 0x86,
+	0x8e, 0xd4, 0xeb, 0x8e, 0x7a, 0xdb, 0x75, 0xf1, 0x31, 0x5a, 0xa7, 0xc4, 0xf3, 0x5c, 0x22, 0x89,
+	0x1d, 0x02, 0xe5, 0xa1, 0x2b, 0x4c, 0x34, 0xef, 0x3a, 0x3b, 0x09, 0xc2, 0x52, 0x00, 0xab, 0x44,
+	0xb5, 0x58, 0xe0, 0xe7, 0xa8, 0x7a, 0xd7, 0x4e, 0x72, 0x97, 0x91, 0x9f, 0x55, 0xe5, 0x67, 0x4b,
+	0xf3, 0x93, 0x5c, 0x50, 0xd7, 0xc5, 0x14, 0x6d, 0xd1, 0x21, 0x61, 0xbe, 0xc7, 0xfc, 0x4f, 0xfa,
+	0x57, 0x2e, 0x28, 0x5b, 0xfb, 0x33, 0x6c, 0xa5, 0xb8, 0xcc, 0xa7, 0x2e, 0xd3, 0xfb, 0xc9, 0xa8,
+	0x57, 0xcd, 0x21, 0x13, 0x92, 0x87, 0x8c, 0x12, 0x2f, 0x51, 0x49, 0xab, 0x2f, 0x2a, 0x99, 0xbd,
+	0x39, 0xd3, 0x90, 0x94, 0x6a, 0x6d, 0xdd, 0xf2, 0x64, 0xf3, 0xb8, 0x87, 0x4a, 0x41, 0xc8, 0xcf,
+	0x98, 0x0b, 0x61, 0xea, 0x7f, 0xad, 0x9e, 0x9f, 0xfd, 0xa1, 0x7b, 0x09, 0x20, 0x76, 0xbe, 0x16,
+	0x64, 0x43, 0xb5, 0x16, 0x82, 0xb1, 0x1c, 0xea, 0x77, 0x52, 0x9a, 0x3b, 0xba, 0x63, 0x39, 0xcc,
+	0xae, 0x85, 0x40, 0x8b, 0xa3, 0x86, 0xdc, 0xa0, 0x7c, 0x14, 0x70, 0xc1, 0x24, 0xd8, 0xcc, 0x77,
+	0x19, 0x05, 0x61, 0xae, 0xcf, 0x6d, 0x80, 0x14, 0xd2, 0xf5, 0x5d, 0xb8, 0xb0, 0xd6, 0x69, 0x26,
+	0x8e, 0x18, 0xb0, 0x40, 0x7f, 0x6a, 0xb4, 0x70, 0xa1, 0xfb, 0xde, 0x50, 0x0a, 0x87, 0x8f, 0x55,
+	0xc8, 0x54, 0xb0, 0x43, 0xa7, 0xbc, 0x51, 0x1b, 0x08, 0x2e, 0xe8, 0x90, 0xf8, 0x03, 0xb0, 0xe5,
+	0x39, 0x09, 0x6c, 0xca, 0xfd, 0x3e, 0x1b, 0x08, 0x13, 0xcf, 0xdb, 0x40, 0x2f, 0x13, 0xd8, 0xc9,
+	0x39, 0x09, 0x3a, 0x0a, 0x64, 0x6d, 0xc2, 0xbd, 0x9c, 0xc0, 0x23, 0x54, 0xd5, 0x15, 0xb4, 0xa2,
+	0x36, 0x95, 0xcc, 0x93, 0xc7, 0xc9, 0x64, 0x4a, 0xda, 0x86, 0x07, 0xf3, 0x02, 0xf7, 0xd1, 0xb6,
+	0x2e, 0x27, 0x7c, 0x12, 0x88, 0x21, 0x97, 0xc2, 0x2c, 0x2b, 0xad, 0xe6, 0xe3, 0xb4, 0x8e, 0x13,
+	0x98, 0x55, 0x81, 0x07, 0xb2, 0x62, 0xb7, 0x8b, 0xd6, 0xf4, 0x91, 0xc6, 0x7f, 0xa0, 0x95, 0xdb,
+	0x05, 0x62, 0xa8, 0x81, 0x5d, 0xa6, 0xe9, 0xce, 0xa8, 0xa2, 0xe5, 0x74, 0xe2, 0xcd, 0x85, 0xba,
+	0xd1, 0x28, 0x58, 0x93, 0xb8, 0xdd, 0xbf, 0xbc, 0xae, 0x19, 0x57, 0xd7, 0x35, 0xe3, 0xfb, 0x75,
+	0xcd, 0xf8, 0x72, 0x53, 0xcb, 0x5d, 0xdd, 0xd4, 0x72, 0x5f, 0x6f, 0x6a, 0xb9, 0x77, 0x47, 0x03,
+	0x26, 0x87, 0xa7, 0x4e, 0x93, 0xf2, 0x51, 0xab, 0x9b, 0xba, 0x3e, 0x22, 0x8e, 0x68, 0x4d, 0x6a,
+	0xd8, 0xa7, 0x3c, 0x84, 0x6c, 0x18, 0xcd, 0x6e, 0x6b, 0xc4, 0xdd, 0x53, 0x0f, 0x44, 0xfa, 0xff,
+	0x96, 0xe3, 0x00, 0x84, 0xb3, 0xa4, 0xfe, 0xd0, 0x4f, 0x7f, 0x0e, 0x00, 0x3c, 0x97, 0x7c, 0xa0,
+	0x22, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -306,6 +355,86 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExchangeTwapSnapshots) > 0 {
+		for iNdEx := len(m.ExchangeTwapSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeTwapSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.ExchangeTwapPriceStates) > 0 {
+		for iNdEx := len(m.ExchangeTwapPriceStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeTwapPriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.ExchangeTwapConfigs) > 0 {
+		for iNdEx := len(m.ExchangeTwapConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeTwapConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.CompositeIndexPriceStates) > 0 {
+		for iNdEx := len(m.CompositeIndexPriceStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompositeIndexPriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.CompositeIndices) > 0 {
+		for iNdEx := len(m.CompositeIndices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompositeIndices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	if len(m.PythPriceStates) > 0 {
 		for iNdEx := len(m.PythPriceStates) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -616,6 +745,36 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.CompositeIndices) > 0 {
+		for _, e := range m.CompositeIndices {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CompositeIndexPriceStates) > 0 {
+		for _, e := range m.CompositeIndexPriceStates {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExchangeTwapConfigs) > 0 {
+		for _, e := range m.ExchangeTwapConfigs {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExchangeTwapPriceStates) > 0 {
+		for _, e := range m.ExchangeTwapPriceStates {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExchangeTwapSnapshots) > 0 {
+		for _, e := range m.ExchangeTwapSnapshots {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1146,6 +1305,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompositeIndices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompositeIndices = append(m.CompositeIndices, &CompositeIndex{})
+			if err := m.CompositeIndices[len(m.CompositeIndices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompositeIndexPriceStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompositeIndexPriceStates = append(m.CompositeIndexPriceStates, &CompositeIndexPriceState{})
+			if err := m.CompositeIndexPriceStates[len(m.CompositeIndexPriceStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeTwapConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeTwapConfigs = append(m.ExchangeTwapConfigs, &ExchangeTwapConfig{})
+			if err := m.ExchangeTwapConfigs[len(m.ExchangeTwapConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeTwapPriceStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeTwapPriceStates = append(m.ExchangeTwapPriceStates, &ExchangeTwapPriceState{})
+			if err := m.ExchangeTwapPriceStates[len(m.ExchangeTwapPriceStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeTwapSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeTwapSnapshots = append(m.ExchangeTwapSnapshots, &ExchangeTwapSnapshot{})
+			if err := m.ExchangeTwapSnapshots[len(m.ExchangeTwapSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -57,6 +57,18 @@ var (
 
 	// PythPriceKey is the prefix for the priceID => PythPriceState store.
 	PythPriceKey = []byte{0x71}
+
+	// CompositeIndexKey is the prefix for the symbol => CompositeIndex store.
+	CompositeIndexKey = []byte{0x81}
+	// CompositeIndexPriceKey is the prefix for the symbol => CompositeIndexPriceState store.
+	CompositeIndexPriceKey = []byte{0x82}
+
+	// ExchangeTwapConfigKey is the prefix for the base/quote hash => ExchangeTwapConfig store.
+	ExchangeTwapConfigKey = []byte{0x91}
+	// ExchangeTwapPriceKey is the prefix for the base/quote hash => ExchangeTwapPriceState store.
+	ExchangeTwapPriceKey = []byte{0x92}
+	// ExchangeTwapSnapshotKey is the prefix for the base/quote hash + timestamp => ExchangeTwapSnapshot store.
+	ExchangeTwapSnapshotKey = []byte{0x93}
 )
 
 func GetBandPriceStoreKey(symbol string) []byte {
@@ -160,3 +172,27 @@ func GetProviderPriceKey(provider, symbol string) []byte {
 func GetPythPriceStoreKey(priceID common.Hash) []byte {
 	return append(PythPriceKey, priceID.Bytes()...)
 }
+
+func GetCompositeIndexStoreKey(symbol string) []byte {
+	return append(CompositeIndexKey, []byte(symbol)...)
+}
+
+func GetCompositeIndexPriceStoreKey(symbol string) []byte {
+	return append(CompositeIndexPriceKey, []byte(symbol)...)
+}
+
+func GetExchangeTwapConfigStoreKey(baseDenom, quoteDenom string) []byte {
+	return append(ExchangeTwapConfigKey, GetBaseQuoteHash(baseDenom, quoteDenom).Bytes()...)
+}
+
+func GetExchangeTwapPriceStoreKey(baseDenom, quoteDenom string) []byte {
+	return append(ExchangeTwapPriceKey, GetBaseQuoteHash(baseDenom, quoteDenom).Bytes()...)
+}
+
+func GetExchangeTwapSnapshotsPrefix(baseDenom, quoteDenom string) []byte {
+	return append(ExchangeTwapSnapshotKey, GetBaseQuoteHash(baseDenom, quoteDenom).Bytes()...)
+}
+
+func GetExchangeTwapSnapshotStoreKey(baseDenom, quoteDenom string, timestamp int64) []byte {
+	return append(GetExchangeTwapSnapshotsPrefix(baseDenom, quoteDenom), sdk.Uint64ToBigEndian(uint64(timestamp))...)
+}
@@ -32,6 +32,10 @@ func GetOracleType(oracleTypeStr string) (OracleType, error) {
 		oracleType = OracleType_Provider
 	case "pyth":
 		oracleType = OracleType_Pyth
+	case "composite":
+		oracleType = OracleType_Composite
+	case "exchangetwap":
+		oracleType = OracleType_ExchangeTwap
 	default:
 		return OracleType_Band, sdkerrors.Wrapf(ErrUnsupportedOracleType, "%s", oracleTypeStr)
 	}
@@ -28,18 +28,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type OracleType int32
 
 const (
-	OracleType_Unspecified OracleType = 0
-	OracleType_Band        OracleType = 1
-	OracleType_PriceFeed   OracleType = 2
-	OracleType_Coinbase    OracleType = 3
-	OracleType_Chainlink   OracleType = 4
-	OracleType_Razor       OracleType = 5
-	OracleType_Dia         OracleType = 6
-	OracleType_API3        OracleType = 7
-	OracleType_Uma         OracleType = 8
-	OracleType_Pyth        OracleType = 9
-	OracleType_BandIBC     OracleType = 10
-	OracleType_Provider    OracleType = 11
+	OracleType_Unspecified  OracleType = 0
+	OracleType_Band         OracleType = 1
+	OracleType_PriceFeed    OracleType = 2
+	OracleType_Coinbase     OracleType = 3
+	OracleType_Chainlink    OracleType = 4
+	OracleType_Razor        OracleType = 5
+	OracleType_Dia          OracleType = 6
+	OracleType_API3         OracleType = 7
+	OracleType_Uma          OracleType = 8
+	OracleType_Pyth         OracleType = 9
+	OracleType_BandIBC      OracleType = 10
+	OracleType_Provider     OracleType = 11
+	OracleType_Composite    OracleType = 12
+	OracleType_ExchangeTwap OracleType = 13
 )
 
 var OracleType_name = map[int32]string{
@@ -55,21 +57,25 @@ var OracleType_name = map[int32]string{
 	9:  "Pyth",
 	10: "BandIBC",
 	11: "Provider",
+	12: "Composite",
+	13: "ExchangeTwap",
 }
 
 var OracleType_value = map[string]int32{
-	"Unspecified": 0,
-	"Band":        1,
-	"PriceFeed":   2,
-	"Coinbase":    3,
-	"Chainlink":   4,
-	"Razor":       5,
-	"Dia":         6,
-	"API3":        7,
-	"Uma":         8,
-	"Pyth":        9,
-	"BandIBC":     10,
-	"Provider":    11,
+	"Unspecified":  0,
+	"Band":         1,
+	"PriceFeed":    2,
+	"Coinbase":     3,
+	"Chainlink":    4,
+	"Razor":        5,
+	"Dia":          6,
+	"API3":         7,
+	"Uma":          8,
+	"Pyth":         9,
+	"BandIBC":      10,
+	"Provider":     11,
+	"Composite":    12,
+	"ExchangeTwap": 13,
 }
 
 func (x OracleType) String() string {
@@ -80,6 +86,34 @@ func (OracleType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_1c8fbf1e7a765423, []int{0}
 }
 
+// CompositeAggregation defines how the component prices of a composite index are combined
+type CompositeAggregation int32
+
+const (
+	// Median is the weighted median of the component prices
+	CompositeAggregation_Median CompositeAggregation = 0
+	// WeightedMean is the weighted mean of the component prices
+	CompositeAggregation_WeightedMean CompositeAggregation = 1
+)
+
+var CompositeAggregation_name = map[int32]string{
+	0: "Median",
+	1: "WeightedMean",
+}
+
+var CompositeAggregation_value = map[string]int32{
+	"Median":       0,
+	"WeightedMean": 1,
+}
+
+func (x CompositeAggregation) String() string {
+	return proto.EnumName(CompositeAggregation_name, int32(x))
+}
+
+func (CompositeAggregation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{1}
+}
+
 type Params struct {
 	PythContract string `protobuf:"bytes,1,opt,name=pyth_contract,json=pythContract,proto3" json:"pyth_contract,omitempty"`
 }
@@ -754,27 +788,30 @@ func (m *PriceState) GetTimestamp() int64 {
 	return 0
 }
 
-type PythPriceState struct {
-	PriceId     string                                 `protobuf:"bytes,1,opt,name=price_id,json=priceId,proto3" json:"price_id,omitempty"`
-	EmaPrice    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=ema_price,json=emaPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ema_price"`
-	EmaConf     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=ema_conf,json=emaConf,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ema_conf"`
-	Conf        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=conf,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"conf"`
-	PublishTime uint64                                 `protobuf:"varint,5,opt,name=publish_time,json=publishTime,proto3" json:"publish_time,omitempty"`
-	PriceState  PriceState                             `protobuf:"bytes,6,opt,name=price_state,json=priceState,proto3" json:"price_state"`
+// CompositeIndexComponent defines one of the oracle prices a composite index is computed from
+type CompositeIndexComponent struct {
+	OracleType OracleType `protobuf:"varint,1,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty"`
+	// base of the component, or the provider for provider oracle components
+	Base string `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	// quote of the component, or the symbol for provider oracle components
+	Quote  string                                 `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+	// max_staleness is the maximum age in seconds of the component price, older prices are left out of the index
+	MaxStaleness int64 `protobuf:"varint,5,opt,name=max_staleness,json=maxStaleness,proto3" json:"max_staleness,omitempty"`
 }
 
-func (m *PythPriceState) Reset()         { *m = PythPriceState{} }
-func (m *PythPriceState) String() string { return proto.CompactTextString(m) }
-func (*PythPriceState) ProtoMessage()    {}
-func (*PythPriceState) Descriptor() ([]byte, []int) {
+func (m *CompositeIndexComponent) Reset()         { *m = CompositeIndexComponent{} }
+func (m *CompositeIndexComponent) String() string { return proto.CompactTextString(m) }
+func (*CompositeIndexComponent) ProtoMessage()    {}
+func (*CompositeIndexComponent) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1c8fbf1e7a765423, []int{12}
 }
-func (m *PythPriceState) XXX_Unmarshal(b []byte) error {
+func (m *CompositeIndexComponent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PythPriceState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CompositeIndexComponent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PythPriceState.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CompositeIndexComponent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -784,75 +821,70 @@ func (m *PythPriceState) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *PythPriceState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PythPriceState.Merge(m, src)
+func (m *CompositeIndexComponent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompositeIndexComponent.Merge(m, src)
 }
-func (m *PythPriceState) XXX_Size() int {
+func (m *CompositeIndexComponent) XXX_Size() int {
 	return m.Size()
 }
-func (m *PythPriceState) XXX_DiscardUnknown() {
-	xxx_messageInfo_PythPriceState.DiscardUnknown(m)
+func (m *CompositeIndexComponent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompositeIndexComponent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PythPriceState proto.InternalMessageInfo
+var xxx_messageInfo_CompositeIndexComponent proto.InternalMessageInfo
 
-func (m *PythPriceState) GetPriceId() string {
+func (m *CompositeIndexComponent) GetOracleType() OracleType {
 	if m != nil {
-		return m.PriceId
+		return m.OracleType
+	}
+	return OracleType_Unspecified
+}
+
+func (m *CompositeIndexComponent) GetBase() string {
+	if m != nil {
+		return m.Base
 	}
 	return ""
 }
 
-func (m *PythPriceState) GetPublishTime() uint64 {
+func (m *CompositeIndexComponent) GetQuote() string {
 	if m != nil {
-		return m.PublishTime
+		return m.Quote
 	}
-	return 0
+	return ""
 }
 
-func (m *PythPriceState) GetPriceState() PriceState {
+func (m *CompositeIndexComponent) GetMaxStaleness() int64 {
 	if m != nil {
-		return m.PriceState
+		return m.MaxStaleness
 	}
-	return PriceState{}
+	return 0
 }
 
-type BandOracleRequest struct {
-	// Unique Identifier for band ibc oracle request
-	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// OracleScriptID is the unique identifier of the oracle script to be executed.
-	OracleScriptId int64 `protobuf:"varint,2,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
-	// Symbols is the list of symbols to prepare in the calldata
-	Symbols []string `protobuf:"bytes,3,rep,name=symbols,proto3" json:"symbols,omitempty"`
-	// AskCount is the number of validators that are requested to respond to this
-	// oracle request. Higher value means more security, at a higher gas cost.
-	AskCount uint64 `protobuf:"varint,4,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
-	// MinCount is the minimum number of validators necessary for the request to
-	// proceed to the execution phase. Higher value means more security, at the
-	// cost of liveness.
-	MinCount uint64 `protobuf:"varint,5,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
-	// FeeLimit is the maximum tokens that will be paid to all data source providers.
-	FeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fee_limit,json=feeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_limit"`
-	// PrepareGas is amount of gas to pay to prepare raw requests
-	PrepareGas uint64 `protobuf:"varint,7,opt,name=prepare_gas,json=prepareGas,proto3" json:"prepare_gas,omitempty"`
-	// ExecuteGas is amount of gas to reserve for executing
-	ExecuteGas uint64 `protobuf:"varint,8,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
-	// MinSourceCount is the minimum number of data sources that must be used by each validator
-	MinSourceCount uint64 `protobuf:"varint,9,opt,name=min_source_count,json=minSourceCount,proto3" json:"min_source_count,omitempty"`
+// CompositeIndex defines a price computed from the prices of several oracle pairs, possibly of different oracle types.
+// Markets reference it with the Composite oracle type, the index symbol as oracle base and its quote as oracle quote.
+type CompositeIndex struct {
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// quote the prices of all the components are denominated in
+	Quote       string                    `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	Aggregation CompositeAggregation      `protobuf:"varint,3,opt,name=aggregation,proto3,enum=injective.oracle.v1beta1.CompositeAggregation" json:"aggregation,omitempty"`
+	Components  []CompositeIndexComponent `protobuf:"bytes,4,rep,name=components,proto3" json:"components"`
+	// min_components is the minimum number of components with a fresh price needed to compute the index
+	MinComponents uint32 `protobuf:"varint,5,opt,name=min_components,json=minComponents,proto3" json:"min_components,omitempty"`
 }
 
-func (m *BandOracleRequest) Reset()         { *m = BandOracleRequest{} }
-func (m *BandOracleRequest) String() string { return proto.CompactTextString(m) }
-func (*BandOracleRequest) ProtoMessage()    {}
-func (*BandOracleRequest) Descriptor() ([]byte, []int) {
+func (m *CompositeIndex) Reset()         { *m = CompositeIndex{} }
+func (m *CompositeIndex) String() string { return proto.CompactTextString(m) }
+func (*CompositeIndex) ProtoMessage()    {}
+func (*CompositeIndex) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1c8fbf1e7a765423, []int{13}
 }
-func (m *BandOracleRequest) XXX_Unmarshal(b []byte) error {
+func (m *CompositeIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BandOracleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CompositeIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BandOracleRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CompositeIndex.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -862,108 +894,126 @@ func (m *BandOracleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *BandOracleRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BandOracleRequest.Merge(m, src)
+func (m *CompositeIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompositeIndex.Merge(m, src)
 }
-func (m *BandOracleRequest) XXX_Size() int {
+func (m *CompositeIndex) XXX_Size() int {
 	return m.Size()
 }
-func (m *BandOracleRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_BandOracleRequest.DiscardUnknown(m)
+func (m *CompositeIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompositeIndex.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BandOracleRequest proto.InternalMessageInfo
+var xxx_messageInfo_CompositeIndex proto.InternalMessageInfo
 
-func (m *BandOracleRequest) GetRequestId() uint64 {
+func (m *CompositeIndex) GetSymbol() string {
 	if m != nil {
-		return m.RequestId
+		return m.Symbol
 	}
-	return 0
+	return ""
 }
 
-func (m *BandOracleRequest) GetOracleScriptId() int64 {
+func (m *CompositeIndex) GetQuote() string {
 	if m != nil {
-		return m.OracleScriptId
+		return m.Quote
 	}
-	return 0
+	return ""
 }
 
-func (m *BandOracleRequest) GetSymbols() []string {
+func (m *CompositeIndex) GetAggregation() CompositeAggregation {
 	if m != nil {
-		return m.Symbols
+		return m.Aggregation
 	}
-	return nil
+	return CompositeAggregation_Median
 }
 
-func (m *BandOracleRequest) GetAskCount() uint64 {
+func (m *CompositeIndex) GetComponents() []CompositeIndexComponent {
 	if m != nil {
-		return m.AskCount
+		return m.Components
 	}
-	return 0
+	return nil
 }
 
-func (m *BandOracleRequest) GetMinCount() uint64 {
+func (m *CompositeIndex) GetMinComponents() uint32 {
 	if m != nil {
-		return m.MinCount
+		return m.MinComponents
 	}
 	return 0
 }
 
-func (m *BandOracleRequest) GetFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
-	if m != nil {
-		return m.FeeLimit
-	}
-	return nil
+// CompositeIndexPriceState records the index price every block to accumulate its cumulative price
+type CompositeIndexPriceState struct {
+	Symbol     string     `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	PriceState PriceState `protobuf:"bytes,2,opt,name=price_state,json=priceState,proto3" json:"price_state"`
 }
 
-func (m *BandOracleRequest) GetPrepareGas() uint64 {
-	if m != nil {
-		return m.PrepareGas
+func (m *CompositeIndexPriceState) Reset()         { *m = CompositeIndexPriceState{} }
+func (m *CompositeIndexPriceState) String() string { return proto.CompactTextString(m) }
+func (*CompositeIndexPriceState) ProtoMessage()    {}
+func (*CompositeIndexPriceState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{14}
+}
+func (m *CompositeIndexPriceState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompositeIndexPriceState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompositeIndexPriceState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *CompositeIndexPriceState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompositeIndexPriceState.Merge(m, src)
+}
+func (m *CompositeIndexPriceState) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompositeIndexPriceState) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompositeIndexPriceState.DiscardUnknown(m)
 }
 
-func (m *BandOracleRequest) GetExecuteGas() uint64 {
+var xxx_messageInfo_CompositeIndexPriceState proto.InternalMessageInfo
+
+func (m *CompositeIndexPriceState) GetSymbol() string {
 	if m != nil {
-		return m.ExecuteGas
+		return m.Symbol
 	}
-	return 0
+	return ""
 }
 
-func (m *BandOracleRequest) GetMinSourceCount() uint64 {
+func (m *CompositeIndexPriceState) GetPriceState() PriceState {
 	if m != nil {
-		return m.MinSourceCount
+		return m.PriceState
 	}
-	return 0
+	return PriceState{}
 }
 
-type BandIBCParams struct {
-	// true if Band IBC should be enabled
-	BandIbcEnabled bool `protobuf:"varint,1,opt,name=band_ibc_enabled,json=bandIbcEnabled,proto3" json:"band_ibc_enabled,omitempty"`
-	// block request interval to send Band IBC prices
-	IbcRequestInterval int64 `protobuf:"varint,2,opt,name=ibc_request_interval,json=ibcRequestInterval,proto3" json:"ibc_request_interval,omitempty"`
-	// band IBC source channel
-	IbcSourceChannel string `protobuf:"bytes,3,opt,name=ibc_source_channel,json=ibcSourceChannel,proto3" json:"ibc_source_channel,omitempty"`
-	// band IBC version
-	IbcVersion string `protobuf:"bytes,4,opt,name=ibc_version,json=ibcVersion,proto3" json:"ibc_version,omitempty"`
-	// band IBC portID
-	IbcPortId string `protobuf:"bytes,5,opt,name=ibc_port_id,json=ibcPortId,proto3" json:"ibc_port_id,omitempty"`
-	//  legacy oracle scheme ids
-	LegacyOracleIds []int64 `protobuf:"varint,6,rep,packed,name=legacy_oracle_ids,json=legacyOracleIds,proto3" json:"legacy_oracle_ids,omitempty"`
+// CompositeIndexComponentPrice is the current price of a composite index component
+type CompositeIndexComponentPrice struct {
+	Price     *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price,omitempty"`
+	Timestamp int64                                   `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// included is false if the price of the component is missing or stale
+	Included bool `protobuf:"varint,3,opt,name=included,proto3" json:"included,omitempty"`
 }
 
-func (m *BandIBCParams) Reset()         { *m = BandIBCParams{} }
-func (m *BandIBCParams) String() string { return proto.CompactTextString(m) }
-func (*BandIBCParams) ProtoMessage()    {}
-func (*BandIBCParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{14}
+func (m *CompositeIndexComponentPrice) Reset()         { *m = CompositeIndexComponentPrice{} }
+func (m *CompositeIndexComponentPrice) String() string { return proto.CompactTextString(m) }
+func (*CompositeIndexComponentPrice) ProtoMessage()    {}
+func (*CompositeIndexComponentPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{15}
 }
-func (m *BandIBCParams) XXX_Unmarshal(b []byte) error {
+func (m *CompositeIndexComponentPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BandIBCParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CompositeIndexComponentPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BandIBCParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CompositeIndexComponentPrice.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -973,78 +1023,117 @@ func (m *BandIBCParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *BandIBCParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BandIBCParams.Merge(m, src)
+func (m *CompositeIndexComponentPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompositeIndexComponentPrice.Merge(m, src)
 }
-func (m *BandIBCParams) XXX_Size() int {
+func (m *CompositeIndexComponentPrice) XXX_Size() int {
 	return m.Size()
 }
-func (m *BandIBCParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_BandIBCParams.DiscardUnknown(m)
+func (m *CompositeIndexComponentPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompositeIndexComponentPrice.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BandIBCParams proto.InternalMessageInfo
+var xxx_messageInfo_CompositeIndexComponentPrice proto.InternalMessageInfo
 
-func (m *BandIBCParams) GetBandIbcEnabled() bool {
+func (m *CompositeIndexComponentPrice) GetTimestamp() int64 {
 	if m != nil {
-		return m.BandIbcEnabled
+		return m.Timestamp
 	}
-	return false
+	return 0
 }
 
-func (m *BandIBCParams) GetIbcRequestInterval() int64 {
+func (m *CompositeIndexComponentPrice) GetIncluded() bool {
 	if m != nil {
-		return m.IbcRequestInterval
+		return m.Included
 	}
-	return 0
+	return false
 }
 
-func (m *BandIBCParams) GetIbcSourceChannel() string {
-	if m != nil {
-		return m.IbcSourceChannel
+// ExchangeTwapConfig defines how the ExchangeTwap oracle prices a base/quote pair from the trades of the spot market
+// with that base and quote denom. Markets reference it with the ExchangeTwap oracle type, the base denom as oracle base
+// and the quote denom as oracle quote.
+type ExchangeTwapConfig struct {
+	BaseDenom  string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// twap_window is the period in seconds the spot trade prices are time-weighted over
+	TwapWindow int64 `protobuf:"varint,3,opt,name=twap_window,json=twapWindow,proto3" json:"twap_window,omitempty"`
+	// min_volume is the minimum quantity in base denom units traded in the window for the TWAP to be valid
+	MinVolume github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_volume,json=minVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_volume"`
+}
+
+func (m *ExchangeTwapConfig) Reset()         { *m = ExchangeTwapConfig{} }
+func (m *ExchangeTwapConfig) String() string { return proto.CompactTextString(m) }
+func (*ExchangeTwapConfig) ProtoMessage()    {}
+func (*ExchangeTwapConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{16}
+}
+func (m *ExchangeTwapConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeTwapConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeTwapConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *ExchangeTwapConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeTwapConfig.Merge(m, src)
+}
+func (m *ExchangeTwapConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeTwapConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeTwapConfig.DiscardUnknown(m)
 }
 
-func (m *BandIBCParams) GetIbcVersion() string {
+var xxx_messageInfo_ExchangeTwapConfig proto.InternalMessageInfo
+
+func (m *ExchangeTwapConfig) GetBaseDenom() string {
 	if m != nil {
-		return m.IbcVersion
+		return m.BaseDenom
 	}
 	return ""
 }
 
-func (m *BandIBCParams) GetIbcPortId() string {
+func (m *ExchangeTwapConfig) GetQuoteDenom() string {
 	if m != nil {
-		return m.IbcPortId
+		return m.QuoteDenom
 	}
 	return ""
 }
 
-func (m *BandIBCParams) GetLegacyOracleIds() []int64 {
+func (m *ExchangeTwapConfig) GetTwapWindow() int64 {
 	if m != nil {
-		return m.LegacyOracleIds
+		return m.TwapWindow
 	}
-	return nil
+	return 0
 }
 
-type SymbolPriceTimestamp struct {
-	Oracle    OracleType `protobuf:"varint,1,opt,name=oracle,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle,omitempty"`
-	SymbolId  string     `protobuf:"bytes,2,opt,name=symbol_id,json=symbolId,proto3" json:"symbol_id,omitempty"`
-	Timestamp int64      `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+// ExchangeTwapPriceState records the TWAP of an exchange TWAP pair every block to accumulate its cumulative price
+type ExchangeTwapPriceState struct {
+	BaseDenom  string     `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	QuoteDenom string     `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	PriceState PriceState `protobuf:"bytes,3,opt,name=price_state,json=priceState,proto3" json:"price_state"`
 }
 
-func (m *SymbolPriceTimestamp) Reset()         { *m = SymbolPriceTimestamp{} }
-func (m *SymbolPriceTimestamp) String() string { return proto.CompactTextString(m) }
-func (*SymbolPriceTimestamp) ProtoMessage()    {}
-func (*SymbolPriceTimestamp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{15}
+func (m *ExchangeTwapPriceState) Reset()         { *m = ExchangeTwapPriceState{} }
+func (m *ExchangeTwapPriceState) String() string { return proto.CompactTextString(m) }
+func (*ExchangeTwapPriceState) ProtoMessage()    {}
+func (*ExchangeTwapPriceState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{17}
 }
-func (m *SymbolPriceTimestamp) XXX_Unmarshal(b []byte) error {
+func (m *ExchangeTwapPriceState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SymbolPriceTimestamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExchangeTwapPriceState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SymbolPriceTimestamp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExchangeTwapPriceState.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1054,55 +1143,68 @@ func (m *SymbolPriceTimestamp) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *SymbolPriceTimestamp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SymbolPriceTimestamp.Merge(m, src)
+func (m *ExchangeTwapPriceState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeTwapPriceState.Merge(m, src)
 }
-func (m *SymbolPriceTimestamp) XXX_Size() int {
+func (m *ExchangeTwapPriceState) XXX_Size() int {
 	return m.Size()
 }
-func (m *SymbolPriceTimestamp) XXX_DiscardUnknown() {
-	xxx_messageInfo_SymbolPriceTimestamp.DiscardUnknown(m)
+func (m *ExchangeTwapPriceState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeTwapPriceState.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SymbolPriceTimestamp proto.InternalMessageInfo
+var xxx_messageInfo_ExchangeTwapPriceState proto.InternalMessageInfo
 
-func (m *SymbolPriceTimestamp) GetOracle() OracleType {
+func (m *ExchangeTwapPriceState) GetBaseDenom() string {
 	if m != nil {
-		return m.Oracle
+		return m.BaseDenom
 	}
-	return OracleType_Unspecified
+	return ""
 }
 
-func (m *SymbolPriceTimestamp) GetSymbolId() string {
+func (m *ExchangeTwapPriceState) GetQuoteDenom() string {
 	if m != nil {
-		return m.SymbolId
+		return m.QuoteDenom
 	}
 	return ""
 }
 
-func (m *SymbolPriceTimestamp) GetTimestamp() int64 {
+func (m *ExchangeTwapPriceState) GetPriceState() PriceState {
 	if m != nil {
-		return m.Timestamp
+		return m.PriceState
 	}
-	return 0
-}
-
-type LastPriceTimestamps struct {
-	LastPriceTimestamps []*SymbolPriceTimestamp `protobuf:"bytes,1,rep,name=last_price_timestamps,json=lastPriceTimestamps,proto3" json:"last_price_timestamps,omitempty"`
+	return PriceState{}
 }
 
-func (m *LastPriceTimestamps) Reset()         { *m = LastPriceTimestamps{} }
-func (m *LastPriceTimestamps) String() string { return proto.CompactTextString(m) }
-func (*LastPriceTimestamps) ProtoMessage()    {}
-func (*LastPriceTimestamps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{16}
+// ExchangeTwapSnapshot records the running sums of a spot market trade record of an exchange TWAP pair, so that the TWAP
+// of a window is the difference of the sums at its ends
+type ExchangeTwapSnapshot struct {
+	BaseDenom  string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// timestamp is the timestamp of the trade record
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// price is the price of the trade record, holding until the next snapshot
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// quantity is the quantity of the trade record
+	Quantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity"`
+	// cumulative_price is the sum of the prices of the previous snapshots weighted by the seconds they held until timestamp
+	CumulativePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=cumulative_price,json=cumulativePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_price"`
+	// cumulative_volume is the sum of the quantities of the snapshots until timestamp, included
+	CumulativeVolume github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=cumulative_volume,json=cumulativeVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_volume"`
+}
+
+func (m *ExchangeTwapSnapshot) Reset()         { *m = ExchangeTwapSnapshot{} }
+func (m *ExchangeTwapSnapshot) String() string { return proto.CompactTextString(m) }
+func (*ExchangeTwapSnapshot) ProtoMessage()    {}
+func (*ExchangeTwapSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{18}
 }
-func (m *LastPriceTimestamps) XXX_Unmarshal(b []byte) error {
+func (m *ExchangeTwapSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LastPriceTimestamps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExchangeTwapSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LastPriceTimestamps.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExchangeTwapSnapshot.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1112,43 +1214,60 @@ func (m *LastPriceTimestamps) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *LastPriceTimestamps) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LastPriceTimestamps.Merge(m, src)
+func (m *ExchangeTwapSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeTwapSnapshot.Merge(m, src)
 }
-func (m *LastPriceTimestamps) XXX_Size() int {
+func (m *ExchangeTwapSnapshot) XXX_Size() int {
 	return m.Size()
 }
-func (m *LastPriceTimestamps) XXX_DiscardUnknown() {
-	xxx_messageInfo_LastPriceTimestamps.DiscardUnknown(m)
+func (m *ExchangeTwapSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeTwapSnapshot.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LastPriceTimestamps proto.InternalMessageInfo
+var xxx_messageInfo_ExchangeTwapSnapshot proto.InternalMessageInfo
 
-func (m *LastPriceTimestamps) GetLastPriceTimestamps() []*SymbolPriceTimestamp {
+func (m *ExchangeTwapSnapshot) GetBaseDenom() string {
 	if m != nil {
-		return m.LastPriceTimestamps
+		return m.BaseDenom
 	}
-	return nil
+	return ""
 }
 
-type PriceRecords struct {
-	Oracle             OracleType     `protobuf:"varint,1,opt,name=oracle,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle,omitempty"`
-	SymbolId           string         `protobuf:"bytes,2,opt,name=symbol_id,json=symbolId,proto3" json:"symbol_id,omitempty"`
-	LatestPriceRecords []*PriceRecord `protobuf:"bytes,3,rep,name=latest_price_records,json=latestPriceRecords,proto3" json:"latest_price_records,omitempty"`
+func (m *ExchangeTwapSnapshot) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
 }
 
-func (m *PriceRecords) Reset()         { *m = PriceRecords{} }
-func (m *PriceRecords) String() string { return proto.CompactTextString(m) }
-func (*PriceRecords) ProtoMessage()    {}
-func (*PriceRecords) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{17}
+func (m *ExchangeTwapSnapshot) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
 }
-func (m *PriceRecords) XXX_Unmarshal(b []byte) error {
+
+type PythPriceState struct {
+	PriceId     string                                 `protobuf:"bytes,1,opt,name=price_id,json=priceId,proto3" json:"price_id,omitempty"`
+	EmaPrice    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=ema_price,json=emaPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ema_price"`
+	EmaConf     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=ema_conf,json=emaConf,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ema_conf"`
+	Conf        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=conf,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"conf"`
+	PublishTime uint64                                 `protobuf:"varint,5,opt,name=publish_time,json=publishTime,proto3" json:"publish_time,omitempty"`
+	PriceState  PriceState                             `protobuf:"bytes,6,opt,name=price_state,json=priceState,proto3" json:"price_state"`
+}
+
+func (m *PythPriceState) Reset()         { *m = PythPriceState{} }
+func (m *PythPriceState) String() string { return proto.CompactTextString(m) }
+func (*PythPriceState) ProtoMessage()    {}
+func (*PythPriceState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{19}
+}
+func (m *PythPriceState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PriceRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PythPriceState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PriceRecords.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PythPriceState.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1158,56 +1277,75 @@ func (m *PriceRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *PriceRecords) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PriceRecords.Merge(m, src)
+func (m *PythPriceState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PythPriceState.Merge(m, src)
 }
-func (m *PriceRecords) XXX_Size() int {
+func (m *PythPriceState) XXX_Size() int {
 	return m.Size()
 }
-func (m *PriceRecords) XXX_DiscardUnknown() {
-	xxx_messageInfo_PriceRecords.DiscardUnknown(m)
+func (m *PythPriceState) XXX_DiscardUnknown() {
+	xxx_messageInfo_PythPriceState.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PriceRecords proto.InternalMessageInfo
+var xxx_messageInfo_PythPriceState proto.InternalMessageInfo
 
-func (m *PriceRecords) GetOracle() OracleType {
+func (m *PythPriceState) GetPriceId() string {
 	if m != nil {
-		return m.Oracle
+		return m.PriceId
 	}
-	return OracleType_Unspecified
+	return ""
 }
 
-func (m *PriceRecords) GetSymbolId() string {
+func (m *PythPriceState) GetPublishTime() uint64 {
 	if m != nil {
-		return m.SymbolId
+		return m.PublishTime
 	}
-	return ""
+	return 0
 }
 
-func (m *PriceRecords) GetLatestPriceRecords() []*PriceRecord {
+func (m *PythPriceState) GetPriceState() PriceState {
 	if m != nil {
-		return m.LatestPriceRecords
+		return m.PriceState
 	}
-	return nil
+	return PriceState{}
 }
 
-type PriceRecord struct {
-	Timestamp int64                                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Price     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+type BandOracleRequest struct {
+	// Unique Identifier for band ibc oracle request
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// OracleScriptID is the unique identifier of the oracle script to be executed.
+	OracleScriptId int64 `protobuf:"varint,2,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	// Symbols is the list of symbols to prepare in the calldata
+	Symbols []string `protobuf:"bytes,3,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	// AskCount is the number of validators that are requested to respond to this
+	// oracle request. Higher value means more security, at a higher gas cost.
+	AskCount uint64 `protobuf:"varint,4,opt,name=ask_count,json=askCount,proto3" json:"ask_count,omitempty"`
+	// MinCount is the minimum number of validators necessary for the request to
+	// proceed to the execution phase. Higher value means more security, at the
+	// cost of liveness.
+	MinCount uint64 `protobuf:"varint,5,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// FeeLimit is the maximum tokens that will be paid to all data source providers.
+	FeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fee_limit,json=feeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_limit"`
+	// PrepareGas is amount of gas to pay to prepare raw requests
+	PrepareGas uint64 `protobuf:"varint,7,opt,name=prepare_gas,json=prepareGas,proto3" json:"prepare_gas,omitempty"`
+	// ExecuteGas is amount of gas to reserve for executing
+	ExecuteGas uint64 `protobuf:"varint,8,opt,name=execute_gas,json=executeGas,proto3" json:"execute_gas,omitempty"`
+	// MinSourceCount is the minimum number of data sources that must be used by each validator
+	MinSourceCount uint64 `protobuf:"varint,9,opt,name=min_source_count,json=minSourceCount,proto3" json:"min_source_count,omitempty"`
 }
 
-func (m *PriceRecord) Reset()         { *m = PriceRecord{} }
-func (m *PriceRecord) String() string { return proto.CompactTextString(m) }
-func (*PriceRecord) ProtoMessage()    {}
-func (*PriceRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{18}
+func (m *BandOracleRequest) Reset()         { *m = BandOracleRequest{} }
+func (m *BandOracleRequest) String() string { return proto.CompactTextString(m) }
+func (*BandOracleRequest) ProtoMessage()    {}
+func (*BandOracleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{20}
 }
-func (m *PriceRecord) XXX_Unmarshal(b []byte) error {
+func (m *BandOracleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PriceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BandOracleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PriceRecord.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BandOracleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1217,134 +1355,108 @@ func (m *PriceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *PriceRecord) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PriceRecord.Merge(m, src)
+func (m *BandOracleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BandOracleRequest.Merge(m, src)
 }
-func (m *PriceRecord) XXX_Size() int {
+func (m *BandOracleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *PriceRecord) XXX_DiscardUnknown() {
-	xxx_messageInfo_PriceRecord.DiscardUnknown(m)
+func (m *BandOracleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BandOracleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PriceRecord proto.InternalMessageInfo
+var xxx_messageInfo_BandOracleRequest proto.InternalMessageInfo
 
-func (m *PriceRecord) GetTimestamp() int64 {
+func (m *BandOracleRequest) GetRequestId() uint64 {
 	if m != nil {
-		return m.Timestamp
+		return m.RequestId
 	}
 	return 0
 }
 
-// MetadataStatistics refers to the metadata summary statistics of the historical sample considered
-type MetadataStatistics struct {
-	// GroupCount refers to the number of groups used. Equals RecordsSampleSize if no grouping is used
-	GroupCount uint32 `protobuf:"varint,1,opt,name=group_count,json=groupCount,proto3" json:"group_count,omitempty"`
-	// RecordsSampleSize refers to the total number of records used.
-	RecordsSampleSize uint32 `protobuf:"varint,2,opt,name=records_sample_size,json=recordsSampleSize,proto3" json:"records_sample_size,omitempty"`
-	// Mean refers to the arithmetic mean
-	// For trades, the mean is the VWAP computed over the grouped trade records ∑ (price * quantity) / ∑ quantity
-	// For oracle prices, the mean is computed over the price records ∑ (price) / prices_count
-	Mean github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=mean,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mean"`
-	// TWAP refers to the time-weighted average price which equals ∑ (price_i * ∆t_i) / ∑ ∆t_i where ∆t_i = t_i - t_{i-1}
-	Twap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=twap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"twap"`
-	// FirstTimestamp is the timestamp of the oldest record considered
-	FirstTimestamp int64 `protobuf:"varint,5,opt,name=first_timestamp,json=firstTimestamp,proto3" json:"first_timestamp,omitempty"`
-	// LastTimestamp is the timestamp of the youngest record considered
-	LastTimestamp int64 `protobuf:"varint,6,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
-	// MinPrice refers to the smallest individual raw price considered
-	MinPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=min_price,json=minPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_price"`
-	// MaxPrice refers to the largest individual raw price considered
-	MaxPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=max_price,json=maxPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price"`
-	// MedianPrice refers to the median individual raw price considered
-	MedianPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=median_price,json=medianPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"median_price"`
+func (m *BandOracleRequest) GetOracleScriptId() int64 {
+	if m != nil {
+		return m.OracleScriptId
+	}
+	return 0
 }
 
-func (m *MetadataStatistics) Reset()         { *m = MetadataStatistics{} }
-func (m *MetadataStatistics) String() string { return proto.CompactTextString(m) }
-func (*MetadataStatistics) ProtoMessage()    {}
-func (*MetadataStatistics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{19}
-}
-func (m *MetadataStatistics) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *MetadataStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_MetadataStatistics.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *BandOracleRequest) GetSymbols() []string {
+	if m != nil {
+		return m.Symbols
 	}
-}
-func (m *MetadataStatistics) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MetadataStatistics.Merge(m, src)
-}
-func (m *MetadataStatistics) XXX_Size() int {
-	return m.Size()
-}
-func (m *MetadataStatistics) XXX_DiscardUnknown() {
-	xxx_messageInfo_MetadataStatistics.DiscardUnknown(m)
+	return nil
 }
 
-var xxx_messageInfo_MetadataStatistics proto.InternalMessageInfo
+func (m *BandOracleRequest) GetAskCount() uint64 {
+	if m != nil {
+		return m.AskCount
+	}
+	return 0
+}
 
-func (m *MetadataStatistics) GetGroupCount() uint32 {
+func (m *BandOracleRequest) GetMinCount() uint64 {
 	if m != nil {
-		return m.GroupCount
+		return m.MinCount
 	}
 	return 0
 }
 
-func (m *MetadataStatistics) GetRecordsSampleSize() uint32 {
+func (m *BandOracleRequest) GetFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.RecordsSampleSize
+		return m.FeeLimit
+	}
+	return nil
+}
+
+func (m *BandOracleRequest) GetPrepareGas() uint64 {
+	if m != nil {
+		return m.PrepareGas
 	}
 	return 0
 }
 
-func (m *MetadataStatistics) GetFirstTimestamp() int64 {
+func (m *BandOracleRequest) GetExecuteGas() uint64 {
 	if m != nil {
-		return m.FirstTimestamp
+		return m.ExecuteGas
 	}
 	return 0
 }
 
-func (m *MetadataStatistics) GetLastTimestamp() int64 {
+func (m *BandOracleRequest) GetMinSourceCount() uint64 {
 	if m != nil {
-		return m.LastTimestamp
+		return m.MinSourceCount
 	}
 	return 0
 }
 
-type PriceAttestation struct {
-	PriceId string `protobuf:"bytes,1,opt,name=price_id,json=priceId,proto3" json:"price_id,omitempty"`
-	// MaxPrice refers to the largest individual raw price considered
-	Price       int64  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
-	Conf        uint64 `protobuf:"varint,3,opt,name=conf,proto3" json:"conf,omitempty"`
-	Expo        int32  `protobuf:"varint,4,opt,name=expo,proto3" json:"expo,omitempty"`
-	EmaPrice    int64  `protobuf:"varint,5,opt,name=ema_price,json=emaPrice,proto3" json:"ema_price,omitempty"`
-	EmaConf     uint64 `protobuf:"varint,6,opt,name=ema_conf,json=emaConf,proto3" json:"ema_conf,omitempty"`
-	EmaExpo     int32  `protobuf:"varint,7,opt,name=ema_expo,json=emaExpo,proto3" json:"ema_expo,omitempty"`
-	PublishTime int64  `protobuf:"varint,8,opt,name=publish_time,json=publishTime,proto3" json:"publish_time,omitempty"`
+type BandIBCParams struct {
+	// true if Band IBC should be enabled
+	BandIbcEnabled bool `protobuf:"varint,1,opt,name=band_ibc_enabled,json=bandIbcEnabled,proto3" json:"band_ibc_enabled,omitempty"`
+	// block request interval to send Band IBC prices
+	IbcRequestInterval int64 `protobuf:"varint,2,opt,name=ibc_request_interval,json=ibcRequestInterval,proto3" json:"ibc_request_interval,omitempty"`
+	// band IBC source channel
+	IbcSourceChannel string `protobuf:"bytes,3,opt,name=ibc_source_channel,json=ibcSourceChannel,proto3" json:"ibc_source_channel,omitempty"`
+	// band IBC version
+	IbcVersion string `protobuf:"bytes,4,opt,name=ibc_version,json=ibcVersion,proto3" json:"ibc_version,omitempty"`
+	// band IBC portID
+	IbcPortId string `protobuf:"bytes,5,opt,name=ibc_port_id,json=ibcPortId,proto3" json:"ibc_port_id,omitempty"`
+	//  legacy oracle scheme ids
+	LegacyOracleIds []int64 `protobuf:"varint,6,rep,packed,name=legacy_oracle_ids,json=legacyOracleIds,proto3" json:"legacy_oracle_ids,omitempty"`
 }
 
-func (m *PriceAttestation) Reset()         { *m = PriceAttestation{} }
-func (m *PriceAttestation) String() string { return proto.CompactTextString(m) }
-func (*PriceAttestation) ProtoMessage()    {}
-func (*PriceAttestation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{20}
+func (m *BandIBCParams) Reset()         { *m = BandIBCParams{} }
+func (m *BandIBCParams) String() string { return proto.CompactTextString(m) }
+func (*BandIBCParams) ProtoMessage()    {}
+func (*BandIBCParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{21}
 }
-func (m *PriceAttestation) XXX_Unmarshal(b []byte) error {
+func (m *BandIBCParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PriceAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BandIBCParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PriceAttestation.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BandIBCParams.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1354,517 +1466,648 @@ func (m *PriceAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *PriceAttestation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PriceAttestation.Merge(m, src)
+func (m *BandIBCParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BandIBCParams.Merge(m, src)
 }
-func (m *PriceAttestation) XXX_Size() int {
+func (m *BandIBCParams) XXX_Size() int {
 	return m.Size()
 }
-func (m *PriceAttestation) XXX_DiscardUnknown() {
-	xxx_messageInfo_PriceAttestation.DiscardUnknown(m)
+func (m *BandIBCParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_BandIBCParams.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PriceAttestation proto.InternalMessageInfo
+var xxx_messageInfo_BandIBCParams proto.InternalMessageInfo
 
-func (m *PriceAttestation) GetPriceId() string {
+func (m *BandIBCParams) GetBandIbcEnabled() bool {
 	if m != nil {
-		return m.PriceId
+		return m.BandIbcEnabled
 	}
-	return ""
+	return false
 }
 
-func (m *PriceAttestation) GetPrice() int64 {
+func (m *BandIBCParams) GetIbcRequestInterval() int64 {
 	if m != nil {
-		return m.Price
+		return m.IbcRequestInterval
 	}
 	return 0
 }
 
-func (m *PriceAttestation) GetConf() uint64 {
+func (m *BandIBCParams) GetIbcSourceChannel() string {
 	if m != nil {
-		return m.Conf
+		return m.IbcSourceChannel
 	}
-	return 0
+	return ""
 }
 
-func (m *PriceAttestation) GetExpo() int32 {
+func (m *BandIBCParams) GetIbcVersion() string {
 	if m != nil {
-		return m.Expo
+		return m.IbcVersion
 	}
-	return 0
+	return ""
 }
 
-func (m *PriceAttestation) GetEmaPrice() int64 {
+func (m *BandIBCParams) GetIbcPortId() string {
 	if m != nil {
-		return m.EmaPrice
+		return m.IbcPortId
 	}
-	return 0
+	return ""
 }
 
-func (m *PriceAttestation) GetEmaConf() uint64 {
+func (m *BandIBCParams) GetLegacyOracleIds() []int64 {
 	if m != nil {
-		return m.EmaConf
+		return m.LegacyOracleIds
 	}
-	return 0
+	return nil
 }
 
-func (m *PriceAttestation) GetEmaExpo() int32 {
-	if m != nil {
-		return m.EmaExpo
-	}
-	return 0
+type SymbolPriceTimestamp struct {
+	Oracle    OracleType `protobuf:"varint,1,opt,name=oracle,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle,omitempty"`
+	SymbolId  string     `protobuf:"bytes,2,opt,name=symbol_id,json=symbolId,proto3" json:"symbol_id,omitempty"`
+	Timestamp int64      `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (m *PriceAttestation) GetPublishTime() int64 {
-	if m != nil {
-		return m.PublishTime
+func (m *SymbolPriceTimestamp) Reset()         { *m = SymbolPriceTimestamp{} }
+func (m *SymbolPriceTimestamp) String() string { return proto.CompactTextString(m) }
+func (*SymbolPriceTimestamp) ProtoMessage()    {}
+func (*SymbolPriceTimestamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{22}
+}
+func (m *SymbolPriceTimestamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbolPriceTimestamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbolPriceTimestamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
 }
-
-func init() {
-	proto.RegisterEnum("injective.oracle.v1beta1.OracleType", OracleType_name, OracleType_value)
-	proto.RegisterType((*Params)(nil), "injective.oracle.v1beta1.Params")
-	proto.RegisterType((*OracleInfo)(nil), "injective.oracle.v1beta1.OracleInfo")
-	proto.RegisterType((*ChainlinkPriceState)(nil), "injective.oracle.v1beta1.ChainlinkPriceState")
-	proto.RegisterType((*BandPriceState)(nil), "injective.oracle.v1beta1.BandPriceState")
-	proto.RegisterType((*PriceFeedState)(nil), "injective.oracle.v1beta1.PriceFeedState")
-	proto.RegisterType((*ProviderInfo)(nil), "injective.oracle.v1beta1.ProviderInfo")
-	proto.RegisterType((*ProviderState)(nil), "injective.oracle.v1beta1.ProviderState")
-	proto.RegisterType((*ProviderPriceState)(nil), "injective.oracle.v1beta1.ProviderPriceState")
-	proto.RegisterType((*PriceFeedInfo)(nil), "injective.oracle.v1beta1.PriceFeedInfo")
-	proto.RegisterType((*PriceFeedPrice)(nil), "injective.oracle.v1beta1.PriceFeedPrice")
-	proto.RegisterType((*CoinbasePriceState)(nil), "injective.oracle.v1beta1.CoinbasePriceState")
-	proto.RegisterType((*PriceState)(nil), "injective.oracle.v1beta1.PriceState")
-	proto.RegisterType((*PythPriceState)(nil), "injective.oracle.v1beta1.PythPriceState")
-	proto.RegisterType((*BandOracleRequest)(nil), "injective.oracle.v1beta1.BandOracleRequest")
-	proto.RegisterType((*BandIBCParams)(nil), "injective.oracle.v1beta1.BandIBCParams")
-	proto.RegisterType((*SymbolPriceTimestamp)(nil), "injective.oracle.v1beta1.SymbolPriceTimestamp")
-	proto.RegisterType((*LastPriceTimestamps)(nil), "injective.oracle.v1beta1.LastPriceTimestamps")
-	proto.RegisterType((*PriceRecords)(nil), "injective.oracle.v1beta1.PriceRecords")
-	proto.RegisterType((*PriceRecord)(nil), "injective.oracle.v1beta1.PriceRecord")
-	proto.RegisterType((*MetadataStatistics)(nil), "injective.oracle.v1beta1.MetadataStatistics")
-	proto.RegisterType((*PriceAttestation)(nil), "injective.oracle.v1beta1.PriceAttestation")
+func (m *SymbolPriceTimestamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbolPriceTimestamp.Merge(m, src)
 }
-
-func init() {
-	proto.RegisterFile("injective/oracle/v1beta1/oracle.proto", fileDescriptor_1c8fbf1e7a765423)
+func (m *SymbolPriceTimestamp) XXX_Size() int {
+	return m.Size()
 }
-
-var fileDescriptor_1c8fbf1e7a765423 = []byte{
-	// 1636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x72, 0x1b, 0x4b,
-	0x15, 0xf6, 0xe8, 0x7f, 0x8e, 0x2c, 0x79, 0xd2, 0xf6, 0x05, 0xc5, 0x80, 0x6c, 0x04, 0xb9, 0xa8,
-	0x6e, 0xdd, 0x2b, 0x25, 0xce, 0x8a, 0x14, 0x9b, 0xd8, 0x8e, 0x29, 0x55, 0x4c, 0x61, 0xc6, 0x4e,
-	0x28, 0xd8, 0x88, 0x9e, 0x99, 0x96, 0xd5, 0x78, 0xfe, 0x32, 0x3d, 0x72, 0xac, 0x3c, 0x40, 0x96,
-	0xc0, 0x0b, 0x50, 0xb0, 0xce, 0x2b, 0x50, 0xc5, 0x82, 0x55, 0xaa, 0xd8, 0x64, 0x49, 0xb1, 0x08,
-	0x90, 0x6c, 0x78, 0x03, 0x16, 0x6c, 0xa8, 0xd3, 0xdd, 0x1a, 0x8d, 0x6d, 0x9c, 0xf8, 0x27, 0x77,
-	0xa5, 0xe9, 0xd3, 0xa7, 0xbf, 0xf9, 0xce, 0x4f, 0x9f, 0x73, 0x46, 0x70, 0x87, 0x87, 0xbf, 0x66,
-	0x6e, 0xca, 0x8f, 0x59, 0x3f, 0x4a, 0xa8, 0xeb, 0xb3, 0xfe, 0xf1, 0x3d, 0x87, 0xa5, 0xf4, 0x9e,
-	0x5e, 0xf6, 0xe2, 0x24, 0x4a, 0x23, 0xd2, 0xca, 0xd4, 0x7a, 0x5a, 0xae, 0xd5, 0x56, 0x57, 0x0e,
-	0xa3, 0xc3, 0x48, 0x2a, 0xf5, 0xf1, 0x49, 0xe9, 0xaf, 0xb6, 0xdd, 0x48, 0x04, 0x91, 0xe8, 0x3b,
-	0x54, 0xcc, 0x11, 0xdd, 0x88, 0x87, 0x6a, 0xbf, 0x73, 0x1f, 0x2a, 0x7b, 0x34, 0xa1, 0x81, 0x20,
-	0xdf, 0x83, 0x46, 0x3c, 0x4d, 0xc7, 0x43, 0x37, 0x0a, 0xd3, 0x84, 0xba, 0x69, 0xcb, 0x58, 0x37,
-	0xba, 0xa6, 0xbd, 0x88, 0xc2, 0x2d, 0x2d, 0x7b, 0x50, 0xfa, 0xf7, 0x1f, 0xd7, 0x8c, 0xce, 0x6f,
-	0x0c, 0x80, 0x9f, 0xca, 0xb7, 0x0f, 0xc2, 0x51, 0x44, 0xbe, 0x01, 0x15, 0x31, 0x0d, 0x9c, 0xc8,
-	0xd7, 0x47, 0xf4, 0x8a, 0x3c, 0x82, 0xba, 0xe2, 0x38, 0x4c, 0xa7, 0x31, 0x6b, 0x15, 0xd6, 0x8d,
-	0x6e, 0x73, 0xe3, 0xfb, 0xbd, 0x8b, 0x2c, 0xe8, 0x29, 0xc8, 0x83, 0x69, 0xcc, 0x6c, 0x88, 0xb2,
-	0x67, 0xf2, 0x5d, 0x58, 0x14, 0x2e, 0xf5, 0xd9, 0x70, 0x44, 0xdd, 0x34, 0x4a, 0x5a, 0xc5, 0x75,
-	0xa3, 0xdb, 0xb0, 0xeb, 0x52, 0xb6, 0x23, 0x45, 0x9d, 0x7f, 0x19, 0xb0, 0xbc, 0x35, 0xa6, 0x3c,
-	0xf4, 0x79, 0x78, 0xb4, 0x97, 0x70, 0x97, 0xed, 0xa7, 0x34, 0x65, 0xe4, 0x9b, 0x50, 0x1d, 0x31,
-	0xe6, 0x0d, 0xb9, 0x37, 0xa3, 0x86, 0xcb, 0x81, 0x47, 0x76, 0xa0, 0x42, 0x43, 0xf1, 0x9c, 0x25,
-	0x92, 0x95, 0xb9, 0xd9, 0x7b, 0xfd, 0x76, 0x6d, 0xe1, 0xef, 0x6f, 0xd7, 0x3e, 0x3f, 0xe4, 0xe9,
-	0x78, 0xe2, 0xf4, 0xdc, 0x28, 0xe8, 0x6b, 0xcf, 0xa9, 0x9f, 0xaf, 0x84, 0x77, 0xd4, 0x47, 0x33,
-	0x44, 0x6f, 0x9b, 0xb9, 0xb6, 0x3e, 0x4d, 0xbe, 0x0d, 0x66, 0xca, 0x03, 0x26, 0x52, 0x1a, 0xc4,
-	0x92, 0x58, 0xc9, 0x9e, 0x0b, 0xc8, 0x63, 0xa8, 0xc7, 0x48, 0x66, 0x28, 0x90, 0x4d, 0xab, 0xb4,
-	0x6e, 0x74, 0xeb, 0x1f, 0x72, 0xc0, 0x9c, 0xf9, 0x66, 0x09, 0x09, 0xd9, 0x10, 0x67, 0x92, 0xce,
-	0x7f, 0x0d, 0x68, 0x6e, 0xd2, 0xd0, 0xcb, 0x99, 0x77, 0x91, 0xe3, 0x37, 0xa1, 0x94, 0xe0, 0x0b,
-	0xaf, 0x6e, 0xdb, 0x20, 0x4c, 0x6d, 0x79, 0x16, 0xbd, 0x9e, 0x30, 0x11, 0xf9, 0xc7, 0x6c, 0x88,
-	0x06, 0x69, 0xe3, 0xea, 0x5a, 0x76, 0xc0, 0x03, 0x46, 0xbe, 0x03, 0x90, 0xb0, 0x67, 0x13, 0x26,
-	0xd2, 0xe1, 0x60, 0x5b, 0x5a, 0x57, 0xb2, 0x4d, 0x2d, 0x19, 0x6c, 0x9f, 0xb5, 0xbe, 0x7c, 0x23,
-	0xeb, 0x7f, 0x6f, 0x40, 0x53, 0x2a, 0xec, 0x30, 0xe6, 0x29, 0xeb, 0x09, 0x94, 0x30, 0xab, 0xb5,
-	0xed, 0xf2, 0x99, 0xac, 0x40, 0xf9, 0xd9, 0x24, 0x9a, 0x99, 0x6e, 0xab, 0x05, 0x26, 0x62, 0x9e,
-	0x49, 0xf1, 0xf2, 0x4c, 0xf2, 0x1c, 0xc8, 0x2a, 0xd4, 0x12, 0xe6, 0xd3, 0x29, 0x4b, 0x44, 0xab,
-	0xb4, 0x5e, 0xec, 0x9a, 0x76, 0xb6, 0xee, 0xec, 0xc0, 0xe2, 0x5e, 0x12, 0x1d, 0x73, 0x8f, 0x25,
-	0xf2, 0x4e, 0xac, 0x42, 0x2d, 0xd6, 0x6b, 0x4d, 0x30, 0x5b, 0x9f, 0xc2, 0x29, 0x9c, 0xc1, 0xf9,
-	0xb3, 0x01, 0x8d, 0x19, 0x90, 0x7a, 0xeb, 0x63, 0x68, 0xcc, 0x4e, 0x0e, 0x79, 0x38, 0x8a, 0x24,
-	0x5c, 0x7d, 0xe3, 0xf3, 0x0f, 0xd1, 0x9f, 0x13, 0xb1, 0x17, 0xe3, 0x3c, 0xad, 0x5f, 0xc1, 0x67,
-	0x19, 0x58, 0xce, 0x25, 0x8a, 0x47, 0x7d, 0xe3, 0xcb, 0x8f, 0x83, 0xe6, 0x7c, 0xb3, 0x1c, 0x9f,
-	0x93, 0x89, 0xce, 0x18, 0xc8, 0x79, 0xd5, 0x0b, 0x33, 0xf5, 0x01, 0x94, 0x55, 0x4c, 0x0a, 0x57,
-	0x88, 0x89, 0x3a, 0xd2, 0xf9, 0x21, 0x7a, 0x4a, 0x67, 0x84, 0x34, 0xee, 0xd2, 0x09, 0xd1, 0x79,
-	0x9a, 0x4b, 0x26, 0xf9, 0x40, 0xb6, 0xa1, 0x2c, 0xfd, 0xa1, 0x0e, 0x5f, 0xb9, 0x1e, 0xa8, 0xc3,
-	0x9d, 0x3f, 0x19, 0x40, 0xb6, 0x22, 0x1e, 0xe2, 0xab, 0x73, 0xd6, 0x13, 0x28, 0x1d, 0xf1, 0x70,
-	0x56, 0x83, 0xe4, 0xf3, 0xe9, 0xca, 0x51, 0x38, 0x5b, 0x39, 0x2c, 0x28, 0x1e, 0xb1, 0xa9, 0xcc,
-	0x54, 0xd3, 0xc6, 0x47, 0x34, 0xe4, 0x98, 0xfa, 0x13, 0xa6, 0xef, 0x99, 0x5a, 0x7c, 0xda, 0x3b,
-	0xf6, 0x57, 0x03, 0x20, 0xc7, 0xfa, 0x93, 0xb8, 0x84, 0xfc, 0x02, 0x2c, 0x77, 0x12, 0x4c, 0x7c,
-	0x8a, 0x74, 0x54, 0xce, 0x5d, 0xb3, 0xe6, 0x2e, 0xcd, 0x71, 0x54, 0xcc, 0xce, 0x15, 0xdf, 0x62,
-	0xce, 0x85, 0x9d, 0xff, 0x14, 0xa0, 0xb9, 0x37, 0x4d, 0xc7, 0x39, 0x8b, 0x6e, 0xe3, 0xa5, 0x44,
-	0x6f, 0x65, 0xfd, 0xa0, 0x2a, 0xd7, 0x03, 0x8f, 0x3c, 0x06, 0x93, 0x05, 0xf4, 0x46, 0xfc, 0x6a,
-	0x2c, 0xa0, 0x8a, 0xd8, 0x00, 0xf0, 0x19, 0x3b, 0xe9, 0x48, 0x85, 0xf0, 0xca, 0x58, 0x55, 0x16,
-	0xd0, 0xad, 0x28, 0x1c, 0x61, 0x29, 0x97, 0x30, 0xa5, 0x6b, 0xc1, 0xc8, 0xb3, 0x58, 0xca, 0xe3,
-	0x89, 0xe3, 0x73, 0x31, 0x56, 0xa5, 0xbc, 0xac, 0x4a, 0xb9, 0x96, 0xc9, 0x52, 0x7e, 0x26, 0x8f,
-	0x2a, 0x37, 0xca, 0xa3, 0x97, 0x45, 0xb8, 0x85, 0x9d, 0x4a, 0xf5, 0x73, 0x5b, 0x35, 0x84, 0x7c,
-	0xb7, 0xd0, 0xee, 0xcf, 0x75, 0x0b, 0x8f, 0x74, 0xc1, 0xd2, 0xc3, 0x82, 0x70, 0x13, 0x1e, 0x4b,
-	0xa5, 0x82, 0x8c, 0x69, 0x53, 0xc9, 0xf7, 0xa5, 0x78, 0xe0, 0x91, 0x16, 0x54, 0x55, 0xf5, 0x10,
-	0xad, 0xa2, 0xac, 0x9e, 0xb3, 0x25, 0xf9, 0x16, 0x98, 0x54, 0x1c, 0x0d, 0xdd, 0x68, 0x12, 0xa6,
-	0xfa, 0x9e, 0xd4, 0xa8, 0x38, 0xda, 0xc2, 0x35, 0x6e, 0x06, 0x3c, 0xd4, 0x9b, 0xca, 0x05, 0xb5,
-	0x80, 0x87, 0x6a, 0x73, 0x0c, 0xe6, 0x88, 0xb1, 0xa1, 0xcf, 0x03, 0x9e, 0xb6, 0x2a, 0xb2, 0x16,
-	0xde, 0xee, 0x29, 0x97, 0xf6, 0xf0, 0x32, 0x67, 0x86, 0xe3, 0xed, 0xde, 0xbc, 0x8b, 0x26, 0xbf,
-	0xfa, 0xc7, 0x5a, 0xf7, 0x12, 0x61, 0xc0, 0x03, 0xc2, 0xae, 0x8d, 0x18, 0xdb, 0x45, 0x70, 0xb2,
-	0x86, 0x9e, 0x66, 0x31, 0x4d, 0xd8, 0xf0, 0x90, 0x8a, 0x56, 0x55, 0x12, 0x01, 0x2d, 0xfa, 0x31,
-	0x15, 0xa8, 0xc0, 0x4e, 0x98, 0x3b, 0x49, 0x95, 0x42, 0x4d, 0x29, 0x68, 0x11, 0x2a, 0x74, 0xc1,
-	0x42, 0x43, 0x44, 0x34, 0x49, 0x5c, 0xa6, 0xed, 0x31, 0xa5, 0x56, 0x33, 0xe0, 0xe1, 0xbe, 0x14,
-	0x4b, 0xab, 0x3a, 0x2f, 0x0b, 0xd0, 0xc0, 0x40, 0x0c, 0x36, 0xb7, 0xf4, 0x90, 0xd7, 0x05, 0xcb,
-	0xa1, 0xa1, 0x37, 0xe4, 0x8e, 0x3b, 0x64, 0x21, 0x75, 0x7c, 0xa6, 0x42, 0x51, 0xb3, 0x9b, 0x28,
-	0x1f, 0x38, 0xee, 0x23, 0x25, 0x25, 0x77, 0x61, 0x05, 0x95, 0xb2, 0x90, 0x85, 0x29, 0x4b, 0x8e,
-	0xa9, 0xaf, 0x63, 0x42, 0xb8, 0xe3, 0xea, 0xc0, 0x0e, 0xf4, 0x0e, 0xf9, 0x12, 0x50, 0x9a, 0xf1,
-	0x1a, 0xd3, 0x30, 0x64, 0xbe, 0x2e, 0x61, 0x16, 0x77, 0x5c, 0xcd, 0x4c, 0xc9, 0xd1, 0x4c, 0xd4,
-	0x3e, 0x66, 0x89, 0xe0, 0x51, 0xa8, 0xf2, 0xdb, 0x06, 0xee, 0xb8, 0x4f, 0x95, 0x84, 0xb4, 0x95,
-	0x42, 0x1c, 0x25, 0x32, 0x17, 0xca, 0x52, 0xc1, 0xe4, 0x8e, 0xbb, 0x17, 0x25, 0x98, 0x06, 0x5f,
-	0xc0, 0x2d, 0x9f, 0x1d, 0x52, 0x77, 0x3a, 0xd4, 0x79, 0xc3, 0x3d, 0x21, 0x43, 0x57, 0xb4, 0x97,
-	0xd4, 0x86, 0x1e, 0x51, 0x3d, 0xd1, 0xf9, 0xad, 0x01, 0x2b, 0xfb, 0x32, 0x49, 0x64, 0xe2, 0x1e,
-	0x64, 0x75, 0xf6, 0x47, 0x50, 0x51, 0xa7, 0xa5, 0x17, 0x2e, 0x3b, 0x9d, 0xea, 0x33, 0x98, 0x52,
-	0x2a, 0xf5, 0x66, 0xc9, 0x6a, 0xda, 0x35, 0x25, 0x18, 0x78, 0x1f, 0xa9, 0x4e, 0x53, 0x58, 0xde,
-	0xa5, 0x22, 0x3d, 0x4d, 0x47, 0x10, 0x07, 0x3e, 0xf3, 0xa9, 0x48, 0x75, 0x6f, 0xce, 0xd4, 0x45,
-	0xcb, 0x90, 0x39, 0xd9, 0xbb, 0x98, 0xde, 0xff, 0x33, 0xcf, 0x5e, 0xf6, 0xcf, 0xbf, 0xa3, 0xf3,
-	0x17, 0x03, 0x67, 0x15, 0xee, 0x32, 0x9b, 0xb9, 0x51, 0xe2, 0x89, 0xaf, 0xd3, 0x09, 0x3f, 0x87,
-	0x15, 0x1f, 0xc7, 0x82, 0x99, 0x45, 0x89, 0x7a, 0xa5, 0xbc, 0xb8, 0xf5, 0x8d, 0x3b, 0x1f, 0x29,
-	0x30, 0x8a, 0xa0, 0x4d, 0x14, 0x44, 0x9e, 0x73, 0xe7, 0x19, 0xd4, 0x73, 0xeb, 0xd3, 0xce, 0x36,
-	0xce, 0x38, 0x7b, 0xde, 0xc9, 0x0a, 0x37, 0x69, 0xee, 0xaf, 0x4a, 0x40, 0x7e, 0xc2, 0x52, 0xea,
-	0xd1, 0x94, 0x62, 0xa1, 0xe3, 0x22, 0xe5, 0xae, 0xbc, 0xaf, 0x87, 0x49, 0x34, 0x89, 0xf5, 0x4d,
-	0x34, 0xe4, 0xd7, 0x09, 0x48, 0x91, 0xaa, 0x2d, 0x3d, 0x58, 0xd6, 0x66, 0x0f, 0x05, 0x0d, 0x62,
-	0xac, 0x70, 0xfc, 0x85, 0xe2, 0xd2, 0xb0, 0x6f, 0xe9, 0xad, 0x7d, 0xb9, 0xb3, 0xcf, 0x5f, 0x30,
-	0x2c, 0xf9, 0x01, 0xa3, 0xe1, 0x35, 0x3b, 0x87, 0x3c, 0x8b, 0x18, 0xe9, 0x73, 0x1a, 0x5f, 0xb7,
-	0x6d, 0xe0, 0x59, 0xf2, 0x03, 0x58, 0x1a, 0xf1, 0x44, 0xa4, 0xf3, 0x34, 0x94, 0x97, 0xb0, 0x68,
-	0x37, 0xa5, 0x78, 0x7e, 0x89, 0xee, 0x40, 0x53, 0x26, 0xed, 0x5c, 0xaf, 0x22, 0xf5, 0x1a, 0x28,
-	0x3d, 0xc8, 0x7d, 0x0d, 0xc9, 0x02, 0xac, 0x22, 0x51, 0xbd, 0x5e, 0x8b, 0x0d, 0x78, 0xa8, 0x5a,
-	0x2c, 0x82, 0xd1, 0x13, 0x0d, 0x56, 0xbb, 0x26, 0x18, 0x3d, 0x51, 0x60, 0x3f, 0x83, 0xc5, 0x80,
-	0x79, 0x9c, 0xce, 0xc8, 0x99, 0xd7, 0xc2, 0xab, 0x2b, 0x0c, 0x09, 0x89, 0x5f, 0xa4, 0x96, 0x7c,
-	0x7a, 0x98, 0x62, 0xee, 0xd2, 0x14, 0x4b, 0xda, 0x07, 0xe6, 0x8f, 0x95, 0x7c, 0x8a, 0x16, 0x67,
-	0xc3, 0x13, 0xd1, 0xdd, 0x5f, 0x7d, 0x7c, 0xa9, 0x6e, 0x4e, 0xa0, 0xc4, 0x4e, 0xe2, 0x48, 0x86,
-	0xb6, 0x6c, 0xcb, 0x67, 0xbc, 0x83, 0xf3, 0xe9, 0x45, 0x05, 0x69, 0x3e, 0x8d, 0xdc, 0xce, 0x4d,
-	0x23, 0x15, 0x09, 0x94, 0x4d, 0x17, 0x7a, 0x4b, 0xe2, 0x55, 0x25, 0x1e, 0x6e, 0x3d, 0x42, 0xc8,
-	0xb3, 0x43, 0x43, 0x4d, 0xa2, 0xe6, 0x87, 0x86, 0x2f, 0xfe, 0x90, 0xfd, 0x0d, 0x20, 0xbf, 0xd3,
-	0x97, 0xa0, 0xfe, 0x24, 0x14, 0x31, 0x73, 0xf9, 0x88, 0x33, 0xcf, 0x5a, 0x20, 0x35, 0x28, 0x61,
-	0xf7, 0xb1, 0x0c, 0xd2, 0x00, 0x33, 0x9b, 0xb7, 0xad, 0x02, 0x59, 0x84, 0xda, 0x6c, 0x4a, 0xb6,
-	0x8a, 0xb8, 0x99, 0x7d, 0xbb, 0x5b, 0x25, 0x62, 0x42, 0xd9, 0xa6, 0x2f, 0xa2, 0xc4, 0x2a, 0x93,
-	0x2a, 0x14, 0xb7, 0x39, 0xb5, 0x2a, 0x88, 0xf4, 0x70, 0x6f, 0x70, 0xdf, 0xaa, 0xa2, 0xe8, 0x49,
-	0x40, 0xad, 0x1a, 0x8a, 0x70, 0xba, 0xb3, 0x4c, 0x52, 0x87, 0xaa, 0x6e, 0x72, 0x16, 0x20, 0xf4,
-	0xec, 0xf3, 0xc3, 0xaa, 0x6f, 0x8e, 0x5e, 0xbf, 0x6b, 0x1b, 0x6f, 0xde, 0xb5, 0x8d, 0x7f, 0xbe,
-	0x6b, 0x1b, 0xbf, 0x7b, 0xdf, 0x5e, 0x78, 0xf3, 0xbe, 0xbd, 0xf0, 0xb7, 0xf7, 0xed, 0x85, 0x5f,
-	0xee, 0xe6, 0x82, 0x3a, 0x98, 0x15, 0xa1, 0x5d, 0xea, 0x88, 0x7e, 0x56, 0x92, 0xbe, 0x72, 0xa3,
-	0x84, 0xe5, 0x97, 0x48, 0xb2, 0x1f, 0x44, 0xde, 0xc4, 0x67, 0x62, 0xf6, 0x27, 0x8d, 0x0c, 0xbf,
-	0x53, 0x91, 0x7f, 0xa6, 0xdc, 0xff, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa9, 0x22, 0xa4, 0xe4,
-	0xc5, 0x11, 0x00, 0x00,
+func (m *SymbolPriceTimestamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbolPriceTimestamp.DiscardUnknown(m)
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+var xxx_messageInfo_SymbolPriceTimestamp proto.InternalMessageInfo
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.PythContract != that1.PythContract {
-		return false
-	}
-	return true
-}
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SymbolPriceTimestamp) GetOracle() OracleType {
+	if m != nil {
+		return m.Oracle
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return OracleType_Unspecified
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PythContract) > 0 {
-		i -= len(m.PythContract)
-		copy(dAtA[i:], m.PythContract)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.PythContract)))
-		i--
-		dAtA[i] = 0xa
+func (m *SymbolPriceTimestamp) GetSymbolId() string {
+	if m != nil {
+		return m.SymbolId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *OracleInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SymbolPriceTimestamp) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *OracleInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type LastPriceTimestamps struct {
+	LastPriceTimestamps []*SymbolPriceTimestamp `protobuf:"bytes,1,rep,name=last_price_timestamps,json=lastPriceTimestamps,proto3" json:"last_price_timestamps,omitempty"`
 }
 
-func (m *OracleInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ScaleFactor != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ScaleFactor))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.OracleType != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.OracleType))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0xa
+func (m *LastPriceTimestamps) Reset()         { *m = LastPriceTimestamps{} }
+func (m *LastPriceTimestamps) String() string { return proto.CompactTextString(m) }
+func (*LastPriceTimestamps) ProtoMessage()    {}
+func (*LastPriceTimestamps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{23}
+}
+func (m *LastPriceTimestamps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LastPriceTimestamps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LastPriceTimestamps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *LastPriceTimestamps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastPriceTimestamps.Merge(m, src)
+}
+func (m *LastPriceTimestamps) XXX_Size() int {
+	return m.Size()
+}
+func (m *LastPriceTimestamps) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastPriceTimestamps.DiscardUnknown(m)
 }
 
-func (m *ChainlinkPriceState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LastPriceTimestamps proto.InternalMessageInfo
+
+func (m *LastPriceTimestamps) GetLastPriceTimestamps() []*SymbolPriceTimestamp {
+	if m != nil {
+		return m.LastPriceTimestamps
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ChainlinkPriceState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PriceRecords struct {
+	Oracle             OracleType     `protobuf:"varint,1,opt,name=oracle,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle,omitempty"`
+	SymbolId           string         `protobuf:"bytes,2,opt,name=symbol_id,json=symbolId,proto3" json:"symbol_id,omitempty"`
+	LatestPriceRecords []*PriceRecord `protobuf:"bytes,3,rep,name=latest_price_records,json=latestPriceRecords,proto3" json:"latest_price_records,omitempty"`
 }
 
-func (m *ChainlinkPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+func (m *PriceRecords) Reset()         { *m = PriceRecords{} }
+func (m *PriceRecords) String() string { return proto.CompactTextString(m) }
+func (*PriceRecords) ProtoMessage()    {}
+func (*PriceRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{24}
+}
+func (m *PriceRecords) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceRecords.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.Timestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	{
-		size := m.Answer.Size()
-		i -= size
-		if _, err := m.Answer.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+}
+func (m *PriceRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceRecords.Merge(m, src)
+}
+func (m *PriceRecords) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceRecords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceRecords proto.InternalMessageInfo
+
+func (m *PriceRecords) GetOracle() OracleType {
+	if m != nil {
+		return m.Oracle
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+	return OracleType_Unspecified
+}
+
+func (m *PriceRecords) GetSymbolId() string {
+	if m != nil {
+		return m.SymbolId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *BandPriceState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PriceRecords) GetLatestPriceRecords() []*PriceRecord {
+	if m != nil {
+		return m.LatestPriceRecords
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BandPriceState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PriceRecord struct {
+	Timestamp int64                                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Price     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
 }
 
-func (m *BandPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+func (m *PriceRecord) Reset()         { *m = PriceRecord{} }
+func (m *PriceRecord) String() string { return proto.CompactTextString(m) }
+func (*PriceRecord) ProtoMessage()    {}
+func (*PriceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{25}
+}
+func (m *PriceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.Request_ID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Request_ID))
-		i--
-		dAtA[i] = 0x20
+		return b[:n], nil
 	}
-	if m.ResolveTime != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ResolveTime))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *PriceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceRecord.Merge(m, src)
+}
+func (m *PriceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceRecord proto.InternalMessageInfo
+
+func (m *PriceRecord) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	{
-		size := m.Rate.Size()
-		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// MetadataStatistics refers to the metadata summary statistics of the historical sample considered
+type MetadataStatistics struct {
+	// GroupCount refers to the number of groups used. Equals RecordsSampleSize if no grouping is used
+	GroupCount uint32 `protobuf:"varint,1,opt,name=group_count,json=groupCount,proto3" json:"group_count,omitempty"`
+	// RecordsSampleSize refers to the total number of records used.
+	RecordsSampleSize uint32 `protobuf:"varint,2,opt,name=records_sample_size,json=recordsSampleSize,proto3" json:"records_sample_size,omitempty"`
+	// Mean refers to the arithmetic mean
+	// For trades, the mean is the VWAP computed over the grouped trade records ∑ (price * quantity) / ∑ quantity
+	// For oracle prices, the mean is computed over the price records ∑ (price) / prices_count
+	Mean github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=mean,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mean"`
+	// TWAP refers to the time-weighted average price which equals ∑ (price_i * ∆t_i) / ∑ ∆t_i where ∆t_i = t_i - t_{i-1}
+	Twap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=twap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"twap"`
+	// FirstTimestamp is the timestamp of the oldest record considered
+	FirstTimestamp int64 `protobuf:"varint,5,opt,name=first_timestamp,json=firstTimestamp,proto3" json:"first_timestamp,omitempty"`
+	// LastTimestamp is the timestamp of the youngest record considered
+	LastTimestamp int64 `protobuf:"varint,6,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+	// MinPrice refers to the smallest individual raw price considered
+	MinPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=min_price,json=minPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_price"`
+	// MaxPrice refers to the largest individual raw price considered
+	MaxPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=max_price,json=maxPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price"`
+	// MedianPrice refers to the median individual raw price considered
+	MedianPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=median_price,json=medianPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"median_price"`
+}
+
+func (m *MetadataStatistics) Reset()         { *m = MetadataStatistics{} }
+func (m *MetadataStatistics) String() string { return proto.CompactTextString(m) }
+func (*MetadataStatistics) ProtoMessage()    {}
+func (*MetadataStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{26}
+}
+func (m *MetadataStatistics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetadataStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetadataStatistics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MetadataStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataStatistics.Merge(m, src)
+}
+func (m *MetadataStatistics) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetadataStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetadataStatistics proto.InternalMessageInfo
+
+func (m *MetadataStatistics) GetGroupCount() uint32 {
+	if m != nil {
+		return m.GroupCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PriceFeedState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MetadataStatistics) GetRecordsSampleSize() uint32 {
+	if m != nil {
+		return m.RecordsSampleSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PriceFeedState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MetadataStatistics) GetFirstTimestamp() int64 {
+	if m != nil {
+		return m.FirstTimestamp
+	}
+	return 0
 }
 
-func (m *PriceFeedState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Relayers) > 0 {
-		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Relayers[iNdEx])
-			copy(dAtA[i:], m.Relayers[iNdEx])
-			i = encodeVarintOracle(dAtA, i, uint64(len(m.Relayers[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+func (m *MetadataStatistics) GetLastTimestamp() int64 {
+	if m != nil {
+		return m.LastTimestamp
 	}
-	if m.PriceState != nil {
-		{
-			size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintOracle(dAtA, i, uint64(size))
+	return 0
+}
+
+type PriceAttestation struct {
+	PriceId string `protobuf:"bytes,1,opt,name=price_id,json=priceId,proto3" json:"price_id,omitempty"`
+	// MaxPrice refers to the largest individual raw price considered
+	Price       int64  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	Conf        uint64 `protobuf:"varint,3,opt,name=conf,proto3" json:"conf,omitempty"`
+	Expo        int32  `protobuf:"varint,4,opt,name=expo,proto3" json:"expo,omitempty"`
+	EmaPrice    int64  `protobuf:"varint,5,opt,name=ema_price,json=emaPrice,proto3" json:"ema_price,omitempty"`
+	EmaConf     uint64 `protobuf:"varint,6,opt,name=ema_conf,json=emaConf,proto3" json:"ema_conf,omitempty"`
+	EmaExpo     int32  `protobuf:"varint,7,opt,name=ema_expo,json=emaExpo,proto3" json:"ema_expo,omitempty"`
+	PublishTime int64  `protobuf:"varint,8,opt,name=publish_time,json=publishTime,proto3" json:"publish_time,omitempty"`
+}
+
+func (m *PriceAttestation) Reset()         { *m = PriceAttestation{} }
+func (m *PriceAttestation) String() string { return proto.CompactTextString(m) }
+func (*PriceAttestation) ProtoMessage()    {}
+func (*PriceAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{27}
+}
+func (m *PriceAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.Quote) > 0 {
-		i -= len(m.Quote)
-		copy(dAtA[i:], m.Quote)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *PriceAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceAttestation.Merge(m, src)
+}
+func (m *PriceAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceAttestation proto.InternalMessageInfo
+
+func (m *PriceAttestation) GetPriceId() string {
+	if m != nil {
+		return m.PriceId
 	}
-	if len(m.Base) > 0 {
-		i -= len(m.Base)
-		copy(dAtA[i:], m.Base)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Base)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *PriceAttestation) GetPrice() int64 {
+	if m != nil {
+		return m.Price
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ProviderInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PriceAttestation) GetConf() uint64 {
+	if m != nil {
+		return m.Conf
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ProviderInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PriceAttestation) GetExpo() int32 {
+	if m != nil {
+		return m.Expo
+	}
+	return 0
 }
 
-func (m *ProviderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Relayers) > 0 {
-		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Relayers[iNdEx])
-			copy(dAtA[i:], m.Relayers[iNdEx])
-			i = encodeVarintOracle(dAtA, i, uint64(len(m.Relayers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *PriceAttestation) GetEmaPrice() int64 {
+	if m != nil {
+		return m.EmaPrice
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *PriceAttestation) GetEmaConf() uint64 {
+	if m != nil {
+		return m.EmaConf
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ProviderState) Marshal() (dAtA []byte, err error) {
+func (m *PriceAttestation) GetEmaExpo() int32 {
+	if m != nil {
+		return m.EmaExpo
+	}
+	return 0
+}
+
+func (m *PriceAttestation) GetPublishTime() int64 {
+	if m != nil {
+		return m.PublishTime
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("injective.oracle.v1beta1.OracleType", OracleType_name, OracleType_value)
+	proto.RegisterEnum("injective.oracle.v1beta1.CompositeAggregation", CompositeAggregation_name, CompositeAggregation_value)
+	proto.RegisterType((*Params)(nil), "injective.oracle.v1beta1.Params")
+	proto.RegisterType((*OracleInfo)(nil), "injective.oracle.v1beta1.OracleInfo")
+	proto.RegisterType((*ChainlinkPriceState)(nil), "injective.oracle.v1beta1.ChainlinkPriceState")
+	proto.RegisterType((*BandPriceState)(nil), "injective.oracle.v1beta1.BandPriceState")
+	proto.RegisterType((*PriceFeedState)(nil), "injective.oracle.v1beta1.PriceFeedState")
+	proto.RegisterType((*ProviderInfo)(nil), "injective.oracle.v1beta1.ProviderInfo")
+	proto.RegisterType((*ProviderState)(nil), "injective.oracle.v1beta1.ProviderState")
+	proto.RegisterType((*ProviderPriceState)(nil), "injective.oracle.v1beta1.ProviderPriceState")
+	proto.RegisterType((*PriceFeedInfo)(nil), "injective.oracle.v1beta1.PriceFeedInfo")
+	proto.RegisterType((*PriceFeedPrice)(nil), "injective.oracle.v1beta1.PriceFeedPrice")
+	proto.RegisterType((*CoinbasePriceState)(nil), "injective.oracle.v1beta1.CoinbasePriceState")
+	proto.RegisterType((*PriceState)(nil), "injective.oracle.v1beta1.PriceState")
+	proto.RegisterType((*CompositeIndexComponent)(nil), "injective.oracle.v1beta1.CompositeIndexComponent")
+	proto.RegisterType((*CompositeIndex)(nil), "injective.oracle.v1beta1.CompositeIndex")
+	proto.RegisterType((*CompositeIndexPriceState)(nil), "injective.oracle.v1beta1.CompositeIndexPriceState")
+	proto.RegisterType((*CompositeIndexComponentPrice)(nil), "injective.oracle.v1beta1.CompositeIndexComponentPrice")
+	proto.RegisterType((*ExchangeTwapConfig)(nil), "injective.oracle.v1beta1.ExchangeTwapConfig")
+	proto.RegisterType((*ExchangeTwapPriceState)(nil), "injective.oracle.v1beta1.ExchangeTwapPriceState")
+	proto.RegisterType((*ExchangeTwapSnapshot)(nil), "injective.oracle.v1beta1.ExchangeTwapSnapshot")
+	proto.RegisterType((*PythPriceState)(nil), "injective.oracle.v1beta1.PythPriceState")
+	proto.RegisterType((*BandOracleRequest)(nil), "injective.oracle.v1beta1.BandOracleRequest")
+	proto.RegisterType((*BandIBCParams)(nil), "injective.oracle.v1beta1.BandIBCParams")
+	proto.RegisterType((*SymbolPriceTimestamp)(nil), "injective.oracle.v1beta1.SymbolPriceTimestamp")
+	proto.RegisterType((*LastPriceTimestamps)(nil), "injective.oracle.v1beta1.LastPriceTimestamps")
+	proto.RegisterType((*PriceRecords)(nil), "injective.oracle.v1beta1.PriceRecords")
+	proto.RegisterType((*PriceRecord)(nil), "injective.oracle.v1beta1.PriceRecord")
+	proto.RegisterType((*MetadataStatistics)(nil), "injective.oracle.v1beta1.MetadataStatistics")
+	proto.RegisterType((*PriceAttestation)(nil), "injective.oracle.v1beta1.PriceAttestation")
+}
+
+func init() {
+	proto.RegisterFile("injective/oracle/v1beta1/oracle.proto", fileDescriptor_1c8fbf1e7a765423)
+}
+
+var fileDescriptor_1c8fbf1e7a765423 = []byte{
+	// 2022 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4f, 0x73, 0x1b, 0x49,
+	0x15, 0xf7, 0xe8, 0x9f, 0xa5, 0x27, 0x4b, 0x99, 0xb4, 0xbd, 0xbb, 0x4a, 0x58, 0x6c, 0x23, 0xc8,
+	0xa2, 0x4a, 0xed, 0xca, 0x1b, 0x87, 0x0b, 0x5b, 0x5c, 0x62, 0x3b, 0xa6, 0x84, 0x93, 0xc2, 0x8c,
+	0xb3, 0x49, 0x01, 0x07, 0xd1, 0x9a, 0x69, 0x49, 0x8d, 0x67, 0xa6, 0x27, 0xd3, 0x23, 0xdb, 0xca,
+	0x85, 0xdb, 0x1e, 0x61, 0xbf, 0x00, 0x14, 0x17, 0x2e, 0xfb, 0x15, 0xa8, 0xda, 0xa2, 0x28, 0x0e,
+	0x5b, 0xc5, 0x65, 0x8f, 0x14, 0x87, 0x05, 0x92, 0x0b, 0xdf, 0x60, 0x0f, 0x5c, 0xa8, 0xd7, 0xdd,
+	0x1a, 0x8d, 0xff, 0xc6, 0x96, 0xb3, 0x27, 0x77, 0xbf, 0x7e, 0xfd, 0xf4, 0x5e, 0xbf, 0xdf, 0xfb,
+	0x37, 0x86, 0x3b, 0x3c, 0xfc, 0x35, 0x73, 0x13, 0x7e, 0xc0, 0xd6, 0x44, 0x4c, 0x5d, 0x9f, 0xad,
+	0x1d, 0xdc, 0xeb, 0xb1, 0x84, 0xde, 0x33, 0xdb, 0x76, 0x14, 0x8b, 0x44, 0x90, 0x46, 0xca, 0xd6,
+	0x36, 0x74, 0xc3, 0x76, 0x7b, 0x69, 0x20, 0x06, 0x42, 0x31, 0xad, 0xe1, 0x4a, 0xf3, 0xdf, 0x5e,
+	0x76, 0x85, 0x0c, 0x84, 0x5c, 0xeb, 0x51, 0x39, 0x95, 0xe8, 0x0a, 0x1e, 0xea, 0xf3, 0xe6, 0x7d,
+	0x28, 0xed, 0xd2, 0x98, 0x06, 0x92, 0x7c, 0x17, 0x6a, 0xd1, 0x38, 0x19, 0x76, 0x5d, 0x11, 0x26,
+	0x31, 0x75, 0x93, 0x86, 0xb5, 0x6a, 0xb5, 0x2a, 0xce, 0x02, 0x12, 0x37, 0x0d, 0xed, 0xa3, 0xc2,
+	0x7f, 0xff, 0xb8, 0x62, 0x35, 0x7f, 0x6b, 0x01, 0xfc, 0x54, 0xfd, 0x7a, 0x27, 0xec, 0x0b, 0xf2,
+	0x36, 0x94, 0xe4, 0x38, 0xe8, 0x09, 0xdf, 0x5c, 0x31, 0x3b, 0xf2, 0x10, 0xaa, 0x5a, 0xc7, 0x6e,
+	0x32, 0x8e, 0x58, 0x23, 0xb7, 0x6a, 0xb5, 0xea, 0xeb, 0xdf, 0x6b, 0x9f, 0x67, 0x41, 0x5b, 0x8b,
+	0x7c, 0x32, 0x8e, 0x98, 0x03, 0x22, 0x5d, 0x93, 0xef, 0xc0, 0x82, 0x74, 0xa9, 0xcf, 0xba, 0x7d,
+	0xea, 0x26, 0x22, 0x6e, 0xe4, 0x57, 0xad, 0x56, 0xcd, 0xa9, 0x2a, 0xda, 0xb6, 0x22, 0x35, 0xff,
+	0x63, 0xc1, 0xe2, 0xe6, 0x90, 0xf2, 0xd0, 0xe7, 0xe1, 0xfe, 0x6e, 0xcc, 0x5d, 0xb6, 0x97, 0xd0,
+	0x84, 0x91, 0x77, 0x60, 0xbe, 0xcf, 0x98, 0xd7, 0xe5, 0xde, 0x44, 0x35, 0xdc, 0x76, 0x3c, 0xb2,
+	0x0d, 0x25, 0x1a, 0xca, 0x43, 0x16, 0x2b, 0xad, 0x2a, 0x1b, 0xed, 0x2f, 0xbe, 0x5a, 0x99, 0xfb,
+	0xe7, 0x57, 0x2b, 0xef, 0x0d, 0x78, 0x32, 0x1c, 0xf5, 0xda, 0xae, 0x08, 0xd6, 0xcc, 0xcb, 0xe9,
+	0x3f, 0x1f, 0x48, 0x6f, 0x7f, 0x0d, 0xcd, 0x90, 0xed, 0x2d, 0xe6, 0x3a, 0xe6, 0x36, 0x79, 0x17,
+	0x2a, 0x09, 0x0f, 0x98, 0x4c, 0x68, 0x10, 0x29, 0xc5, 0x0a, 0xce, 0x94, 0x40, 0x76, 0xa0, 0x1a,
+	0xa1, 0x32, 0x5d, 0x89, 0xda, 0x34, 0x0a, 0xab, 0x56, 0xab, 0x7a, 0xd1, 0x03, 0x4c, 0x35, 0xdf,
+	0x28, 0xa0, 0x42, 0x0e, 0x44, 0x29, 0xa5, 0xf9, 0x3f, 0x0b, 0xea, 0x1b, 0x34, 0xf4, 0x32, 0xe6,
+	0x9d, 0xf7, 0xf0, 0x1b, 0x50, 0x88, 0xf1, 0x07, 0xaf, 0x6e, 0x5b, 0x27, 0x4c, 0x1c, 0x75, 0x17,
+	0x5f, 0x3d, 0x66, 0x52, 0xf8, 0x07, 0xac, 0x8b, 0x06, 0x19, 0xe3, 0xaa, 0x86, 0xf6, 0x84, 0x07,
+	0x8c, 0x7c, 0x1b, 0x20, 0x66, 0xcf, 0x47, 0x4c, 0x26, 0xdd, 0xce, 0x96, 0xb2, 0xae, 0xe0, 0x54,
+	0x0c, 0xa5, 0xb3, 0x75, 0xd2, 0xfa, 0xe2, 0xb5, 0xac, 0xff, 0xbd, 0x05, 0x75, 0xc5, 0xb0, 0xcd,
+	0x98, 0xa7, 0xad, 0x27, 0x50, 0x40, 0x54, 0x1b, 0xdb, 0xd5, 0x9a, 0x2c, 0x41, 0xf1, 0xf9, 0x48,
+	0x4c, 0x4c, 0x77, 0xf4, 0x06, 0x81, 0x98, 0xd5, 0x24, 0x7f, 0x79, 0x4d, 0xb2, 0x3a, 0x90, 0xdb,
+	0x50, 0x8e, 0x99, 0x4f, 0xc7, 0x2c, 0x96, 0x8d, 0xc2, 0x6a, 0xbe, 0x55, 0x71, 0xd2, 0x7d, 0x73,
+	0x1b, 0x16, 0x76, 0x63, 0x71, 0xc0, 0x3d, 0x16, 0xab, 0x98, 0xb8, 0x0d, 0xe5, 0xc8, 0xec, 0x8d,
+	0x82, 0xe9, 0xfe, 0x98, 0x9c, 0xdc, 0x09, 0x39, 0x9f, 0x5b, 0x50, 0x9b, 0x08, 0xd2, 0xbf, 0xba,
+	0x03, 0xb5, 0xc9, 0xcd, 0x2e, 0x0f, 0xfb, 0x42, 0x89, 0xab, 0xae, 0xbf, 0x77, 0x91, 0xfa, 0x53,
+	0x45, 0x9c, 0x85, 0x28, 0xab, 0xd6, 0xaf, 0xe0, 0xad, 0x54, 0x58, 0xe6, 0x49, 0xb4, 0x1e, 0xd5,
+	0xf5, 0xf7, 0x5f, 0x2f, 0x34, 0xf3, 0x36, 0x8b, 0xd1, 0x29, 0x9a, 0x6c, 0x0e, 0x81, 0x9c, 0x66,
+	0x3d, 0x17, 0xa9, 0x1f, 0x41, 0x51, 0xfb, 0x24, 0x77, 0x05, 0x9f, 0xe8, 0x2b, 0xcd, 0x1f, 0x42,
+	0x2d, 0x45, 0x84, 0x32, 0xee, 0xd2, 0x80, 0x68, 0x3e, 0xcd, 0x80, 0x49, 0x2d, 0xc8, 0x16, 0x14,
+	0xd5, 0x7b, 0x34, 0xac, 0x2b, 0xc7, 0x0c, 0xe6, 0x03, 0x7d, 0xb9, 0xf9, 0x67, 0x0b, 0xc8, 0xa6,
+	0xe0, 0x21, 0xfe, 0x74, 0xc6, 0x7a, 0x02, 0x85, 0x7d, 0x1e, 0x4e, 0x72, 0x90, 0x5a, 0x1f, 0xcf,
+	0x1c, 0xb9, 0x93, 0x99, 0xc3, 0x86, 0xfc, 0x3e, 0x1b, 0x2b, 0xa4, 0x56, 0x1c, 0x5c, 0xa2, 0x21,
+	0x07, 0xd4, 0x1f, 0x31, 0x13, 0x67, 0x7a, 0xf3, 0x66, 0x63, 0xec, 0xef, 0x16, 0x40, 0x46, 0xeb,
+	0x37, 0xf2, 0x24, 0xe4, 0xe7, 0x60, 0xbb, 0xa3, 0x60, 0xe4, 0x53, 0x54, 0x47, 0x63, 0x6e, 0xc6,
+	0x9c, 0x7b, 0x63, 0x2a, 0x47, 0xfb, 0xec, 0x54, 0xf2, 0xcd, 0x67, 0x9e, 0xb0, 0xf9, 0xb5, 0x05,
+	0xef, 0x6c, 0x8a, 0x20, 0x12, 0x92, 0x27, 0xac, 0x13, 0x7a, 0xec, 0x48, 0xed, 0x42, 0x16, 0x26,
+	0x27, 0x2b, 0x93, 0x35, 0x63, 0x65, 0x9a, 0x00, 0x2e, 0x77, 0x16, 0xe0, 0xf2, 0xd9, 0x0c, 0xb4,
+	0x0d, 0xa5, 0x43, 0xc6, 0x07, 0xc3, 0xa4, 0x51, 0x98, 0xc9, 0x76, 0x73, 0x1b, 0x8b, 0x74, 0x40,
+	0x8f, 0xd0, 0xdb, 0x3e, 0x0b, 0x99, 0x94, 0xca, 0xe3, 0x79, 0x67, 0x21, 0xa0, 0x47, 0x7b, 0x13,
+	0x5a, 0xf3, 0xd3, 0x1c, 0xd4, 0x8f, 0x5b, 0x7e, 0x6e, 0xfc, 0x9d, 0x9d, 0x2f, 0x77, 0xa1, 0x4a,
+	0x07, 0x83, 0x98, 0x0d, 0x68, 0xc2, 0x45, 0xa8, 0x2c, 0xa9, 0xaf, 0xb7, 0xcf, 0x7f, 0x9e, 0xf4,
+	0xc7, 0x1e, 0x4c, 0x6f, 0x39, 0x59, 0x11, 0xe4, 0x19, 0x80, 0x3b, 0x79, 0x7d, 0x9d, 0x3c, 0xab,
+	0xeb, 0xf7, 0x2e, 0x21, 0xf0, 0xb8, 0xdf, 0x26, 0x98, 0x9d, 0x8a, 0x22, 0x77, 0xa0, 0x1e, 0xf0,
+	0xb0, 0x9b, 0x11, 0x5e, 0x54, 0xed, 0x41, 0x2d, 0xe0, 0x61, 0x7a, 0x4f, 0x36, 0x7f, 0x03, 0x8d,
+	0xe3, 0x32, 0x2f, 0x91, 0x9b, 0x4e, 0xc4, 0x56, 0xee, 0x5a, 0xb1, 0xf5, 0x07, 0x0b, 0xde, 0x3d,
+	0xc7, 0xaa, 0x73, 0x13, 0x90, 0x75, 0xf5, 0x68, 0x3b, 0x95, 0x55, 0xb2, 0x21, 0x81, 0x85, 0x87,
+	0x87, 0xae, 0x3f, 0xf2, 0x98, 0xa7, 0x9c, 0x5a, 0x76, 0xd2, 0x7d, 0xf3, 0x6f, 0x16, 0x90, 0x87,
+	0x47, 0xee, 0x90, 0x86, 0x03, 0xf6, 0xe4, 0x90, 0x46, 0x9b, 0x22, 0xec, 0xf3, 0x01, 0xd6, 0x78,
+	0x84, 0x75, 0xd7, 0x63, 0xa1, 0x08, 0xcc, 0x03, 0x55, 0x90, 0xb2, 0x85, 0x04, 0xb2, 0x02, 0x55,
+	0x05, 0x19, 0x73, 0xae, 0x51, 0x04, 0x8a, 0x94, 0x32, 0x24, 0x87, 0x34, 0xea, 0x1e, 0xf2, 0xd0,
+	0x13, 0x87, 0x26, 0x4a, 0x01, 0x49, 0xcf, 0x14, 0x85, 0x3c, 0x06, 0x40, 0x07, 0x1e, 0x08, 0x7f,
+	0x14, 0xb0, 0x19, 0xa3, 0xa3, 0x12, 0xf0, 0xf0, 0xa9, 0x12, 0xd0, 0xfc, 0x93, 0x05, 0x6f, 0x67,
+	0xcd, 0xc8, 0xf8, 0xf9, 0xba, 0xa6, 0xec, 0xcc, 0xdc, 0x45, 0x9c, 0x81, 0x87, 0xcf, 0xf3, 0xb0,
+	0x94, 0xd5, 0x73, 0x2f, 0xa4, 0x91, 0x1c, 0x8a, 0xe4, 0xda, 0x5a, 0x5e, 0x98, 0x14, 0xa7, 0x28,
+	0x2b, 0x5c, 0x27, 0xa7, 0xff, 0x04, 0xca, 0xcf, 0x47, 0x34, 0x4c, 0x78, 0x32, 0x6e, 0x14, 0x67,
+	0x12, 0x94, 0xde, 0x3f, 0xb3, 0x3e, 0x94, 0xde, 0x4c, 0x7d, 0xf8, 0x25, 0xdc, 0xcc, 0x88, 0x36,
+	0x08, 0x9b, 0x9f, 0x49, 0x76, 0x46, 0x47, 0x03, 0xb4, 0xaf, 0x73, 0x50, 0xdf, 0x1d, 0x27, 0xc3,
+	0x0c, 0xc0, 0x6e, 0x41, 0x59, 0x03, 0x24, 0x1d, 0x37, 0xe6, 0xd5, 0xbe, 0xe3, 0x91, 0x1d, 0xa8,
+	0xb0, 0x80, 0x5e, 0xab, 0xfc, 0x95, 0x59, 0x40, 0xb5, 0x5d, 0x1d, 0xc0, 0x35, 0x0e, 0x6a, 0xfd,
+	0x46, 0x7e, 0x26, 0x59, 0xf3, 0x2c, 0xa0, 0x18, 0xe0, 0x38, 0x29, 0x28, 0x31, 0xb3, 0xc1, 0x41,
+	0xdd, 0xc5, 0x49, 0x21, 0x1a, 0xf5, 0x7c, 0x2e, 0x87, 0x7a, 0x52, 0x28, 0xea, 0x49, 0xc1, 0xd0,
+	0xd4, 0xa4, 0x70, 0x22, 0x74, 0x4a, 0xd7, 0x0a, 0x9d, 0x4f, 0xf2, 0x70, 0x13, 0x07, 0x21, 0x5d,
+	0x94, 0x1d, 0x3d, 0x6f, 0x64, 0x87, 0x11, 0xf3, 0xfc, 0x99, 0x61, 0xc4, 0x23, 0x2d, 0xb0, 0x4d,
+	0xc5, 0x97, 0x6e, 0xcc, 0x23, 0xc5, 0xa4, 0xf3, 0x63, 0x5d, 0xd3, 0xf7, 0x14, 0xb9, 0xe3, 0x91,
+	0x06, 0xcc, 0xeb, 0x02, 0x20, 0x1b, 0x79, 0xd5, 0x9c, 0x4f, 0xb6, 0xe4, 0x5b, 0x50, 0xa1, 0x72,
+	0xbf, 0xeb, 0x8a, 0x51, 0x98, 0x98, 0x36, 0xac, 0x4c, 0xe5, 0xfe, 0x26, 0xee, 0xf1, 0x50, 0x17,
+	0x22, 0x3c, 0xd4, 0x4f, 0x50, 0x56, 0x35, 0x08, 0x0f, 0x87, 0x50, 0xe9, 0x33, 0xd6, 0xf5, 0x79,
+	0xc0, 0x93, 0x46, 0x49, 0x55, 0xbf, 0x5b, 0x6d, 0xfd, 0xa4, 0x6d, 0x8c, 0xed, 0x4c, 0xe1, 0xe3,
+	0xe1, 0xc6, 0x87, 0x68, 0xf2, 0x67, 0xff, 0x5a, 0x69, 0x5d, 0xc2, 0x0d, 0x78, 0x41, 0x3a, 0xe5,
+	0x3e, 0x63, 0x8f, 0x50, 0x38, 0xe6, 0x87, 0x28, 0x66, 0x11, 0x8d, 0x59, 0x77, 0x40, 0xa5, 0x42,
+	0x7b, 0xc1, 0x01, 0x43, 0xfa, 0x31, 0x95, 0xc8, 0xc0, 0x8e, 0x98, 0x3b, 0x4a, 0x34, 0x43, 0x59,
+	0x33, 0x18, 0x12, 0x32, 0xb4, 0xc0, 0x46, 0x43, 0xa4, 0x18, 0xc5, 0x2e, 0x33, 0xf6, 0x54, 0x14,
+	0x17, 0x56, 0xda, 0x3d, 0x45, 0x56, 0x56, 0x35, 0x3f, 0xc9, 0x41, 0x0d, 0x1d, 0xd1, 0xd9, 0xd8,
+	0x34, 0xdf, 0x10, 0x5a, 0x60, 0xf7, 0x68, 0xe8, 0x75, 0x79, 0xcf, 0xed, 0xb2, 0x90, 0xf6, 0x7c,
+	0xa6, 0x5d, 0x51, 0x76, 0xea, 0x48, 0xef, 0xf4, 0xdc, 0x87, 0x9a, 0x4a, 0x3e, 0x84, 0x25, 0x64,
+	0x4a, 0x5d, 0x16, 0x26, 0x2c, 0x3e, 0xa0, 0xbe, 0xf1, 0x09, 0xe1, 0x3d, 0xd7, 0x38, 0xb6, 0x63,
+	0x4e, 0xc8, 0xfb, 0x80, 0xd4, 0x54, 0xaf, 0x21, 0x0d, 0x43, 0xe6, 0x9b, 0x2e, 0xcb, 0xe6, 0x3d,
+	0xd7, 0x68, 0xa6, 0xe9, 0x68, 0x26, 0x72, 0x1f, 0xb0, 0x58, 0x62, 0x0b, 0xa3, 0xf0, 0xed, 0x00,
+	0xef, 0xb9, 0x4f, 0x35, 0x85, 0x2c, 0x6b, 0x86, 0x48, 0xc4, 0x0a, 0x0b, 0x45, 0x9d, 0x68, 0x79,
+	0xcf, 0xdd, 0x15, 0x31, 0xc2, 0xe0, 0x2e, 0xdc, 0xf4, 0xd9, 0x80, 0xba, 0xe3, 0xae, 0xc1, 0x0d,
+	0xf7, 0xa4, 0x72, 0x5d, 0xde, 0xb9, 0xa1, 0x0f, 0xcc, 0x17, 0x10, 0x4f, 0x36, 0x7f, 0x67, 0xc1,
+	0xd2, 0x9e, 0x02, 0x89, 0x02, 0xee, 0x93, 0x34, 0xdd, 0xfe, 0x08, 0x4a, 0xfa, 0xf6, 0x95, 0x5a,
+	0x4c, 0x73, 0x07, 0x21, 0xa5, 0xa1, 0x37, 0x01, 0x6b, 0xc5, 0x29, 0x6b, 0x42, 0xc7, 0x7b, 0x4d,
+	0xf3, 0x3b, 0x86, 0xc5, 0x47, 0x54, 0x26, 0xc7, 0xd5, 0x91, 0xa4, 0x07, 0x6f, 0xf9, 0x54, 0x26,
+	0x66, 0xf4, 0x4b, 0xd9, 0x65, 0xc3, 0x52, 0x98, 0xbc, 0xa0, 0xc5, 0x3b, 0xcb, 0x3c, 0x67, 0xd1,
+	0x3f, 0xfd, 0x1b, 0xcd, 0xbf, 0x5a, 0x38, 0x0a, 0x73, 0x97, 0x39, 0xcc, 0x15, 0xb1, 0x27, 0xbf,
+	0xc9, 0x47, 0x78, 0x06, 0x4b, 0x3e, 0x4d, 0x58, 0x6a, 0x51, 0xac, 0x7f, 0x52, 0x05, 0x6e, 0x75,
+	0xfd, 0xce, 0x6b, 0x12, 0x8c, 0x56, 0xd0, 0x21, 0x5a, 0x44, 0x56, 0xe7, 0xe6, 0x73, 0xa8, 0x66,
+	0xf6, 0xc7, 0x1f, 0xdb, 0x3a, 0xb7, 0xa8, 0xe6, 0xae, 0x33, 0x3b, 0x7e, 0x56, 0x00, 0xf2, 0x98,
+	0x25, 0xd4, 0xa3, 0x09, 0xc5, 0x44, 0xc7, 0x65, 0xc2, 0x5d, 0x15, 0xaf, 0x83, 0x58, 0x8c, 0x22,
+	0x13, 0x89, 0x96, 0xea, 0x6e, 0x41, 0x91, 0x74, 0x6e, 0x69, 0xc3, 0xa2, 0x31, 0xbb, 0x2b, 0x69,
+	0x10, 0x61, 0x86, 0xe3, 0x2f, 0xb4, 0x2e, 0x35, 0xe7, 0xa6, 0x39, 0xda, 0x53, 0x27, 0x7b, 0xfc,
+	0x05, 0xc3, 0x94, 0x1f, 0x30, 0x1a, 0xce, 0x58, 0x39, 0xd4, 0x5d, 0x94, 0x81, 0x2d, 0xdc, 0xac,
+	0x65, 0x03, 0xef, 0x92, 0xef, 0xc3, 0x8d, 0x3e, 0x8f, 0x65, 0x32, 0x85, 0xa1, 0x19, 0x66, 0xea,
+	0x8a, 0x3c, 0x0d, 0xa2, 0x3b, 0x50, 0xf7, 0xe9, 0x31, 0xbe, 0x92, 0xe2, 0xab, 0xf9, 0x34, 0xcb,
+	0xb6, 0xa3, 0x13, 0xb0, 0xf6, 0xc4, 0x6c, 0x55, 0x1e, 0x13, 0xb6, 0x2e, 0xb1, 0x28, 0x8c, 0x1e,
+	0x19, 0x61, 0xe5, 0x19, 0x85, 0x51, 0x3d, 0x65, 0x90, 0x9f, 0xc1, 0x42, 0xc0, 0x3c, 0x4e, 0x27,
+	0xca, 0x55, 0x66, 0x92, 0x57, 0xd5, 0x32, 0x94, 0x48, 0xfc, 0xe0, 0x69, 0xab, 0xd5, 0x83, 0x04,
+	0xb1, 0xab, 0x87, 0xac, 0x0b, 0xfa, 0x8f, 0xa5, 0x2c, 0x44, 0xf3, 0x93, 0x3e, 0x8e, 0x98, 0xea,
+	0xaf, 0xbf, 0xed, 0xa9, 0x35, 0xd2, 0xd8, 0x51, 0x24, 0x94, 0x6b, 0x8b, 0x8e, 0x5a, 0x63, 0x0c,
+	0x4e, 0xbb, 0x17, 0xed, 0xa4, 0x69, 0x37, 0x72, 0x2b, 0xd3, 0x8d, 0x94, 0x94, 0xa0, 0xb4, 0xbb,
+	0x30, 0x47, 0x4a, 0xde, 0xbc, 0x92, 0x87, 0x47, 0x0f, 0x51, 0xe4, 0xc9, 0xa6, 0xa1, 0xac, 0xa4,
+	0x66, 0x9b, 0x86, 0xbb, 0x7f, 0x49, 0xbf, 0x32, 0xab, 0x61, 0xfb, 0x06, 0x54, 0x3f, 0x0e, 0x65,
+	0xc4, 0x5c, 0xde, 0xe7, 0xcc, 0xb3, 0xe7, 0x48, 0x19, 0x0a, 0x58, 0x7d, 0x6c, 0x8b, 0xd4, 0xa0,
+	0x92, 0x7e, 0xce, 0xb1, 0x73, 0x64, 0x01, 0xca, 0x93, 0x8f, 0x30, 0x76, 0x1e, 0x0f, 0xd3, 0x4f,
+	0xc3, 0x76, 0x81, 0x54, 0xa0, 0xe8, 0xd0, 0x17, 0x22, 0xb6, 0x8b, 0x64, 0x1e, 0xf2, 0x5b, 0x9c,
+	0xda, 0x25, 0x94, 0xf4, 0x60, 0xb7, 0x73, 0xdf, 0x9e, 0x47, 0xd2, 0xc7, 0x01, 0xb5, 0xcb, 0x48,
+	0xc2, 0xee, 0xce, 0xae, 0x90, 0x2a, 0xcc, 0x9b, 0x22, 0x67, 0x03, 0x8a, 0x9e, 0x7c, 0xdd, 0xb2,
+	0xab, 0x4a, 0xf4, 0x64, 0xa6, 0xb3, 0x17, 0x88, 0x0d, 0x0b, 0xd9, 0x96, 0xde, 0xae, 0xdd, 0xfd,
+	0x01, 0x2c, 0x9d, 0x35, 0x1b, 0x13, 0x80, 0xd2, 0x63, 0xe5, 0x4d, 0x7b, 0x0e, 0x6f, 0x3d, 0x53,
+	0xc3, 0x3d, 0xf3, 0x1e, 0x33, 0x1a, 0xda, 0xd6, 0x46, 0xff, 0x8b, 0x97, 0xcb, 0xd6, 0x97, 0x2f,
+	0x97, 0xad, 0x7f, 0xbf, 0x5c, 0xb6, 0x3e, 0x7d, 0xb5, 0x3c, 0xf7, 0xe5, 0xab, 0xe5, 0xb9, 0x7f,
+	0xbc, 0x5a, 0x9e, 0xfb, 0xc5, 0xa3, 0x0c, 0x56, 0x3a, 0x93, 0xdc, 0xf6, 0x88, 0xf6, 0xe4, 0x5a,
+	0x9a, 0xe9, 0x3e, 0x70, 0x45, 0xcc, 0xb2, 0x5b, 0xb4, 0x7d, 0x2d, 0x10, 0xde, 0xc8, 0x67, 0x72,
+	0xf2, 0xaf, 0x05, 0x85, 0xaa, 0x5e, 0x49, 0xfd, 0x0b, 0xe0, 0xfe, 0xff, 0x07, 0x00, 0xb1, 0xd7,
+	0x80, 0xfb, 0x7b, 0x18, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PythContract != that1.PythContract {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1874,46 +2117,27 @@ func (m *ProviderState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProviderState) MarshalTo(dAtA []byte) (int, error) {
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProviderState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ProviderPriceStates) > 0 {
-		for iNdEx := len(m.ProviderPriceStates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ProviderPriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.ProviderInfo != nil {
-		{
-			size, err := m.ProviderInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintOracle(dAtA, i, uint64(size))
-		}
+	if len(m.PythContract) > 0 {
+		i -= len(m.PythContract)
+		copy(dAtA[i:], m.PythContract)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.PythContract)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ProviderPriceState) Marshal() (dAtA []byte, err error) {
+func (m *OracleInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1923,27 +2147,25 @@ func (m *ProviderPriceState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProviderPriceState) MarshalTo(dAtA []byte) (int, error) {
+func (m *OracleInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProviderPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OracleInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.State != nil {
-		{
-			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintOracle(dAtA, i, uint64(size))
-		}
+	if m.ScaleFactor != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ScaleFactor))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
+	}
+	if m.OracleType != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleType))
+		i--
+		dAtA[i] = 0x10
 	}
 	if len(m.Symbol) > 0 {
 		i -= len(m.Symbol)
@@ -1955,7 +2177,7 @@ func (m *ProviderPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PriceFeedInfo) Marshal() (dAtA []byte, err error) {
+func (m *ChainlinkPriceState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1965,34 +2187,52 @@ func (m *PriceFeedInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PriceFeedInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChainlinkPriceState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceFeedInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChainlinkPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Quote) > 0 {
-		i -= len(m.Quote)
-		copy(dAtA[i:], m.Quote)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
+	{
+		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Timestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if len(m.Base) > 0 {
-		i -= len(m.Base)
-		copy(dAtA[i:], m.Base)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Base)))
+	{
+		size := m.Answer.Size()
+		i -= size
+		if _, err := m.Answer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.FeedId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PriceFeedPrice) Marshal() (dAtA []byte, err error) {
+func (m *BandPriceState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2002,30 +2242,57 @@ func (m *PriceFeedPrice) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PriceFeedPrice) MarshalTo(dAtA []byte) (int, error) {
+func (m *BandPriceState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceFeedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BandPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Price.Size()
+		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
 		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Request_ID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Request_ID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ResolveTime != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ResolveTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CoinbasePriceState) Marshal() (dAtA []byte, err error) {
+func (m *PriceFeedState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2035,54 +2302,55 @@ func (m *CoinbasePriceState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CoinbasePriceState) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceFeedState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CoinbasePriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceFeedState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Relayers[iNdEx])
+			copy(dAtA[i:], m.Relayers[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Relayers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
-		i -= size
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.Value != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x20
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Key)))
+	if m.PriceState != nil {
+		{
+			size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Timestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Kind) > 0 {
-		i -= len(m.Kind)
-		copy(dAtA[i:], m.Kind)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Kind)))
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Base)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PriceState) Marshal() (dAtA []byte, err error) {
+func (m *ProviderInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2092,45 +2360,36 @@ func (m *PriceState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PriceState) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProviderInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProviderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size := m.CumulativePrice.Size()
-		i -= size
-		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Relayers[iNdEx])
+			copy(dAtA[i:], m.Relayers[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Relayers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *PythPriceState) Marshal() (dAtA []byte, err error) {
+func (m *ProviderState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2140,72 +2399,46 @@ func (m *PythPriceState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PythPriceState) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProviderState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PythPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProviderState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if m.PublishTime != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.PublishTime))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size := m.Conf.Size()
-		i -= size
-		if _, err := m.Conf.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.EmaConf.Size()
-		i -= size
-		if _, err := m.EmaConf.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.ProviderPriceStates) > 0 {
+		for iNdEx := len(m.ProviderPriceStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderPriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.EmaPrice.Size()
-		i -= size
-		if _, err := m.EmaPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.ProviderInfo != nil {
+		{
+			size, err := m.ProviderInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOracle(dAtA, i, uint64(size))
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.PriceId) > 0 {
-		i -= len(m.PriceId)
-		copy(dAtA[i:], m.PriceId)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.PriceId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BandOracleRequest) Marshal() (dAtA []byte, err error) {
+func (m *ProviderPriceState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2215,78 +2448,39 @@ func (m *BandOracleRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BandOracleRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProviderPriceState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BandOracleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProviderPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MinSourceCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MinSourceCount))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.ExecuteGas != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ExecuteGas))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.PrepareGas != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.PrepareGas))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.FeeLimit) > 0 {
-		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if m.MinCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MinCount))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.AskCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.AskCount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Symbols) > 0 {
-		for iNdEx := len(m.Symbols) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Symbols[iNdEx])
-			copy(dAtA[i:], m.Symbols[iNdEx])
-			i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbols[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+			i -= size
+			i = encodeVarintOracle(dAtA, i, uint64(size))
 		}
-	}
-	if m.OracleScriptId != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptId))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.RequestId != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.RequestId))
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BandIBCParams) Marshal() (dAtA []byte, err error) {
+func (m *PriceFeedInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2296,75 +2490,34 @@ func (m *BandIBCParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BandIBCParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceFeedInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BandIBCParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceFeedInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.LegacyOracleIds) > 0 {
-		dAtA9 := make([]byte, len(m.LegacyOracleIds)*10)
-		var j8 int
-		for _, num1 := range m.LegacyOracleIds {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j8++
-			}
-			dAtA9[j8] = uint8(num)
-			j8++
-		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintOracle(dAtA, i, uint64(j8))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.IbcPortId) > 0 {
-		i -= len(m.IbcPortId)
-		copy(dAtA[i:], m.IbcPortId)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.IbcPortId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.IbcVersion) > 0 {
-		i -= len(m.IbcVersion)
-		copy(dAtA[i:], m.IbcVersion)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.IbcVersion)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.IbcSourceChannel) > 0 {
-		i -= len(m.IbcSourceChannel)
-		copy(dAtA[i:], m.IbcSourceChannel)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.IbcSourceChannel)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.IbcRequestInterval != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.IbcRequestInterval))
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.BandIbcEnabled {
-		i--
-		if m.BandIbcEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Base)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SymbolPriceTimestamp) Marshal() (dAtA []byte, err error) {
+func (m *PriceFeedPrice) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2374,37 +2527,30 @@ func (m *SymbolPriceTimestamp) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SymbolPriceTimestamp) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceFeedPrice) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SymbolPriceTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceFeedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.SymbolId) > 0 {
-		i -= len(m.SymbolId)
-		copy(dAtA[i:], m.SymbolId)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.SymbolId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Oracle != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Oracle))
-		i--
-		dAtA[i] = 0x8
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *LastPriceTimestamps) Marshal() (dAtA []byte, err error) {
+func (m *CoinbasePriceState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2414,34 +2560,54 @@ func (m *LastPriceTimestamps) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LastPriceTimestamps) MarshalTo(dAtA []byte) (int, error) {
+func (m *CoinbasePriceState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LastPriceTimestamps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CoinbasePriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.LastPriceTimestamps) > 0 {
-		for iNdEx := len(m.LastPriceTimestamps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LastPriceTimestamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Value != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PriceRecords) Marshal() (dAtA []byte, err error) {
+func (m *PriceState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2451,46 +2617,45 @@ func (m *PriceRecords) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PriceRecords) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.LatestPriceRecords) > 0 {
-		for iNdEx := len(m.LatestPriceRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LatestPriceRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.SymbolId) > 0 {
-		i -= len(m.SymbolId)
-		copy(dAtA[i:], m.SymbolId)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.SymbolId)))
+	if m.Timestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if m.Oracle != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Oracle))
-		i--
-		dAtA[i] = 0x8
+	{
+		size := m.CumulativePrice.Size()
+		i -= size
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *PriceRecord) Marshal() (dAtA []byte, err error) {
+func (m *CompositeIndexComponent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2500,35 +2665,54 @@ func (m *PriceRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PriceRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *CompositeIndexComponent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CompositeIndexComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.MaxStaleness != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxStaleness))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
-		size := m.Price.Size()
+		size := m.Weight.Size()
 		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if m.Timestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+	dAtA[i] = 0x22
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OracleType != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleType))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MetadataStatistics) Marshal() (dAtA []byte, err error) {
+func (m *CompositeIndex) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2538,90 +2722,98 @@ func (m *MetadataStatistics) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MetadataStatistics) MarshalTo(dAtA []byte) (int, error) {
+func (m *CompositeIndex) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MetadataStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CompositeIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.MedianPrice.Size()
-		i -= size
-		if _, err := m.MedianPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+	if m.MinComponents != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinComponents))
+		i--
+		dAtA[i] = 0x28
 	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.MaxPrice.Size()
-		i -= size
-		if _, err := m.MaxPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Components) > 0 {
+		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Components[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.MinPrice.Size()
-		i -= size
-		if _, err := m.MinPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+	if m.Aggregation != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Aggregation))
+		i--
+		dAtA[i] = 0x18
 	}
-	i--
-	dAtA[i] = 0x3a
-	if m.LastTimestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.LastTimestamp))
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x12
 	}
-	if m.FirstTimestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.FirstTimestamp))
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0xa
 	}
-	{
-		size := m.Twap.Size()
-		i -= size
-		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+	return len(dAtA) - i, nil
+}
+
+func (m *CompositeIndexPriceState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x22
+	return dAtA[:n], nil
+}
+
+func (m *CompositeIndexPriceState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompositeIndexPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size := m.Mean.Size()
-		i -= size
-		if _, err := m.Mean.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if m.RecordsSampleSize != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.RecordsSampleSize))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.GroupCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.GroupCount))
+	dAtA[i] = 0x12
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PriceAttestation) Marshal() (dAtA []byte, err error) {
+func (m *CompositeIndexComponentPrice) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2631,540 +2823,2624 @@ func (m *PriceAttestation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PriceAttestation) MarshalTo(dAtA []byte) (int, error) {
+func (m *CompositeIndexComponentPrice) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CompositeIndexComponentPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PublishTime != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.PublishTime))
+	if m.Included {
 		i--
-		dAtA[i] = 0x40
-	}
-	if m.EmaExpo != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.EmaExpo))
+		if m.Included {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x18
 	}
-	if m.EmaConf != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.EmaConf))
+	if m.Timestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x10
 	}
-	if m.EmaPrice != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.EmaPrice))
+	if m.Price != nil {
+		{
+			size := m.Price.Size()
+			i -= size
+			if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0xa
 	}
-	if m.Expo != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Expo))
-		i--
-		dAtA[i] = 0x20
+	return len(dAtA) - i, nil
+}
+
+func (m *ExchangeTwapConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Conf != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Conf))
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeTwapConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeTwapConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinVolume.Size()
+		i -= size
+		if _, err := m.MinVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TwapWindow != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TwapWindow))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.Price != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Price))
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.QuoteDenom)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.PriceId) > 0 {
-		i -= len(m.PriceId)
-		copy(dAtA[i:], m.PriceId)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.PriceId)))
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.BaseDenom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ExchangeTwapPriceState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PythContract)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	return n
+
+func (m *ExchangeTwapPriceState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OracleInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ExchangeTwapPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	{
+		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if m.OracleType != 0 {
-		n += 1 + sovOracle(uint64(m.OracleType))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ScaleFactor != 0 {
-		n += 1 + sovOracle(uint64(m.ScaleFactor))
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ChainlinkPriceState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	l = m.Answer.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.Timestamp != 0 {
-		n += 1 + sovOracle(uint64(m.Timestamp))
+func (m *ExchangeTwapSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.PriceState.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *BandPriceState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	l = m.Rate.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.ResolveTime != 0 {
-		n += 1 + sovOracle(uint64(m.ResolveTime))
-	}
-	if m.Request_ID != 0 {
-		n += 1 + sovOracle(uint64(m.Request_ID))
-	}
-	l = m.PriceState.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+func (m *ExchangeTwapSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceFeedState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ExchangeTwapSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Base)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	{
+		size := m.CumulativeVolume.Size()
+		i -= size
+		if _, err := m.CumulativeVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	l = len(m.Quote)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.CumulativePrice.Size()
+		i -= size
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if m.PriceState != nil {
-		l = m.PriceState.Size()
-		n += 1 + l + sovOracle(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if len(m.Relayers) > 0 {
-		for _, s := range m.Relayers {
-			l = len(s)
-			n += 1 + l + sovOracle(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *ProviderInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x22
+	if m.Timestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Relayers) > 0 {
-		for _, s := range m.Relayers {
-			l = len(s)
-			n += 1 + l + sovOracle(uint64(l))
-		}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ProviderState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PythPriceState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PythPriceState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PythPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProviderInfo != nil {
-		l = m.ProviderInfo.Size()
-		n += 1 + l + sovOracle(uint64(l))
+	{
+		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if len(m.ProviderPriceStates) > 0 {
-		for _, e := range m.ProviderPriceStates {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	if m.PublishTime != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PublishTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Conf.Size()
+		i -= size
+		if _, err := m.Conf.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *ProviderPriceState) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.EmaConf.Size()
+		i -= size
+		if _, err := m.EmaConf.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.EmaPrice.Size()
+		i -= size
+		if _, err := m.EmaPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if m.State != nil {
-		l = m.State.Size()
-		n += 1 + l + sovOracle(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.PriceId) > 0 {
+		i -= len(m.PriceId)
+		copy(dAtA[i:], m.PriceId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.PriceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PriceFeedInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Base)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	l = len(m.Quote)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+func (m *BandOracleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PriceFeedPrice) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+func (m *BandOracleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CoinbasePriceState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BandOracleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Kind)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovOracle(uint64(m.Timestamp))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	if m.Value != 0 {
-		n += 1 + sovOracle(uint64(m.Value))
-	}
-	l = m.PriceState.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
-}
-
-func (m *PriceState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.CumulativePrice.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.Timestamp != 0 {
-		n += 1 + sovOracle(uint64(m.Timestamp))
-	}
-	return n
-}
-
-func (m *PythPriceState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PriceId)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	l = m.EmaPrice.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.EmaConf.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.Conf.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.PublishTime != 0 {
-		n += 1 + sovOracle(uint64(m.PublishTime))
-	}
-	l = m.PriceState.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
-}
-
-func (m *BandOracleRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.MinSourceCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinSourceCount))
+		i--
+		dAtA[i] = 0x48
 	}
-	var l int
-	_ = l
-	if m.RequestId != 0 {
-		n += 1 + sovOracle(uint64(m.RequestId))
+	if m.ExecuteGas != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExecuteGas))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.OracleScriptId != 0 {
-		n += 1 + sovOracle(uint64(m.OracleScriptId))
+	if m.PrepareGas != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PrepareGas))
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.Symbols) > 0 {
-		for _, s := range m.Symbols {
-			l = len(s)
-			n += 1 + l + sovOracle(uint64(l))
+	if len(m.FeeLimit) > 0 {
+		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	if m.AskCount != 0 {
-		n += 1 + sovOracle(uint64(m.AskCount))
-	}
 	if m.MinCount != 0 {
-		n += 1 + sovOracle(uint64(m.MinCount))
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinCount))
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.FeeLimit) > 0 {
-		for _, e := range m.FeeLimit {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
-		}
+	if m.AskCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AskCount))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.PrepareGas != 0 {
-		n += 1 + sovOracle(uint64(m.PrepareGas))
+	if len(m.Symbols) > 0 {
+		for iNdEx := len(m.Symbols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Symbols[iNdEx])
+			copy(dAtA[i:], m.Symbols[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbols[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.ExecuteGas != 0 {
-		n += 1 + sovOracle(uint64(m.ExecuteGas))
+	if m.OracleScriptId != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.MinSourceCount != 0 {
-		n += 1 + sovOracle(uint64(m.MinSourceCount))
+	if m.RequestId != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BandIBCParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BandIBCParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BandIBCParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BandIBCParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BandIbcEnabled {
-		n += 2
+	if len(m.LegacyOracleIds) > 0 {
+		dAtA11 := make([]byte, len(m.LegacyOracleIds)*10)
+		var j10 int
+		for _, num1 := range m.LegacyOracleIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintOracle(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.IbcRequestInterval != 0 {
-		n += 1 + sovOracle(uint64(m.IbcRequestInterval))
+	if len(m.IbcPortId) > 0 {
+		i -= len(m.IbcPortId)
+		copy(dAtA[i:], m.IbcPortId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.IbcPortId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.IbcSourceChannel)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.IbcVersion) > 0 {
+		i -= len(m.IbcVersion)
+		copy(dAtA[i:], m.IbcVersion)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.IbcVersion)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.IbcVersion)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.IbcSourceChannel) > 0 {
+		i -= len(m.IbcSourceChannel)
+		copy(dAtA[i:], m.IbcSourceChannel)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.IbcSourceChannel)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.IbcPortId)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.IbcRequestInterval != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.IbcRequestInterval))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.LegacyOracleIds) > 0 {
-		l = 0
-		for _, e := range m.LegacyOracleIds {
-			l += sovOracle(uint64(e))
+	if m.BandIbcEnabled {
+		i--
+		if m.BandIbcEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		n += 1 + sovOracle(uint64(l)) + l
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SymbolPriceTimestamp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SymbolPriceTimestamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbolPriceTimestamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbolPriceTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Oracle != 0 {
-		n += 1 + sovOracle(uint64(m.Oracle))
+	if m.Timestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.SymbolId)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.SymbolId) > 0 {
+		i -= len(m.SymbolId)
+		copy(dAtA[i:], m.SymbolId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.SymbolId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovOracle(uint64(m.Timestamp))
+	if m.Oracle != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Oracle))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LastPriceTimestamps) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LastPriceTimestamps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LastPriceTimestamps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastPriceTimestamps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.LastPriceTimestamps) > 0 {
-		for _, e := range m.LastPriceTimestamps {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+		for iNdEx := len(m.LastPriceTimestamps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastPriceTimestamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PriceRecords) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PriceRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Oracle != 0 {
-		n += 1 + sovOracle(uint64(m.Oracle))
-	}
-	l = len(m.SymbolId)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
 	if len(m.LatestPriceRecords) > 0 {
-		for _, e := range m.LatestPriceRecords {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+		for iNdEx := len(m.LatestPriceRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LatestPriceRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	if len(m.SymbolId) > 0 {
+		i -= len(m.SymbolId)
+		copy(dAtA[i:], m.SymbolId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.SymbolId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Oracle != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Oracle))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PriceRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PriceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if m.Timestamp != 0 {
-		n += 1 + sovOracle(uint64(m.Timestamp))
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MetadataStatistics) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MetadataStatistics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MetadataStatistics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetadataStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GroupCount != 0 {
-		n += 1 + sovOracle(uint64(m.GroupCount))
+	{
+		size := m.MedianPrice.Size()
+		i -= size
+		if _, err := m.MedianPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if m.RecordsSampleSize != 0 {
-		n += 1 + sovOracle(uint64(m.RecordsSampleSize))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MaxPrice.Size()
+		i -= size
+		if _, err := m.MaxPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	l = m.Mean.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.Twap.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.FirstTimestamp != 0 {
-		n += 1 + sovOracle(uint64(m.FirstTimestamp))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MinPrice.Size()
+		i -= size
+		if _, err := m.MinPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x3a
 	if m.LastTimestamp != 0 {
-		n += 1 + sovOracle(uint64(m.LastTimestamp))
+		i = encodeVarintOracle(dAtA, i, uint64(m.LastTimestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FirstTimestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.FirstTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Mean.Size()
+		i -= size
+		if _, err := m.Mean.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.RecordsSampleSize != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RecordsSampleSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GroupCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.GroupCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PublishTime != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PublishTime))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EmaExpo != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.EmaExpo))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EmaConf != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.EmaConf))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EmaPrice != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.EmaPrice))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Expo != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Expo))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Conf != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Conf))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Price != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PriceId) > 0 {
+		i -= len(m.PriceId)
+		copy(dAtA[i:], m.PriceId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.PriceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PythContract)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *OracleInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.OracleType != 0 {
+		n += 1 + sovOracle(uint64(m.OracleType))
+	}
+	if m.ScaleFactor != 0 {
+		n += 1 + sovOracle(uint64(m.ScaleFactor))
+	}
+	return n
+}
+
+func (m *ChainlinkPriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Answer.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	l = m.PriceState.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *BandPriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.ResolveTime != 0 {
+		n += 1 + sovOracle(uint64(m.ResolveTime))
+	}
+	if m.Request_ID != 0 {
+		n += 1 + sovOracle(uint64(m.Request_ID))
+	}
+	l = m.PriceState.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *PriceFeedState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.PriceState != nil {
+		l = m.PriceState.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Relayers) > 0 {
+		for _, s := range m.Relayers {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Relayers) > 0 {
+		for _, s := range m.Relayers {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProviderInfo != nil {
+		l = m.ProviderInfo.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.ProviderPriceStates) > 0 {
+		for _, e := range m.ProviderPriceStates {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderPriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *PriceFeedInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *PriceFeedPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *CoinbasePriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovOracle(uint64(m.Value))
+	}
+	l = m.PriceState.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *PriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *CompositeIndexComponent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleType != 0 {
+		n += 1 + sovOracle(uint64(m.OracleType))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.MaxStaleness != 0 {
+		n += 1 + sovOracle(uint64(m.MaxStaleness))
+	}
+	return n
+}
+
+func (m *CompositeIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Aggregation != 0 {
+		n += 1 + sovOracle(uint64(m.Aggregation))
+	}
+	if len(m.Components) > 0 {
+		for _, e := range m.Components {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.MinComponents != 0 {
+		n += 1 + sovOracle(uint64(m.MinComponents))
+	}
+	return n
+}
+
+func (m *CompositeIndexPriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.PriceState.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *CompositeIndexComponentPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	if m.Included {
+		n += 2
+	}
+	return n
+}
+
+func (m *ExchangeTwapConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.TwapWindow != 0 {
+		n += 1 + sovOracle(uint64(m.TwapWindow))
+	}
+	l = m.MinVolume.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *ExchangeTwapPriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.PriceState.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *ExchangeTwapSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.CumulativeVolume.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *PythPriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PriceId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.EmaPrice.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.EmaConf.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Conf.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.PublishTime != 0 {
+		n += 1 + sovOracle(uint64(m.PublishTime))
+	}
+	l = m.PriceState.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *BandOracleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovOracle(uint64(m.RequestId))
+	}
+	if m.OracleScriptId != 0 {
+		n += 1 + sovOracle(uint64(m.OracleScriptId))
+	}
+	if len(m.Symbols) > 0 {
+		for _, s := range m.Symbols {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.AskCount != 0 {
+		n += 1 + sovOracle(uint64(m.AskCount))
+	}
+	if m.MinCount != 0 {
+		n += 1 + sovOracle(uint64(m.MinCount))
+	}
+	if len(m.FeeLimit) > 0 {
+		for _, e := range m.FeeLimit {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.PrepareGas != 0 {
+		n += 1 + sovOracle(uint64(m.PrepareGas))
+	}
+	if m.ExecuteGas != 0 {
+		n += 1 + sovOracle(uint64(m.ExecuteGas))
+	}
+	if m.MinSourceCount != 0 {
+		n += 1 + sovOracle(uint64(m.MinSourceCount))
+	}
+	return n
+}
+
+func (m *BandIBCParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BandIbcEnabled {
+		n += 2
+	}
+	if m.IbcRequestInterval != 0 {
+		n += 1 + sovOracle(uint64(m.IbcRequestInterval))
+	}
+	l = len(m.IbcSourceChannel)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.IbcVersion)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.IbcPortId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.LegacyOracleIds) > 0 {
+		l = 0
+		for _, e := range m.LegacyOracleIds {
+			l += sovOracle(uint64(e))
+		}
+		n += 1 + sovOracle(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *SymbolPriceTimestamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Oracle != 0 {
+		n += 1 + sovOracle(uint64(m.Oracle))
+	}
+	l = len(m.SymbolId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *LastPriceTimestamps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LastPriceTimestamps) > 0 {
+		for _, e := range m.LastPriceTimestamps {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PriceRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Oracle != 0 {
+		n += 1 + sovOracle(uint64(m.Oracle))
+	}
+	l = len(m.SymbolId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.LatestPriceRecords) > 0 {
+		for _, e := range m.LatestPriceRecords {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PriceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *MetadataStatistics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupCount != 0 {
+		n += 1 + sovOracle(uint64(m.GroupCount))
+	}
+	if m.RecordsSampleSize != 0 {
+		n += 1 + sovOracle(uint64(m.RecordsSampleSize))
+	}
+	l = m.Mean.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Twap.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.FirstTimestamp != 0 {
+		n += 1 + sovOracle(uint64(m.FirstTimestamp))
+	}
+	if m.LastTimestamp != 0 {
+		n += 1 + sovOracle(uint64(m.LastTimestamp))
+	}
+	l = m.MinPrice.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.MaxPrice.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.MedianPrice.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *PriceAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PriceId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 1 + sovOracle(uint64(m.Price))
+	}
+	if m.Conf != 0 {
+		n += 1 + sovOracle(uint64(m.Conf))
+	}
+	if m.Expo != 0 {
+		n += 1 + sovOracle(uint64(m.Expo))
+	}
+	if m.EmaPrice != 0 {
+		n += 1 + sovOracle(uint64(m.EmaPrice))
+	}
+	if m.EmaConf != 0 {
+		n += 1 + sovOracle(uint64(m.EmaConf))
+	}
+	if m.EmaExpo != 0 {
+		n += 1 + sovOracle(uint64(m.EmaExpo))
+	}
+	if m.PublishTime != 0 {
+		n += 1 + sovOracle(uint64(m.PublishTime))
+	}
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PythContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PythContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+			}
+			m.OracleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleType |= OracleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleFactor", wireType)
+			}
+			m.ScaleFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScaleFactor |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainlinkPriceState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainlinkPriceState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainlinkPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Answer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Answer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BandPriceState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BandPriceState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BandPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+			}
+			m.ResolveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolveTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request_ID", wireType)
+			}
+			m.Request_ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Request_ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceFeedState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceFeedState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceFeedState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PriceState == nil {
+				m.PriceState = &PriceState{}
+			}
+			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderInfo == nil {
+				m.ProviderInfo = &ProviderInfo{}
+			}
+			if err := m.ProviderInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPriceStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderPriceStates = append(m.ProviderPriceStates, &ProviderPriceState{})
+			if err := m.ProviderPriceStates[len(m.ProviderPriceStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderPriceState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderPriceState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &PriceState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceFeedInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceFeedInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceFeedInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.MinPrice.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.MaxPrice.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.MedianPrice.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
-}
 
-func (m *PriceAttestation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PriceId)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	if m.Price != 0 {
-		n += 1 + sovOracle(uint64(m.Price))
-	}
-	if m.Conf != 0 {
-		n += 1 + sovOracle(uint64(m.Conf))
-	}
-	if m.Expo != 0 {
-		n += 1 + sovOracle(uint64(m.Expo))
-	}
-	if m.EmaPrice != 0 {
-		n += 1 + sovOracle(uint64(m.EmaPrice))
-	}
-	if m.EmaConf != 0 {
-		n += 1 + sovOracle(uint64(m.EmaConf))
-	}
-	if m.EmaExpo != 0 {
-		n += 1 + sovOracle(uint64(m.EmaExpo))
-	}
-	if m.PublishTime != 0 {
-		n += 1 + sovOracle(uint64(m.PublishTime))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PriceFeedPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3187,15 +5463,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceFeedPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceFeedPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PythContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3223,7 +5499,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PythContract = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3246,7 +5524,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleInfo) Unmarshal(dAtA []byte) error {
+func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3269,15 +5547,15 @@ func (m *OracleInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CoinbasePriceState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CoinbasePriceState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3305,13 +5583,13 @@ func (m *OracleInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.OracleType = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -3321,16 +5599,48 @@ func (m *OracleInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleType |= OracleType(b&0x7F) << shift
+				m.Timestamp |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.ScaleFactor = 0
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -3340,11 +5650,44 @@ func (m *OracleInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScaleFactor |= uint32(b&0x7F) << shift
+				m.Value |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -3366,7 +5709,7 @@ func (m *OracleInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainlinkPriceState) Unmarshal(dAtA []byte) error {
+func (m *PriceState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3389,15 +5732,15 @@ func (m *ChainlinkPriceState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainlinkPriceState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainlinkPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3425,11 +5768,13 @@ func (m *ChainlinkPriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedId = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Answer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3457,7 +5802,7 @@ func (m *ChainlinkPriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Answer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3475,44 +5820,11 @@ func (m *ChainlinkPriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -3534,7 +5846,7 @@ func (m *ChainlinkPriceState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BandPriceState) Unmarshal(dAtA []byte) error {
+func (m *CompositeIndexComponent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3553,19 +5865,38 @@ func (m *BandPriceState) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BandPriceState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BandPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompositeIndexComponent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompositeIndexComponent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+			}
+			m.OracleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleType |= OracleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3593,11 +5924,11 @@ func (m *BandPriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Base = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3625,15 +5956,13 @@ func (m *BandPriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			m.ResolveTime = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -3643,35 +5972,31 @@ func (m *BandPriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResolveTime |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request_ID", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			m.Request_ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Request_ID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStaleness", wireType)
 			}
-			var msglen int
+			m.MaxStaleness = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -3681,25 +6006,11 @@ func (m *BandPriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxStaleness |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -3721,7 +6032,7 @@ func (m *BandPriceState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceFeedState) Unmarshal(dAtA []byte) error {
+func (m *CompositeIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3744,15 +6055,15 @@ func (m *PriceFeedState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceFeedState: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompositeIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceFeedState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompositeIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3780,7 +6091,7 @@ func (m *PriceFeedState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Base = string(dAtA[iNdEx:postIndex])
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3815,8 +6126,27 @@ func (m *PriceFeedState) Unmarshal(dAtA []byte) error {
 			m.Quote = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
+			}
+			m.Aggregation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Aggregation |= CompositeAggregation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3843,18 +6173,16 @@ func (m *PriceFeedState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PriceState == nil {
-				m.PriceState = &PriceState{}
-			}
-			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Components = append(m.Components, CompositeIndexComponent{})
+			if err := m.Components[len(m.Components)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinComponents", wireType)
 			}
-			var stringLen uint64
+			m.MinComponents = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -3864,24 +6192,11 @@ func (m *PriceFeedState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MinComponents |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -3903,7 +6218,7 @@ func (m *PriceFeedState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
+func (m *CompositeIndexPriceState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3926,15 +6241,15 @@ func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProviderInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompositeIndexPriceState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProviderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompositeIndexPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3962,13 +6277,13 @@ func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -3978,23 +6293,24 @@ func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4017,7 +6333,7 @@ func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProviderState) Unmarshal(dAtA []byte) error {
+func (m *CompositeIndexComponentPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4040,17 +6356,17 @@ func (m *ProviderState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProviderState: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompositeIndexComponentPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProviderState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompositeIndexComponentPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4060,33 +6376,33 @@ func (m *ProviderState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderInfo == nil {
-				m.ProviderInfo = &ProviderInfo{}
-			}
-			if err := m.ProviderInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.Price = &v
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPriceStates", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var msglen int
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4096,26 +6412,31 @@ func (m *ProviderState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Included", wireType)
 			}
-			m.ProviderPriceStates = append(m.ProviderPriceStates, &ProviderPriceState{})
-			if err := m.ProviderPriceStates[len(m.ProviderPriceStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Included = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -4137,7 +6458,7 @@ func (m *ProviderState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProviderPriceState) Unmarshal(dAtA []byte) error {
+func (m *ExchangeTwapConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4147,28 +6468,60 @@ func (m *ProviderPriceState) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowOracle
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeTwapConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeTwapConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProviderPriceState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProviderPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4196,13 +6549,32 @@ func (m *ProviderPriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+			}
+			m.TwapWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwapWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVolume", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4212,25 +6584,23 @@ func (m *ProviderPriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &PriceState{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4255,7 +6625,7 @@ func (m *ProviderPriceState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceFeedInfo) Unmarshal(dAtA []byte) error {
+func (m *ExchangeTwapPriceState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4278,15 +6648,15 @@ func (m *PriceFeedInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceFeedInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExchangeTwapPriceState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceFeedInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExchangeTwapPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4314,11 +6684,11 @@ func (m *PriceFeedInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Base = string(dAtA[iNdEx:postIndex])
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4346,63 +6716,13 @@ func (m *PriceFeedInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quote = string(dAtA[iNdEx:postIndex])
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PriceFeedPrice) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PriceFeedPrice: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceFeedPrice: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4412,23 +6732,22 @@ func (m *PriceFeedPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4453,7 +6772,7 @@ func (m *PriceFeedPrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
+func (m *ExchangeTwapSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4476,15 +6795,15 @@ func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CoinbasePriceState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExchangeTwapSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoinbasePriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExchangeTwapSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4512,30 +6831,11 @@ func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4563,13 +6863,13 @@ func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.Value = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4579,16 +6879,16 @@ func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= uint64(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4598,78 +6898,29 @@ func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PriceState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PriceState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4697,11 +6948,11 @@ func (m *PriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
 			}
@@ -4735,11 +6986,11 @@ func (m *PriceState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeVolume", wireType)
 			}
-			m.Timestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4749,11 +7000,26 @@ func (m *PriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
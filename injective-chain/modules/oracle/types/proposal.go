@@ -23,6 +23,10 @@ const (
 	ProposalEnableBandIBC                        string = "ProposalTypeEnableBandIBC"
 	ProposalTypeGrantProviderPrivilege           string = "ProposalTypeGrantProviderPrivilege"
 	ProposalTypeRevokeProviderPrivilege          string = "ProposalTypeRevokeProviderPrivilege"
+	ProposalTypeSetCompositeIndex                string = "ProposalTypeSetCompositeIndex"
+	ProposalTypeRemoveCompositeIndex             string = "ProposalTypeRemoveCompositeIndex"
+	ProposalTypeSetExchangeTwapConfig            string = "ProposalTypeSetExchangeTwapConfig"
+	ProposalTypeRemoveExchangeTwapConfig         string = "ProposalTypeRemoveExchangeTwapConfig"
 )
 
 func init() {
@@ -44,6 +48,14 @@ func init() {
 	gov.RegisterProposalTypeCodec(&GrantProviderPrivilegeProposal{}, "injective/GrantProviderPrivilegeProposal")
 	gov.RegisterProposalType(ProposalTypeRevokeProviderPrivilege)
 	gov.RegisterProposalTypeCodec(&RevokeProviderPrivilegeProposal{}, "injective/RevokeProviderPrivilegeProposal")
+	gov.RegisterProposalType(ProposalTypeSetCompositeIndex)
+	gov.RegisterProposalTypeCodec(&SetCompositeIndexProposal{}, "injective/SetCompositeIndexProposal")
+	gov.RegisterProposalType(ProposalTypeRemoveCompositeIndex)
+	gov.RegisterProposalTypeCodec(&RemoveCompositeIndexProposal{}, "injective/RemoveCompositeIndexProposal")
+	gov.RegisterProposalType(ProposalTypeSetExchangeTwapConfig)
+	gov.RegisterProposalTypeCodec(&SetExchangeTwapConfigProposal{}, "injective/SetExchangeTwapConfigProposal")
+	gov.RegisterProposalType(ProposalTypeRemoveExchangeTwapConfig)
+	gov.RegisterProposalTypeCodec(&RemoveExchangeTwapConfigProposal{}, "injective/RemoveExchangeTwapConfigProposal")
 }
 
 // Implements Proposal Interface
@@ -56,6 +68,10 @@ var _ gov.Content = &UpdateBandOracleRequestProposal{}
 var _ gov.Content = &EnableBandIBCProposal{}
 var _ gov.Content = &GrantProviderPrivilegeProposal{}
 var _ gov.Content = &RevokeProviderPrivilegeProposal{}
+var _ gov.Content = &SetCompositeIndexProposal{}
+var _ gov.Content = &RemoveCompositeIndexProposal{}
+var _ gov.Content = &SetExchangeTwapConfigProposal{}
+var _ gov.Content = &RemoveExchangeTwapConfigProposal{}
 
 // GetTitle returns the title of this proposal.
 func (p *GrantBandOraclePrivilegeProposal) GetTitle() string {
@@ -422,3 +438,107 @@ func (p *UpdateBandOracleRequestProposal) ValidateBasic() error {
 
 	return gov.ValidateAbstract(p)
 }
+
+// GetTitle returns the title of this proposal.
+func (p *SetCompositeIndexProposal) GetTitle() string {
+	return p.Title
+}
+
+// GetDescription returns the description of this proposal.
+func (p *SetCompositeIndexProposal) GetDescription() string {
+	return p.Description
+}
+
+// ProposalRoute returns router key of this proposal.
+func (p *SetCompositeIndexProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type of this proposal.
+func (p *SetCompositeIndexProposal) ProposalType() string {
+	return ProposalTypeSetCompositeIndex
+}
+
+// ValidateBasic returns ValidateBasic result of this proposal.
+func (p *SetCompositeIndexProposal) ValidateBasic() error {
+	if err := p.Index.Validate(); err != nil {
+		return err
+	}
+	return gov.ValidateAbstract(p)
+}
+
+// GetTitle returns the title of this proposal.
+func (p *RemoveCompositeIndexProposal) GetTitle() string {
+	return p.Title
+}
+
+// GetDescription returns the description of this proposal.
+func (p *RemoveCompositeIndexProposal) GetDescription() string {
+	return p.Description
+}
+
+// ProposalRoute returns router key of this proposal.
+func (p *RemoveCompositeIndexProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type of this proposal.
+func (p *RemoveCompositeIndexProposal) ProposalType() string {
+	return ProposalTypeRemoveCompositeIndex
+}
+
+// ValidateBasic returns ValidateBasic result of this proposal.
+func (p *RemoveCompositeIndexProposal) ValidateBasic() error {
+	if p.Symbol == "" {
+		return ErrInvalidSymbol
+	}
+	return gov.ValidateAbstract(p)
+}
+
+// GetTitle returns the title of this proposal.
+func (p *SetExchangeTwapConfigProposal) GetTitle() string {
+	return p.Title
+}
+
+// GetDescription returns the description of this proposal.
+func (p *SetExchangeTwapConfigProposal) GetDescription() string {
+	return p.Description
+}
+
+// ProposalRoute returns router key of this proposal.
+func (p *SetExchangeTwapConfigProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type of this proposal.
+func (p *SetExchangeTwapConfigProposal) ProposalType() string {
+	return ProposalTypeSetExchangeTwapConfig
+}
+
+// ValidateBasic returns ValidateBasic result of this proposal.
+func (p *SetExchangeTwapConfigProposal) ValidateBasic() error {
+	if err := p.Config.Validate(); err != nil {
+		return err
+	}
+	return gov.ValidateAbstract(p)
+}
+
+// GetTitle returns the title of this proposal.
+func (p *RemoveExchangeTwapConfigProposal) GetTitle() string {
+	return p.Title
+}
+
+// GetDescription returns the description of this proposal.
+func (p *RemoveExchangeTwapConfigProposal) GetDescription() string {
+	return p.Description
+}
+
+// ProposalRoute returns router key of this proposal.
+func (p *RemoveExchangeTwapConfigProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type of this proposal.
+func (p *RemoveExchangeTwapConfigProposal) ProposalType() string {
+	return ProposalTypeRemoveExchangeTwapConfig
+}
+
+// ValidateBasic returns ValidateBasic result of this proposal.
+func (p *RemoveExchangeTwapConfigProposal) ValidateBasic() error {
+	if p.BaseDenom == "" || p.QuoteDenom == "" {
+		return sdkerrors.Wrap(ErrInvalidExchangeTwapConfig, "base and quote denoms should not be empty")
+	}
+	return gov.ValidateAbstract(p)
+}
@@ -382,6 +382,163 @@ func (m *EnableBandIBCProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EnableBandIBCProposal proto.InternalMessageInfo
 
+type SetCompositeIndexProposal struct {
+	Title       string         `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Index       CompositeIndex `protobuf:"bytes,3,opt,name=index,proto3" json:"index"`
+}
+
+func (m *SetCompositeIndexProposal) Reset()         { *m = SetCompositeIndexProposal{} }
+func (m *SetCompositeIndexProposal) String() string { return proto.CompactTextString(m) }
+func (*SetCompositeIndexProposal) ProtoMessage()    {}
+func (*SetCompositeIndexProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5a187f865fd0c5b, []int{9}
+}
+func (m *SetCompositeIndexProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCompositeIndexProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCompositeIndexProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetCompositeIndexProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCompositeIndexProposal.Merge(m, src)
+}
+func (m *SetCompositeIndexProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCompositeIndexProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCompositeIndexProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCompositeIndexProposal proto.InternalMessageInfo
+
+type RemoveCompositeIndexProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Symbol      string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+}
+
+func (m *RemoveCompositeIndexProposal) Reset()         { *m = RemoveCompositeIndexProposal{} }
+func (m *RemoveCompositeIndexProposal) String() string { return proto.CompactTextString(m) }
+func (*RemoveCompositeIndexProposal) ProtoMessage()    {}
+func (*RemoveCompositeIndexProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5a187f865fd0c5b, []int{10}
+}
+func (m *RemoveCompositeIndexProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveCompositeIndexProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveCompositeIndexProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveCompositeIndexProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveCompositeIndexProposal.Merge(m, src)
+}
+func (m *RemoveCompositeIndexProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveCompositeIndexProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveCompositeIndexProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveCompositeIndexProposal proto.InternalMessageInfo
+
+type SetExchangeTwapConfigProposal struct {
+	Title       string             `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string             `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Config      ExchangeTwapConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config"`
+}
+
+func (m *SetExchangeTwapConfigProposal) Reset()         { *m = SetExchangeTwapConfigProposal{} }
+func (m *SetExchangeTwapConfigProposal) String() string { return proto.CompactTextString(m) }
+func (*SetExchangeTwapConfigProposal) ProtoMessage()    {}
+func (*SetExchangeTwapConfigProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5a187f865fd0c5b, []int{11}
+}
+func (m *SetExchangeTwapConfigProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetExchangeTwapConfigProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetExchangeTwapConfigProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetExchangeTwapConfigProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetExchangeTwapConfigProposal.Merge(m, src)
+}
+func (m *SetExchangeTwapConfigProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetExchangeTwapConfigProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetExchangeTwapConfigProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetExchangeTwapConfigProposal proto.InternalMessageInfo
+
+type RemoveExchangeTwapConfigProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	BaseDenom   string `protobuf:"bytes,3,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	QuoteDenom  string `protobuf:"bytes,4,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+}
+
+func (m *RemoveExchangeTwapConfigProposal) Reset()         { *m = RemoveExchangeTwapConfigProposal{} }
+func (m *RemoveExchangeTwapConfigProposal) String() string { return proto.CompactTextString(m) }
+func (*RemoveExchangeTwapConfigProposal) ProtoMessage()    {}
+func (*RemoveExchangeTwapConfigProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5a187f865fd0c5b, []int{12}
+}
+func (m *RemoveExchangeTwapConfigProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveExchangeTwapConfigProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveExchangeTwapConfigProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveExchangeTwapConfigProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveExchangeTwapConfigProposal.Merge(m, src)
+}
+func (m *RemoveExchangeTwapConfigProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveExchangeTwapConfigProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveExchangeTwapConfigProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveExchangeTwapConfigProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GrantBandOraclePrivilegeProposal)(nil), "injective.oracle.v1beta1.GrantBandOraclePrivilegeProposal")
 	proto.RegisterType((*RevokeBandOraclePrivilegeProposal)(nil), "injective.oracle.v1beta1.RevokeBandOraclePrivilegeProposal")
@@ -392,6 +549,10 @@ func init() {
 	proto.RegisterType((*AuthorizeBandOracleRequestProposal)(nil), "injective.oracle.v1beta1.AuthorizeBandOracleRequestProposal")
 	proto.RegisterType((*UpdateBandOracleRequestProposal)(nil), "injective.oracle.v1beta1.UpdateBandOracleRequestProposal")
 	proto.RegisterType((*EnableBandIBCProposal)(nil), "injective.oracle.v1beta1.EnableBandIBCProposal")
+	proto.RegisterType((*SetCompositeIndexProposal)(nil), "injective.oracle.v1beta1.SetCompositeIndexProposal")
+	proto.RegisterType((*RemoveCompositeIndexProposal)(nil), "injective.oracle.v1beta1.RemoveCompositeIndexProposal")
+	proto.RegisterType((*SetExchangeTwapConfigProposal)(nil), "injective.oracle.v1beta1.SetExchangeTwapConfigProposal")
+	proto.RegisterType((*RemoveExchangeTwapConfigProposal)(nil), "injective.oracle.v1beta1.RemoveExchangeTwapConfigProposal")
 }
 
 func init() {
@@ -399,42 +560,51 @@ func init() {
 }
 
 var fileDescriptor_c5a187f865fd0c5b = []byte{
-	// 561 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x77, 0x4c, 0xaa, 0xe9, 0x14, 0x51, 0xd6, 0x16, 0x96, 0x1c, 0x36, 0x69, 0x40, 0x5a,
-	0x50, 0x77, 0xa9, 0xde, 0xbc, 0x19, 0x51, 0x09, 0x16, 0x0c, 0x0b, 0xbd, 0x78, 0x59, 0x66, 0x67,
-	0x9f, 0xc9, 0xe8, 0x66, 0x67, 0x3b, 0x33, 0x1b, 0xa8, 0x27, 0xbd, 0x79, 0xf4, 0xac, 0x97, 0xde,
-	0xf5, 0x20, 0xf8, 0x25, 0x7a, 0xec, 0xd1, 0x93, 0x48, 0x72, 0xf1, 0xec, 0x27, 0x90, 0xcc, 0x4c,
-	0xd2, 0x04, 0x89, 0x20, 0xad, 0x94, 0xde, 0xe6, 0xcd, 0x7b, 0xfb, 0xff, 0xff, 0xde, 0x3c, 0x66,
-	0x07, 0x6f, 0xb1, 0xfc, 0x25, 0x50, 0xc5, 0x86, 0x10, 0x72, 0x41, 0x68, 0x06, 0xe1, 0x70, 0x27,
-	0x01, 0x45, 0x76, 0xc2, 0x42, 0xf0, 0x82, 0x4b, 0x92, 0x05, 0x85, 0xe0, 0x8a, 0xbb, 0xde, 0xac,
-	0x30, 0x30, 0x85, 0x81, 0x2d, 0xac, 0xaf, 0xf7, 0x78, 0x8f, 0xeb, 0xa2, 0x70, 0xb2, 0x32, 0xf5,
-	0x75, 0x9f, 0x72, 0x39, 0xe0, 0x32, 0x4c, 0x88, 0x3c, 0xd1, 0xa4, 0x9c, 0xe5, 0x36, 0x7f, 0x73,
-	0xa9, 0xb1, 0x95, 0xd7, 0x65, 0xad, 0x37, 0x08, 0x37, 0x9f, 0x08, 0x92, 0xab, 0x36, 0xc9, 0xd3,
-	0x67, 0x3a, 0xd3, 0x15, 0x6c, 0xc8, 0x32, 0xe8, 0x41, 0xd7, 0x12, 0xba, 0xeb, 0x78, 0x45, 0x31,
-	0x95, 0x81, 0x87, 0x9a, 0x68, 0x7b, 0x35, 0x32, 0x81, 0xdb, 0xc4, 0x6b, 0x29, 0x48, 0x2a, 0x58,
-	0xa1, 0x18, 0xcf, 0xbd, 0x4b, 0x3a, 0x37, 0xbf, 0xe5, 0xd6, 0x71, 0x4d, 0x40, 0x46, 0x0e, 0x40,
-	0x48, 0xaf, 0xd2, 0xac, 0x6c, 0xaf, 0x46, 0xb3, 0xf8, 0x7e, 0xed, 0xdd, 0x61, 0xc3, 0xf9, 0x79,
-	0xd8, 0x70, 0x5a, 0x6f, 0x11, 0xde, 0x8c, 0x60, 0xc8, 0x5f, 0xc1, 0xf9, 0x31, 0x7c, 0x42, 0x78,
-	0x53, 0x1f, 0x43, 0x57, 0x30, 0x0a, 0x8f, 0x01, 0x52, 0x10, 0x67, 0xc7, 0xe0, 0xe2, 0xea, 0x64,
-	0x4c, 0x5e, 0x45, 0xa7, 0xf4, 0x7a, 0xa2, 0xb5, 0x5f, 0x72, 0x05, 0x5e, 0xd5, 0x68, 0xe9, 0x60,
-	0x81, 0x76, 0x65, 0x29, 0xed, 0x07, 0x84, 0x7d, 0x4b, 0xcb, 0x87, 0xec, 0x4c, 0x51, 0xeb, 0xb8,
-	0x56, 0x58, 0x51, 0x8b, 0x3b, 0x8b, 0x17, 0xe0, 0xaa, 0x4b, 0xe1, 0x3e, 0x22, 0xdc, 0x30, 0xe3,
-	0x3c, 0x3f, 0xba, 0xe5, 0x47, 0xf7, 0x19, 0xe1, 0xd6, 0x94, 0xee, 0x02, 0x4c, 0xfa, 0x2b, 0xc2,
-	0xad, 0x07, 0xa5, 0xea, 0x73, 0xc1, 0x5e, 0xcf, 0x5d, 0x8f, 0x08, 0xf6, 0x4b, 0x90, 0xea, 0xd4,
-	0xb8, 0x4f, 0xf1, 0x15, 0x61, 0xa4, 0x34, 0xf1, 0xda, 0xdd, 0x5b, 0xc1, 0xb2, 0xdf, 0x50, 0xf0,
-	0x87, 0x7b, 0xbb, 0x7a, 0xf4, 0xbd, 0xe1, 0x44, 0x53, 0x85, 0x39, 0xea, 0x5f, 0x08, 0x37, 0xf6,
-	0x8a, 0x94, 0xa8, 0xff, 0x80, 0x7c, 0x1b, 0xbb, 0x29, 0x64, 0xa0, 0x20, 0xb6, 0xbe, 0x31, 0x4b,
-	0xcd, 0xcd, 0xae, 0x46, 0xd7, 0x4d, 0xc6, 0x5a, 0x75, 0x52, 0xe9, 0xc6, 0x78, 0xa3, 0xd4, 0x20,
-	0xb1, 0xe9, 0x66, 0xfa, 0x91, 0x9e, 0xc5, 0xbf, 0xb5, 0x1b, 0xdd, 0x30, 0x4a, 0x0b, 0x9b, 0x73,
-	0x4d, 0x7f, 0x41, 0x78, 0xe3, 0x51, 0x4e, 0x92, 0x4c, 0x37, 0xdd, 0x69, 0x3f, 0x3c, 0x75, 0xab,
-	0x7b, 0xf8, 0x5a, 0x42, 0xf2, 0x34, 0x66, 0x09, 0x8d, 0x0b, 0x22, 0xc8, 0x40, 0xda, 0x29, 0x6d,
-	0xfd, 0x1d, 0x7b, 0xe2, 0xad, 0xcb, 0xed, 0x84, 0xae, 0x4e, 0x54, 0x3a, 0x09, 0x35, 0x9b, 0x27,
-	0xc8, 0xed, 0x17, 0x47, 0x23, 0x1f, 0x1d, 0x8f, 0x7c, 0xf4, 0x63, 0xe4, 0xa3, 0xf7, 0x63, 0xdf,
-	0x39, 0x1e, 0xfb, 0xce, 0xb7, 0xb1, 0xef, 0x3c, 0xdf, 0xed, 0x31, 0xd5, 0x2f, 0x93, 0x80, 0xf2,
-	0x41, 0xd8, 0x99, 0x7a, 0xed, 0x92, 0x44, 0x86, 0x33, 0xe7, 0x3b, 0x94, 0x0b, 0x98, 0x0f, 0xfb,
-	0x84, 0xe5, 0xe1, 0x80, 0xa7, 0x65, 0x06, 0x72, 0xfa, 0xe6, 0xa8, 0x83, 0x02, 0x64, 0x72, 0x59,
-	0xbf, 0x35, 0xf7, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x52, 0xdf, 0x66, 0x3e, 0x0d, 0x07, 0x00,
+	// 705 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0xcf, 0x6f, 0xd3, 0x4a,
+	0x10, 0xc7, 0xb3, 0xaf, 0x69, 0x5f, 0x3b, 0xd5, 0xd3, 0x7b, 0xf2, 0x6b, 0x51, 0x88, 0xa8, 0x93,
+	0x46, 0x42, 0xad, 0x44, 0x89, 0x55, 0xb8, 0x71, 0x23, 0x6d, 0x41, 0x81, 0x4a, 0x54, 0x2e, 0xbd,
+	0x70, 0x89, 0xd6, 0xf6, 0x34, 0x59, 0xb0, 0xbd, 0xae, 0xbd, 0x09, 0x2d, 0x27, 0xb8, 0x71, 0xe4,
+	0x0c, 0x97, 0x4a, 0x70, 0x03, 0x24, 0x24, 0xfe, 0x89, 0x1e, 0x7b, 0xe4, 0x84, 0x50, 0x7b, 0xe1,
+	0xcc, 0x5f, 0x80, 0xbc, 0xbb, 0x49, 0x1d, 0x55, 0x46, 0x42, 0x69, 0x55, 0x71, 0xf3, 0xfc, 0xf0,
+	0x77, 0x3e, 0x33, 0xa3, 0x5d, 0x2d, 0x2c, 0xb0, 0xf0, 0x31, 0xba, 0x82, 0xf5, 0xd0, 0xe2, 0x31,
+	0x75, 0x7d, 0xb4, 0x7a, 0xcb, 0x0e, 0x0a, 0xba, 0x6c, 0x45, 0x31, 0x8f, 0x78, 0x42, 0xfd, 0x7a,
+	0x14, 0x73, 0xc1, 0x8d, 0xd2, 0x20, 0xb1, 0xae, 0x12, 0xeb, 0x3a, 0xb1, 0x3c, 0xd3, 0xe6, 0x6d,
+	0x2e, 0x93, 0xac, 0xf4, 0x4b, 0xe5, 0x97, 0x4d, 0x97, 0x27, 0x01, 0x4f, 0x2c, 0x87, 0x26, 0x27,
+	0x9a, 0x2e, 0x67, 0xa1, 0x8e, 0x5f, 0xcd, 0x2d, 0xac, 0xe5, 0x65, 0x5a, 0xed, 0x39, 0x81, 0xea,
+	0xdd, 0x98, 0x86, 0xa2, 0x41, 0x43, 0xef, 0x81, 0x8c, 0x6c, 0xc4, 0xac, 0xc7, 0x7c, 0x6c, 0xe3,
+	0x86, 0x26, 0x34, 0x66, 0x60, 0x5c, 0x30, 0xe1, 0x63, 0x89, 0x54, 0xc9, 0xe2, 0x94, 0xad, 0x0c,
+	0xa3, 0x0a, 0xd3, 0x1e, 0x26, 0x6e, 0xcc, 0x22, 0xc1, 0x78, 0x58, 0xfa, 0x4b, 0xc6, 0xb2, 0x2e,
+	0xa3, 0x0c, 0x93, 0x31, 0xfa, 0x74, 0x0f, 0xe3, 0xa4, 0x34, 0x56, 0x1d, 0x5b, 0x9c, 0xb2, 0x07,
+	0xf6, 0xad, 0xc9, 0x97, 0xfb, 0x95, 0xc2, 0xf7, 0xfd, 0x4a, 0xa1, 0xf6, 0x82, 0xc0, 0xbc, 0x8d,
+	0x3d, 0xfe, 0x04, 0x2f, 0x8e, 0xe1, 0x3d, 0x81, 0x79, 0x39, 0x86, 0x8d, 0x98, 0xb9, 0x78, 0x07,
+	0xd1, 0xc3, 0xf8, 0xec, 0x18, 0x0c, 0x28, 0xa6, 0x6b, 0x2a, 0x8d, 0xc9, 0x90, 0xfc, 0x4e, 0xb5,
+	0x76, 0xba, 0x5c, 0x60, 0xa9, 0xa8, 0xb4, 0xa4, 0x31, 0x44, 0x3b, 0x9e, 0x4b, 0xfb, 0x9a, 0x80,
+	0xa9, 0x69, 0x79, 0x8f, 0x9d, 0x29, 0x6a, 0x19, 0x26, 0x23, 0x2d, 0xaa, 0x71, 0x07, 0xf6, 0x10,
+	0x5c, 0x31, 0x17, 0xee, 0x0d, 0x81, 0x8a, 0x5a, 0xe7, 0xc5, 0xd1, 0xe5, 0x8f, 0xee, 0x03, 0x81,
+	0x5a, 0x9f, 0xee, 0x0f, 0xd8, 0xf4, 0x67, 0x02, 0xb5, 0xdb, 0x5d, 0xd1, 0xe1, 0x31, 0x7b, 0x96,
+	0x39, 0x1e, 0x36, 0xee, 0x74, 0x31, 0x11, 0x23, 0xe3, 0xde, 0x87, 0xbf, 0x63, 0x25, 0x25, 0x89,
+	0xa7, 0x6f, 0x5c, 0xab, 0xe7, 0x5d, 0x43, 0xf5, 0x53, 0xd5, 0x1b, 0xc5, 0x83, 0xaf, 0x95, 0x82,
+	0xdd, 0x57, 0xc8, 0x50, 0xff, 0x20, 0x50, 0xd9, 0x8a, 0x3c, 0x2a, 0xce, 0x01, 0x79, 0x09, 0x0c,
+	0x0f, 0x7d, 0x14, 0xd8, 0xd2, 0x75, 0x5b, 0xcc, 0x53, 0x27, 0xbb, 0x68, 0xff, 0xa7, 0x22, 0xba,
+	0x54, 0xd3, 0x4b, 0x8c, 0x16, 0xcc, 0x76, 0x25, 0x48, 0x4b, 0x75, 0xd3, 0xff, 0xa9, 0x54, 0xfc,
+	0xed, 0x76, 0xed, 0xff, 0x95, 0xd2, 0x90, 0x33, 0xd3, 0xf4, 0x27, 0x02, 0xb3, 0x6b, 0x21, 0x75,
+	0x7c, 0xd9, 0x74, 0xb3, 0xb1, 0x32, 0x72, 0xab, 0x5b, 0xf0, 0xaf, 0x43, 0x43, 0xaf, 0xc5, 0x1c,
+	0xb7, 0x15, 0xd1, 0x98, 0x06, 0x89, 0xde, 0xd2, 0xc2, 0xaf, 0xb1, 0xd3, 0xda, 0x32, 0x5d, 0x6f,
+	0xe8, 0x9f, 0x54, 0xa5, 0xe9, 0xb8, 0xca, 0x99, 0x41, 0x7e, 0x4b, 0xe0, 0xf2, 0x26, 0x8a, 0x15,
+	0x1e, 0x44, 0x3c, 0x61, 0x02, 0x9b, 0xa1, 0x87, 0xbb, 0x23, 0x63, 0xaf, 0xc2, 0x38, 0x4b, 0x85,
+	0x34, 0xec, 0x62, 0x3e, 0xec, 0x70, 0x61, 0x4d, 0xab, 0x7e, 0xce, 0x50, 0xee, 0xc2, 0x15, 0x1b,
+	0x03, 0xde, 0xc3, 0x33, 0xe6, 0xbc, 0x04, 0x13, 0xc9, 0x5e, 0xe0, 0x70, 0x5f, 0x9f, 0x56, 0x6d,
+	0x65, 0x2a, 0x7f, 0x24, 0x30, 0xb7, 0x89, 0x62, 0x6d, 0xd7, 0xed, 0xd0, 0xb0, 0x8d, 0x0f, 0x9f,
+	0xd2, 0x68, 0x85, 0x87, 0xdb, 0xac, 0x3d, 0x72, 0xed, 0x7b, 0x30, 0xe1, 0x4a, 0x25, 0x3d, 0xa4,
+	0xa5, 0xfc, 0x21, 0x9d, 0xae, 0xae, 0x07, 0xa5, 0x15, 0x32, 0xbc, 0xef, 0x08, 0x54, 0xd5, 0xa8,
+	0xce, 0x01, 0x79, 0x0e, 0x20, 0xbd, 0xce, 0x5a, 0x1e, 0x86, 0x3c, 0xd0, 0x23, 0x9b, 0x4a, 0x3d,
+	0xab, 0xa9, 0xc3, 0xa8, 0xc0, 0xb4, 0xbc, 0xd8, 0x74, 0x5c, 0xdd, 0x75, 0x20, 0x5d, 0x32, 0xe1,
+	0x04, 0xb3, 0xb1, 0x7d, 0x70, 0x64, 0x92, 0xc3, 0x23, 0x93, 0x7c, 0x3b, 0x32, 0xc9, 0xab, 0x63,
+	0xb3, 0x70, 0x78, 0x6c, 0x16, 0xbe, 0x1c, 0x9b, 0x85, 0x47, 0xeb, 0x6d, 0x26, 0x3a, 0x5d, 0xa7,
+	0xee, 0xf2, 0xc0, 0x6a, 0xf6, 0x07, 0xb2, 0x4e, 0x9d, 0xc4, 0x1a, 0x8c, 0xe7, 0xba, 0xcb, 0x63,
+	0xcc, 0x9a, 0x1d, 0xca, 0x42, 0x2b, 0xe0, 0x5e, 0xd7, 0xc7, 0xa4, 0xff, 0xd4, 0x11, 0x7b, 0x11,
+	0x26, 0xce, 0x84, 0x7c, 0xe2, 0xdc, 0xfc, 0x39, 0x00, 0xdf, 0x7d, 0x38, 0x30, 0x84, 0x09, 0x00,
 	0x00,
 }
 
@@ -917,6 +1087,195 @@ func (m *EnableBandIBCProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SetCompositeIndexProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCompositeIndexProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCompositeIndexProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Index.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveCompositeIndexProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveCompositeIndexProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveCompositeIndexProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetExchangeTwapConfigProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetExchangeTwapConfigProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetExchangeTwapConfigProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveExchangeTwapConfigProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveExchangeTwapConfigProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveExchangeTwapConfigProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProposal(v)
 	base := offset
@@ -1156,15 +1515,99 @@ func (m *EnableBandIBCProposal) Size() (n int) {
 	return n
 }
 
-func sovProposal(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozProposal(x uint64) (n int) {
-	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GrantBandOraclePrivilegeProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+func (m *SetCompositeIndexProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = m.Index.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func (m *RemoveCompositeIndexProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *SetExchangeTwapConfigProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func (m *RemoveExchangeTwapConfigProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GrantBandOraclePrivilegeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -2750,6 +3193,624 @@ func (m *EnableBandIBCProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetCompositeIndexProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetCompositeIndexProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetCompositeIndexProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Index.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveCompositeIndexProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveCompositeIndexProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveCompositeIndexProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetExchangeTwapConfigProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetExchangeTwapConfigProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetExchangeTwapConfigProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveExchangeTwapConfigProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveExchangeTwapConfigProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveExchangeTwapConfigProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProposal(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
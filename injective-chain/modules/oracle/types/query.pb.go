@@ -118,6 +118,265 @@ func (m *QueryPythPriceResponse) GetPriceState() *PythPriceState {
 	return nil
 }
 
+type QueryCompositeIndexRequest struct {
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+}
+
+func (m *QueryCompositeIndexRequest) Reset()         { *m = QueryCompositeIndexRequest{} }
+func (m *QueryCompositeIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCompositeIndexRequest) ProtoMessage()    {}
+func (*QueryCompositeIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{2}
+}
+func (m *QueryCompositeIndexRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCompositeIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCompositeIndexRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCompositeIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCompositeIndexRequest.Merge(m, src)
+}
+func (m *QueryCompositeIndexRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCompositeIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCompositeIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCompositeIndexRequest proto.InternalMessageInfo
+
+func (m *QueryCompositeIndexRequest) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+type QueryCompositeIndexResponse struct {
+	Index *CompositeIndex `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	// component_prices are the current prices of the index components, in the same order
+	ComponentPrices []CompositeIndexComponentPrice `protobuf:"bytes,2,rep,name=component_prices,json=componentPrices,proto3" json:"component_prices"`
+	// price of the index, empty if not enough components have a fresh price
+	Price *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price,omitempty"`
+}
+
+func (m *QueryCompositeIndexResponse) Reset()         { *m = QueryCompositeIndexResponse{} }
+func (m *QueryCompositeIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCompositeIndexResponse) ProtoMessage()    {}
+func (*QueryCompositeIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{3}
+}
+func (m *QueryCompositeIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCompositeIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCompositeIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCompositeIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCompositeIndexResponse.Merge(m, src)
+}
+func (m *QueryCompositeIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCompositeIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCompositeIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCompositeIndexResponse proto.InternalMessageInfo
+
+func (m *QueryCompositeIndexResponse) GetIndex() *CompositeIndex {
+	if m != nil {
+		return m.Index
+	}
+	return nil
+}
+
+func (m *QueryCompositeIndexResponse) GetComponentPrices() []CompositeIndexComponentPrice {
+	if m != nil {
+		return m.ComponentPrices
+	}
+	return nil
+}
+
+type QueryCompositeIndicesRequest struct {
+}
+
+func (m *QueryCompositeIndicesRequest) Reset()         { *m = QueryCompositeIndicesRequest{} }
+func (m *QueryCompositeIndicesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCompositeIndicesRequest) ProtoMessage()    {}
+func (*QueryCompositeIndicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{4}
+}
+func (m *QueryCompositeIndicesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCompositeIndicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCompositeIndicesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCompositeIndicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCompositeIndicesRequest.Merge(m, src)
+}
+func (m *QueryCompositeIndicesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCompositeIndicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCompositeIndicesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCompositeIndicesRequest proto.InternalMessageInfo
+
+type QueryCompositeIndicesResponse struct {
+	Indices []*CompositeIndex `protobuf:"bytes,1,rep,name=indices,proto3" json:"indices,omitempty"`
+}
+
+func (m *QueryCompositeIndicesResponse) Reset()         { *m = QueryCompositeIndicesResponse{} }
+func (m *QueryCompositeIndicesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCompositeIndicesResponse) ProtoMessage()    {}
+func (*QueryCompositeIndicesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{5}
+}
+func (m *QueryCompositeIndicesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCompositeIndicesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCompositeIndicesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCompositeIndicesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCompositeIndicesResponse.Merge(m, src)
+}
+func (m *QueryCompositeIndicesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCompositeIndicesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCompositeIndicesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCompositeIndicesResponse proto.InternalMessageInfo
+
+func (m *QueryCompositeIndicesResponse) GetIndices() []*CompositeIndex {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+type QueryExchangeTwapConfigsRequest struct {
+}
+
+func (m *QueryExchangeTwapConfigsRequest) Reset()         { *m = QueryExchangeTwapConfigsRequest{} }
+func (m *QueryExchangeTwapConfigsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeTwapConfigsRequest) ProtoMessage()    {}
+func (*QueryExchangeTwapConfigsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{6}
+}
+func (m *QueryExchangeTwapConfigsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeTwapConfigsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeTwapConfigsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExchangeTwapConfigsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeTwapConfigsRequest.Merge(m, src)
+}
+func (m *QueryExchangeTwapConfigsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeTwapConfigsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeTwapConfigsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExchangeTwapConfigsRequest proto.InternalMessageInfo
+
+type QueryExchangeTwapConfigsResponse struct {
+	Configs []*ExchangeTwapConfig `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+}
+
+func (m *QueryExchangeTwapConfigsResponse) Reset()         { *m = QueryExchangeTwapConfigsResponse{} }
+func (m *QueryExchangeTwapConfigsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeTwapConfigsResponse) ProtoMessage()    {}
+func (*QueryExchangeTwapConfigsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{7}
+}
+func (m *QueryExchangeTwapConfigsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeTwapConfigsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeTwapConfigsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExchangeTwapConfigsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeTwapConfigsResponse.Merge(m, src)
+}
+func (m *QueryExchangeTwapConfigsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeTwapConfigsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeTwapConfigsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExchangeTwapConfigsResponse proto.InternalMessageInfo
+
+func (m *QueryExchangeTwapConfigsResponse) GetConfigs() []*ExchangeTwapConfig {
+	if m != nil {
+		return m.Configs
+	}
+	return nil
+}
+
 // QueryOracleParamsRequest is the request type for the Query/OracleParams RPC method.
 type QueryParamsRequest struct {
 }
@@ -126,7 +385,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{2}
+	return fileDescriptor_52f5d6f9962923ad, []int{8}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -164,7 +423,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{3}
+	return fileDescriptor_52f5d6f9962923ad, []int{9}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -208,7 +467,7 @@ func (m *QueryBandRelayersRequest) Reset()         { *m = QueryBandRelayersReque
 func (m *QueryBandRelayersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBandRelayersRequest) ProtoMessage()    {}
 func (*QueryBandRelayersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{4}
+	return fileDescriptor_52f5d6f9962923ad, []int{10}
 }
 func (m *QueryBandRelayersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -246,7 +505,7 @@ func (m *QueryBandRelayersResponse) Reset()         { *m = QueryBandRelayersResp
 func (m *QueryBandRelayersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBandRelayersResponse) ProtoMessage()    {}
 func (*QueryBandRelayersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{5}
+	return fileDescriptor_52f5d6f9962923ad, []int{11}
 }
 func (m *QueryBandRelayersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -290,7 +549,7 @@ func (m *QueryBandPriceStatesRequest) Reset()         { *m = QueryBandPriceState
 func (m *QueryBandPriceStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBandPriceStatesRequest) ProtoMessage()    {}
 func (*QueryBandPriceStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{6}
+	return fileDescriptor_52f5d6f9962923ad, []int{12}
 }
 func (m *QueryBandPriceStatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,7 +587,7 @@ func (m *QueryBandPriceStatesResponse) Reset()         { *m = QueryBandPriceStat
 func (m *QueryBandPriceStatesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBandPriceStatesResponse) ProtoMessage()    {}
 func (*QueryBandPriceStatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{7}
+	return fileDescriptor_52f5d6f9962923ad, []int{13}
 }
 func (m *QueryBandPriceStatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -372,7 +631,7 @@ func (m *QueryBandIBCPriceStatesRequest) Reset()         { *m = QueryBandIBCPric
 func (m *QueryBandIBCPriceStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBandIBCPriceStatesRequest) ProtoMessage()    {}
 func (*QueryBandIBCPriceStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{8}
+	return fileDescriptor_52f5d6f9962923ad, []int{14}
 }
 func (m *QueryBandIBCPriceStatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -410,7 +669,7 @@ func (m *QueryBandIBCPriceStatesResponse) Reset()         { *m = QueryBandIBCPri
 func (m *QueryBandIBCPriceStatesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBandIBCPriceStatesResponse) ProtoMessage()    {}
 func (*QueryBandIBCPriceStatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{9}
+	return fileDescriptor_52f5d6f9962923ad, []int{15}
 }
 func (m *QueryBandIBCPriceStatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -454,7 +713,7 @@ func (m *QueryPriceFeedPriceStatesRequest) Reset()         { *m = QueryPriceFeed
 func (m *QueryPriceFeedPriceStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPriceFeedPriceStatesRequest) ProtoMessage()    {}
 func (*QueryPriceFeedPriceStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{10}
+	return fileDescriptor_52f5d6f9962923ad, []int{16}
 }
 func (m *QueryPriceFeedPriceStatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -492,7 +751,7 @@ func (m *QueryPriceFeedPriceStatesResponse) Reset()         { *m = QueryPriceFee
 func (m *QueryPriceFeedPriceStatesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPriceFeedPriceStatesResponse) ProtoMessage()    {}
 func (*QueryPriceFeedPriceStatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{11}
+	return fileDescriptor_52f5d6f9962923ad, []int{17}
 }
 func (m *QueryPriceFeedPriceStatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -536,7 +795,7 @@ func (m *QueryCoinbasePriceStatesRequest) Reset()         { *m = QueryCoinbasePr
 func (m *QueryCoinbasePriceStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCoinbasePriceStatesRequest) ProtoMessage()    {}
 func (*QueryCoinbasePriceStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{12}
+	return fileDescriptor_52f5d6f9962923ad, []int{18}
 }
 func (m *QueryCoinbasePriceStatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -574,7 +833,7 @@ func (m *QueryCoinbasePriceStatesResponse) Reset()         { *m = QueryCoinbaseP
 func (m *QueryCoinbasePriceStatesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCoinbasePriceStatesResponse) ProtoMessage()    {}
 func (*QueryCoinbasePriceStatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{13}
+	return fileDescriptor_52f5d6f9962923ad, []int{19}
 }
 func (m *QueryCoinbasePriceStatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -618,7 +877,7 @@ func (m *QueryPythPriceStatesRequest) Reset()         { *m = QueryPythPriceState
 func (m *QueryPythPriceStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPythPriceStatesRequest) ProtoMessage()    {}
 func (*QueryPythPriceStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{14}
+	return fileDescriptor_52f5d6f9962923ad, []int{20}
 }
 func (m *QueryPythPriceStatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -656,7 +915,7 @@ func (m *QueryPythPriceStatesResponse) Reset()         { *m = QueryPythPriceStat
 func (m *QueryPythPriceStatesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPythPriceStatesResponse) ProtoMessage()    {}
 func (*QueryPythPriceStatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{15}
+	return fileDescriptor_52f5d6f9962923ad, []int{21}
 }
 func (m *QueryPythPriceStatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -702,7 +961,7 @@ func (m *QueryProviderPriceStateRequest) Reset()         { *m = QueryProviderPri
 func (m *QueryProviderPriceStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryProviderPriceStateRequest) ProtoMessage()    {}
 func (*QueryProviderPriceStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{16}
+	return fileDescriptor_52f5d6f9962923ad, []int{22}
 }
 func (m *QueryProviderPriceStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -754,7 +1013,7 @@ func (m *QueryProviderPriceStateResponse) Reset()         { *m = QueryProviderPr
 func (m *QueryProviderPriceStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryProviderPriceStateResponse) ProtoMessage()    {}
 func (*QueryProviderPriceStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{17}
+	return fileDescriptor_52f5d6f9962923ad, []int{23}
 }
 func (m *QueryProviderPriceStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -798,7 +1057,7 @@ func (m *QueryModuleStateRequest) Reset()         { *m = QueryModuleStateRequest
 func (m *QueryModuleStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleStateRequest) ProtoMessage()    {}
 func (*QueryModuleStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{18}
+	return fileDescriptor_52f5d6f9962923ad, []int{24}
 }
 func (m *QueryModuleStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -836,7 +1095,7 @@ func (m *QueryModuleStateResponse) Reset()         { *m = QueryModuleStateRespon
 func (m *QueryModuleStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleStateResponse) ProtoMessage()    {}
 func (*QueryModuleStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{19}
+	return fileDescriptor_52f5d6f9962923ad, []int{25}
 }
 func (m *QueryModuleStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -881,7 +1140,7 @@ func (m *QueryHistoricalPriceRecordsRequest) Reset()         { *m = QueryHistori
 func (m *QueryHistoricalPriceRecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHistoricalPriceRecordsRequest) ProtoMessage()    {}
 func (*QueryHistoricalPriceRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{20}
+	return fileDescriptor_52f5d6f9962923ad, []int{26}
 }
 func (m *QueryHistoricalPriceRecordsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -932,7 +1191,7 @@ func (m *QueryHistoricalPriceRecordsResponse) Reset()         { *m = QueryHistor
 func (m *QueryHistoricalPriceRecordsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHistoricalPriceRecordsResponse) ProtoMessage()    {}
 func (*QueryHistoricalPriceRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{21}
+	return fileDescriptor_52f5d6f9962923ad, []int{27}
 }
 func (m *QueryHistoricalPriceRecordsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -982,7 +1241,7 @@ func (m *OracleHistoryOptions) Reset()         { *m = OracleHistoryOptions{} }
 func (m *OracleHistoryOptions) String() string { return proto.CompactTextString(m) }
 func (*OracleHistoryOptions) ProtoMessage()    {}
 func (*OracleHistoryOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{22}
+	return fileDescriptor_52f5d6f9962923ad, []int{28}
 }
 func (m *OracleHistoryOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1043,7 +1302,7 @@ func (m *QueryOracleVolatilityRequest) Reset()         { *m = QueryOracleVolatil
 func (m *QueryOracleVolatilityRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOracleVolatilityRequest) ProtoMessage()    {}
 func (*QueryOracleVolatilityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{23}
+	return fileDescriptor_52f5d6f9962923ad, []int{29}
 }
 func (m *QueryOracleVolatilityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1104,7 +1363,7 @@ func (m *QueryOracleVolatilityResponse) Reset()         { *m = QueryOracleVolati
 func (m *QueryOracleVolatilityResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOracleVolatilityResponse) ProtoMessage()    {}
 func (*QueryOracleVolatilityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{24}
+	return fileDescriptor_52f5d6f9962923ad, []int{30}
 }
 func (m *QueryOracleVolatilityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1154,7 +1413,7 @@ func (m *QueryOracleProvidersInfoRequest) Reset()         { *m = QueryOracleProv
 func (m *QueryOracleProvidersInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOracleProvidersInfoRequest) ProtoMessage()    {}
 func (*QueryOracleProvidersInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{25}
+	return fileDescriptor_52f5d6f9962923ad, []int{31}
 }
 func (m *QueryOracleProvidersInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1191,7 +1450,7 @@ func (m *QueryOracleProvidersInfoResponse) Reset()         { *m = QueryOraclePro
 func (m *QueryOracleProvidersInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOracleProvidersInfoResponse) ProtoMessage()    {}
 func (*QueryOracleProvidersInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{26}
+	return fileDescriptor_52f5d6f9962923ad, []int{32}
 }
 func (m *QueryOracleProvidersInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1235,7 +1494,7 @@ func (m *QueryOracleProviderPricesRequest) Reset()         { *m = QueryOraclePro
 func (m *QueryOracleProviderPricesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOracleProviderPricesRequest) ProtoMessage()    {}
 func (*QueryOracleProviderPricesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{27}
+	return fileDescriptor_52f5d6f9962923ad, []int{33}
 }
 func (m *QueryOracleProviderPricesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1279,7 +1538,7 @@ func (m *QueryOracleProviderPricesResponse) Reset()         { *m = QueryOraclePr
 func (m *QueryOracleProviderPricesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOracleProviderPricesResponse) ProtoMessage()    {}
 func (*QueryOracleProviderPricesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{28}
+	return fileDescriptor_52f5d6f9962923ad, []int{34}
 }
 func (m *QueryOracleProviderPricesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1326,7 +1585,7 @@ func (m *QueryOraclePriceRequest) Reset()         { *m = QueryOraclePriceRequest
 func (m *QueryOraclePriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOraclePriceRequest) ProtoMessage()    {}
 func (*QueryOraclePriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{29}
+	return fileDescriptor_52f5d6f9962923ad, []int{35}
 }
 func (m *QueryOraclePriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1390,7 +1649,7 @@ func (m *PricePairState) Reset()         { *m = PricePairState{} }
 func (m *PricePairState) String() string { return proto.CompactTextString(m) }
 func (*PricePairState) ProtoMessage()    {}
 func (*PricePairState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{30}
+	return fileDescriptor_52f5d6f9962923ad, []int{36}
 }
 func (m *PricePairState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1442,7 +1701,7 @@ func (m *QueryOraclePriceResponse) Reset()         { *m = QueryOraclePriceRespon
 func (m *QueryOraclePriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOraclePriceResponse) ProtoMessage()    {}
 func (*QueryOraclePriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{31}
+	return fileDescriptor_52f5d6f9962923ad, []int{37}
 }
 func (m *QueryOraclePriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1481,6 +1740,12 @@ func (m *QueryOraclePriceResponse) GetPricePairState() *PricePairState {
 func init() {
 	proto.RegisterType((*QueryPythPriceRequest)(nil), "injective.oracle.v1beta1.QueryPythPriceRequest")
 	proto.RegisterType((*QueryPythPriceResponse)(nil), "injective.oracle.v1beta1.QueryPythPriceResponse")
+	proto.RegisterType((*QueryCompositeIndexRequest)(nil), "injective.oracle.v1beta1.QueryCompositeIndexRequest")
+	proto.RegisterType((*QueryCompositeIndexResponse)(nil), "injective.oracle.v1beta1.QueryCompositeIndexResponse")
+	proto.RegisterType((*QueryCompositeIndicesRequest)(nil), "injective.oracle.v1beta1.QueryCompositeIndicesRequest")
+	proto.RegisterType((*QueryCompositeIndicesResponse)(nil), "injective.oracle.v1beta1.QueryCompositeIndicesResponse")
+	proto.RegisterType((*QueryExchangeTwapConfigsRequest)(nil), "injective.oracle.v1beta1.QueryExchangeTwapConfigsRequest")
+	proto.RegisterType((*QueryExchangeTwapConfigsResponse)(nil), "injective.oracle.v1beta1.QueryExchangeTwapConfigsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "injective.oracle.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "injective.oracle.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryBandRelayersRequest)(nil), "injective.oracle.v1beta1.QueryBandRelayersRequest")
@@ -1518,111 +1783,126 @@ func init() {
 }
 
 var fileDescriptor_52f5d6f9962923ad = []byte{
-	// 1657 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xdf, 0x6f, 0xd3, 0xd6,
-	0x17, 0xaf, 0xfb, 0x8b, 0xe6, 0x04, 0xda, 0x72, 0x1b, 0x4a, 0x31, 0x90, 0x06, 0x43, 0x7f, 0xf0,
-	0x85, 0xc6, 0x34, 0x7c, 0x37, 0x18, 0x63, 0x48, 0xb4, 0xc0, 0x56, 0x46, 0xd5, 0xce, 0xb0, 0x1f,
-	0xda, 0x4b, 0x74, 0xe3, 0xdc, 0x26, 0x1e, 0x89, 0xaf, 0xb1, 0x9d, 0x42, 0x84, 0xd0, 0xa4, 0x3d,
-	0x4e, 0x93, 0x36, 0x69, 0x4f, 0x93, 0xb6, 0xf7, 0x69, 0x6f, 0x7b, 0xd8, 0xc3, 0x5e, 0x27, 0x4d,
-	0x62, 0x6f, 0x4c, 0xd3, 0xa4, 0x89, 0x07, 0x34, 0xc1, 0xfe, 0x90, 0xc9, 0xf7, 0x5e, 0xbb, 0x76,
-	0x63, 0xc7, 0x49, 0xa4, 0x3d, 0x35, 0xbe, 0x3e, 0xe7, 0x73, 0x3e, 0xe7, 0xf8, 0x9c, 0x63, 0x7f,
-	0x54, 0x38, 0x63, 0x98, 0x9f, 0x10, 0xdd, 0x35, 0x76, 0x89, 0x4a, 0x6d, 0xac, 0x37, 0x88, 0xba,
-	0xbb, 0x5a, 0x21, 0x2e, 0x5e, 0x55, 0x1f, 0xb4, 0x88, 0xdd, 0x2e, 0x5a, 0x36, 0x75, 0x29, 0x9a,
-	0x0b, 0xac, 0x8a, 0xdc, 0xaa, 0x28, 0xac, 0xe4, 0x13, 0x35, 0x4a, 0x6b, 0x0d, 0xa2, 0x62, 0xcb,
-	0x50, 0xb1, 0x69, 0x52, 0x17, 0xbb, 0x06, 0x35, 0x1d, 0xee, 0x27, 0x2f, 0x24, 0xa2, 0x0b, 0x18,
-	0x6e, 0xb6, 0x98, 0x68, 0x56, 0x23, 0x26, 0x71, 0x0c, 0x1f, 0x2e, 0x57, 0xa3, 0x35, 0xca, 0x7e,
-	0xaa, 0xde, 0x2f, 0x7e, 0xaa, 0x94, 0xe0, 0xc8, 0x7b, 0x1e, 0xd7, 0xed, 0xb6, 0x5b, 0xdf, 0xb6,
-	0x0d, 0x9d, 0x68, 0xe4, 0x41, 0x8b, 0x38, 0x2e, 0x3a, 0x06, 0x13, 0x96, 0x77, 0x5d, 0x36, 0xaa,
-	0x73, 0x52, 0x41, 0x5a, 0xce, 0x68, 0x07, 0xd8, 0xf5, 0x46, 0x55, 0xd1, 0x61, 0x76, 0xbf, 0x8f,
-	0x63, 0x51, 0xd3, 0x21, 0x68, 0x03, 0xb2, 0xdc, 0xc9, 0x71, 0xb1, 0x4b, 0x98, 0x5f, 0xb6, 0xb4,
-	0x5c, 0x4c, 0x2a, 0x40, 0x31, 0x40, 0xb8, 0xeb, 0xd9, 0x6b, 0x60, 0x05, 0xbf, 0x95, 0x1c, 0x20,
-	0x1e, 0x04, 0xdb, 0xb8, 0xe9, 0x08, 0x56, 0xca, 0xfb, 0x30, 0x13, 0x39, 0x15, 0x71, 0xaf, 0xc1,
-	0xb8, 0xc5, 0x4e, 0x44, 0xc8, 0x42, 0x97, 0x90, 0xcc, 0x6e, 0x6d, 0xf4, 0xe9, 0x8b, 0xf9, 0x21,
-	0x4d, 0x78, 0x29, 0x32, 0xcc, 0x31, 0xd8, 0x35, 0x6c, 0x56, 0x35, 0xd2, 0xc0, 0x6d, 0x62, 0x07,
-	0x21, 0x2f, 0xc1, 0xb1, 0x98, 0x7b, 0x22, 0xb0, 0x0c, 0x13, 0xb6, 0x38, 0x9b, 0x93, 0x0a, 0x23,
-	0xcb, 0x19, 0x2d, 0xb8, 0x56, 0x4e, 0xc2, 0xf1, 0xc0, 0x71, 0x2f, 0xc9, 0x00, 0xf7, 0x3e, 0x9c,
-	0x88, 0xbf, 0x2d, 0xa0, 0xdf, 0x85, 0x83, 0xa1, 0x5a, 0x72, 0xf8, 0xae, 0xc5, 0x8c, 0x02, 0x69,
-	0xd9, 0xbd, 0x62, 0x3a, 0x4a, 0x01, 0xf2, 0x41, 0xb0, 0x8d, 0xb5, 0xf5, 0x18, 0x3a, 0x26, 0xcc,
-	0x27, 0x5a, 0xfc, 0x17, 0x8c, 0x14, 0x28, 0xf0, 0x27, 0xe9, 0x9d, 0xdd, 0x22, 0x24, 0xae, 0x44,
-	0x16, 0x9c, 0xea, 0x62, 0x33, 0x28, 0xab, 0x00, 0x2d, 0x86, 0xd5, 0x29, 0x51, 0x85, 0x75, 0x6a,
-	0x98, 0x15, 0xec, 0x90, 0x18, 0x52, 0x8e, 0x20, 0x1e, 0x6b, 0x22, 0x38, 0x6d, 0xc5, 0x72, 0x3a,
-	0x9f, 0xcc, 0xa9, 0x13, 0x2c, 0xca, 0xcb, 0xef, 0xa5, 0xe8, 0xc0, 0x74, 0xf4, 0x52, 0xc7, 0xed,
-	0x81, 0x6b, 0x14, 0x1d, 0xcc, 0x08, 0x97, 0x7b, 0xa2, 0x97, 0xb6, 0x6d, 0xba, 0x6b, 0x54, 0x89,
-	0x1d, 0xb2, 0x13, 0xbb, 0x43, 0xf6, 0x76, 0x07, 0xbf, 0x29, 0x76, 0x47, 0x70, 0x8d, 0x66, 0x61,
-	0xdc, 0x69, 0x37, 0x2b, 0xb4, 0x31, 0x37, 0xcc, 0xee, 0x88, 0x2b, 0xa5, 0x2e, 0x2a, 0x1f, 0x87,
-	0x2a, 0xb2, 0xb8, 0x19, 0xb7, 0x5d, 0xce, 0xa4, 0x3c, 0xe8, 0xce, 0xcd, 0x72, 0x0c, 0x8e, 0xb2,
-	0x48, 0x9b, 0xb4, 0xda, 0x6a, 0x44, 0x88, 0x2b, 0x1f, 0x89, 0x3d, 0x10, 0xb9, 0x25, 0xa2, 0x5f,
-	0x85, 0xb1, 0x70, 0xdc, 0xc5, 0xe4, 0xb8, 0x6f, 0xf3, 0xbd, 0xcb, 0xdd, 0xb9, 0x93, 0xf2, 0x29,
-	0x28, 0x0c, 0xf9, 0x1d, 0xc3, 0x71, 0xa9, 0x6d, 0xe8, 0xb8, 0x21, 0x36, 0xa7, 0x4e, 0xed, 0xaa,
-	0xff, 0x1c, 0xd1, 0x55, 0x18, 0xe7, 0x58, 0x2c, 0xc8, 0x64, 0xb7, 0xe4, 0xb6, 0xd8, 0xe5, 0xbd,
-	0xb6, 0x45, 0x34, 0xe1, 0x83, 0x8e, 0x43, 0x86, 0x17, 0xd3, 0xdb, 0xd9, 0xbc, 0xba, 0x13, 0xfc,
-	0x60, 0xa3, 0xaa, 0xd8, 0x70, 0xba, 0x2b, 0x81, 0xa0, 0x53, 0x0e, 0xf1, 0x1a, 0xdb, 0xfc, 0x86,
-	0x68, 0x95, 0xc5, 0x94, 0x2a, 0xfb, 0x30, 0xbc, 0xcd, 0xc4, 0x95, 0xf2, 0xb9, 0x04, 0x39, 0xce,
-	0x93, 0x47, 0x6d, 0x6f, 0x59, 0xec, 0x05, 0x87, 0x8e, 0xc2, 0x81, 0x26, 0x7e, 0x54, 0xc6, 0x35,
-	0x9e, 0xe8, 0xa8, 0x36, 0xde, 0xc4, 0x8f, 0xae, 0xd7, 0x08, 0x2a, 0xc2, 0x8c, 0x61, 0xea, 0x8d,
-	0x56, 0x95, 0x94, 0x6d, 0xfc, 0xb0, 0x5c, 0xe7, 0x6e, 0x2c, 0x99, 0x09, 0xed, 0xb0, 0xb8, 0xa5,
-	0xe1, 0x87, 0x02, 0x0f, 0x9d, 0x85, 0x69, 0xdf, 0xbe, 0x49, 0x5c, 0x5c, 0xc5, 0x2e, 0x9e, 0x1b,
-	0x61, 0xc6, 0x53, 0xe2, 0x7c, 0x53, 0x1c, 0x2b, 0x5f, 0x0c, 0x8b, 0x21, 0xe1, 0x8c, 0x3e, 0xa0,
-	0x0d, 0xec, 0x1a, 0x0d, 0xc3, 0x6d, 0xfb, 0xc5, 0xbf, 0x0e, 0x19, 0x6f, 0x04, 0xcb, 0x86, 0xb9,
-	0x43, 0xd3, 0x9b, 0x8b, 0xa3, 0x6c, 0x98, 0x3b, 0x54, 0x9b, 0xf0, 0xdc, 0xbc, 0x5f, 0x68, 0x1d,
-	0xe0, 0x41, 0x8b, 0xba, 0x02, 0x63, 0xb8, 0x0f, 0x8c, 0x0c, 0xf3, 0x63, 0x20, 0x55, 0x98, 0xe5,
-	0x76, 0x7e, 0xfa, 0x65, 0xca, 0xcb, 0xc6, 0x32, 0xcb, 0x96, 0x8a, 0x69, 0x80, 0xd1, 0x62, 0x6b,
-	0x39, 0x1a, 0x73, 0xea, 0x95, 0xe3, 0x64, 0x42, 0x39, 0x44, 0x2b, 0xdc, 0x06, 0xd8, 0x0d, 0x4e,
-	0xf9, 0x1c, 0xaf, 0xfd, 0xef, 0xf9, 0x8b, 0xf9, 0xc5, 0x9a, 0xe1, 0xd6, 0x5b, 0x95, 0xa2, 0x4e,
-	0x9b, 0xaa, 0x4e, 0x9d, 0x26, 0x75, 0xc4, 0x9f, 0x15, 0xa7, 0x7a, 0x5f, 0x75, 0xdb, 0x16, 0x71,
-	0x8a, 0x37, 0x88, 0xae, 0x85, 0xbc, 0xd1, 0x87, 0x30, 0xed, 0x27, 0x13, 0x3c, 0x27, 0x5e, 0x9e,
-	0x2e, 0x4b, 0xd1, 0x7f, 0x74, 0xde, 0x20, 0x19, 0x8e, 0x6b, 0xe8, 0x8e, 0x36, 0x25, 0x50, 0xfc,
-	0x5b, 0xe8, 0x16, 0x64, 0xc3, 0x8d, 0x32, 0xc2, 0xba, 0x75, 0xa1, 0xa7, 0x6e, 0xd5, 0xc0, 0x0e,
-	0x1a, 0x29, 0x58, 0xfc, 0xbc, 0x1a, 0xfe, 0x12, 0x72, 0xd8, 0xb3, 0x11, 0xcb, 0xa1, 0x2e, 0x16,
-	0x7f, 0xac, 0x89, 0xa8, 0xd9, 0x0d, 0xc8, 0xf8, 0x9b, 0xae, 0xa7, 0xd1, 0xe1, 0xa6, 0xbc, 0x03,
-	0x02, 0x47, 0xe5, 0x5a, 0x6c, 0x24, 0x46, 0xdd, 0xe9, 0x61, 0xc7, 0x2a, 0xb6, 0x78, 0x6f, 0xc6,
-	0xfb, 0x0b, 0xaa, 0x9b, 0xde, 0xa4, 0xf3, 0x3b, 0x77, 0xc5, 0x5e, 0xf3, 0xe8, 0x2e, 0xa5, 0xd3,
-	0xe5, 0x8b, 0x2d, 0xea, 0xed, 0xcd, 0xfa, 0xd1, 0x48, 0xd0, 0xd0, 0xb7, 0xe4, 0x4d, 0xc8, 0x8a,
-	0x8e, 0xf6, 0xba, 0xa3, 0xaf, 0xdd, 0x06, 0x34, 0xf8, 0x8d, 0x10, 0x8c, 0x7a, 0x93, 0x26, 0x56,
-	0x1b, 0xfb, 0x8d, 0x72, 0x30, 0xc6, 0x26, 0x87, 0xcd, 0x46, 0x46, 0xe3, 0x17, 0xca, 0x37, 0xa3,
-	0x30, 0xc9, 0x18, 0x6c, 0x63, 0x83, 0xf3, 0x43, 0x9b, 0x00, 0x16, 0x36, 0xec, 0x32, 0x5b, 0x50,
-	0xa2, 0x9b, 0x8b, 0xde, 0x47, 0x60, 0x1f, 0x1d, 0x9d, 0xf1, 0x10, 0x18, 0xae, 0x07, 0xc7, 0x96,
-	0x05, 0x87, 0x1b, 0x1e, 0x0c, 0x2e, 0x78, 0xe3, 0xa3, 0x2d, 0xc8, 0xf2, 0xc5, 0xc1, 0xf1, 0x46,
-	0x06, 0xc2, 0xe3, 0xbb, 0x87, 0x03, 0x56, 0xe0, 0x08, 0xe3, 0xa7, 0xb7, 0x9a, 0x2d, 0x6f, 0x0a,
-	0x77, 0x7d, 0xe8, 0xd1, 0x81, 0xa0, 0x67, 0x3c, 0xb0, 0xf5, 0x00, 0x8b, 0xc7, 0xa8, 0xc2, 0x2c,
-	0x27, 0xdd, 0x11, 0x64, 0x6c, 0xa0, 0x20, 0x39, 0x86, 0xb6, 0x3f, 0xca, 0x02, 0x4c, 0xb2, 0x4c,
-	0x5c, 0xa3, 0x49, 0x1c, 0x17, 0x37, 0xad, 0xb9, 0xf1, 0x82, 0xb4, 0x3c, 0xa2, 0x1d, 0xf2, 0x4e,
-	0xef, 0xf9, 0x87, 0x68, 0x09, 0xa6, 0x38, 0x99, 0x3d, 0xbb, 0x03, 0xcc, 0x6e, 0x92, 0x1d, 0x07,
-	0x86, 0x8a, 0x29, 0xde, 0xf1, 0x91, 0x3e, 0x15, 0x33, 0xa1, 0xc1, 0x34, 0x7f, 0xfb, 0xb1, 0x56,
-	0xe9, 0x55, 0xc4, 0x44, 0x1a, 0x4d, 0x9b, 0xb4, 0x22, 0xd7, 0xa5, 0xe7, 0x47, 0x60, 0x8c, 0x05,
-	0x44, 0x5f, 0x4a, 0x30, 0xce, 0xe5, 0x07, 0xea, 0xb2, 0xf5, 0x3a, 0x55, 0x8f, 0xbc, 0xd2, 0xa3,
-	0x35, 0xcf, 0x42, 0x59, 0xfe, 0xec, 0x8f, 0x7f, 0xbe, 0x1e, 0x56, 0x50, 0x41, 0x4d, 0x94, 0x86,
-	0x5c, 0xf7, 0xa0, 0xef, 0x25, 0x38, 0x18, 0xd6, 0x35, 0xa8, 0x94, 0x12, 0x29, 0x46, 0x20, 0xc9,
-	0x17, 0xfb, 0xf2, 0x11, 0x1c, 0x55, 0xc6, 0xf1, 0x2c, 0x5a, 0x4a, 0xe6, 0x58, 0xc1, 0x66, 0xb5,
-	0xec, 0xab, 0x29, 0xf4, 0x93, 0x04, 0x53, 0xfb, 0xa4, 0x12, 0x7a, 0xad, 0x87, 0xc8, 0x9d, 0x5f,
-	0xcb, 0xf2, 0xeb, 0xfd, 0xba, 0x09, 0xce, 0x17, 0x19, 0xe7, 0x15, 0x74, 0x2e, 0x85, 0x73, 0xf8,
-	0x53, 0x1b, 0xfd, 0x22, 0x01, 0xea, 0xd4, 0x54, 0xe8, 0x72, 0x0f, 0x1c, 0x62, 0x85, 0x9a, 0xfc,
-	0xc6, 0x00, 0x9e, 0x22, 0x81, 0x4b, 0x2c, 0x81, 0x55, 0xa4, 0xa6, 0x24, 0x60, 0x54, 0xf4, 0x68,
-	0x12, 0xbf, 0x49, 0x90, 0x8b, 0x13, 0x61, 0xe8, 0x4a, 0x5a, 0x67, 0x26, 0xab, 0x3b, 0xf9, 0xcd,
-	0x81, 0x7c, 0x45, 0x2a, 0x97, 0x59, 0x2a, 0x25, 0x74, 0xa1, 0x4b, 0x8f, 0x7b, 0x6e, 0x3b, 0x84,
-	0xec, 0x7b, 0x20, 0xbf, 0x4a, 0x30, 0x13, 0xa3, 0xdd, 0x50, 0x5a, 0x5d, 0x93, 0x25, 0xa1, 0x7c,
-	0x65, 0x10, 0xd7, 0xde, 0x9f, 0x89, 0x2e, 0xdc, 0xa3, 0x79, 0x78, 0x03, 0xb1, 0x4f, 0xef, 0xa5,
-	0x0e, 0x44, 0xbc, 0x7c, 0x4c, 0x1d, 0x88, 0x04, 0x59, 0xd9, 0xcb, 0x40, 0x58, 0x6d, 0xb7, 0x1e,
-	0xe5, 0xfd, 0xa7, 0x04, 0xa8, 0x53, 0xe4, 0xa5, 0x0e, 0x44, 0xa2, 0xda, 0x4c, 0x1d, 0x88, 0x64,
-	0x45, 0xa9, 0xdc, 0x66, 0x09, 0xdc, 0x40, 0x6b, 0xdd, 0xba, 0x88, 0x7b, 0x87, 0x93, 0x50, 0x1f,
-	0xfb, 0xa7, 0x4f, 0xd4, 0xc7, 0x5c, 0x61, 0x3d, 0x41, 0x3f, 0x48, 0x70, 0x98, 0xbf, 0x53, 0x42,
-	0xea, 0x11, 0xad, 0xa6, 0x90, 0xeb, 0x14, 0xa1, 0x72, 0xa9, 0x1f, 0x17, 0x91, 0x48, 0x91, 0x25,
-	0xb2, 0x8c, 0x16, 0x93, 0x13, 0x69, 0x32, 0x37, 0x9e, 0x00, 0xfa, 0x5d, 0x82, 0xd9, 0x78, 0x25,
-	0x88, 0xae, 0xa6, 0x84, 0xef, 0xaa, 0x60, 0xe5, 0xb7, 0x06, 0xf4, 0x16, 0x79, 0x5c, 0x61, 0x79,
-	0xfc, 0x1f, 0x95, 0x92, 0xf3, 0xa8, 0x07, 0x08, 0xe5, 0x88, 0x52, 0x45, 0x3f, 0x4a, 0x30, 0xbd,
-	0x5f, 0xcc, 0xa0, 0xb4, 0xd6, 0x4e, 0x10, 0x83, 0xf2, 0xa5, 0xbe, 0xfd, 0x44, 0x06, 0xe7, 0x59,
-	0x06, 0x8b, 0xe8, 0x4c, 0x72, 0x06, 0x21, 0x5d, 0xf4, 0xb3, 0x04, 0x33, 0x31, 0x7a, 0x22, 0x75,
-	0x19, 0x25, 0xcb, 0x94, 0xd4, 0x65, 0xd4, 0x45, 0xbe, 0x28, 0xe7, 0x18, 0xf9, 0x05, 0x74, 0x3a,
-	0x7d, 0x1e, 0xd8, 0x9b, 0x2d, 0x17, 0xa7, 0x30, 0x50, 0x7f, 0x0c, 0x22, 0xb2, 0x26, 0xf5, 0xa5,
-	0xd0, 0x4d, 0xd2, 0x28, 0xab, 0x8c, 0xfe, 0x39, 0x74, 0xb6, 0xd7, 0x71, 0x76, 0xd0, 0x77, 0x12,
-	0x64, 0x43, 0x5f, 0x82, 0xa9, 0xf3, 0xda, 0xa9, 0x6e, 0x52, 0xe7, 0x35, 0xe6, 0x43, 0x53, 0x59,
-	0x62, 0x4c, 0x4f, 0xa1, 0xf9, 0x94, 0xd7, 0x17, 0xfa, 0x56, 0x82, 0x4c, 0xb0, 0x7e, 0x91, 0xda,
-	0xeb, 0xa2, 0xf6, 0xb9, 0x5d, 0xe8, 0xdd, 0xa1, 0xf7, 0xfe, 0xdd, 0xdb, 0xe9, 0x6b, 0x3b, 0x4f,
-	0x5f, 0xe6, 0xa5, 0x67, 0x2f, 0xf3, 0xd2, 0xdf, 0x2f, 0xf3, 0xd2, 0x57, 0xaf, 0xf2, 0x43, 0xcf,
-	0x5e, 0xe5, 0x87, 0xfe, 0x7a, 0x95, 0x1f, 0xfa, 0xf8, 0x4e, 0xe8, 0xa3, 0x7f, 0xc3, 0x47, 0xba,
-	0x83, 0x2b, 0xce, 0x1e, 0xee, 0x8a, 0x4e, 0x6d, 0x12, 0xbe, 0xac, 0x63, 0xc3, 0x14, 0x7b, 0xca,
-	0xf1, 0x83, 0x32, 0x79, 0x50, 0x19, 0x67, 0xff, 0xad, 0xb8, 0xf8, 0x6f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xe1, 0xe8, 0xd9, 0x82, 0x72, 0x19, 0x00, 0x00,
+	// 1889 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4b, 0x6f, 0xdb, 0xc6,
+	0x16, 0x36, 0xfd, 0xd6, 0x51, 0x62, 0x3b, 0x63, 0xc5, 0x51, 0x98, 0x44, 0x96, 0x99, 0xf8, 0x91,
+	0x9b, 0x58, 0x8a, 0x95, 0x87, 0x13, 0x27, 0x37, 0x40, 0x64, 0x27, 0xf7, 0x3a, 0x37, 0x86, 0x7d,
+	0x19, 0xdf, 0x07, 0xba, 0x11, 0x28, 0x6a, 0x2c, 0x31, 0x91, 0x48, 0x86, 0xa4, 0x6c, 0x0b, 0x41,
+	0x50, 0xa0, 0xcb, 0xa2, 0x40, 0x03, 0x74, 0x55, 0xa0, 0xdd, 0x17, 0x5d, 0xb5, 0x8b, 0x02, 0xed,
+	0xb6, 0x40, 0x81, 0x74, 0x97, 0xa2, 0x28, 0x50, 0x74, 0x11, 0x14, 0x49, 0x7f, 0x48, 0xc1, 0x99,
+	0x21, 0x4d, 0x4a, 0xa4, 0x48, 0x09, 0xe8, 0xca, 0xe2, 0xcc, 0x7c, 0xdf, 0xf9, 0xce, 0xf0, 0x9c,
+	0x33, 0x73, 0x4c, 0xb8, 0xa0, 0xa8, 0x4f, 0xb0, 0x6c, 0x29, 0xfb, 0x38, 0xaf, 0x19, 0x92, 0x5c,
+	0xc7, 0xf9, 0xfd, 0x95, 0x32, 0xb6, 0xa4, 0x95, 0xfc, 0xb3, 0x26, 0x36, 0x5a, 0x39, 0xdd, 0xd0,
+	0x2c, 0x0d, 0xa5, 0xdd, 0x55, 0x39, 0xba, 0x2a, 0xc7, 0x56, 0xf1, 0x67, 0xab, 0x9a, 0x56, 0xad,
+	0xe3, 0xbc, 0xa4, 0x2b, 0x79, 0x49, 0x55, 0x35, 0x4b, 0xb2, 0x14, 0x4d, 0x35, 0x29, 0x8e, 0x9f,
+	0x0f, 0x65, 0x67, 0x34, 0x74, 0xd9, 0x42, 0xe8, 0xb2, 0x2a, 0x56, 0xb1, 0xa9, 0x38, 0x74, 0xa9,
+	0xaa, 0x56, 0xd5, 0xc8, 0xcf, 0xbc, 0xfd, 0x8b, 0x8e, 0x0a, 0x05, 0x38, 0xf9, 0x6f, 0x5b, 0xeb,
+	0x4e, 0xcb, 0xaa, 0xed, 0x18, 0x8a, 0x8c, 0x45, 0xfc, 0xac, 0x89, 0x4d, 0x0b, 0x9d, 0x86, 0x71,
+	0xdd, 0x7e, 0x2e, 0x29, 0x95, 0x34, 0x97, 0xe5, 0x96, 0x12, 0xe2, 0x18, 0x79, 0xde, 0xac, 0x08,
+	0x32, 0xcc, 0xb4, 0x63, 0x4c, 0x5d, 0x53, 0x4d, 0x8c, 0x36, 0x21, 0x49, 0x41, 0xa6, 0x25, 0x59,
+	0x98, 0xe0, 0x92, 0x85, 0xa5, 0x5c, 0xd8, 0x06, 0xe4, 0x5c, 0x86, 0xc7, 0xf6, 0x7a, 0x11, 0x74,
+	0xf7, 0xb7, 0x70, 0x0d, 0x78, 0x62, 0x64, 0x5d, 0x6b, 0xe8, 0x9a, 0xa9, 0x58, 0x78, 0x53, 0xad,
+	0xe0, 0x43, 0x47, 0xdd, 0x0c, 0x8c, 0x9a, 0xad, 0x46, 0x59, 0xab, 0x33, 0x6d, 0xec, 0x49, 0xf8,
+	0x68, 0x10, 0xce, 0x04, 0xc2, 0x98, 0xc0, 0xbb, 0x30, 0xa2, 0xd8, 0x03, 0xd1, 0xd2, 0xda, 0x08,
+	0x28, 0x0c, 0x55, 0x61, 0x4a, 0xb6, 0x27, 0x54, 0xac, 0x5a, 0x25, 0xa2, 0xd6, 0x4c, 0x0f, 0x66,
+	0x87, 0x96, 0x92, 0x85, 0x1b, 0x71, 0xa9, 0xd6, 0x1d, 0x3c, 0x71, 0xbc, 0x38, 0xfc, 0xea, 0xcd,
+	0xec, 0x80, 0x38, 0x29, 0xfb, 0x46, 0x4d, 0xb4, 0x01, 0x23, 0x84, 0x3e, 0x3d, 0x64, 0xfb, 0x57,
+	0xcc, 0xbd, 0x7a, 0x33, 0xcb, 0xfd, 0xf6, 0x66, 0x76, 0xa1, 0xaa, 0x58, 0xb5, 0x66, 0x39, 0x27,
+	0x6b, 0x8d, 0xbc, 0xac, 0x99, 0x0d, 0xcd, 0x64, 0x7f, 0x96, 0xcd, 0xca, 0xd3, 0xbc, 0xd5, 0xd2,
+	0xb1, 0x99, 0xdb, 0xc0, 0xb2, 0x48, 0xc1, 0x42, 0x06, 0xce, 0x76, 0xec, 0x86, 0x4d, 0xcf, 0xb6,
+	0x51, 0x90, 0xe1, 0x5c, 0xc8, 0x3c, 0xdb, 0xaf, 0x22, 0x8c, 0x29, 0x74, 0x28, 0xcd, 0x65, 0x87,
+	0x7a, 0xda, 0x31, 0x07, 0x28, 0xcc, 0xc1, 0x2c, 0x31, 0x72, 0xff, 0x50, 0xae, 0x49, 0x6a, 0x15,
+	0xef, 0x1e, 0x48, 0xfa, 0xba, 0xa6, 0xee, 0x29, 0x55, 0x57, 0xc7, 0x13, 0xc8, 0x86, 0x2f, 0x61,
+	0x52, 0x1e, 0xc0, 0x98, 0x4c, 0x87, 0x98, 0x94, 0xcb, 0xe1, 0x52, 0x3a, 0x79, 0x44, 0x07, 0x2c,
+	0xa4, 0x00, 0xd1, 0xe8, 0x95, 0x0c, 0xa9, 0xe1, 0x2a, 0xf8, 0x0f, 0x4c, 0xfb, 0x46, 0xdd, 0x78,
+	0x19, 0xd5, 0xc9, 0x08, 0x0b, 0x98, 0x6c, 0x97, 0x58, 0x26, 0xeb, 0xd8, 0xfb, 0x64, 0x28, 0x81,
+	0x87, 0x34, 0xa1, 0x2d, 0x4a, 0x6a, 0x45, 0xc4, 0x75, 0xa9, 0x85, 0x0d, 0xd7, 0xe4, 0x2a, 0x9c,
+	0x0e, 0x98, 0x63, 0x86, 0x79, 0x18, 0x37, 0xd8, 0x18, 0x71, 0x37, 0x21, 0xba, 0xcf, 0xc2, 0x39,
+	0x38, 0xe3, 0x02, 0x8f, 0xb2, 0xc7, 0xe5, 0x7d, 0x0a, 0x67, 0x83, 0xa7, 0x19, 0xf5, 0xbf, 0xe0,
+	0x98, 0x27, 0x49, 0x63, 0xbc, 0x58, 0x3f, 0x91, 0x98, 0x3c, 0xca, 0x52, 0x53, 0xc8, 0x42, 0xc6,
+	0x35, 0xb6, 0x59, 0x5c, 0x0f, 0x90, 0xa3, 0xc2, 0x6c, 0xe8, 0x8a, 0xbf, 0x42, 0x91, 0xc0, 0x62,
+	0x89, 0xcc, 0x3f, 0xc0, 0x38, 0x68, 0x8b, 0x74, 0x98, 0xeb, 0xb2, 0xa6, 0x5f, 0x55, 0x2e, 0x5b,
+	0x80, 0x2a, 0x27, 0x09, 0xd6, 0x35, 0x45, 0x2d, 0x4b, 0x26, 0x0e, 0x10, 0x65, 0x42, 0x36, 0x7c,
+	0x09, 0xd3, 0xb4, 0x1d, 0xa8, 0xe9, 0x72, 0xb7, 0xa4, 0x6c, 0x27, 0xf3, 0xeb, 0x72, 0x62, 0xc9,
+	0x5f, 0x89, 0x3b, 0x62, 0xa9, 0x63, 0xba, 0xef, 0x3d, 0xf2, 0x57, 0x7c, 0x9f, 0x96, 0x5d, 0x16,
+	0x4b, 0x3b, 0x86, 0xb6, 0xaf, 0x54, 0xb0, 0xe1, 0x59, 0xc7, 0xca, 0x3e, 0x6f, 0x1f, 0x4a, 0x74,
+	0x92, 0x15, 0x7e, 0xf7, 0xd9, 0x73, 0x24, 0x0c, 0xfa, 0x8e, 0x84, 0x1a, 0xcc, 0x86, 0xb2, 0x32,
+	0x2f, 0xee, 0x07, 0x1d, 0x5b, 0x17, 0x22, 0x5e, 0x74, 0xe7, 0x91, 0x75, 0x1a, 0x4e, 0x11, 0x4b,
+	0x5b, 0x5a, 0xa5, 0x59, 0xf7, 0x09, 0x17, 0xfe, 0x0f, 0xe9, 0xce, 0x29, 0x66, 0xfd, 0x0e, 0x8c,
+	0x78, 0xed, 0x2e, 0x84, 0xdb, 0xfd, 0x07, 0x3d, 0xd0, 0x29, 0x9c, 0x82, 0x84, 0xf7, 0x41, 0x20,
+	0xcc, 0xff, 0x54, 0x4c, 0x4b, 0x33, 0x14, 0x59, 0xaa, 0xb3, 0x23, 0x59, 0xd6, 0x8c, 0x8a, 0xf3,
+	0x1e, 0xd1, 0x1d, 0x18, 0xa5, 0x5c, 0xc4, 0xc8, 0x44, 0x37, 0xe7, 0xb6, 0xc9, 0xe3, 0x6e, 0x4b,
+	0xc7, 0x22, 0xc3, 0xa0, 0x33, 0x90, 0xa0, 0x9b, 0x69, 0x5f, 0x06, 0xe8, 0xee, 0x8e, 0xd3, 0x81,
+	0xcd, 0x8a, 0x60, 0xc0, 0xf9, 0xae, 0x02, 0xdc, 0x48, 0x39, 0x4e, 0xf7, 0xd8, 0xa0, 0x13, 0x2c,
+	0x54, 0x16, 0x22, 0x76, 0xd9, 0xa1, 0x39, 0xa6, 0x7b, 0x9e, 0x84, 0x0f, 0x39, 0x48, 0x51, 0x9d,
+	0xd4, 0x6a, 0x6b, 0x5b, 0x27, 0x37, 0x27, 0x74, 0x0a, 0xc6, 0x1a, 0xd2, 0x61, 0x49, 0xaa, 0x52,
+	0x47, 0x87, 0xc5, 0xd1, 0x86, 0x74, 0x78, 0xaf, 0x8a, 0x51, 0x0e, 0xa6, 0x15, 0x55, 0xae, 0x37,
+	0x2b, 0xb8, 0x64, 0x48, 0x07, 0xa5, 0x1a, 0x85, 0x11, 0x67, 0xc6, 0xc5, 0x13, 0x6c, 0x4a, 0x94,
+	0x0e, 0x18, 0x1f, 0xba, 0x08, 0x53, 0xce, 0xfa, 0x06, 0xb6, 0xa4, 0x8a, 0x64, 0x49, 0xe4, 0x28,
+	0x1e, 0x17, 0x27, 0xd9, 0xf8, 0x16, 0x1b, 0xb6, 0xef, 0x1c, 0x34, 0x49, 0xa8, 0xa2, 0xff, 0x6a,
+	0x75, 0xc9, 0x52, 0xea, 0x8a, 0xd5, 0x72, 0x36, 0xff, 0x1e, 0x24, 0xec, 0x14, 0x2c, 0x29, 0xea,
+	0x9e, 0x16, 0x1d, 0x5c, 0x94, 0x65, 0x53, 0xdd, 0xd3, 0xc4, 0x71, 0x1b, 0x66, 0xff, 0x42, 0xeb,
+	0x00, 0xcf, 0x9a, 0x9a, 0xc5, 0x38, 0x06, 0x7b, 0xe0, 0x48, 0x10, 0x1c, 0x21, 0xa9, 0xc0, 0x0c,
+	0x5d, 0xe7, 0xb8, 0x5f, 0xd2, 0xe8, 0xb6, 0x11, 0xcf, 0x92, 0x85, 0x5c, 0x14, 0xa1, 0x7f, 0xb3,
+	0xc5, 0x94, 0x16, 0x30, 0x6a, 0x6f, 0xc7, 0xb9, 0x90, 0xed, 0x60, 0xa1, 0xf0, 0x10, 0x60, 0xdf,
+	0x1d, 0xa5, 0x79, 0x5c, 0xfc, 0x5b, 0x0f, 0x97, 0x1b, 0x0f, 0x1a, 0xfd, 0x0f, 0xa6, 0x1c, 0x67,
+	0xdc, 0xf7, 0x44, 0xb7, 0xa7, 0x4b, 0x51, 0x74, 0x5e, 0x9d, 0x9d, 0x48, 0x8a, 0x69, 0x29, 0xb2,
+	0x29, 0x4e, 0x32, 0x16, 0x67, 0x0a, 0x3d, 0x80, 0xa4, 0x37, 0x50, 0x86, 0x48, 0xb4, 0xce, 0xc7,
+	0x8a, 0x56, 0x11, 0x0c, 0x37, 0x90, 0xdc, 0xc2, 0x4f, 0x77, 0xc3, 0x29, 0x42, 0x26, 0x79, 0x37,
+	0xac, 0x38, 0xd4, 0x20, 0x1b, 0xbe, 0x84, 0xed, 0xd9, 0x06, 0x24, 0x9c, 0x4a, 0x17, 0x2b, 0x75,
+	0xe8, 0x52, 0x1a, 0x01, 0x2e, 0x50, 0xb8, 0x1b, 0x68, 0x89, 0x5e, 0x39, 0x63, 0xd4, 0x58, 0xc1,
+	0x80, 0xb9, 0x2e, 0x78, 0x26, 0x75, 0xcb, 0xce, 0x74, 0x3a, 0xf3, 0x98, 0xd5, 0x35, 0x5b, 0xee,
+	0x62, 0xb4, 0x5c, 0x5a, 0xd8, 0xfc, 0x68, 0x3b, 0xd7, 0x4f, 0xf9, 0x8c, 0x7a, 0x9a, 0x94, 0xfb,
+	0x90, 0x64, 0x11, 0x6d, 0x47, 0x47, 0x4f, 0xb5, 0x0d, 0x34, 0xf7, 0x37, 0x42, 0x30, 0x6c, 0x67,
+	0x1a, 0x2b, 0x6d, 0xe4, 0x37, 0x4a, 0xc1, 0x08, 0xc9, 0x1c, 0x7a, 0x01, 0x17, 0xe9, 0x83, 0xf0,
+	0xe9, 0x30, 0x4c, 0x10, 0x05, 0x3b, 0x92, 0x42, 0xf5, 0xa1, 0x2d, 0x00, 0x5d, 0x52, 0x0c, 0xda,
+	0x0d, 0xa4, 0x39, 0xf7, 0xba, 0x3e, 0xd0, 0x43, 0x44, 0x27, 0x6c, 0x06, 0xc2, 0x6b, 0xd3, 0x91,
+	0x62, 0x41, 0xe9, 0x06, 0xfb, 0xa3, 0x73, 0x4f, 0x7c, 0xb4, 0x0d, 0x49, 0x5a, 0x38, 0xda, 0xbb,
+	0x89, 0x5e, 0xf8, 0x68, 0xed, 0xa1, 0x84, 0x65, 0x38, 0x49, 0xf4, 0xc9, 0xcd, 0x46, 0xd3, 0xce,
+	0xc2, 0x7d, 0x87, 0x7a, 0xb8, 0x2f, 0xea, 0x69, 0x9b, 0x6c, 0xdd, 0xe5, 0xa2, 0x36, 0x2a, 0x30,
+	0x43, 0x45, 0x77, 0x18, 0x19, 0xe9, 0xcb, 0x48, 0x8a, 0xb0, 0xb5, 0x5b, 0x99, 0x87, 0x09, 0xe2,
+	0x89, 0xa5, 0x34, 0xb0, 0x69, 0x49, 0x0d, 0x3d, 0x3d, 0x9a, 0xe5, 0x96, 0x86, 0xc4, 0xe3, 0xf6,
+	0xe8, 0xae, 0x33, 0x88, 0x16, 0x61, 0x92, 0x8a, 0x39, 0x5a, 0x37, 0x46, 0xd6, 0x4d, 0x90, 0x61,
+	0x77, 0xa1, 0xa0, 0xb2, 0x33, 0xde, 0x17, 0xa7, 0x2c, 0x27, 0x44, 0x98, 0xa2, 0xa7, 0x1f, 0x09,
+	0x95, 0xb8, 0xdd, 0xb1, 0x2f, 0xd0, 0xc4, 0x09, 0xdd, 0xf7, 0x5c, 0x78, 0xc9, 0xc3, 0x08, 0x31,
+	0x88, 0x3e, 0xe6, 0x60, 0x94, 0xb6, 0x1f, 0xa8, 0x4b, 0xd5, 0xeb, 0xec, 0x7a, 0xf8, 0xe5, 0x98,
+	0xab, 0xa9, 0x17, 0xc2, 0xd2, 0x07, 0x3f, 0xff, 0xf1, 0xc9, 0xa0, 0x80, 0xb2, 0xf9, 0xd0, 0xff,
+	0x39, 0xd0, 0xbe, 0x07, 0x7d, 0xc1, 0xc1, 0x31, 0x6f, 0x5f, 0x83, 0x0a, 0x11, 0x96, 0x02, 0x1a,
+	0x24, 0xfe, 0x6a, 0x4f, 0x18, 0xa6, 0x31, 0x4f, 0x34, 0x5e, 0x44, 0x8b, 0xe1, 0x1a, 0xcb, 0x92,
+	0x5a, 0x29, 0x39, 0xdd, 0x14, 0xfa, 0x86, 0x83, 0xc9, 0xb6, 0x56, 0x09, 0x5d, 0x8f, 0x61, 0xb9,
+	0xf3, 0xb6, 0xcc, 0xdf, 0xe8, 0x15, 0xc6, 0x34, 0x5f, 0x25, 0x9a, 0x97, 0xd1, 0xa5, 0x08, 0xcd,
+	0xde, 0xab, 0x36, 0xfa, 0x9e, 0x03, 0xd4, 0xd9, 0x53, 0xa1, 0x9b, 0x31, 0x34, 0x04, 0x36, 0x6a,
+	0xfc, 0xad, 0x3e, 0x90, 0xcc, 0x81, 0x55, 0xe2, 0xc0, 0x0a, 0xca, 0x47, 0x38, 0xa0, 0x94, 0x65,
+	0xbf, 0x13, 0x3f, 0x72, 0x90, 0x0a, 0x6a, 0xc2, 0xd0, 0x5a, 0x54, 0x64, 0x86, 0x77, 0x77, 0xfc,
+	0xed, 0xbe, 0xb0, 0xcc, 0x95, 0x9b, 0xc4, 0x95, 0x02, 0xba, 0xd2, 0x25, 0xc6, 0x6d, 0xd8, 0x1e,
+	0xc6, 0x6d, 0x2f, 0xe4, 0x07, 0x0e, 0xa6, 0x03, 0x7a, 0x37, 0x14, 0xb5, 0xaf, 0xe1, 0x2d, 0x21,
+	0xbf, 0xd6, 0x0f, 0x34, 0xfe, 0x3b, 0x91, 0x19, 0xdc, 0xef, 0x87, 0x9d, 0x10, 0x6d, 0xfd, 0x5e,
+	0x64, 0x42, 0x04, 0xb7, 0x8f, 0xfc, 0x8d, 0x5e, 0x61, 0xf1, 0x13, 0x42, 0x6f, 0x59, 0x35, 0xbf,
+	0xee, 0x5f, 0x38, 0x40, 0x9d, 0x4d, 0x5e, 0x64, 0x42, 0x84, 0x76, 0x9b, 0xfc, 0xad, 0x3e, 0x90,
+	0xcc, 0x81, 0x87, 0xc4, 0x81, 0x0d, 0x54, 0xec, 0x16, 0x45, 0x14, 0xed, 0x75, 0x22, 0xff, 0xdc,
+	0x19, 0x7d, 0x91, 0x7f, 0x4e, 0x3b, 0xac, 0x17, 0xe8, 0x4b, 0x0e, 0x4e, 0xd0, 0x33, 0xc5, 0xd3,
+	0x3d, 0xa2, 0x95, 0x08, 0x71, 0x9d, 0x4d, 0x28, 0x5f, 0xe8, 0x05, 0xc2, 0x1c, 0xc9, 0x11, 0x47,
+	0x96, 0xd0, 0x42, 0xb8, 0x23, 0x0d, 0x02, 0xa3, 0x0e, 0xa0, 0x9f, 0x38, 0x98, 0x09, 0xee, 0x04,
+	0xd1, 0x9d, 0x08, 0xf3, 0x5d, 0x3b, 0x58, 0xfe, 0xef, 0x7d, 0xa2, 0x99, 0x1f, 0x6b, 0xc4, 0x8f,
+	0x6b, 0xa8, 0x10, 0xee, 0x47, 0xcd, 0x65, 0x28, 0xf9, 0x3a, 0x55, 0xf4, 0x35, 0x07, 0x53, 0xed,
+	0xcd, 0x0c, 0x8a, 0x0a, 0xed, 0x90, 0x66, 0x90, 0x5f, 0xed, 0x19, 0xc7, 0x3c, 0xb8, 0x4c, 0x3c,
+	0x58, 0x40, 0x17, 0xc2, 0x3d, 0xf0, 0xf4, 0x45, 0xdf, 0x71, 0x30, 0x1d, 0xd0, 0x4f, 0x44, 0x16,
+	0xa3, 0xf0, 0x36, 0x85, 0x5f, 0xeb, 0x07, 0xca, 0xc4, 0x5f, 0x22, 0xe2, 0xe7, 0xd1, 0xf9, 0xe8,
+	0x7c, 0x20, 0x27, 0x5b, 0x2a, 0xa8, 0xc3, 0x40, 0xbd, 0x29, 0xf0, 0xb5, 0x35, 0xfc, 0xed, 0xbe,
+	0xb0, 0x4c, 0xfe, 0x0a, 0x91, 0x7f, 0x09, 0x5d, 0x8c, 0x9b, 0xce, 0x26, 0xfa, 0x9c, 0x83, 0xa4,
+	0xe7, 0x26, 0x18, 0x99, 0xaf, 0x9d, 0xdd, 0x0d, 0x5f, 0xe8, 0x05, 0xc2, 0x94, 0x2e, 0x12, 0xa5,
+	0x73, 0x68, 0x36, 0xe2, 0xf8, 0x42, 0x9f, 0x71, 0x90, 0x70, 0xcb, 0x2f, 0xca, 0xc7, 0x2d, 0xd4,
+	0x8e, 0xb6, 0x2b, 0xf1, 0x01, 0xf1, 0xe3, 0xf7, 0xa8, 0xa6, 0xa3, 0xaf, 0x38, 0x98, 0xf0, 0x7f,
+	0x50, 0x40, 0xd7, 0x22, 0x0f, 0xc3, 0x80, 0x2f, 0x45, 0xfc, 0xf5, 0x1e, 0x51, 0xf1, 0xdf, 0xb8,
+	0xec, 0x20, 0x4b, 0xf4, 0xdb, 0xd0, 0xb7, 0x1c, 0x4c, 0xb5, 0x7f, 0x48, 0x89, 0x2c, 0x13, 0x21,
+	0x5f, 0x66, 0xf8, 0xd5, 0x9e, 0x71, 0xf1, 0x8f, 0x4e, 0x9f, 0x70, 0x45, 0x66, 0x57, 0x97, 0x80,
+	0x6f, 0x2f, 0x91, 0xd5, 0x22, 0xfc, 0x93, 0x0e, 0xbf, 0xd6, 0x0f, 0x34, 0xfe, 0xd5, 0x05, 0x33,
+	0x78, 0xc9, 0x3a, 0x90, 0xf4, 0x12, 0xfb, 0xb6, 0x53, 0xdc, 0x7b, 0xf5, 0x36, 0xc3, 0xbd, 0x7e,
+	0x9b, 0xe1, 0x7e, 0x7f, 0x9b, 0xe1, 0x5e, 0xbe, 0xcb, 0x0c, 0xbc, 0x7e, 0x97, 0x19, 0xf8, 0xf5,
+	0x5d, 0x66, 0xe0, 0xbd, 0x47, 0x9e, 0x56, 0x71, 0xd3, 0x21, 0x7d, 0x24, 0x95, 0xcd, 0x23, 0x13,
+	0xcb, 0xb2, 0x66, 0x60, 0xef, 0x63, 0x4d, 0x52, 0x54, 0x76, 0xba, 0x99, 0x8e, 0x7d, 0xd2, 0x54,
+	0x96, 0x47, 0xc9, 0xc7, 0xd3, 0xab, 0x7f, 0x0e, 0x00, 0x72, 0x08, 0x6e, 0xf5, 0x01, 0x1e, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1663,6 +1943,12 @@ type QueryClient interface {
 	OracleProviderPrices(ctx context.Context, in *QueryOracleProviderPricesRequest, opts ...grpc.CallOption) (*QueryOracleProviderPricesResponse, error)
 	OraclePrice(ctx context.Context, in *QueryOraclePriceRequest, opts ...grpc.CallOption) (*QueryOraclePriceResponse, error)
 	PythPrice(ctx context.Context, in *QueryPythPriceRequest, opts ...grpc.CallOption) (*QueryPythPriceResponse, error)
+	// Retrieves the components and weights of a composite index along with their current prices
+	CompositeIndex(ctx context.Context, in *QueryCompositeIndexRequest, opts ...grpc.CallOption) (*QueryCompositeIndexResponse, error)
+	// Retrieves all the composite indices
+	CompositeIndices(ctx context.Context, in *QueryCompositeIndicesRequest, opts ...grpc.CallOption) (*QueryCompositeIndicesResponse, error)
+	// Retrieves all the exchange TWAP configs
+	ExchangeTwapConfigs(ctx context.Context, in *QueryExchangeTwapConfigsRequest, opts ...grpc.CallOption) (*QueryExchangeTwapConfigsResponse, error)
 }
 
 type queryClient struct {
@@ -1808,6 +2094,33 @@ func (c *queryClient) PythPrice(ctx context.Context, in *QueryPythPriceRequest,
 	return out, nil
 }
 
+func (c *queryClient) CompositeIndex(ctx context.Context, in *QueryCompositeIndexRequest, opts ...grpc.CallOption) (*QueryCompositeIndexResponse, error) {
+	out := new(QueryCompositeIndexResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/CompositeIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CompositeIndices(ctx context.Context, in *QueryCompositeIndicesRequest, opts ...grpc.CallOption) (*QueryCompositeIndicesResponse, error) {
+	out := new(QueryCompositeIndicesResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/CompositeIndices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExchangeTwapConfigs(ctx context.Context, in *QueryExchangeTwapConfigsRequest, opts ...grpc.CallOption) (*QueryExchangeTwapConfigsResponse, error) {
+	out := new(QueryExchangeTwapConfigsResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/ExchangeTwapConfigs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Retrieves oracle params
@@ -1836,6 +2149,12 @@ type QueryServer interface {
 	OracleProviderPrices(context.Context, *QueryOracleProviderPricesRequest) (*QueryOracleProviderPricesResponse, error)
 	OraclePrice(context.Context, *QueryOraclePriceRequest) (*QueryOraclePriceResponse, error)
 	PythPrice(context.Context, *QueryPythPriceRequest) (*QueryPythPriceResponse, error)
+	// Retrieves the components and weights of a composite index along with their current prices
+	CompositeIndex(context.Context, *QueryCompositeIndexRequest) (*QueryCompositeIndexResponse, error)
+	// Retrieves all the composite indices
+	CompositeIndices(context.Context, *QueryCompositeIndicesRequest) (*QueryCompositeIndicesResponse, error)
+	// Retrieves all the exchange TWAP configs
+	ExchangeTwapConfigs(context.Context, *QueryExchangeTwapConfigsRequest) (*QueryExchangeTwapConfigsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1887,6 +2206,15 @@ func (*UnimplementedQueryServer) OraclePrice(ctx context.Context, req *QueryOrac
 func (*UnimplementedQueryServer) PythPrice(ctx context.Context, req *QueryPythPriceRequest) (*QueryPythPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PythPrice not implemented")
 }
+func (*UnimplementedQueryServer) CompositeIndex(ctx context.Context, req *QueryCompositeIndexRequest) (*QueryCompositeIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompositeIndex not implemented")
+}
+func (*UnimplementedQueryServer) CompositeIndices(ctx context.Context, req *QueryCompositeIndicesRequest) (*QueryCompositeIndicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompositeIndices not implemented")
+}
+func (*UnimplementedQueryServer) ExchangeTwapConfigs(ctx context.Context, req *QueryExchangeTwapConfigsRequest) (*QueryExchangeTwapConfigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeTwapConfigs not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2162,29 +2490,83 @@ func _Query_PythPrice_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "injective.oracle.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "BandRelayers",
-			Handler:    _Query_BandRelayers_Handler,
-		},
-		{
-			MethodName: "BandPriceStates",
-			Handler:    _Query_BandPriceStates_Handler,
-		},
-		{
-			MethodName: "BandIBCPriceStates",
-			Handler:    _Query_BandIBCPriceStates_Handler,
-		},
-		{
-			MethodName: "PriceFeedPriceStates",
-			Handler:    _Query_PriceFeedPriceStates_Handler,
+func _Query_CompositeIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCompositeIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CompositeIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/CompositeIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CompositeIndex(ctx, req.(*QueryCompositeIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CompositeIndices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCompositeIndicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CompositeIndices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/CompositeIndices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CompositeIndices(ctx, req.(*QueryCompositeIndicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExchangeTwapConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeTwapConfigsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeTwapConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/ExchangeTwapConfigs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeTwapConfigs(ctx, req.(*QueryExchangeTwapConfigsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "injective.oracle.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "BandRelayers",
+			Handler:    _Query_BandRelayers_Handler,
+		},
+		{
+			MethodName: "BandPriceStates",
+			Handler:    _Query_BandPriceStates_Handler,
+		},
+		{
+			MethodName: "BandIBCPriceStates",
+			Handler:    _Query_BandIBCPriceStates_Handler,
+		},
+		{
+			MethodName: "PriceFeedPriceStates",
+			Handler:    _Query_PriceFeedPriceStates_Handler,
 		},
 		{
 			MethodName: "CoinbasePriceStates",
@@ -2226,6 +2608,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PythPrice",
 			Handler:    _Query_PythPrice_Handler,
 		},
+		{
+			MethodName: "CompositeIndex",
+			Handler:    _Query_CompositeIndex_Handler,
+		},
+		{
+			MethodName: "CompositeIndices",
+			Handler:    _Query_CompositeIndices_Handler,
+		},
+		{
+			MethodName: "ExchangeTwapConfigs",
+			Handler:    _Query_ExchangeTwapConfigs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "injective/oracle/v1beta1/query.proto",
@@ -2296,6 +2690,217 @@ func (m *QueryPythPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCompositeIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCompositeIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCompositeIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCompositeIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCompositeIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCompositeIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Price != nil {
+		{
+			size := m.Price.Size()
+			i -= size
+			if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ComponentPrices) > 0 {
+		for iNdEx := len(m.ComponentPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ComponentPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Index != nil {
+		{
+			size, err := m.Index.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCompositeIndicesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCompositeIndicesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCompositeIndicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCompositeIndicesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCompositeIndicesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCompositeIndicesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Indices) > 0 {
+		for iNdEx := len(m.Indices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Indices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeTwapConfigsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeTwapConfigsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeTwapConfigsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeTwapConfigsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeTwapConfigsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeTwapConfigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Configs) > 0 {
+		for iNdEx := len(m.Configs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Configs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3401,25 +4006,109 @@ func (m *QueryPythPriceResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
+func (m *QueryCompositeIndexRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
+func (m *QueryCompositeIndexResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
+	if m.Index != nil {
+		l = m.Index.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ComponentPrices) > 0 {
+		for _, e := range m.ComponentPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCompositeIndicesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCompositeIndicesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Indices) > 0 {
+		for _, e := range m.Indices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryExchangeTwapConfigsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryExchangeTwapConfigsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Configs) > 0 {
+		for _, e := range m.Configs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
 
 func (m *QueryBandRelayersRequest) Size() (n int) {
 	if m == nil {
@@ -3994,6 +4683,512 @@ func (m *QueryPythPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCompositeIndexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCompositeIndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCompositeIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCompositeIndexResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCompositeIndexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCompositeIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Index == nil {
+				m.Index = &CompositeIndex{}
+			}
+			if err := m.Index.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentPrices = append(m.ComponentPrices, CompositeIndexComponentPrice{})
+			if err := m.ComponentPrices[len(m.ComponentPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.Price = &v
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCompositeIndicesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCompositeIndicesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCompositeIndicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCompositeIndicesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCompositeIndicesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCompositeIndicesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indices = append(m.Indices, &CompositeIndex{})
+			if err := m.Indices[len(m.Indices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeTwapConfigsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeTwapConfigsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeTwapConfigsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeTwapConfigsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeTwapConfigsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeTwapConfigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Configs = append(m.Configs, &ExchangeTwapConfig{})
+			if err := m.Configs[len(m.Configs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
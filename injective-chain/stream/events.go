@@ -0,0 +1,158 @@
+package stream
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+	"github.com/InjectiveLabs/injective-core/injective-chain/stream/types"
+)
+
+// NewStreamResponse builds the update of a block from its typed events, ignoring any other event
+func NewStreamResponse(ctx sdk.Context, events []abci.Event) *types.StreamResponse {
+	res := &types.StreamResponse{
+		BlockHeight: ctx.BlockHeight(),
+		BlockTime:   ctx.BlockTime().UnixMilli(),
+	}
+
+	subaccountDeposits := make(map[string]*types.SubaccountDeposits)
+
+	for _, event := range events {
+		if proto.MessageType(event.Type) == nil {
+			continue
+		}
+
+		typedEvent, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+
+		switch e := typedEvent.(type) {
+		case *exchangetypes.EventOrderbookUpdate:
+			res.SpotOrderbookUpdates = append(res.SpotOrderbookUpdates, e.SpotUpdates...)
+			res.DerivativeOrderbookUpdates = append(res.DerivativeOrderbookUpdates, e.DerivativeUpdates...)
+		case *exchangetypes.EventBatchSpotExecution:
+			for _, trade := range e.Trades {
+				res.SpotTrades = append(res.SpotTrades, &types.SpotTrade{
+					MarketId:            e.MarketId,
+					IsBuy:               e.IsBuy,
+					ExecutionType:       e.ExecutionType,
+					Quantity:            trade.Quantity,
+					Price:               trade.Price,
+					SubaccountId:        common.BytesToHash(trade.SubaccountId).Hex(),
+					Fee:                 trade.Fee,
+					OrderHash:           common.BytesToHash(trade.OrderHash).Hex(),
+					FeeRecipientAddress: sdk.AccAddress(trade.FeeRecipientAddress).String(),
+				})
+			}
+		case *exchangetypes.EventBatchDerivativeExecution:
+			for _, trade := range e.Trades {
+				res.DerivativeTrades = append(res.DerivativeTrades, &types.DerivativeTrade{
+					MarketId:            e.MarketId,
+					IsBuy:               e.IsBuy,
+					ExecutionType:       e.ExecutionType,
+					IsLiquidation:       e.IsLiquidation,
+					SubaccountId:        common.BytesToHash(trade.SubaccountId).Hex(),
+					PositionDelta:       trade.PositionDelta,
+					Payout:              trade.Payout,
+					Fee:                 trade.Fee,
+					OrderHash:           common.BytesToHash(trade.OrderHash).Hex(),
+					FeeRecipientAddress: sdk.AccAddress(trade.FeeRecipientAddress).String(),
+				})
+			}
+		case *exchangetypes.EventNewSpotOrders:
+			for _, order := range append(e.BuyOrders, e.SellOrders...) {
+				res.SpotOrders = append(res.SpotOrders, newSpotOrderUpdate(types.OrderUpdateStatus_Booked, e.MarketId, order))
+			}
+		case *exchangetypes.EventCancelSpotOrder:
+			order := e.Order
+			res.SpotOrders = append(res.SpotOrders, newSpotOrderUpdate(types.OrderUpdateStatus_Cancelled, e.MarketId, &order))
+		case *exchangetypes.EventNewDerivativeOrders:
+			for _, order := range append(e.BuyOrders, e.SellOrders...) {
+				res.DerivativeOrders = append(res.DerivativeOrders, newDerivativeOrderUpdate(types.OrderUpdateStatus_Booked, e.MarketId, order))
+			}
+		case *exchangetypes.EventCancelDerivativeOrder:
+			// market orders never rest on the orderbook
+			if e.IsLimitCancel && e.LimitOrder != nil {
+				res.DerivativeOrders = append(res.DerivativeOrders, newDerivativeOrderUpdate(types.OrderUpdateStatus_Cancelled, e.MarketId, e.LimitOrder))
+			}
+		case *exchangetypes.EventBatchDerivativePosition:
+			for _, position := range e.Positions {
+				res.Positions = append(res.Positions, &types.Position{
+					MarketId:     e.MarketId,
+					SubaccountId: common.BytesToHash(position.SubaccountId).Hex(),
+					Position:     position.Position,
+				})
+			}
+		case *exchangetypes.EventBatchDepositUpdate:
+			for _, depositUpdate := range e.DepositUpdates {
+				for _, deposit := range depositUpdate.Deposits {
+					if deposit.Deposit == nil {
+						continue
+					}
+
+					subaccountID := common.BytesToHash(deposit.SubaccountId).Hex()
+					if _, ok := subaccountDeposits[subaccountID]; !ok {
+						subaccountDeposits[subaccountID] = &types.SubaccountDeposits{SubaccountId: subaccountID}
+						res.SubaccountDeposits = append(res.SubaccountDeposits, subaccountDeposits[subaccountID])
+					}
+
+					subaccountDeposits[subaccountID].Deposits = append(subaccountDeposits[subaccountID].Deposits, types.SubaccountDeposit{
+						Denom:   depositUpdate.Denom,
+						Deposit: *deposit.Deposit,
+					})
+				}
+			}
+		case *oracletypes.SetBandPriceEvent:
+			res.OraclePrices = append(res.OraclePrices, newOraclePrice(e.Symbol, e.Price, oracletypes.OracleType_Band))
+		case *oracletypes.SetBandIBCPriceEvent:
+			for idx := range e.Symbols {
+				if idx < len(e.Prices) {
+					res.OraclePrices = append(res.OraclePrices, newOraclePrice(e.Symbols[idx], e.Prices[idx], oracletypes.OracleType_BandIBC))
+				}
+			}
+		case *oracletypes.SetPriceFeedPriceEvent:
+			res.OraclePrices = append(res.OraclePrices, newOraclePrice(e.Base+"/"+e.Quote, e.Price, oracletypes.OracleType_PriceFeed))
+		case *oracletypes.SetCoinbasePriceEvent:
+			res.OraclePrices = append(res.OraclePrices, newOraclePrice(e.Symbol, e.Price, oracletypes.OracleType_Coinbase))
+		case *oracletypes.SetChainlinkPriceEvent:
+			res.OraclePrices = append(res.OraclePrices, newOraclePrice(e.FeedId, e.Answer, oracletypes.OracleType_Chainlink))
+		case *oracletypes.SetProviderPriceEvent:
+			res.OraclePrices = append(res.OraclePrices, newOraclePrice(e.Provider+"/"+e.Symbol, e.Price, oracletypes.OracleType_Provider))
+		case *oracletypes.EventSetPythPrices:
+			for _, price := range e.Prices {
+				res.OraclePrices = append(res.OraclePrices, newOraclePrice(price.PriceId, price.PriceState.Price, oracletypes.OracleType_Pyth))
+			}
+		}
+	}
+	return res
+}
+
+func newSpotOrderUpdate(status types.OrderUpdateStatus, marketID string, order *exchangetypes.SpotLimitOrder) *types.SpotOrderUpdate {
+	return &types.SpotOrderUpdate{
+		Status:    status,
+		MarketId:  marketID,
+		OrderHash: common.BytesToHash(order.OrderHash).Hex(),
+		Order:     order,
+	}
+}
+
+func newDerivativeOrderUpdate(status types.OrderUpdateStatus, marketID string, order *exchangetypes.DerivativeLimitOrder) *types.DerivativeOrderUpdate {
+	return &types.DerivativeOrderUpdate{
+		Status:    status,
+		MarketId:  marketID,
+		OrderHash: common.BytesToHash(order.OrderHash).Hex(),
+		Order:     order,
+	}
+}
+
+func newOraclePrice(symbol string, price sdk.Dec, oracleType oracletypes.OracleType) *types.OraclePrice {
+	return &types.OraclePrice{
+		Symbol: symbol,
+		Price:  price,
+		Type:   oracleType.String(),
+	}
+}
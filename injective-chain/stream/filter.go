@@ -0,0 +1,181 @@
+package stream
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/stream/types"
+)
+
+// idSet matches the IDs it contains, or any ID if nil
+type idSet map[string]struct{}
+
+func newIDSet(ids []string) idSet {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	set := make(idSet, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+	return set
+}
+
+func newHashSet(ids []string) idSet {
+	hashes := make([]string, 0, len(ids))
+	for _, id := range ids {
+		hashes = append(hashes, normalizeHash(id))
+	}
+	return newIDSet(hashes)
+}
+
+func (s idSet) matches(id string) bool {
+	if s == nil {
+		return true
+	}
+
+	_, ok := s[id]
+	return ok
+}
+
+// normalizeHash returns the lowercase hex of a market or subaccount ID
+func normalizeHash(id string) string {
+	return common.HexToHash(id).Hex()
+}
+
+type marketSubaccountFilter struct {
+	marketIDs     idSet
+	subaccountIDs idSet
+}
+
+func newMarketSubaccountFilter(marketIDs, subaccountIDs []string) *marketSubaccountFilter {
+	return &marketSubaccountFilter{
+		marketIDs:     newHashSet(marketIDs),
+		subaccountIDs: newHashSet(subaccountIDs),
+	}
+}
+
+// matches returns false if the filter is nil, i.e. the update type isn't subscribed to
+func (f *marketSubaccountFilter) matches(marketID, subaccountID string) bool {
+	return f != nil && f.marketIDs.matches(normalizeHash(marketID)) && f.subaccountIDs.matches(normalizeHash(subaccountID))
+}
+
+type oraclePriceFilter struct {
+	symbols idSet
+}
+
+// matches returns false if the filter is nil, i.e. the oracle prices aren't subscribed to
+func (f *oraclePriceFilter) matches(symbol string) bool {
+	return f != nil && f.symbols.matches(symbol)
+}
+
+// streamFilter is the compiled filter of a stream request. A nil filter doesn't match anything.
+type streamFilter struct {
+	orderbooks         *marketSubaccountFilter
+	spotTrades         *marketSubaccountFilter
+	derivativeTrades   *marketSubaccountFilter
+	spotOrders         *marketSubaccountFilter
+	derivativeOrders   *marketSubaccountFilter
+	positions          *marketSubaccountFilter
+	subaccountDeposits *marketSubaccountFilter
+	oraclePrices       *oraclePriceFilter
+}
+
+func newStreamFilter(req *types.StreamRequest) *streamFilter {
+	filter := &streamFilter{}
+
+	if f := req.OrderbooksFilter; f != nil {
+		filter.orderbooks = newMarketSubaccountFilter(f.MarketIds, nil)
+	}
+	if f := req.SpotTradesFilter; f != nil {
+		filter.spotTrades = newMarketSubaccountFilter(f.MarketIds, f.SubaccountIds)
+	}
+	if f := req.DerivativeTradesFilter; f != nil {
+		filter.derivativeTrades = newMarketSubaccountFilter(f.MarketIds, f.SubaccountIds)
+	}
+	if f := req.SpotOrdersFilter; f != nil {
+		filter.spotOrders = newMarketSubaccountFilter(f.MarketIds, f.SubaccountIds)
+	}
+	if f := req.DerivativeOrdersFilter; f != nil {
+		filter.derivativeOrders = newMarketSubaccountFilter(f.MarketIds, f.SubaccountIds)
+	}
+	if f := req.PositionsFilter; f != nil {
+		filter.positions = newMarketSubaccountFilter(f.MarketIds, f.SubaccountIds)
+	}
+	if f := req.SubaccountDepositsFilter; f != nil {
+		filter.subaccountDeposits = newMarketSubaccountFilter(nil, f.SubaccountIds)
+	}
+	if f := req.OraclePriceFilter; f != nil {
+		filter.oraclePrices = &oraclePriceFilter{symbols: newIDSet(f.Symbols)}
+	}
+	return filter
+}
+
+// apply returns the part of the block update matching the filter, or nil if nothing matches
+func (f *streamFilter) apply(update *types.StreamResponse) *types.StreamResponse {
+	res := &types.StreamResponse{
+		BlockHeight: update.BlockHeight,
+		BlockTime:   update.BlockTime,
+	}
+	isEmpty := true
+
+	for _, orderbookUpdate := range update.SpotOrderbookUpdates {
+		if f.orderbooks.matches(common.BytesToHash(orderbookUpdate.Orderbook.MarketId).Hex(), "") {
+			res.SpotOrderbookUpdates = append(res.SpotOrderbookUpdates, orderbookUpdate)
+			isEmpty = false
+		}
+	}
+	for _, orderbookUpdate := range update.DerivativeOrderbookUpdates {
+		if f.orderbooks.matches(common.BytesToHash(orderbookUpdate.Orderbook.MarketId).Hex(), "") {
+			res.DerivativeOrderbookUpdates = append(res.DerivativeOrderbookUpdates, orderbookUpdate)
+			isEmpty = false
+		}
+	}
+	for _, trade := range update.SpotTrades {
+		if f.spotTrades.matches(trade.MarketId, trade.SubaccountId) {
+			res.SpotTrades = append(res.SpotTrades, trade)
+			isEmpty = false
+		}
+	}
+	for _, trade := range update.DerivativeTrades {
+		if f.derivativeTrades.matches(trade.MarketId, trade.SubaccountId) {
+			res.DerivativeTrades = append(res.DerivativeTrades, trade)
+			isEmpty = false
+		}
+	}
+	for _, order := range update.SpotOrders {
+		if f.spotOrders.matches(order.MarketId, order.Order.OrderInfo.SubaccountId) {
+			res.SpotOrders = append(res.SpotOrders, order)
+			isEmpty = false
+		}
+	}
+	for _, order := range update.DerivativeOrders {
+		if f.derivativeOrders.matches(order.MarketId, order.Order.OrderInfo.SubaccountId) {
+			res.DerivativeOrders = append(res.DerivativeOrders, order)
+			isEmpty = false
+		}
+	}
+	for _, position := range update.Positions {
+		if f.positions.matches(position.MarketId, position.SubaccountId) {
+			res.Positions = append(res.Positions, position)
+			isEmpty = false
+		}
+	}
+	for _, deposits := range update.SubaccountDeposits {
+		if f.subaccountDeposits.matches("", deposits.SubaccountId) {
+			res.SubaccountDeposits = append(res.SubaccountDeposits, deposits)
+			isEmpty = false
+		}
+	}
+	for _, price := range update.OraclePrices {
+		if f.oraclePrices.matches(price.Symbol) {
+			res.OraclePrices = append(res.OraclePrices, price)
+			isEmpty = false
+		}
+	}
+
+	if isEmpty {
+		return nil
+	}
+	return res
+}
@@ -0,0 +1,125 @@
+package stream
+
+import (
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/stream/types"
+)
+
+// DefaultSubscriberBufferCapacity is the number of blocks a subscriber can lag behind before being dropped
+const DefaultSubscriberBufferCapacity = 100
+
+var _ baseapp.StreamingService = &Publisher{}
+
+// Publisher collects the events of every block through the ABCI listener hooks of the BaseApp and publishes the block
+// updates to its subscribers once the EndBlocker is executed. Publishing never blocks the block execution: a
+// subscriber whose buffer is full is dropped.
+type Publisher struct {
+	subscribersMux sync.RWMutex
+	subscribers    map[uint64]chan *types.StreamResponse
+	nextID         uint64
+	bufferCapacity int
+
+	// the events of the block being executed, only accessed by the ABCI hooks
+	events []abci.Event
+}
+
+func NewPublisher(bufferCapacity int) *Publisher {
+	return &Publisher{
+		subscribers:    make(map[uint64]chan *types.StreamResponse),
+		bufferCapacity: bufferCapacity,
+	}
+}
+
+// Subscribe returns the channel on which the updates of every block are published, closed if the subscriber is dropped
+func (p *Publisher) Subscribe() (id uint64, updates <-chan *types.StreamResponse) {
+	p.subscribersMux.Lock()
+	defer p.subscribersMux.Unlock()
+
+	id = p.nextID
+	p.nextID++
+
+	subscriber := make(chan *types.StreamResponse, p.bufferCapacity)
+	p.subscribers[id] = subscriber
+	return id, subscriber
+}
+
+func (p *Publisher) Unsubscribe(id uint64) {
+	p.subscribersMux.Lock()
+	defer p.subscribersMux.Unlock()
+
+	if subscriber, ok := p.subscribers[id]; ok {
+		close(subscriber)
+		delete(p.subscribers, id)
+	}
+}
+
+func (p *Publisher) hasSubscribers() bool {
+	p.subscribersMux.RLock()
+	defer p.subscribersMux.RUnlock()
+
+	return len(p.subscribers) > 0
+}
+
+func (p *Publisher) publish(update *types.StreamResponse) {
+	p.subscribersMux.Lock()
+	defer p.subscribersMux.Unlock()
+
+	for id, subscriber := range p.subscribers {
+		select {
+		case subscriber <- update:
+		default:
+			// the subscriber can't keep up with the blocks
+			close(subscriber)
+			delete(p.subscribers, id)
+		}
+	}
+}
+
+func (p *Publisher) ListenBeginBlock(_ sdk.Context, _ abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	p.events = append(p.events[:0], res.Events...)
+	return nil
+}
+
+func (p *Publisher) ListenDeliverTx(_ sdk.Context, _ abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	if res.IsOK() {
+		p.events = append(p.events, res.Events...)
+	}
+	return nil
+}
+
+func (p *Publisher) ListenEndBlock(ctx sdk.Context, _ abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	p.events = append(p.events, res.Events...)
+
+	if p.hasSubscribers() {
+		p.publish(NewStreamResponse(ctx, p.events))
+	}
+	return nil
+}
+
+// Listeners returns no store listener since the updates are built from the events
+func (p *Publisher) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// Stream is a no-op since the updates are published from the ABCI hooks
+func (p *Publisher) Stream(_ *sync.WaitGroup) error {
+	return nil
+}
+
+// Close drops all the subscribers
+func (p *Publisher) Close() error {
+	p.subscribersMux.Lock()
+	defer p.subscribersMux.Unlock()
+
+	for id, subscriber := range p.subscribers {
+		close(subscriber)
+		delete(p.subscribers, id)
+	}
+	return nil
+}
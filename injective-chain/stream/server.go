@@ -0,0 +1,82 @@
+package stream
+
+import (
+	"net"
+
+	log "github.com/xlab/suplog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/stream/types"
+)
+
+var _ types.StreamServer = &Server{}
+
+// Server serves the block updates of a Publisher as gRPC server-side streams, filtered by the request of every stream
+type Server struct {
+	publisher  *Publisher
+	grpcServer *grpc.Server
+}
+
+func NewServer(publisher *Publisher) *Server {
+	return &Server{
+		publisher: publisher,
+	}
+}
+
+// Serve starts serving the streams on the given address in the background
+func (s *Server) Serve(address string) error {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	s.grpcServer = grpc.NewServer()
+	types.RegisterStreamServer(s.grpcServer, s)
+
+	go func() {
+		log.Infoln("Starting stream server on", address)
+		if err := s.grpcServer.Serve(listener); err != nil {
+			log.WithError(err).Errorln("stream server stopped")
+		}
+	}()
+	return nil
+}
+
+// Stop closes the streams and stops the server
+func (s *Server) Stop() {
+	if s.grpcServer == nil {
+		return
+	}
+
+	_ = s.publisher.Close()
+	s.grpcServer.Stop()
+}
+
+func (s *Server) Stream(req *types.StreamRequest, server types.Stream_StreamServer) error {
+	filter := newStreamFilter(req)
+
+	id, updates := s.publisher.Subscribe()
+	defer s.publisher.Unsubscribe(id)
+
+	for {
+		select {
+		case <-server.Context().Done():
+			return nil
+		case update, ok := <-updates:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "the stream couldn't keep up with the blocks")
+			}
+
+			res := filter.apply(update)
+			if res == nil {
+				continue
+			}
+
+			if err := server.Send(res); err != nil {
+				return err
+			}
+		}
+	}
+}